@@ -349,6 +349,8 @@ func SetupAndRun(cmd *cobra.Command, args []string) {
 			result, err = mcir.FilterSpec(inData)
 		case "filter-by-range":
 			result, err = mcir.FilterSpecsByRange(inData)
+		case "search":
+			result, err = mcir.SearchSpecs(inData)
 		case "sort":
 			result, err = mcir.SortSpecs(inData)
 		case "update":
@@ -419,7 +421,13 @@ func SetupAndRun(cmd *cobra.Command, args []string) {
 		case "install-mon":
 			result, err = mcis.InstallMonitorAgentToMcis(inData)
 		case "get-mon":
-			result, err = mcis.GetMonitorDataByParam(nameSpaceID, mcisID, metric)
+			if monRange != "" {
+				result, err = mcis.GetMonitorRangeByParam(nameSpaceID, mcisID, metric, "-"+monRange, "", monStep, monAgg)
+			} else {
+				result, err = mcis.GetMonitorDataByParam(nameSpaceID, mcisID, metric)
+			}
+		case "cost":
+			result, err = mcis.GetCostReportByParam(nameSpaceID, mcisID, costFrom, costTo, costGroupBy, costFormat)
 		case "create-policy":
 			result, err = mcis.CreateMcisPolicy(inData)
 		case "list-policy":
@@ -64,6 +64,7 @@ func NewMcisCmd() *cobra.Command {
 
 	mcisCmd.AddCommand(NewInstallMonAgentCmd())
 	mcisCmd.AddCommand(NewGetMonDataCmd())
+	mcisCmd.AddCommand(NewMcisCostCmd())
 
 	mcisCmd.AddCommand(NewMcisCreatePolicyCmd())
 	mcisCmd.AddCommand(NewMcisListPolicyCmd())
@@ -1008,6 +1009,7 @@ func NewGetMonDataCmd() *cobra.Command {
 			logger.Debug("--ns parameter value : ", nameSpaceID)
 			logger.Debug("--mcis parameter value : ", mcisID)
 			logger.Debug("--metric parameter value : ", metric)
+			logger.Debug("--range parameter value : ", monRange)
 
 			SetupAndRun(cmd, args)
 		},
@@ -1016,10 +1018,46 @@ func NewGetMonDataCmd() *cobra.Command {
 	getMonCmd.PersistentFlags().StringVarP(&nameSpaceID, "ns", "", "", "namespace id")
 	getMonCmd.PersistentFlags().StringVarP(&mcisID, "mcis", "", "", "mcis id")
 	getMonCmd.PersistentFlags().StringVarP(&metric, "metric", "", "", "metric")
+	getMonCmd.PersistentFlags().StringVarP(&monRange, "range", "", "", "time range until now for retained samples (ex: 1h, 30m)")
+	getMonCmd.PersistentFlags().StringVarP(&monStep, "step", "", "", "step of points for --range (ex: 1m)")
+	getMonCmd.PersistentFlags().StringVarP(&monAgg, "agg", "", "", "aggregation for --range (avg, min, max, sum, count)")
 
 	return getMonCmd
 }
 
+// NewMcisCostCmd : "cbadm mcis cost"
+func NewMcisCostCmd() *cobra.Command {
+
+	costCmd := &cobra.Command{
+		Use:   "cost",
+		Short: "This is cost command for mcis",
+		Long:  "This is cost command for mcis (cost report of all MCIS in the namespace if --mcis is not given)",
+		Run: func(cmd *cobra.Command, args []string) {
+			logger := logger.NewLogger()
+			if nameSpaceID == "" {
+				logger.Error("failed to validate --ns parameter")
+				return
+			}
+			logger.Debug("--ns parameter value : ", nameSpaceID)
+			logger.Debug("--mcis parameter value : ", mcisID)
+			logger.Debug("--from parameter value : ", costFrom)
+			logger.Debug("--to parameter value : ", costTo)
+			logger.Debug("--group-by parameter value : ", costGroupBy)
+
+			SetupAndRun(cmd, args)
+		},
+	}
+
+	costCmd.PersistentFlags().StringVarP(&nameSpaceID, "ns", "", "", "namespace id")
+	costCmd.PersistentFlags().StringVarP(&mcisID, "mcis", "", "", "mcis id")
+	costCmd.PersistentFlags().StringVarP(&costFrom, "from", "", "", "start of time range (RFC3339 or YYYY-MM-DD, the first day of this month if empty)")
+	costCmd.PersistentFlags().StringVarP(&costTo, "to", "", "", "end of time range (RFC3339 or YYYY-MM-DD, now if empty)")
+	costCmd.PersistentFlags().StringVarP(&costGroupBy, "group-by", "", "mcis", "group of cost (vm, mcis, ns, connection, label)")
+	costCmd.PersistentFlags().StringVarP(&costFormat, "format", "", "", "csv to get the report in CSV (output format by --output if empty)")
+
+	return costCmd
+}
+
 // NewMcisCreatePolicyCmd : "cbadm mcis create-policy"
 func NewMcisCreatePolicyCmd() *cobra.Command {
 
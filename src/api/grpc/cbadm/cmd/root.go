@@ -41,6 +41,13 @@ var (
 	host         string
 	action       string
 	metric       string
+	monRange     string
+	monStep      string
+	monAgg       string
+	costFrom     string
+	costTo       string
+	costGroupBy  string
+	costFormat   string
 
 	configId string
 	objKey   string
@@ -38,6 +38,7 @@ func NewSpecCmd() *cobra.Command {
 	specCmd.AddCommand(NewSpecFetchCmd())
 	specCmd.AddCommand(NewSpecFilterCmd())
 	specCmd.AddCommand(NewSpecFilterByRangeCmd())
+	specCmd.AddCommand(NewSpecSearchCmd())
 	specCmd.AddCommand(NewSpecSortCmd())
 	specCmd.AddCommand(NewSpecUpdateCmd())
 
@@ -396,6 +397,33 @@ func NewSpecFilterByRangeCmd() *cobra.Command {
 	return filterByRangeCmd
 }
 
+// NewSpecSearchCmd : "cbadm spec search"
+func NewSpecSearchCmd() *cobra.Command {
+
+	searchCmd := &cobra.Command{
+		Use:   "search",
+		Short: "This is search command for spec",
+		Long:  "This is search command for spec",
+		Run: func(cmd *cobra.Command, args []string) {
+			logger := logger.NewLogger()
+			readInDataFromFile()
+			if inData == "" {
+				logger.Error("failed to validate --indata parameter")
+				return
+			}
+			logger.Debug("--indata parameter value : \n", inData)
+			logger.Debug("--infile parameter value : ", inFile)
+
+			SetupAndRun(cmd, args)
+		},
+	}
+
+	searchCmd.PersistentFlags().StringVarP(&inData, "indata", "d", "", "input string data")
+	searchCmd.PersistentFlags().StringVarP(&inFile, "infile", "f", "", "input file path")
+
+	return searchCmd
+}
+
 // NewSpecSortCmd : "cbadm spec sort"
 func NewSpecSortCmd() *cobra.Command {
 
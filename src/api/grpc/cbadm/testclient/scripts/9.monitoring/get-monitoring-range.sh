@@ -0,0 +1,39 @@
+#!/bin/bash
+
+#function get_monitoring_range() {
+
+
+	TestSetFile=${7:-../testSet.env}
+    
+    if [ ! -f "$TestSetFile" ]; then
+        echo "$TestSetFile does not exist."
+        exit
+    fi
+	source $TestSetFile
+    source ../conf.env
+	
+	echo "####################################################################"
+	echo "## Get retained monitoring data for MCIS in a time range (cpu/memory/disk/network)"
+	echo "####################################################################"
+
+	CSP=${1}
+	REGION=${2:-1}
+	POSTFIX=${3:-developer}
+
+	source ../common-functions.sh
+	getCloudIndex $CSP
+
+	MCISID=${CONN_CONFIG[$INDEX,$REGION]}-${POSTFIX}
+	if [ "${INDEX}" == "0" ]; then
+		# MCISPREFIX=avengers
+		MCISID=${MCISPREFIX}-${POSTFIX}
+	fi
+
+	USERCMD=${4}
+	RANGE=${5:-1h}
+	STEP=${6:-1m}
+
+	$CBTUMBLEBUG_ROOT/src/api/grpc/cbadm/cbadm mcis get-mon --config $CBTUMBLEBUG_ROOT/src/api/grpc/cbadm/grpc_conf.yaml -o json --ns $NSID --mcis $MCISID --metric $USERCMD --range $RANGE --step $STEP --agg avg | jq '' #|| return 1
+#}
+
+#get_monitoring_range
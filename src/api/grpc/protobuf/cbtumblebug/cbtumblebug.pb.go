@@ -1063,6 +1063,13 @@ type TbImageInfo struct {
 	KeyValueList         []*KeyValue `protobuf:"bytes,11,rep,name=key_value_list,json=keyValueList,proto3" json:"keyValueList,omitempty" yaml:"keyValueList"`
 	AssociatedObjectList []string    `protobuf:"bytes,12,rep,name=associated_object_list,json=associatedObjectList,proto3" json:"associatedObjectList" yaml:"associatedObjectList"`
 	IsAutoGenerated      bool        `protobuf:"varint,13,opt,name=is_auto_generated,json=isAutoGenerated,proto3" json:"isAutoGenerated" yaml:"isAutoGenerated"`
+	OsFamily             string      `protobuf:"bytes,14,opt,name=os_family,json=osFamily,proto3" json:"osFamily,omitempty" yaml:"osFamily"`
+	OsDistribution       string      `protobuf:"bytes,15,opt,name=os_distribution,json=osDistribution,proto3" json:"osDistribution,omitempty" yaml:"osDistribution"`
+	OsVersion            string      `protobuf:"bytes,16,opt,name=os_version,json=osVersion,proto3" json:"osVersion,omitempty" yaml:"osVersion"`
+	OsArchitecture       string      `protobuf:"bytes,17,opt,name=os_architecture,json=osArchitecture,proto3" json:"osArchitecture,omitempty" yaml:"osArchitecture"`
+	VirtualizationType   string      `protobuf:"bytes,18,opt,name=virtualization_type,json=virtualizationType,proto3" json:"virtualizationType,omitempty" yaml:"virtualizationType"`
+	BootMode             string      `protobuf:"bytes,19,opt,name=boot_mode,json=bootMode,proto3" json:"bootMode,omitempty" yaml:"bootMode"`
+	Deprecated           bool        `protobuf:"varint,20,opt,name=deprecated,proto3" json:"deprecated,omitempty" yaml:"deprecated"`
 	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
 	XXX_unrecognized     []byte      `json:"-"`
 	XXX_sizecache        int32       `json:"-"`
@@ -1192,6 +1199,55 @@ func (m *TbImageInfo) GetIsAutoGenerated() bool {
 	return false
 }
 
+func (m *TbImageInfo) GetOsFamily() string {
+	if m != nil {
+		return m.OsFamily
+	}
+	return ""
+}
+
+func (m *TbImageInfo) GetOsDistribution() string {
+	if m != nil {
+		return m.OsDistribution
+	}
+	return ""
+}
+
+func (m *TbImageInfo) GetOsVersion() string {
+	if m != nil {
+		return m.OsVersion
+	}
+	return ""
+}
+
+func (m *TbImageInfo) GetOsArchitecture() string {
+	if m != nil {
+		return m.OsArchitecture
+	}
+	return ""
+}
+
+func (m *TbImageInfo) GetVirtualizationType() string {
+	if m != nil {
+		return m.VirtualizationType
+	}
+	return ""
+}
+
+func (m *TbImageInfo) GetBootMode() string {
+	if m != nil {
+		return m.BootMode
+	}
+	return ""
+}
+
+func (m *TbImageInfo) GetDeprecated() bool {
+	if m != nil {
+		return m.Deprecated
+	}
+	return false
+}
+
 type TbImageCreateRequest struct {
 	NsId                 string      `protobuf:"bytes,1,opt,name=ns_id,json=nsId,proto3" json:"nsId" yaml:"nsId"`
 	Item                 *TbImageReq `protobuf:"bytes,2,opt,name=item,json=image,proto3" json:"image" yaml:"image"`
@@ -1376,6 +1432,14 @@ func (m *FetchImageQryRequest) GetNsId() string {
 type SearchImageQryRequest struct {
 	NsId                 string   `protobuf:"bytes,1,opt,name=ns_id,json=nsId,proto3" json:"nsId" yaml:"nsId"`
 	Keywords             []string `protobuf:"bytes,2,rep,name=keywords,proto3" json:"keywords" yaml:"keywords"`
+	ConnectionName       string   `protobuf:"bytes,3,opt,name=connection_name,json=connectionName,proto3" json:"connectionName" yaml:"connectionName"`
+	OsFamily             string   `protobuf:"bytes,4,opt,name=os_family,json=osFamily,proto3" json:"osFamily" yaml:"osFamily"`
+	OsDistribution       string   `protobuf:"bytes,5,opt,name=os_distribution,json=osDistribution,proto3" json:"osDistribution" yaml:"osDistribution"`
+	OsVersion            string   `protobuf:"bytes,6,opt,name=os_version,json=osVersion,proto3" json:"osVersion" yaml:"osVersion"`
+	OsArchitecture       string   `protobuf:"bytes,7,opt,name=os_architecture,json=osArchitecture,proto3" json:"osArchitecture" yaml:"osArchitecture"`
+	VirtualizationType   string   `protobuf:"bytes,8,opt,name=virtualization_type,json=virtualizationType,proto3" json:"virtualizationType" yaml:"virtualizationType"`
+	BootMode             string   `protobuf:"bytes,9,opt,name=boot_mode,json=bootMode,proto3" json:"bootMode" yaml:"bootMode"`
+	Os                   string   `protobuf:"bytes,10,opt,name=os,proto3" json:"os" yaml:"os"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1428,6 +1492,62 @@ func (m *SearchImageQryRequest) GetKeywords() []string {
 	return nil
 }
 
+func (m *SearchImageQryRequest) GetConnectionName() string {
+	if m != nil {
+		return m.ConnectionName
+	}
+	return ""
+}
+
+func (m *SearchImageQryRequest) GetOsFamily() string {
+	if m != nil {
+		return m.OsFamily
+	}
+	return ""
+}
+
+func (m *SearchImageQryRequest) GetOsDistribution() string {
+	if m != nil {
+		return m.OsDistribution
+	}
+	return ""
+}
+
+func (m *SearchImageQryRequest) GetOsVersion() string {
+	if m != nil {
+		return m.OsVersion
+	}
+	return ""
+}
+
+func (m *SearchImageQryRequest) GetOsArchitecture() string {
+	if m != nil {
+		return m.OsArchitecture
+	}
+	return ""
+}
+
+func (m *SearchImageQryRequest) GetVirtualizationType() string {
+	if m != nil {
+		return m.VirtualizationType
+	}
+	return ""
+}
+
+func (m *SearchImageQryRequest) GetBootMode() string {
+	if m != nil {
+		return m.BootMode
+	}
+	return ""
+}
+
+func (m *SearchImageQryRequest) GetOs() string {
+	if m != nil {
+		return m.Os
+	}
+	return ""
+}
+
 type SpiderImageInfoResponse struct {
 	Item                 *SpiderImageInfo `protobuf:"bytes,1,opt,name=item,json=image,proto3" json:"image" yaml:"image"`
 	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
@@ -2326,44 +2446,47 @@ func (m *ListTbSpecInfoResponse) GetItems() []*TbSpecInfo {
 }
 
 type TbSpecInfo struct {
-	Namespace             string   `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace" yaml:"namespace"`
-	Id                    string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id" yaml:"id"`
-	Name                  string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name" yaml:"name"`
-	ConnectionName        string   `protobuf:"bytes,4,opt,name=connection_name,json=connectionName,proto3" json:"connectionName" yaml:"connectionName"`
-	CspSpecName           string   `protobuf:"bytes,5,opt,name=csp_spec_name,json=cspSpecName,proto3" json:"cspSpecName" yaml:"cspSpecName"`
-	OsType                string   `protobuf:"bytes,6,opt,name=os_type,proto3" json:"os_type" yaml:"os_type"`
-	NumVcpu               uint32   `protobuf:"varint,7,opt,name=num_vcpu,json=num_vCPU,proto3" json:"num_vCPU" yaml:"num_vCPU"`
-	NumCore               uint32   `protobuf:"varint,8,opt,name=num_core,proto3" json:"num_core" yaml:"num_core"`
-	MemGib                float32  `protobuf:"fixed32,9,opt,name=mem_gib,json=mem_GiB,proto3" json:"mem_GiB" yaml:"mem_GiB"`
-	StorageGib            uint32   `protobuf:"varint,10,opt,name=storage_gib,json=storage_GiB,proto3" json:"storage_GiB" yaml:"storage_GiB"`
-	Description           string   `protobuf:"bytes,11,opt,name=description,proto3" json:"description" yaml:"description"`
-	CostPerHour           float32  `protobuf:"fixed32,12,opt,name=cost_per_hour,proto3" json:"cost_per_hour" yaml:"cost_per_hour"`
-	NumStorage            uint32   `protobuf:"varint,13,opt,name=num_storage,proto3" json:"num_storage" yaml:"num_storage"`
-	MaxNumStorage         uint32   `protobuf:"varint,14,opt,name=max_num_storage,proto3" json:"max_num_storage" yaml:"max_num_storage"`
-	MaxTotalStorageTib    uint32   `protobuf:"varint,15,opt,name=max_total_storage_tib,json=max_total_storage_TiB,proto3" json:"max_total_storage_TiB" yaml:"max_total_storage_TiB"`
-	NetBwGbps             uint32   `protobuf:"varint,16,opt,name=net_bw_gbps,json=net_bw_Gbps,proto3" json:"net_bw_Gbps" yaml:"net_bw_Gbps"`
-	EbsBwMbps             uint32   `protobuf:"varint,17,opt,name=ebs_bw_mbps,json=ebs_bw_Mbps,proto3" json:"ebs_bw_Mbps" yaml:"ebs_bw_Mbps"`
-	GpuModel              string   `protobuf:"bytes,18,opt,name=gpu_model,proto3" json:"gpu_model" yaml:"gpu_model"`
-	NumGpu                uint32   `protobuf:"varint,19,opt,name=num_gpu,proto3" json:"num_gpu" yaml:"num_gpu"`
-	GpumemGib             float32  `protobuf:"fixed32,20,opt,name=gpumem_gib,json=gpumem_GiB,proto3" json:"gpumem_GiB" yaml:"gpumem_GiB"`
-	GpuP2P                string   `protobuf:"bytes,21,opt,name=gpu_p2p,proto3" json:"gpu_p2p" yaml:"gpu_p2p"`
-	OrderInFilteredResult uint32   `protobuf:"varint,22,opt,name=order_in_filtered_result,json=orderInFilteredResult,proto3" json:"orderInFilteredResult" yaml:"orderInFilteredResult"`
-	EvaluationStatus      string   `protobuf:"bytes,23,opt,name=evaluation_status,json=evaluationStatus,proto3" json:"evaluationStatus" yaml:"evaluationStatus"`
-	EvaluationScore_01    float32  `protobuf:"fixed32,31,opt,name=evaluation_score_01,json=evaluationScore_01,proto3" json:"evaluationScore_01" yaml:"evaluationScore_01"`
-	EvaluationScore_02    float32  `protobuf:"fixed32,32,opt,name=evaluation_score_02,json=evaluationScore_02,proto3" json:"evaluationScore_02" yaml:"evaluationScore_02"`
-	EvaluationScore_03    float32  `protobuf:"fixed32,33,opt,name=evaluation_score_03,json=evaluationScore_03,proto3" json:"evaluationScore_03" yaml:"evaluationScore_03"`
-	EvaluationScore_04    float32  `protobuf:"fixed32,34,opt,name=evaluation_score_04,json=evaluationScore_04,proto3" json:"evaluationScore_04" yaml:"evaluationScore_04"`
-	EvaluationScore_05    float32  `protobuf:"fixed32,35,opt,name=evaluation_score_05,json=evaluationScore_05,proto3" json:"evaluationScore_05" yaml:"evaluationScore_05"`
-	EvaluationScore_06    float32  `protobuf:"fixed32,36,opt,name=evaluation_score_06,json=evaluationScore_06,proto3" json:"evaluationScore_06" yaml:"evaluationScore_06"`
-	EvaluationScore_07    float32  `protobuf:"fixed32,37,opt,name=evaluation_score_07,json=evaluationScore_07,proto3" json:"evaluationScore_07" yaml:"evaluationScore_07"`
-	EvaluationScore_08    float32  `protobuf:"fixed32,38,opt,name=evaluation_score_08,json=evaluationScore_08,proto3" json:"evaluationScore_08" yaml:"evaluationScore_08"`
-	EvaluationScore_09    float32  `protobuf:"fixed32,39,opt,name=evaluation_score_09,json=evaluationScore_09,proto3" json:"evaluationScore_09" yaml:"evaluationScore_09"`
-	EvaluationScore_10    float32  `protobuf:"fixed32,40,opt,name=evaluation_score_10,json=evaluationScore_10,proto3" json:"evaluationScore_10" yaml:"evaluationScore_10"`
-	AssociatedObjectList  []string `protobuf:"bytes,41,rep,name=associated_object_list,json=associatedObjectList,proto3" json:"associatedObjectList" yaml:"associatedObjectList"`
-	IsAutoGenerated       bool     `protobuf:"varint,42,opt,name=is_auto_generated,json=isAutoGenerated,proto3" json:"isAutoGenerated" yaml:"isAutoGenerated"`
-	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
-	XXX_unrecognized      []byte   `json:"-"`
-	XXX_sizecache         int32    `json:"-"`
+	Namespace             string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace" yaml:"namespace"`
+	Id                    string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id" yaml:"id"`
+	Name                  string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name" yaml:"name"`
+	ConnectionName        string                 `protobuf:"bytes,4,opt,name=connection_name,json=connectionName,proto3" json:"connectionName" yaml:"connectionName"`
+	CspSpecName           string                 `protobuf:"bytes,5,opt,name=csp_spec_name,json=cspSpecName,proto3" json:"cspSpecName" yaml:"cspSpecName"`
+	OsType                string                 `protobuf:"bytes,6,opt,name=os_type,proto3" json:"os_type" yaml:"os_type"`
+	NumVcpu               uint32                 `protobuf:"varint,7,opt,name=num_vcpu,json=num_vCPU,proto3" json:"num_vCPU" yaml:"num_vCPU"`
+	NumCore               uint32                 `protobuf:"varint,8,opt,name=num_core,proto3" json:"num_core" yaml:"num_core"`
+	MemGib                float32                `protobuf:"fixed32,9,opt,name=mem_gib,json=mem_GiB,proto3" json:"mem_GiB" yaml:"mem_GiB"`
+	StorageGib            uint32                 `protobuf:"varint,10,opt,name=storage_gib,json=storage_GiB,proto3" json:"storage_GiB" yaml:"storage_GiB"`
+	Description           string                 `protobuf:"bytes,11,opt,name=description,proto3" json:"description" yaml:"description"`
+	CostPerHour           float32                `protobuf:"fixed32,12,opt,name=cost_per_hour,proto3" json:"cost_per_hour" yaml:"cost_per_hour"`
+	NumStorage            uint32                 `protobuf:"varint,13,opt,name=num_storage,proto3" json:"num_storage" yaml:"num_storage"`
+	MaxNumStorage         uint32                 `protobuf:"varint,14,opt,name=max_num_storage,proto3" json:"max_num_storage" yaml:"max_num_storage"`
+	MaxTotalStorageTib    uint32                 `protobuf:"varint,15,opt,name=max_total_storage_tib,json=max_total_storage_TiB,proto3" json:"max_total_storage_TiB" yaml:"max_total_storage_TiB"`
+	NetBwGbps             uint32                 `protobuf:"varint,16,opt,name=net_bw_gbps,json=net_bw_Gbps,proto3" json:"net_bw_Gbps" yaml:"net_bw_Gbps"`
+	EbsBwMbps             uint32                 `protobuf:"varint,17,opt,name=ebs_bw_mbps,json=ebs_bw_Mbps,proto3" json:"ebs_bw_Mbps" yaml:"ebs_bw_Mbps"`
+	GpuModel              string                 `protobuf:"bytes,18,opt,name=gpu_model,proto3" json:"gpu_model" yaml:"gpu_model"`
+	NumGpu                uint32                 `protobuf:"varint,19,opt,name=num_gpu,proto3" json:"num_gpu" yaml:"num_gpu"`
+	GpumemGib             float32                `protobuf:"fixed32,20,opt,name=gpumem_gib,json=gpumem_GiB,proto3" json:"gpumem_GiB" yaml:"gpumem_GiB"`
+	GpuP2P                string                 `protobuf:"bytes,21,opt,name=gpu_p2p,proto3" json:"gpu_p2p" yaml:"gpu_p2p"`
+	OrderInFilteredResult uint32                 `protobuf:"varint,22,opt,name=order_in_filtered_result,json=orderInFilteredResult,proto3" json:"orderInFilteredResult" yaml:"orderInFilteredResult"`
+	EvaluationStatus      string                 `protobuf:"bytes,23,opt,name=evaluation_status,json=evaluationStatus,proto3" json:"evaluationStatus" yaml:"evaluationStatus"`
+	EvaluationScore_01    float32                `protobuf:"fixed32,31,opt,name=evaluation_score_01,json=evaluationScore_01,proto3" json:"evaluationScore_01" yaml:"evaluationScore_01"`
+	EvaluationScore_02    float32                `protobuf:"fixed32,32,opt,name=evaluation_score_02,json=evaluationScore_02,proto3" json:"evaluationScore_02" yaml:"evaluationScore_02"`
+	EvaluationScore_03    float32                `protobuf:"fixed32,33,opt,name=evaluation_score_03,json=evaluationScore_03,proto3" json:"evaluationScore_03" yaml:"evaluationScore_03"`
+	EvaluationScore_04    float32                `protobuf:"fixed32,34,opt,name=evaluation_score_04,json=evaluationScore_04,proto3" json:"evaluationScore_04" yaml:"evaluationScore_04"`
+	EvaluationScore_05    float32                `protobuf:"fixed32,35,opt,name=evaluation_score_05,json=evaluationScore_05,proto3" json:"evaluationScore_05" yaml:"evaluationScore_05"`
+	EvaluationScore_06    float32                `protobuf:"fixed32,36,opt,name=evaluation_score_06,json=evaluationScore_06,proto3" json:"evaluationScore_06" yaml:"evaluationScore_06"`
+	EvaluationScore_07    float32                `protobuf:"fixed32,37,opt,name=evaluation_score_07,json=evaluationScore_07,proto3" json:"evaluationScore_07" yaml:"evaluationScore_07"`
+	EvaluationScore_08    float32                `protobuf:"fixed32,38,opt,name=evaluation_score_08,json=evaluationScore_08,proto3" json:"evaluationScore_08" yaml:"evaluationScore_08"`
+	EvaluationScore_09    float32                `protobuf:"fixed32,39,opt,name=evaluation_score_09,json=evaluationScore_09,proto3" json:"evaluationScore_09" yaml:"evaluationScore_09"`
+	EvaluationScore_10    float32                `protobuf:"fixed32,40,opt,name=evaluation_score_10,json=evaluationScore_10,proto3" json:"evaluationScore_10" yaml:"evaluationScore_10"`
+	AssociatedObjectList  []string               `protobuf:"bytes,41,rep,name=associated_object_list,json=associatedObjectList,proto3" json:"associatedObjectList" yaml:"associatedObjectList"`
+	IsAutoGenerated       bool                   `protobuf:"varint,42,opt,name=is_auto_generated,json=isAutoGenerated,proto3" json:"isAutoGenerated" yaml:"isAutoGenerated"`
+	EvaluationBreakdown   []*SpecEvaluationScore `protobuf:"bytes,43,rep,name=evaluation_breakdown,json=evaluationBreakdown,proto3" json:"evaluationBreakdown" yaml:"evaluationBreakdown"`
+	Deprecated            bool                   `protobuf:"varint,44,opt,name=deprecated,proto3" json:"deprecated,omitempty" yaml:"deprecated"`
+	EvaluationTotalScore  float32                `protobuf:"fixed32,45,opt,name=evaluation_total_score,json=evaluationTotalScore,proto3" json:"evaluationTotalScore,omitempty" yaml:"evaluationTotalScore"`
+	XXX_NoUnkeyedLiteral  struct{}               `json:"-"`
+	XXX_unrecognized      []byte                 `json:"-"`
+	XXX_sizecache         int32                  `json:"-"`
 }
 
 func (m *TbSpecInfo) Reset()         { *m = TbSpecInfo{} }
@@ -2644,6 +2767,106 @@ func (m *TbSpecInfo) GetIsAutoGenerated() bool {
 	return false
 }
 
+func (m *TbSpecInfo) GetEvaluationBreakdown() []*SpecEvaluationScore {
+	if m != nil {
+		return m.EvaluationBreakdown
+	}
+	return nil
+}
+
+func (m *TbSpecInfo) GetDeprecated() bool {
+	if m != nil {
+		return m.Deprecated
+	}
+	return false
+}
+
+func (m *TbSpecInfo) GetEvaluationTotalScore() float32 {
+	if m != nil {
+		return m.EvaluationTotalScore
+	}
+	return 0
+}
+
+type SpecEvaluationScore struct {
+	Metric               string   `protobuf:"bytes,1,opt,name=metric,proto3" json:"metric" yaml:"metric"`
+	Value                float32  `protobuf:"fixed32,2,opt,name=value,proto3" json:"value" yaml:"value"`
+	Score                float32  `protobuf:"fixed32,3,opt,name=score,proto3" json:"score" yaml:"score"`
+	Weight               float32  `protobuf:"fixed32,4,opt,name=weight,proto3" json:"weight" yaml:"weight"`
+	WeightedScore        float32  `protobuf:"fixed32,5,opt,name=weighted_score,json=weightedScore,proto3" json:"weightedScore" yaml:"weightedScore"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SpecEvaluationScore) Reset()         { *m = SpecEvaluationScore{} }
+func (m *SpecEvaluationScore) String() string { return proto.CompactTextString(m) }
+func (*SpecEvaluationScore) ProtoMessage()    {}
+func (*SpecEvaluationScore) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d7122b45d641d698, []int{41}
+}
+func (m *SpecEvaluationScore) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpecEvaluationScore) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpecEvaluationScore.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SpecEvaluationScore) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpecEvaluationScore.Merge(m, src)
+}
+func (m *SpecEvaluationScore) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpecEvaluationScore) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpecEvaluationScore.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpecEvaluationScore proto.InternalMessageInfo
+
+func (m *SpecEvaluationScore) GetMetric() string {
+	if m != nil {
+		return m.Metric
+	}
+	return ""
+}
+
+func (m *SpecEvaluationScore) GetValue() float32 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+func (m *SpecEvaluationScore) GetScore() float32 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
+}
+
+func (m *SpecEvaluationScore) GetWeight() float32 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
+func (m *SpecEvaluationScore) GetWeightedScore() float32 {
+	if m != nil {
+		return m.WeightedScore
+	}
+	return 0
+}
+
 type TbSpecCreateRequest struct {
 	NsId                 string     `protobuf:"bytes,1,opt,name=ns_id,json=nsId,proto3" json:"nsId" yaml:"nsId"`
 	Item                 *TbSpecReq `protobuf:"bytes,2,opt,name=item,json=spec,proto3" json:"spec" yaml:"spec"`
@@ -2656,7 +2879,7 @@ func (m *TbSpecCreateRequest) Reset()         { *m = TbSpecCreateRequest{} }
 func (m *TbSpecCreateRequest) String() string { return proto.CompactTextString(m) }
 func (*TbSpecCreateRequest) ProtoMessage()    {}
 func (*TbSpecCreateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{41}
+	return fileDescriptor_d7122b45d641d698, []int{42}
 }
 func (m *TbSpecCreateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2713,7 +2936,7 @@ func (m *TbSpecReq) Reset()         { *m = TbSpecReq{} }
 func (m *TbSpecReq) String() string { return proto.CompactTextString(m) }
 func (*TbSpecReq) ProtoMessage()    {}
 func (*TbSpecReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{42}
+	return fileDescriptor_d7122b45d641d698, []int{43}
 }
 func (m *TbSpecReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2782,7 +3005,7 @@ func (m *FetchSpecQryRequest) Reset()         { *m = FetchSpecQryRequest{} }
 func (m *FetchSpecQryRequest) String() string { return proto.CompactTextString(m) }
 func (*FetchSpecQryRequest) ProtoMessage()    {}
 func (*FetchSpecQryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{43}
+	return fileDescriptor_d7122b45d641d698, []int{44}
 }
 func (m *FetchSpecQryRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2836,7 +3059,7 @@ func (m *SpiderSpecInfoResponse) Reset()         { *m = SpiderSpecInfoResponse{}
 func (m *SpiderSpecInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*SpiderSpecInfoResponse) ProtoMessage()    {}
 func (*SpiderSpecInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{44}
+	return fileDescriptor_d7122b45d641d698, []int{45}
 }
 func (m *SpiderSpecInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2883,7 +3106,7 @@ func (m *ListSpiderSpecInfoResponse) Reset()         { *m = ListSpiderSpecInfoRe
 func (m *ListSpiderSpecInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*ListSpiderSpecInfoResponse) ProtoMessage()    {}
 func (*ListSpiderSpecInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{45}
+	return fileDescriptor_d7122b45d641d698, []int{46}
 }
 func (m *ListSpiderSpecInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2935,7 +3158,7 @@ func (m *SpiderSpecInfo) Reset()         { *m = SpiderSpecInfo{} }
 func (m *SpiderSpecInfo) String() string { return proto.CompactTextString(m) }
 func (*SpiderSpecInfo) ProtoMessage()    {}
 func (*SpiderSpecInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{46}
+	return fileDescriptor_d7122b45d641d698, []int{47}
 }
 func (m *SpiderSpecInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3018,7 +3241,7 @@ func (m *SpiderVCpuInfo) Reset()         { *m = SpiderVCpuInfo{} }
 func (m *SpiderVCpuInfo) String() string { return proto.CompactTextString(m) }
 func (*SpiderVCpuInfo) ProtoMessage()    {}
 func (*SpiderVCpuInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{47}
+	return fileDescriptor_d7122b45d641d698, []int{48}
 }
 func (m *SpiderVCpuInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3075,7 +3298,7 @@ func (m *SpiderGpuInfo) Reset()         { *m = SpiderGpuInfo{} }
 func (m *SpiderGpuInfo) String() string { return proto.CompactTextString(m) }
 func (*SpiderGpuInfo) ProtoMessage()    {}
 func (*SpiderGpuInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{48}
+	return fileDescriptor_d7122b45d641d698, []int{49}
 }
 func (m *SpiderGpuInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3143,7 +3366,7 @@ func (m *LookupSpecListQryRequest) Reset()         { *m = LookupSpecListQryReque
 func (m *LookupSpecListQryRequest) String() string { return proto.CompactTextString(m) }
 func (*LookupSpecListQryRequest) ProtoMessage()    {}
 func (*LookupSpecListQryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{49}
+	return fileDescriptor_d7122b45d641d698, []int{50}
 }
 func (m *LookupSpecListQryRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3191,7 +3414,7 @@ func (m *LookupSpecQryRequest) Reset()         { *m = LookupSpecQryRequest{} }
 func (m *LookupSpecQryRequest) String() string { return proto.CompactTextString(m) }
 func (*LookupSpecQryRequest) ProtoMessage()    {}
 func (*LookupSpecQryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{50}
+	return fileDescriptor_d7122b45d641d698, []int{51}
 }
 func (m *LookupSpecQryRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3246,7 +3469,7 @@ func (m *FilterSpecsByRangeRequest) Reset()         { *m = FilterSpecsByRangeReq
 func (m *FilterSpecsByRangeRequest) String() string { return proto.CompactTextString(m) }
 func (*FilterSpecsByRangeRequest) ProtoMessage()    {}
 func (*FilterSpecsByRangeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{51}
+	return fileDescriptor_d7122b45d641d698, []int{52}
 }
 func (m *FilterSpecsByRangeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3289,6 +3512,337 @@ func (m *FilterSpecsByRangeRequest) GetFilter() *SpecRangeFilter {
 	return nil
 }
 
+type SpecSearchRequest struct {
+	NsId                 string         `protobuf:"bytes,1,opt,name=ns_id,json=nsId,proto3" json:"nsId" yaml:"nsId"`
+	Item                 *SpecSearchReq `protobuf:"bytes,2,opt,name=item,json=search,proto3" json:"search" yaml:"search"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *SpecSearchRequest) Reset()         { *m = SpecSearchRequest{} }
+func (m *SpecSearchRequest) String() string { return proto.CompactTextString(m) }
+func (*SpecSearchRequest) ProtoMessage()    {}
+func (*SpecSearchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d7122b45d641d698, []int{53}
+}
+func (m *SpecSearchRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpecSearchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpecSearchRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SpecSearchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpecSearchRequest.Merge(m, src)
+}
+func (m *SpecSearchRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpecSearchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpecSearchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpecSearchRequest proto.InternalMessageInfo
+
+func (m *SpecSearchRequest) GetNsId() string {
+	if m != nil {
+		return m.NsId
+	}
+	return ""
+}
+
+func (m *SpecSearchRequest) GetItem() *SpecSearchReq {
+	if m != nil {
+		return m.Item
+	}
+	return nil
+}
+
+type SpecSearchReq struct {
+	Condition            []*SpecSearchCondition `protobuf:"bytes,1,rep,name=condition,proto3" json:"condition" yaml:"condition"`
+	OrderBy              []*SpecSearchOrder     `protobuf:"bytes,2,rep,name=order_by,json=orderBy,proto3" json:"orderBy" yaml:"orderBy"`
+	Limit                int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit" yaml:"limit"`
+	Offset               int32                  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset" yaml:"offset"`
+	Cursor               string                 `protobuf:"bytes,5,opt,name=cursor,proto3" json:"cursor" yaml:"cursor"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *SpecSearchReq) Reset()         { *m = SpecSearchReq{} }
+func (m *SpecSearchReq) String() string { return proto.CompactTextString(m) }
+func (*SpecSearchReq) ProtoMessage()    {}
+func (*SpecSearchReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d7122b45d641d698, []int{54}
+}
+func (m *SpecSearchReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpecSearchReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpecSearchReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SpecSearchReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpecSearchReq.Merge(m, src)
+}
+func (m *SpecSearchReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpecSearchReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpecSearchReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpecSearchReq proto.InternalMessageInfo
+
+func (m *SpecSearchReq) GetCondition() []*SpecSearchCondition {
+	if m != nil {
+		return m.Condition
+	}
+	return nil
+}
+
+func (m *SpecSearchReq) GetOrderBy() []*SpecSearchOrder {
+	if m != nil {
+		return m.OrderBy
+	}
+	return nil
+}
+
+func (m *SpecSearchReq) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *SpecSearchReq) GetOffset() int32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *SpecSearchReq) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+type SpecSearchCondition struct {
+	Field                string   `protobuf:"bytes,1,opt,name=field,proto3" json:"field" yaml:"field"`
+	Operator             string   `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator" yaml:"operator"`
+	Value                []string `protobuf:"bytes,3,rep,name=value,proto3" json:"value" yaml:"value"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SpecSearchCondition) Reset()         { *m = SpecSearchCondition{} }
+func (m *SpecSearchCondition) String() string { return proto.CompactTextString(m) }
+func (*SpecSearchCondition) ProtoMessage()    {}
+func (*SpecSearchCondition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d7122b45d641d698, []int{55}
+}
+func (m *SpecSearchCondition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpecSearchCondition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpecSearchCondition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SpecSearchCondition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpecSearchCondition.Merge(m, src)
+}
+func (m *SpecSearchCondition) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpecSearchCondition) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpecSearchCondition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpecSearchCondition proto.InternalMessageInfo
+
+func (m *SpecSearchCondition) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *SpecSearchCondition) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+func (m *SpecSearchCondition) GetValue() []string {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+type SpecSearchOrder struct {
+	Field                string   `protobuf:"bytes,1,opt,name=field,proto3" json:"field" yaml:"field"`
+	Direction            string   `protobuf:"bytes,2,opt,name=direction,proto3" json:"direction" yaml:"direction"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SpecSearchOrder) Reset()         { *m = SpecSearchOrder{} }
+func (m *SpecSearchOrder) String() string { return proto.CompactTextString(m) }
+func (*SpecSearchOrder) ProtoMessage()    {}
+func (*SpecSearchOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d7122b45d641d698, []int{56}
+}
+func (m *SpecSearchOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpecSearchOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpecSearchOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SpecSearchOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpecSearchOrder.Merge(m, src)
+}
+func (m *SpecSearchOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpecSearchOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpecSearchOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpecSearchOrder proto.InternalMessageInfo
+
+func (m *SpecSearchOrder) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *SpecSearchOrder) GetDirection() string {
+	if m != nil {
+		return m.Direction
+	}
+	return ""
+}
+
+type SpecSearchResponse struct {
+	Spec                 []*TbSpecInfo `protobuf:"bytes,1,rep,name=spec,proto3" json:"spec" yaml:"spec"`
+	TotalCount           int64         `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"totalCount" yaml:"totalCount"`
+	Limit                int32         `protobuf:"varint,3,opt,name=limit,proto3" json:"limit" yaml:"limit"`
+	Offset               int32         `protobuf:"varint,4,opt,name=offset,proto3" json:"offset" yaml:"offset"`
+	NextCursor           string        `protobuf:"bytes,5,opt,name=next_cursor,json=nextCursor,proto3" json:"nextCursor" yaml:"nextCursor"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *SpecSearchResponse) Reset()         { *m = SpecSearchResponse{} }
+func (m *SpecSearchResponse) String() string { return proto.CompactTextString(m) }
+func (*SpecSearchResponse) ProtoMessage()    {}
+func (*SpecSearchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d7122b45d641d698, []int{57}
+}
+func (m *SpecSearchResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpecSearchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpecSearchResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SpecSearchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpecSearchResponse.Merge(m, src)
+}
+func (m *SpecSearchResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpecSearchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpecSearchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpecSearchResponse proto.InternalMessageInfo
+
+func (m *SpecSearchResponse) GetSpec() []*TbSpecInfo {
+	if m != nil {
+		return m.Spec
+	}
+	return nil
+}
+
+func (m *SpecSearchResponse) GetTotalCount() int64 {
+	if m != nil {
+		return m.TotalCount
+	}
+	return 0
+}
+
+func (m *SpecSearchResponse) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *SpecSearchResponse) GetOffset() int32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *SpecSearchResponse) GetNextCursor() string {
+	if m != nil {
+		return m.NextCursor
+	}
+	return ""
+}
+
 type SpecRangeFilter struct {
 	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id" yaml:"id"`
 	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name" yaml:"name"`
@@ -3330,7 +3884,7 @@ func (m *SpecRangeFilter) Reset()         { *m = SpecRangeFilter{} }
 func (m *SpecRangeFilter) String() string { return proto.CompactTextString(m) }
 func (*SpecRangeFilter) ProtoMessage()    {}
 func (*SpecRangeFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{52}
+	return fileDescriptor_d7122b45d641d698, []int{58}
 }
 func (m *SpecRangeFilter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3588,7 +4142,7 @@ func (m *Range) Reset()         { *m = Range{} }
 func (m *Range) String() string { return proto.CompactTextString(m) }
 func (*Range) ProtoMessage()    {}
 func (*Range) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{53}
+	return fileDescriptor_d7122b45d641d698, []int{59}
 }
 func (m *Range) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3644,7 +4198,7 @@ func (m *SortSpecsRequest) Reset()         { *m = SortSpecsRequest{} }
 func (m *SortSpecsRequest) String() string { return proto.CompactTextString(m) }
 func (*SortSpecsRequest) ProtoMessage()    {}
 func (*SortSpecsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{54}
+	return fileDescriptor_d7122b45d641d698, []int{60}
 }
 func (m *SortSpecsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3705,7 +4259,7 @@ func (m *TbSecurityGroupInfoResponse) Reset()         { *m = TbSecurityGroupInfo
 func (m *TbSecurityGroupInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*TbSecurityGroupInfoResponse) ProtoMessage()    {}
 func (*TbSecurityGroupInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{55}
+	return fileDescriptor_d7122b45d641d698, []int{61}
 }
 func (m *TbSecurityGroupInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3752,7 +4306,7 @@ func (m *ListTbSecurityGroupInfoResponse) Reset()         { *m = ListTbSecurityG
 func (m *ListTbSecurityGroupInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*ListTbSecurityGroupInfoResponse) ProtoMessage()    {}
 func (*ListTbSecurityGroupInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{56}
+	return fileDescriptor_d7122b45d641d698, []int{62}
 }
 func (m *ListTbSecurityGroupInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3809,7 +4363,7 @@ func (m *TbSecurityGroupInfo) Reset()         { *m = TbSecurityGroupInfo{} }
 func (m *TbSecurityGroupInfo) String() string { return proto.CompactTextString(m) }
 func (*TbSecurityGroupInfo) ProtoMessage()    {}
 func (*TbSecurityGroupInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{57}
+	return fileDescriptor_d7122b45d641d698, []int{63}
 }
 func (m *TbSecurityGroupInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3930,7 +4484,7 @@ func (m *SpiderSecurityRuleInfo) Reset()         { *m = SpiderSecurityRuleInfo{}
 func (m *SpiderSecurityRuleInfo) String() string { return proto.CompactTextString(m) }
 func (*SpiderSecurityRuleInfo) ProtoMessage()    {}
 func (*SpiderSecurityRuleInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{58}
+	return fileDescriptor_d7122b45d641d698, []int{64}
 }
 func (m *SpiderSecurityRuleInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4006,7 +4560,7 @@ func (m *TbSecurityGroupCreateRequest) Reset()         { *m = TbSecurityGroupCre
 func (m *TbSecurityGroupCreateRequest) String() string { return proto.CompactTextString(m) }
 func (*TbSecurityGroupCreateRequest) ProtoMessage()    {}
 func (*TbSecurityGroupCreateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{59}
+	return fileDescriptor_d7122b45d641d698, []int{65}
 }
 func (m *TbSecurityGroupCreateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4064,7 +4618,7 @@ func (m *TbSecurityGroupReq) Reset()         { *m = TbSecurityGroupReq{} }
 func (m *TbSecurityGroupReq) String() string { return proto.CompactTextString(m) }
 func (*TbSecurityGroupReq) ProtoMessage()    {}
 func (*TbSecurityGroupReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{60}
+	return fileDescriptor_d7122b45d641d698, []int{66}
 }
 func (m *TbSecurityGroupReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4139,7 +4693,7 @@ func (m *TbVNetInfoResponse) Reset()         { *m = TbVNetInfoResponse{} }
 func (m *TbVNetInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*TbVNetInfoResponse) ProtoMessage()    {}
 func (*TbVNetInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{61}
+	return fileDescriptor_d7122b45d641d698, []int{67}
 }
 func (m *TbVNetInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4186,7 +4740,7 @@ func (m *ListTbVNetInfoResponse) Reset()         { *m = ListTbVNetInfoResponse{}
 func (m *ListTbVNetInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*ListTbVNetInfoResponse) ProtoMessage()    {}
 func (*ListTbVNetInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{62}
+	return fileDescriptor_d7122b45d641d698, []int{68}
 }
 func (m *ListTbVNetInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4244,7 +4798,7 @@ func (m *TbVNetInfo) Reset()         { *m = TbVNetInfo{} }
 func (m *TbVNetInfo) String() string { return proto.CompactTextString(m) }
 func (*TbVNetInfo) ProtoMessage()    {}
 func (*TbVNetInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{63}
+	return fileDescriptor_d7122b45d641d698, []int{69}
 }
 func (m *TbVNetInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4370,7 +4924,7 @@ func (m *SpiderSubnetInfo) Reset()         { *m = SpiderSubnetInfo{} }
 func (m *SpiderSubnetInfo) String() string { return proto.CompactTextString(m) }
 func (*SpiderSubnetInfo) ProtoMessage()    {}
 func (*SpiderSubnetInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{64}
+	return fileDescriptor_d7122b45d641d698, []int{70}
 }
 func (m *SpiderSubnetInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4432,7 +4986,7 @@ func (m *TbVNetCreateRequest) Reset()         { *m = TbVNetCreateRequest{} }
 func (m *TbVNetCreateRequest) String() string { return proto.CompactTextString(m) }
 func (*TbVNetCreateRequest) ProtoMessage()    {}
 func (*TbVNetCreateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{65}
+	return fileDescriptor_d7122b45d641d698, []int{71}
 }
 func (m *TbVNetCreateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4490,7 +5044,7 @@ func (m *TbVNetReq) Reset()         { *m = TbVNetReq{} }
 func (m *TbVNetReq) String() string { return proto.CompactTextString(m) }
 func (*TbVNetReq) ProtoMessage()    {}
 func (*TbVNetReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{66}
+	return fileDescriptor_d7122b45d641d698, []int{72}
 }
 func (m *TbVNetReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4567,7 +5121,7 @@ func (m *SpiderSubnetReqInfo) Reset()         { *m = SpiderSubnetReqInfo{} }
 func (m *SpiderSubnetReqInfo) String() string { return proto.CompactTextString(m) }
 func (*SpiderSubnetReqInfo) ProtoMessage()    {}
 func (*SpiderSubnetReqInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{67}
+	return fileDescriptor_d7122b45d641d698, []int{73}
 }
 func (m *SpiderSubnetReqInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4628,7 +5182,7 @@ func (m *TbMcisInfoResponse) Reset()         { *m = TbMcisInfoResponse{} }
 func (m *TbMcisInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*TbMcisInfoResponse) ProtoMessage()    {}
 func (*TbMcisInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{68}
+	return fileDescriptor_d7122b45d641d698, []int{74}
 }
 func (m *TbMcisInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4675,7 +5229,7 @@ func (m *ListTbMcisInfoResponse) Reset()         { *m = ListTbMcisInfoResponse{}
 func (m *ListTbMcisInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*ListTbMcisInfoResponse) ProtoMessage()    {}
 func (*ListTbMcisInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{69}
+	return fileDescriptor_d7122b45d641d698, []int{75}
 }
 func (m *ListTbMcisInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4731,7 +5285,7 @@ func (m *TbMcisInfo) Reset()         { *m = TbMcisInfo{} }
 func (m *TbMcisInfo) String() string { return proto.CompactTextString(m) }
 func (*TbMcisInfo) ProtoMessage()    {}
 func (*TbMcisInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{70}
+	return fileDescriptor_d7122b45d641d698, []int{76}
 }
 func (m *TbMcisInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4861,6 +5415,9 @@ type TbVmInfo struct {
 	VmUserAccount        string        `protobuf:"bytes,28,opt,name=vm_user_account,json=vmUserAccount,proto3" json:"vmUserAccount" yaml:"vmUserAccount"`
 	VmUserPassword       string        `protobuf:"bytes,29,opt,name=vm_user_password,json=vmUserPassword,proto3" json:"vmUserPassword" yaml:"vmUserPassword"`
 	CspViewVmDetail      *SpiderVMInfo `protobuf:"bytes,30,opt,name=csp_view_vm_detail,json=cspViewVmDetail,proto3" json:"cspViewVmDetail" yaml:"cspViewVmDetail"`
+	UserData             string        `protobuf:"bytes,31,opt,name=user_data,json=userData,proto3" json:"userData" yaml:"userData"`
+	PostCommand          *McisCmdReq   `protobuf:"bytes,32,opt,name=post_command,json=postCommand,proto3" json:"postCommand" yaml:"postCommand"`
+	UserDataEncoding     string        `protobuf:"bytes,33,opt,name=user_data_encoding,json=userDataEncoding,proto3" json:"userDataEncoding" yaml:"userDataEncoding"`
 	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
 	XXX_unrecognized     []byte        `json:"-"`
 	XXX_sizecache        int32         `json:"-"`
@@ -4870,7 +5427,7 @@ func (m *TbVmInfo) Reset()         { *m = TbVmInfo{} }
 func (m *TbVmInfo) String() string { return proto.CompactTextString(m) }
 func (*TbVmInfo) ProtoMessage()    {}
 func (*TbVmInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{71}
+	return fileDescriptor_d7122b45d641d698, []int{77}
 }
 func (m *TbVmInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5109,6 +5666,27 @@ func (m *TbVmInfo) GetCspViewVmDetail() *SpiderVMInfo {
 	return nil
 }
 
+func (m *TbVmInfo) GetUserData() string {
+	if m != nil {
+		return m.UserData
+	}
+	return ""
+}
+
+func (m *TbVmInfo) GetPostCommand() *McisCmdReq {
+	if m != nil {
+		return m.PostCommand
+	}
+	return nil
+}
+
+func (m *TbVmInfo) GetUserDataEncoding() string {
+	if m != nil {
+		return m.UserDataEncoding
+	}
+	return ""
+}
+
 type GeoLocation struct {
 	Latitude             string   `protobuf:"bytes,1,opt,name=latitude,proto3" json:"latitude" yaml:"latitude"`
 	Longitude            string   `protobuf:"bytes,2,opt,name=longitude,proto3" json:"longitude" yaml:"longitude"`
@@ -5124,7 +5702,7 @@ func (m *GeoLocation) Reset()         { *m = GeoLocation{} }
 func (m *GeoLocation) String() string { return proto.CompactTextString(m) }
 func (*GeoLocation) ProtoMessage()    {}
 func (*GeoLocation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{72}
+	return fileDescriptor_d7122b45d641d698, []int{78}
 }
 func (m *GeoLocation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5200,7 +5778,7 @@ func (m *RegionInfo) Reset()         { *m = RegionInfo{} }
 func (m *RegionInfo) String() string { return proto.CompactTextString(m) }
 func (*RegionInfo) ProtoMessage()    {}
 func (*RegionInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{73}
+	return fileDescriptor_d7122b45d641d698, []int{79}
 }
 func (m *RegionInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5273,6 +5851,7 @@ type SpiderVMInfo struct {
 	VmBlockDisk          string      `protobuf:"bytes,24,opt,name=vm_block_disk,json=VMBlockDisk,proto3" json:"VMBlockDisk" yaml:"VMBlockDisk"`
 	SshAccessPoint       string      `protobuf:"bytes,25,opt,name=ssh_access_point,json=SSHAccessPoint,proto3" json:"SSHAccessPoint" yaml:"SSHAccessPoint"`
 	KeyValueList         []*KeyValue `protobuf:"bytes,26,rep,name=key_value_list,json=KeyValueList,proto3" json:"KeyValueList" yaml:"KeyValueList"`
+	UserData             string      `protobuf:"bytes,27,opt,name=user_data,json=UserData,proto3" json:"UserData" yaml:"UserData"`
 	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
 	XXX_unrecognized     []byte      `json:"-"`
 	XXX_sizecache        int32       `json:"-"`
@@ -5282,7 +5861,7 @@ func (m *SpiderVMInfo) Reset()         { *m = SpiderVMInfo{} }
 func (m *SpiderVMInfo) String() string { return proto.CompactTextString(m) }
 func (*SpiderVMInfo) ProtoMessage()    {}
 func (*SpiderVMInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{74}
+	return fileDescriptor_d7122b45d641d698, []int{80}
 }
 func (m *SpiderVMInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5493,6 +6072,13 @@ func (m *SpiderVMInfo) GetKeyValueList() []*KeyValue {
 	return nil
 }
 
+func (m *SpiderVMInfo) GetUserData() string {
+	if m != nil {
+		return m.UserData
+	}
+	return ""
+}
+
 type TbMcisCreateRequest struct {
 	NsId                 string     `protobuf:"bytes,1,opt,name=ns_id,json=nsId,proto3" json:"nsId" yaml:"nsId"`
 	Item                 *TbMcisReq `protobuf:"bytes,2,opt,name=item,json=mcis,proto3" json:"mcis" yaml:"mcis"`
@@ -5505,7 +6091,7 @@ func (m *TbMcisCreateRequest) Reset()         { *m = TbMcisCreateRequest{} }
 func (m *TbMcisCreateRequest) String() string { return proto.CompactTextString(m) }
 func (*TbMcisCreateRequest) ProtoMessage()    {}
 func (*TbMcisCreateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{75}
+	return fileDescriptor_d7122b45d641d698, []int{81}
 }
 func (m *TbMcisCreateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5564,7 +6150,7 @@ func (m *TbMcisReq) Reset()         { *m = TbMcisReq{} }
 func (m *TbMcisReq) String() string { return proto.CompactTextString(m) }
 func (*TbMcisReq) ProtoMessage()    {}
 func (*TbMcisReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{76}
+	return fileDescriptor_d7122b45d641d698, []int{82}
 }
 func (m *TbMcisReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5649,6 +6235,8 @@ type TbVmReq struct {
 	SshKeyId             string   `protobuf:"bytes,11,opt,name=ssh_key_id,json=sshKeyId,proto3" json:"sshKeyId" yaml:"sshKeyId"`
 	VmUserAccount        string   `protobuf:"bytes,12,opt,name=vm_user_account,json=vmUserAccount,proto3" json:"vmUserAccount" yaml:"vmUserAccount"`
 	VmUserPassword       string   `protobuf:"bytes,13,opt,name=vm_user_password,json=vmUserPassword,proto3" json:"vmUserPassword" yaml:"vmUserPassword"`
+	UserData             string   `protobuf:"bytes,14,opt,name=user_data,json=userData,proto3" json:"userData" yaml:"userData"`
+	UserDataEncoding     string   `protobuf:"bytes,15,opt,name=user_data_encoding,json=userDataEncoding,proto3" json:"userDataEncoding" yaml:"userDataEncoding"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -5658,7 +6246,7 @@ func (m *TbVmReq) Reset()         { *m = TbVmReq{} }
 func (m *TbVmReq) String() string { return proto.CompactTextString(m) }
 func (*TbVmReq) ProtoMessage()    {}
 func (*TbVmReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{77}
+	return fileDescriptor_d7122b45d641d698, []int{83}
 }
 func (m *TbVmReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5778,6 +6366,20 @@ func (m *TbVmReq) GetVmUserPassword() string {
 	return ""
 }
 
+func (m *TbVmReq) GetUserData() string {
+	if m != nil {
+		return m.UserData
+	}
+	return ""
+}
+
+func (m *TbVmReq) GetUserDataEncoding() string {
+	if m != nil {
+		return m.UserDataEncoding
+	}
+	return ""
+}
+
 type ListTbMcisStatusInfoResponse struct {
 	Items                []*McisStatusInfo `protobuf:"bytes,1,rep,name=items,json=mcis,proto3" json:"mcis" yaml:"mcis"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
@@ -5789,7 +6391,7 @@ func (m *ListTbMcisStatusInfoResponse) Reset()         { *m = ListTbMcisStatusIn
 func (m *ListTbMcisStatusInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*ListTbMcisStatusInfoResponse) ProtoMessage()    {}
 func (*ListTbMcisStatusInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{78}
+	return fileDescriptor_d7122b45d641d698, []int{84}
 }
 func (m *ListTbMcisStatusInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5836,7 +6438,7 @@ func (m *TbMcisStatusInfoResponse) Reset()         { *m = TbMcisStatusInfoRespon
 func (m *TbMcisStatusInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*TbMcisStatusInfoResponse) ProtoMessage()    {}
 func (*TbMcisStatusInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{79}
+	return fileDescriptor_d7122b45d641d698, []int{85}
 }
 func (m *TbMcisStatusInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5892,7 +6494,7 @@ func (m *McisStatusInfo) Reset()         { *m = McisStatusInfo{} }
 func (m *McisStatusInfo) String() string { return proto.CompactTextString(m) }
 func (*McisStatusInfo) ProtoMessage()    {}
 func (*McisStatusInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{80}
+	return fileDescriptor_d7122b45d641d698, []int{86}
 }
 func (m *McisStatusInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6015,7 +6617,7 @@ func (m *TbVmStatusInfo) Reset()         { *m = TbVmStatusInfo{} }
 func (m *TbVmStatusInfo) String() string { return proto.CompactTextString(m) }
 func (*TbVmStatusInfo) ProtoMessage()    {}
 func (*TbVmStatusInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{81}
+	return fileDescriptor_d7122b45d641d698, []int{87}
 }
 func (m *TbVmStatusInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6153,7 +6755,7 @@ func (m *TbMcisAllQryRequest) Reset()         { *m = TbMcisAllQryRequest{} }
 func (m *TbMcisAllQryRequest) String() string { return proto.CompactTextString(m) }
 func (*TbMcisAllQryRequest) ProtoMessage()    {}
 func (*TbMcisAllQryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{82}
+	return fileDescriptor_d7122b45d641d698, []int{88}
 }
 func (m *TbMcisAllQryRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6202,7 +6804,7 @@ func (m *TbMcisActionRequest) Reset()         { *m = TbMcisActionRequest{} }
 func (m *TbMcisActionRequest) String() string { return proto.CompactTextString(m) }
 func (*TbMcisActionRequest) ProtoMessage()    {}
 func (*TbMcisActionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{83}
+	return fileDescriptor_d7122b45d641d698, []int{89}
 }
 func (m *TbMcisActionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6252,6 +6854,354 @@ func (m *TbMcisActionRequest) GetAction() string {
 	return ""
 }
 
+type CostReportQryRequest struct {
+	NsId                 string   `protobuf:"bytes,1,opt,name=ns_id,json=nsId,proto3" json:"nsId" yaml:"nsId"`
+	McisId               string   `protobuf:"bytes,2,opt,name=mcis_id,json=mcisId,proto3" json:"mcisId" yaml:"mcisId"`
+	From                 string   `protobuf:"bytes,3,opt,name=from,proto3" json:"from" yaml:"from"`
+	To                   string   `protobuf:"bytes,4,opt,name=to,proto3" json:"to" yaml:"to"`
+	GroupBy              string   `protobuf:"bytes,5,opt,name=group_by,json=groupBy,proto3" json:"groupBy" yaml:"groupBy"`
+	Format               string   `protobuf:"bytes,6,opt,name=format,proto3" json:"format" yaml:"format"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CostReportQryRequest) Reset()         { *m = CostReportQryRequest{} }
+func (m *CostReportQryRequest) String() string { return proto.CompactTextString(m) }
+func (*CostReportQryRequest) ProtoMessage()    {}
+func (*CostReportQryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d7122b45d641d698, []int{90}
+}
+func (m *CostReportQryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CostReportQryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CostReportQryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CostReportQryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CostReportQryRequest.Merge(m, src)
+}
+func (m *CostReportQryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CostReportQryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CostReportQryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CostReportQryRequest proto.InternalMessageInfo
+
+func (m *CostReportQryRequest) GetNsId() string {
+	if m != nil {
+		return m.NsId
+	}
+	return ""
+}
+
+func (m *CostReportQryRequest) GetMcisId() string {
+	if m != nil {
+		return m.McisId
+	}
+	return ""
+}
+
+func (m *CostReportQryRequest) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *CostReportQryRequest) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *CostReportQryRequest) GetGroupBy() string {
+	if m != nil {
+		return m.GroupBy
+	}
+	return ""
+}
+
+func (m *CostReportQryRequest) GetFormat() string {
+	if m != nil {
+		return m.Format
+	}
+	return ""
+}
+
+type CostReportResponse struct {
+	Item                 *TbCostReport `protobuf:"bytes,1,opt,name=item,json=report,proto3" json:"report" yaml:"report"`
+	Csv                  string        `protobuf:"bytes,2,opt,name=csv,proto3" json:"csv" yaml:"csv"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *CostReportResponse) Reset()         { *m = CostReportResponse{} }
+func (m *CostReportResponse) String() string { return proto.CompactTextString(m) }
+func (*CostReportResponse) ProtoMessage()    {}
+func (*CostReportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d7122b45d641d698, []int{91}
+}
+func (m *CostReportResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CostReportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CostReportResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CostReportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CostReportResponse.Merge(m, src)
+}
+func (m *CostReportResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CostReportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CostReportResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CostReportResponse proto.InternalMessageInfo
+
+func (m *CostReportResponse) GetItem() *TbCostReport {
+	if m != nil {
+		return m.Item
+	}
+	return nil
+}
+
+func (m *CostReportResponse) GetCsv() string {
+	if m != nil {
+		return m.Csv
+	}
+	return ""
+}
+
+type TbCostReport struct {
+	NsId                      string              `protobuf:"bytes,1,opt,name=ns_id,json=nsId,proto3" json:"nsId" yaml:"nsId"`
+	McisId                    string              `protobuf:"bytes,2,opt,name=mcis_id,json=mcisId,proto3" json:"mcisId" yaml:"mcisId"`
+	From                      string              `protobuf:"bytes,3,opt,name=from,proto3" json:"from" yaml:"from"`
+	To                        string              `protobuf:"bytes,4,opt,name=to,proto3" json:"to" yaml:"to"`
+	GroupBy                   string              `protobuf:"bytes,5,opt,name=group_by,json=groupBy,proto3" json:"groupBy" yaml:"groupBy"`
+	Item                      []*TbCostReportItem `protobuf:"bytes,6,rep,name=item,proto3" json:"item" yaml:"item"`
+	TotalCost                 float64             `protobuf:"fixed64,7,opt,name=total_cost,json=totalCost,proto3" json:"totalCost" yaml:"totalCost"`
+	TotalCurrentCostPerHour   float64             `protobuf:"fixed64,8,opt,name=total_current_cost_per_hour,json=totalCurrentCostPerHour,proto3" json:"totalCurrentCostPerHour" yaml:"totalCurrentCostPerHour"`
+	TotalProjectedMonthlyCost float64             `protobuf:"fixed64,9,opt,name=total_projected_monthly_cost,json=totalProjectedMonthlyCost,proto3" json:"totalProjectedMonthlyCost" yaml:"totalProjectedMonthlyCost"`
+	XXX_NoUnkeyedLiteral      struct{}            `json:"-"`
+	XXX_unrecognized          []byte              `json:"-"`
+	XXX_sizecache             int32               `json:"-"`
+}
+
+func (m *TbCostReport) Reset()         { *m = TbCostReport{} }
+func (m *TbCostReport) String() string { return proto.CompactTextString(m) }
+func (*TbCostReport) ProtoMessage()    {}
+func (*TbCostReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d7122b45d641d698, []int{92}
+}
+func (m *TbCostReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TbCostReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TbCostReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TbCostReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TbCostReport.Merge(m, src)
+}
+func (m *TbCostReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *TbCostReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_TbCostReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TbCostReport proto.InternalMessageInfo
+
+func (m *TbCostReport) GetNsId() string {
+	if m != nil {
+		return m.NsId
+	}
+	return ""
+}
+
+func (m *TbCostReport) GetMcisId() string {
+	if m != nil {
+		return m.McisId
+	}
+	return ""
+}
+
+func (m *TbCostReport) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *TbCostReport) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *TbCostReport) GetGroupBy() string {
+	if m != nil {
+		return m.GroupBy
+	}
+	return ""
+}
+
+func (m *TbCostReport) GetItem() []*TbCostReportItem {
+	if m != nil {
+		return m.Item
+	}
+	return nil
+}
+
+func (m *TbCostReport) GetTotalCost() float64 {
+	if m != nil {
+		return m.TotalCost
+	}
+	return 0
+}
+
+func (m *TbCostReport) GetTotalCurrentCostPerHour() float64 {
+	if m != nil {
+		return m.TotalCurrentCostPerHour
+	}
+	return 0
+}
+
+func (m *TbCostReport) GetTotalProjectedMonthlyCost() float64 {
+	if m != nil {
+		return m.TotalProjectedMonthlyCost
+	}
+	return 0
+}
+
+type TbCostReportItem struct {
+	Group                string   `protobuf:"bytes,1,opt,name=group,proto3" json:"group" yaml:"group"`
+	VmNum                int32    `protobuf:"varint,2,opt,name=vm_num,json=vmNum,proto3" json:"vmNum" yaml:"vmNum"`
+	RunningVmNum         int32    `protobuf:"varint,3,opt,name=running_vm_num,json=runningVmNum,proto3" json:"runningVmNum" yaml:"runningVmNum"`
+	RunningHours         float64  `protobuf:"fixed64,4,opt,name=running_hours,json=runningHours,proto3" json:"runningHours" yaml:"runningHours"`
+	Cost                 float64  `protobuf:"fixed64,5,opt,name=cost,proto3" json:"cost" yaml:"cost"`
+	CurrentCostPerHour   float64  `protobuf:"fixed64,6,opt,name=current_cost_per_hour,json=currentCostPerHour,proto3" json:"currentCostPerHour" yaml:"currentCostPerHour"`
+	ProjectedMonthlyCost float64  `protobuf:"fixed64,7,opt,name=projected_monthly_cost,json=projectedMonthlyCost,proto3" json:"projectedMonthlyCost" yaml:"projectedMonthlyCost"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TbCostReportItem) Reset()         { *m = TbCostReportItem{} }
+func (m *TbCostReportItem) String() string { return proto.CompactTextString(m) }
+func (*TbCostReportItem) ProtoMessage()    {}
+func (*TbCostReportItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d7122b45d641d698, []int{93}
+}
+func (m *TbCostReportItem) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TbCostReportItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TbCostReportItem.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TbCostReportItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TbCostReportItem.Merge(m, src)
+}
+func (m *TbCostReportItem) XXX_Size() int {
+	return m.Size()
+}
+func (m *TbCostReportItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_TbCostReportItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TbCostReportItem proto.InternalMessageInfo
+
+func (m *TbCostReportItem) GetGroup() string {
+	if m != nil {
+		return m.Group
+	}
+	return ""
+}
+
+func (m *TbCostReportItem) GetVmNum() int32 {
+	if m != nil {
+		return m.VmNum
+	}
+	return 0
+}
+
+func (m *TbCostReportItem) GetRunningVmNum() int32 {
+	if m != nil {
+		return m.RunningVmNum
+	}
+	return 0
+}
+
+func (m *TbCostReportItem) GetRunningHours() float64 {
+	if m != nil {
+		return m.RunningHours
+	}
+	return 0
+}
+
+func (m *TbCostReportItem) GetCost() float64 {
+	if m != nil {
+		return m.Cost
+	}
+	return 0
+}
+
+func (m *TbCostReportItem) GetCurrentCostPerHour() float64 {
+	if m != nil {
+		return m.CurrentCostPerHour
+	}
+	return 0
+}
+
+func (m *TbCostReportItem) GetProjectedMonthlyCost() float64 {
+	if m != nil {
+		return m.ProjectedMonthlyCost
+	}
+	return 0
+}
+
 type TbMcisQryRequest struct {
 	NsId                 string   `protobuf:"bytes,1,opt,name=ns_id,json=nsId,proto3" json:"nsId" yaml:"nsId"`
 	McisId               string   `protobuf:"bytes,2,opt,name=mcis_id,json=mcisId,proto3" json:"mcisId" yaml:"mcisId"`
@@ -6264,7 +7214,7 @@ func (m *TbMcisQryRequest) Reset()         { *m = TbMcisQryRequest{} }
 func (m *TbMcisQryRequest) String() string { return proto.CompactTextString(m) }
 func (*TbMcisQryRequest) ProtoMessage()    {}
 func (*TbMcisQryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{84}
+	return fileDescriptor_d7122b45d641d698, []int{94}
 }
 func (m *TbMcisQryRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6318,7 +7268,7 @@ func (m *TbVmInfoResponse) Reset()         { *m = TbVmInfoResponse{} }
 func (m *TbVmInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*TbVmInfoResponse) ProtoMessage()    {}
 func (*TbVmInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{85}
+	return fileDescriptor_d7122b45d641d698, []int{95}
 }
 func (m *TbVmInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6367,7 +7317,7 @@ func (m *TbVmCreateRequest) Reset()         { *m = TbVmCreateRequest{} }
 func (m *TbVmCreateRequest) String() string { return proto.CompactTextString(m) }
 func (*TbVmCreateRequest) ProtoMessage()    {}
 func (*TbVmCreateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{86}
+	return fileDescriptor_d7122b45d641d698, []int{96}
 }
 func (m *TbVmCreateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6430,7 +7380,7 @@ func (m *TbVmGroupCreateRequest) Reset()         { *m = TbVmGroupCreateRequest{}
 func (m *TbVmGroupCreateRequest) String() string { return proto.CompactTextString(m) }
 func (*TbVmGroupCreateRequest) ProtoMessage()    {}
 func (*TbVmGroupCreateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{87}
+	return fileDescriptor_d7122b45d641d698, []int{97}
 }
 func (m *TbVmGroupCreateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6491,7 +7441,7 @@ func (m *TbVmStatusInfoesponse) Reset()         { *m = TbVmStatusInfoesponse{} }
 func (m *TbVmStatusInfoesponse) String() string { return proto.CompactTextString(m) }
 func (*TbVmStatusInfoesponse) ProtoMessage()    {}
 func (*TbVmStatusInfoesponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{88}
+	return fileDescriptor_d7122b45d641d698, []int{98}
 }
 func (m *TbVmStatusInfoesponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6540,7 +7490,7 @@ func (m *TbVmQryRequest) Reset()         { *m = TbVmQryRequest{} }
 func (m *TbVmQryRequest) String() string { return proto.CompactTextString(m) }
 func (*TbVmQryRequest) ProtoMessage()    {}
 func (*TbVmQryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{89}
+	return fileDescriptor_d7122b45d641d698, []int{99}
 }
 func (m *TbVmQryRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6604,7 +7554,7 @@ func (m *TbVmActionRequest) Reset()         { *m = TbVmActionRequest{} }
 func (m *TbVmActionRequest) String() string { return proto.CompactTextString(m) }
 func (*TbVmActionRequest) ProtoMessage()    {}
 func (*TbVmActionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{90}
+	return fileDescriptor_d7122b45d641d698, []int{100}
 }
 func (m *TbVmActionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6672,7 +7622,7 @@ func (m *McisRecommendInfoResponse) Reset()         { *m = McisRecommendInfoResp
 func (m *McisRecommendInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*McisRecommendInfoResponse) ProtoMessage()    {}
 func (*McisRecommendInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{91}
+	return fileDescriptor_d7122b45d641d698, []int{101}
 }
 func (m *McisRecommendInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6721,7 +7671,7 @@ func (m *McisRecommendInfo) Reset()         { *m = McisRecommendInfo{} }
 func (m *McisRecommendInfo) String() string { return proto.CompactTextString(m) }
 func (*McisRecommendInfo) ProtoMessage()    {}
 func (*McisRecommendInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{92}
+	return fileDescriptor_d7122b45d641d698, []int{102}
 }
 func (m *McisRecommendInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6785,7 +7735,7 @@ func (m *TbVmRecommendInfo) Reset()         { *m = TbVmRecommendInfo{} }
 func (m *TbVmRecommendInfo) String() string { return proto.CompactTextString(m) }
 func (*TbVmRecommendInfo) ProtoMessage()    {}
 func (*TbVmRecommendInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{93}
+	return fileDescriptor_d7122b45d641d698, []int{103}
 }
 func (m *TbVmRecommendInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6854,7 +7804,7 @@ func (m *TbVmPriority) Reset()         { *m = TbVmPriority{} }
 func (m *TbVmPriority) String() string { return proto.CompactTextString(m) }
 func (*TbVmPriority) ProtoMessage()    {}
 func (*TbVmPriority) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{94}
+	return fileDescriptor_d7122b45d641d698, []int{104}
 }
 func (m *TbVmPriority) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6909,7 +7859,7 @@ func (m *McisRecommendCreateRequest) Reset()         { *m = McisRecommendCreateR
 func (m *McisRecommendCreateRequest) String() string { return proto.CompactTextString(m) }
 func (*McisRecommendCreateRequest) ProtoMessage()    {}
 func (*McisRecommendCreateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{95}
+	return fileDescriptor_d7122b45d641d698, []int{105}
 }
 func (m *McisRecommendCreateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6966,7 +7916,7 @@ func (m *McisRecommendReq) Reset()         { *m = McisRecommendReq{} }
 func (m *McisRecommendReq) String() string { return proto.CompactTextString(m) }
 func (*McisRecommendReq) ProtoMessage()    {}
 func (*McisRecommendReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{96}
+	return fileDescriptor_d7122b45d641d698, []int{106}
 }
 func (m *McisRecommendReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7040,7 +7990,7 @@ func (m *TbVmRecommendReq) Reset()         { *m = TbVmRecommendReq{} }
 func (m *TbVmRecommendReq) String() string { return proto.CompactTextString(m) }
 func (*TbVmRecommendReq) ProtoMessage()    {}
 func (*TbVmRecommendReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{97}
+	return fileDescriptor_d7122b45d641d698, []int{107}
 }
 func (m *TbVmRecommendReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7130,7 +8080,7 @@ func (m *McisRecommendVmCreateRequest) Reset()         { *m = McisRecommendVmCre
 func (m *McisRecommendVmCreateRequest) String() string { return proto.CompactTextString(m) }
 func (*McisRecommendVmCreateRequest) ProtoMessage()    {}
 func (*McisRecommendVmCreateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{98}
+	return fileDescriptor_d7122b45d641d698, []int{108}
 }
 func (m *McisRecommendVmCreateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7186,7 +8136,7 @@ func (m *DeploymentPlan) Reset()         { *m = DeploymentPlan{} }
 func (m *DeploymentPlan) String() string { return proto.CompactTextString(m) }
 func (*DeploymentPlan) ProtoMessage()    {}
 func (*DeploymentPlan) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{99}
+	return fileDescriptor_d7122b45d641d698, []int{109}
 }
 func (m *DeploymentPlan) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7247,7 +8197,7 @@ func (m *FilterInfo) Reset()         { *m = FilterInfo{} }
 func (m *FilterInfo) String() string { return proto.CompactTextString(m) }
 func (*FilterInfo) ProtoMessage()    {}
 func (*FilterInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{100}
+	return fileDescriptor_d7122b45d641d698, []int{110}
 }
 func (m *FilterInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7295,7 +8245,7 @@ func (m *FilterCondition) Reset()         { *m = FilterCondition{} }
 func (m *FilterCondition) String() string { return proto.CompactTextString(m) }
 func (*FilterCondition) ProtoMessage()    {}
 func (*FilterCondition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{101}
+	return fileDescriptor_d7122b45d641d698, []int{111}
 }
 func (m *FilterCondition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7350,7 +8300,7 @@ func (m *Operation) Reset()         { *m = Operation{} }
 func (m *Operation) String() string { return proto.CompactTextString(m) }
 func (*Operation) ProtoMessage()    {}
 func (*Operation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{102}
+	return fileDescriptor_d7122b45d641d698, []int{112}
 }
 func (m *Operation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7404,7 +8354,7 @@ func (m *PriorityInfo) Reset()         { *m = PriorityInfo{} }
 func (m *PriorityInfo) String() string { return proto.CompactTextString(m) }
 func (*PriorityInfo) ProtoMessage()    {}
 func (*PriorityInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{103}
+	return fileDescriptor_d7122b45d641d698, []int{113}
 }
 func (m *PriorityInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7453,7 +8403,7 @@ func (m *PriorityCondition) Reset()         { *m = PriorityCondition{} }
 func (m *PriorityCondition) String() string { return proto.CompactTextString(m) }
 func (*PriorityCondition) ProtoMessage()    {}
 func (*PriorityCondition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{104}
+	return fileDescriptor_d7122b45d641d698, []int{114}
 }
 func (m *PriorityCondition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7515,7 +8465,7 @@ func (m *ParameterKeyVal) Reset()         { *m = ParameterKeyVal{} }
 func (m *ParameterKeyVal) String() string { return proto.CompactTextString(m) }
 func (*ParameterKeyVal) ProtoMessage()    {}
 func (*ParameterKeyVal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{105}
+	return fileDescriptor_d7122b45d641d698, []int{115}
 }
 func (m *ParameterKeyVal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7569,7 +8519,7 @@ func (m *ListCmdMcisResponse) Reset()         { *m = ListCmdMcisResponse{} }
 func (m *ListCmdMcisResponse) String() string { return proto.CompactTextString(m) }
 func (*ListCmdMcisResponse) ProtoMessage()    {}
 func (*ListCmdMcisResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{106}
+	return fileDescriptor_d7122b45d641d698, []int{116}
 }
 func (m *ListCmdMcisResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7619,7 +8569,7 @@ func (m *CmdMcisResult) Reset()         { *m = CmdMcisResult{} }
 func (m *CmdMcisResult) String() string { return proto.CompactTextString(m) }
 func (*CmdMcisResult) ProtoMessage()    {}
 func (*CmdMcisResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{107}
+	return fileDescriptor_d7122b45d641d698, []int{117}
 }
 func (m *CmdMcisResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7689,7 +8639,7 @@ func (m *McisCmdCreateRequest) Reset()         { *m = McisCmdCreateRequest{} }
 func (m *McisCmdCreateRequest) String() string { return proto.CompactTextString(m) }
 func (*McisCmdCreateRequest) ProtoMessage()    {}
 func (*McisCmdCreateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{108}
+	return fileDescriptor_d7122b45d641d698, []int{118}
 }
 func (m *McisCmdCreateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7753,7 +8703,7 @@ func (m *McisCmdVmCreateRequest) Reset()         { *m = McisCmdVmCreateRequest{}
 func (m *McisCmdVmCreateRequest) String() string { return proto.CompactTextString(m) }
 func (*McisCmdVmCreateRequest) ProtoMessage()    {}
 func (*McisCmdVmCreateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{109}
+	return fileDescriptor_d7122b45d641d698, []int{119}
 }
 func (m *McisCmdVmCreateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7822,7 +8772,7 @@ func (m *McisCmdReq) Reset()         { *m = McisCmdReq{} }
 func (m *McisCmdReq) String() string { return proto.CompactTextString(m) }
 func (*McisCmdReq) ProtoMessage()    {}
 func (*McisCmdReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{110}
+	return fileDescriptor_d7122b45d641d698, []int{120}
 }
 func (m *McisCmdReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7876,7 +8826,7 @@ func (m *ListAgentInstallResponse) Reset()         { *m = ListAgentInstallRespon
 func (m *ListAgentInstallResponse) String() string { return proto.CompactTextString(m) }
 func (*ListAgentInstallResponse) ProtoMessage()    {}
 func (*ListAgentInstallResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{111}
+	return fileDescriptor_d7122b45d641d698, []int{121}
 }
 func (m *ListAgentInstallResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7923,7 +8873,7 @@ func (m *MonitorResultSimpleResponse) Reset()         { *m = MonitorResultSimple
 func (m *MonitorResultSimpleResponse) String() string { return proto.CompactTextString(m) }
 func (*MonitorResultSimpleResponse) ProtoMessage()    {}
 func (*MonitorResultSimpleResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{112}
+	return fileDescriptor_d7122b45d641d698, []int{122}
 }
 func (m *MonitorResultSimpleResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7972,7 +8922,7 @@ func (m *MonResultSimpleInfo) Reset()         { *m = MonResultSimpleInfo{} }
 func (m *MonResultSimpleInfo) String() string { return proto.CompactTextString(m) }
 func (*MonResultSimpleInfo) ProtoMessage()    {}
 func (*MonResultSimpleInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{113}
+	return fileDescriptor_d7122b45d641d698, []int{123}
 }
 func (m *MonResultSimpleInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8036,7 +8986,7 @@ func (m *MonResultSimple) Reset()         { *m = MonResultSimple{} }
 func (m *MonResultSimple) String() string { return proto.CompactTextString(m) }
 func (*MonResultSimple) ProtoMessage()    {}
 func (*MonResultSimple) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{114}
+	return fileDescriptor_d7122b45d641d698, []int{124}
 }
 func (m *MonResultSimple) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8093,10 +9043,377 @@ func (m *MonResultSimple) GetErr() string {
 	return ""
 }
 
+type MonitorRangeResponse struct {
+	Item                 *MonRangeInfo `protobuf:"bytes,1,opt,name=item,json=monitor,proto3" json:"monitor" yaml:"monitor"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *MonitorRangeResponse) Reset()         { *m = MonitorRangeResponse{} }
+func (m *MonitorRangeResponse) String() string { return proto.CompactTextString(m) }
+func (*MonitorRangeResponse) ProtoMessage()    {}
+func (*MonitorRangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d7122b45d641d698, []int{125}
+}
+func (m *MonitorRangeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MonitorRangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MonitorRangeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MonitorRangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MonitorRangeResponse.Merge(m, src)
+}
+func (m *MonitorRangeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MonitorRangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MonitorRangeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MonitorRangeResponse proto.InternalMessageInfo
+
+func (m *MonitorRangeResponse) GetItem() *MonRangeInfo {
+	if m != nil {
+		return m.Item
+	}
+	return nil
+}
+
+type MonRangeInfo struct {
+	NsId                 string          `protobuf:"bytes,1,opt,name=ns_id,json=nsId,proto3" json:"nsId" yaml:"nsId"`
+	McisId               string          `protobuf:"bytes,2,opt,name=mcis_id,json=mcisId,proto3" json:"mcisId" yaml:"mcisId"`
+	Metric               string          `protobuf:"bytes,3,opt,name=metric,proto3" json:"metric" yaml:"metric"`
+	From                 string          `protobuf:"bytes,4,opt,name=from,proto3" json:"from" yaml:"from"`
+	To                   string          `protobuf:"bytes,5,opt,name=to,proto3" json:"to" yaml:"to"`
+	StepSec              int64           `protobuf:"varint,6,opt,name=step_sec,json=stepSec,proto3" json:"stepSec" yaml:"stepSec"`
+	Aggregation          string          `protobuf:"bytes,7,opt,name=aggregation,proto3" json:"aggregation" yaml:"aggregation"`
+	Resolution           string          `protobuf:"bytes,8,opt,name=resolution,proto3" json:"resolution" yaml:"resolution"`
+	VmSeries             []*MonVmSeries  `protobuf:"bytes,9,rep,name=vm_series,json=vmSeries,proto3" json:"vmSeries" yaml:"vmSeries"`
+	McisSeries           []*MonMcisPoint `protobuf:"bytes,10,rep,name=mcis_series,json=mcisSeries,proto3" json:"mcisSeries" yaml:"mcisSeries"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *MonRangeInfo) Reset()         { *m = MonRangeInfo{} }
+func (m *MonRangeInfo) String() string { return proto.CompactTextString(m) }
+func (*MonRangeInfo) ProtoMessage()    {}
+func (*MonRangeInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d7122b45d641d698, []int{126}
+}
+func (m *MonRangeInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MonRangeInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MonRangeInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MonRangeInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MonRangeInfo.Merge(m, src)
+}
+func (m *MonRangeInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *MonRangeInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_MonRangeInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MonRangeInfo proto.InternalMessageInfo
+
+func (m *MonRangeInfo) GetNsId() string {
+	if m != nil {
+		return m.NsId
+	}
+	return ""
+}
+
+func (m *MonRangeInfo) GetMcisId() string {
+	if m != nil {
+		return m.McisId
+	}
+	return ""
+}
+
+func (m *MonRangeInfo) GetMetric() string {
+	if m != nil {
+		return m.Metric
+	}
+	return ""
+}
+
+func (m *MonRangeInfo) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *MonRangeInfo) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *MonRangeInfo) GetStepSec() int64 {
+	if m != nil {
+		return m.StepSec
+	}
+	return 0
+}
+
+func (m *MonRangeInfo) GetAggregation() string {
+	if m != nil {
+		return m.Aggregation
+	}
+	return ""
+}
+
+func (m *MonRangeInfo) GetResolution() string {
+	if m != nil {
+		return m.Resolution
+	}
+	return ""
+}
+
+func (m *MonRangeInfo) GetVmSeries() []*MonVmSeries {
+	if m != nil {
+		return m.VmSeries
+	}
+	return nil
+}
+
+func (m *MonRangeInfo) GetMcisSeries() []*MonMcisPoint {
+	if m != nil {
+		return m.McisSeries
+	}
+	return nil
+}
+
+type MonVmSeries struct {
+	VmId                 string      `protobuf:"bytes,1,opt,name=vm_id,json=vmId,proto3" json:"vmId" yaml:"vmId"`
+	Points               []*MonPoint `protobuf:"bytes,2,rep,name=points,proto3" json:"points" yaml:"points"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *MonVmSeries) Reset()         { *m = MonVmSeries{} }
+func (m *MonVmSeries) String() string { return proto.CompactTextString(m) }
+func (*MonVmSeries) ProtoMessage()    {}
+func (*MonVmSeries) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d7122b45d641d698, []int{127}
+}
+func (m *MonVmSeries) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MonVmSeries) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MonVmSeries.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MonVmSeries) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MonVmSeries.Merge(m, src)
+}
+func (m *MonVmSeries) XXX_Size() int {
+	return m.Size()
+}
+func (m *MonVmSeries) XXX_DiscardUnknown() {
+	xxx_messageInfo_MonVmSeries.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MonVmSeries proto.InternalMessageInfo
+
+func (m *MonVmSeries) GetVmId() string {
+	if m != nil {
+		return m.VmId
+	}
+	return ""
+}
+
+func (m *MonVmSeries) GetPoints() []*MonPoint {
+	if m != nil {
+		return m.Points
+	}
+	return nil
+}
+
+type MonPoint struct {
+	Time                 string   `protobuf:"bytes,1,opt,name=time,proto3" json:"time" yaml:"time"`
+	Value                float64  `protobuf:"fixed64,2,opt,name=value,proto3" json:"value" yaml:"value"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MonPoint) Reset()         { *m = MonPoint{} }
+func (m *MonPoint) String() string { return proto.CompactTextString(m) }
+func (*MonPoint) ProtoMessage()    {}
+func (*MonPoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d7122b45d641d698, []int{128}
+}
+func (m *MonPoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MonPoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MonPoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MonPoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MonPoint.Merge(m, src)
+}
+func (m *MonPoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *MonPoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_MonPoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MonPoint proto.InternalMessageInfo
+
+func (m *MonPoint) GetTime() string {
+	if m != nil {
+		return m.Time
+	}
+	return ""
+}
+
+func (m *MonPoint) GetValue() float64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+type MonMcisPoint struct {
+	Time                 string   `protobuf:"bytes,1,opt,name=time,proto3" json:"time" yaml:"time"`
+	Avg                  float64  `protobuf:"fixed64,2,opt,name=avg,proto3" json:"avg" yaml:"avg"`
+	Min                  float64  `protobuf:"fixed64,3,opt,name=min,proto3" json:"min" yaml:"min"`
+	Max                  float64  `protobuf:"fixed64,4,opt,name=max,proto3" json:"max" yaml:"max"`
+	Sum                  float64  `protobuf:"fixed64,5,opt,name=sum,proto3" json:"sum" yaml:"sum"`
+	VmCount              int32    `protobuf:"varint,6,opt,name=vm_count,json=vmCount,proto3" json:"vmCount" yaml:"vmCount"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MonMcisPoint) Reset()         { *m = MonMcisPoint{} }
+func (m *MonMcisPoint) String() string { return proto.CompactTextString(m) }
+func (*MonMcisPoint) ProtoMessage()    {}
+func (*MonMcisPoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d7122b45d641d698, []int{129}
+}
+func (m *MonMcisPoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MonMcisPoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MonMcisPoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MonMcisPoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MonMcisPoint.Merge(m, src)
+}
+func (m *MonMcisPoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *MonMcisPoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_MonMcisPoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MonMcisPoint proto.InternalMessageInfo
+
+func (m *MonMcisPoint) GetTime() string {
+	if m != nil {
+		return m.Time
+	}
+	return ""
+}
+
+func (m *MonMcisPoint) GetAvg() float64 {
+	if m != nil {
+		return m.Avg
+	}
+	return 0
+}
+
+func (m *MonMcisPoint) GetMin() float64 {
+	if m != nil {
+		return m.Min
+	}
+	return 0
+}
+
+func (m *MonMcisPoint) GetMax() float64 {
+	if m != nil {
+		return m.Max
+	}
+	return 0
+}
+
+func (m *MonMcisPoint) GetSum() float64 {
+	if m != nil {
+		return m.Sum
+	}
+	return 0
+}
+
+func (m *MonMcisPoint) GetVmCount() int32 {
+	if m != nil {
+		return m.VmCount
+	}
+	return 0
+}
+
 type MonitorQryRequest struct {
 	NsId                 string   `protobuf:"bytes,1,opt,name=ns_id,json=nsId,proto3" json:"nsId" yaml:"nsId"`
 	McisId               string   `protobuf:"bytes,2,opt,name=mcis_id,json=mcisId,proto3" json:"mcisId" yaml:"mcisId"`
 	Metric               string   `protobuf:"bytes,3,opt,name=metric,proto3" json:"metric" yaml:"metric"`
+	From                 string   `protobuf:"bytes,4,opt,name=from,proto3" json:"from" yaml:"from"`
+	To                   string   `protobuf:"bytes,5,opt,name=to,proto3" json:"to" yaml:"to"`
+	Step                 string   `protobuf:"bytes,6,opt,name=step,proto3" json:"step" yaml:"step"`
+	Agg                  string   `protobuf:"bytes,7,opt,name=agg,proto3" json:"agg" yaml:"agg"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -8106,7 +9423,7 @@ func (m *MonitorQryRequest) Reset()         { *m = MonitorQryRequest{} }
 func (m *MonitorQryRequest) String() string { return proto.CompactTextString(m) }
 func (*MonitorQryRequest) ProtoMessage()    {}
 func (*MonitorQryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{115}
+	return fileDescriptor_d7122b45d641d698, []int{130}
 }
 func (m *MonitorQryRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8156,6 +9473,34 @@ func (m *MonitorQryRequest) GetMetric() string {
 	return ""
 }
 
+func (m *MonitorQryRequest) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *MonitorQryRequest) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *MonitorQryRequest) GetStep() string {
+	if m != nil {
+		return m.Step
+	}
+	return ""
+}
+
+func (m *MonitorQryRequest) GetAgg() string {
+	if m != nil {
+		return m.Agg
+	}
+	return ""
+}
+
 type ListBenchmarkInfoResponse struct {
 	Items                []*BenchmarkInfo `protobuf:"bytes,1,rep,name=items,json=resultarray,proto3" json:"resultarray" yaml:"resultarray"`
 	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
@@ -8167,7 +9512,7 @@ func (m *ListBenchmarkInfoResponse) Reset()         { *m = ListBenchmarkInfoResp
 func (m *ListBenchmarkInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*ListBenchmarkInfoResponse) ProtoMessage()    {}
 func (*ListBenchmarkInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{116}
+	return fileDescriptor_d7122b45d641d698, []int{131}
 }
 func (m *ListBenchmarkInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8219,7 +9564,7 @@ func (m *BenchmarkInfo) Reset()         { *m = BenchmarkInfo{} }
 func (m *BenchmarkInfo) String() string { return proto.CompactTextString(m) }
 func (*BenchmarkInfo) ProtoMessage()    {}
 func (*BenchmarkInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{117}
+	return fileDescriptor_d7122b45d641d698, []int{132}
 }
 func (m *BenchmarkInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8303,7 +9648,7 @@ func (m *BmQryAllRequest) Reset()         { *m = BmQryAllRequest{} }
 func (m *BmQryAllRequest) String() string { return proto.CompactTextString(m) }
 func (*BmQryAllRequest) ProtoMessage()    {}
 func (*BmQryAllRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{118}
+	return fileDescriptor_d7122b45d641d698, []int{133}
 }
 func (m *BmQryAllRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8367,7 +9712,7 @@ func (m *BmQryRequest) Reset()         { *m = BmQryRequest{} }
 func (m *BmQryRequest) String() string { return proto.CompactTextString(m) }
 func (*BmQryRequest) ProtoMessage()    {}
 func (*BmQryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{119}
+	return fileDescriptor_d7122b45d641d698, []int{134}
 }
 func (m *BmQryRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8435,7 +9780,7 @@ func (m *BmReq) Reset()         { *m = BmReq{} }
 func (m *BmReq) String() string { return proto.CompactTextString(m) }
 func (*BmReq) ProtoMessage()    {}
 func (*BmReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{120}
+	return fileDescriptor_d7122b45d641d698, []int{135}
 }
 func (m *BmReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8482,7 +9827,7 @@ func (m *McisPolicyInfoResponse) Reset()         { *m = McisPolicyInfoResponse{}
 func (m *McisPolicyInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*McisPolicyInfoResponse) ProtoMessage()    {}
 func (*McisPolicyInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{121}
+	return fileDescriptor_d7122b45d641d698, []int{136}
 }
 func (m *McisPolicyInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8529,7 +9874,7 @@ func (m *ListMcisPolicyInfoResponse) Reset()         { *m = ListMcisPolicyInfoRe
 func (m *ListMcisPolicyInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*ListMcisPolicyInfoResponse) ProtoMessage()    {}
 func (*ListMcisPolicyInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{122}
+	return fileDescriptor_d7122b45d641d698, []int{137}
 }
 func (m *ListMcisPolicyInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8566,21 +9911,24 @@ func (m *ListMcisPolicyInfoResponse) GetItems() []*McisPolicyInfo {
 }
 
 type McisPolicyInfo struct {
-	Name                 string    `protobuf:"bytes,1,opt,name=name,json=Name,proto3" json:"Name" yaml:"Name"`
-	Id                   string    `protobuf:"bytes,2,opt,name=id,json=Id,proto3" json:"Id" yaml:"Id"`
-	Policy               []*Policy `protobuf:"bytes,3,rep,name=policy,proto3" json:"policy" yaml:"policy"`
-	ActionLog            string    `protobuf:"bytes,4,opt,name=action_log,json=actionLog,proto3" json:"actionLog" yaml:"actionLog"`
-	Description          string    `protobuf:"bytes,5,opt,name=description,proto3" json:"description" yaml:"description"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+	Name                 string         `protobuf:"bytes,1,opt,name=name,json=Name,proto3" json:"Name" yaml:"Name"`
+	Id                   string         `protobuf:"bytes,2,opt,name=id,json=Id,proto3" json:"Id" yaml:"Id"`
+	Policy               []*Policy      `protobuf:"bytes,3,rep,name=policy,proto3" json:"policy" yaml:"policy"`
+	ActionLog            string         `protobuf:"bytes,4,opt,name=action_log,json=actionLog,proto3" json:"actionLog" yaml:"actionLog"`
+	Description          string         `protobuf:"bytes,5,opt,name=description,proto3" json:"description" yaml:"description"`
+	LastScaleOutTime     string         `protobuf:"bytes,6,opt,name=last_scale_out_time,json=lastScaleOutTime,proto3" json:"lastScaleOutTime" yaml:"lastScaleOutTime"`
+	LastScaleInTime      string         `protobuf:"bytes,7,opt,name=last_scale_in_time,json=lastScaleInTime,proto3" json:"lastScaleInTime" yaml:"lastScaleInTime"`
+	MetricsSource        *MetricsSource `protobuf:"bytes,8,opt,name=metrics_source,json=metricsSource,proto3" json:"metricsSource" yaml:"metricsSource"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
 func (m *McisPolicyInfo) Reset()         { *m = McisPolicyInfo{} }
 func (m *McisPolicyInfo) String() string { return proto.CompactTextString(m) }
 func (*McisPolicyInfo) ProtoMessage()    {}
 func (*McisPolicyInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{123}
+	return fileDescriptor_d7122b45d641d698, []int{138}
 }
 func (m *McisPolicyInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8644,10 +9992,112 @@ func (m *McisPolicyInfo) GetDescription() string {
 	return ""
 }
 
+func (m *McisPolicyInfo) GetLastScaleOutTime() string {
+	if m != nil {
+		return m.LastScaleOutTime
+	}
+	return ""
+}
+
+func (m *McisPolicyInfo) GetLastScaleInTime() string {
+	if m != nil {
+		return m.LastScaleInTime
+	}
+	return ""
+}
+
+func (m *McisPolicyInfo) GetMetricsSource() *MetricsSource {
+	if m != nil {
+		return m.MetricsSource
+	}
+	return nil
+}
+
+type MetricsSource struct {
+	Provider             string            `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider" yaml:"provider"`
+	PrometheusUrl        string            `protobuf:"bytes,2,opt,name=prometheus_url,json=prometheusUrl,proto3" json:"prometheusUrl" yaml:"prometheusUrl"`
+	PrometheusQuery      map[string]string `protobuf:"bytes,3,rep,name=prometheus_query,json=prometheusQuery,proto3" json:"prometheusQuery" yaml:"prometheusQuery" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	PrometheusVmLabel    string            `protobuf:"bytes,4,opt,name=prometheus_vm_label,json=prometheusVmLabel,proto3" json:"prometheusVmLabel" yaml:"prometheusVmLabel"`
+	SshUserName          string            `protobuf:"bytes,5,opt,name=ssh_user_name,json=sshUserName,proto3" json:"sshUserName" yaml:"sshUserName"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *MetricsSource) Reset()         { *m = MetricsSource{} }
+func (m *MetricsSource) String() string { return proto.CompactTextString(m) }
+func (*MetricsSource) ProtoMessage()    {}
+func (*MetricsSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d7122b45d641d698, []int{139}
+}
+func (m *MetricsSource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MetricsSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MetricsSource.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MetricsSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MetricsSource.Merge(m, src)
+}
+func (m *MetricsSource) XXX_Size() int {
+	return m.Size()
+}
+func (m *MetricsSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_MetricsSource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MetricsSource proto.InternalMessageInfo
+
+func (m *MetricsSource) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *MetricsSource) GetPrometheusUrl() string {
+	if m != nil {
+		return m.PrometheusUrl
+	}
+	return ""
+}
+
+func (m *MetricsSource) GetPrometheusQuery() map[string]string {
+	if m != nil {
+		return m.PrometheusQuery
+	}
+	return nil
+}
+
+func (m *MetricsSource) GetPrometheusVmLabel() string {
+	if m != nil {
+		return m.PrometheusVmLabel
+	}
+	return ""
+}
+
+func (m *MetricsSource) GetSshUserName() string {
+	if m != nil {
+		return m.SshUserName
+	}
+	return ""
+}
+
 type Policy struct {
 	AutoCondition        *AutoCondition `protobuf:"bytes,1,opt,name=auto_condition,json=autoCondition,proto3" json:"autoCondition" yaml:"autoCondition"`
 	AutoAction           *AutoAction    `protobuf:"bytes,2,opt,name=auto_action,json=autoAction,proto3" json:"autoAction" yaml:"autoAction"`
 	Status               string         `protobuf:"bytes,3,opt,name=status,proto3" json:"status" yaml:"status"`
+	AutoSchedule         *AutoSchedule  `protobuf:"bytes,4,opt,name=auto_schedule,json=autoSchedule,proto3" json:"autoSchedule" yaml:"autoSchedule"`
+	AutoPredict          *AutoPredict   `protobuf:"bytes,5,opt,name=auto_predict,json=autoPredict,proto3" json:"autoPredict" yaml:"autoPredict"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
 	XXX_unrecognized     []byte         `json:"-"`
 	XXX_sizecache        int32          `json:"-"`
@@ -8657,7 +10107,7 @@ func (m *Policy) Reset()         { *m = Policy{} }
 func (m *Policy) String() string { return proto.CompactTextString(m) }
 func (*Policy) ProtoMessage()    {}
 func (*Policy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{124}
+	return fileDescriptor_d7122b45d641d698, []int{140}
 }
 func (m *Policy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8707,22 +10157,236 @@ func (m *Policy) GetStatus() string {
 	return ""
 }
 
-type AutoCondition struct {
-	Metric               string   `protobuf:"bytes,1,opt,name=metric,proto3" json:"metric" yaml:"metric"`
-	Operator             string   `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator" yaml:"operator"`
-	Operand              string   `protobuf:"bytes,3,opt,name=operand,proto3" json:"operand" yaml:"operand"`
-	EvaluationPeriod     string   `protobuf:"bytes,4,opt,name=evaluation_period,json=evaluationPeriod,proto3" json:"evaluationPeriod" yaml:"evaluationPeriod"`
-	EvaluationValue      []string `protobuf:"bytes,5,rep,name=evaluation_value,json=evaluationValue,proto3" json:"evaluationValue" yaml:"evaluationValue"`
+func (m *Policy) GetAutoSchedule() *AutoSchedule {
+	if m != nil {
+		return m.AutoSchedule
+	}
+	return nil
+}
+
+func (m *Policy) GetAutoPredict() *AutoPredict {
+	if m != nil {
+		return m.AutoPredict
+	}
+	return nil
+}
+
+type AutoPredict struct {
+	Model                string   `protobuf:"bytes,1,opt,name=model,proto3" json:"model" yaml:"model"`
+	SeasonLength         int32    `protobuf:"varint,2,opt,name=season_length,json=seasonLength,proto3" json:"seasonLength" yaml:"seasonLength"`
+	LeadTimeMin          int32    `protobuf:"varint,3,opt,name=lead_time_min,json=leadTimeMin,proto3" json:"leadTimeMin" yaml:"leadTimeMin"`
+	ActionEnabled        bool     `protobuf:"varint,4,opt,name=action_enabled,json=actionEnabled,proto3" json:"actionEnabled" yaml:"actionEnabled"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AutoPredict) Reset()         { *m = AutoPredict{} }
+func (m *AutoPredict) String() string { return proto.CompactTextString(m) }
+func (*AutoPredict) ProtoMessage()    {}
+func (*AutoPredict) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d7122b45d641d698, []int{141}
+}
+func (m *AutoPredict) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoPredict) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoPredict.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AutoPredict) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoPredict.Merge(m, src)
+}
+func (m *AutoPredict) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoPredict) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoPredict.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoPredict proto.InternalMessageInfo
+
+func (m *AutoPredict) GetModel() string {
+	if m != nil {
+		return m.Model
+	}
+	return ""
+}
+
+func (m *AutoPredict) GetSeasonLength() int32 {
+	if m != nil {
+		return m.SeasonLength
+	}
+	return 0
+}
+
+func (m *AutoPredict) GetLeadTimeMin() int32 {
+	if m != nil {
+		return m.LeadTimeMin
+	}
+	return 0
+}
+
+func (m *AutoPredict) GetActionEnabled() bool {
+	if m != nil {
+		return m.ActionEnabled
+	}
+	return false
+}
+
+type AutoSchedule struct {
+	TimeZone             string              `protobuf:"bytes,1,opt,name=time_zone,json=timeZone,proto3" json:"timeZone" yaml:"timeZone"`
+	Rules                []*AutoScheduleRule `protobuf:"bytes,2,rep,name=rules,proto3" json:"rules" yaml:"rules"`
+	ActiveRule           int32               `protobuf:"varint,3,opt,name=active_rule,json=activeRule,proto3" json:"activeRule" yaml:"activeRule"`
+	ActiveRuleTime       string              `protobuf:"bytes,4,opt,name=active_rule_time,json=activeRuleTime,proto3" json:"activeRuleTime" yaml:"activeRuleTime"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *AutoSchedule) Reset()         { *m = AutoSchedule{} }
+func (m *AutoSchedule) String() string { return proto.CompactTextString(m) }
+func (*AutoSchedule) ProtoMessage()    {}
+func (*AutoSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d7122b45d641d698, []int{142}
+}
+func (m *AutoSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AutoSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoSchedule.Merge(m, src)
+}
+func (m *AutoSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoSchedule proto.InternalMessageInfo
+
+func (m *AutoSchedule) GetTimeZone() string {
+	if m != nil {
+		return m.TimeZone
+	}
+	return ""
+}
+
+func (m *AutoSchedule) GetRules() []*AutoScheduleRule {
+	if m != nil {
+		return m.Rules
+	}
+	return nil
+}
+
+func (m *AutoSchedule) GetActiveRule() int32 {
+	if m != nil {
+		return m.ActiveRule
+	}
+	return 0
+}
+
+func (m *AutoSchedule) GetActiveRuleTime() string {
+	if m != nil {
+		return m.ActiveRuleTime
+	}
+	return ""
+}
+
+type AutoScheduleRule struct {
+	Cron                 string   `protobuf:"bytes,1,opt,name=cron,proto3" json:"cron" yaml:"cron"`
+	DesiredVmCount       int32    `protobuf:"varint,2,opt,name=desired_vm_count,json=desiredVmCount,proto3" json:"desiredVmCount" yaml:"desiredVmCount"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
+func (m *AutoScheduleRule) Reset()         { *m = AutoScheduleRule{} }
+func (m *AutoScheduleRule) String() string { return proto.CompactTextString(m) }
+func (*AutoScheduleRule) ProtoMessage()    {}
+func (*AutoScheduleRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d7122b45d641d698, []int{143}
+}
+func (m *AutoScheduleRule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoScheduleRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoScheduleRule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AutoScheduleRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoScheduleRule.Merge(m, src)
+}
+func (m *AutoScheduleRule) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoScheduleRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoScheduleRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoScheduleRule proto.InternalMessageInfo
+
+func (m *AutoScheduleRule) GetCron() string {
+	if m != nil {
+		return m.Cron
+	}
+	return ""
+}
+
+func (m *AutoScheduleRule) GetDesiredVmCount() int32 {
+	if m != nil {
+		return m.DesiredVmCount
+	}
+	return 0
+}
+
+type AutoCondition struct {
+	Metric               string             `protobuf:"bytes,1,opt,name=metric,proto3" json:"metric" yaml:"metric"`
+	Operator             string             `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator" yaml:"operator"`
+	Operand              string             `protobuf:"bytes,3,opt,name=operand,proto3" json:"operand" yaml:"operand"`
+	EvaluationPeriod     string             `protobuf:"bytes,4,opt,name=evaluation_period,json=evaluationPeriod,proto3" json:"evaluationPeriod" yaml:"evaluationPeriod"`
+	EvaluationValue      []string           `protobuf:"bytes,5,rep,name=evaluation_value,json=evaluationValue,proto3" json:"evaluationValue" yaml:"evaluationValue"`
+	Aggregation          string             `protobuf:"bytes,6,opt,name=aggregation,proto3" json:"aggregation" yaml:"aggregation"`
+	Expression           *AutoConditionExpr `protobuf:"bytes,7,opt,name=expression,proto3" json:"expression" yaml:"expression"`
+	Window               string             `protobuf:"bytes,8,opt,name=window,proto3" json:"window" yaml:"window"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
 func (m *AutoCondition) Reset()         { *m = AutoCondition{} }
 func (m *AutoCondition) String() string { return proto.CompactTextString(m) }
 func (*AutoCondition) ProtoMessage()    {}
 func (*AutoCondition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{125}
+	return fileDescriptor_d7122b45d641d698, []int{144}
 }
 func (m *AutoCondition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8786,11 +10450,152 @@ func (m *AutoCondition) GetEvaluationValue() []string {
 	return nil
 }
 
+func (m *AutoCondition) GetAggregation() string {
+	if m != nil {
+		return m.Aggregation
+	}
+	return ""
+}
+
+func (m *AutoCondition) GetExpression() *AutoConditionExpr {
+	if m != nil {
+		return m.Expression
+	}
+	return nil
+}
+
+func (m *AutoCondition) GetWindow() string {
+	if m != nil {
+		return m.Window
+	}
+	return ""
+}
+
+type AutoConditionExpr struct {
+	Combinator           string               `protobuf:"bytes,1,opt,name=combinator,proto3" json:"combinator" yaml:"combinator"`
+	Children             []*AutoConditionExpr `protobuf:"bytes,2,rep,name=children,proto3" json:"children" yaml:"children"`
+	Metric               string               `protobuf:"bytes,3,opt,name=metric,proto3" json:"metric" yaml:"metric"`
+	Aggregation          string               `protobuf:"bytes,4,opt,name=aggregation,proto3" json:"aggregation" yaml:"aggregation"`
+	Operator             string               `protobuf:"bytes,5,opt,name=operator,proto3" json:"operator" yaml:"operator"`
+	Operand              string               `protobuf:"bytes,6,opt,name=operand,proto3" json:"operand" yaml:"operand"`
+	EvaluationPeriod     string               `protobuf:"bytes,7,opt,name=evaluation_period,json=evaluationPeriod,proto3" json:"evaluationPeriod" yaml:"evaluationPeriod"`
+	EvaluationValue      []string             `protobuf:"bytes,8,rep,name=evaluation_value,json=evaluationValue,proto3" json:"evaluationValue" yaml:"evaluationValue"`
+	Window               string               `protobuf:"bytes,9,opt,name=window,proto3" json:"window" yaml:"window"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *AutoConditionExpr) Reset()         { *m = AutoConditionExpr{} }
+func (m *AutoConditionExpr) String() string { return proto.CompactTextString(m) }
+func (*AutoConditionExpr) ProtoMessage()    {}
+func (*AutoConditionExpr) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d7122b45d641d698, []int{145}
+}
+func (m *AutoConditionExpr) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoConditionExpr) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoConditionExpr.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AutoConditionExpr) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoConditionExpr.Merge(m, src)
+}
+func (m *AutoConditionExpr) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoConditionExpr) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoConditionExpr.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoConditionExpr proto.InternalMessageInfo
+
+func (m *AutoConditionExpr) GetCombinator() string {
+	if m != nil {
+		return m.Combinator
+	}
+	return ""
+}
+
+func (m *AutoConditionExpr) GetChildren() []*AutoConditionExpr {
+	if m != nil {
+		return m.Children
+	}
+	return nil
+}
+
+func (m *AutoConditionExpr) GetMetric() string {
+	if m != nil {
+		return m.Metric
+	}
+	return ""
+}
+
+func (m *AutoConditionExpr) GetAggregation() string {
+	if m != nil {
+		return m.Aggregation
+	}
+	return ""
+}
+
+func (m *AutoConditionExpr) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+func (m *AutoConditionExpr) GetOperand() string {
+	if m != nil {
+		return m.Operand
+	}
+	return ""
+}
+
+func (m *AutoConditionExpr) GetEvaluationPeriod() string {
+	if m != nil {
+		return m.EvaluationPeriod
+	}
+	return ""
+}
+
+func (m *AutoConditionExpr) GetEvaluationValue() []string {
+	if m != nil {
+		return m.EvaluationValue
+	}
+	return nil
+}
+
+func (m *AutoConditionExpr) GetWindow() string {
+	if m != nil {
+		return m.Window
+	}
+	return ""
+}
+
 type AutoAction struct {
 	ActionType           string      `protobuf:"bytes,1,opt,name=action_type,json=actionType,proto3" json:"actionType" yaml:"actionType"`
 	Vm                   *TbVmInfo   `protobuf:"bytes,2,opt,name=vm,proto3" json:"vm" yaml:"vm"`
 	PostCommand          *McisCmdReq `protobuf:"bytes,3,opt,name=post_command,json=postCommand,proto3" json:"postCommand" yaml:"postCommand"`
 	PlacementAlgo        string      `protobuf:"bytes,4,opt,name=placement_algo,json=placementAlgo,proto3" json:"placementAlgo" yaml:"placementAlgo"`
+	ScopeLabel           string      `protobuf:"bytes,5,opt,name=scope_label,json=scopeLabel,proto3" json:"scopeLabel" yaml:"scopeLabel"`
+	MinVmCount           int32       `protobuf:"varint,6,opt,name=min_vm_count,json=minVmCount,proto3" json:"minVmCount" yaml:"minVmCount"`
+	MaxVmCount           int32       `protobuf:"varint,7,opt,name=max_vm_count,json=maxVmCount,proto3" json:"maxVmCount" yaml:"maxVmCount"`
+	StepSize             string      `protobuf:"bytes,8,opt,name=step_size,json=stepSize,proto3" json:"stepSize" yaml:"stepSize"`
+	ScaleOutCooldownSec  int32       `protobuf:"varint,9,opt,name=scale_out_cooldown_sec,json=scaleOutCooldownSec,proto3" json:"scaleOutCooldownSec" yaml:"scaleOutCooldownSec"`
+	ScaleInCooldownSec   int32       `protobuf:"varint,10,opt,name=scale_in_cooldown_sec,json=scaleInCooldownSec,proto3" json:"scaleInCooldownSec" yaml:"scaleInCooldownSec"`
+	VictimStrategy       string      `protobuf:"bytes,11,opt,name=victim_strategy,json=victimStrategy,proto3" json:"victimStrategy" yaml:"victimStrategy"`
+	ScopeVmGroupId       string      `protobuf:"bytes,12,opt,name=scope_vm_group_id,json=scopeVmGroupId,proto3" json:"scopeVmGroupId" yaml:"scopeVmGroupId"`
+	PlacementLocation    string      `protobuf:"bytes,13,opt,name=placement_location,json=placementLocation,proto3" json:"placementLocation" yaml:"placementLocation"`
 	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
 	XXX_unrecognized     []byte      `json:"-"`
 	XXX_sizecache        int32       `json:"-"`
@@ -8800,7 +10605,7 @@ func (m *AutoAction) Reset()         { *m = AutoAction{} }
 func (m *AutoAction) String() string { return proto.CompactTextString(m) }
 func (*AutoAction) ProtoMessage()    {}
 func (*AutoAction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{126}
+	return fileDescriptor_d7122b45d641d698, []int{146}
 }
 func (m *AutoAction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8857,6 +10662,69 @@ func (m *AutoAction) GetPlacementAlgo() string {
 	return ""
 }
 
+func (m *AutoAction) GetScopeLabel() string {
+	if m != nil {
+		return m.ScopeLabel
+	}
+	return ""
+}
+
+func (m *AutoAction) GetMinVmCount() int32 {
+	if m != nil {
+		return m.MinVmCount
+	}
+	return 0
+}
+
+func (m *AutoAction) GetMaxVmCount() int32 {
+	if m != nil {
+		return m.MaxVmCount
+	}
+	return 0
+}
+
+func (m *AutoAction) GetStepSize() string {
+	if m != nil {
+		return m.StepSize
+	}
+	return ""
+}
+
+func (m *AutoAction) GetScaleOutCooldownSec() int32 {
+	if m != nil {
+		return m.ScaleOutCooldownSec
+	}
+	return 0
+}
+
+func (m *AutoAction) GetScaleInCooldownSec() int32 {
+	if m != nil {
+		return m.ScaleInCooldownSec
+	}
+	return 0
+}
+
+func (m *AutoAction) GetVictimStrategy() string {
+	if m != nil {
+		return m.VictimStrategy
+	}
+	return ""
+}
+
+func (m *AutoAction) GetScopeVmGroupId() string {
+	if m != nil {
+		return m.ScopeVmGroupId
+	}
+	return ""
+}
+
+func (m *AutoAction) GetPlacementLocation() string {
+	if m != nil {
+		return m.PlacementLocation
+	}
+	return ""
+}
+
 type McisPolicyCreateRequest struct {
 	NsId                 string          `protobuf:"bytes,1,opt,name=ns_id,json=nsId,proto3" json:"nsId" yaml:"nsId"`
 	McisId               string          `protobuf:"bytes,2,opt,name=mcis_id,json=mcisId,proto3" json:"mcisId" yaml:"mcisId"`
@@ -8870,7 +10738,7 @@ func (m *McisPolicyCreateRequest) Reset()         { *m = McisPolicyCreateRequest
 func (m *McisPolicyCreateRequest) String() string { return proto.CompactTextString(m) }
 func (*McisPolicyCreateRequest) ProtoMessage()    {}
 func (*McisPolicyCreateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{127}
+	return fileDescriptor_d7122b45d641d698, []int{147}
 }
 func (m *McisPolicyCreateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8931,7 +10799,7 @@ func (m *McisPolicyAllQryRequest) Reset()         { *m = McisPolicyAllQryRequest
 func (m *McisPolicyAllQryRequest) String() string { return proto.CompactTextString(m) }
 func (*McisPolicyAllQryRequest) ProtoMessage()    {}
 func (*McisPolicyAllQryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{128}
+	return fileDescriptor_d7122b45d641d698, []int{148}
 }
 func (m *McisPolicyAllQryRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8979,7 +10847,7 @@ func (m *McisPolicyQryRequest) Reset()         { *m = McisPolicyQryRequest{} }
 func (m *McisPolicyQryRequest) String() string { return proto.CompactTextString(m) }
 func (*McisPolicyQryRequest) ProtoMessage()    {}
 func (*McisPolicyQryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{129}
+	return fileDescriptor_d7122b45d641d698, []int{149}
 }
 func (m *McisPolicyQryRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9033,7 +10901,7 @@ func (m *ConnConfigResponse) Reset()         { *m = ConnConfigResponse{} }
 func (m *ConnConfigResponse) String() string { return proto.CompactTextString(m) }
 func (*ConnConfigResponse) ProtoMessage()    {}
 func (*ConnConfigResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{130}
+	return fileDescriptor_d7122b45d641d698, []int{150}
 }
 func (m *ConnConfigResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9080,7 +10948,7 @@ func (m *ListConnConfigResponse) Reset()         { *m = ListConnConfigResponse{}
 func (m *ListConnConfigResponse) String() string { return proto.CompactTextString(m) }
 func (*ListConnConfigResponse) ProtoMessage()    {}
 func (*ListConnConfigResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{131}
+	return fileDescriptor_d7122b45d641d698, []int{151}
 }
 func (m *ListConnConfigResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9131,7 +10999,7 @@ func (m *ConnConfig) Reset()         { *m = ConnConfig{} }
 func (m *ConnConfig) String() string { return proto.CompactTextString(m) }
 func (*ConnConfig) ProtoMessage()    {}
 func (*ConnConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{132}
+	return fileDescriptor_d7122b45d641d698, []int{152}
 }
 func (m *ConnConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9206,7 +11074,7 @@ func (m *ConnConfigQryRequest) Reset()         { *m = ConnConfigQryRequest{} }
 func (m *ConnConfigQryRequest) String() string { return proto.CompactTextString(m) }
 func (*ConnConfigQryRequest) ProtoMessage()    {}
 func (*ConnConfigQryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{133}
+	return fileDescriptor_d7122b45d641d698, []int{153}
 }
 func (m *ConnConfigQryRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9253,7 +11121,7 @@ func (m *RegionResponse) Reset()         { *m = RegionResponse{} }
 func (m *RegionResponse) String() string { return proto.CompactTextString(m) }
 func (*RegionResponse) ProtoMessage()    {}
 func (*RegionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{134}
+	return fileDescriptor_d7122b45d641d698, []int{154}
 }
 func (m *RegionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9300,7 +11168,7 @@ func (m *ListRegionResponse) Reset()         { *m = ListRegionResponse{} }
 func (m *ListRegionResponse) String() string { return proto.CompactTextString(m) }
 func (*ListRegionResponse) ProtoMessage()    {}
 func (*ListRegionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{135}
+	return fileDescriptor_d7122b45d641d698, []int{155}
 }
 func (m *ListRegionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9349,7 +11217,7 @@ func (m *Region) Reset()         { *m = Region{} }
 func (m *Region) String() string { return proto.CompactTextString(m) }
 func (*Region) ProtoMessage()    {}
 func (*Region) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{136}
+	return fileDescriptor_d7122b45d641d698, []int{156}
 }
 func (m *Region) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9410,7 +11278,7 @@ func (m *RegionQryRequest) Reset()         { *m = RegionQryRequest{} }
 func (m *RegionQryRequest) String() string { return proto.CompactTextString(m) }
 func (*RegionQryRequest) ProtoMessage()    {}
 func (*RegionQryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{137}
+	return fileDescriptor_d7122b45d641d698, []int{157}
 }
 func (m *RegionQryRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9457,7 +11325,7 @@ func (m *ConfigInfoResponse) Reset()         { *m = ConfigInfoResponse{} }
 func (m *ConfigInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*ConfigInfoResponse) ProtoMessage()    {}
 func (*ConfigInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{138}
+	return fileDescriptor_d7122b45d641d698, []int{158}
 }
 func (m *ConfigInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9504,7 +11372,7 @@ func (m *ListConfigInfoResponse) Reset()         { *m = ListConfigInfoResponse{}
 func (m *ListConfigInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*ListConfigInfoResponse) ProtoMessage()    {}
 func (*ListConfigInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{139}
+	return fileDescriptor_d7122b45d641d698, []int{159}
 }
 func (m *ListConfigInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9553,7 +11421,7 @@ func (m *ConfigInfo) Reset()         { *m = ConfigInfo{} }
 func (m *ConfigInfo) String() string { return proto.CompactTextString(m) }
 func (*ConfigInfo) ProtoMessage()    {}
 func (*ConfigInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{140}
+	return fileDescriptor_d7122b45d641d698, []int{160}
 }
 func (m *ConfigInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9614,7 +11482,7 @@ func (m *ConfigCreateRequest) Reset()         { *m = ConfigCreateRequest{} }
 func (m *ConfigCreateRequest) String() string { return proto.CompactTextString(m) }
 func (*ConfigCreateRequest) ProtoMessage()    {}
 func (*ConfigCreateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{141}
+	return fileDescriptor_d7122b45d641d698, []int{161}
 }
 func (m *ConfigCreateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9662,7 +11530,7 @@ func (m *ConfigReq) Reset()         { *m = ConfigReq{} }
 func (m *ConfigReq) String() string { return proto.CompactTextString(m) }
 func (*ConfigReq) ProtoMessage()    {}
 func (*ConfigReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{142}
+	return fileDescriptor_d7122b45d641d698, []int{162}
 }
 func (m *ConfigReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9716,7 +11584,7 @@ func (m *ConfigQryRequest) Reset()         { *m = ConfigQryRequest{} }
 func (m *ConfigQryRequest) String() string { return proto.CompactTextString(m) }
 func (*ConfigQryRequest) ProtoMessage()    {}
 func (*ConfigQryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{143}
+	return fileDescriptor_d7122b45d641d698, []int{163}
 }
 func (m *ConfigQryRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9763,7 +11631,7 @@ func (m *InspectMcirInfoResponse) Reset()         { *m = InspectMcirInfoResponse
 func (m *InspectMcirInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*InspectMcirInfoResponse) ProtoMessage()    {}
 func (*InspectMcirInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{144}
+	return fileDescriptor_d7122b45d641d698, []int{164}
 }
 func (m *InspectMcirInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9810,7 +11678,7 @@ func (m *ListInspectMcirInfoResponse) Reset()         { *m = ListInspectMcirInfo
 func (m *ListInspectMcirInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*ListInspectMcirInfoResponse) ProtoMessage()    {}
 func (*ListInspectMcirInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{145}
+	return fileDescriptor_d7122b45d641d698, []int{165}
 }
 func (m *ListInspectMcirInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9859,7 +11727,7 @@ func (m *InspectMcirInfo) Reset()         { *m = InspectMcirInfo{} }
 func (m *InspectMcirInfo) String() string { return proto.CompactTextString(m) }
 func (*InspectMcirInfo) ProtoMessage()    {}
 func (*InspectMcirInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{146}
+	return fileDescriptor_d7122b45d641d698, []int{166}
 }
 func (m *InspectMcirInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9921,7 +11789,7 @@ func (m *McirResourceOnCspOrSpider) Reset()         { *m = McirResourceOnCspOrSp
 func (m *McirResourceOnCspOrSpider) String() string { return proto.CompactTextString(m) }
 func (*McirResourceOnCspOrSpider) ProtoMessage()    {}
 func (*McirResourceOnCspOrSpider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{147}
+	return fileDescriptor_d7122b45d641d698, []int{167}
 }
 func (m *McirResourceOnCspOrSpider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9979,7 +11847,7 @@ func (m *McirResourceOnTumblebug) Reset()         { *m = McirResourceOnTumblebug
 func (m *McirResourceOnTumblebug) String() string { return proto.CompactTextString(m) }
 func (*McirResourceOnTumblebug) ProtoMessage()    {}
 func (*McirResourceOnTumblebug) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{148}
+	return fileDescriptor_d7122b45d641d698, []int{168}
 }
 func (m *McirResourceOnTumblebug) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10054,7 +11922,7 @@ func (m *InspectVmInfoResponse) Reset()         { *m = InspectVmInfoResponse{} }
 func (m *InspectVmInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*InspectVmInfoResponse) ProtoMessage()    {}
 func (*InspectVmInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{149}
+	return fileDescriptor_d7122b45d641d698, []int{169}
 }
 func (m *InspectVmInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10101,7 +11969,7 @@ func (m *ListInspectVmInfoResponse) Reset()         { *m = ListInspectVmInfoResp
 func (m *ListInspectVmInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*ListInspectVmInfoResponse) ProtoMessage()    {}
 func (*ListInspectVmInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{150}
+	return fileDescriptor_d7122b45d641d698, []int{170}
 }
 func (m *ListInspectVmInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10150,7 +12018,7 @@ func (m *InspectVmInfo) Reset()         { *m = InspectVmInfo{} }
 func (m *InspectVmInfo) String() string { return proto.CompactTextString(m) }
 func (*InspectVmInfo) ProtoMessage()    {}
 func (*InspectVmInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{151}
+	return fileDescriptor_d7122b45d641d698, []int{171}
 }
 func (m *InspectVmInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10212,7 +12080,7 @@ func (m *VmResourceOnCspOrSpider) Reset()         { *m = VmResourceOnCspOrSpider
 func (m *VmResourceOnCspOrSpider) String() string { return proto.CompactTextString(m) }
 func (*VmResourceOnCspOrSpider) ProtoMessage()    {}
 func (*VmResourceOnCspOrSpider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{152}
+	return fileDescriptor_d7122b45d641d698, []int{172}
 }
 func (m *VmResourceOnCspOrSpider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10271,7 +12139,7 @@ func (m *VmResourceOnTumblebug) Reset()         { *m = VmResourceOnTumblebug{} }
 func (m *VmResourceOnTumblebug) String() string { return proto.CompactTextString(m) }
 func (*VmResourceOnTumblebug) ProtoMessage()    {}
 func (*VmResourceOnTumblebug) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{153}
+	return fileDescriptor_d7122b45d641d698, []int{173}
 }
 func (m *VmResourceOnTumblebug) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10354,7 +12222,7 @@ func (m *InspectQryRequest) Reset()         { *m = InspectQryRequest{} }
 func (m *InspectQryRequest) String() string { return proto.CompactTextString(m) }
 func (*InspectQryRequest) ProtoMessage()    {}
 func (*InspectQryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{154}
+	return fileDescriptor_d7122b45d641d698, []int{174}
 }
 func (m *InspectQryRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10408,7 +12276,7 @@ func (m *ObjectInfoResponse) Reset()         { *m = ObjectInfoResponse{} }
 func (m *ObjectInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*ObjectInfoResponse) ProtoMessage()    {}
 func (*ObjectInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{155}
+	return fileDescriptor_d7122b45d641d698, []int{175}
 }
 func (m *ObjectInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10455,7 +12323,7 @@ func (m *ListObjectInfoResponse) Reset()         { *m = ListObjectInfoResponse{}
 func (m *ListObjectInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*ListObjectInfoResponse) ProtoMessage()    {}
 func (*ListObjectInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{156}
+	return fileDescriptor_d7122b45d641d698, []int{176}
 }
 func (m *ListObjectInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10502,7 +12370,7 @@ func (m *ObjectQryRequest) Reset()         { *m = ObjectQryRequest{} }
 func (m *ObjectQryRequest) String() string { return proto.CompactTextString(m) }
 func (*ObjectQryRequest) ProtoMessage()    {}
 func (*ObjectQryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d7122b45d641d698, []int{157}
+	return fileDescriptor_d7122b45d641d698, []int{177}
 }
 func (m *ObjectQryRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10580,6 +12448,7 @@ func init() {
 	proto.RegisterType((*TbSpecInfoResponse)(nil), "cbtumblebug.TbSpecInfoResponse")
 	proto.RegisterType((*ListTbSpecInfoResponse)(nil), "cbtumblebug.ListTbSpecInfoResponse")
 	proto.RegisterType((*TbSpecInfo)(nil), "cbtumblebug.TbSpecInfo")
+	proto.RegisterType((*SpecEvaluationScore)(nil), "cbtumblebug.SpecEvaluationScore")
 	proto.RegisterType((*TbSpecCreateRequest)(nil), "cbtumblebug.TbSpecCreateRequest")
 	proto.RegisterType((*TbSpecReq)(nil), "cbtumblebug.TbSpecReq")
 	proto.RegisterType((*FetchSpecQryRequest)(nil), "cbtumblebug.FetchSpecQryRequest")
@@ -10591,6 +12460,11 @@ func init() {
 	proto.RegisterType((*LookupSpecListQryRequest)(nil), "cbtumblebug.LookupSpecListQryRequest")
 	proto.RegisterType((*LookupSpecQryRequest)(nil), "cbtumblebug.LookupSpecQryRequest")
 	proto.RegisterType((*FilterSpecsByRangeRequest)(nil), "cbtumblebug.FilterSpecsByRangeRequest")
+	proto.RegisterType((*SpecSearchRequest)(nil), "cbtumblebug.SpecSearchRequest")
+	proto.RegisterType((*SpecSearchReq)(nil), "cbtumblebug.SpecSearchReq")
+	proto.RegisterType((*SpecSearchCondition)(nil), "cbtumblebug.SpecSearchCondition")
+	proto.RegisterType((*SpecSearchOrder)(nil), "cbtumblebug.SpecSearchOrder")
+	proto.RegisterType((*SpecSearchResponse)(nil), "cbtumblebug.SpecSearchResponse")
 	proto.RegisterType((*SpecRangeFilter)(nil), "cbtumblebug.SpecRangeFilter")
 	proto.RegisterType((*Range)(nil), "cbtumblebug.Range")
 	proto.RegisterType((*SortSpecsRequest)(nil), "cbtumblebug.SortSpecsRequest")
@@ -10623,6 +12497,10 @@ func init() {
 	proto.RegisterType((*TbVmStatusInfo)(nil), "cbtumblebug.TbVmStatusInfo")
 	proto.RegisterType((*TbMcisAllQryRequest)(nil), "cbtumblebug.TbMcisAllQryRequest")
 	proto.RegisterType((*TbMcisActionRequest)(nil), "cbtumblebug.TbMcisActionRequest")
+	proto.RegisterType((*CostReportQryRequest)(nil), "cbtumblebug.CostReportQryRequest")
+	proto.RegisterType((*CostReportResponse)(nil), "cbtumblebug.CostReportResponse")
+	proto.RegisterType((*TbCostReport)(nil), "cbtumblebug.TbCostReport")
+	proto.RegisterType((*TbCostReportItem)(nil), "cbtumblebug.TbCostReportItem")
 	proto.RegisterType((*TbMcisQryRequest)(nil), "cbtumblebug.TbMcisQryRequest")
 	proto.RegisterType((*TbVmInfoResponse)(nil), "cbtumblebug.TbVmInfoResponse")
 	proto.RegisterType((*TbVmCreateRequest)(nil), "cbtumblebug.TbVmCreateRequest")
@@ -10654,6 +12532,11 @@ func init() {
 	proto.RegisterType((*MonitorResultSimpleResponse)(nil), "cbtumblebug.MonitorResultSimpleResponse")
 	proto.RegisterType((*MonResultSimpleInfo)(nil), "cbtumblebug.MonResultSimpleInfo")
 	proto.RegisterType((*MonResultSimple)(nil), "cbtumblebug.MonResultSimple")
+	proto.RegisterType((*MonitorRangeResponse)(nil), "cbtumblebug.MonitorRangeResponse")
+	proto.RegisterType((*MonRangeInfo)(nil), "cbtumblebug.MonRangeInfo")
+	proto.RegisterType((*MonVmSeries)(nil), "cbtumblebug.MonVmSeries")
+	proto.RegisterType((*MonPoint)(nil), "cbtumblebug.MonPoint")
+	proto.RegisterType((*MonMcisPoint)(nil), "cbtumblebug.MonMcisPoint")
 	proto.RegisterType((*MonitorQryRequest)(nil), "cbtumblebug.MonitorQryRequest")
 	proto.RegisterType((*ListBenchmarkInfoResponse)(nil), "cbtumblebug.ListBenchmarkInfoResponse")
 	proto.RegisterType((*BenchmarkInfo)(nil), "cbtumblebug.BenchmarkInfo")
@@ -10663,8 +12546,14 @@ func init() {
 	proto.RegisterType((*McisPolicyInfoResponse)(nil), "cbtumblebug.McisPolicyInfoResponse")
 	proto.RegisterType((*ListMcisPolicyInfoResponse)(nil), "cbtumblebug.ListMcisPolicyInfoResponse")
 	proto.RegisterType((*McisPolicyInfo)(nil), "cbtumblebug.McisPolicyInfo")
+	proto.RegisterType((*MetricsSource)(nil), "cbtumblebug.MetricsSource")
+	proto.RegisterMapType((map[string]string)(nil), "cbtumblebug.MetricsSource.PrometheusQueryEntry")
 	proto.RegisterType((*Policy)(nil), "cbtumblebug.Policy")
+	proto.RegisterType((*AutoPredict)(nil), "cbtumblebug.AutoPredict")
+	proto.RegisterType((*AutoSchedule)(nil), "cbtumblebug.AutoSchedule")
+	proto.RegisterType((*AutoScheduleRule)(nil), "cbtumblebug.AutoScheduleRule")
 	proto.RegisterType((*AutoCondition)(nil), "cbtumblebug.AutoCondition")
+	proto.RegisterType((*AutoConditionExpr)(nil), "cbtumblebug.AutoConditionExpr")
 	proto.RegisterType((*AutoAction)(nil), "cbtumblebug.AutoAction")
 	proto.RegisterType((*McisPolicyCreateRequest)(nil), "cbtumblebug.McisPolicyCreateRequest")
 	proto.RegisterType((*McisPolicyAllQryRequest)(nil), "cbtumblebug.McisPolicyAllQryRequest")
@@ -10702,617 +12591,779 @@ func init() {
 func init() { proto.RegisterFile("cbtumblebug/cbtumblebug.proto", fileDescriptor_d7122b45d641d698) }
 
 var fileDescriptor_d7122b45d641d698 = []byte{
-	// 9758 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x7d, 0x5b, 0x6c, 0x24, 0x57,
-	0x76, 0x98, 0xba, 0x9b, 0xcf, 0xc3, 0x77, 0x71, 0xc8, 0xe9, 0x99, 0xd1, 0x4c, 0x4b, 0x57, 0xbb,
-	0xd2, 0x6e, 0x76, 0x63, 0x49, 0xa3, 0xd9, 0x95, 0x66, 0xa5, 0xc5, 0x8a, 0x43, 0x8e, 0x28, 0xee,
-	0x0c, 0x1f, 0x73, 0x7b, 0x86, 0x5a, 0xad, 0x56, 0x69, 0x17, 0xbb, 0x8b, 0x9c, 0x32, 0xbb, 0xba,
-	0x4a, 0x55, 0xd5, 0x9c, 0xa1, 0x12, 0xe7, 0xc3, 0x1b, 0x60, 0xe3, 0xc4, 0x46, 0xe0, 0x35, 0x60,
-	0x24, 0x8b, 0x00, 0x46, 0x1c, 0x24, 0x30, 0x02, 0x23, 0x08, 0x82, 0x04, 0xfe, 0xc8, 0xc3, 0x0e,
-	0xec, 0x8f, 0xfd, 0x4a, 0xfc, 0x11, 0x04, 0x88, 0x91, 0x30, 0x81, 0xf2, 0x61, 0x84, 0x40, 0x80,
-	0x78, 0xb2, 0x3f, 0xf9, 0x08, 0x10, 0xdc, 0x57, 0xdd, 0x7b, 0xab, 0x6e, 0x77, 0x57, 0x37, 0x9b,
-	0xb4, 0x84, 0xfd, 0x22, 0xeb, 0x9c, 0x73, 0xcf, 0x7d, 0x9d, 0x7b, 0x5e, 0xf7, 0xd1, 0x70, 0xbd,
-	0xbe, 0x17, 0xb7, 0xbd, 0xbd, 0xa6, 0xb3, 0xd7, 0x3e, 0x78, 0x55, 0xf9, 0xff, 0x17, 0x82, 0xd0,
-	0x8f, 0x7d, 0x6b, 0x4a, 0x01, 0x5d, 0xbd, 0x74, 0xe0, 0x1f, 0xf8, 0x14, 0xfe, 0x2a, 0xf9, 0x8f,
-	0x91, 0xa0, 0x71, 0x18, 0xbd, 0xeb, 0x05, 0xf1, 0x31, 0x6a, 0xc0, 0xc4, 0x3d, 0xe7, 0x78, 0xd7,
-	0x6e, 0xb6, 0x1d, 0xeb, 0x15, 0x28, 0x1d, 0x3a, 0xc7, 0xe5, 0xc2, 0x0b, 0x85, 0xaf, 0x4c, 0xde,
-	0x59, 0x3a, 0x3d, 0xa9, 0x94, 0xee, 0x39, 0xc7, 0xcf, 0x4e, 0x2a, 0x70, 0x6c, 0x7b, 0xcd, 0x6f,
-	0xa1, 0x7b, 0xce, 0x31, 0xc2, 0x04, 0x64, 0xbd, 0x0a, 0xa3, 0x47, 0xa4, 0x44, 0xb9, 0x48, 0x49,
-	0xaf, 0x9c, 0x9e, 0x54, 0x46, 0x29, 0x8b, 0x67, 0x27, 0x95, 0x69, 0x46, 0x4c, 0x3f, 0x11, 0x66,
-	0x60, 0x74, 0x0c, 0xa5, 0x8d, 0x8d, 0x35, 0xeb, 0x16, 0x8c, 0xb7, 0x6c, 0xcf, 0xa9, 0xb9, 0x0d,
-	0x5e, 0xc9, 0xb5, 0xd3, 0x93, 0xca, 0xd8, 0x96, 0xed, 0x39, 0x1b, 0x8d, 0x67, 0x27, 0x95, 0x19,
-	0x56, 0x94, 0x7d, 0x23, 0xcc, 0x11, 0xd6, 0x3b, 0x30, 0x19, 0x1d, 0x47, 0xb1, 0xe3, 0x91, 0x72,
-	0xac, 0xc6, 0xca, 0xe9, 0x49, 0x65, 0xa2, 0x4a, 0x81, 0xb4, 0xe4, 0x1c, 0x2b, 0x29, 0x20, 0x08,
-	0x27, 0x48, 0xf4, 0x1e, 0xcc, 0xdd, 0xf1, 0xfd, 0xa6, 0x63, 0xb7, 0xb0, 0x13, 0x05, 0x7e, 0x2b,
-	0x72, 0xac, 0x37, 0x60, 0x2c, 0x74, 0xa2, 0x76, 0x33, 0xa6, 0xad, 0x98, 0x60, 0xad, 0xc0, 0x14,
-	0x22, 0x5b, 0xc1, 0xbe, 0x11, 0xe6, 0x08, 0x74, 0x17, 0x66, 0xef, 0x3e, 0x75, 0xa3, 0x38, 0x52,
-	0xd9, 0x38, 0x14, 0xa2, 0xb2, 0x61, 0x10, 0xc9, 0x86, 0x7d, 0x23, 0xcc, 0x11, 0x84, 0x4d, 0x35,
-	0x0e, 0xdd, 0xd6, 0x41, 0x87, 0xd6, 0x4c, 0xe6, 0x6b, 0xcd, 0x77, 0x61, 0x6e, 0xd3, 0x89, 0x22,
-	0xfb, 0xc0, 0x49, 0xf8, 0xbc, 0x09, 0xe3, 0x1e, 0x03, 0x71, 0x46, 0xd7, 0x4f, 0x4f, 0x2a, 0x02,
-	0xf4, 0xec, 0xa4, 0x32, 0xcb, 0x38, 0x71, 0x00, 0xc2, 0x02, 0xc5, 0x9a, 0x64, 0xc7, 0x6d, 0xad,
-	0x67, 0x11, 0x85, 0xa8, 0x4d, 0x62, 0x34, 0xb2, 0x49, 0xec, 0x1b, 0x61, 0x8e, 0x40, 0xf7, 0x61,
-	0x76, 0xab, 0xba, 0xd1, 0xda, 0xf7, 0x13, 0x36, 0xdf, 0x82, 0x11, 0x37, 0x76, 0x3c, 0xca, 0x64,
-	0xea, 0xe6, 0xe2, 0x2f, 0xa8, 0x92, 0xca, 0x48, 0xef, 0x2c, 0x9e, 0x9e, 0x54, 0x8a, 0x2d, 0xc2,
-	0x75, 0x92, 0x71, 0x6d, 0x45, 0x08, 0x17, 0x5b, 0x11, 0x7a, 0x00, 0xd6, 0x7d, 0x37, 0x8a, 0x53,
-	0x1c, 0xdf, 0x86, 0x51, 0xc2, 0x91, 0xb4, 0xab, 0xd4, 0x37, 0xcb, 0x7f, 0x58, 0x80, 0x31, 0x46,
-	0x63, 0xbd, 0x04, 0xc5, 0x44, 0x06, 0x29, 0xbd, 0xdb, 0x90, 0xf4, 0x6e, 0x03, 0xe1, 0xa2, 0xdb,
-	0xb0, 0xbe, 0x06, 0x23, 0x44, 0x5a, 0xb9, 0xc8, 0x5d, 0x3e, 0x3d, 0xa9, 0xd0, 0xef, 0x67, 0x27,
-	0x95, 0x29, 0xce, 0xd8, 0xf6, 0x1c, 0x84, 0x29, 0xd0, 0x5a, 0x87, 0xa9, 0x86, 0x13, 0xd5, 0x43,
-	0x37, 0x88, 0x5d, 0xbf, 0x55, 0x2e, 0xd1, 0x32, 0x5f, 0x3e, 0x3d, 0xa9, 0xa8, 0xe0, 0x67, 0x27,
-	0x15, 0x8b, 0x15, 0x55, 0x80, 0x08, 0xab, 0x24, 0xe8, 0x3e, 0xcc, 0x6d, 0x55, 0x57, 0x43, 0xc7,
-	0x8e, 0x1d, 0xec, 0x7c, 0xd2, 0x76, 0xa2, 0xd8, 0xba, 0xad, 0x8d, 0xa3, 0xa5, 0x77, 0x3a, 0xc2,
-	0xce, 0x27, 0x9d, 0xfb, 0xfc, 0xcb, 0x30, 0x4a, 0x29, 0x92, 0xce, 0x14, 0x06, 0xe8, 0x4c, 0x71,
-	0xe0, 0xce, 0xbc, 0x03, 0xd3, 0x5b, 0xd5, 0x07, 0xe1, 0xb1, 0xe8, 0xc9, 0xd7, 0x61, 0xb4, 0x15,
-	0xc9, 0xe5, 0xcf, 0x9a, 0x11, 0xd1, 0x25, 0x2c, 0x9a, 0x11, 0x91, 0xe5, 0x4b, 0x81, 0xe8, 0x3d,
-	0x98, 0x25, 0x32, 0xb0, 0xd1, 0x48, 0xe6, 0xff, 0x16, 0x8c, 0xbb, 0x8d, 0x5a, 0xd3, 0x8d, 0x62,
-	0x2a, 0x01, 0x5c, 0x32, 0xdd, 0x06, 0x21, 0x93, 0x92, 0xc9, 0xbe, 0x11, 0xe6, 0x08, 0xf4, 0xa3,
-	0x22, 0x58, 0xd8, 0x89, 0xfc, 0x76, 0x58, 0x77, 0x06, 0x6d, 0x8c, 0x75, 0x1f, 0x66, 0x42, 0xce,
-	0xa3, 0x16, 0x1f, 0x07, 0x42, 0x2c, 0x5e, 0x39, 0x3d, 0xa9, 0x4c, 0x0b, 0xc4, 0xc3, 0xe3, 0x80,
-	0x8c, 0xe8, 0x22, 0x2b, 0xad, 0x42, 0x11, 0xd6, 0x88, 0xac, 0x35, 0x98, 0x4a, 0xb8, 0xb9, 0x0d,
-	0x2e, 0x2e, 0x2f, 0x9d, 0x9e, 0x54, 0x40, 0x80, 0x69, 0x3b, 0x16, 0x74, 0x4e, 0xa4, 0x35, 0x0a,
-	0x01, 0xd1, 0xc3, 0xfb, 0x7e, 0x58, 0x77, 0xca, 0x23, 0x52, 0x0f, 0x53, 0x80, 0xd4, 0xc3, 0xf4,
-	0x13, 0x61, 0x06, 0x46, 0x7f, 0x5c, 0x80, 0x25, 0x31, 0x12, 0x2b, 0xcd, 0xe6, 0xe7, 0x64, 0x30,
-	0x92, 0x6e, 0x94, 0x72, 0x76, 0xe3, 0xd7, 0x0a, 0x60, 0x3d, 0xdc, 0xdb, 0xf0, 0xec, 0x03, 0x87,
-	0xa9, 0x87, 0x41, 0xfa, 0xf0, 0x3e, 0x5f, 0x55, 0x45, 0xba, 0xaa, 0xca, 0xda, 0xaa, 0x52, 0x98,
-	0xb3, 0xe6, 0xb8, 0x1e, 0x53, 0xa2, 0xbc, 0x39, 0xf4, 0x13, 0x61, 0x06, 0x46, 0x35, 0x58, 0xd4,
-	0x5a, 0xc3, 0x85, 0xf5, 0x7d, 0x6d, 0xd9, 0x9e, 0xa5, 0x82, 0x06, 0x5c, 0x26, 0x82, 0x6c, 0xaa,
-	0x64, 0x43, 0xd7, 0x88, 0x67, 0xa9, 0xe5, 0x67, 0xe3, 0x30, 0xa5, 0x94, 0xb0, 0xbe, 0x03, 0x93,
-	0x44, 0x1b, 0x44, 0x81, 0x5d, 0x17, 0x7a, 0xe3, 0xc5, 0xd3, 0x93, 0x8a, 0x04, 0x3e, 0x3b, 0xa9,
-	0xcc, 0x4b, 0xe5, 0x41, 0x41, 0x08, 0x4b, 0x34, 0xd7, 0xb2, 0xc5, 0x7c, 0x5a, 0xb6, 0x94, 0x47,
-	0x31, 0x3d, 0x84, 0xb9, 0xba, 0xdf, 0x6a, 0x39, 0x75, 0xa2, 0x5d, 0x6a, 0xb4, 0x1c, 0x13, 0xfd,
-	0xaf, 0x9d, 0x9e, 0x54, 0x66, 0x25, 0x6a, 0x8b, 0x71, 0x58, 0x62, 0x1c, 0x74, 0x38, 0xc2, 0x29,
-	0x42, 0xeb, 0x2e, 0x4c, 0xd7, 0xa3, 0xa0, 0x46, 0x47, 0x81, 0x88, 0xcf, 0xa8, 0x5c, 0x8d, 0xf5,
-	0x28, 0x60, 0x03, 0xa2, 0xac, 0x46, 0x09, 0x43, 0x58, 0x21, 0xb0, 0x36, 0x61, 0x56, 0xb2, 0xa1,
-	0x6d, 0x1b, 0x93, 0xab, 0x42, 0xd0, 0xf1, 0x96, 0x2d, 0xea, 0xac, 0x58, 0xbb, 0x34, 0x22, 0xeb,
-	0x81, 0xae, 0x84, 0xc7, 0x29, 0xaf, 0x57, 0x4f, 0x4f, 0x2a, 0x4b, 0x0a, 0xf8, 0xeb, 0xbe, 0x47,
-	0xa6, 0x3f, 0x88, 0x8f, 0x73, 0xa8, 0x63, 0x6b, 0x17, 0x66, 0xea, 0xc4, 0xb2, 0x90, 0xc1, 0x6b,
-	0xd8, 0xb1, 0x53, 0x9e, 0xa0, 0x4c, 0x5f, 0x3f, 0x3d, 0xa9, 0x2c, 0x0b, 0xc4, 0x9a, 0x1d, 0x3b,
-	0x1a, 0x57, 0xd1, 0x54, 0x05, 0x4f, 0x9a, 0xaa, 0x7c, 0x5a, 0x77, 0x60, 0xe2, 0x80, 0xac, 0xc0,
-	0x9a, 0x1f, 0x95, 0x27, 0x93, 0x3e, 0x2f, 0x50, 0xd8, 0x76, 0x55, 0xe3, 0xc6, 0xbd, 0x10, 0x8e,
-	0x42, 0x78, 0x9c, 0xff, 0x67, 0x7d, 0x3b, 0xf1, 0x39, 0x20, 0x31, 0x37, 0xf3, 0x0c, 0xa2, 0x31,
-	0xe0, 0x3a, 0x3e, 0x12, 0xde, 0x07, 0xfb, 0xc7, 0x6a, 0xc1, 0xec, 0xa1, 0x73, 0x5c, 0xa3, 0x6e,
-	0x29, 0x33, 0x10, 0x53, 0x74, 0x41, 0x2c, 0x69, 0x0b, 0x42, 0xb8, 0xba, 0xac, 0xcb, 0x87, 0xfc,
-	0x8b, 0xac, 0x2d, 0x53, 0x97, 0x55, 0x3c, 0xc2, 0xd3, 0xea, 0xa7, 0xe5, 0xc1, 0xb2, 0x1d, 0x45,
-	0x7e, 0xdd, 0xb5, 0x63, 0xa7, 0x51, 0xf3, 0xf7, 0x7e, 0xc9, 0xa9, 0xc7, 0xac, 0xde, 0x69, 0x6a,
-	0x98, 0xde, 0x3c, 0x3d, 0xa9, 0x5c, 0x92, 0x14, 0xdb, 0x94, 0x80, 0x9b, 0xa9, 0x6b, 0x8c, 0xbd,
-	0x09, 0x8b, 0xb0, 0xb1, 0x90, 0xf5, 0x21, 0x2c, 0xb8, 0x51, 0xcd, 0x6e, 0xc7, 0x7e, 0xed, 0xc0,
-	0x69, 0x39, 0x21, 0x41, 0x97, 0x67, 0xa8, 0xdb, 0xf9, 0x97, 0x4f, 0x4f, 0x2a, 0x73, 0x6e, 0xb4,
-	0xd2, 0x8e, 0xfd, 0x75, 0x81, 0x7a, 0x76, 0x52, 0x59, 0xe6, 0xcb, 0x4c, 0x47, 0x20, 0x9c, 0x26,
-	0x45, 0xbf, 0x5e, 0x80, 0x4b, 0x7c, 0xd9, 0xeb, 0x6e, 0x47, 0x7f, 0xea, 0x74, 0x5d, 0x53, 0xa7,
-	0x97, 0x4d, 0x7a, 0x88, 0x78, 0x2a, 0xbd, 0xd5, 0xd0, 0x6f, 0x17, 0x01, 0x64, 0x81, 0xfe, 0x1c,
-	0x17, 0x83, 0x7e, 0x28, 0x0e, 0x5f, 0x3f, 0x94, 0x06, 0xd3, 0x0f, 0x29, 0xaf, 0x6a, 0x64, 0x60,
-	0xaf, 0xea, 0x27, 0x05, 0xb8, 0xf4, 0x9e, 0x13, 0xd7, 0x1f, 0x53, 0xce, 0x8a, 0x11, 0x37, 0x74,
-	0xbf, 0x70, 0xf6, 0xee, 0x27, 0x72, 0x50, 0xcc, 0xe3, 0xb4, 0xfd, 0x4a, 0x01, 0x96, 0xaa, 0x8e,
-	0x1d, 0x66, 0x5b, 0xd7, 0x9f, 0x3c, 0xbd, 0x0d, 0x13, 0x87, 0xce, 0xf1, 0x13, 0x3f, 0x6c, 0x44,
-	0xe5, 0x22, 0x5d, 0x52, 0x34, 0xe8, 0x13, 0x30, 0x19, 0xf4, 0x09, 0x08, 0xc2, 0x09, 0x12, 0x1d,
-	0xc0, 0xe5, 0x6a, 0xe0, 0x36, 0x9c, 0x30, 0x6b, 0x30, 0xef, 0x6b, 0x56, 0xf9, 0x79, 0x4d, 0x4e,
-	0x53, 0x65, 0x72, 0x08, 0x6b, 0x13, 0xae, 0x91, 0xf5, 0xd9, 0xa9, 0xb2, 0x4d, 0xdd, 0x3a, 0x9f,
-	0xb5, 0xb6, 0x7f, 0x50, 0x84, 0xb9, 0x54, 0x29, 0xeb, 0x36, 0x94, 0x5c, 0x3e, 0xa6, 0x53, 0x37,
-	0xe7, 0xb5, 0x0a, 0x36, 0x36, 0xd6, 0x58, 0x18, 0xbf, 0x41, 0x07, 0x99, 0x87, 0xf1, 0x1b, 0x64,
-	0x8c, 0x09, 0xc8, 0x7a, 0x4b, 0x51, 0xdb, 0x45, 0x19, 0x32, 0xae, 0x33, 0x8d, 0x2c, 0x95, 0xf5,
-	0x7a, 0xa2, 0xac, 0xf9, 0x7f, 0x4a, 0x80, 0x58, 0xca, 0x1d, 0x20, 0x5a, 0x8d, 0x8c, 0x8a, 0x1e,
-	0xe9, 0xa6, 0xa2, 0xa9, 0xd9, 0xbc, 0xa7, 0xe8, 0x5c, 0xa9, 0x98, 0xef, 0xe9, 0x8a, 0x59, 0xfb,
-	0xfc, 0x04, 0xae, 0xdc, 0xf7, 0xfd, 0xc3, 0x36, 0x5b, 0x76, 0x04, 0x74, 0xde, 0x0b, 0x04, 0xfd,
-	0x8b, 0x02, 0x2c, 0x29, 0x75, 0x9e, 0xfb, 0x82, 0x4c, 0xeb, 0xa3, 0xe2, 0x40, 0xfa, 0x08, 0xfd,
-	0x94, 0x2a, 0xfe, 0x47, 0x01, 0xf1, 0x04, 0x84, 0xba, 0x1d, 0x60, 0xa1, 0xbe, 0x05, 0x13, 0xa9,
-	0x96, 0x50, 0x29, 0x72, 0x93, 0x66, 0xcc, 0x2a, 0xa2, 0x4c, 0x8a, 0x09, 0x54, 0xe2, 0x20, 0x97,
-	0x86, 0xe0, 0x20, 0x5f, 0x7a, 0xb8, 0x57, 0x8d, 0x1e, 0xdf, 0x73, 0x8e, 0xbb, 0x2c, 0xf6, 0x2b,
-	0xa9, 0x1a, 0x64, 0x01, 0x26, 0xc0, 0x11, 0xfd, 0x56, 0x7c, 0x0c, 0xfa, 0x4d, 0x7c, 0x0c, 0xf6,
-	0x8f, 0x0b, 0x65, 0xe6, 0x86, 0x1b, 0x6a, 0x4a, 0xad, 0xf4, 0xb3, 0x56, 0xf5, 0xbf, 0xc7, 0x61,
-	0x5a, 0x2d, 0x75, 0x0e, 0x19, 0x0b, 0x83, 0x6c, 0x96, 0xce, 0x2e, 0x9b, 0xc3, 0x32, 0x72, 0x16,
-	0x86, 0x79, 0x22, 0xe4, 0x51, 0xf4, 0xb8, 0x46, 0xb4, 0x06, 0x6d, 0x1f, 0x73, 0xcc, 0xbf, 0x7a,
-	0x7a, 0x52, 0x99, 0xa9, 0x47, 0x01, 0x1b, 0x1d, 0xde, 0xbc, 0x4b, 0x89, 0xac, 0x4b, 0x30, 0xc2,
-	0x3a, 0x19, 0x69, 0xdc, 0xbe, 0xdb, 0x3a, 0x70, 0xc2, 0x20, 0x74, 0x5b, 0x31, 0x77, 0xcf, 0x69,
-	0xe3, 0x14, 0xb0, 0x6c, 0x9c, 0x02, 0x44, 0x58, 0x25, 0x21, 0xc6, 0xa9, 0x1d, 0x39, 0x21, 0x6d,
-	0xd4, 0xb8, 0xcc, 0x48, 0x0a, 0x98, 0x34, 0x4e, 0x02, 0x82, 0x70, 0x82, 0xb4, 0x3e, 0x06, 0xeb,
-	0xc8, 0x09, 0xdd, 0x7d, 0xd7, 0x69, 0xd4, 0x08, 0x90, 0xf5, 0x6d, 0x22, 0xf1, 0xef, 0xe7, 0x05,
-	0xf6, 0x91, 0x64, 0x77, 0x99, 0xb1, 0x4b, 0x63, 0x10, 0xce, 0x10, 0x5b, 0xef, 0x02, 0x04, 0xed,
-	0xbd, 0xa6, 0x5b, 0x27, 0xe3, 0xc6, 0xdd, 0x71, 0x1a, 0xb7, 0x31, 0x28, 0x13, 0x3b, 0x1e, 0xb7,
-	0x25, 0x20, 0x84, 0x25, 0xda, 0x5a, 0x83, 0xa9, 0x20, 0x74, 0x8f, 0xec, 0xd8, 0xa1, 0x2c, 0x40,
-	0xaa, 0x17, 0x0e, 0x66, 0x3c, 0xb8, 0x7a, 0x91, 0x30, 0x84, 0x15, 0x02, 0x83, 0xba, 0x9f, 0xea,
-	0xa9, 0xee, 0x0f, 0x8d, 0xea, 0xfe, 0xe7, 0xc3, 0x0f, 0xff, 0x71, 0x01, 0x96, 0xc4, 0x92, 0x3f,
-	0x8b, 0x23, 0x7e, 0xaf, 0x6b, 0x5e, 0x83, 0xf1, 0x27, 0x9e, 0x78, 0x2e, 0x3d, 0xf4, 0x9f, 0x0b,
-	0x30, 0xa5, 0x14, 0xfa, 0x3c, 0x78, 0xe3, 0x43, 0xcb, 0xb4, 0xfe, 0x41, 0x01, 0x16, 0x85, 0xfd,
-	0xab, 0x06, 0x4e, 0x7d, 0xb0, 0xe1, 0xbe, 0x05, 0xe3, 0x51, 0xe0, 0xd4, 0xa5, 0xf5, 0x63, 0xe3,
-	0x1a, 0x38, 0x75, 0x75, 0x4f, 0x83, 0x7d, 0x93, 0x71, 0xa5, 0xff, 0x58, 0x6b, 0x9a, 0xe9, 0x4b,
-	0x47, 0x4b, 0xa4, 0x35, 0xd4, 0x56, 0xd0, 0xba, 0x49, 0x11, 0x59, 0x37, 0xf9, 0x42, 0x98, 0x02,
-	0xd1, 0x8f, 0x0a, 0xb0, 0x20, 0xa9, 0x07, 0x6b, 0xff, 0x5a, 0xd7, 0xb8, 0x2d, 0x6f, 0x4b, 0xbe,
-	0x0f, 0x96, 0xda, 0x10, 0x6e, 0x14, 0xd7, 0x34, 0xf3, 0x3b, 0x28, 0xef, 0x1a, 0x2c, 0x73, 0xb3,
-	0x9b, 0xe6, 0x7f, 0x57, 0x37, 0xba, 0x83, 0x56, 0xf0, 0xc7, 0xcb, 0x24, 0xe2, 0x14, 0xd4, 0x3f,
-	0x3f, 0x79, 0xaf, 0x0d, 0x98, 0xa1, 0x26, 0x96, 0x88, 0xaf, 0x62, 0x5f, 0xe9, 0x5a, 0x22, 0x86,
-	0x33, 0x70, 0xea, 0x9c, 0xa1, 0x25, 0xad, 0x2b, 0x07, 0x22, 0xac, 0x92, 0x58, 0x6f, 0xc2, 0xb8,
-	0x1f, 0xb1, 0x54, 0xf0, 0x98, 0xf4, 0x01, 0x39, 0x48, 0xfa, 0x80, 0x1c, 0x80, 0xb0, 0x40, 0x11,
-	0x4b, 0xda, 0x6a, 0x7b, 0xb5, 0xa3, 0x7a, 0xd0, 0xa6, 0x96, 0x74, 0x86, 0x59, 0x52, 0x0a, 0x5b,
-	0xdd, 0x79, 0x24, 0x2d, 0xa9, 0x80, 0x20, 0x9c, 0x20, 0x45, 0xe1, 0xba, 0x1f, 0x32, 0xfb, 0xa9,
-	0x14, 0x26, 0x30, 0xbd, 0x30, 0x81, 0xf0, 0xc2, 0xe4, 0x5f, 0xb6, 0x5f, 0xe6, 0xd5, 0x0e, 0xdc,
-	0x3d, 0x6a, 0x24, 0x8b, 0x62, 0xbf, 0xcc, 0xab, 0xad, 0xbb, 0x77, 0xd4, 0xfd, 0x32, 0x0a, 0xa0,
-	0xfb, 0x65, 0xf4, 0x3f, 0xa2, 0x80, 0xa2, 0xd8, 0x0f, 0x89, 0xcb, 0x4b, 0x0a, 0x03, 0xad, 0x98,
-	0x0e, 0x9a, 0x00, 0x33, 0x06, 0x96, 0xc8, 0x54, 0x25, 0x40, 0x84, 0x55, 0x92, 0xb4, 0x26, 0x9b,
-	0x1a, 0xd8, 0x57, 0xda, 0x86, 0x99, 0xba, 0x1f, 0xc5, 0xb5, 0xc0, 0x09, 0x6b, 0x8f, 0xfd, 0x76,
-	0x58, 0x9e, 0xa6, 0x1d, 0x62, 0x8e, 0x92, 0x8a, 0x50, 0x1c, 0x25, 0x15, 0x4c, 0x1c, 0x25, 0xf5,
-	0x9b, 0xb4, 0x8c, 0x8c, 0x13, 0x6f, 0x2c, 0x35, 0x70, 0xbc, 0x8b, 0x0a, 0x58, 0xb6, 0x4c, 0x01,
-	0x22, 0xac, 0x92, 0x58, 0x1f, 0xc0, 0x9c, 0x67, 0x3f, 0xad, 0xa9, 0xcc, 0x66, 0x29, 0x33, 0x6a,
-	0x2d, 0x53, 0x28, 0x69, 0x2d, 0x53, 0x08, 0x84, 0xd3, 0xa4, 0x96, 0x0f, 0x4b, 0x04, 0x14, 0xfb,
-	0xb1, 0xdd, 0x14, 0xc0, 0x5a, 0xec, 0xee, 0x95, 0xe7, 0x28, 0xfb, 0xdb, 0xa7, 0x27, 0x15, 0x03,
-	0xc1, 0x43, 0x3a, 0x31, 0xcf, 0xcb, 0x4a, 0x32, 0x68, 0x84, 0xcd, 0xc5, 0xe8, 0x90, 0x38, 0x71,
-	0x6d, 0xef, 0x49, 0xed, 0x60, 0x2f, 0x88, 0xca, 0xf3, 0xca, 0x90, 0x30, 0xf0, 0xfa, 0x5e, 0x10,
-	0x29, 0x43, 0x22, 0x81, 0x64, 0x48, 0xe4, 0x17, 0x61, 0xe4, 0xec, 0x45, 0xe4, 0xd3, 0x23, 0x8c,
-	0x16, 0x24, 0x23, 0x0e, 0xde, 0xd4, 0x18, 0x29, 0x40, 0x84, 0x55, 0x12, 0xa2, 0xa7, 0x0e, 0x82,
-	0x76, 0xcd, 0xf3, 0x1b, 0x4e, 0xb3, 0x6c, 0x49, 0x3d, 0x95, 0x00, 0xa5, 0x9e, 0x4a, 0x40, 0x08,
-	0x4b, 0x34, 0x59, 0x01, 0x64, 0x48, 0x0f, 0x82, 0x76, 0x79, 0x91, 0xb6, 0x82, 0xae, 0x00, 0x0e,
-	0x92, 0x2b, 0x80, 0x03, 0x10, 0x16, 0x28, 0x6b, 0x15, 0xe0, 0x20, 0x68, 0x8b, 0xd5, 0x73, 0x89,
-	0x0a, 0x1b, 0xf5, 0x0f, 0x39, 0x94, 0xc9, 0xff, 0x42, 0x52, 0x77, 0xb2, 0x86, 0x14, 0x02, 0x52,
-	0x3b, 0x69, 0x4a, 0x70, 0x33, 0x28, 0x2f, 0x49, 0x95, 0xc1, 0x41, 0x4a, 0xa6, 0x98, 0x01, 0x10,
-	0x16, 0x28, 0x2b, 0x84, 0xb2, 0x1f, 0x36, 0x9c, 0xb0, 0xe6, 0xb6, 0x6a, 0xfb, 0x6e, 0x33, 0x76,
-	0x42, 0xa7, 0x51, 0xe3, 0x5b, 0xe8, 0xcb, 0x72, 0xf6, 0x29, 0xcd, 0x46, 0xeb, 0x3d, 0x4e, 0x91,
-	0xec, 0xa8, 0xf3, 0xd9, 0x37, 0xa2, 0x11, 0x36, 0x17, 0xb3, 0x7e, 0x00, 0x0b, 0x0e, 0xf1, 0x64,
-	0x59, 0xee, 0x9c, 0xe7, 0x3e, 0x2e, 0x4b, 0x97, 0x5d, 0x22, 0x93, 0x2c, 0x08, 0x77, 0xd9, 0xd3,
-	0x18, 0x84, 0x33, 0xc4, 0x56, 0x03, 0x16, 0x55, 0xee, 0x44, 0x3d, 0xd5, 0x5e, 0x7b, 0xbd, 0x5c,
-	0xa1, 0x03, 0xfb, 0xc6, 0xe9, 0x49, 0xc5, 0x52, 0x8a, 0x70, 0xec, 0xb3, 0x93, 0xca, 0x95, 0x4c,
-	0x0d, 0x1c, 0x87, 0xb0, 0xa1, 0x80, 0xb9, 0x96, 0x9b, 0xe5, 0x17, 0xba, 0xd4, 0x72, 0xb3, 0x4b,
-	0x2d, 0x37, 0x4d, 0xb5, 0xdc, 0x34, 0xd7, 0xf2, 0x46, 0xf9, 0xc5, 0x2e, 0xb5, 0xbc, 0xd1, 0xa5,
-	0x96, 0x37, 0x4c, 0xb5, 0xbc, 0x61, 0xae, 0xe5, 0x56, 0x19, 0x75, 0xa9, 0xe5, 0x56, 0x97, 0x5a,
-	0x6e, 0x99, 0x6a, 0xb9, 0x65, 0xae, 0xe5, 0x1b, 0xe5, 0x97, 0xba, 0xd4, 0xf2, 0x8d, 0x2e, 0xb5,
-	0x7c, 0xc3, 0x54, 0xcb, 0x37, 0xcc, 0xb5, 0x7c, 0xb3, 0xfc, 0xa5, 0x2e, 0xb5, 0x7c, 0xb3, 0x4b,
-	0x2d, 0xdf, 0x34, 0xd5, 0xf2, 0x4d, 0x73, 0x2d, 0x6f, 0x96, 0xbf, 0xdc, 0xa5, 0x96, 0x37, 0xbb,
-	0xd4, 0xf2, 0xa6, 0xa9, 0x96, 0x37, 0xcd, 0xb5, 0xbc, 0x55, 0x7e, 0xb9, 0x4b, 0x2d, 0x6f, 0x75,
-	0xa9, 0xe5, 0x2d, 0x53, 0x2d, 0x6f, 0x99, 0x6b, 0xb9, 0x5d, 0x7e, 0xa5, 0x4b, 0x2d, 0xb7, 0xbb,
-	0xd4, 0x72, 0xdb, 0x54, 0xcb, 0x6d, 0x63, 0x2d, 0xaf, 0xbf, 0x56, 0xfe, 0x4a, 0xe7, 0x5a, 0x5e,
-	0x7f, 0xad, 0x73, 0x2d, 0xaf, 0xbf, 0x66, 0xa8, 0xe5, 0xf5, 0xd7, 0xba, 0x04, 0xb0, 0x5f, 0xbd,
-	0xb0, 0x00, 0xf6, 0x2f, 0x0d, 0x25, 0x80, 0xfd, 0x9b, 0x34, 0x9e, 0x22, 0x2e, 0xe1, 0x59, 0xc2,
-	0xd7, 0x55, 0x2d, 0x1e, 0x59, 0x36, 0xb8, 0xf4, 0x24, 0x78, 0xed, 0xe1, 0xd1, 0xff, 0x4e, 0x11,
-	0x26, 0x13, 0xe2, 0xcf, 0x43, 0xd0, 0x9a, 0x71, 0xb5, 0x4b, 0x03, 0xbb, 0xda, 0x43, 0xdb, 0x46,
-	0xfa, 0x7b, 0x05, 0x58, 0xa4, 0xdb, 0x48, 0x84, 0xf5, 0xe7, 0x6c, 0x17, 0xe9, 0x31, 0x2c, 0xb3,
-	0x8d, 0x8e, 0x4c, 0xcc, 0xb7, 0xa5, 0xc5, 0x94, 0xd7, 0x0c, 0x3b, 0x2a, 0x49, 0xd8, 0x47, 0x23,
-	0xf1, 0x23, 0x8f, 0x8b, 0x09, 0x8f, 0xc4, 0xd9, 0x37, 0xc2, 0x1c, 0x81, 0x3c, 0xb8, 0x2a, 0x77,
-	0x70, 0x32, 0xb5, 0x6d, 0xeb, 0x11, 0xe6, 0xd9, 0xab, 0xfb, 0x8d, 0x12, 0xcc, 0xea, 0xe5, 0xd8,
-	0x01, 0xc0, 0x03, 0x32, 0x97, 0xda, 0x01, 0xc0, 0x03, 0x36, 0x8d, 0xc9, 0x01, 0xc0, 0x03, 0x3a,
-	0x83, 0x1c, 0x61, 0x4a, 0xf5, 0x6e, 0x69, 0x32, 0xcd, 0x66, 0x61, 0x84, 0x4b, 0xdf, 0xe8, 0x51,
-	0x8d, 0x44, 0x58, 0xa5, 0x8e, 0x83, 0xb6, 0xbb, 0x1a, 0xb4, 0x65, 0xac, 0x4c, 0xbe, 0x24, 0x2b,
-	0xf2, 0x85, 0x30, 0x05, 0x5a, 0xaf, 0x40, 0xc9, 0x73, 0x3c, 0x2e, 0x75, 0x74, 0x73, 0x69, 0xd3,
-	0xf1, 0xe4, 0xe6, 0xd2, 0xa6, 0xe3, 0x21, 0x4c, 0x40, 0xd6, 0x2a, 0x94, 0x88, 0x63, 0x39, 0x4a,
-	0xc7, 0xed, 0xaa, 0xa1, 0xc6, 0x75, 0x5e, 0x21, 0x65, 0xb2, 0x4e, 0xeb, 0xe3, 0x4c, 0xd6, 0x49,
-	0x75, 0x04, 0x64, 0xc8, 0x21, 0x8e, 0x9d, 0xc3, 0x96, 0x51, 0x28, 0xa6, 0x44, 0x0c, 0x82, 0xf5,
-	0x2a, 0x8c, 0xd6, 0xfd, 0x76, 0x4b, 0x1c, 0xc9, 0xa4, 0x1b, 0x10, 0xab, 0x04, 0x20, 0x37, 0x20,
-	0xe8, 0x27, 0xc2, 0x0c, 0x4c, 0x0b, 0x34, 0xfd, 0xfa, 0xa1, 0x7a, 0x22, 0x76, 0x95, 0x00, 0x94,
-	0x02, 0xe4, 0x93, 0x14, 0xa0, 0x7f, 0xff, 0xa8, 0x00, 0x33, 0xda, 0x38, 0xf4, 0x5f, 0x27, 0x99,
-	0x8a, 0xfd, 0x90, 0xd7, 0xc8, 0xa6, 0x62, 0x3f, 0x54, 0xa6, 0x62, 0x3f, 0x24, 0x53, 0xb1, 0x1f,
-	0x12, 0xce, 0x2c, 0x48, 0x50, 0xce, 0x57, 0x6d, 0xf2, 0x00, 0x81, 0x73, 0xde, 0x64, 0xc1, 0x01,
-	0x03, 0xe7, 0x9e, 0x64, 0x14, 0x40, 0x99, 0x6d, 0x7c, 0x11, 0x61, 0xbe, 0x90, 0xbd, 0xb6, 0xdf,
-	0x2f, 0xc0, 0x25, 0x59, 0xe5, 0xb9, 0x6b, 0xad, 0x8c, 0xde, 0x2e, 0x0e, 0xaa, 0xb7, 0xd1, 0xdf,
-	0x2f, 0xc0, 0x15, 0x16, 0x55, 0x10, 0x50, 0x74, 0xe7, 0x18, 0xdb, 0xad, 0x41, 0xf7, 0xdc, 0x1e,
-	0xc0, 0x18, 0x8b, 0x7c, 0xb8, 0x99, 0x4c, 0x6f, 0x2c, 0x3b, 0x75, 0xca, 0x9c, 0x55, 0xc7, 0x14,
-	0x0a, 0xa3, 0x97, 0x0a, 0x85, 0x7d, 0x23, 0xcc, 0x11, 0xe8, 0xff, 0x2e, 0xc3, 0x5c, 0xaa, 0xe0,
-	0x17, 0x66, 0xd3, 0x29, 0x33, 0x4b, 0x23, 0xc3, 0x48, 0x64, 0x8d, 0xf6, 0x95, 0xc8, 0xda, 0x86,
-	0x24, 0x2f, 0x45, 0x53, 0x60, 0xe9, 0x83, 0xba, 0x74, 0x5c, 0xfb, 0x49, 0x6e, 0x6d, 0x2b, 0xc9,
-	0xad, 0xf1, 0xde, 0x0c, 0x7b, 0x27, 0xbc, 0xee, 0x81, 0x48, 0x61, 0xd1, 0x64, 0x99, 0x99, 0x5f,
-	0xde, 0x24, 0xd8, 0x47, 0xa0, 0xa6, 0xb2, 0x68, 0x06, 0xcd, 0xcc, 0x70, 0x08, 0x89, 0x31, 0x18,
-	0x38, 0x31, 0x56, 0x4f, 0x27, 0xc6, 0xa6, 0x3a, 0xb6, 0x73, 0xf0, 0x64, 0xd9, 0x47, 0x7a, 0xb2,
-	0x6c, 0xba, 0xfb, 0x50, 0xf4, 0x99, 0x40, 0x3b, 0xcc, 0x26, 0xd0, 0x66, 0x3a, 0x56, 0x70, 0xd6,
-	0xa4, 0xda, 0x0f, 0x0b, 0x60, 0xce, 0x7e, 0xd1, 0xa4, 0x9d, 0xb9, 0xce, 0xe1, 0x67, 0xda, 0x3e,
-	0x02, 0x35, 0x5f, 0x46, 0x13, 0x7a, 0xdd, 0xc6, 0xb3, 0xbf, 0xec, 0xdb, 0x47, 0xa0, 0xe6, 0xd0,
-	0x68, 0x1a, 0xaf, 0x0b, 0xf3, 0xb3, 0x64, 0xe4, 0x16, 0x06, 0xc8, 0xc8, 0xdd, 0x93, 0x19, 0x39,
-	0xab, 0xfb, 0x12, 0xcd, 0x91, 0xa5, 0xfb, 0x00, 0x94, 0x74, 0x1b, 0xcd, 0xf0, 0x99, 0xf9, 0x9d,
-	0x25, 0x73, 0x77, 0xa9, 0xaf, 0xcc, 0x9d, 0x31, 0x8b, 0xb6, 0x34, 0xac, 0x2c, 0xda, 0x13, 0x30,
-	0x64, 0xbd, 0x68, 0x12, 0xcd, 0xdc, 0xef, 0xa1, 0x25, 0xd6, 0x4c, 0x15, 0xb3, 0xbc, 0x5a, 0x3f,
-	0x15, 0x0f, 0x90, 0x6b, 0x33, 0x55, 0xcc, 0x52, 0x6d, 0xfd, 0x54, 0x3c, 0x40, 0xfa, 0xcd, 0x54,
-	0x31, 0xcb, 0xbe, 0xf5, 0x53, 0xf1, 0x00, 0x19, 0x39, 0x53, 0xc5, 0x2c, 0x21, 0xd7, 0x4f, 0xc5,
-	0x03, 0x24, 0xe9, 0x4c, 0x15, 0xb3, 0x1c, 0x5d, 0x3f, 0x15, 0x0f, 0x90, 0xb7, 0x33, 0x55, 0xcc,
-	0xd2, 0x76, 0xfd, 0x54, 0x3c, 0x40, 0x2a, 0xcf, 0x54, 0x31, 0xcb, 0xe4, 0xf5, 0x53, 0xf1, 0x00,
-	0xd9, 0x3d, 0x53, 0xc5, 0x2c, 0xb9, 0xd7, 0x4f, 0xc5, 0x03, 0x24, 0xfc, 0x0c, 0x15, 0xf3, 0x7c,
-	0x5f, 0x1f, 0x15, 0x0f, 0x90, 0x03, 0x44, 0x1f, 0xc2, 0x28, 0xe5, 0x48, 0x03, 0x2f, 0x97, 0xe5,
-	0x01, 0x8a, 0x2c, 0xf0, 0xf2, 0xdc, 0x96, 0x0c, 0xbc, 0x3c, 0xb7, 0x85, 0x30, 0x01, 0x51, 0x42,
-	0xfb, 0x29, 0xf5, 0xb9, 0x05, 0xa1, 0xfd, 0x54, 0x21, 0xb4, 0x9f, 0x12, 0x42, 0xfb, 0x29, 0xfa,
-	0x8f, 0x05, 0x98, 0xaf, 0xfa, 0x61, 0x4c, 0x63, 0x0e, 0x11, 0x6c, 0x0c, 0x67, 0xdf, 0xdc, 0x7a,
-	0x0b, 0x26, 0xd8, 0x46, 0xcc, 0xde, 0xb1, 0x7a, 0xf2, 0x8f, 0xc2, 0xee, 0x28, 0x87, 0xfd, 0x39,
-	0x80, 0x38, 0xcb, 0xec, 0x3f, 0x62, 0x28, 0x1b, 0x6e, 0xc8, 0x3c, 0x78, 0x1e, 0x00, 0x50, 0x43,
-	0x99, 0x00, 0xa5, 0xa1, 0x4c, 0x40, 0x08, 0x4b, 0x34, 0xfa, 0x51, 0x01, 0xae, 0x3d, 0xdc, 0xab,
-	0x3a, 0xf5, 0x76, 0xe8, 0xc6, 0xc7, 0xeb, 0xa1, 0xdf, 0x0e, 0xb4, 0xbc, 0xcd, 0x63, 0x2d, 0x4b,
-	0xf4, 0x42, 0xba, 0x83, 0xe9, 0x72, 0xcc, 0xfb, 0x8b, 0x54, 0xb0, 0xf4, 0xfe, 0x34, 0x30, 0xc2,
-	0x3a, 0x19, 0xfa, 0xb5, 0x02, 0x54, 0xf8, 0xf1, 0x84, 0x8e, 0xad, 0x71, 0xf5, 0xf1, 0x3e, 0xcf,
-	0xe6, 0xfc, 0xab, 0x71, 0x9a, 0x84, 0x4d, 0x73, 0xfc, 0xc2, 0x84, 0x72, 0xb7, 0x60, 0xfc, 0x88,
-	0xf8, 0x6b, 0x6e, 0x83, 0x07, 0x71, 0x2c, 0xab, 0xb6, 0xe5, 0xc4, 0xea, 0x71, 0x1a, 0xf6, 0x8d,
-	0x30, 0x47, 0xa4, 0x03, 0x86, 0xd1, 0x81, 0x03, 0x86, 0x36, 0xcc, 0xee, 0xbb, 0xa1, 0xf3, 0xc4,
-	0x6e, 0x36, 0x6b, 0x61, 0xbb, 0xe9, 0x44, 0x3c, 0xe1, 0xf4, 0x92, 0x29, 0xf1, 0xc7, 0x07, 0x19,
-	0xb7, 0x9b, 0x8e, 0x9c, 0x35, 0x51, 0x9c, 0x40, 0x23, 0x39, 0x6b, 0x1a, 0x18, 0x61, 0x9d, 0xcc,
-	0xda, 0x87, 0x25, 0x1a, 0xc0, 0x72, 0x8e, 0xb5, 0x03, 0x32, 0x6f, 0x64, 0x0c, 0xd8, 0xe1, 0x42,
-	0xaa, 0x68, 0x48, 0x94, 0xaa, 0x4d, 0x6b, 0x43, 0x2a, 0x9a, 0x2c, 0x0e, 0x61, 0x43, 0x01, 0xab,
-	0x05, 0x97, 0x0d, 0xf5, 0x28, 0xe7, 0x0f, 0xe9, 0x6e, 0x43, 0xba, 0x20, 0x9f, 0xc1, 0x6b, 0xe6,
-	0xba, 0xd8, 0x3c, 0x1a, 0x0b, 0x19, 0xf2, 0x77, 0x93, 0x17, 0x7a, 0x06, 0x10, 0x2e, 0x6c, 0x0b,
-	0x65, 0x6a, 0x28, 0x5b, 0x28, 0x7f, 0x58, 0x4c, 0xf2, 0xde, 0x29, 0xe1, 0xb2, 0xde, 0x81, 0xc9,
-	0xfd, 0xd0, 0xf7, 0x6a, 0x81, 0x1f, 0x8a, 0x14, 0x21, 0x8d, 0xfd, 0xdf, 0x0b, 0x7d, 0x6f, 0xc7,
-	0x0f, 0x63, 0x19, 0xfb, 0x0b, 0x08, 0xc2, 0x09, 0x92, 0x2c, 0xab, 0xd8, 0x67, 0x65, 0x95, 0x53,
-	0x6a, 0x0f, 0x7d, 0x5e, 0x92, 0x2f, 0x2b, 0xf6, 0x8d, 0x30, 0x47, 0x58, 0x6b, 0x30, 0xe5, 0x06,
-	0x35, 0xfa, 0x62, 0x40, 0xdd, 0x6f, 0xaa, 0xf7, 0x5e, 0x36, 0x76, 0x76, 0x38, 0x54, 0x86, 0x0b,
-	0x12, 0x86, 0xb0, 0x42, 0xa0, 0x2b, 0xfb, 0x11, 0xa9, 0xec, 0xd7, 0xb2, 0xca, 0x7e, 0x4d, 0x51,
-	0xf6, 0xc9, 0xff, 0x44, 0x2d, 0xd5, 0xdd, 0x46, 0xc8, 0x97, 0x35, 0x55, 0x4b, 0xab, 0x1b, 0x6b,
-	0x58, 0xaa, 0x25, 0xf2, 0x85, 0x30, 0x05, 0xa2, 0x7f, 0x59, 0x80, 0xe7, 0x53, 0x0a, 0xf0, 0x2c,
-	0xdb, 0x51, 0x07, 0xda, 0x76, 0x54, 0xa5, 0x9b, 0xe6, 0xc6, 0xce, 0x27, 0x67, 0x50, 0xdc, 0xbf,
-	0x5e, 0xa2, 0x47, 0xe8, 0x52, 0x0c, 0x3f, 0x0f, 0x7b, 0x57, 0x8a, 0x4a, 0x2e, 0x0d, 0xac, 0x92,
-	0x47, 0x86, 0xa8, 0x92, 0x47, 0x2f, 0x40, 0x25, 0xb3, 0x13, 0x8d, 0xbb, 0xa4, 0x2f, 0xf9, 0x4f,
-	0x34, 0x0a, 0x72, 0x36, 0x4f, 0x64, 0x20, 0xe4, 0x3c, 0x91, 0x2f, 0x84, 0x29, 0x50, 0x9e, 0x68,
-	0xcc, 0xf0, 0xef, 0xe1, 0x99, 0xe5, 0xad, 0xe0, 0x77, 0xc7, 0x01, 0x24, 0xf5, 0x17, 0xc6, 0xf8,
-	0xbf, 0x0b, 0x40, 0x16, 0x7a, 0x6d, 0x8f, 0x6e, 0xa5, 0x28, 0xaa, 0x82, 0x40, 0xef, 0xf0, 0xed,
-	0x14, 0xae, 0x2a, 0x12, 0x10, 0xc2, 0x12, 0x6d, 0xc5, 0x30, 0x1f, 0xb5, 0xf7, 0xa8, 0xb4, 0xb6,
-	0xf6, 0x7d, 0x66, 0x04, 0x98, 0xb8, 0x5c, 0x37, 0x89, 0x0b, 0x25, 0xa5, 0x03, 0x4a, 0xdb, 0x1d,
-	0x25, 0xdf, 0xdc, 0x3a, 0xf0, 0x76, 0xeb, 0x70, 0x84, 0x53, 0x84, 0x69, 0x59, 0x1f, 0x1b, 0x58,
-	0xd6, 0x57, 0x60, 0x8a, 0xd8, 0x67, 0xb1, 0xdc, 0xc6, 0x95, 0x11, 0x88, 0x82, 0x5d, 0xb1, 0xe2,
-	0xe6, 0x13, 0x43, 0xbc, 0xcb, 0x17, 0x9d, 0x44, 0x8b, 0x5c, 0x38, 0x65, 0xa1, 0x18, 0x76, 0x91,
-	0x0b, 0x27, 0x54, 0x99, 0x5c, 0xb8, 0x00, 0xb2, 0x5c, 0xb8, 0xf8, 0x52, 0x6e, 0x79, 0x4d, 0x2a,
-	0x27, 0x9b, 0x53, 0xb7, 0xbc, 0xd2, 0x17, 0x71, 0xb3, 0x26, 0x1f, 0x2e, 0xd4, 0xe4, 0x4f, 0x5d,
-	0x98, 0xc9, 0x9f, 0x1e, 0x8a, 0xc9, 0xff, 0x19, 0x09, 0xd0, 0x52, 0xd2, 0x78, 0x96, 0x4b, 0x7d,
-	0xdf, 0x81, 0x49, 0x37, 0x38, 0xba, 0x55, 0xa3, 0x16, 0xb3, 0x28, 0x05, 0x68, 0x63, 0xe7, 0xe8,
-	0x56, 0x8d, 0x9b, 0xcd, 0x79, 0x61, 0xb0, 0x39, 0x08, 0x61, 0x89, 0x36, 0x4c, 0x60, 0xe9, 0x1c,
-	0xf6, 0x5c, 0xd9, 0x61, 0x11, 0x22, 0x6a, 0xe7, 0x77, 0x58, 0x84, 0x70, 0x4f, 0x0e, 0x8b, 0x74,
-	0x56, 0x96, 0x3f, 0x2e, 0xc1, 0x64, 0x42, 0xfc, 0x79, 0x30, 0xb8, 0xba, 0x1a, 0x2c, 0x0d, 0xa0,
-	0x06, 0x9f, 0x18, 0xd4, 0xe0, 0x88, 0x21, 0xf6, 0x54, 0x05, 0x0f, 0x3b, 0x9f, 0x0c, 0x5d, 0x13,
-	0x0e, 0x1c, 0x88, 0xa1, 0xff, 0x59, 0x80, 0x45, 0x43, 0xeb, 0x4c, 0xd3, 0xd3, 0xf9, 0xdc, 0xc3,
-	0x17, 0x64, 0x2d, 0x50, 0x57, 0x63, 0xb3, 0xee, 0x46, 0x7d, 0xb8, 0x1a, 0x82, 0x9c, 0x0d, 0x81,
-	0x57, 0x77, 0x23, 0x39, 0x04, 0xe4, 0x0b, 0x61, 0x0a, 0x94, 0xae, 0x46, 0x86, 0x7f, 0x0f, 0x57,
-	0x23, 0x6f, 0x05, 0x3f, 0x1e, 0x25, 0xae, 0x86, 0xa0, 0x3e, 0x07, 0x57, 0x43, 0x5a, 0xa1, 0xf1,
-	0xfc, 0x56, 0xe8, 0x3e, 0xcc, 0xc4, 0x76, 0x78, 0xe0, 0xc4, 0x62, 0x97, 0x61, 0x42, 0x3e, 0xc5,
-	0xc1, 0x10, 0xc9, 0x0e, 0x03, 0x9f, 0x20, 0x15, 0x8a, 0xb0, 0x46, 0xa4, 0x70, 0xb3, 0x59, 0x14,
-	0x33, 0x99, 0xe6, 0xb6, 0x22, 0x02, 0x19, 0x8d, 0xdb, 0x0a, 0x8f, 0x65, 0x34, 0x22, 0x6a, 0x4c,
-	0x5a, 0x51, 0x4c, 0xfc, 0x59, 0xcf, 0x6f, 0xd5, 0xec, 0x03, 0xa7, 0x15, 0xf3, 0x3d, 0x4e, 0x66,
-	0x4c, 0x18, 0x72, 0xd3, 0x6f, 0xad, 0x10, 0x94, 0x62, 0x4c, 0x74, 0x04, 0x31, 0x26, 0x3a, 0xc4,
-	0x7a, 0x15, 0x46, 0x9b, 0xf6, 0x9e, 0xd3, 0xe4, 0x2e, 0x08, 0x3d, 0xe9, 0x41, 0x01, 0xf2, 0xa4,
-	0x07, 0xfd, 0x44, 0x98, 0x81, 0xad, 0x1d, 0x98, 0x0d, 0x9a, 0x76, 0xdd, 0xf1, 0x9c, 0x56, 0x5c,
-	0xb3, 0x9b, 0x07, 0x3e, 0xf7, 0xba, 0xa8, 0xdf, 0x9c, 0x60, 0x56, 0x9a, 0x07, 0xbe, 0xf4, 0x9b,
-	0x35, 0x30, 0xc2, 0x3a, 0xd9, 0xf0, 0x52, 0x31, 0xdf, 0x82, 0xe2, 0x91, 0x67, 0x5c, 0x6f, 0x0f,
-	0xf7, 0x76, 0x3d, 0xf9, 0xd4, 0xd7, 0x91, 0x27, 0x05, 0xec, 0xc8, 0x43, 0xb8, 0x78, 0xe4, 0xa1,
-	0xff, 0x30, 0x0f, 0x13, 0x82, 0xea, 0x1c, 0x44, 0x72, 0x05, 0xa6, 0x8e, 0x3c, 0x99, 0xa4, 0x51,
-	0x34, 0xf4, 0x91, 0x27, 0x73, 0x33, 0xf3, 0xa2, 0x4d, 0x49, 0x4a, 0x46, 0xa2, 0xad, 0x47, 0x30,
-	0xd1, 0xf4, 0xeb, 0x76, 0x12, 0x1b, 0xa5, 0x6f, 0xea, 0xad, 0x3b, 0xfe, 0x7d, 0x8e, 0x67, 0x71,
-	0xbe, 0xa0, 0x96, 0x71, 0xbe, 0x80, 0x20, 0x9c, 0x20, 0x95, 0xc5, 0x32, 0x7a, 0x86, 0xc5, 0x32,
-	0x36, 0xd4, 0xc5, 0x32, 0x7e, 0x96, 0xc5, 0xf2, 0x08, 0xe6, 0x93, 0x45, 0xa2, 0xaf, 0x65, 0x6a,
-	0xa7, 0x3c, 0x2e, 0xf9, 0x49, 0x03, 0xb9, 0x9d, 0xd2, 0xe1, 0x08, 0xa7, 0x08, 0x89, 0xdc, 0xf3,
-	0x37, 0x05, 0xc5, 0x9b, 0x79, 0x93, 0x52, 0xee, 0x19, 0x66, 0x33, 0x79, 0x39, 0x4f, 0xc4, 0xef,
-	0x2a, 0x98, 0xc4, 0xef, 0xea, 0xb7, 0xf5, 0x3e, 0xb0, 0x37, 0x71, 0x9c, 0x46, 0x2d, 0x76, 0x3d,
-	0x47, 0x3d, 0xb4, 0xc0, 0xe1, 0x0f, 0x5d, 0xcd, 0xed, 0x96, 0x40, 0xe2, 0x76, 0xcb, 0x2f, 0xb9,
-	0x88, 0xa7, 0x72, 0x2e, 0xe2, 0xd4, 0x92, 0x9b, 0x1e, 0x78, 0xc9, 0xdd, 0x4f, 0x4e, 0x22, 0xce,
-	0x18, 0x8c, 0x0e, 0x3b, 0x79, 0x28, 0x8f, 0x3a, 0x86, 0xa9, 0x23, 0x8a, 0xa1, 0x38, 0xa2, 0xc8,
-	0xfe, 0xb1, 0xde, 0x01, 0x7e, 0xa7, 0xb8, 0xe6, 0x06, 0xf4, 0x00, 0x01, 0xcf, 0x58, 0x31, 0xe0,
-	0xc6, 0x8e, 0x94, 0x64, 0x01, 0x41, 0x38, 0x41, 0x5a, 0x6f, 0xc1, 0x44, 0x14, 0x3d, 0x66, 0x29,
-	0xab, 0x39, 0xb9, 0xb9, 0x10, 0x45, 0x8f, 0x79, 0xce, 0x6a, 0x36, 0xb9, 0xb1, 0xca, 0x92, 0x56,
-	0x02, 0xa5, 0x5c, 0x80, 0x6e, 0xb4, 0xd8, 0x0e, 0xbf, 0x76, 0x01, 0x7a, 0x6d, 0xab, 0x9a, 0xbe,
-	0x00, 0xbd, 0xb6, 0x55, 0x4d, 0x2e, 0x40, 0xaf, 0x6d, 0x55, 0x29, 0x07, 0x7e, 0x01, 0xda, 0x0d,
-	0xd4, 0x8d, 0x7c, 0x0e, 0xa5, 0x6d, 0x9f, 0xd7, 0xae, 0x3f, 0x93, 0xc6, 0x4b, 0xb4, 0x7a, 0x85,
-	0x9a, 0x34, 0xc2, 0xca, 0x5c, 0xa1, 0x66, 0xad, 0xd0, 0xaf, 0x50, 0xd3, 0x66, 0x28, 0x04, 0xd6,
-	0x5d, 0x98, 0x3e, 0xf2, 0x6a, 0x7b, 0xbe, 0x1f, 0xd7, 0x1a, 0x6e, 0x74, 0x48, 0xf7, 0xf0, 0x39,
-	0x9b, 0x23, 0xef, 0x8e, 0xef, 0xc7, 0x6b, 0x6e, 0x74, 0x28, 0xd9, 0x48, 0x18, 0xc2, 0x0a, 0x01,
-	0x09, 0x09, 0x09, 0x1b, 0xe2, 0x19, 0x32, 0x3e, 0x97, 0xa4, 0x84, 0x1c, 0x79, 0xd4, 0x63, 0xe4,
-	0x8c, 0xac, 0x84, 0x91, 0x00, 0x22, 0xac, 0x92, 0x98, 0x1c, 0xde, 0xa5, 0xa1, 0x64, 0x98, 0xc4,
-	0x1d, 0xda, 0xe5, 0xfc, 0x77, 0x68, 0xd5, 0x87, 0x27, 0x2e, 0xf7, 0xf5, 0xf0, 0x84, 0x92, 0xd1,
-	0x2a, 0xe7, 0xcf, 0x68, 0xbd, 0x03, 0x93, 0xc2, 0xa9, 0x6e, 0x94, 0xaf, 0x48, 0x79, 0xe6, 0x2e,
-	0xb0, 0xf2, 0x0e, 0xa9, 0x80, 0x20, 0x9c, 0x20, 0xad, 0x8f, 0xc1, 0xca, 0xa4, 0xf7, 0xa3, 0xf2,
-	0x55, 0x1a, 0xad, 0xd2, 0xc3, 0x0f, 0x91, 0x9e, 0xab, 0x57, 0x0e, 0x3f, 0xa4, 0x31, 0x08, 0x67,
-	0x88, 0xad, 0x6f, 0x03, 0x88, 0xa7, 0x12, 0xdc, 0x46, 0xf9, 0x9a, 0xd2, 0x3a, 0xf6, 0x86, 0x84,
-	0xda, 0x3a, 0x0e, 0x21, 0xad, 0xe3, 0xff, 0x5a, 0x0f, 0x60, 0xee, 0xc8, 0x63, 0xaf, 0x11, 0xd8,
-	0x75, 0x76, 0x0c, 0xf5, 0x79, 0xa9, 0x10, 0x8f, 0xbc, 0x47, 0x91, 0x13, 0xae, 0x30, 0x84, 0x54,
-	0x88, 0x1a, 0x18, 0x61, 0x9d, 0x8c, 0x68, 0x6e, 0xc1, 0x32, 0xb0, 0xa3, 0xe8, 0x89, 0x1f, 0x36,
-	0xca, 0xd7, 0xa5, 0xac, 0x30, 0xe2, 0x1d, 0x8e, 0x91, 0xb2, 0xa2, 0xc3, 0x11, 0x4e, 0x11, 0x5a,
-	0x6d, 0xb0, 0x68, 0x7e, 0xc3, 0x75, 0x9e, 0xd4, 0x8e, 0xbc, 0x5a, 0xc3, 0x89, 0x6d, 0xb7, 0x59,
-	0xbe, 0x61, 0x78, 0xe0, 0x83, 0x9f, 0xe9, 0xdd, 0xa4, 0x1a, 0x8b, 0x7a, 0x56, 0xf5, 0x28, 0xd8,
-	0x75, 0x9d, 0x27, 0xbb, 0xde, 0x1a, 0x2d, 0x25, 0x3d, 0xab, 0x14, 0x02, 0xe1, 0x34, 0x29, 0xfa,
-	0xaf, 0x45, 0x98, 0x52, 0x6c, 0xb2, 0xf5, 0x36, 0x4c, 0x34, 0xed, 0xd8, 0x8d, 0xdb, 0x0d, 0x47,
-	0xcd, 0xc6, 0x0b, 0x98, 0x62, 0xa5, 0x39, 0x84, 0x58, 0x69, 0xfe, 0x2f, 0x89, 0x4b, 0x9a, 0x7e,
-	0xeb, 0x80, 0x95, 0x56, 0xe2, 0x92, 0x04, 0x28, 0xd5, 0x4b, 0x02, 0x42, 0x58, 0xa2, 0x89, 0x82,
-	0xda, 0x0b, 0x5d, 0x67, 0xbf, 0x66, 0x37, 0x1a, 0xa1, 0xea, 0x7f, 0x50, 0xe8, 0x4a, 0xa3, 0x11,
-	0x4a, 0x0e, 0x09, 0x08, 0x61, 0x89, 0xa6, 0x31, 0x66, 0xd3, 0x6f, 0x37, 0xd8, 0x51, 0x47, 0x35,
-	0xd5, 0x46, 0xa0, 0xfc, 0xed, 0x46, 0x11, 0x63, 0x0a, 0x10, 0x89, 0x31, 0xc5, 0xff, 0xc4, 0xce,
-	0xb7, 0xec, 0xd8, 0x3d, 0x72, 0x6a, 0xdc, 0x66, 0x8c, 0x4a, 0x3b, 0xcf, 0x10, 0xc9, 0x19, 0xf6,
-	0x45, 0xe1, 0x42, 0x49, 0x28, 0xc2, 0x1a, 0x11, 0x6a, 0x01, 0x48, 0xfb, 0x32, 0xf0, 0x91, 0xf8,
-	0x4f, 0xfd, 0x96, 0xe6, 0xc2, 0x7d, 0xdf, 0x6f, 0x29, 0x2e, 0x1c, 0xf9, 0x42, 0x98, 0x02, 0xd1,
-	0xbf, 0x9d, 0x83, 0x69, 0x55, 0x40, 0xfa, 0x0b, 0x2c, 0xdf, 0x05, 0x50, 0x9e, 0xf9, 0x53, 0x23,
-	0x4b, 0xe5, 0x8d, 0x3f, 0x11, 0x59, 0xca, 0x07, 0xfe, 0x24, 0x9a, 0x28, 0xaf, 0xa3, 0x40, 0xbb,
-	0x0b, 0x42, 0x95, 0xd7, 0xee, 0xce, 0x2a, 0x2f, 0xcd, 0x95, 0x17, 0x07, 0x20, 0x2c, 0x50, 0xc4,
-	0xb4, 0x70, 0x35, 0xa4, 0x1c, 0x75, 0xa5, 0x36, 0x81, 0x45, 0xca, 0xbc, 0x3c, 0xb7, 0x09, 0x12,
-	0x86, 0xb0, 0x42, 0x60, 0x39, 0x70, 0xc9, 0xb0, 0x0b, 0xc8, 0x72, 0xeb, 0x7c, 0xc3, 0x31, 0xb3,
-	0x9d, 0x17, 0xc9, 0x0d, 0xc7, 0x2c, 0x0e, 0x61, 0x43, 0x01, 0x62, 0x7a, 0x88, 0x4a, 0x0a, 0x6c,
-	0x37, 0x54, 0x9f, 0x44, 0xa4, 0xa6, 0xe7, 0x9e, 0x73, 0xbc, 0x63, 0xbb, 0xa1, 0x9e, 0x8d, 0x54,
-	0x80, 0x08, 0xab, 0x24, 0xdc, 0x18, 0xca, 0x33, 0xbe, 0xe3, 0xb2, 0xe3, 0xbb, 0x9b, 0xca, 0x11,
-	0x5f, 0xde, 0x71, 0x09, 0x43, 0x58, 0x21, 0x20, 0x8a, 0x52, 0xa8, 0x25, 0xb7, 0xc1, 0x5d, 0x49,
-	0xba, 0x74, 0x77, 0x37, 0x89, 0x9e, 0x51, 0x15, 0xa5, 0x80, 0x20, 0x9c, 0x20, 0xad, 0x4d, 0x98,
-	0xd5, 0xb4, 0x5a, 0x43, 0x8d, 0x05, 0x19, 0x15, 0x55, 0x55, 0x0d, 0x29, 0xf6, 0x2a, 0x14, 0x61,
-	0x8d, 0x48, 0x24, 0xfa, 0x60, 0x80, 0x44, 0xdf, 0x16, 0x4c, 0x72, 0xf3, 0xe7, 0x36, 0xf8, 0xb9,
-	0xd6, 0x2c, 0x03, 0xda, 0x33, 0xf6, 0x92, 0x92, 0xda, 0x33, 0x01, 0x41, 0x38, 0x41, 0x5a, 0xef,
-	0xc1, 0x38, 0x91, 0x48, 0xc2, 0x6d, 0xba, 0x03, 0x37, 0xba, 0x0c, 0x77, 0x83, 0xfa, 0xc6, 0xc6,
-	0x9a, 0x5c, 0x86, 0xec, 0x1b, 0x61, 0x8e, 0xb0, 0x30, 0x80, 0x30, 0x93, 0x6e, 0x83, 0x3b, 0x92,
-	0x59, 0x56, 0x74, 0xb5, 0xf0, 0x8c, 0x27, 0xe5, 0x36, 0xaf, 0xca, 0x2b, 0x65, 0x28, 0xd1, 0x56,
-	0x04, 0x8b, 0x69, 0xe3, 0x49, 0xac, 0xe7, 0x2c, 0x0d, 0x0e, 0xb3, 0xcc, 0x5f, 0x3f, 0x3d, 0xa9,
-	0x2c, 0xe8, 0x7b, 0xdf, 0xcc, 0xa0, 0x96, 0x0d, 0xd2, 0xbb, 0x41, 0x2d, 0x6a, 0x96, 0xdc, 0xfa,
-	0x00, 0xa6, 0x13, 0xd9, 0x25, 0x5d, 0x99, 0xeb, 0xd0, 0x15, 0x2a, 0x82, 0x5c, 0x52, 0x37, 0xd4,
-	0x87, 0xb7, 0x24, 0x0c, 0x61, 0x85, 0x80, 0x68, 0x8f, 0x28, 0xb6, 0xc3, 0x98, 0x05, 0x0a, 0x8a,
-	0x83, 0x5a, 0x25, 0x50, 0x1e, 0x26, 0xcc, 0x27, 0x8f, 0xa8, 0x31, 0x10, 0x19, 0x0f, 0xf1, 0xbf,
-	0xe2, 0xa8, 0x2f, 0xe4, 0x70, 0xd4, 0x7b, 0x29, 0xce, 0x1f, 0xc0, 0x42, 0xcb, 0x89, 0x9f, 0xf8,
-	0xe1, 0x61, 0xcd, 0x6d, 0xc5, 0x4e, 0xb8, 0x6f, 0xd7, 0x1d, 0xee, 0xb2, 0x52, 0xcf, 0x64, 0x8b,
-	0x21, 0x37, 0x04, 0x4e, 0x7a, 0x26, 0x69, 0x0c, 0xc2, 0x19, 0x62, 0x3d, 0x0c, 0x58, 0x94, 0xeb,
-	0x6d, 0x27, 0x13, 0x06, 0xec, 0xc8, 0x30, 0x40, 0xfc, 0x9b, 0x72, 0xe6, 0x2f, 0xc9, 0xb1, 0xda,
-	0xc9, 0x3a, 0xf3, 0x3b, 0x8a, 0x33, 0xbf, 0xd3, 0xc1, 0x99, 0x5f, 0x52, 0x38, 0x64, 0x9d, 0xf9,
-	0x1d, 0xc5, 0x99, 0xdf, 0xe9, 0xe4, 0xcc, 0x2f, 0x4b, 0xc5, 0xb3, 0x63, 0x70, 0xe6, 0x77, 0x54,
-	0x67, 0x7e, 0xa7, 0xb3, 0x33, 0x7f, 0x59, 0xd5, 0x5f, 0x59, 0x67, 0x5e, 0xc2, 0xa8, 0xfe, 0xea,
-	0xec, 0xcc, 0x97, 0xa5, 0x46, 0xdd, 0xdd, 0x34, 0x38, 0xf3, 0x0a, 0x10, 0x61, 0x95, 0x84, 0x78,
-	0x68, 0xc4, 0x67, 0xb4, 0xeb, 0x75, 0x27, 0x8a, 0x6a, 0x81, 0xef, 0xb6, 0x62, 0xee, 0xd7, 0x52,
-	0x0f, 0xad, 0x5a, 0x7d, 0x7f, 0x85, 0xa2, 0x76, 0x7c, 0xf6, 0x2c, 0x16, 0xf7, 0xd0, 0x74, 0x38,
-	0xc2, 0x29, 0x42, 0x43, 0xd2, 0xf4, 0xea, 0xb9, 0x6d, 0x20, 0x6c, 0xd6, 0xdd, 0xe8, 0xfc, 0x36,
-	0x10, 0x08, 0xf7, 0x64, 0x03, 0xa1, 0x73, 0x0a, 0xf4, 0x27, 0x74, 0x03, 0x81, 0x13, 0xf7, 0xb7,
-	0x81, 0x60, 0xcc, 0x05, 0x16, 0x87, 0x9b, 0x0b, 0x2c, 0x7d, 0xf1, 0x73, 0x81, 0xb7, 0x69, 0x2e,
-	0x90, 0x1d, 0xc5, 0xba, 0x94, 0xc9, 0x05, 0x26, 0x2f, 0xe0, 0x9b, 0x52, 0x81, 0xff, 0x6f, 0x0c,
-	0xc6, 0x39, 0x51, 0x7f, 0x53, 0xc3, 0x16, 0x1a, 0xb3, 0x36, 0x91, 0xfb, 0xa9, 0x76, 0xf5, 0x8b,
-	0xe7, 0xf1, 0xaa, 0xee, 0xa7, 0x8e, 0x1a, 0x35, 0x27, 0x40, 0x1a, 0x35, 0x27, 0x5f, 0xfd, 0x4f,
-	0xc5, 0xd0, 0x0e, 0x4f, 0x18, 0xe2, 0xf5, 0xd1, 0xa1, 0xc6, 0xeb, 0x63, 0x83, 0xc5, 0xeb, 0xe3,
-	0x83, 0xc6, 0xeb, 0x13, 0x03, 0xc6, 0xeb, 0x93, 0xc3, 0x89, 0xd7, 0xe1, 0x7c, 0xe2, 0xf5, 0xa9,
-	0x21, 0xc4, 0xeb, 0xd3, 0xe7, 0x10, 0xaf, 0xcf, 0x9c, 0x39, 0x5e, 0x47, 0x2e, 0x3c, 0x2f, 0x37,
-	0xa0, 0x58, 0xf6, 0xb5, 0xdb, 0x03, 0xf6, 0xfa, 0xdd, 0x64, 0xbd, 0x4c, 0x2f, 0x3d, 0xfc, 0x4b,
-	0x50, 0xee, 0x58, 0x4d, 0xb7, 0x6b, 0xe3, 0xa9, 0x5a, 0xf2, 0xe4, 0xcc, 0xd1, 0xef, 0x8e, 0xc2,
-	0xac, 0x5e, 0xee, 0x5c, 0xb7, 0xbe, 0x4a, 0x67, 0xc8, 0xe6, 0x8f, 0x0c, 0x35, 0x9b, 0x3f, 0x3a,
-	0xf4, 0xad, 0xaf, 0xb1, 0xa1, 0x98, 0xbb, 0xbb, 0x30, 0xed, 0xd9, 0x51, 0xec, 0x84, 0xb5, 0x23,
-	0x4f, 0x6a, 0x18, 0xea, 0x5e, 0x31, 0xf8, 0xae, 0xa7, 0xfa, 0xe6, 0x12, 0x86, 0xb0, 0x42, 0x40,
-	0x94, 0x06, 0x67, 0xe3, 0x06, 0x6a, 0x74, 0xc8, 0x80, 0x1b, 0x81, 0x5c, 0x96, 0x02, 0x82, 0x70,
-	0x82, 0x24, 0xcb, 0x92, 0x97, 0x4e, 0x72, 0xd7, 0xca, 0xbe, 0x02, 0x43, 0x55, 0xab, 0xef, 0xf3,
-	0x0c, 0xf6, 0x25, 0x95, 0x11, 0x07, 0x23, 0xac, 0x93, 0x59, 0xef, 0x52, 0xd3, 0x07, 0x86, 0xc5,
-	0x41, 0xac, 0x9a, 0x22, 0xb6, 0x1d, 0x2d, 0xe0, 0x1f, 0x8e, 0xc3, 0xac, 0x4e, 0x7b, 0x0e, 0xa2,
-	0x7a, 0x1b, 0x26, 0x69, 0x5a, 0xce, 0x93, 0x1b, 0x62, 0x54, 0xbb, 0xd7, 0xa3, 0x80, 0x0f, 0xfc,
-	0xac, 0x4c, 0xb5, 0xd1, 0x51, 0x17, 0x28, 0x45, 0xca, 0x47, 0xce, 0x20, 0xe5, 0xa3, 0x43, 0x95,
-	0xf2, 0xb1, 0xb3, 0x48, 0xb9, 0xcc, 0x8c, 0x69, 0x1b, 0xd7, 0x4a, 0x66, 0x2c, 0xdd, 0x36, 0x15,
-	0x9a, 0x64, 0xc6, 0x78, 0xdb, 0x7e, 0x0e, 0x77, 0xc0, 0xb4, 0x90, 0x71, 0x2a, 0xb3, 0x73, 0x14,
-	0x64, 0x76, 0x8e, 0x02, 0xb9, 0x73, 0x14, 0xa4, 0x02, 0xbe, 0xe9, 0xec, 0xee, 0x4d, 0x90, 0xdd,
-	0xbd, 0x09, 0x94, 0xdd, 0x9b, 0x40, 0xdb, 0x7b, 0x9a, 0xe9, 0x6b, 0xef, 0x49, 0xdd, 0xd6, 0x9d,
-	0x1d, 0xda, 0xb6, 0x2e, 0x5a, 0x15, 0xb1, 0xce, 0x19, 0x7e, 0xb4, 0x07, 0xfd, 0xd3, 0x24, 0x62,
-	0x62, 0x72, 0x3a, 0xf0, 0x7b, 0xa7, 0xc4, 0xd8, 0xa6, 0xde, 0x3b, 0x25, 0x20, 0xd5, 0x17, 0x63,
-	0xdf, 0x08, 0x73, 0x04, 0x59, 0xe3, 0xb6, 0x7a, 0xdb, 0x87, 0x16, 0xb2, 0xc5, 0x9a, 0xe2, 0x85,
-	0x6c, 0xbe, 0x9a, 0x38, 0x02, 0x1d, 0xc1, 0x3c, 0x6b, 0xef, 0xc0, 0x3f, 0x22, 0x30, 0x50, 0x63,
-	0xd1, 0x0f, 0x48, 0xbd, 0xec, 0xf0, 0x40, 0x87, 0x1f, 0xf3, 0xe9, 0x70, 0x1e, 0x21, 0xe1, 0x4e,
-	0xd5, 0xb0, 0xc2, 0x9d, 0xa8, 0x62, 0x8e, 0x40, 0xff, 0x8e, 0x3e, 0xda, 0xba, 0xeb, 0x9d, 0x25,
-	0x6c, 0x1d, 0x6c, 0x12, 0xf4, 0xf7, 0xd6, 0xcf, 0xd2, 0x87, 0x9f, 0x16, 0x60, 0x99, 0x94, 0x38,
-	0xf3, 0xf1, 0xfa, 0xc1, 0x3a, 0xf2, 0x5d, 0xad, 0x23, 0xe6, 0x80, 0x90, 0xdd, 0x49, 0x26, 0xed,
-	0xa3, 0x1d, 0x11, 0x77, 0x92, 0x19, 0x00, 0x61, 0x81, 0x42, 0x1e, 0x2c, 0xe9, 0xc6, 0x51, 0xcc,
-	0xf8, 0xc3, 0x2e, 0x1e, 0x63, 0xca, 0xf4, 0x32, 0xc7, 0x9d, 0x7e, 0xd3, 0xca, 0xe6, 0x54, 0x9b,
-	0x45, 0x6a, 0x4b, 0x90, 0xe8, 0x77, 0x0a, 0xcc, 0x18, 0x5f, 0xac, 0x48, 0x93, 0x3a, 0x54, 0xd3,
-	0xcc, 0x4e, 0x44, 0x7a, 0x6a, 0x1d, 0x47, 0xd4, 0x28, 0x53, 0x20, 0xfa, 0x53, 0x2e, 0xa2, 0x17,
-	0xaf, 0x27, 0xfa, 0x6a, 0xa7, 0xa2, 0x55, 0x46, 0xf2, 0x6b, 0x95, 0x27, 0x70, 0x85, 0xa5, 0x6a,
-	0xea, 0xbe, 0xe7, 0x39, 0xad, 0x86, 0xb6, 0xcc, 0xbf, 0xaf, 0x4d, 0xfa, 0x8d, 0x4c, 0x98, 0xa0,
-	0x95, 0x62, 0x56, 0x25, 0x14, 0x20, 0x69, 0x55, 0x12, 0x10, 0xc2, 0x12, 0x8d, 0xfe, 0xa0, 0x08,
-	0x0b, 0x19, 0x1e, 0xd6, 0x21, 0x4d, 0x0b, 0x26, 0x54, 0x3c, 0x0c, 0xba, 0x61, 0x90, 0x69, 0xb5,
-	0x66, 0xea, 0x4b, 0xa8, 0xe5, 0xa4, 0x2f, 0xa1, 0x42, 0x11, 0xd6, 0x88, 0x0c, 0x29, 0x9e, 0xe2,
-	0x19, 0x53, 0x3c, 0x87, 0x30, 0x27, 0x39, 0x06, 0x76, 0x68, 0x7b, 0xdd, 0x8f, 0x48, 0x52, 0x9f,
-	0x25, 0x29, 0xb1, 0x43, 0x0a, 0x48, 0x9f, 0x45, 0x87, 0x23, 0x9c, 0x22, 0x44, 0x7f, 0xa3, 0xc4,
-	0xe4, 0x52, 0x1f, 0xc1, 0x6d, 0x18, 0xa3, 0x9d, 0xfc, 0x84, 0xcf, 0xda, 0xf5, 0xce, 0x63, 0x97,
-	0xfc, 0x02, 0xd1, 0x11, 0xd1, 0x11, 0x32, 0xfb, 0x42, 0x3f, 0x11, 0x66, 0x60, 0xab, 0x46, 0x8f,
-	0x77, 0x05, 0xa1, 0xeb, 0x93, 0x90, 0x9d, 0xfe, 0xfa, 0x4c, 0xf6, 0x17, 0x1d, 0x76, 0xbd, 0x1d,
-	0x4e, 0x20, 0x0e, 0x64, 0x88, 0x6f, 0xf5, 0x40, 0x86, 0x80, 0xd1, 0x03, 0x19, 0xe2, 0xc3, 0x30,
-	0x0d, 0xa5, 0xe1, 0x4f, 0xc3, 0xc8, 0xb9, 0x4d, 0xc3, 0x4f, 0x0a, 0x30, 0xad, 0x0e, 0x80, 0xf5,
-	0x36, 0x4c, 0x24, 0xa3, 0xa5, 0x6c, 0x86, 0x07, 0x72, 0x40, 0xe6, 0x12, 0x77, 0x8b, 0x0f, 0x47,
-	0x82, 0xb4, 0x36, 0x61, 0x9c, 0xef, 0xeb, 0xf5, 0x7a, 0x83, 0x9c, 0x3f, 0xb0, 0x56, 0x4d, 0x3d,
-	0xb0, 0x56, 0x15, 0x0f, 0xac, 0xd1, 0x7f, 0xfe, 0x51, 0x01, 0xae, 0x6a, 0xab, 0xec, 0x2c, 0xe6,
-	0xe9, 0x43, 0x2d, 0x3d, 0x7c, 0xbd, 0xb3, 0x3a, 0x20, 0x82, 0xd5, 0x9f, 0x36, 0xf8, 0xf3, 0x22,
-	0xcc, 0xa7, 0x59, 0x68, 0xa2, 0x5c, 0x1a, 0x86, 0x28, 0x7f, 0xb1, 0x17, 0xbc, 0xb5, 0x09, 0xb3,
-	0x9e, 0xfd, 0x94, 0x3f, 0xee, 0x5b, 0x6b, 0xb5, 0x3d, 0x35, 0x99, 0xe1, 0xd9, 0x4f, 0xd9, 0xeb,
-	0xbc, 0x5b, 0x6d, 0x4f, 0xaa, 0x3f, 0x15, 0x8a, 0xb0, 0x46, 0x84, 0x7e, 0x6f, 0x84, 0x79, 0x76,
-	0xda, 0x98, 0xbf, 0x0f, 0xd3, 0x21, 0x13, 0x0e, 0xf5, 0xd5, 0x30, 0x1a, 0xb6, 0x70, 0xb8, 0xbe,
-	0x43, 0xad, 0x00, 0x11, 0x56, 0x49, 0x0c, 0xad, 0x2d, 0x9e, 0xa1, 0xb5, 0x24, 0x0a, 0x3a, 0xaa,
-	0x07, 0x6d, 0x96, 0x7c, 0x2e, 0xc9, 0x65, 0x45, 0x80, 0x3c, 0xf3, 0xcc, 0x97, 0x95, 0x80, 0x20,
-	0x9c, 0x20, 0xad, 0x35, 0x98, 0xf2, 0x1c, 0xcf, 0x0f, 0x8f, 0x59, 0x79, 0xe5, 0x98, 0x00, 0x03,
-	0x73, 0x0e, 0x0b, 0xc9, 0x03, 0x4f, 0x1c, 0x86, 0xb0, 0x42, 0x40, 0xda, 0xd0, 0x70, 0xa3, 0x43,
-	0xc6, 0x63, 0x54, 0xb6, 0x81, 0x00, 0xf5, 0x36, 0x08, 0x08, 0xc2, 0x09, 0xd2, 0x20, 0x7d, 0x63,
-	0xc3, 0x97, 0xbe, 0xf1, 0x73, 0xd3, 0x73, 0xbf, 0x55, 0x80, 0xe7, 0xb5, 0x25, 0x7a, 0x36, 0xa7,
-	0x5d, 0xff, 0xc1, 0x51, 0xdd, 0xa1, 0x5c, 0x73, 0x82, 0xa6, 0x7f, 0x4c, 0xab, 0x6e, 0xda, 0x2d,
-	0xc6, 0x29, 0x68, 0xda, 0x2d, 0xc9, 0x89, 0x7c, 0x21, 0x4c, 0x81, 0xe8, 0xcf, 0x0a, 0x30, 0xab,
-	0x97, 0xb0, 0xee, 0x27, 0x2f, 0xc2, 0x99, 0xee, 0x0b, 0xb0, 0xf7, 0xdc, 0xa4, 0x12, 0xed, 0xf1,
-	0x18, 0x9c, 0xb5, 0xab, 0x28, 0xf4, 0xa2, 0xe1, 0x68, 0x95, 0xd0, 0xfc, 0xd2, 0xfb, 0xcd, 0xa7,
-	0xeb, 0x5f, 0x85, 0xd1, 0xa6, 0xeb, 0xb9, 0xb1, 0xb6, 0x11, 0x42, 0x00, 0xca, 0x46, 0x08, 0xf9,
-	0x44, 0x98, 0x81, 0x51, 0x0d, 0x40, 0xb6, 0xdd, 0x7a, 0x00, 0x63, 0x81, 0xdf, 0x74, 0xeb, 0xc7,
-	0xc6, 0xdf, 0x53, 0x63, 0x84, 0xab, 0x7e, 0xab, 0xe1, 0xd2, 0xf8, 0x9a, 0xf6, 0x94, 0xd1, 0xcb,
-	0x9e, 0xb2, 0x6f, 0x84, 0x39, 0x02, 0xfd, 0x76, 0x01, 0xe6, 0x52, 0x05, 0x89, 0x5b, 0xe9, 0x39,
-	0x71, 0xe8, 0xd6, 0xd5, 0xd3, 0x47, 0x0c, 0xa2, 0x78, 0xae, 0xf4, 0x9b, 0x78, 0xae, 0xf4, 0x1f,
-	0xeb, 0x03, 0x98, 0xac, 0x0b, 0x0e, 0xdc, 0x65, 0xd0, 0xb7, 0x13, 0xb7, 0x03, 0x27, 0x64, 0x81,
-	0x3f, 0x3b, 0x67, 0x25, 0x88, 0x95, 0x73, 0x56, 0x02, 0x84, 0xb0, 0x44, 0xa3, 0x1f, 0x16, 0x60,
-	0x32, 0x29, 0x4b, 0x4c, 0xad, 0x4f, 0x3f, 0xfc, 0x50, 0x35, 0xb5, 0x02, 0x26, 0x87, 0x5f, 0x40,
-	0x10, 0x4e, 0x90, 0x34, 0x49, 0xa7, 0xb4, 0x51, 0xbe, 0xd8, 0x41, 0x08, 0x5a, 0x4a, 0x92, 0x8e,
-	0x03, 0x10, 0x16, 0x28, 0x54, 0x87, 0x69, 0x75, 0xd2, 0xad, 0x6a, 0x6a, 0x2a, 0x6e, 0x18, 0xe5,
-	0xa3, 0xcf, 0xc9, 0xf8, 0x2f, 0x05, 0x58, 0xc8, 0x14, 0x1d, 0x6c, 0x3a, 0xde, 0x80, 0xb1, 0x27,
-	0x8e, 0x7b, 0xf0, 0x58, 0xbb, 0xef, 0xce, 0x20, 0xb2, 0x10, 0xfb, 0x46, 0x98, 0x23, 0xac, 0x8f,
-	0x61, 0x92, 0xea, 0x14, 0x87, 0xac, 0xa3, 0x92, 0x41, 0xc4, 0x76, 0x04, 0x96, 0x29, 0x18, 0x9e,
-	0x56, 0x12, 0x40, 0x25, 0xad, 0x24, 0x40, 0x08, 0x4b, 0x34, 0xaa, 0xc3, 0x5c, 0x8a, 0x81, 0xe1,
-	0x27, 0xf7, 0x0f, 0xd5, 0x9f, 0xdc, 0x3f, 0xa4, 0x3f, 0xb9, 0x7f, 0xe8, 0x1c, 0x13, 0xc2, 0x23,
-	0xbb, 0xc9, 0x7f, 0x09, 0x91, 0x12, 0x1e, 0xd9, 0x4d, 0x49, 0x78, 0x64, 0x37, 0x11, 0x26, 0x20,
-	0xf4, 0x04, 0x16, 0xef, 0xbb, 0x51, 0xbc, 0xea, 0x35, 0x98, 0xea, 0xe2, 0x81, 0xcd, 0x2f, 0xea,
-	0xdb, 0x2c, 0xfa, 0x83, 0xac, 0x92, 0xb8, 0xdd, 0x8c, 0x93, 0x5f, 0x71, 0x26, 0x46, 0xcc, 0x0e,
-	0x43, 0xfb, 0x58, 0xfb, 0x15, 0xe7, 0x04, 0xca, 0x7e, 0xc5, 0x59, 0x7e, 0xfe, 0xa7, 0x02, 0xcc,
-	0x68, 0x8c, 0xd4, 0x10, 0xb0, 0x30, 0x40, 0x08, 0x58, 0xcc, 0x13, 0x02, 0x72, 0xea, 0x20, 0x15,
-	0x30, 0x06, 0x1a, 0x75, 0xc0, 0xa8, 0x03, 0xe5, 0xb7, 0xf6, 0x95, 0x80, 0x31, 0x4c, 0xfd, 0xd6,
-	0x7e, 0x28, 0x7e, 0x6b, 0x9f, 0xff, 0xf3, 0x6f, 0x0a, 0x70, 0x89, 0x9e, 0x33, 0xf0, 0x1a, 0x17,
-	0x9f, 0xea, 0x58, 0xe9, 0xf2, 0x43, 0x41, 0xbc, 0x51, 0xc4, 0x13, 0xa4, 0x12, 0x51, 0xf7, 0x94,
-	0x83, 0x62, 0x75, 0xaf, 0x81, 0x30, 0x01, 0xa1, 0xff, 0x55, 0x80, 0x65, 0x4e, 0xfa, 0x17, 0x91,
-	0x75, 0xea, 0x2f, 0xa4, 0x17, 0xfd, 0x1d, 0x19, 0xbc, 0xbf, 0x3f, 0x2c, 0x00, 0x48, 0x52, 0xe2,
-	0xc2, 0xc8, 0x5f, 0x59, 0x2b, 0xe8, 0x3f, 0xd6, 0xb6, 0x95, 0xf9, 0xb1, 0xb6, 0x2d, 0xf9, 0x63,
-	0x6d, 0xe2, 0x3d, 0x50, 0x62, 0xfc, 0xed, 0x96, 0xf6, 0xe3, 0x86, 0x1c, 0xa4, 0xec, 0x6a, 0x30,
-	0x00, 0xc2, 0x02, 0x85, 0xfe, 0x1a, 0xfb, 0xb1, 0x40, 0x9a, 0x72, 0xdf, 0x60, 0x7b, 0x55, 0x17,
-	0xb8, 0x18, 0xdb, 0x70, 0x6d, 0xd3, 0x6f, 0xb9, 0xb1, 0x1f, 0x32, 0x3e, 0x55, 0xd7, 0x0b, 0x9a,
-	0x4e, 0xd2, 0x80, 0xdd, 0x2e, 0xcf, 0x23, 0x6d, 0xfa, 0x2d, 0xb5, 0x0c, 0x35, 0xf1, 0xec, 0x55,
-	0x50, 0xc6, 0x50, 0x79, 0x15, 0x94, 0x01, 0x10, 0x16, 0x28, 0x22, 0x6a, 0x8b, 0x86, 0xf2, 0x17,
-	0x22, 0x67, 0x21, 0xcc, 0xd1, 0x52, 0xbc, 0x2d, 0x6e, 0xeb, 0xc0, 0xa8, 0xc2, 0x53, 0xcd, 0xe3,
-	0x9b, 0x28, 0x75, 0x37, 0xda, 0x4c, 0xca, 0x29, 0x9b, 0x28, 0x1a, 0x1c, 0xe1, 0x14, 0x21, 0xfa,
-	0xf7, 0x05, 0x98, 0x4b, 0x31, 0x1c, 0xcc, 0x5c, 0xf5, 0xa7, 0xf4, 0x5e, 0x85, 0x51, 0x7a, 0xba,
-	0x4a, 0x75, 0xa3, 0x28, 0x40, 0x09, 0x03, 0xc9, 0x27, 0x09, 0x03, 0xc9, 0x5f, 0x62, 0x3d, 0x9c,
-	0x30, 0x54, 0x1f, 0x74, 0x76, 0x42, 0xe5, 0xa9, 0x68, 0x27, 0x0c, 0x11, 0x26, 0x20, 0xf4, 0x7b,
-	0x05, 0x58, 0xe0, 0xfd, 0xbb, 0xe0, 0x0c, 0xa5, 0x1c, 0xb6, 0x52, 0xee, 0x61, 0x43, 0x9f, 0xc2,
-	0x15, 0xb2, 0xc8, 0xee, 0x38, 0xad, 0xfa, 0x63, 0xcf, 0x0e, 0x0f, 0xb5, 0x5c, 0xde, 0xc7, 0xdd,
-	0x56, 0x99, 0x56, 0x44, 0x44, 0x7b, 0x64, 0x16, 0xc5, 0x22, 0xb3, 0xd4, 0x45, 0xc6, 0xd7, 0x98,
-	0x4a, 0x82, 0xfe, 0x4f, 0x11, 0x66, 0x34, 0x2e, 0x8a, 0x75, 0x29, 0xe4, 0xb6, 0x2e, 0xd6, 0xd7,
-	0x60, 0xa4, 0xdd, 0x72, 0x63, 0x75, 0xe2, 0xc9, 0xb7, 0x1c, 0x5a, 0xf2, 0x85, 0x30, 0x05, 0x12,
-	0xe2, 0x86, 0x13, 0xd5, 0x55, 0x55, 0x4a, 0xbe, 0x25, 0x31, 0xf9, 0x42, 0x98, 0x02, 0x89, 0xea,
-	0x72, 0x9a, 0x76, 0x10, 0x39, 0xe2, 0x29, 0x2d, 0xba, 0x8a, 0x39, 0x48, 0xae, 0x62, 0x0e, 0x40,
-	0x58, 0xa0, 0xd4, 0xe3, 0x3d, 0xa3, 0xfa, 0xf1, 0x1e, 0x37, 0x75, 0xbc, 0xc7, 0x15, 0xc7, 0x7b,
-	0xdc, 0x86, 0xd5, 0x00, 0x4d, 0x05, 0xf1, 0xd3, 0x5a, 0xc3, 0x1e, 0xf5, 0x7f, 0x56, 0x80, 0xb9,
-	0x3b, 0xde, 0x83, 0xf0, 0x78, 0x85, 0xe8, 0xd3, 0x8b, 0x13, 0xcf, 0xdb, 0x9a, 0x1d, 0xd6, 0x5f,
-	0x03, 0xbc, 0x23, 0x4f, 0xa0, 0xed, 0x29, 0xfb, 0xef, 0x7b, 0x1e, 0xc2, 0xc5, 0x3d, 0x0f, 0x7d,
-	0x56, 0x80, 0xe9, 0x3b, 0xde, 0xc5, 0x2f, 0xa7, 0xbe, 0x37, 0xdc, 0x92, 0x4e, 0x8e, 0xf4, 0xdf,
-	0xc9, 0x5b, 0x30, 0x7a, 0x47, 0x9c, 0xb1, 0x7b, 0xec, 0x47, 0xb1, 0xda, 0x37, 0xf2, 0x2d, 0xfb,
-	0x46, 0xbe, 0x10, 0xa6, 0x40, 0x14, 0x33, 0xcf, 0x64, 0x87, 0xba, 0xff, 0x5d, 0x12, 0xf1, 0xd9,
-	0xf3, 0x3a, 0xb2, 0x08, 0x4f, 0x6a, 0x24, 0x30, 0x25, 0xa9, 0x91, 0xc0, 0x10, 0x56, 0x08, 0xd0,
-	0x31, 0xfb, 0xc9, 0x87, 0x0e, 0x35, 0x7f, 0xd4, 0xeb, 0x40, 0xd2, 0x59, 0xaa, 0xfe, 0xfd, 0x22,
-	0x3b, 0x36, 0x24, 0x79, 0xf4, 0x77, 0xf1, 0x24, 0xf3, 0xd3, 0x82, 0x1b, 0xca, 0xc1, 0x0d, 0x32,
-	0xff, 0x45, 0x9a, 0x68, 0x10, 0xb1, 0x19, 0x33, 0x80, 0x8b, 0x7a, 0x0c, 0x43, 0x51, 0xb9, 0x02,
-	0x32, 0xeb, 0x5d, 0x00, 0x26, 0x1a, 0xb5, 0xa6, 0x7f, 0xa0, 0xde, 0x12, 0x62, 0xd0, 0xfb, 0xfe,
-	0x81, 0x8c, 0x79, 0x12, 0x10, 0xc2, 0x12, 0x3d, 0xbc, 0x07, 0x21, 0xfe, 0x56, 0x11, 0xc6, 0x58,
-	0xd3, 0xad, 0x26, 0xcc, 0xd2, 0xa7, 0x58, 0x64, 0x2c, 0xcb, 0xa4, 0x44, 0xd7, 0x35, 0x2b, 0xed,
-	0xd8, 0x97, 0xf1, 0x27, 0x4d, 0x39, 0xd9, 0x2a, 0x48, 0xa6, 0x9c, 0x34, 0x30, 0xc2, 0x3a, 0x99,
-	0xf5, 0x31, 0x4c, 0xd1, 0xda, 0xf8, 0x72, 0x32, 0xe5, 0xa8, 0x49, 0x55, 0x6c, 0xaf, 0x8c, 0x49,
-	0x84, 0x9d, 0x7c, 0x4b, 0x89, 0x90, 0x30, 0x84, 0x15, 0x82, 0x81, 0xce, 0x78, 0xa1, 0x67, 0x45,
-	0x98, 0xd1, 0xfa, 0x37, 0x98, 0xd7, 0xa1, 0x26, 0x13, 0x8a, 0xfd, 0x26, 0x13, 0xde, 0x04, 0x91,
-	0x1c, 0x50, 0xcf, 0xfb, 0xf4, 0x4e, 0x25, 0xa4, 0x5e, 0x81, 0x0e, 0x9c, 0xd0, 0xf5, 0x85, 0x85,
-	0x4a, 0xbd, 0x02, 0xbd, 0x43, 0x71, 0xa6, 0x57, 0xa0, 0x19, 0x46, 0x7b, 0x05, 0x9a, 0x81, 0xac,
-	0xef, 0x81, 0x02, 0x63, 0x87, 0xd0, 0xf9, 0xa5, 0x26, 0x7a, 0xc2, 0x4c, 0xe2, 0x76, 0xb9, 0xc3,
-	0xb4, 0x9c, 0xe6, 0xbd, 0xcb, 0x5c, 0xa7, 0x34, 0x29, 0xfa, 0xa3, 0x22, 0x80, 0x9c, 0x69, 0x6b,
-	0x0d, 0xa6, 0xf8, 0xda, 0xa0, 0x57, 0xe8, 0x0a, 0x32, 0xc1, 0xca, 0xc0, 0xfc, 0x0e, 0xdd, 0x82,
-	0xba, 0x3a, 0xd8, 0x25, 0x3a, 0x85, 0x80, 0xbf, 0x72, 0x50, 0xec, 0xb6, 0x23, 0xdf, 0xe9, 0x60,
-	0x97, 0x65, 0xc3, 0x74, 0xe0, 0x47, 0x71, 0x4d, 0x04, 0x28, 0x3d, 0x62, 0x44, 0xba, 0xea, 0x48,
-	0x81, 0xd5, 0x24, 0x7a, 0xb1, 0xc4, 0xb2, 0x4f, 0x80, 0x08, 0xab, 0x24, 0xc3, 0x3f, 0x13, 0x8e,
-	0xfe, 0xa4, 0x00, 0x97, 0xa5, 0x06, 0xbc, 0xf8, 0x70, 0xf4, 0x81, 0x66, 0xc8, 0xbb, 0x6a, 0x77,
-	0x2a, 0xd0, 0xfc, 0xe1, 0x19, 0x29, 0xd0, 0x1c, 0x80, 0xb0, 0x40, 0xa1, 0x75, 0xb5, 0x47, 0x67,
-	0x39, 0xa1, 0xf3, 0x29, 0x4b, 0x34, 0x30, 0x46, 0x17, 0x7c, 0xe8, 0xe5, 0xaf, 0x83, 0xb5, 0xea,
-	0xb7, 0x5a, 0xab, 0x7e, 0x6b, 0xdf, 0x3d, 0xe8, 0xf0, 0x8e, 0xae, 0x2e, 0x5a, 0x92, 0x9c, 0xad,
-	0x5b, 0x79, 0x48, 0xbc, 0x4e, 0xa1, 0x72, 0xdd, 0xa6, 0x31, 0x08, 0x67, 0x88, 0x49, 0xd4, 0x4e,
-	0x5f, 0xaa, 0x31, 0x34, 0xc2, 0xed, 0xf6, 0x52, 0xcd, 0x70, 0x5b, 0xf1, 0x2b, 0x25, 0x00, 0xc9,
-	0x91, 0xac, 0x71, 0x86, 0x50, 0xb3, 0x07, 0x74, 0x8d, 0x33, 0x02, 0xfd, 0xca, 0xa1, 0x84, 0x21,
-	0xac, 0x10, 0x58, 0xf7, 0x61, 0x26, 0x08, 0xfd, 0x23, 0xb7, 0x21, 0xb2, 0x10, 0xca, 0xb6, 0xd0,
-	0x0e, 0x47, 0x70, 0x4e, 0x8b, 0xe2, 0x0e, 0x91, 0x84, 0x22, 0xac, 0x11, 0x91, 0x36, 0x35, 0x42,
-	0xf7, 0x48, 0xf0, 0x52, 0x1e, 0xe5, 0x5c, 0xa3, 0x60, 0xbd, 0x4d, 0x12, 0x86, 0xb0, 0x42, 0x40,
-	0x2f, 0x06, 0x84, 0x4e, 0xc3, 0x69, 0xc5, 0xae, 0xdd, 0xcc, 0xfc, 0xa2, 0xf0, 0x6a, 0x82, 0xd2,
-	0x2f, 0x06, 0xe8, 0x70, 0x84, 0x53, 0x84, 0xa4, 0x6d, 0xec, 0x5e, 0x9a, 0x7a, 0xd5, 0x80, 0xb6,
-	0x8d, 0x5d, 0x35, 0xd3, 0xdb, 0x26, 0x61, 0x08, 0x2b, 0x04, 0xc8, 0x83, 0x4b, 0x72, 0x0e, 0x94,
-	0x65, 0xf0, 0x08, 0xe8, 0x84, 0xd5, 0xb2, 0x53, 0x92, 0xdc, 0x66, 0xd0, 0xa6, 0x45, 0xb9, 0xcd,
-	0xa0, 0x4e, 0x4d, 0x8a, 0x10, 0x7d, 0x0f, 0x66, 0x59, 0xe5, 0x89, 0xc0, 0xbd, 0xa7, 0x49, 0xfd,
-	0xa2, 0xe1, 0x72, 0x5d, 0xae, 0x17, 0x30, 0xd0, 0xc7, 0x60, 0x11, 0x91, 0x4e, 0x71, 0x5f, 0xd7,
-	0xc5, 0x79, 0x70, 0xf6, 0xbf, 0x59, 0x04, 0x71, 0x85, 0x2f, 0x35, 0xf0, 0x85, 0x81, 0x06, 0x7e,
-	0xc8, 0x82, 0xda, 0x86, 0x45, 0x79, 0x0f, 0x4c, 0xbe, 0x43, 0xd6, 0x75, 0xb7, 0x98, 0x2e, 0x61,
-	0xf1, 0xa5, 0x3c, 0x3f, 0x76, 0x59, 0xbf, 0x10, 0x26, 0x1f, 0x20, 0xcb, 0x10, 0xa3, 0xef, 0xc1,
-	0x3c, 0xeb, 0x92, 0x22, 0x39, 0x9d, 0x87, 0x27, 0x34, 0x0c, 0x4f, 0xa8, 0x0e, 0x8f, 0xf2, 0xf1,
-	0x8b, 0x54, 0x45, 0xee, 0xbb, 0x07, 0x5a, 0xbc, 0xf0, 0xdd, 0xee, 0x2a, 0x92, 0x93, 0xb3, 0x19,
-	0x4d, 0x54, 0xd2, 0x4c, 0x22, 0x9a, 0x54, 0x11, 0x71, 0x04, 0x72, 0x12, 0x1d, 0x98, 0xae, 0xe5,
-	0x5e, 0x0f, 0x1d, 0xd8, 0x57, 0x35, 0x7f, 0xa7, 0x00, 0x20, 0xcb, 0x9c, 0xc3, 0x69, 0xf0, 0x7e,
-	0x13, 0x54, 0xa8, 0x0e, 0x8b, 0xac, 0x41, 0xba, 0x43, 0x70, 0x5f, 0x1b, 0xdb, 0x65, 0x43, 0xa7,
-	0x93, 0xa3, 0x7e, 0x39, 0xec, 0xb4, 0x0b, 0x93, 0x49, 0xa1, 0xfe, 0xee, 0x82, 0x25, 0xfd, 0x29,
-	0xe6, 0xec, 0xcf, 0x0e, 0xcc, 0x67, 0xd4, 0xd7, 0x3b, 0x74, 0xf7, 0x8d, 0x68, 0xae, 0x64, 0xb4,
-	0xa9, 0xbb, 0xcd, 0x80, 0xea, 0x8d, 0x1f, 0x01, 0x41, 0x38, 0x41, 0xa2, 0x00, 0x2e, 0x6f, 0xb4,
-	0xa2, 0xc0, 0xa9, 0x93, 0xb8, 0x35, 0xd4, 0x64, 0xe3, 0x91, 0x36, 0x4a, 0x7a, 0xba, 0x33, 0x55,
-	0x86, 0xd5, 0x18, 0x3a, 0x91, 0xdf, 0x0e, 0xeb, 0x4a, 0xea, 0x5b, 0x40, 0x10, 0x4e, 0x90, 0xa8,
-	0x0d, 0xd7, 0x88, 0x30, 0x76, 0xaa, 0x75, 0x57, 0x97, 0xc8, 0xa1, 0x55, 0xfb, 0xcf, 0x4b, 0x30,
-	0x97, 0x2a, 0x6e, 0xfd, 0x32, 0xcc, 0x0b, 0x7c, 0x54, 0xf3, 0x5b, 0xb5, 0x7a, 0x14, 0xf0, 0x6a,
-	0x5f, 0x4e, 0x3b, 0x70, 0x21, 0xe6, 0x84, 0xdb, 0xad, 0xd5, 0x28, 0xd8, 0x0e, 0xd9, 0x23, 0x0f,
-	0xcc, 0x42, 0x24, 0x3c, 0x28, 0x4e, 0x5a, 0x08, 0x1d, 0x8e, 0x70, 0x8a, 0xd0, 0xfa, 0xd5, 0x02,
-	0x2c, 0x6a, 0xf5, 0x47, 0x94, 0x29, 0xdf, 0xe6, 0xcd, 0xdb, 0x04, 0x7a, 0x2b, 0x5d, 0xe1, 0xcc,
-	0xc0, 0xf2, 0x56, 0x7a, 0x06, 0x85, 0x70, 0x96, 0xdc, 0xfa, 0xcd, 0x02, 0x2c, 0x6b, 0x6d, 0x49,
-	0xaa, 0xe6, 0x9a, 0xf5, 0x4b, 0x5d, 0x9a, 0xf3, 0x50, 0xc0, 0xd9, 0x03, 0xa9, 0x0a, 0xf7, 0x04,
-	0x23, 0x1f, 0x48, 0x35, 0x61, 0x11, 0x36, 0x16, 0x42, 0x7f, 0xbb, 0x40, 0x4f, 0x55, 0x9a, 0x7b,
-	0x9e, 0x4f, 0xc1, 0xf0, 0x87, 0x6b, 0xf9, 0xcd, 0x89, 0xc4, 0x79, 0x15, 0x0f, 0xd7, 0x6e, 0x51,
-	0xf8, 0x46, 0x43, 0x7b, 0xb8, 0x56, 0x00, 0xd9, 0xc3, 0xb5, 0xc9, 0xd7, 0x3f, 0x2e, 0x52, 0x8f,
-	0xdc, 0xd4, 0xf1, 0x8b, 0x6e, 0x8b, 0xf4, 0xdd, 0x4b, 0x79, 0x7c, 0xf7, 0xaf, 0xc1, 0x88, 0xf2,
-	0x20, 0x0b, 0x25, 0xe6, 0x3f, 0x3c, 0xc7, 0x89, 0xd9, 0xaf, 0xce, 0x51, 0xa0, 0xf5, 0x2e, 0x00,
-	0x7f, 0xf9, 0xf6, 0xd0, 0x39, 0xe6, 0xce, 0x16, 0xcd, 0xce, 0x30, 0xe8, 0x3d, 0xba, 0x93, 0xcc,
-	0xb3, 0x33, 0x09, 0x08, 0x61, 0x89, 0x46, 0x4d, 0x58, 0xe2, 0x4b, 0x2d, 0x75, 0xdc, 0xbd, 0xaa,
-	0xa9, 0x94, 0xab, 0xa6, 0xb5, 0xcd, 0xa3, 0xd3, 0x3e, 0x56, 0xf6, 0x27, 0x2c, 0x5b, 0x6f, 0xae,
-	0xf1, 0x61, 0xb7, 0x6c, 0xfd, 0xc0, 0x55, 0xfe, 0x93, 0x12, 0xcc, 0x68, 0x85, 0xad, 0xbf, 0xda,
-	0x51, 0x95, 0xe8, 0x0b, 0x67, 0xd7, 0x3b, 0x07, 0x45, 0xf2, 0xa3, 0xae, 0x8a, 0x24, 0x5f, 0x03,
-	0x86, 0xa3, 0x46, 0x7e, 0xa3, 0x97, 0x1a, 0x41, 0x1d, 0x1b, 0x73, 0x6e, 0x4a, 0xe4, 0x57, 0x0b,
-	0x70, 0xb9, 0x43, 0xaf, 0x2f, 0x5c, 0x85, 0xfc, 0x69, 0x11, 0x96, 0x8c, 0x9d, 0xfe, 0x9c, 0x2b,
-	0x10, 0x25, 0xf8, 0x1f, 0xc9, 0x9f, 0x14, 0x11, 0x6a, 0x67, 0xb4, 0x7f, 0xb5, 0x33, 0x36, 0x80,
-	0xda, 0xf9, 0xad, 0x02, 0x2c, 0xf0, 0x55, 0x79, 0xee, 0xbf, 0xe0, 0x2a, 0xba, 0x56, 0xcc, 0xd1,
-	0x35, 0xb4, 0x0e, 0x16, 0x7b, 0xf5, 0x5b, 0x53, 0x4d, 0xaf, 0x2b, 0xca, 0x90, 0x0f, 0x28, 0xeb,
-	0x8b, 0x1c, 0x50, 0xf6, 0x8d, 0x30, 0x47, 0xa0, 0xfb, 0xcc, 0x91, 0x37, 0x30, 0xbb, 0xa9, 0xea,
-	0xb9, 0x9c, 0xdc, 0xde, 0x86, 0x79, 0xc6, 0x49, 0x19, 0xad, 0xbc, 0x27, 0x87, 0x6e, 0xfe, 0xb7,
-	0x12, 0x14, 0xb7, 0xaa, 0xd6, 0x3a, 0x4c, 0x30, 0xdf, 0x7a, 0xab, 0x6a, 0xe9, 0xbe, 0xda, 0x56,
-	0x55, 0x73, 0xba, 0xaf, 0x5e, 0x4b, 0x61, 0xd5, 0xe6, 0xa3, 0xe7, 0xac, 0xef, 0xc0, 0x18, 0xe9,
-	0xda, 0x56, 0xd5, 0xd2, 0x37, 0x88, 0xee, 0x7a, 0x41, 0x7c, 0x7c, 0x55, 0xff, 0x85, 0x0c, 0x46,
-	0x98, 0x62, 0xf0, 0x6d, 0x98, 0xe0, 0xf0, 0x86, 0x91, 0xc5, 0xb5, 0x0c, 0x8b, 0x8d, 0x86, 0x52,
-	0x7c, 0x05, 0x46, 0xd7, 0x1d, 0x52, 0xfd, 0x95, 0x54, 0x3b, 0xe5, 0xe0, 0xf4, 0xea, 0xc2, 0x5d,
-	0x98, 0x58, 0x73, 0x9a, 0x0e, 0x1d, 0x8b, 0x2e, 0x5c, 0x52, 0x07, 0x07, 0xd8, 0x35, 0x47, 0xad,
-	0x25, 0x53, 0x8c, 0xcd, 0x4a, 0xb3, 0xd9, 0x61, 0x38, 0x7a, 0xb1, 0x58, 0x85, 0xf1, 0xd5, 0xc7,
-	0x4e, 0xfd, 0xb0, 0x9f, 0xee, 0xdc, 0x7d, 0xea, 0x46, 0x71, 0x24, 0x99, 0xdc, 0xfc, 0xf3, 0x1b,
-	0x30, 0xb2, 0xb9, 0xba, 0x81, 0xad, 0x6d, 0x98, 0xa1, 0xdc, 0x84, 0xda, 0xb2, 0x2a, 0xa9, 0xdc,
-	0x02, 0x03, 0xe7, 0xe6, 0x6c, 0x7d, 0x1f, 0x16, 0x99, 0x6c, 0xd0, 0x57, 0xa2, 0x3e, 0x70, 0xe3,
-	0xc7, 0xd4, 0x86, 0xa6, 0x7f, 0x06, 0x85, 0xbd, 0x21, 0x45, 0xc7, 0x98, 0xb1, 0x7d, 0xa1, 0x33,
-	0x81, 0xc2, 0x7b, 0x21, 0xcd, 0x7b, 0xcd, 0x7a, 0xd1, 0x54, 0x50, 0x17, 0xcf, 0x3c, 0xbc, 0x3f,
-	0x80, 0x49, 0x2a, 0x37, 0x04, 0x65, 0x21, 0xe3, 0x20, 0x68, 0x79, 0xda, 0xab, 0x5f, 0xca, 0xc8,
-	0x9c, 0x99, 0xf1, 0x0e, 0x4c, 0x25, 0x8c, 0x37, 0x1a, 0xb9, 0x58, 0xf7, 0x10, 0xe7, 0x6d, 0x98,
-	0x58, 0x77, 0x78, 0x4b, 0x7b, 0x4e, 0x57, 0x9e, 0xbe, 0x6f, 0x09, 0xa9, 0xcc, 0xc9, 0xb3, 0x97,
-	0x88, 0x3e, 0x84, 0xd9, 0x44, 0xca, 0xf3, 0x0f, 0x68, 0x2f, 0xae, 0x3f, 0x80, 0xd9, 0x75, 0x27,
-	0x66, 0xbf, 0xe4, 0x6d, 0xe2, 0xaa, 0x60, 0x3a, 0x4e, 0x13, 0x73, 0x0d, 0x4c, 0x63, 0xe0, 0xc0,
-	0x1c, 0x19, 0x68, 0x95, 0xfd, 0xcb, 0x9d, 0xd8, 0xeb, 0x3f, 0x5b, 0x7e, 0xf5, 0x2b, 0x99, 0xe9,
-	0xea, 0x5c, 0xcd, 0x36, 0xc0, 0x7b, 0x4e, 0x5c, 0x7f, 0xcc, 0x6a, 0xd0, 0x65, 0x57, 0x22, 0xfa,
-	0x18, 0x95, 0x0f, 0x61, 0xaa, 0xea, 0xd8, 0xa1, 0xe0, 0xa8, 0x0f, 0x89, 0x82, 0x19, 0x40, 0x72,
-	0x1f, 0xc2, 0xd4, 0xa3, 0xa0, 0x21, 0x96, 0x5b, 0x66, 0xa1, 0x29, 0xb8, 0xfe, 0x16, 0xda, 0x34,
-	0x5b, 0x9d, 0x55, 0xfa, 0x32, 0x49, 0xaa, 0xc5, 0x0f, 0xf7, 0x18, 0x58, 0x5f, 0xc0, 0x2f, 0x1a,
-	0x69, 0x52, 0x8c, 0x3f, 0x04, 0xa0, 0x63, 0x6f, 0x62, 0x6b, 0x96, 0xb8, 0x2f, 0x1b, 0x06, 0xc2,
-	0xc8, 0xfa, 0x01, 0x4c, 0x4b, 0xd6, 0xc3, 0x59, 0xc4, 0x0f, 0x60, 0x72, 0xdd, 0x11, 0x8d, 0xed,
-	0xb9, 0xe2, 0x72, 0x0d, 0xc0, 0x36, 0x4c, 0xb3, 0x65, 0x97, 0x97, 0x6b, 0x2f, 0xd9, 0x7a, 0x04,
-	0x73, 0xc9, 0x3a, 0xee, 0x63, 0x58, 0x7b, 0xb1, 0xfd, 0x00, 0x2c, 0x2e, 0x01, 0x81, 0x53, 0x4f,
-	0x2c, 0xc4, 0x8d, 0x0e, 0x77, 0xb8, 0x04, 0xd7, 0x4a, 0x47, 0x7c, 0xc2, 0xf8, 0x63, 0x58, 0xd6,
-	0x19, 0x27, 0x4f, 0x38, 0xbe, 0x60, 0x28, 0xac, 0x8b, 0x58, 0x0e, 0xf6, 0x8f, 0x98, 0x17, 0x42,
-	0x30, 0xb9, 0xc6, 0xe1, 0x25, 0x93, 0x78, 0x65, 0xd9, 0x6e, 0x73, 0xb9, 0x65, 0x4f, 0x1e, 0x0d,
-	0x41, 0xb4, 0x36, 0x61, 0x9c, 0x88, 0x16, 0x69, 0x66, 0x4f, 0x11, 0xc8, 0xd1, 0xed, 0x4d, 0x00,
-	0x2e, 0x56, 0xb9, 0x38, 0xf6, 0x9a, 0xfd, 0x2a, 0xcc, 0x48, 0xa1, 0xca, 0x3b, 0x94, 0xbd, 0xb5,
-	0xe0, 0x4c, 0x62, 0x1b, 0x28, 0xd3, 0x17, 0x0d, 0xba, 0x9b, 0x20, 0x3a, 0x4e, 0x0f, 0xff, 0xe5,
-	0x90, 0x6c, 0xf7, 0xf7, 0x60, 0x56, 0x1a, 0x06, 0xca, 0xfb, 0xcb, 0x1d, 0x78, 0xa7, 0xcc, 0xc2,
-	0x2b, 0x1d, 0xcc, 0x82, 0x71, 0x88, 0x27, 0xa9, 0xf2, 0xa7, 0xec, 0x5f, 0xc8, 0x1a, 0x85, 0x54,
-	0xcb, 0x7b, 0x0f, 0x31, 0xbf, 0x02, 0x43, 0xf9, 0xf5, 0x5a, 0x58, 0x39, 0xc5, 0xb4, 0x0e, 0x96,
-	0x64, 0x1a, 0xdd, 0x39, 0x66, 0x3f, 0x3f, 0xfb, 0xb2, 0xe1, 0x3e, 0x8d, 0x4a, 0xd0, 0x67, 0x25,
-	0x0f, 0x60, 0x32, 0xf9, 0xe9, 0x59, 0x2b, 0xf5, 0xfb, 0x5b, 0xa9, 0x9f, 0xa4, 0xcd, 0xcf, 0x12,
-	0x98, 0xa5, 0x32, 0x0c, 0xae, 0x30, 0x62, 0x04, 0xd5, 0xc7, 0x8a, 0x68, 0x0a, 0x1f, 0x57, 0x7b,
-	0x01, 0xd4, 0xfa, 0x6a, 0xb7, 0x9f, 0xfa, 0xd3, 0xb5, 0xcd, 0x57, 0x7a, 0xfd, 0x9e, 0xab, 0x52,
-	0xdb, 0x01, 0x2c, 0x50, 0xe1, 0xd1, 0xea, 0xca, 0xb3, 0x68, 0xbe, 0x6e, 0x1a, 0xa0, 0x2e, 0x15,
-	0x7d, 0x8f, 0xdd, 0x03, 0x49, 0xff, 0x02, 0xe8, 0x10, 0x34, 0x52, 0x0d, 0xe6, 0x89, 0x46, 0xd2,
-	0x7a, 0xd0, 0x53, 0x91, 0xf4, 0x33, 0x46, 0xbb, 0xb0, 0xc8, 0x75, 0x54, 0x7f, 0x75, 0xf4, 0xf6,
-	0x39, 0x97, 0xa5, 0xb2, 0xea, 0x7b, 0x02, 0x7a, 0x71, 0x7f, 0x00, 0xc0, 0xc4, 0x62, 0x77, 0xcb,
-	0x89, 0x33, 0xa2, 0x99, 0xf9, 0xa5, 0xab, 0x8c, 0x68, 0xa6, 0x7f, 0x0b, 0x50, 0xda, 0x28, 0xca,
-	0x70, 0x50, 0x1b, 0x65, 0x60, 0xcb, 0x6d, 0x14, 0xff, 0xc1, 0xb8, 0xa1, 0xd9, 0x28, 0xda, 0xcc,
-	0xbe, 0x6d, 0x94, 0xa1, 0x7d, 0x89, 0x8d, 0xca, 0xc7, 0xb1, 0x1f, 0x1b, 0x95, 0x7b, 0x28, 0x7b,
-	0x30, 0xbd, 0xf9, 0x67, 0x4b, 0x34, 0xe6, 0xae, 0xca, 0x69, 0xdf, 0xac, 0xbb, 0x51, 0x66, 0xda,
-	0x33, 0xef, 0x93, 0x66, 0xfa, 0x9f, 0xfe, 0x5d, 0x26, 0xda, 0xe0, 0x09, 0x71, 0x3e, 0xd5, 0xc8,
-	0xb0, 0xf7, 0xa4, 0x1b, 0x98, 0x6e, 0xb2, 0x49, 0xdf, 0x64, 0x09, 0xbf, 0xde, 0x6c, 0x7b, 0x86,
-	0xad, 0x53, 0xab, 0x7e, 0x2b, 0x0e, 0xfd, 0x66, 0xe7, 0x66, 0xaa, 0xaf, 0x87, 0xf4, 0x9c, 0xa5,
-	0x1a, 0xb3, 0xcc, 0xf2, 0x4d, 0xbd, 0x1c, 0x6d, 0xfc, 0x6a, 0x87, 0xae, 0x67, 0xdf, 0xff, 0xa3,
-	0x8e, 0x2a, 0xf1, 0x2a, 0x14, 0xfe, 0xd7, 0x0d, 0xfc, 0x3b, 0xc6, 0x13, 0x5d, 0x18, 0x6f, 0xc3,
-	0x14, 0x67, 0x4c, 0x5d, 0xdf, 0x1e, 0x6c, 0x73, 0xcc, 0xff, 0x7d, 0x16, 0xa0, 0x10, 0x0c, 0x7d,
-	0x20, 0xad, 0x07, 0xc7, 0x1e, 0x33, 0x75, 0x4f, 0xac, 0x26, 0x3a, 0x51, 0x3d, 0x78, 0xf5, 0x56,
-	0x72, 0x72, 0x2d, 0xe5, 0x94, 0xcf, 0x5e, 0x2c, 0xb7, 0x45, 0x08, 0x49, 0xfb, 0xbb, 0x69, 0x65,
-	0x9f, 0x3e, 0xd1, 0x17, 0xd0, 0x75, 0xe3, 0x29, 0x49, 0x85, 0xe1, 0x47, 0x22, 0xb1, 0xc4, 0x18,
-	0x32, 0x0d, 0xff, 0x52, 0xa6, 0x94, 0xc1, 0x90, 0xe7, 0x98, 0x9b, 0x6d, 0x98, 0x51, 0xe4, 0xde,
-	0xd8, 0xdc, 0xfe, 0xe4, 0xfe, 0x21, 0xcc, 0x71, 0xe9, 0xd9, 0xdd, 0xe4, 0x82, 0x99, 0x7d, 0x6b,
-	0x48, 0x19, 0x4e, 0xd4, 0xe5, 0x21, 0x22, 0x75, 0xb5, 0xcf, 0x24, 0x5c, 0xa9, 0x54, 0x76, 0xe5,
-	0xd9, 0x73, 0x48, 0xef, 0x89, 0x60, 0x94, 0x77, 0xba, 0x2b, 0xb7, 0x5e, 0x3d, 0xde, 0x83, 0x99,
-	0xe4, 0x46, 0x3d, 0x95, 0xa1, 0x57, 0x3a, 0xbf, 0xab, 0xa1, 0xcf, 0xcf, 0xcb, 0xdd, 0xdf, 0xe3,
-	0xd1, 0xb4, 0xc9, 0x94, 0xbc, 0xb5, 0xbf, 0x99, 0x72, 0xe6, 0xba, 0xdd, 0xe9, 0xcf, 0xeb, 0x88,
-	0xee, 0xc0, 0x38, 0xbf, 0xa8, 0x97, 0x8a, 0x4e, 0x4c, 0x37, 0x45, 0x53, 0xa9, 0x14, 0xc3, 0xfd,
-	0x5c, 0x2a, 0x59, 0x93, 0x1c, 0xb8, 0xeb, 0xa5, 0xc4, 0xd5, 0x7c, 0x7b, 0x33, 0xb5, 0xf0, 0xab,
-	0x71, 0xe8, 0xb6, 0x0e, 0x14, 0x86, 0x2e, 0x5c, 0xe3, 0x17, 0x0f, 0x93, 0x6b, 0x37, 0xf4, 0x36,
-	0xe2, 0x43, 0x3f, 0x6f, 0xb3, 0xb3, 0x29, 0x15, 0xd3, 0x75, 0x46, 0x6a, 0xb1, 0xa6, 0xd7, 0x1d,
-	0x79, 0x0d, 0x2b, 0x95, 0xcb, 0x56, 0x2f, 0xbf, 0xa4, 0xe6, 0xb0, 0xe3, 0xed, 0x2d, 0x1a, 0x06,
-	0x92, 0x95, 0xb1, 0xa2, 0x34, 0x3f, 0xb5, 0x71, 0x91, 0xba, 0x07, 0xd4, 0x07, 0xeb, 0x03, 0xb8,
-	0xb2, 0x91, 0xbc, 0x1f, 0xea, 0xc6, 0x7e, 0x78, 0x5e, 0x03, 0xc3, 0xd2, 0x9c, 0xbc, 0x92, 0x35,
-	0x3b, 0xb6, 0x53, 0xfa, 0x22, 0x73, 0xd7, 0x2e, 0xe5, 0x28, 0x77, 0xb9, 0xc4, 0x89, 0x9e, 0xb3,
-	0x36, 0x60, 0x92, 0xe6, 0xfb, 0xf3, 0x68, 0xf6, 0x1e, 0x99, 0xfe, 0xbb, 0x7c, 0x23, 0x62, 0xd7,
-	0xeb, 0xbe, 0xb8, 0x7b, 0xb0, 0xa9, 0xc1, 0xbc, 0xd4, 0xbd, 0xfc, 0xba, 0xc6, 0x97, 0x3a, 0x9c,
-	0xb1, 0xee, 0xb6, 0xee, 0xcc, 0x77, 0x73, 0xd0, 0x73, 0x96, 0x2d, 0xdd, 0x84, 0x1e, 0xec, 0x75,
-	0x2b, 0x94, 0x8d, 0xdf, 0x3b, 0x56, 0xf1, 0x61, 0xa2, 0x3b, 0x79, 0x0d, 0x2f, 0x76, 0xa8, 0xa1,
-	0xa3, 0x13, 0xd6, 0x91, 0xf5, 0x23, 0x98, 0x97, 0x7a, 0x34, 0x3f, 0xf7, 0x5e, 0x1a, 0xf5, 0x23,
-	0x11, 0x30, 0x71, 0xab, 0xdc, 0xd7, 0xc8, 0xf4, 0xf2, 0x74, 0xff, 0xf5, 0x24, 0x8c, 0x3f, 0x8a,
-	0xdd, 0xa6, 0x1b, 0x1f, 0x5b, 0xf7, 0xd8, 0xe8, 0x2b, 0x27, 0xa4, 0x4d, 0x9b, 0x5e, 0x59, 0x15,
-	0x9a, 0x3d, 0xd4, 0x4d, 0x07, 0x83, 0x8c, 0xb3, 0xc2, 0xeb, 0xc5, 0x0e, 0x07, 0xbb, 0x3b, 0x7a,
-	0x4f, 0x46, 0xb6, 0xab, 0xcc, 0xd1, 0xe5, 0x07, 0x63, 0xf3, 0xed, 0x51, 0xea, 0x27, 0x74, 0xd9,
-	0xca, 0x5a, 0x77, 0x04, 0x8f, 0xeb, 0x86, 0x13, 0xba, 0x1d, 0x97, 0x44, 0x86, 0x55, 0x55, 0xf8,
-	0x37, 0xbc, 0x97, 0x2f, 0x18, 0x4e, 0x31, 0x76, 0x73, 0x43, 0xb2, 0x87, 0x41, 0xd1, 0x73, 0xd6,
-	0x3a, 0xeb, 0x64, 0xbf, 0x93, 0x90, 0x65, 0xb4, 0x49, 0x3b, 0xca, 0xf9, 0x5c, 0x37, 0x54, 0xdc,
-	0x6d, 0xf0, 0xb3, 0xec, 0xee, 0x01, 0x6c, 0xb4, 0xdc, 0x9c, 0xfc, 0x7a, 0x6f, 0x8e, 0xce, 0x10,
-	0x66, 0x2b, 0xcd, 0x66, 0x97, 0x7e, 0xf6, 0x62, 0xf2, 0x57, 0xe0, 0x92, 0x72, 0x9a, 0x50, 0x04,
-	0x7b, 0x51, 0x4a, 0x0f, 0x67, 0x4e, 0x23, 0xa4, 0xf6, 0x55, 0x3a, 0x1c, 0x82, 0xa4, 0xdb, 0x98,
-	0x56, 0x72, 0xc0, 0x28, 0x3f, 0x77, 0xd4, 0xf9, 0x78, 0x93, 0xc2, 0x1b, 0xb3, 0x59, 0x66, 0x7b,
-	0xff, 0xa9, 0xd1, 0x4c, 0x1f, 0x08, 0x30, 0x4c, 0x78, 0xf6, 0xf4, 0x41, 0x32, 0xe1, 0xf9, 0x58,
-	0x56, 0x0c, 0xe8, 0x0c, 0x3b, 0xee, 0x19, 0xe6, 0xe3, 0xd8, 0x6b, 0xb6, 0x76, 0x94, 0x4d, 0x8a,
-	0xa1, 0x70, 0xbc, 0x33, 0xff, 0xd3, 0xcf, 0x6e, 0x14, 0xfe, 0xe4, 0xb3, 0x1b, 0x85, 0xff, 0xfe,
-	0xd9, 0x8d, 0xc2, 0xdf, 0xfd, 0x1f, 0x37, 0x9e, 0xdb, 0x1b, 0x0b, 0x42, 0x3f, 0xf6, 0xdf, 0xf8,
-	0xff, 0x01, 0x00, 0x00, 0xff, 0xff, 0x2b, 0x70, 0xaf, 0x0a, 0x46, 0xc5, 0x00, 0x00,
+	// 12349 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x7d, 0x6d, 0x8c, 0x24, 0xc9,
+	0x95, 0x90, 0xab, 0xaa, 0x3f, 0xa3, 0xbf, 0xb3, 0x7b, 0x66, 0x6a, 0x66, 0x76, 0xa7, 0x67, 0x63,
+	0x6d, 0xaf, 0x8d, 0x7d, 0xf6, 0x7e, 0x7a, 0x77, 0xfc, 0x21, 0x7b, 0xa6, 0x7b, 0xb6, 0xb7, 0x77,
+	0xa6, 0x7b, 0x7a, 0xa2, 0x66, 0x7a, 0xbd, 0x5e, 0xaf, 0xcb, 0xd9, 0x55, 0x39, 0xd5, 0xe9, 0xa9,
+	0xac, 0xcc, 0xcd, 0xcc, 0xaa, 0x99, 0xde, 0xe3, 0xf8, 0x71, 0x46, 0x32, 0x07, 0x1c, 0x70, 0x3e,
+	0x69, 0x85, 0xad, 0x93, 0x4e, 0x1c, 0x02, 0x1d, 0x08, 0x21, 0xc4, 0x87, 0xd0, 0xe9, 0x40, 0x77,
+	0xe8, 0xf8, 0xe1, 0x1f, 0xc0, 0xdd, 0x8f, 0x03, 0xc4, 0x09, 0x1a, 0x30, 0x42, 0x88, 0x91, 0x4e,
+	0x82, 0x85, 0x3f, 0x20, 0x7e, 0xa0, 0x17, 0x1f, 0x19, 0x2f, 0x32, 0xb3, 0x3e, 0xbb, 0xba, 0x6f,
+	0x57, 0xfe, 0xd3, 0x5d, 0xf1, 0xde, 0x8b, 0x17, 0x91, 0xf1, 0xf1, 0xe2, 0xc5, 0x7b, 0x11, 0x2f,
+	0xc8, 0xd3, 0xb5, 0x83, 0xb8, 0xed, 0x1d, 0x34, 0x9d, 0x83, 0x76, 0xe3, 0x8b, 0xe8, 0xf7, 0x17,
+	0x82, 0xd0, 0x8f, 0x7d, 0x6b, 0x0e, 0x81, 0x2e, 0xad, 0x35, 0xfc, 0x86, 0xcf, 0xe1, 0x5f, 0x84,
+	0x5f, 0x82, 0x84, 0x4e, 0x93, 0xc9, 0x9b, 0x5e, 0x10, 0x1f, 0xd1, 0x3a, 0x99, 0xb9, 0xe5, 0x1c,
+	0xed, 0xdb, 0xcd, 0xb6, 0x63, 0x3d, 0x47, 0x4a, 0x0f, 0x9d, 0xa3, 0x72, 0xe1, 0x6a, 0xe1, 0x33,
+	0xb3, 0x37, 0xce, 0x3d, 0x39, 0x5e, 0x2f, 0xdd, 0x72, 0x8e, 0x3e, 0x3c, 0x5e, 0x27, 0x47, 0xb6,
+	0xd7, 0xfc, 0x32, 0xbd, 0xe5, 0x1c, 0x51, 0x06, 0x20, 0xeb, 0x8b, 0x64, 0xb2, 0x03, 0x39, 0xca,
+	0x45, 0x4e, 0x7a, 0xf1, 0xc9, 0xf1, 0xfa, 0x24, 0x67, 0xf1, 0xe1, 0xf1, 0xfa, 0xbc, 0x20, 0xe6,
+	0x49, 0xca, 0x04, 0x98, 0x1e, 0x91, 0xd2, 0xf6, 0xf6, 0xa6, 0xf5, 0x32, 0x99, 0x6e, 0xd9, 0x9e,
+	0x53, 0x75, 0xeb, 0xb2, 0x90, 0xcb, 0x4f, 0x8e, 0xd7, 0xa7, 0x76, 0x6d, 0xcf, 0xd9, 0xae, 0x7f,
+	0x78, 0xbc, 0xbe, 0x20, 0xb2, 0x8a, 0x34, 0x65, 0x12, 0x61, 0x7d, 0x95, 0xcc, 0x46, 0x47, 0x51,
+	0xec, 0x78, 0x90, 0x4f, 0x94, 0xb8, 0xfe, 0xe4, 0x78, 0x7d, 0xa6, 0xc2, 0x81, 0x3c, 0xe7, 0x92,
+	0xc8, 0xa9, 0x20, 0x94, 0x25, 0x48, 0xfa, 0x3a, 0x59, 0xba, 0xe1, 0xfb, 0x4d, 0xc7, 0x6e, 0x31,
+	0x27, 0x0a, 0xfc, 0x56, 0xe4, 0x58, 0x2f, 0x91, 0xa9, 0xd0, 0x89, 0xda, 0xcd, 0x98, 0xd7, 0x62,
+	0x46, 0xd4, 0x82, 0x71, 0x88, 0xae, 0x85, 0x48, 0x53, 0x26, 0x11, 0xf4, 0x26, 0x59, 0xbc, 0xf9,
+	0xd8, 0x8d, 0xe2, 0x08, 0xb3, 0x71, 0x38, 0x04, 0xb3, 0x11, 0x10, 0xcd, 0x46, 0xa4, 0x29, 0x93,
+	0x08, 0x60, 0x53, 0x89, 0x43, 0xb7, 0xd5, 0xe8, 0x52, 0x9b, 0xd9, 0xc1, 0x6a, 0xf3, 0x26, 0x59,
+	0xda, 0x71, 0xa2, 0xc8, 0x6e, 0x38, 0x09, 0x9f, 0x57, 0xc9, 0xb4, 0x27, 0x40, 0x92, 0xd1, 0xd3,
+	0x4f, 0x8e, 0xd7, 0x15, 0xe8, 0xc3, 0xe3, 0xf5, 0x45, 0xc1, 0x49, 0x02, 0x28, 0x53, 0x28, 0x51,
+	0x25, 0x3b, 0x6e, 0x1b, 0x5f, 0x16, 0x71, 0x08, 0xae, 0x92, 0xa0, 0xd1, 0x55, 0x12, 0x69, 0xca,
+	0x24, 0x82, 0xde, 0x26, 0x8b, 0xbb, 0x95, 0xed, 0xd6, 0x03, 0x3f, 0x61, 0xf3, 0x65, 0x32, 0xe1,
+	0xc6, 0x8e, 0xc7, 0x99, 0xcc, 0xbd, 0xb8, 0xfa, 0x05, 0x3c, 0x52, 0x05, 0xe9, 0x8d, 0xd5, 0x27,
+	0xc7, 0xeb, 0xc5, 0x16, 0x70, 0x9d, 0x15, 0x5c, 0x5b, 0x11, 0x65, 0xc5, 0x56, 0x44, 0xef, 0x12,
+	0xeb, 0xb6, 0x1b, 0xc5, 0x29, 0x8e, 0x5f, 0x21, 0x93, 0xc0, 0x11, 0xea, 0x55, 0x1a, 0x9a, 0xe5,
+	0x5f, 0x2f, 0x90, 0x29, 0x41, 0x63, 0x3d, 0x4b, 0x8a, 0xc9, 0x18, 0xe4, 0xf4, 0x6e, 0x5d, 0xd3,
+	0xbb, 0x75, 0xca, 0x8a, 0x6e, 0xdd, 0xfa, 0x1c, 0x99, 0x80, 0xd1, 0x2a, 0x87, 0xdc, 0x85, 0x27,
+	0xc7, 0xeb, 0x3c, 0xfd, 0xe1, 0xf1, 0xfa, 0x9c, 0x64, 0x6c, 0x7b, 0x0e, 0x65, 0x1c, 0x68, 0x6d,
+	0x91, 0xb9, 0xba, 0x13, 0xd5, 0x42, 0x37, 0x88, 0x5d, 0xbf, 0x55, 0x2e, 0xf1, 0x3c, 0x9f, 0x7a,
+	0x72, 0xbc, 0x8e, 0xc1, 0x1f, 0x1e, 0xaf, 0x5b, 0x22, 0x2b, 0x02, 0x52, 0x86, 0x49, 0xe8, 0x6d,
+	0xb2, 0xb4, 0x5b, 0xd9, 0x08, 0x1d, 0x3b, 0x76, 0x98, 0xf3, 0x5e, 0xdb, 0x89, 0x62, 0xeb, 0x9a,
+	0xd1, 0x8e, 0x96, 0xf9, 0xd1, 0x11, 0x73, 0xde, 0xeb, 0xfe, 0xcd, 0xbf, 0x40, 0x26, 0x39, 0x45,
+	0xf2, 0x31, 0x85, 0x11, 0x3e, 0xa6, 0x38, 0xf2, 0xc7, 0x7c, 0x95, 0xcc, 0xef, 0x56, 0xee, 0x86,
+	0x47, 0xea, 0x4b, 0x3e, 0x4f, 0x26, 0x5b, 0x91, 0x9e, 0xfe, 0xa2, 0x1a, 0xd1, 0x76, 0x1d, 0x55,
+	0x23, 0x82, 0xe9, 0xcb, 0x81, 0xf4, 0x75, 0xb2, 0x08, 0x63, 0x60, 0xbb, 0x9e, 0xf4, 0xff, 0xcb,
+	0x64, 0xda, 0xad, 0x57, 0x9b, 0x6e, 0x14, 0xf3, 0x11, 0x20, 0x47, 0xa6, 0x5b, 0x07, 0x32, 0x3d,
+	0x32, 0x45, 0x9a, 0x32, 0x89, 0xa0, 0x3f, 0x28, 0x12, 0x8b, 0x39, 0x91, 0xdf, 0x0e, 0x6b, 0xce,
+	0xa8, 0x95, 0xb1, 0x6e, 0x93, 0x85, 0x50, 0xf2, 0xa8, 0xc6, 0x47, 0x81, 0x1a, 0x16, 0xcf, 0x3d,
+	0x39, 0x5e, 0x9f, 0x57, 0x88, 0x7b, 0x47, 0x01, 0xb4, 0xe8, 0xaa, 0xc8, 0x8d, 0xa1, 0x94, 0x19,
+	0x44, 0xd6, 0x26, 0x99, 0x4b, 0xb8, 0xb9, 0x75, 0x39, 0x5c, 0x9e, 0x7d, 0x72, 0xbc, 0x4e, 0x14,
+	0x98, 0xd7, 0x63, 0xc5, 0xe4, 0x04, 0xb5, 0x41, 0x04, 0x20, 0x87, 0x1f, 0xf8, 0x61, 0xcd, 0x29,
+	0x4f, 0x68, 0x39, 0xcc, 0x01, 0x5a, 0x0e, 0xf3, 0x24, 0x65, 0x02, 0x4c, 0xff, 0x79, 0x81, 0x9c,
+	0x53, 0x2d, 0x71, 0xbd, 0xd9, 0xfc, 0x88, 0x34, 0x46, 0xf2, 0x19, 0xa5, 0x01, 0x3f, 0xe3, 0x2f,
+	0x16, 0x88, 0x75, 0xef, 0x60, 0xdb, 0xb3, 0x1b, 0x8e, 0x10, 0x0f, 0xa3, 0x7c, 0xc3, 0x1b, 0x72,
+	0x56, 0x15, 0xf9, 0xac, 0x2a, 0x1b, 0xb3, 0x0a, 0x31, 0x17, 0xd5, 0x71, 0x3d, 0xbb, 0x81, 0xaa,
+	0xc3, 0x93, 0x94, 0x09, 0x30, 0xad, 0x92, 0x55, 0xa3, 0x36, 0x72, 0xb0, 0xbe, 0x61, 0x4c, 0xdb,
+	0x93, 0x14, 0x50, 0x27, 0x17, 0x60, 0x20, 0xe7, 0x15, 0xb2, 0x6d, 0x4a, 0xc4, 0x93, 0x94, 0xf2,
+	0xfb, 0xf3, 0x64, 0x0e, 0xe5, 0xb0, 0xbe, 0x4e, 0x66, 0x41, 0x1a, 0x44, 0x81, 0x5d, 0x53, 0x72,
+	0xe3, 0x99, 0x27, 0xc7, 0xeb, 0x1a, 0xf8, 0xe1, 0xf1, 0xfa, 0xb2, 0x16, 0x1e, 0x1c, 0x44, 0x99,
+	0x46, 0x4b, 0x29, 0x5b, 0x1c, 0x4c, 0xca, 0x96, 0x06, 0x11, 0x4c, 0xf7, 0xc8, 0x52, 0xcd, 0x6f,
+	0xb5, 0x9c, 0x1a, 0x48, 0x97, 0x2a, 0xcf, 0x27, 0x86, 0xfe, 0xe7, 0x9e, 0x1c, 0xaf, 0x2f, 0x6a,
+	0xd4, 0xae, 0xe0, 0x70, 0x4e, 0x70, 0x30, 0xe1, 0x94, 0xa5, 0x08, 0xad, 0x9b, 0x64, 0xbe, 0x16,
+	0x05, 0x55, 0xde, 0x0a, 0x30, 0x7c, 0x26, 0xf5, 0x6c, 0xac, 0x45, 0x81, 0x68, 0x10, 0x34, 0x1b,
+	0x35, 0x8c, 0x32, 0x44, 0x60, 0xed, 0x90, 0x45, 0xcd, 0x86, 0xd7, 0x6d, 0x4a, 0xcf, 0x0a, 0x45,
+	0x27, 0x6b, 0xb6, 0x6a, 0xb2, 0x12, 0xf5, 0x32, 0x88, 0xac, 0xbb, 0xa6, 0x10, 0x9e, 0xe6, 0xbc,
+	0xbe, 0xf8, 0xe4, 0x78, 0xfd, 0x1c, 0x02, 0x7f, 0xde, 0xf7, 0xa0, 0xfb, 0x83, 0xf8, 0x68, 0x00,
+	0x71, 0x6c, 0xed, 0x93, 0x85, 0x1a, 0xac, 0x2c, 0xd0, 0x78, 0x75, 0x3b, 0x76, 0xca, 0x33, 0x9c,
+	0xe9, 0x0b, 0x4f, 0x8e, 0xd7, 0xcf, 0x2b, 0xc4, 0xa6, 0x1d, 0x3b, 0x06, 0x57, 0x55, 0x55, 0x84,
+	0x87, 0xaa, 0xa2, 0xa4, 0x75, 0x83, 0xcc, 0x34, 0x60, 0x06, 0x56, 0xfd, 0xa8, 0x3c, 0x9b, 0x7c,
+	0xf3, 0x0a, 0x87, 0xdd, 0xa9, 0x18, 0xdc, 0xa4, 0x16, 0x22, 0x51, 0x94, 0x4d, 0xcb, 0x5f, 0xd6,
+	0xd7, 0x12, 0x9d, 0x83, 0x24, 0xcb, 0xcd, 0xb2, 0x80, 0x18, 0x0c, 0xa4, 0x8c, 0x8f, 0x94, 0xf6,
+	0x21, 0x7e, 0x58, 0x2d, 0xb2, 0xf8, 0xd0, 0x39, 0xaa, 0x72, 0xb5, 0x54, 0x2c, 0x10, 0x73, 0x7c,
+	0x42, 0x9c, 0x33, 0x26, 0x84, 0x52, 0x75, 0xc5, 0x27, 0x3f, 0x94, 0x29, 0x98, 0x5b, 0x79, 0x9f,
+	0x8c, 0xf1, 0x94, 0xcd, 0xe3, 0xa4, 0xe5, 0x91, 0xf3, 0x76, 0x14, 0xf9, 0x35, 0xd7, 0x8e, 0x9d,
+	0x7a, 0xd5, 0x3f, 0xf8, 0x9e, 0x53, 0x8b, 0x45, 0xb9, 0xf3, 0x7c, 0x61, 0x7a, 0xf5, 0xc9, 0xf1,
+	0xfa, 0x9a, 0xa6, 0xb8, 0xc3, 0x09, 0xe4, 0x32, 0x75, 0x59, 0xb0, 0xcf, 0xc3, 0x52, 0x96, 0x9b,
+	0xc9, 0x7a, 0x9b, 0xac, 0xb8, 0x51, 0xd5, 0x6e, 0xc7, 0x7e, 0xb5, 0xe1, 0xb4, 0x9c, 0x10, 0xd0,
+	0xe5, 0x05, 0xae, 0x76, 0xfe, 0xdc, 0x93, 0xe3, 0xf5, 0x25, 0x37, 0xba, 0xde, 0x8e, 0xfd, 0x2d,
+	0x85, 0xfa, 0xf0, 0x78, 0xfd, 0xbc, 0x9c, 0x66, 0x26, 0x82, 0xb2, 0x34, 0xa9, 0xf5, 0x3a, 0x99,
+	0xf5, 0xa3, 0xea, 0x03, 0xdb, 0x73, 0x9b, 0x47, 0xe5, 0x45, 0xde, 0xf6, 0x9f, 0x7d, 0x72, 0xbc,
+	0x6e, 0xf9, 0xd1, 0xeb, 0x1c, 0x66, 0xb4, 0x8c, 0x54, 0xb4, 0x15, 0x8e, 0xb2, 0x19, 0xf5, 0xd3,
+	0xfa, 0x0e, 0x59, 0xf2, 0xa3, 0x6a, 0xdd, 0x8d, 0xe2, 0xd0, 0x3d, 0x68, 0xf3, 0x31, 0xbb, 0xc4,
+	0xb9, 0xbd, 0xf2, 0xe4, 0x78, 0xbd, 0xec, 0x47, 0x9b, 0x08, 0x63, 0xf0, 0x3c, 0xa7, 0x78, 0x62,
+	0x0a, 0xca, 0x16, 0x4d, 0x80, 0xf5, 0x26, 0x21, 0x7e, 0x54, 0xed, 0x38, 0x61, 0x04, 0xac, 0x97,
+	0x93, 0x69, 0xbf, 0xea, 0x47, 0xfb, 0x02, 0x68, 0x70, 0x5d, 0x56, 0x5c, 0x25, 0x92, 0xb2, 0xd9,
+	0xe4, 0xb7, 0xac, 0xab, 0x1d, 0xd6, 0x0e, 0xdd, 0xd8, 0xa9, 0xc5, 0xed, 0xd0, 0x29, 0xaf, 0xe0,
+	0xba, 0x5e, 0x47, 0x98, 0xfc, 0xba, 0x62, 0x0a, 0x5e, 0x57, 0x0c, 0xb0, 0x5a, 0x64, 0xb5, 0xe3,
+	0x86, 0x71, 0xdb, 0x6e, 0xba, 0xef, 0x8b, 0xe9, 0xc6, 0x57, 0x49, 0x8b, 0x97, 0xf1, 0xb5, 0x27,
+	0xc7, 0xeb, 0x4f, 0x99, 0x68, 0x58, 0x06, 0x8d, 0x72, 0x2e, 0x8a, 0x72, 0xb2, 0x54, 0x94, 0x59,
+	0x59, 0x20, 0xf4, 0xe1, 0x81, 0xef, 0xc7, 0x55, 0xcf, 0xaf, 0x3b, 0xe5, 0x55, 0xdd, 0x87, 0x00,
+	0xdc, 0xf1, 0xeb, 0x4e, 0x5e, 0x1f, 0x2a, 0x1c, 0x65, 0x33, 0xea, 0xa7, 0xb5, 0x43, 0x48, 0xdd,
+	0x09, 0x42, 0xa7, 0xc6, 0xc7, 0xd7, 0x5a, 0x32, 0xbe, 0xd6, 0x34, 0xd4, 0x60, 0xb5, 0xa2, 0x24,
+	0x8e, 0xc2, 0x52, 0x86, 0x18, 0xd0, 0x5f, 0x2e, 0x90, 0x35, 0xb9, 0xa2, 0x98, 0x1a, 0xed, 0x70,
+	0x2b, 0xf5, 0x96, 0xb1, 0x52, 0x5f, 0xc8, 0x5b, 0xe2, 0x40, 0x09, 0xee, 0xbf, 0xc2, 0xfd, 0x7a,
+	0x91, 0x10, 0x9d, 0x61, 0x38, 0x9d, 0x38, 0x67, 0xe9, 0x29, 0x8e, 0x7f, 0xe9, 0x29, 0x8d, 0xb6,
+	0xf4, 0xa4, 0x14, 0xf6, 0x89, 0x91, 0x15, 0xf6, 0x1f, 0x17, 0xc8, 0xda, 0xeb, 0x4e, 0x5c, 0x3b,
+	0xe4, 0x9c, 0x91, 0x7e, 0x98, 0xf3, 0xf9, 0x85, 0x93, 0x7f, 0x7e, 0x32, 0x0e, 0x8a, 0x83, 0xec,
+	0x07, 0xfe, 0xe5, 0x24, 0x39, 0x57, 0x71, 0xec, 0x30, 0x5b, 0xbb, 0xe1, 0xc6, 0xd3, 0x57, 0xc8,
+	0xcc, 0x43, 0xe7, 0xe8, 0x91, 0x1f, 0xd6, 0xa3, 0x72, 0xf1, 0x6a, 0x49, 0xd9, 0x13, 0x14, 0x4c,
+	0x4f, 0x11, 0x05, 0xa1, 0x2c, 0x41, 0xe6, 0x35, 0x44, 0xe9, 0xe4, 0x0d, 0xf1, 0x55, 0x2c, 0x84,
+	0x27, 0xb4, 0x8d, 0x43, 0x49, 0xd7, 0xde, 0xa2, 0xf7, 0x5e, 0x56, 0xf4, 0x4e, 0xea, 0x3a, 0x99,
+	0x72, 0x74, 0x70, 0x81, 0xfb, 0x0d, 0x43, 0xe0, 0x4e, 0x69, 0x05, 0x30, 0x91, 0xa3, 0xfd, 0xc4,
+	0xec, 0xbd, 0xac, 0x98, 0x9d, 0xc6, 0xf5, 0xc2, 0x32, 0x73, 0x70, 0xe1, 0x5a, 0xcf, 0x17, 0xae,
+	0x42, 0x97, 0x79, 0x09, 0xc4, 0x5e, 0x56, 0x42, 0x0e, 0x2f, 0x52, 0xbf, 0x8a, 0x45, 0xea, 0xac,
+	0xee, 0x11, 0x25, 0x2b, 0x7b, 0x0b, 0xd2, 0x67, 0x49, 0xd1, 0x57, 0x9a, 0x0c, 0x57, 0x7d, 0x7d,
+	0xb4, 0x39, 0xf7, 0x61, 0x73, 0xee, 0x47, 0xb4, 0x41, 0x2e, 0x54, 0x02, 0xb7, 0xee, 0x84, 0x59,
+	0xb5, 0xfe, 0xb6, 0xb1, 0x77, 0x78, 0xca, 0x10, 0x79, 0xa9, 0x3c, 0x03, 0xc8, 0xbd, 0x26, 0xb9,
+	0x0c, 0x5a, 0x44, 0xb7, 0xc2, 0x76, 0xcc, 0x3d, 0xc4, 0x49, 0x4b, 0xfb, 0x6b, 0x45, 0xb2, 0x94,
+	0xca, 0x65, 0x5d, 0x23, 0x25, 0x57, 0x4e, 0xcf, 0xb9, 0x17, 0x97, 0x8d, 0x02, 0xb6, 0xb7, 0x37,
+	0x85, 0xb1, 0x71, 0x7b, 0xbb, 0xae, 0x8d, 0x8d, 0xdb, 0x30, 0x5d, 0x01, 0x64, 0xbd, 0x86, 0x94,
+	0xcb, 0xa2, 0x36, 0x6c, 0x6d, 0x09, 0xbd, 0x51, 0xab, 0x94, 0x5b, 0x89, 0x4a, 0x29, 0x7f, 0x21,
+	0x33, 0x56, 0x69, 0x60, 0x33, 0x96, 0x55, 0xcf, 0x28, 0x92, 0x13, 0xbd, 0x14, 0x49, 0xae, 0xdc,
+	0xdf, 0x42, 0x9a, 0xa1, 0x56, 0x1f, 0x6f, 0x99, 0xea, 0xa3, 0x91, 0x7c, 0x8f, 0x5c, 0xbc, 0xed,
+	0xfb, 0x0f, 0xdb, 0x42, 0x82, 0x03, 0xe8, 0xb4, 0x65, 0x2d, 0xfd, 0x87, 0x05, 0x72, 0x0e, 0x95,
+	0x79, 0xea, 0xb2, 0x3d, 0xbd, 0xb4, 0x15, 0x47, 0x5a, 0xda, 0xe8, 0x4f, 0xb8, 0x0e, 0x71, 0x3f,
+	0x80, 0xfd, 0x8a, 0x5a, 0xb9, 0x47, 0x90, 0xf9, 0xaf, 0x91, 0x99, 0x54, 0x4d, 0xf8, 0x28, 0x72,
+	0x93, 0x6a, 0x2c, 0xa2, 0xa1, 0x0c, 0xd9, 0x14, 0x2a, 0xd9, 0xc6, 0x97, 0xc6, 0xb0, 0x8d, 0x5f,
+	0xbb, 0x77, 0x50, 0x89, 0x0e, 0x6f, 0x39, 0x47, 0x3d, 0x26, 0xfb, 0xc5, 0x54, 0x09, 0x3a, 0x83,
+	0x18, 0xc0, 0x11, 0x4f, 0xa3, 0x9d, 0x10, 0x4f, 0xc3, 0x4e, 0x48, 0xfc, 0x70, 0x49, 0x59, 0x18,
+	0x0b, 0x72, 0x4a, 0x4a, 0xcd, 0xf4, 0x93, 0x16, 0xf5, 0x3f, 0xa6, 0xc9, 0x3c, 0xce, 0x75, 0x0a,
+	0x76, 0xd5, 0xd3, 0x59, 0x6e, 0xc7, 0xa5, 0x2f, 0x59, 0x8c, 0x2c, 0xc3, 0x20, 0x8f, 0xa2, 0xc3,
+	0x2a, 0x48, 0x0d, 0x5e, 0xbf, 0xc9, 0x44, 0xff, 0x5e, 0xa8, 0x45, 0x81, 0x68, 0x1d, 0x59, 0xbd,
+	0xb5, 0x64, 0xac, 0x6b, 0x30, 0x65, 0x26, 0x19, 0x54, 0xee, 0x81, 0xdb, 0x6a, 0x38, 0x61, 0x10,
+	0xba, 0xad, 0xb8, 0x3c, 0xa5, 0x2b, 0x87, 0xc0, 0xba, 0x72, 0x08, 0x48, 0x19, 0x26, 0x01, 0x3d,
+	0xa7, 0x1d, 0x39, 0x21, 0xaf, 0xd4, 0xb4, 0x5e, 0xc1, 0x14, 0x4c, 0xaf, 0x60, 0x0a, 0x42, 0x59,
+	0x82, 0xb4, 0xde, 0x25, 0x56, 0xc7, 0x09, 0xdd, 0x07, 0xae, 0x53, 0xaf, 0x02, 0x50, 0x7c, 0xdb,
+	0x4c, 0x62, 0x85, 0x58, 0x56, 0xd8, 0xfb, 0x9a, 0xdd, 0x05, 0xb9, 0xc4, 0xa6, 0x30, 0x94, 0x65,
+	0x88, 0x41, 0xb9, 0x08, 0xda, 0x07, 0x4d, 0xb7, 0x06, 0xed, 0x26, 0xd7, 0x57, 0xae, 0x5c, 0x08,
+	0xa8, 0x18, 0x76, 0x52, 0xb9, 0x48, 0x40, 0x94, 0x69, 0x34, 0x98, 0x50, 0x83, 0xd0, 0xed, 0xd8,
+	0xb1, 0xc3, 0x59, 0x10, 0x2d, 0x5e, 0x24, 0x58, 0xf0, 0x90, 0xe2, 0x45, 0xc3, 0x28, 0x43, 0x04,
+	0x56, 0x7d, 0x38, 0xbb, 0x01, 0x17, 0xf7, 0x0f, 0x73, 0xc5, 0xfd, 0xcf, 0x84, 0xb5, 0x80, 0xfe,
+	0xb0, 0x40, 0xce, 0xa9, 0x29, 0x7f, 0x92, 0x3d, 0xdd, 0xad, 0x9e, 0xd6, 0x57, 0xc1, 0x1f, 0x36,
+	0x75, 0x03, 0xc9, 0xa1, 0x7f, 0x57, 0x20, 0x73, 0x28, 0xd3, 0x47, 0x61, 0x63, 0x37, 0x36, 0x7f,
+	0xd0, 0xef, 0x14, 0xc8, 0xaa, 0x5a, 0xff, 0x2a, 0x81, 0x53, 0x1b, 0xad, 0xb9, 0x5f, 0x26, 0xd3,
+	0x51, 0xe0, 0xd4, 0xf4, 0xea, 0x27, 0xda, 0x35, 0x70, 0x6a, 0xd8, 0xf3, 0x2a, 0xd2, 0xd0, 0xae,
+	0xfc, 0x87, 0xb5, 0x69, 0x2c, 0x7d, 0xe9, 0x8d, 0x37, 0xd4, 0x86, 0xaf, 0x15, 0xbc, 0x6c, 0xc8,
+	0xa2, 0xcb, 0x86, 0x14, 0x65, 0x1c, 0x48, 0x7f, 0x50, 0x20, 0x2b, 0x9a, 0x7a, 0xb4, 0xfa, 0x6f,
+	0xf6, 0x34, 0x01, 0x0c, 0x5a, 0x93, 0x6f, 0x11, 0x4b, 0x13, 0x27, 0x8b, 0xe2, 0xa6, 0xb1, 0xfc,
+	0x8e, 0xca, 0xbb, 0x4a, 0xce, 0xcb, 0x65, 0x37, 0xcd, 0xff, 0xa6, 0xb9, 0xe8, 0x8e, 0x5a, 0xc0,
+	0x8f, 0x2e, 0x12, 0xa2, 0xa9, 0x7f, 0x76, 0xac, 0xf3, 0xdb, 0x64, 0x81, 0x2f, 0xb1, 0x30, 0x7c,
+	0xd1, 0xfa, 0xca, 0xe7, 0x12, 0x2c, 0x9c, 0x81, 0x53, 0x93, 0x0c, 0x2d, 0xbd, 0xba, 0x4a, 0x20,
+	0x65, 0x98, 0x04, 0x5c, 0xe4, 0x7e, 0x24, 0x76, 0x8b, 0x53, 0x5a, 0x07, 0x94, 0x20, 0xad, 0x03,
+	0x4a, 0x00, 0x65, 0x0a, 0x05, 0x2b, 0x69, 0xab, 0xed, 0x55, 0x3b, 0xb5, 0xa0, 0xcd, 0x57, 0xd2,
+	0x05, 0xb1, 0x92, 0x72, 0xd8, 0xc6, 0xde, 0x7d, 0xbd, 0x92, 0x2a, 0x08, 0x65, 0x09, 0x52, 0x65,
+	0xae, 0xf9, 0xa1, 0x58, 0x3f, 0x51, 0x66, 0x80, 0x99, 0x99, 0x01, 0x22, 0x33, 0xc3, 0x4f, 0xe1,
+	0xd5, 0xf7, 0xaa, 0x0d, 0xf7, 0x80, 0x2f, 0x92, 0x45, 0xe5, 0xd5, 0xf7, 0xaa, 0x5b, 0xee, 0x0d,
+	0xec, 0xd5, 0xe7, 0x00, 0xee, 0xd5, 0xe7, 0xbf, 0x40, 0x00, 0x45, 0xb1, 0x1f, 0x82, 0xca, 0x0b,
+	0x99, 0x09, 0x2f, 0x98, 0x37, 0x9a, 0x02, 0x0b, 0x06, 0x96, 0xb2, 0xa7, 0x27, 0x40, 0xca, 0x30,
+	0x49, 0x5a, 0x92, 0xcd, 0x8d, 0xac, 0x2b, 0xdd, 0x21, 0x0b, 0x35, 0x3f, 0x8a, 0xab, 0x81, 0x13,
+	0x56, 0x0f, 0xfd, 0x76, 0x58, 0x9e, 0xe7, 0x1f, 0x24, 0x14, 0x25, 0x8c, 0x40, 0x8a, 0x12, 0x06,
+	0x83, 0xa2, 0x84, 0xd3, 0x50, 0x33, 0x68, 0x27, 0x59, 0xd9, 0xf2, 0x82, 0xfe, 0x44, 0x04, 0xd6,
+	0x35, 0x43, 0x40, 0xca, 0x30, 0x89, 0xf5, 0x16, 0x59, 0xf2, 0xec, 0xc7, 0x55, 0xcc, 0x6c, 0x91,
+	0x33, 0xe3, 0xab, 0x65, 0x0a, 0xa5, 0x57, 0xcb, 0x14, 0x82, 0xb2, 0x34, 0xa9, 0xe5, 0x93, 0x73,
+	0x00, 0x8a, 0xfd, 0xd8, 0x6e, 0x2a, 0x60, 0x35, 0x76, 0x0f, 0xb8, 0x65, 0x7c, 0xe1, 0xc6, 0x35,
+	0xf0, 0xe6, 0x64, 0x09, 0xee, 0xf1, 0x8e, 0x79, 0x4a, 0x17, 0x92, 0x41, 0x53, 0x96, 0x9f, 0x8d,
+	0x37, 0x89, 0x13, 0x57, 0x0f, 0x1e, 0x55, 0x1b, 0x07, 0x41, 0x54, 0x5e, 0x46, 0x4d, 0x22, 0xc0,
+	0x5b, 0x07, 0x41, 0x84, 0x9a, 0x44, 0x03, 0xa1, 0x49, 0x74, 0x0a, 0x18, 0x39, 0x07, 0x11, 0x24,
+	0x3d, 0x60, 0xb4, 0xa2, 0x19, 0x49, 0xf0, 0x8e, 0xc1, 0x08, 0x01, 0x29, 0xc3, 0x24, 0x20, 0xa7,
+	0x1a, 0x41, 0x9b, 0x9b, 0x51, 0x9a, 0x65, 0x4b, 0xcb, 0xa9, 0x04, 0xa8, 0xe5, 0x54, 0x02, 0xa2,
+	0x4c, 0xa3, 0x61, 0x06, 0x40, 0x93, 0x36, 0x82, 0x36, 0xb7, 0x6c, 0x2f, 0x88, 0x19, 0x20, 0x41,
+	0x7a, 0x06, 0x48, 0x00, 0x65, 0x0a, 0x65, 0x6d, 0x10, 0xd2, 0x08, 0xda, 0x6a, 0xf6, 0xac, 0xf1,
+	0xc1, 0xc6, 0xf5, 0x43, 0x09, 0x15, 0xe3, 0x7f, 0x25, 0x29, 0x3b, 0x99, 0x43, 0x88, 0x00, 0x4a,
+	0x87, 0xaa, 0x04, 0x2f, 0x06, 0xe5, 0x73, 0x5a, 0x64, 0x48, 0x90, 0x2e, 0x5d, 0x02, 0xc0, 0x9f,
+	0x25, 0x7e, 0x59, 0x21, 0x29, 0xfb, 0x61, 0xdd, 0x09, 0xab, 0x6e, 0xab, 0xfa, 0xc0, 0x6d, 0xc6,
+	0x4e, 0xe8, 0xd4, 0xab, 0xf2, 0xa0, 0xcf, 0x79, 0xdd, 0xfb, 0x9c, 0x66, 0xbb, 0xf5, 0xba, 0xa4,
+	0x48, 0xce, 0xfd, 0xc8, 0xde, 0xcf, 0x45, 0x53, 0x96, 0x9f, 0xcd, 0xfa, 0x36, 0x59, 0x71, 0x40,
+	0x93, 0x15, 0x56, 0x31, 0x69, 0xfb, 0xb8, 0xa0, 0x55, 0x76, 0x8d, 0x4c, 0xac, 0x20, 0x52, 0x65,
+	0x4f, 0x63, 0x28, 0xcb, 0x10, 0x83, 0xdd, 0x0d, 0x73, 0x07, 0xf1, 0x54, 0x7d, 0xfe, 0x85, 0xf2,
+	0x3a, 0x6f, 0x58, 0x6e, 0x77, 0x43, 0x59, 0x24, 0x56, 0xdb, 0xdd, 0xb2, 0x38, 0xca, 0x72, 0x32,
+	0xe4, 0x97, 0xf2, 0x62, 0xf9, 0x6a, 0x8f, 0x52, 0x5e, 0xec, 0x51, 0xca, 0x8b, 0x79, 0xa5, 0xbc,
+	0x98, 0x5f, 0xca, 0x4b, 0xe5, 0x67, 0x7a, 0x94, 0xf2, 0x52, 0x8f, 0x52, 0x5e, 0xca, 0x2b, 0xe5,
+	0xa5, 0xfc, 0x52, 0x5e, 0x2e, 0xd3, 0x1e, 0xa5, 0xbc, 0xdc, 0xa3, 0x94, 0x97, 0xf3, 0x4a, 0x79,
+	0x39, 0xbf, 0x94, 0x57, 0xca, 0xcf, 0xf6, 0x28, 0xe5, 0x95, 0x1e, 0xa5, 0xbc, 0x92, 0x57, 0xca,
+	0x2b, 0xf9, 0xa5, 0x7c, 0xa9, 0xfc, 0xc9, 0x1e, 0xa5, 0x7c, 0xa9, 0x47, 0x29, 0x5f, 0xca, 0x2b,
+	0xe5, 0x4b, 0xf9, 0xa5, 0xbc, 0x5a, 0xfe, 0x54, 0x8f, 0x52, 0x5e, 0xed, 0x51, 0xca, 0xab, 0x79,
+	0xa5, 0xbc, 0x9a, 0x5f, 0xca, 0x6b, 0xe5, 0x4f, 0xf7, 0x28, 0xe5, 0xb5, 0x1e, 0xa5, 0xbc, 0x96,
+	0x57, 0xca, 0x6b, 0xf9, 0xa5, 0x5c, 0x2b, 0x3f, 0xd7, 0xa3, 0x94, 0x6b, 0x3d, 0x4a, 0xb9, 0x96,
+	0x57, 0xca, 0xb5, 0xdc, 0x52, 0x5e, 0x78, 0xbe, 0xfc, 0x99, 0xee, 0xa5, 0xbc, 0xf0, 0x7c, 0xf7,
+	0x52, 0x5e, 0x78, 0x3e, 0xa7, 0x94, 0x17, 0x9e, 0xef, 0xb1, 0x81, 0xfd, 0xec, 0x99, 0x6d, 0x60,
+	0xff, 0xd4, 0x58, 0xdc, 0xdd, 0x7f, 0xbe, 0x40, 0xd6, 0x50, 0x83, 0x1d, 0x84, 0x8e, 0xfd, 0xb0,
+	0xee, 0x3f, 0x6a, 0x95, 0x3f, 0xc7, 0xb5, 0xf3, 0xab, 0x29, 0xe3, 0xb7, 0x53, 0xbb, 0x69, 0xb6,
+	0x06, 0x77, 0x11, 0xa3, 0x26, 0xbf, 0xa1, 0x18, 0x7c, 0x78, 0xbc, 0x7e, 0x29, 0xdd, 0xa8, 0x09,
+	0x92, 0xb2, 0xbc, 0x2c, 0x29, 0x7f, 0xeb, 0xe7, 0x4f, 0xe8, 0x6f, 0xb5, 0x1e, 0x91, 0xf3, 0xe8,
+	0xd3, 0xa4, 0x76, 0xc0, 0xf5, 0xce, 0x9f, 0xe3, 0xc3, 0xe1, 0xfa, 0x93, 0xe3, 0xf5, 0x2b, 0x9a,
+	0xe2, 0x1e, 0x10, 0xf0, 0x8f, 0x32, 0x0a, 0xb9, 0x9c, 0xfe, 0x0a, 0x4d, 0x47, 0xd9, 0x5a, 0x2e,
+	0xf8, 0x1f, 0x14, 0xc9, 0x6a, 0x4e, 0x5b, 0x81, 0x05, 0xde, 0x73, 0xe2, 0xd0, 0xad, 0xe1, 0x83,
+	0xa4, 0x02, 0xa2, 0x77, 0x9d, 0x22, 0x4d, 0x99, 0x44, 0x98, 0xa7, 0x8b, 0x8b, 0xc2, 0xae, 0xda,
+	0x31, 0x4f, 0x17, 0x77, 0xe4, 0xe9, 0x62, 0xfe, 0x1f, 0x32, 0x88, 0xaf, 0x2c, 0xe9, 0x0c, 0x91,
+	0x54, 0xad, 0x65, 0x86, 0x48, 0xd4, 0x7e, 0x32, 0x52, 0xd5, 0x7a, 0xe4, 0xb8, 0x8d, 0xc3, 0x98,
+	0xef, 0x4f, 0x8a, 0xa2, 0x5a, 0x02, 0xa2, 0xab, 0x25, 0xd2, 0x94, 0x49, 0x84, 0xb5, 0x47, 0x16,
+	0xc5, 0x2f, 0xa7, 0x2e, 0x1b, 0x75, 0x52, 0xeb, 0xaf, 0x0a, 0x53, 0x91, 0xc5, 0xae, 0x61, 0x1e,
+	0x12, 0x4c, 0x99, 0x49, 0x46, 0xff, 0x1c, 0xdf, 0xda, 0x43, 0xbb, 0x9d, 0xc4, 0x92, 0xb2, 0x61,
+	0x6c, 0x8d, 0xcf, 0xe7, 0xec, 0x2e, 0xc1, 0x8e, 0xd2, 0x67, 0x73, 0xf9, 0x1b, 0x45, 0x32, 0x9b,
+	0x10, 0x7f, 0x14, 0xec, 0x27, 0x99, 0x5d, 0x5f, 0x69, 0xe4, 0x5d, 0xdf, 0xd8, 0x9c, 0xe3, 0x3f,
+	0x2a, 0x90, 0x55, 0xee, 0x1c, 0x07, 0xd6, 0x1f, 0x31, 0xdf, 0xf8, 0x21, 0x39, 0x2f, 0x7c, 0x6e,
+	0x19, 0xf3, 0xc3, 0xae, 0x61, 0xde, 0xb8, 0x9c, 0xe3, 0xdc, 0x53, 0x59, 0xc4, 0x3c, 0xe8, 0x78,
+	0x72, 0x98, 0xc8, 0x79, 0x20, 0xd2, 0x94, 0x49, 0x04, 0xf5, 0xc8, 0x25, 0xed, 0x4c, 0xcc, 0x94,
+	0x76, 0xc7, 0x34, 0x76, 0x9c, 0xbc, 0xb8, 0x5f, 0x29, 0x91, 0x45, 0x33, 0x9f, 0x38, 0x31, 0xdf,
+	0x80, 0xbe, 0x34, 0x4e, 0xcc, 0x37, 0x44, 0x37, 0x26, 0x27, 0xe6, 0x1b, 0xbc, 0x07, 0x25, 0x22,
+	0xcf, 0xeb, 0xb0, 0x6b, 0x8c, 0x69, 0xd1, 0x0b, 0x13, 0x72, 0xf4, 0x4d, 0x76, 0xaa, 0xb0, 0xd9,
+	0x2f, 0x75, 0x6d, 0xb4, 0xfd, 0x8d, 0xa0, 0xad, 0xcd, 0x36, 0x90, 0xd2, 0xac, 0x20, 0x45, 0x19,
+	0x07, 0xc2, 0xa5, 0x0a, 0xcf, 0xf1, 0xe4, 0xa8, 0xe3, 0x7e, 0xce, 0x1d, 0xc7, 0xd3, 0x7e, 0xce,
+	0x1d, 0xc7, 0xa3, 0x0c, 0x40, 0xd6, 0x06, 0x29, 0xc1, 0x1e, 0x67, 0x92, 0xb7, 0xdb, 0xa5, 0x9c,
+	0x12, 0xb7, 0x64, 0x81, 0x9c, 0xc9, 0x56, 0xd0, 0xd6, 0x4c, 0xb6, 0xa0, 0x38, 0x00, 0xe5, 0x98,
+	0xb3, 0xa7, 0x4e, 0xc1, 0x7b, 0x19, 0xaa, 0x2e, 0x51, 0x8d, 0x00, 0x22, 0xb8, 0xe6, 0xb7, 0x5b,
+	0xea, 0x0e, 0x03, 0x17, 0xc1, 0x1b, 0x00, 0xd0, 0x22, 0x98, 0x27, 0x29, 0x13, 0x60, 0x9e, 0xa1,
+	0xe9, 0xd7, 0x1e, 0xe2, 0x2b, 0x24, 0x1b, 0x00, 0x40, 0x19, 0x20, 0x09, 0x19, 0xf8, 0xff, 0xdf,
+	0x2b, 0x90, 0x05, 0xa3, 0x1d, 0x86, 0x2f, 0x13, 0xba, 0xe2, 0x41, 0x28, 0x4b, 0x14, 0x5d, 0xf1,
+	0x20, 0x44, 0x5d, 0xf1, 0x20, 0x84, 0xae, 0x78, 0x10, 0x02, 0x67, 0xb1, 0x5f, 0x45, 0x07, 0x92,
+	0x77, 0xe4, 0x5e, 0x55, 0x72, 0xde, 0x11, 0xfb, 0x54, 0x01, 0x1e, 0xb8, 0x93, 0x69, 0x40, 0xca,
+	0xc2, 0x07, 0x0b, 0x83, 0xf9, 0x4c, 0xdc, 0xbe, 0xff, 0xb8, 0x40, 0xd6, 0x74, 0x91, 0xa7, 0x2e,
+	0xb5, 0x32, 0x72, 0xbb, 0x38, 0xaa, 0xdc, 0xa6, 0xbf, 0x56, 0x20, 0x17, 0xc5, 0x06, 0x17, 0x40,
+	0xd1, 0x8d, 0x23, 0x66, 0xb7, 0x46, 0x75, 0xff, 0xde, 0x25, 0x53, 0x62, 0x13, 0x2e, 0x97, 0xc9,
+	0xa7, 0x32, 0x6a, 0x1e, 0x67, 0x2e, 0x8a, 0x13, 0x02, 0x45, 0xd0, 0x6b, 0x81, 0x22, 0xd2, 0x94,
+	0x49, 0x04, 0xfd, 0x2b, 0x05, 0xb2, 0x02, 0x19, 0xc5, 0x89, 0xa4, 0xd1, 0xaa, 0xb5, 0x63, 0xac,
+	0xdd, 0x97, 0x32, 0x95, 0x4a, 0x78, 0x8b, 0x2a, 0x45, 0x3c, 0xa9, 0xab, 0x24, 0xd2, 0x60, 0xaf,
+	0x17, 0x3f, 0xfe, 0x6b, 0x91, 0x2c, 0x18, 0xd9, 0x2c, 0x9b, 0xcc, 0xd6, 0xfc, 0x56, 0xdd, 0x8d,
+	0x85, 0xb4, 0xcc, 0xd7, 0x70, 0x05, 0xf9, 0x86, 0xa2, 0x13, 0xf6, 0x99, 0x24, 0x9b, 0xb6, 0xcf,
+	0x24, 0x20, 0xca, 0x34, 0xda, 0xda, 0x27, 0x33, 0xc2, 0xd0, 0x71, 0x70, 0xc4, 0x4f, 0x53, 0xe5,
+	0x35, 0xae, 0x28, 0xe1, 0x0e, 0x90, 0x49, 0x9b, 0x2b, 0xff, 0x89, 0x0e, 0x04, 0x4b, 0x00, 0xd8,
+	0x5c, 0xc5, 0x2f, 0x98, 0x84, 0x4d, 0xd7, 0x73, 0x63, 0x3e, 0x09, 0x27, 0xc5, 0x24, 0xe4, 0x00,
+	0x3d, 0x09, 0x79, 0x92, 0x32, 0x01, 0x86, 0x65, 0xc1, 0x7f, 0xf0, 0x20, 0x72, 0x84, 0x56, 0x37,
+	0x29, 0x9a, 0x4c, 0x40, 0x74, 0x93, 0x89, 0x34, 0x65, 0x12, 0x01, 0x99, 0x6a, 0xed, 0x30, 0xf2,
+	0x43, 0x69, 0x56, 0xe6, 0x99, 0x04, 0x44, 0x67, 0x12, 0x69, 0xca, 0x24, 0x02, 0x8e, 0x52, 0xac,
+	0xe6, 0x34, 0x1c, 0xbf, 0xc8, 0xe0, 0x3a, 0xcd, 0x3a, 0x96, 0x48, 0x1c, 0x80, 0x2e, 0x32, 0x40,
+	0x12, 0x2e, 0x32, 0xc0, 0x7f, 0x30, 0x0d, 0xfb, 0x01, 0x6c, 0x4c, 0xfc, 0x10, 0xdf, 0x6c, 0x53,
+	0x30, 0x74, 0xea, 0x4b, 0x42, 0xe0, 0xd4, 0x97, 0xfc, 0xa9, 0xf5, 0xe4, 0xd2, 0xd5, 0x92, 0x2a,
+	0xad, 0x97, 0x9e, 0x4c, 0xbf, 0x5f, 0x20, 0x4b, 0xba, 0xda, 0xbc, 0x37, 0x86, 0xaf, 0xf2, 0xd7,
+	0xc9, 0x6c, 0xdd, 0x0d, 0xc5, 0x8c, 0x97, 0x75, 0xe6, 0xe3, 0x25, 0x01, 0xea, 0xf1, 0x92, 0x80,
+	0x28, 0xd3, 0x68, 0xfa, 0x2f, 0x8a, 0xc4, 0xc2, 0x83, 0x54, 0x7b, 0x61, 0x40, 0x68, 0x9c, 0xcc,
+	0x49, 0x02, 0x4e, 0x61, 0xb1, 0xed, 0x11, 0x2b, 0x03, 0xd4, 0xaf, 0x24, 0x8c, 0x7e, 0x1c, 0xac,
+	0x96, 0x07, 0xb9, 0x8f, 0xd2, 0x30, 0xca, 0x10, 0xc1, 0x19, 0x0d, 0xbd, 0x4d, 0xb0, 0xd5, 0x3e,
+	0x8e, 0xab, 0xc6, 0xf8, 0xe3, 0x75, 0x05, 0xf0, 0x86, 0x1a, 0x83, 0x2b, 0xca, 0x54, 0xab, 0x60,
+	0x94, 0x21, 0x02, 0xfa, 0x7f, 0xce, 0x93, 0xa5, 0x94, 0xfc, 0xfa, 0xd8, 0x1c, 0xc3, 0xc8, 0x2c,
+	0x16, 0x13, 0xe3, 0x70, 0xed, 0x4c, 0x0e, 0xe5, 0xda, 0xb9, 0x43, 0x12, 0x4f, 0x4d, 0x79, 0x2a,
+	0xe7, 0x82, 0x1d, 0x6f, 0xd7, 0x61, 0xdc, 0x3d, 0x77, 0x90, 0xbb, 0x67, 0xba, 0x3f, 0xc3, 0xfe,
+	0x2e, 0xa0, 0x5b, 0x44, 0x39, 0x75, 0xca, 0x33, 0x5d, 0xf9, 0x0d, 0xea, 0x16, 0x7a, 0x87, 0x60,
+	0xe7, 0x4e, 0x79, 0xb6, 0x2b, 0xc3, 0x31, 0xb8, 0x8a, 0xc8, 0xc8, 0xae, 0xa2, 0x5a, 0xda, 0x55,
+	0x34, 0xd7, 0xb5, 0x9e, 0xa3, 0xbb, 0x8f, 0xde, 0x31, 0xdd, 0x47, 0xf3, 0xbd, 0x9b, 0x62, 0x48,
+	0x97, 0xd2, 0xc3, 0xac, 0x4b, 0x69, 0xa1, 0x6b, 0x01, 0x27, 0x75, 0x33, 0x7d, 0xbf, 0x40, 0xf2,
+	0xfd, 0x41, 0xe5, 0xc5, 0xae, 0x65, 0x8e, 0xdf, 0xf7, 0xf4, 0x0e, 0xc1, 0x1e, 0xa4, 0xf2, 0x52,
+	0xd7, 0xa2, 0x47, 0xf1, 0x47, 0xbd, 0x43, 0xb0, 0x57, 0xa9, 0xbc, 0xdc, 0x9b, 0xf9, 0x49, 0x7c,
+	0x54, 0x2b, 0x23, 0xf8, 0xa8, 0x6e, 0x69, 0x1f, 0x95, 0xd5, 0x7b, 0x8a, 0x0e, 0xe0, 0xb7, 0x7a,
+	0x8b, 0x20, 0x07, 0x54, 0x79, 0xb5, 0x2b, 0xbf, 0x93, 0xf8, 0xb2, 0xd6, 0x86, 0xf2, 0x65, 0xe5,
+	0xfa, 0x95, 0xce, 0x8d, 0xcb, 0xaf, 0xf4, 0x88, 0xe4, 0xf8, 0x81, 0xca, 0xeb, 0x5d, 0xbf, 0x7b,
+	0x6c, 0xae, 0xa6, 0xbc, 0x82, 0x85, 0xa7, 0x69, 0x98, 0x82, 0x47, 0xf0, 0x3e, 0xe5, 0x15, 0x2c,
+	0x9c, 0x4f, 0xc3, 0x14, 0x3c, 0x82, 0x43, 0x2a, 0xaf, 0x60, 0xe1, 0x8f, 0x1a, 0xa6, 0xe0, 0x11,
+	0x7c, 0x54, 0x79, 0x05, 0x0b, 0x17, 0xd5, 0x30, 0x05, 0x8f, 0xe0, 0xb6, 0xca, 0x2b, 0x58, 0x78,
+	0xad, 0x86, 0x29, 0x78, 0x04, 0x4f, 0x56, 0x5e, 0xc1, 0xc2, 0x91, 0x35, 0x4c, 0xc1, 0x23, 0x38,
+	0xb7, 0xf2, 0x0a, 0x16, 0xbe, 0xad, 0x61, 0x0a, 0x1e, 0xc1, 0xdf, 0x95, 0x57, 0xb0, 0x70, 0x77,
+	0x0d, 0x53, 0xf0, 0x08, 0x2e, 0xb0, 0x9c, 0x82, 0xa5, 0x07, 0x6c, 0x88, 0x82, 0x47, 0xf0, 0x8a,
+	0xd1, 0xb7, 0xc9, 0x24, 0xe7, 0xc8, 0xed, 0x3f, 0xae, 0x30, 0x47, 0x16, 0x85, 0xfd, 0xc7, 0x73,
+	0x5b, 0xda, 0xfe, 0xe3, 0xb9, 0x2d, 0xca, 0x00, 0xc4, 0x09, 0xed, 0xc7, 0xe5, 0x22, 0x22, 0xb4,
+	0x1f, 0x23, 0x42, 0xfb, 0x31, 0x10, 0xda, 0x8f, 0xe9, 0x1f, 0x16, 0xc8, 0x72, 0xc5, 0x0f, 0x63,
+	0x6e, 0xfa, 0x50, 0xc6, 0x85, 0xf1, 0x9c, 0x24, 0x83, 0xb3, 0xf0, 0x68, 0xc7, 0xae, 0x95, 0xe5,
+	0xfe, 0x7b, 0x72, 0x63, 0xf3, 0x57, 0x1a, 0x61, 0xf3, 0xf7, 0x83, 0x02, 0xb9, 0x7c, 0xef, 0xa0,
+	0xe2, 0xd4, 0xda, 0xa1, 0x1b, 0x1f, 0x6d, 0x85, 0x7e, 0x3b, 0x30, 0xcc, 0xc7, 0x87, 0x86, 0xb1,
+	0xfa, 0x6a, 0xfa, 0x03, 0xd3, 0xf9, 0x84, 0xf6, 0x17, 0x61, 0xb0, 0xd6, 0xfe, 0x0c, 0x30, 0x65,
+	0x26, 0x19, 0xc4, 0x10, 0x58, 0x97, 0x07, 0xf6, 0xba, 0xd6, 0xc6, 0x35, 0xdb, 0xfb, 0x34, 0xab,
+	0xf3, 0xdb, 0xd3, 0xdc, 0x17, 0x94, 0xe6, 0xf8, 0xb1, 0xd9, 0xca, 0xbd, 0x4c, 0xa6, 0x3b, 0xa0,
+	0xaf, 0xb9, 0x75, 0xb9, 0x89, 0x13, 0xc6, 0xfd, 0x5d, 0x27, 0xc6, 0x07, 0x4c, 0x45, 0x1a, 0x8c,
+	0xfb, 0xfc, 0x47, 0x7a, 0xc3, 0x30, 0x39, 0xf2, 0x86, 0xa1, 0x4d, 0x16, 0x1f, 0xb8, 0xa1, 0xf3,
+	0xc8, 0x6e, 0x36, 0xab, 0x61, 0xbb, 0xe9, 0x44, 0xd2, 0xee, 0xfd, 0x6c, 0x9e, 0xff, 0x41, 0x36,
+	0x32, 0x6b, 0x37, 0x1d, 0xdd, 0x6b, 0x2a, 0x3b, 0x40, 0x23, 0xdd, 0x6b, 0x06, 0x98, 0x32, 0x93,
+	0xcc, 0x7a, 0x40, 0xce, 0xf1, 0x0d, 0xac, 0xe4, 0x58, 0x6d, 0x40, 0xbf, 0x41, 0x1b, 0x4c, 0xeb,
+	0xcb, 0x68, 0xb0, 0x4b, 0x35, 0xba, 0xb5, 0xae, 0x05, 0x4d, 0x16, 0x47, 0x59, 0x4e, 0x06, 0xab,
+	0x45, 0x2e, 0xe4, 0x94, 0x83, 0x4e, 0xe4, 0x73, 0xff, 0x7b, 0x3a, 0xa3, 0xec, 0xc1, 0xcb, 0xf9,
+	0x65, 0x89, 0x7e, 0xcc, 0xcd, 0x94, 0xe3, 0x46, 0x98, 0x3d, 0xd3, 0x53, 0xf1, 0xe4, 0xcc, 0x0e,
+	0x15, 0xcc, 0x8d, 0xe5, 0x54, 0xfc, 0xef, 0x16, 0x13, 0xf7, 0x5b, 0x6a, 0x70, 0xc1, 0x3d, 0xc2,
+	0x07, 0xa1, 0xef, 0x55, 0x03, 0x3f, 0x54, 0x9e, 0x0a, 0xbe, 0xf7, 0x7f, 0x3d, 0xf4, 0xbd, 0x3d,
+	0x3f, 0x8c, 0xf5, 0xde, 0x5f, 0x41, 0x28, 0x4b, 0x90, 0x30, 0xad, 0x62, 0x5f, 0xe4, 0x45, 0xe7,
+	0xb6, 0xef, 0xf9, 0x32, 0xa7, 0x9c, 0x56, 0x22, 0x4d, 0x99, 0x44, 0x80, 0x65, 0xc9, 0x0d, 0xaa,
+	0x3c, 0xd2, 0x57, 0xcd, 0x6f, 0xe2, 0x4b, 0xc5, 0xdb, 0x7b, 0x7b, 0x12, 0xaa, 0xb7, 0x0b, 0x1a,
+	0x46, 0x19, 0x22, 0x30, 0x85, 0xfd, 0x84, 0x16, 0xf6, 0x9b, 0x59, 0x61, 0xbf, 0x89, 0x84, 0x7d,
+	0xf2, 0x1b, 0xc4, 0x52, 0xcd, 0xad, 0x2b, 0xcb, 0x16, 0x17, 0x4b, 0x1b, 0xdb, 0x9b, 0x4c, 0x8b,
+	0x25, 0x48, 0x51, 0xc6, 0x81, 0xf4, 0x1f, 0x15, 0xc8, 0x53, 0x29, 0x01, 0x78, 0x12, 0xaf, 0x78,
+	0xc3, 0xb0, 0xac, 0xaf, 0xf7, 0x92, 0xdc, 0x60, 0x5e, 0x1f, 0x5d, 0x70, 0xff, 0x72, 0x89, 0x1f,
+	0x2a, 0x4f, 0x31, 0xfc, 0x28, 0xb8, 0xd0, 0x91, 0x48, 0x2e, 0x8d, 0x2c, 0x92, 0x27, 0xc6, 0x28,
+	0x92, 0x27, 0xcf, 0x40, 0x24, 0x8b, 0x33, 0xfe, 0xfb, 0xf0, 0x2d, 0x83, 0x9f, 0xf1, 0x57, 0xe4,
+	0xa2, 0x9f, 0xa0, 0x21, 0x74, 0x3f, 0x41, 0x8a, 0x32, 0x0e, 0xd4, 0x67, 0xfc, 0x33, 0xfc, 0xfb,
+	0x68, 0x66, 0x83, 0x16, 0xf0, 0x9b, 0xd3, 0x84, 0x68, 0xea, 0x8f, 0xcd, 0xe2, 0xff, 0x0d, 0x42,
+	0x60, 0xa2, 0x57, 0x0f, 0xb8, 0x47, 0x17, 0x89, 0x0a, 0x80, 0xde, 0x90, 0x5e, 0x5d, 0xe5, 0x44,
+	0x52, 0x20, 0x70, 0x22, 0xa9, 0xdf, 0x56, 0x4c, 0x96, 0xa3, 0xf6, 0x01, 0x1f, 0xad, 0xad, 0x07,
+	0xbe, 0x58, 0x04, 0xc4, 0x70, 0x79, 0x3a, 0x6f, 0xb8, 0x70, 0x52, 0xde, 0xa0, 0xbc, 0xde, 0x51,
+	0x92, 0x96, 0xab, 0x83, 0xac, 0xb7, 0x09, 0xa7, 0x2c, 0x45, 0x98, 0x1e, 0xeb, 0x53, 0x23, 0x8f,
+	0xf5, 0xeb, 0x04, 0x8c, 0xd1, 0x55, 0x35, 0xdd, 0xa6, 0x51, 0x0b, 0x44, 0xc1, 0xbe, 0x9a, 0x71,
+	0xcb, 0xc9, 0x42, 0xbc, 0x2f, 0x27, 0x9d, 0x46, 0x2b, 0x5b, 0x38, 0x67, 0x81, 0x16, 0x76, 0x65,
+	0x0b, 0x07, 0xaa, 0x8c, 0x2d, 0x5c, 0x01, 0x85, 0x2d, 0x5c, 0xa5, 0xd0, 0xbd, 0xe7, 0x59, 0x3d,
+	0xef, 0xa3, 0xd4, 0xbd, 0xe7, 0x74, 0x00, 0x9d, 0xec, 0x92, 0x4f, 0xce, 0x74, 0xc9, 0x9f, 0x3b,
+	0xb3, 0x25, 0x7f, 0x7e, 0x2c, 0x4b, 0xfe, 0xff, 0x86, 0x0d, 0x5a, 0x6a, 0x34, 0x9e, 0xe4, 0x9a,
+	0xfb, 0xd7, 0xc9, 0xac, 0x1b, 0x74, 0x5e, 0xae, 0xf2, 0x15, 0x13, 0xf9, 0xd5, 0xb6, 0xf7, 0x3a,
+	0x2f, 0x57, 0xe5, 0xb2, 0xb9, 0xac, 0x16, 0x6c, 0x09, 0xa2, 0x4c, 0xa3, 0x73, 0x3a, 0xb0, 0x74,
+	0x0a, 0x47, 0x3f, 0xc4, 0x99, 0x35, 0x18, 0x6a, 0xa7, 0x77, 0x66, 0x0d, 0xb8, 0x27, 0x67, 0xd6,
+	0xba, 0x0b, 0xcb, 0x1f, 0x96, 0xc8, 0x6c, 0x42, 0xfc, 0x51, 0x58, 0x70, 0x4d, 0x31, 0x58, 0x1a,
+	0x41, 0x0c, 0x3e, 0xca, 0x11, 0x83, 0x13, 0xb9, 0x5e, 0x7b, 0x3d, 0xf0, 0x98, 0xf3, 0xde, 0xd8,
+	0x25, 0xe1, 0xc8, 0x1b, 0x31, 0xfa, 0xdf, 0xb9, 0x6b, 0x3c, 0x53, 0xbb, 0xbc, 0xee, 0xe9, 0x7e,
+	0xfc, 0xea, 0x63, 0x32, 0x17, 0xb8, 0xaa, 0xb1, 0x53, 0x73, 0xa3, 0x21, 0x54, 0x0d, 0x45, 0x2e,
+	0x9a, 0xc0, 0xab, 0xb9, 0x91, 0x6e, 0x02, 0x48, 0x51, 0xc6, 0x81, 0x5a, 0xd5, 0xc8, 0xf0, 0xef,
+	0xa3, 0x6a, 0x0c, 0x5a, 0xc0, 0x0f, 0x27, 0x09, 0xd1, 0xd4, 0xa7, 0xa0, 0x6a, 0xe8, 0x55, 0x68,
+	0x7a, 0xf0, 0x55, 0xe8, 0x36, 0x59, 0x88, 0xed, 0xb0, 0xe1, 0xc4, 0xca, 0xcb, 0x30, 0xa3, 0x43,
+	0xe8, 0x09, 0x44, 0xe2, 0x61, 0x90, 0x1d, 0x84, 0xa1, 0x94, 0x19, 0x44, 0x88, 0x9b, 0x2d, 0x76,
+	0x31, 0xb3, 0x69, 0x6e, 0xd7, 0xd5, 0x46, 0xc6, 0xe0, 0x76, 0x5d, 0xee, 0x65, 0x0c, 0x22, 0xbe,
+	0x98, 0xb4, 0xa2, 0x18, 0xf4, 0x59, 0xcf, 0x6f, 0x55, 0xed, 0x86, 0xd3, 0x8a, 0xa5, 0x8f, 0x53,
+	0x2c, 0x26, 0x02, 0xb9, 0xe3, 0xb7, 0xae, 0x03, 0x0a, 0x2d, 0x26, 0x26, 0x02, 0x16, 0x13, 0x13,
+	0xc2, 0x0f, 0x1c, 0xd8, 0x07, 0x4e, 0x53, 0xaa, 0x20, 0xe2, 0xc0, 0x01, 0x00, 0xd0, 0x81, 0x03,
+	0x48, 0xc2, 0x81, 0x03, 0xf8, 0x0f, 0x87, 0x91, 0x83, 0xa6, 0x5d, 0x73, 0x3c, 0xa7, 0x15, 0x57,
+	0xed, 0x66, 0xc3, 0x97, 0x5a, 0x17, 0xd7, 0x9b, 0x13, 0xcc, 0xf5, 0x66, 0xc3, 0xd7, 0x7a, 0xb3,
+	0x01, 0xa6, 0xcc, 0x24, 0x1b, 0x9f, 0x29, 0xe6, 0xcb, 0xa4, 0xd8, 0xf1, 0x72, 0xe7, 0xdb, 0xbd,
+	0x83, 0x7d, 0x4f, 0x87, 0xe8, 0xed, 0x78, 0x7a, 0x80, 0x75, 0x3c, 0xca, 0x8a, 0x1d, 0x8f, 0xfe,
+	0xbe, 0x45, 0x66, 0x14, 0xd5, 0x29, 0x0c, 0xc9, 0xeb, 0x64, 0xae, 0xe3, 0x69, 0x23, 0x0d, 0x92,
+	0xd0, 0x1d, 0x4f, 0xdb, 0x66, 0x96, 0x55, 0x9d, 0x12, 0x93, 0x8c, 0x46, 0x5b, 0xf7, 0xc9, 0x4c,
+	0xd3, 0xaf, 0xd9, 0xc9, 0xde, 0x28, 0x7d, 0x77, 0x7d, 0xcb, 0xf1, 0x6f, 0x4b, 0xbc, 0xd8, 0xe7,
+	0x2b, 0x6a, 0xbd, 0xcf, 0x57, 0x10, 0xca, 0x12, 0x24, 0x9a, 0x2c, 0x93, 0x27, 0x98, 0x2c, 0x53,
+	0x63, 0x9d, 0x2c, 0xd3, 0x27, 0x99, 0x2c, 0xf7, 0xc9, 0x72, 0x32, 0x49, 0xcc, 0xb9, 0xcc, 0xd7,
+	0x29, 0x4f, 0x8e, 0xfc, 0xa4, 0x82, 0x72, 0x9d, 0x32, 0xe1, 0x94, 0xa5, 0x08, 0x61, 0xdc, 0xcb,
+	0x58, 0xe0, 0x2a, 0xd6, 0xf5, 0xac, 0x1e, 0xf7, 0x02, 0xb3, 0x93, 0x44, 0xbc, 0x56, 0xfb, 0x77,
+	0x0c, 0x86, 0xfd, 0x3b, 0x4e, 0x5b, 0x6f, 0x10, 0x11, 0xcb, 0xd2, 0xa9, 0x57, 0x63, 0xd7, 0x73,
+	0xf0, 0xa1, 0x05, 0x09, 0xbf, 0xe7, 0x1a, 0x6a, 0xb7, 0x06, 0x82, 0xda, 0xad, 0x53, 0x7a, 0x12,
+	0xcf, 0x0d, 0x38, 0x89, 0x53, 0x53, 0x6e, 0x7e, 0xe4, 0x29, 0x77, 0x3b, 0x39, 0x10, 0xbd, 0x90,
+	0xb3, 0xe8, 0x88, 0x03, 0xd0, 0xfa, 0xc4, 0x75, 0x98, 0x3a, 0x29, 0x1d, 0xaa, 0x93, 0xd2, 0xe2,
+	0x07, 0x58, 0xac, 0x64, 0x68, 0x0e, 0x37, 0x90, 0x01, 0x21, 0xf9, 0x48, 0x16, 0xc0, 0xed, 0x3d,
+	0x3d, 0x92, 0x15, 0x84, 0xb2, 0x04, 0x09, 0xce, 0x05, 0x88, 0x86, 0xc2, 0x4d, 0x56, 0x4b, 0xda,
+	0xb9, 0x10, 0x45, 0x87, 0xd2, 0x66, 0xb5, 0x98, 0xc4, 0x70, 0x10, 0x46, 0x2b, 0x85, 0x42, 0x21,
+	0x41, 0xea, 0xad, 0xa8, 0xbc, 0xac, 0x27, 0xa7, 0x80, 0x6e, 0xee, 0x56, 0xd2, 0x21, 0x41, 0x36,
+	0x77, 0x2b, 0x49, 0x48, 0x90, 0xcd, 0xdd, 0x0a, 0xe7, 0x20, 0x43, 0x82, 0xb8, 0x01, 0x76, 0xe4,
+	0x4b, 0xe8, 0xf6, 0x1e, 0xe2, 0xa0, 0x40, 0xc0, 0x41, 0xfd, 0xc6, 0x41, 0x45, 0xa0, 0x12, 0x56,
+	0x26, 0xa8, 0x88, 0xa8, 0x85, 0x19, 0x54, 0x84, 0x57, 0x03, 0x11, 0x40, 0xe8, 0xa3, 0x8e, 0x57,
+	0xe5, 0xe1, 0xc3, 0xea, 0x6e, 0xf4, 0xb0, 0xbc, 0xaa, 0xd9, 0x74, 0xbc, 0x1b, 0xbe, 0x1f, 0x6f,
+	0xba, 0xd1, 0x43, 0xcd, 0x46, 0xc3, 0x28, 0x43, 0x04, 0xb0, 0x25, 0x04, 0x36, 0xa0, 0x19, 0x0a,
+	0x3e, 0x6b, 0x7a, 0x84, 0x74, 0x3c, 0xae, 0x31, 0x4a, 0x46, 0x56, 0xc2, 0x48, 0x01, 0x29, 0xc3,
+	0x24, 0x79, 0x0a, 0xef, 0xb9, 0xb1, 0x58, 0x98, 0x54, 0x54, 0x89, 0xf3, 0x83, 0x47, 0x95, 0xc0,
+	0xa1, 0x98, 0x2e, 0x0c, 0x15, 0x8a, 0x09, 0x59, 0xb4, 0xca, 0x83, 0x5b, 0xb4, 0xe0, 0xfd, 0x00,
+	0xa9, 0x54, 0xd7, 0xcb, 0x17, 0xf5, 0x78, 0x16, 0x40, 0xfc, 0x7e, 0x80, 0x82, 0x50, 0x96, 0x20,
+	0x21, 0x0e, 0x4e, 0xc6, 0xbc, 0x1f, 0x95, 0x2f, 0x5d, 0x2d, 0xa9, 0xc3, 0x0f, 0x91, 0x69, 0xab,
+	0x47, 0x87, 0x1f, 0xd2, 0x18, 0xca, 0x32, 0xc4, 0xd6, 0xd7, 0x08, 0x51, 0xc1, 0x83, 0xdc, 0x7a,
+	0xf9, 0x32, 0xaa, 0x9d, 0x88, 0xaa, 0x84, 0x6b, 0x27, 0x21, 0x50, 0x3b, 0xf9, 0xd3, 0xba, 0x4b,
+	0x96, 0x3a, 0x9e, 0x88, 0xcf, 0x63, 0xd7, 0xc4, 0x99, 0xc7, 0xa7, 0xb4, 0x40, 0xec, 0x78, 0x10,
+	0x6f, 0xe7, 0xba, 0x40, 0x68, 0x81, 0x68, 0x80, 0x29, 0x33, 0xc9, 0x40, 0x72, 0x2b, 0x96, 0x81,
+	0x1d, 0x45, 0x10, 0xf5, 0xb0, 0xfc, 0xb4, 0x1e, 0x2b, 0x82, 0x78, 0x4f, 0x62, 0xf4, 0x58, 0x31,
+	0xe1, 0x94, 0xa5, 0x08, 0xad, 0x36, 0xb1, 0xb8, 0x7d, 0xc3, 0x75, 0x1e, 0x55, 0x3b, 0x5e, 0xb5,
+	0xee, 0xc4, 0xb6, 0xdb, 0x2c, 0x5f, 0xc9, 0x09, 0x79, 0x25, 0xaf, 0x16, 0xec, 0x70, 0x89, 0xc5,
+	0x35, 0x2b, 0x30, 0x6e, 0xb8, 0xce, 0xa3, 0x7d, 0x6f, 0x93, 0xe7, 0xd2, 0x9a, 0x55, 0x0a, 0x41,
+	0x59, 0x9a, 0x14, 0x3a, 0x9f, 0x7f, 0x4a, 0xdd, 0x8e, 0xed, 0xf2, 0xba, 0x6e, 0x5e, 0x00, 0x6e,
+	0xda, 0xb1, 0x6d, 0x06, 0x41, 0x02, 0x88, 0x0c, 0x82, 0x04, 0x3f, 0x2d, 0x9b, 0xcc, 0x07, 0x70,
+	0x66, 0xac, 0xe6, 0x7b, 0x9e, 0xdd, 0xaa, 0x97, 0xaf, 0xe6, 0x88, 0x57, 0x50, 0xa1, 0x37, 0xbc,
+	0x3a, 0x6c, 0x58, 0xf9, 0xcc, 0x84, 0x0c, 0x1b, 0x82, 0x5e, 0xcf, 0x4c, 0x04, 0xa4, 0x0c, 0x93,
+	0xc0, 0xf8, 0x4a, 0x2a, 0x58, 0x75, 0x5a, 0x35, 0xbf, 0xee, 0xb6, 0x1a, 0xfc, 0x2c, 0x88, 0x1c,
+	0x5f, 0xaa, 0x32, 0x37, 0x25, 0x4e, 0x8f, 0xaf, 0x34, 0x86, 0xb2, 0x0c, 0x31, 0xfd, 0x0f, 0x45,
+	0x32, 0x87, 0x74, 0x12, 0x38, 0x71, 0xdc, 0xb4, 0x63, 0x37, 0x6e, 0xd7, 0x1d, 0xec, 0x8d, 0x50,
+	0x30, 0xa4, 0xa5, 0x48, 0x08, 0x68, 0x29, 0xf2, 0x27, 0xec, 0xcb, 0x9a, 0x7e, 0xab, 0x21, 0x72,
+	0xa3, 0x7d, 0x59, 0x02, 0xd4, 0xe2, 0x35, 0x01, 0x51, 0xa6, 0xd1, 0x20, 0xa0, 0x0f, 0x42, 0xd7,
+	0x79, 0x50, 0xb5, 0xeb, 0xf5, 0x10, 0xeb, 0x5f, 0x1c, 0x7a, 0xbd, 0x5e, 0x0f, 0x35, 0x87, 0x04,
+	0x44, 0x99, 0x46, 0x03, 0x87, 0x5a, 0xd3, 0x6f, 0xd7, 0xc5, 0x51, 0x4f, 0x6c, 0x6a, 0x04, 0xa8,
+	0x0c, 0xf5, 0x28, 0x39, 0x24, 0x20, 0xd8, 0x63, 0xab, 0xdf, 0xa0, 0xe7, 0xb4, 0xec, 0xd8, 0xed,
+	0x38, 0x55, 0xb9, 0x66, 0x4e, 0x6a, 0x3d, 0x47, 0x20, 0x92, 0xab, 0x44, 0xab, 0x4a, 0x85, 0xd4,
+	0x50, 0xca, 0x0c, 0x22, 0xda, 0x22, 0x44, 0xaf, 0xaf, 0x23, 0xdf, 0x4c, 0x7a, 0xdf, 0x6f, 0x19,
+	0x2a, 0xec, 0xb7, 0xfc, 0x16, 0x52, 0x61, 0x21, 0x45, 0x19, 0x07, 0xd2, 0xff, 0xb7, 0x44, 0xe6,
+	0xf1, 0x04, 0x19, 0x6e, 0x63, 0xfd, 0x0d, 0x42, 0x50, 0x78, 0x72, 0xbc, 0xb3, 0x46, 0xb1, 0xc9,
+	0xd5, 0xce, 0x5a, 0x07, 0x26, 0xd7, 0x68, 0x10, 0xde, 0x9d, 0xc0, 0xb8, 0x92, 0xc7, 0x85, 0xf7,
+	0xfe, 0xde, 0x86, 0xcc, 0x2d, 0x85, 0xb7, 0x04, 0x50, 0xa6, 0x50, 0xb0, 0xb4, 0x4a, 0x31, 0x8c,
+	0x8e, 0xfa, 0xf2, 0x35, 0x51, 0x58, 0x0a, 0x64, 0x7e, 0xb9, 0x26, 0x6a, 0x18, 0x65, 0x88, 0xc0,
+	0x72, 0xc8, 0x5a, 0x8e, 0x17, 0x54, 0xf8, 0x16, 0xa4, 0xc3, 0x35, 0xe3, 0xce, 0x8c, 0xb4, 0xc3,
+	0x35, 0x8b, 0xa3, 0x2c, 0x27, 0x03, 0x2c, 0xbd, 0x20, 0x92, 0x03, 0xdb, 0x0d, 0x71, 0x28, 0x77,
+	0x3e, 0xc1, 0x6f, 0x39, 0x47, 0x7b, 0xb6, 0x1b, 0x9a, 0xd6, 0x58, 0x04, 0xa4, 0x0c, 0x93, 0x48,
+	0x65, 0x40, 0x9f, 0x71, 0x9e, 0xd6, 0x1f, 0xbe, 0xbf, 0x83, 0x8e, 0x38, 0xcb, 0x0f, 0xd7, 0x30,
+	0xca, 0x10, 0x01, 0x2c, 0x14, 0x4a, 0x2c, 0xbb, 0xf5, 0xf2, 0x8c, 0x9e, 0xba, 0xfb, 0x3b, 0x20,
+	0x67, 0xf1, 0x42, 0xa1, 0x20, 0x94, 0x25, 0x48, 0x08, 0x4e, 0x6f, 0x48, 0xf5, 0x3a, 0xde, 0x0b,
+	0xef, 0xef, 0x24, 0xa2, 0xba, 0xae, 0x87, 0x3d, 0x86, 0x52, 0x66, 0x10, 0x29, 0x43, 0x27, 0x19,
+	0xc1, 0xd0, 0xb9, 0x4b, 0x66, 0xe5, 0xf2, 0xef, 0xd6, 0xcb, 0x73, 0x5d, 0x18, 0xf0, 0x2f, 0x13,
+	0xb1, 0x15, 0xf1, 0x97, 0x29, 0x08, 0x65, 0x09, 0xd2, 0x7a, 0x9d, 0x4c, 0xc3, 0x88, 0x04, 0x6e,
+	0xf3, 0x5d, 0xb8, 0xf1, 0x69, 0xb8, 0x1f, 0xd4, 0xb6, 0xb7, 0x37, 0xf5, 0x34, 0x14, 0x69, 0xca,
+	0x24, 0xc2, 0x62, 0x84, 0x28, 0x35, 0xc1, 0xad, 0x97, 0x17, 0xba, 0xb0, 0xe2, 0xb3, 0x45, 0x5a,
+	0x7c, 0xb7, 0x37, 0xf5, 0x6c, 0x49, 0x40, 0x94, 0x69, 0xb4, 0x15, 0x91, 0xd5, 0xb4, 0xf2, 0x00,
+	0xda, 0xc3, 0xe2, 0xd5, 0x52, 0x2e, 0x73, 0x88, 0x4a, 0xbf, 0x62, 0xfa, 0xfe, 0x85, 0x42, 0x51,
+	0xce, 0x19, 0xbd, 0xdb, 0x5c, 0xa3, 0xc8, 0x92, 0x5b, 0x6f, 0x91, 0xf9, 0x64, 0xec, 0xc2, 0xa7,
+	0x2c, 0x75, 0xf9, 0x14, 0x3e, 0x04, 0xe5, 0x48, 0xdd, 0xc6, 0xa1, 0x38, 0x35, 0x8c, 0x32, 0x44,
+	0x00, 0xd2, 0x23, 0x8a, 0xed, 0x30, 0x16, 0x1b, 0x25, 0xa4, 0xa0, 0x57, 0x00, 0x2a, 0xb7, 0x49,
+	0xcb, 0x49, 0x58, 0x55, 0x01, 0x82, 0xf6, 0x50, 0xbf, 0xd1, 0x46, 0x65, 0x65, 0x80, 0x8d, 0x4a,
+	0x3f, 0xc1, 0xf9, 0x6d, 0xb2, 0xd2, 0x72, 0xe2, 0x47, 0x7e, 0xf8, 0xb0, 0xea, 0xb6, 0x62, 0x27,
+	0x7c, 0x60, 0xd7, 0x54, 0x8c, 0x75, 0xbe, 0x72, 0xee, 0x0a, 0xe4, 0xb6, 0xc2, 0xe9, 0x95, 0x33,
+	0x8d, 0xa1, 0x2c, 0x43, 0x6c, 0x6e, 0x83, 0x56, 0xf5, 0x7c, 0xdb, 0xcb, 0x6c, 0x83, 0xf6, 0xf4,
+	0x36, 0x48, 0xfd, 0x4c, 0x6d, 0x66, 0xd6, 0x74, 0x5b, 0xed, 0x65, 0x37, 0x33, 0x7b, 0x68, 0x33,
+	0xb3, 0xd7, 0x65, 0x33, 0x73, 0x0e, 0x71, 0xc8, 0x6e, 0x66, 0xf6, 0xd0, 0x66, 0x66, 0xaf, 0xdb,
+	0x66, 0xe6, 0xbc, 0x16, 0x3c, 0x7b, 0x39, 0x9b, 0x99, 0x3d, 0xbc, 0x99, 0xd9, 0xeb, 0xbe, 0x99,
+	0xb9, 0x80, 0xe5, 0x57, 0x76, 0x33, 0xa3, 0x61, 0x5c, 0x7e, 0x75, 0xdf, 0xcc, 0x94, 0xb5, 0x44,
+	0xdd, 0xdf, 0xc9, 0xd9, 0xcc, 0x20, 0x20, 0x65, 0x98, 0x04, 0x34, 0x54, 0xd0, 0x99, 0xed, 0x5a,
+	0xcd, 0x89, 0xa2, 0x6a, 0xe0, 0x43, 0x94, 0xcc, 0x8b, 0x5a, 0x43, 0xad, 0x54, 0xde, 0xb8, 0xce,
+	0x51, 0x7b, 0xbe, 0x08, 0x94, 0x29, 0x35, 0x54, 0x13, 0x4e, 0x59, 0x8a, 0x30, 0xc7, 0x68, 0x7c,
+	0x69, 0xfc, 0x46, 0x63, 0x53, 0x21, 0x45, 0xfa, 0xfe, 0xfd, 0x8c, 0x42, 0x7a, 0x5f, 0x2b, 0xa4,
+	0xc9, 0x4f, 0xe1, 0x7e, 0xe1, 0x2a, 0xe7, 0xa9, 0xb9, 0x5f, 0x80, 0x7b, 0xe2, 0x7e, 0xe9, 0x6e,
+	0x40, 0xfe, 0x31, 0x77, 0xbf, 0x48, 0xe2, 0xe1, 0xdc, 0x2f, 0xb9, 0x96, 0xd4, 0xe2, 0x78, 0x2d,
+	0xa9, 0xa5, 0x8f, 0xbf, 0x25, 0xf5, 0x1a, 0xb7, 0xa4, 0x8a, 0x83, 0x6c, 0x6b, 0x19, 0x4b, 0x6a,
+	0xf2, 0xee, 0x57, 0x9e, 0x21, 0xf5, 0xc7, 0x33, 0x64, 0x5a, 0x12, 0x0d, 0xd7, 0x35, 0x62, 0x9a,
+	0x8a, 0xb5, 0x2a, 0x72, 0xdf, 0x37, 0xee, 0xef, 0x4a, 0x2b, 0x68, 0xc5, 0x7d, 0xdf, 0xc1, 0x36,
+	0x87, 0x04, 0xc8, 0x6d, 0x0e, 0x49, 0x6a, 0xf8, 0xae, 0x18, 0xdb, 0xd1, 0x93, 0x1c, 0x6b, 0xc7,
+	0xe4, 0x58, 0xad, 0x1d, 0x53, 0xa3, 0x59, 0x3b, 0xa6, 0x47, 0xb5, 0x76, 0xcc, 0x8c, 0x68, 0xed,
+	0x98, 0x1d, 0x8f, 0xb5, 0x83, 0x9c, 0x8e, 0xb5, 0x63, 0x6e, 0x0c, 0xd6, 0x8e, 0xf9, 0x53, 0xb0,
+	0x76, 0x2c, 0x9c, 0xdc, 0xda, 0x61, 0x48, 0xf9, 0xc5, 0x61, 0xcd, 0x0e, 0xf9, 0x36, 0x81, 0xa5,
+	0x71, 0xd9, 0x04, 0x5c, 0xf2, 0x94, 0xf6, 0x2d, 0x0a, 0xc3, 0x7a, 0xaf, 0x37, 0xc5, 0x2e, 0x67,
+	0xcc, 0x1d, 0x3a, 0x4f, 0xbf, 0x45, 0xe2, 0x7b, 0xa4, 0xdc, 0xb5, 0x98, 0x5e, 0x81, 0x49, 0x52,
+	0xa5, 0x0c, 0xe2, 0x0e, 0xa1, 0xbf, 0x39, 0x49, 0x16, 0xcd, 0x7c, 0xa7, 0xea, 0xd5, 0x2c, 0x9d,
+	0xc0, 0x51, 0x33, 0x31, 0x56, 0x47, 0xcd, 0xe4, 0xd8, 0xbd, 0x9a, 0x53, 0x63, 0x59, 0x8b, 0x6f,
+	0x92, 0x79, 0xcf, 0x8e, 0x62, 0x27, 0x04, 0x83, 0x5f, 0x22, 0xfe, 0xb8, 0xe6, 0x28, 0xe0, 0xfb,
+	0x1e, 0xde, 0x76, 0x68, 0x18, 0x65, 0x88, 0x00, 0xe6, 0x92, 0x64, 0xe3, 0x06, 0x78, 0xe3, 0x2b,
+	0x80, 0xdb, 0x81, 0x9e, 0x4b, 0x0a, 0x42, 0x59, 0x82, 0x04, 0x99, 0x21, 0x73, 0x27, 0x6e, 0x09,
+	0xe4, 0x32, 0x12, 0xa8, 0x4a, 0xe5, 0x0d, 0xe9, 0x9c, 0x58, 0xc3, 0x8c, 0x24, 0x98, 0x32, 0x93,
+	0xcc, 0xfa, 0x06, 0x5f, 0x97, 0x49, 0xce, 0xe4, 0x80, 0x25, 0x17, 0x0d, 0xdb, 0xae, 0xcb, 0xf3,
+	0xef, 0x4e, 0x93, 0x45, 0x93, 0xf6, 0x14, 0x86, 0xea, 0x35, 0x32, 0xcb, 0x2d, 0xae, 0x9e, 0xf6,
+	0x75, 0xf2, 0xa5, 0x07, 0x4c, 0xa4, 0x1e, 0x5e, 0x7a, 0x24, 0x80, 0x32, 0x85, 0x42, 0xa3, 0x7c,
+	0xe2, 0x04, 0xa3, 0x7c, 0x72, 0xac, 0xa3, 0x7c, 0xea, 0x24, 0xa3, 0x5c, 0x1b, 0xfd, 0x8c, 0x33,
+	0x09, 0xc8, 0xe8, 0x97, 0xae, 0x1b, 0x86, 0x26, 0x46, 0x3f, 0x59, 0xb7, 0x9f, 0x41, 0xe7, 0xa6,
+	0xb1, 0x1b, 0x9e, 0xcb, 0x38, 0x05, 0x83, 0x8c, 0x53, 0x30, 0xd0, 0x4e, 0xc1, 0x20, 0xb5, 0x97,
+	0x9d, 0xcf, 0x3a, 0xe6, 0x82, 0xac, 0x63, 0x2e, 0x40, 0x8e, 0xb9, 0xc0, 0x70, 0x2b, 0x2e, 0x0c,
+	0xe5, 0x56, 0xc4, 0x1e, 0xfb, 0xc5, 0xb1, 0x79, 0xec, 0xe9, 0x86, 0xda, 0x88, 0x9d, 0xe0, 0x1d,
+	0x55, 0xfa, 0x77, 0x93, 0xed, 0x9c, 0x18, 0xa7, 0x23, 0x07, 0x77, 0x87, 0xc5, 0x36, 0x15, 0xdc,
+	0x1d, 0x40, 0x58, 0x51, 0x14, 0x69, 0x08, 0xb3, 0xc7, 0x7f, 0xc0, 0x1c, 0xb7, 0xf1, 0x45, 0x2e,
+	0x9e, 0xc9, 0x56, 0x73, 0x4a, 0x66, 0xb2, 0xe5, 0x6c, 0x92, 0x08, 0xfa, 0xdb, 0x45, 0xb2, 0xb6,
+	0xe1, 0x47, 0x31, 0x73, 0xa0, 0x27, 0x46, 0xfd, 0xee, 0x11, 0x6b, 0xfc, 0x39, 0x32, 0x01, 0x57,
+	0x29, 0x70, 0x30, 0x72, 0x48, 0xeb, 0x22, 0x20, 0x45, 0x19, 0x07, 0x82, 0x3c, 0x8d, 0xd5, 0x5e,
+	0x8e, 0xcb, 0xd3, 0xd8, 0xd7, 0xf2, 0x34, 0xf6, 0x29, 0x2b, 0xc6, 0x3e, 0x7f, 0x5b, 0x88, 0x6b,
+	0xb9, 0x07, 0x47, 0x38, 0x6c, 0x04, 0x87, 0xe1, 0x9b, 0x70, 0x12, 0x00, 0x57, 0xa2, 0xc5, 0x2f,
+	0x68, 0xbd, 0x07, 0x7e, 0xe8, 0xd9, 0x31, 0xde, 0x0b, 0x08, 0x88, 0xfe, 0x00, 0x91, 0x86, 0x90,
+	0x41, 0xe2, 0xc7, 0x5f, 0x28, 0x10, 0x4b, 0xb7, 0xde, 0x80, 0xef, 0xbf, 0xe8, 0x0c, 0xca, 0x7d,
+	0x1f, 0x18, 0x97, 0x3f, 0x44, 0x9a, 0xbb, 0xef, 0xe1, 0x07, 0x5c, 0x31, 0xac, 0x45, 0x1d, 0x1c,
+	0xe5, 0xaa, 0x16, 0x75, 0xb4, 0x21, 0xb6, 0x16, 0x75, 0x28, 0x03, 0x10, 0xfd, 0x4b, 0x93, 0x64,
+	0x1e, 0xb3, 0xff, 0x99, 0xeb, 0xc3, 0x37, 0x65, 0xbb, 0x4f, 0xe5, 0x1c, 0x34, 0xc7, 0x0d, 0xb3,
+	0x1d, 0x3b, 0x9e, 0xa8, 0x2a, 0x90, 0xeb, 0xaa, 0x42, 0x8a, 0x32, 0x0e, 0x04, 0x09, 0xa7, 0x02,
+	0xcf, 0x44, 0x31, 0x5f, 0x5d, 0x0a, 0x42, 0xc2, 0xc9, 0xb0, 0x32, 0x51, 0xac, 0x25, 0x5c, 0x02,
+	0xa2, 0x4c, 0xa3, 0xad, 0x9f, 0x27, 0x97, 0x25, 0x87, 0x76, 0x18, 0xc2, 0xc2, 0x62, 0x46, 0xc0,
+	0x98, 0xe1, 0x2c, 0xe1, 0xed, 0xc8, 0x0b, 0x22, 0x8f, 0xa0, 0x82, 0xac, 0x7b, 0x4e, 0xf8, 0x86,
+	0x88, 0x7b, 0x71, 0x05, 0x17, 0x90, 0x21, 0xa0, 0xac, 0x5b, 0x56, 0xeb, 0x17, 0x0b, 0xe4, 0x29,
+	0x51, 0x7a, 0x10, 0xfa, 0x70, 0x7c, 0xda, 0xa9, 0x83, 0x3a, 0x18, 0x1f, 0x36, 0x8f, 0xc4, 0x17,
+	0xcd, 0xf2, 0xe2, 0x21, 0x80, 0xe8, 0x45, 0x4e, 0xb7, 0xa7, 0xc8, 0x76, 0x04, 0x95, 0xfc, 0xc2,
+	0xab, 0xa8, 0x02, 0x79, 0x24, 0x94, 0x75, 0xcf, 0x4e, 0x3f, 0x98, 0x20, 0xcb, 0xe9, 0x76, 0x07,
+	0x2b, 0x02, 0xef, 0x2f, 0x1c, 0xa0, 0xa8, 0x21, 0x2f, 0xe8, 0xcc, 0xa3, 0x9e, 0xa5, 0x4c, 0x80,
+	0xad, 0xe7, 0xc9, 0x54, 0xc7, 0x83, 0xf0, 0x18, 0xe5, 0xa2, 0x8e, 0xde, 0xd3, 0xf1, 0x76, 0xdb,
+	0x9e, 0xce, 0xc1, 0x93, 0x10, 0x17, 0x09, 0xfe, 0x83, 0x6f, 0x24, 0x6c, 0xb7, 0x5a, 0x6e, 0xab,
+	0x51, 0x95, 0x39, 0x45, 0xdc, 0x1f, 0xf1, 0x9c, 0xb5, 0xc0, 0xec, 0x4b, 0x06, 0xea, 0x39, 0x6b,
+	0x04, 0x85, 0xe7, 0xac, 0x51, 0x92, 0x3f, 0x8e, 0x2d, 0xd9, 0x41, 0xcf, 0x09, 0x1d, 0xaa, 0x60,
+	0x70, 0x83, 0x36, 0x8f, 0x32, 0xdc, 0x38, 0x54, 0x73, 0xe3, 0x49, 0x7e, 0x89, 0xca, 0xe7, 0xb7,
+	0x21, 0x80, 0x09, 0x1f, 0x85, 0x35, 0xd1, 0xd6, 0x73, 0x3a, 0xc8, 0x09, 0x65, 0x1c, 0xc8, 0xef,
+	0x23, 0xe6, 0x8e, 0x9e, 0x29, 0x9e, 0x5b, 0xdc, 0x47, 0xcc, 0x1b, 0x38, 0x17, 0x93, 0x40, 0x57,
+	0x99, 0x31, 0x93, 0x93, 0x01, 0x8e, 0xf1, 0x77, 0x19, 0x27, 0x62, 0xe4, 0xf3, 0x63, 0xfc, 0x41,
+	0xfe, 0x10, 0xb9, 0xac, 0x96, 0xf9, 0xbc, 0xd1, 0x91, 0x9b, 0x89, 0x76, 0xc8, 0xb2, 0x58, 0x25,
+	0xcf, 0x76, 0xc1, 0xa1, 0xdf, 0x26, 0xcb, 0xea, 0x34, 0x62, 0x97, 0x57, 0xbd, 0xbb, 0x1c, 0x70,
+	0x4c, 0xb8, 0x77, 0x3c, 0x93, 0x3b, 0x6c, 0x00, 0x24, 0x82, 0xfe, 0x33, 0xfe, 0x2e, 0xca, 0xbe,
+	0x77, 0x12, 0x4b, 0xee, 0x68, 0x42, 0xd8, 0x7c, 0xd2, 0xec, 0x24, 0xdf, 0xf0, 0x93, 0x02, 0x39,
+	0x0f, 0x39, 0x4e, 0x7c, 0x5f, 0x6f, 0xb4, 0x0f, 0x79, 0xd3, 0xf8, 0x90, 0x7c, 0x1b, 0xa9, 0x5e,
+	0x0d, 0x3a, 0x5e, 0x6a, 0x35, 0x80, 0x2f, 0x51, 0x28, 0xea, 0x91, 0x73, 0xe6, 0x96, 0x4c, 0xf5,
+	0xf8, 0xbd, 0x1e, 0x76, 0x0a, 0x33, 0x87, 0xb4, 0x65, 0xf1, 0x74, 0xc7, 0xd3, 0xfa, 0xa3, 0x82,
+	0x80, 0x2d, 0x4b, 0xfd, 0xfc, 0x8d, 0x82, 0xd8, 0x02, 0x9e, 0xb1, 0x0e, 0xf5, 0x79, 0x32, 0x89,
+	0x37, 0x84, 0xbc, 0x8c, 0x8e, 0x87, 0xcb, 0xe8, 0xf0, 0xad, 0x20, 0x07, 0xd2, 0x3f, 0x92, 0x43,
+	0xf4, 0xec, 0xb5, 0xd3, 0xa1, 0xea, 0x89, 0x74, 0xd9, 0x89, 0xc1, 0x75, 0xd9, 0x47, 0xe4, 0xa2,
+	0xf0, 0x5e, 0xc0, 0xe9, 0x1e, 0xa7, 0x55, 0x37, 0xa6, 0xf9, 0xb7, 0x8c, 0x4e, 0xbf, 0x92, 0x31,
+	0x4e, 0x19, 0xb9, 0xc4, 0x4a, 0x1f, 0x2a, 0x90, 0x5e, 0xe9, 0x13, 0x10, 0x65, 0x1a, 0x4d, 0x7f,
+	0xa7, 0x48, 0x56, 0x32, 0x3c, 0xac, 0x87, 0xdc, 0xcf, 0x96, 0x50, 0x49, 0xe3, 0xdb, 0x95, 0x9c,
+	0x31, 0x8d, 0x4b, 0xe6, 0xab, 0x0a, 0xce, 0xa7, 0x57, 0x15, 0x0c, 0xa5, 0xcc, 0x20, 0xca, 0xf1,
+	0x7a, 0x14, 0x4f, 0xe8, 0xf5, 0x78, 0x48, 0x96, 0x34, 0xc7, 0xc0, 0x0e, 0x6d, 0xaf, 0xf7, 0x9d,
+	0x0b, 0xbe, 0x53, 0x4e, 0x72, 0xec, 0x41, 0x06, 0xbd, 0x53, 0x36, 0xe1, 0x94, 0xa5, 0x08, 0xe9,
+	0x9f, 0x2d, 0x91, 0x95, 0x4c, 0x5b, 0x58, 0x77, 0xf8, 0xca, 0x1f, 0x3a, 0xef, 0xc9, 0x5e, 0x7b,
+	0xba, 0x7b, 0xdb, 0x25, 0xef, 0x45, 0x77, 0x40, 0x46, 0x60, 0xc5, 0x80, 0x39, 0xef, 0x71, 0xc5,
+	0x00, 0x3c, 0x27, 0x55, 0x7e, 0x5e, 0x3c, 0x08, 0x5d, 0x1f, 0xac, 0xd8, 0x32, 0xba, 0xe5, 0xc5,
+	0x0c, 0xd7, 0x3d, 0x49, 0xa0, 0x4e, 0x78, 0xaa, 0x34, 0x3e, 0xe1, 0xa9, 0x60, 0xfc, 0x84, 0xa7,
+	0x4a, 0xe4, 0x74, 0x43, 0x69, 0xfc, 0xdd, 0x30, 0x71, 0x6a, 0xdd, 0xf0, 0xe3, 0x02, 0x99, 0xc7,
+	0x0d, 0x00, 0xa7, 0xcb, 0x92, 0xd6, 0x42, 0xa7, 0xcb, 0x02, 0xdd, 0x20, 0x4b, 0xc9, 0x26, 0x5f,
+	0x36, 0x47, 0x82, 0xb4, 0x76, 0xc8, 0xb4, 0x3c, 0x28, 0xd3, 0xef, 0x99, 0x2f, 0x19, 0x38, 0xba,
+	0x92, 0x0a, 0x1c, 0x5d, 0x51, 0x81, 0xa3, 0xf9, 0x8f, 0xbf, 0x51, 0x20, 0x97, 0x8c, 0x59, 0x76,
+	0x92, 0xe5, 0xe9, 0x6d, 0xc3, 0x63, 0xfa, 0x74, 0x77, 0x71, 0x00, 0x03, 0x6b, 0x38, 0x69, 0xf0,
+	0x3f, 0x8b, 0x64, 0x39, 0xcd, 0xc2, 0x18, 0xca, 0xa5, 0x71, 0x0c, 0xe5, 0x8f, 0xf7, 0x84, 0x07,
+	0x15, 0x1d, 0x82, 0xce, 0x89, 0xf7, 0x73, 0xb8, 0x8a, 0x8e, 0x4c, 0xe8, 0x9e, 0xfd, 0x58, 0x3c,
+	0x80, 0x63, 0xa8, 0xe8, 0x18, 0x4a, 0x99, 0x41, 0x44, 0xff, 0xce, 0x04, 0x59, 0x4e, 0x37, 0x22,
+	0x18, 0xcb, 0x42, 0x31, 0x38, 0x70, 0x34, 0x64, 0x6e, 0x2c, 0x93, 0x70, 0xf3, 0xc8, 0x17, 0x02,
+	0x52, 0x86, 0x49, 0x72, 0x6a, 0x5b, 0x3c, 0x41, 0x6d, 0xc1, 0xf6, 0x06, 0x2f, 0x8f, 0x09, 0x7f,
+	0x6c, 0x49, 0x4f, 0x2b, 0x00, 0x4a, 0x67, 0xac, 0x9c, 0x56, 0x0a, 0x42, 0x59, 0x82, 0x84, 0x53,
+	0x20, 0x9e, 0xe3, 0xf9, 0xe1, 0x91, 0xc8, 0x8f, 0xce, 0xdd, 0x09, 0xb0, 0xe4, 0xb0, 0x92, 0x44,
+	0x8c, 0x94, 0x30, 0x30, 0xc2, 0x27, 0x09, 0xa8, 0x03, 0x9c, 0xda, 0x10, 0x3c, 0x26, 0x75, 0x1d,
+	0x00, 0x68, 0xd6, 0x41, 0x41, 0x28, 0x4b, 0x90, 0x39, 0xa3, 0x6f, 0x6a, 0xfc, 0xa3, 0x6f, 0xfa,
+	0xd4, 0xe4, 0xdc, 0x07, 0x05, 0xf2, 0x94, 0x31, 0x45, 0x4f, 0xa6, 0xb4, 0xbf, 0x61, 0x08, 0x13,
+	0x53, 0xa1, 0xdc, 0x74, 0x82, 0xa6, 0x7f, 0xc4, 0x8b, 0x6e, 0xda, 0x2d, 0xc1, 0x29, 0x68, 0xda,
+	0x2d, 0xcd, 0x09, 0x52, 0x94, 0x71, 0x20, 0xfd, 0x6f, 0x05, 0xb2, 0x68, 0xe6, 0x80, 0x23, 0x56,
+	0x32, 0xd2, 0x75, 0xde, 0x05, 0x44, 0x11, 0x20, 0x56, 0x0b, 0xd1, 0x3e, 0x41, 0xae, 0x21, 0xb8,
+	0x33, 0x5a, 0xfe, 0xb2, 0xe6, 0x29, 0x25, 0xf9, 0xb5, 0xf6, 0x3b, 0x98, 0xac, 0x37, 0x22, 0xec,
+	0xce, 0xf6, 0x8f, 0xb0, 0x4b, 0xab, 0x84, 0xe8, 0xba, 0x43, 0x38, 0xef, 0xc0, 0x6f, 0xba, 0xb5,
+	0xa3, 0xdc, 0x27, 0xcb, 0x05, 0xa1, 0x8e, 0x67, 0xcd, 0xbf, 0x54, 0xd0, 0xeb, 0x2f, 0x15, 0x69,
+	0xca, 0x24, 0x82, 0xfe, 0x7a, 0x81, 0x2c, 0xa5, 0x32, 0x8e, 0xf6, 0x7c, 0xc9, 0x5b, 0x38, 0xe4,
+	0xb6, 0x50, 0x19, 0xcc, 0x13, 0x36, 0x77, 0x78, 0x00, 0xe7, 0xa1, 0x03, 0x6d, 0x43, 0xf8, 0xe6,
+	0xd9, 0x24, 0xaf, 0x11, 0x3a, 0xba, 0x30, 0x6c, 0xe8, 0xe8, 0x6b, 0x66, 0x1d, 0x75, 0x08, 0x30,
+	0x20, 0x68, 0x21, 0xd7, 0x90, 0x04, 0x40, 0x08, 0x30, 0xf9, 0xab, 0x46, 0xe6, 0x71, 0xa7, 0x5b,
+	0x95, 0x54, 0x57, 0x5c, 0xc9, 0x1d, 0x1f, 0x43, 0x76, 0xc6, 0xbf, 0x2f, 0x90, 0x95, 0x4c, 0xd6,
+	0xd1, 0xba, 0x43, 0xbf, 0xf5, 0x82, 0x76, 0x1f, 0xfd, 0xde, 0x7a, 0x79, 0x97, 0xcc, 0x72, 0x99,
+	0xe2, 0xc0, 0x3c, 0x2a, 0xe5, 0x0c, 0xb1, 0x3d, 0x85, 0x15, 0x02, 0x46, 0x3a, 0x33, 0x14, 0x10,
+	0x39, 0x33, 0x14, 0x08, 0x9c, 0x19, 0xc9, 0xef, 0x1a, 0x59, 0x4a, 0x31, 0x00, 0xab, 0x2d, 0xbc,
+	0x62, 0x5c, 0xd0, 0x56, 0xdb, 0x87, 0xce, 0x91, 0xb6, 0xda, 0x3e, 0x84, 0xe7, 0x6e, 0x01, 0x04,
+	0x84, 0x1d, 0xbb, 0xc9, 0x07, 0x96, 0x24, 0xec, 0xd8, 0x4d, 0x4d, 0xd8, 0xb1, 0x9b, 0x94, 0x01,
+	0x88, 0x3e, 0x22, 0xab, 0xe0, 0xe5, 0xdf, 0xf0, 0xea, 0x42, 0x74, 0xc9, 0x8d, 0xcd, 0x77, 0x4d,
+	0xe7, 0xbe, 0x19, 0x73, 0x5e, 0x13, 0xb7, 0x9b, 0xb1, 0xb4, 0x58, 0xf1, 0xdf, 0x55, 0x3b, 0x0c,
+	0xed, 0x23, 0x64, 0xb1, 0x42, 0x50, 0xb0, 0x58, 0xe1, 0xe4, 0xbf, 0x29, 0x90, 0x05, 0x83, 0x11,
+	0xde, 0x02, 0x16, 0x46, 0xd8, 0x02, 0x16, 0x07, 0xd9, 0x02, 0x4a, 0xea, 0x20, 0xb5, 0x61, 0x0c,
+	0x0c, 0xea, 0x40, 0x50, 0x07, 0xe2, 0xa0, 0x3e, 0xd4, 0x0d, 0x6f, 0x18, 0x43, 0xf5, 0xf8, 0xde,
+	0x02, 0xfe, 0x48, 0x6e, 0x59, 0xe7, 0x3f, 0xfe, 0x69, 0x81, 0xac, 0xc9, 0xdb, 0x1e, 0x67, 0x6f,
+	0xea, 0xb8, 0xde, 0xe3, 0x2d, 0x5e, 0x74, 0x05, 0x45, 0x18, 0xfc, 0x3d, 0x74, 0xf2, 0xba, 0xe6,
+	0xc1, 0xc9, 0x6b, 0xf8, 0xfb, 0xc7, 0x05, 0x72, 0x5e, 0x92, 0xfe, 0x49, 0x58, 0x9d, 0x86, 0xdb,
+	0xd2, 0xab, 0xef, 0x9d, 0x18, 0xfd, 0x7b, 0xbf, 0x5f, 0x20, 0x44, 0x93, 0x26, 0x67, 0x72, 0x90,
+	0x72, 0x97, 0x9c, 0xc9, 0xd9, 0xcd, 0xbc, 0x87, 0xbe, 0xab, 0xdf, 0x43, 0x57, 0x01, 0xc6, 0xd5,
+	0x2d, 0x20, 0x24, 0x30, 0x6b, 0xc9, 0x45, 0x1f, 0xe5, 0x4b, 0x57, 0x97, 0x7c, 0x14, 0x8a, 0xfe,
+	0x69, 0xf1, 0x1e, 0x3f, 0x77, 0xf4, 0x6e, 0x8b, 0x13, 0x12, 0x67, 0x38, 0x19, 0xdb, 0xe4, 0xf2,
+	0x8e, 0xdf, 0x72, 0x63, 0x3f, 0x14, 0x7c, 0x2a, 0xae, 0x17, 0x34, 0x9d, 0xa4, 0x02, 0xfb, 0x3d,
+	0xe2, 0x2d, 0xee, 0xf8, 0x2d, 0x9c, 0x87, 0x2f, 0xf1, 0xfc, 0xa3, 0x3d, 0xc1, 0x50, 0x7f, 0xb4,
+	0x04, 0x40, 0x98, 0x71, 0xf9, 0xeb, 0x8f, 0x0b, 0x64, 0x35, 0x27, 0xff, 0x99, 0x8c, 0xb3, 0x90,
+	0x2c, 0xf1, 0x5c, 0xb2, 0x2e, 0x70, 0x74, 0x2a, 0x4f, 0x84, 0xa7, 0xaa, 0x27, 0x5d, 0xf7, 0x35,
+	0x37, 0xda, 0x49, 0xf2, 0x21, 0xd7, 0xbd, 0x01, 0x07, 0xd7, 0xbd, 0x09, 0xf8, 0x57, 0x05, 0xb2,
+	0x94, 0x62, 0x38, 0xda, 0x72, 0x35, 0x9c, 0xd0, 0x43, 0x4f, 0x40, 0x14, 0x06, 0x79, 0x02, 0x02,
+	0x56, 0x0f, 0x27, 0x0c, 0xf1, 0x43, 0x35, 0x4e, 0x88, 0x9e, 0xc0, 0x71, 0x42, 0x78, 0x02, 0x07,
+	0xfe, 0x36, 0xc8, 0x9a, 0x1a, 0x37, 0xe2, 0xd5, 0x95, 0xe4, 0x7d, 0xa7, 0xee, 0xbe, 0x4a, 0x68,
+	0x00, 0x20, 0x1e, 0x6a, 0xa4, 0xfc, 0xdf, 0x09, 0x32, 0x8f, 0x33, 0x9e, 0x95, 0xef, 0x5b, 0x76,
+	0x4d, 0x69, 0xf0, 0xae, 0x51, 0xae, 0xcb, 0x89, 0xc1, 0x5d, 0x97, 0x93, 0x7d, 0x5d, 0x97, 0x51,
+	0xec, 0xf0, 0xb8, 0x8e, 0x7c, 0x43, 0x54, 0x12, 0xad, 0x06, 0xb0, 0x8a, 0x53, 0xd3, 0xad, 0x26,
+	0x01, 0x70, 0xa8, 0x41, 0xfc, 0x82, 0xa3, 0xb2, 0x76, 0xa3, 0x11, 0x3a, 0x0d, 0x1b, 0x5d, 0xd5,
+	0xe7, 0x5b, 0x55, 0x04, 0xd6, 0x5b, 0x55, 0x04, 0xa4, 0x0c, 0x93, 0xc0, 0x23, 0xb9, 0xa1, 0x13,
+	0xf9, 0xcd, 0x36, 0xe7, 0x33, 0xa3, 0x37, 0x87, 0x1a, 0xaa, 0x37, 0x87, 0x1a, 0x46, 0x19, 0x22,
+	0xb0, 0xf6, 0xc9, 0x2c, 0x58, 0x6e, 0x9c, 0xd0, 0x75, 0x22, 0x19, 0x29, 0xb2, 0x9c, 0x1e, 0x19,
+	0xfb, 0x5e, 0x85, 0xe3, 0xe5, 0xd6, 0x55, 0xa6, 0xd0, 0xd6, 0x55, 0x42, 0x60, 0xeb, 0x2a, 0x7f,
+	0x82, 0xfd, 0x8d, 0x77, 0xae, 0xe4, 0x4c, 0x72, 0xec, 0x6f, 0x3b, 0x7e, 0x0b, 0x84, 0x24, 0x3f,
+	0xc1, 0x2f, 0x77, 0xb5, 0x70, 0x04, 0x50, 0x31, 0x5f, 0xd1, 0xfd, 0xaf, 0xd8, 0x23, 0x02, 0x08,
+	0x47, 0x3b, 0x87, 0xea, 0xa6, 0x67, 0x5f, 0x61, 0x90, 0xd9, 0xf7, 0x26, 0xa8, 0xbe, 0x6e, 0x2b,
+	0x8e, 0xca, 0xc5, 0x9c, 0xad, 0xe7, 0x8e, 0xdf, 0x12, 0xb5, 0x92, 0x1a, 0x2f, 0x10, 0x62, 0x8d,
+	0x17, 0xd2, 0x5c, 0xe3, 0xe5, 0x3f, 0x0e, 0xc9, 0x8c, 0xca, 0x00, 0x03, 0x8d, 0x9f, 0xd6, 0x41,
+	0x95, 0x88, 0x5d, 0x7c, 0xca, 0x2b, 0xe6, 0xe7, 0x73, 0x38, 0xd0, 0x7c, 0x2d, 0xb1, 0xd0, 0x5f,
+	0x04, 0xd0, 0x1f, 0x15, 0xc9, 0x3c, 0x6e, 0xb5, 0xe1, 0x8a, 0x7b, 0x8e, 0x94, 0xec, 0x4e, 0x43,
+	0x16, 0xc6, 0x05, 0x88, 0xdd, 0x69, 0x68, 0x01, 0x62, 0x77, 0x1a, 0x94, 0x01, 0x48, 0x85, 0x44,
+	0x2e, 0x69, 0xc2, 0xde, 0x21, 0x91, 0x27, 0x10, 0x61, 0x6e, 0x48, 0x64, 0x20, 0x8c, 0xda, 0x5e,
+	0x79, 0x52, 0x13, 0x46, 0x6d, 0xf4, 0xc8, 0x56, 0x04, 0x86, 0x13, 0x00, 0xf1, 0x3b, 0x8a, 0x9e,
+	0x7c, 0x01, 0x66, 0x8a, 0x7b, 0x72, 0xf9, 0xb4, 0xea, 0x78, 0xea, 0xf9, 0x97, 0x45, 0xd5, 0x97,
+	0xf2, 0xed, 0x17, 0x85, 0xa2, 0x7f, 0x08, 0x96, 0x79, 0x21, 0x98, 0xce, 0xd8, 0x2f, 0xf3, 0x11,
+	0x91, 0x48, 0x9f, 0x23, 0x13, 0x20, 0x62, 0xca, 0x53, 0x9a, 0x23, 0xa4, 0x35, 0x47, 0x48, 0x41,
+	0x1c, 0xe9, 0xd8, 0x09, 0xf8, 0x58, 0x68, 0x34, 0xa4, 0xf0, 0x11, 0x63, 0xa1, 0x81, 0xc7, 0x42,
+	0x83, 0x8f, 0x85, 0x46, 0x83, 0xbe, 0x4f, 0x2e, 0x82, 0x0a, 0x74, 0xc3, 0x69, 0xd5, 0x0e, 0x3d,
+	0x3b, 0x7c, 0x68, 0x78, 0x5a, 0xde, 0xed, 0xa5, 0x03, 0x19, 0x59, 0x94, 0x2d, 0x0e, 0xd6, 0x58,
+	0xa5, 0x02, 0x59, 0x58, 0x05, 0x92, 0x1a, 0x10, 0x26, 0xa1, 0xff, 0xab, 0x48, 0x16, 0x0c, 0x2e,
+	0x48, 0xf7, 0x2f, 0x0c, 0xac, 0xfb, 0x43, 0xc3, 0xb4, 0x5b, 0x6e, 0x8c, 0x97, 0x65, 0x48, 0xeb,
+	0x86, 0x81, 0x14, 0x65, 0x1c, 0x08, 0xc4, 0x70, 0x1d, 0x01, 0x2b, 0xba, 0x90, 0xd6, 0xc4, 0x90,
+	0xa2, 0x8c, 0x03, 0x41, 0xb1, 0x74, 0x9a, 0x76, 0x10, 0x39, 0x2a, 0x72, 0x32, 0x1f, 0xac, 0x12,
+	0xa4, 0x07, 0xab, 0x04, 0x50, 0xa6, 0x50, 0xf8, 0x3e, 0xc2, 0xa4, 0x79, 0x1f, 0xc1, 0x4d, 0xdd,
+	0x47, 0x70, 0xd5, 0x7d, 0x04, 0xb7, 0x6e, 0xd5, 0x89, 0xa1, 0x20, 0x96, 0xa7, 0x4e, 0xa5, 0xd5,
+	0xff, 0x5e, 0x81, 0x2c, 0xdd, 0x00, 0xdf, 0xe6, 0xf5, 0x66, 0xf3, 0x2c, 0xa7, 0xd1, 0x35, 0x63,
+	0x97, 0x64, 0x06, 0x7f, 0xbf, 0xa1, 0xaf, 0xcc, 0x1c, 0xa0, 0x33, 0xb9, 0x07, 0x70, 0x26, 0xf7,
+	0xc0, 0xa3, 0x3f, 0x2d, 0x90, 0xf9, 0x1b, 0xde, 0xd9, 0x4f, 0xfb, 0xa1, 0x0f, 0xe1, 0x25, 0x1f,
+	0x39, 0x31, 0xfc, 0x47, 0xbe, 0x4c, 0x26, 0x6f, 0xa8, 0x4b, 0x41, 0x87, 0x70, 0x5e, 0x03, 0x7d,
+	0xdb, 0xa1, 0x71, 0xac, 0xe4, 0x50, 0x1c, 0x2b, 0xe1, 0xff, 0x62, 0xb1, 0x6f, 0xdc, 0xe3, 0xc6,
+	0x99, 0x1e, 0x6e, 0xd2, 0xec, 0x19, 0x7e, 0x9d, 0x45, 0x2f, 0xce, 0x7b, 0xca, 0xf8, 0x83, 0x16,
+	0xe7, 0x3d, 0x69, 0x00, 0x42, 0x04, 0xf4, 0x48, 0x3c, 0x34, 0xda, 0xa5, 0xe4, 0x77, 0xfa, 0x5d,
+	0x52, 0x38, 0x49, 0xd1, 0xff, 0x79, 0x42, 0x5c, 0x25, 0xd0, 0x3c, 0x86, 0xbb, 0x67, 0x2f, 0x0e,
+	0x73, 0x17, 0xb5, 0xac, 0xdd, 0x46, 0x87, 0xb9, 0xa1, 0xff, 0x8b, 0xdc, 0x0c, 0xac, 0x2c, 0x67,
+	0x62, 0x7b, 0xb2, 0x6a, 0x5a, 0x98, 0x38, 0x6a, 0x20, 0x73, 0x19, 0x1c, 0x3e, 0x13, 0x43, 0xa3,
+	0xda, 0xf4, 0x1b, 0x38, 0x28, 0x82, 0x80, 0xde, 0xf6, 0x1b, 0xda, 0x22, 0x95, 0x80, 0x28, 0xd3,
+	0xe8, 0xf1, 0xdd, 0x59, 0xfb, 0x0e, 0x59, 0x6d, 0xda, 0x51, 0x5c, 0x8d, 0x6a, 0x76, 0xd3, 0xa9,
+	0xfa, 0x6d, 0x79, 0x59, 0x78, 0x4a, 0xdf, 0x5d, 0x01, 0x74, 0x05, 0xb0, 0x77, 0xda, 0xea, 0xce,
+	0xf0, 0x05, 0x75, 0x1b, 0xcc, 0xc4, 0x50, 0x96, 0x21, 0xb6, 0xbe, 0x45, 0x2c, 0xc4, 0xdf, 0x6d,
+	0x09, 0xf6, 0xd3, 0xfa, 0xbe, 0x42, 0x92, 0x63, 0xbb, 0x25, 0xb9, 0x9f, 0x4f, 0x71, 0x17, 0x08,
+	0xca, 0xd2, 0xa4, 0x56, 0x93, 0x2c, 0x8a, 0x85, 0x35, 0xaa, 0x46, 0x7e, 0x3b, 0xac, 0x39, 0xf2,
+	0xbd, 0x2d, 0x53, 0x38, 0xee, 0x08, 0x92, 0x0a, 0xa7, 0x90, 0xb7, 0x08, 0x30, 0x08, 0xdd, 0x22,
+	0xc0, 0x60, 0xb8, 0x45, 0x60, 0xa4, 0x7f, 0x6d, 0x82, 0x2c, 0x18, 0xbc, 0x84, 0xf7, 0xd4, 0xef,
+	0xb8, 0x75, 0x27, 0x34, 0xbd, 0xa7, 0x02, 0x86, 0x2d, 0xea, 0x02, 0xc2, 0x2d, 0xea, 0xe2, 0x27,
+	0x77, 0xb1, 0x84, 0xbe, 0xe7, 0xc4, 0x87, 0x4e, 0x3b, 0xaa, 0xb6, 0xc3, 0xa6, 0xe1, 0xe0, 0x4b,
+	0x30, 0xf7, 0xc3, 0xa6, 0xae, 0xa0, 0x01, 0x06, 0x17, 0x0b, 0x4e, 0x5b, 0xbf, 0x54, 0x20, 0xcb,
+	0x88, 0xe5, 0x7b, 0x6d, 0x27, 0x54, 0x43, 0xf5, 0x8b, 0xdd, 0x5b, 0xe4, 0x0b, 0x7b, 0x49, 0x96,
+	0xbb, 0x90, 0xe3, 0x66, 0x2b, 0x0e, 0x8f, 0x44, 0xd7, 0x04, 0x26, 0x46, 0x77, 0x4d, 0x0a, 0x41,
+	0x59, 0x9a, 0xd4, 0xb2, 0xc9, 0x2a, 0xaa, 0x4a, 0xc7, 0xab, 0x8a, 0x9b, 0x85, 0x62, 0xa8, 0xf3,
+	0x6b, 0xf3, 0x1a, 0xbd, 0xef, 0xdd, 0x96, 0xb7, 0x0c, 0xcb, 0x69, 0xf6, 0x12, 0x45, 0x59, 0x96,
+	0x1c, 0x6e, 0x3e, 0xc2, 0x09, 0x73, 0x6d, 0x22, 0x42, 0x93, 0x20, 0x8a, 0x0e, 0xef, 0x6b, 0x2b,
+	0x91, 0x95, 0x1c, 0x35, 0xbf, 0x9f, 0x18, 0x8a, 0x30, 0xc9, 0xa5, 0x1b, 0x64, 0x2d, 0xaf, 0x15,
+	0xac, 0x65, 0x64, 0xe4, 0x15, 0xd6, 0xdc, 0x35, 0xac, 0xbd, 0xcf, 0x4a, 0x15, 0xfd, 0xcb, 0xc5,
+	0xd7, 0x0a, 0xf4, 0xdf, 0x96, 0xc8, 0x94, 0x90, 0x01, 0x30, 0x2e, 0x79, 0x08, 0x5b, 0xfc, 0x92,
+	0x67, 0x76, 0x5c, 0x42, 0x78, 0x5a, 0x6d, 0x66, 0xe7, 0xdd, 0x6e, 0x63, 0x90, 0xee, 0x76, 0x03,
+	0x4c, 0x99, 0x49, 0x66, 0xbd, 0x4b, 0xe6, 0x78, 0x69, 0xb6, 0x7e, 0xe2, 0x31, 0x6d, 0x7f, 0x83,
+	0xa2, 0xc4, 0x91, 0x20, 0x21, 0x5a, 0xed, 0x24, 0xad, 0x45, 0xab, 0x86, 0x51, 0x86, 0x08, 0x46,
+	0xbb, 0x40, 0xd5, 0x20, 0xbc, 0x92, 0xd5, 0xa8, 0x76, 0xe8, 0xd4, 0xdb, 0x4d, 0x47, 0x2e, 0x7d,
+	0x17, 0x33, 0xb5, 0xaa, 0x48, 0x02, 0x61, 0x2e, 0xb3, 0x11, 0x44, 0x9b, 0xcb, 0x30, 0x94, 0x32,
+	0x83, 0xc8, 0x3a, 0x20, 0x3c, 0x5d, 0x0d, 0x42, 0xa7, 0xee, 0xd6, 0xc4, 0xa9, 0xcb, 0xf4, 0x66,
+	0x16, 0xca, 0xd9, 0x13, 0x78, 0xb9, 0xe5, 0xd6, 0x00, 0xb4, 0xe5, 0xd6, 0x40, 0xd8, 0x72, 0xa3,
+	0xd4, 0xdf, 0x2a, 0x92, 0x39, 0xc4, 0x43, 0xbf, 0x36, 0x8c, 0x4e, 0xb8, 0x7a, 0xe6, 0x6b, 0xc3,
+	0x9e, 0x7c, 0x6d, 0x98, 0xff, 0x87, 0x03, 0xa6, 0x91, 0x63, 0x47, 0x20, 0xee, 0x9d, 0x56, 0x23,
+	0x3e, 0x94, 0x07, 0x5d, 0xf9, 0x27, 0x0b, 0xc4, 0x6d, 0x0e, 0xd7, 0x9f, 0x8c, 0xa1, 0x94, 0x19,
+	0x44, 0x30, 0xee, 0x9b, 0x8e, 0x2d, 0x2e, 0x88, 0x54, 0xd5, 0x96, 0x6d, 0x52, 0x7c, 0x19, 0x20,
+	0x40, 0x34, 0xee, 0xb8, 0x48, 0xf8, 0x23, 0x20, 0x65, 0x98, 0x04, 0x64, 0x90, 0x5c, 0x87, 0x9c,
+	0x96, 0x7d, 0xd0, 0x94, 0x1a, 0xed, 0x8c, 0x1c, 0x8c, 0x1c, 0x73, 0x53, 0x20, 0xd0, 0x60, 0xc4,
+	0x60, 0x18, 0x8c, 0x46, 0xfa, 0xb7, 0x8a, 0x64, 0x1e, 0xf7, 0x2b, 0x18, 0x71, 0x79, 0x45, 0x79,
+	0xc4, 0x1c, 0x24, 0x24, 0x01, 0x28, 0xa3, 0xe6, 0x2c, 0xe9, 0x5d, 0xab, 0x88, 0x9c, 0x93, 0x20,
+	0xad, 0x5d, 0x32, 0x29, 0x42, 0xd1, 0x17, 0x73, 0x4e, 0x55, 0xe0, 0x72, 0x18, 0x8c, 0x21, 0xde,
+	0x13, 0xa1, 0x0c, 0x3e, 0x3f, 0xaf, 0x8e, 0xea, 0xf2, 0xa0, 0xf3, 0x02, 0x0c, 0xbe, 0x75, 0xbb,
+	0x26, 0x62, 0x09, 0xc1, 0xa8, 0x14, 0x2d, 0x27, 0xa6, 0x04, 0x07, 0x33, 0x31, 0xf0, 0x56, 0xf4,
+	0xa7, 0x0a, 0x18, 0x4c, 0x89, 0x24, 0x01, 0xd7, 0x89, 0x10, 0x17, 0xb1, 0xa2, 0x4d, 0xe8, 0xeb,
+	0x44, 0x9a, 0x52, 0x2e, 0x68, 0xe7, 0xd2, 0xec, 0xc4, 0x7a, 0x96, 0x22, 0xa4, 0x1f, 0x14, 0xc8,
+	0x72, 0xfa, 0x9b, 0xf8, 0x71, 0xe2, 0xd0, 0x6f, 0x61, 0x35, 0x06, 0xd2, 0xe8, 0x38, 0x71, 0x08,
+	0x33, 0x96, 0x03, 0xa1, 0x62, 0x75, 0x27, 0x72, 0x43, 0xa7, 0x5e, 0x4d, 0x36, 0xd4, 0x62, 0xac,
+	0xf1, 0x8a, 0x49, 0xdc, 0x7e, 0xb2, 0xaf, 0x3e, 0x97, 0x68, 0x07, 0x08, 0x4e, 0x59, 0x8a, 0x90,
+	0xfe, 0xeb, 0x09, 0xb2, 0x60, 0x48, 0xab, 0xd1, 0x4c, 0xa5, 0x27, 0x7a, 0x3c, 0x17, 0xde, 0x0b,
+	0x15, 0x1e, 0x4d, 0x7c, 0x35, 0xae, 0xbf, 0xff, 0x33, 0xf5, 0x16, 0x5e, 0xe0, 0x84, 0xae, 0xaf,
+	0x36, 0x6e, 0xa9, 0xb7, 0xf0, 0xf6, 0x38, 0x2e, 0xef, 0x2d, 0x3c, 0x81, 0x31, 0xde, 0xc2, 0x13,
+	0x20, 0xeb, 0x9b, 0x04, 0xc1, 0x44, 0x28, 0x0a, 0x19, 0xda, 0x88, 0xaf, 0xa0, 0x1a, 0xb7, 0x2f,
+	0x4d, 0x3c, 0xe7, 0xd3, 0xbc, 0xf7, 0x85, 0xb1, 0x27, 0x4d, 0x9a, 0xb6, 0x18, 0x4e, 0x8d, 0x6c,
+	0x31, 0xac, 0x11, 0xe2, 0x3c, 0x0e, 0x42, 0x27, 0x8a, 0x94, 0xe5, 0x31, 0xed, 0xf4, 0x35, 0xfa,
+	0xf6, 0xe6, 0xe3, 0x20, 0x14, 0x53, 0x42, 0xe7, 0xd2, 0x53, 0x42, 0xc3, 0x28, 0x43, 0x04, 0xdc,
+	0x6b, 0xeb, 0xb6, 0xea, 0xfe, 0x23, 0x7c, 0xf5, 0x5d, 0x40, 0x90, 0xd7, 0x96, 0xa7, 0xc1, 0x6b,
+	0x2b, 0x7e, 0x7c, 0x30, 0x49, 0x56, 0x32, 0x65, 0x83, 0x85, 0xb3, 0xe6, 0x7b, 0x07, 0x6e, 0x0b,
+	0xb9, 0xca, 0x79, 0x7d, 0x34, 0x54, 0xd7, 0x47, 0xc3, 0x28, 0x43, 0x04, 0xd6, 0x3b, 0x64, 0xa6,
+	0x76, 0xe8, 0x36, 0xeb, 0xa1, 0xa3, 0x7c, 0xfa, 0xfd, 0x3e, 0x99, 0x8f, 0x45, 0x95, 0x47, 0x8f,
+	0x45, 0x05, 0xa1, 0x2c, 0x41, 0x8e, 0x66, 0xfb, 0x49, 0xf5, 0xe7, 0xc4, 0xc8, 0xfd, 0x89, 0xa7,
+	0xd1, 0xe4, 0x09, 0xa6, 0xd1, 0xd4, 0xc9, 0xa7, 0xd1, 0xf4, 0x69, 0x4e, 0xa3, 0x99, 0xb1, 0x4c,
+	0x23, 0x3d, 0x30, 0x67, 0x07, 0x1f, 0x98, 0xbf, 0x35, 0x43, 0x88, 0xd6, 0x99, 0xd4, 0xaa, 0xe1,
+	0xb7, 0x44, 0x10, 0x3b, 0x34, 0x24, 0x05, 0x58, 0x46, 0xb1, 0x5b, 0xc1, 0x0b, 0xa4, 0x08, 0x63,
+	0x87, 0x08, 0x64, 0x9c, 0xe5, 0x62, 0xaf, 0x23, 0xfc, 0xdd, 0xee, 0x1f, 0x67, 0xe2, 0x1a, 0x96,
+	0xc6, 0x1f, 0xd7, 0x70, 0xfc, 0x71, 0x55, 0x20, 0x80, 0x5c, 0xcd, 0x0f, 0x1c, 0xa9, 0xfb, 0xa3,
+	0xf7, 0xb2, 0x39, 0x58, 0x29, 0xfd, 0xb2, 0xd9, 0x34, 0x8c, 0x32, 0x44, 0xc0, 0x2f, 0xa5, 0xbb,
+	0xad, 0x6a, 0xca, 0x40, 0xcc, 0xd9, 0x78, 0x6e, 0x4b, 0xaf, 0x65, 0x2b, 0x89, 0x9d, 0x3a, 0x59,
+	0xc7, 0x10, 0x01, 0x67, 0x63, 0x3f, 0xd6, 0x6c, 0xa6, 0x11, 0x1b, 0xfb, 0x71, 0x96, 0x8d, 0xfd,
+	0x18, 0xb1, 0x49, 0x12, 0x3c, 0x5a, 0x07, 0xf7, 0x00, 0xc1, 0xb1, 0x3a, 0x74, 0xb7, 0x1d, 0x80,
+	0xe6, 0xb1, 0x3a, 0x05, 0xe1, 0x77, 0x08, 0xc4, 0x4f, 0xeb, 0x7b, 0xe4, 0xbc, 0xde, 0x67, 0xd7,
+	0x7c, 0xbf, 0x59, 0xf7, 0x1f, 0xb5, 0xb8, 0x37, 0x69, 0x96, 0x57, 0xe7, 0x95, 0x27, 0xc7, 0xeb,
+	0xab, 0x91, 0xdc, 0x3e, 0x6f, 0x48, 0xbc, 0xf0, 0x2c, 0x5d, 0x52, 0xad, 0x94, 0x41, 0x52, 0x96,
+	0x97, 0x05, 0xae, 0x16, 0x25, 0x7b, 0x6e, 0xa3, 0x28, 0xc2, 0x8b, 0xe2, 0x57, 0x8b, 0x22, 0xb1,
+	0x99, 0x36, 0x4b, 0xba, 0x88, 0x4a, 0x32, 0x70, 0x94, 0xe5, 0x64, 0x80, 0xd8, 0x2d, 0x1d, 0xb7,
+	0x16, 0xbb, 0xf0, 0xba, 0x71, 0x68, 0xc7, 0x4e, 0xe3, 0x48, 0x5e, 0x37, 0x16, 0xf1, 0x38, 0x38,
+	0xaa, 0x22, 0x31, 0x28, 0x1e, 0x87, 0x01, 0x87, 0x78, 0x1c, 0x06, 0xc0, 0xda, 0x27, 0x2b, 0x62,
+	0xec, 0xe0, 0xf8, 0xdf, 0xf3, 0x9a, 0x2f, 0x47, 0xee, 0xa3, 0x20, 0xe0, 0xe7, 0xd0, 0x28, 0xda,
+	0xd7, 0x91, 0xc0, 0x53, 0x84, 0xd6, 0x77, 0x89, 0xa5, 0x47, 0x79, 0x72, 0xcd, 0x78, 0x01, 0x6d,
+	0x4b, 0x15, 0xf6, 0xb6, 0xbe, 0x55, 0x5c, 0x4e, 0x8d, 0xf6, 0xdb, 0xc9, 0xf5, 0xe2, 0x2c, 0x39,
+	0xfd, 0x83, 0x02, 0xb9, 0xa0, 0x4d, 0x51, 0x67, 0x7f, 0x6a, 0xe3, 0xae, 0x61, 0x51, 0xed, 0x69,
+	0x66, 0xe3, 0xb2, 0x5f, 0x3e, 0xf8, 0xa0, 0x65, 0xbf, 0x04, 0x50, 0xa6, 0x50, 0x74, 0x0b, 0x7f,
+	0xd1, 0x49, 0xae, 0x4f, 0xbf, 0x4f, 0xd6, 0x34, 0xa3, 0x33, 0xbe, 0x1b, 0xf6, 0x67, 0xe0, 0x2a,
+	0x6f, 0xab, 0xb5, 0xe1, 0xb7, 0x1e, 0xb8, 0x8d, 0x2e, 0xef, 0x57, 0x9a, 0x02, 0x55, 0x93, 0x8b,
+	0x25, 0x4e, 0x87, 0x17, 0xaa, 0x71, 0xa8, 0x5e, 0xe2, 0xd2, 0x18, 0xca, 0x32, 0xc4, 0x70, 0xb8,
+	0x85, 0xbf, 0x10, 0x91, 0x53, 0x09, 0xb7, 0xd7, 0x0b, 0x11, 0xe3, 0xad, 0xc5, 0x2f, 0x96, 0x08,
+	0xd1, 0x1c, 0x41, 0x44, 0x0b, 0x04, 0x3e, 0x64, 0xc3, 0x85, 0xa2, 0x20, 0x30, 0x43, 0x5d, 0x6a,
+	0x18, 0x65, 0x88, 0x00, 0xf6, 0xb7, 0xca, 0xac, 0x85, 0x03, 0x95, 0xf2, 0xfd, 0xed, 0x9e, 0x44,
+	0x48, 0x4e, 0xab, 0x2a, 0x76, 0x9d, 0x86, 0x52, 0x66, 0x10, 0x41, 0x9d, 0xea, 0xa1, 0xdb, 0x51,
+	0xbc, 0xd0, 0x63, 0x78, 0x9b, 0x1c, 0x6c, 0xd6, 0x49, 0xc3, 0x28, 0x43, 0x04, 0x3c, 0xa4, 0x54,
+	0xe8, 0xd4, 0x9d, 0x56, 0xec, 0xda, 0x4d, 0x1c, 0xc1, 0x94, 0x8b, 0x8f, 0x8d, 0x04, 0x65, 0x86,
+	0x94, 0x32, 0xe1, 0x94, 0xa5, 0x08, 0xa1, 0x6e, 0x22, 0x1e, 0x22, 0xb6, 0x38, 0xf1, 0xba, 0x89,
+	0x10, 0x87, 0x66, 0xdd, 0x34, 0x8c, 0x32, 0x44, 0x40, 0x3d, 0xb2, 0xa6, 0xfb, 0x00, 0x4d, 0x83,
+	0xfb, 0x84, 0x77, 0x58, 0x35, 0xdb, 0x25, 0x49, 0x1c, 0x2c, 0xa3, 0x5b, 0x50, 0x1c, 0x2c, 0xdc,
+	0x35, 0x29, 0x42, 0xfa, 0x4d, 0xb2, 0x28, 0x0a, 0x4f, 0x06, 0xdc, 0xeb, 0xc6, 0xa8, 0x5f, 0xcd,
+	0x09, 0xea, 0x38, 0x50, 0xe4, 0x79, 0xfa, 0x2e, 0xb1, 0x60, 0x48, 0xa7, 0xb8, 0x6f, 0x99, 0xc3,
+	0x79, 0x74, 0xf6, 0xbf, 0x5a, 0x24, 0x2a, 0x74, 0x64, 0xaa, 0xe1, 0x0b, 0x23, 0x35, 0xfc, 0x98,
+	0x07, 0x6a, 0x9b, 0xac, 0xea, 0xf8, 0x83, 0xfa, 0xfd, 0x9f, 0x9e, 0x97, 0x2a, 0xf8, 0x14, 0x56,
+	0x29, 0xf4, 0xec, 0xcf, 0x05, 0x33, 0x10, 0xa1, 0x7e, 0xf8, 0x27, 0x43, 0x4c, 0xbf, 0x49, 0x96,
+	0xc5, 0x27, 0xa1, 0x91, 0xd3, 0xbd, 0x79, 0xc2, 0x9c, 0xe6, 0x09, 0x71, 0xf3, 0xa0, 0xc4, 0x77,
+	0xb9, 0x88, 0x7c, 0xe0, 0x36, 0x0c, 0xc7, 0xcd, 0x9b, 0xbd, 0x45, 0xa4, 0x24, 0x17, 0x3d, 0x9a,
+	0x88, 0xa4, 0x85, 0x64, 0x68, 0x72, 0x41, 0x24, 0x11, 0xd4, 0x49, 0x64, 0x60, 0xba, 0x94, 0x5b,
+	0x7d, 0x64, 0xe0, 0x50, 0xc5, 0xfc, 0xe5, 0x02, 0x21, 0x3a, 0xcf, 0x29, 0x84, 0xea, 0x19, 0xf6,
+	0x1c, 0x17, 0xad, 0x91, 0x55, 0x51, 0x21, 0x53, 0x21, 0x30, 0x23, 0x49, 0x9c, 0xcf, 0xf9, 0xe8,
+	0xe4, 0x46, 0xec, 0x00, 0xeb, 0xb4, 0x4b, 0x66, 0x93, 0x4c, 0xc3, 0x45, 0x11, 0x34, 0x0e, 0xa5,
+	0x0c, 0xf2, 0x3d, 0x7b, 0x64, 0x39, 0x23, 0xbe, 0xbe, 0x4a, 0x66, 0xa5, 0xe4, 0x4a, 0x5a, 0x5b,
+	0x6c, 0xaa, 0x45, 0x4f, 0xa0, 0x58, 0x71, 0x0a, 0x02, 0x9b, 0x6a, 0xf5, 0x33, 0x20, 0x17, 0xb6,
+	0x5b, 0xe0, 0xf3, 0x06, 0x07, 0x62, 0x68, 0x8c, 0x8d, 0xfb, 0x46, 0x2b, 0x99, 0xa7, 0x02, 0x53,
+	0x79, 0x44, 0x89, 0xa1, 0x13, 0x29, 0xd7, 0xce, 0x92, 0x3e, 0x09, 0x25, 0xbc, 0x3a, 0x09, 0x12,
+	0x8e, 0x5a, 0xc2, 0x60, 0xec, 0x56, 0xea, 0xbe, 0x39, 0x22, 0xc7, 0x56, 0xec, 0xdf, 0x2f, 0x91,
+	0xa5, 0x54, 0x76, 0xeb, 0x17, 0xc8, 0xb2, 0xc2, 0x47, 0x55, 0xbf, 0x55, 0xad, 0x45, 0x81, 0x2c,
+	0xf6, 0xd3, 0x69, 0x05, 0x2e, 0x64, 0x92, 0xf0, 0x4e, 0x6b, 0x23, 0x0a, 0xee, 0x84, 0x22, 0xb8,
+	0xb8, 0x58, 0x21, 0x12, 0x1e, 0x1c, 0xa7, 0x57, 0x08, 0x13, 0x4e, 0x59, 0x8a, 0x10, 0x3c, 0x47,
+	0xab, 0x46, 0xf9, 0x11, 0x67, 0x5a, 0x2e, 0x0e, 0x55, 0x05, 0xae, 0x3f, 0x23, 0xce, 0x02, 0xac,
+	0xf5, 0xe7, 0x0c, 0x8a, 0xb2, 0x2c, 0xb9, 0xf5, 0xab, 0x05, 0x72, 0xde, 0xa8, 0x4b, 0x52, 0xb4,
+	0x94, 0xac, 0x9f, 0xec, 0x51, 0x9d, 0x7b, 0x0a, 0x2e, 0x22, 0x1a, 0x20, 0xee, 0x09, 0x46, 0x47,
+	0x34, 0xc8, 0xc3, 0x52, 0x96, 0x9b, 0x09, 0x22, 0xc1, 0x5c, 0xec, 0xfa, 0xe5, 0x83, 0x09, 0x18,
+	0xf9, 0x60, 0xa4, 0x0c, 0x6b, 0x95, 0x28, 0xaf, 0xea, 0xc1, 0xc8, 0x5d, 0x0e, 0xdf, 0xae, 0x1b,
+	0x0f, 0x46, 0x2a, 0xa0, 0x78, 0x30, 0x32, 0x49, 0xfd, 0xcd, 0x22, 0xb9, 0x60, 0xd6, 0x26, 0xa9,
+	0xe9, 0x59, 0xd7, 0x45, 0xeb, 0xee, 0xa5, 0x41, 0x74, 0x77, 0x38, 0xbb, 0xa6, 0x1f, 0x02, 0xe0,
+	0xc4, 0xb1, 0xb0, 0x9e, 0x48, 0xe2, 0x98, 0xdb, 0x4d, 0x38, 0x10, 0xdc, 0xe4, 0xf2, 0xc5, 0x49,
+	0xf0, 0xc2, 0x4d, 0x6a, 0x37, 0xb9, 0x80, 0xde, 0x72, 0x8e, 0xb4, 0x9b, 0x3c, 0x01, 0x51, 0xa6,
+	0xd1, 0xb4, 0x49, 0xce, 0xc9, 0xa9, 0x96, 0x8a, 0x0a, 0x51, 0x31, 0x44, 0xca, 0xa5, 0xbc, 0xb9,
+	0xbd, 0xef, 0x0d, 0x3b, 0xb3, 0xdf, 0x13, 0xc7, 0xa6, 0xf2, 0x4b, 0xbc, 0xd7, 0xeb, 0xd8, 0xd4,
+	0xc8, 0x45, 0xfe, 0xed, 0x12, 0x59, 0x30, 0x32, 0x5b, 0x3f, 0xdf, 0x55, 0x94, 0x98, 0x13, 0x07,
+	0x2e, 0x53, 0x8e, 0x5d, 0x90, 0xfc, 0xa0, 0xa7, 0x20, 0x19, 0xac, 0x02, 0xe3, 0x11, 0x23, 0xbf,
+	0xd2, 0x4f, 0x8c, 0xd0, 0xae, 0x95, 0x39, 0x35, 0x21, 0xf2, 0x4b, 0x05, 0x72, 0xa1, 0xcb, 0x57,
+	0x9f, 0xb9, 0x08, 0xf9, 0xa3, 0x22, 0x39, 0x97, 0xfb, 0xd1, 0x1f, 0x71, 0x01, 0x82, 0x36, 0xff,
+	0x13, 0x43, 0x45, 0xb1, 0xe2, 0x62, 0x67, 0x72, 0x78, 0xb1, 0x33, 0x35, 0x82, 0xd8, 0xf9, 0xa0,
+	0x40, 0x56, 0xe4, 0xac, 0x44, 0xfa, 0x51, 0x4e, 0x94, 0xe3, 0xc2, 0xc9, 0xa3, 0x1c, 0xab, 0x4f,
+	0x2b, 0x0e, 0xf0, 0x69, 0x74, 0x8b, 0x58, 0xe2, 0xb5, 0x5d, 0x43, 0x34, 0xbd, 0x80, 0x84, 0xa1,
+	0x6c, 0x50, 0xf1, 0x2d, 0xba, 0x41, 0x45, 0x9a, 0x32, 0x89, 0xa0, 0xb7, 0x85, 0x22, 0x9f, 0xc3,
+	0xec, 0x45, 0x2c, 0xe7, 0x06, 0xe4, 0xf6, 0x15, 0xb2, 0x2c, 0x38, 0xa1, 0xd6, 0x1a, 0xf4, 0x82,
+	0xdd, 0x8b, 0xff, 0xb1, 0x44, 0x8a, 0xbb, 0x15, 0x6b, 0x8b, 0xcc, 0x08, 0xdd, 0x7a, 0xb7, 0x62,
+	0x99, 0xba, 0xda, 0x6e, 0xc5, 0x50, 0xba, 0x2f, 0x5d, 0x4e, 0x61, 0x71, 0xf5, 0xe9, 0x27, 0xac,
+	0xaf, 0x93, 0x29, 0xf8, 0xb4, 0xdd, 0x8a, 0x65, 0x9e, 0xd4, 0xbb, 0xe9, 0x05, 0xf1, 0xd1, 0x25,
+	0xf3, 0x65, 0x7a, 0x41, 0x98, 0x62, 0xf0, 0x35, 0x32, 0x23, 0xe1, 0xf5, 0x5c, 0x16, 0x97, 0x33,
+	0x2c, 0xb6, 0xeb, 0x28, 0xfb, 0x75, 0x32, 0xb9, 0xe5, 0x40, 0xf1, 0x17, 0x53, 0xf5, 0xd4, 0x8d,
+	0xd3, 0xef, 0x13, 0x6e, 0x92, 0x99, 0x4d, 0xa7, 0xe9, 0xc4, 0x4e, 0x6f, 0x2e, 0xa9, 0xfb, 0x35,
+	0x22, 0x06, 0xa5, 0x51, 0x93, 0x39, 0xc1, 0xe6, 0x7a, 0xb3, 0xd9, 0xa5, 0x39, 0xfa, 0xb1, 0xd8,
+	0x20, 0xd3, 0x1b, 0x87, 0x4e, 0xed, 0xe1, 0x30, 0x9f, 0x73, 0xf3, 0xb1, 0x1b, 0xc5, 0x91, 0x66,
+	0xf2, 0xe2, 0xef, 0xad, 0x93, 0x89, 0x9d, 0x8d, 0x6d, 0x66, 0xdd, 0x21, 0x0b, 0x9c, 0x9b, 0x12,
+	0x5b, 0xd6, 0x7a, 0xca, 0xb6, 0x20, 0xc0, 0x03, 0x73, 0xb6, 0xbe, 0x45, 0x56, 0xc5, 0xd8, 0xe0,
+	0xaf, 0x93, 0xbc, 0xe5, 0xc6, 0x87, 0x7c, 0x0d, 0x5d, 0x4f, 0xb9, 0x67, 0x38, 0x56, 0xb4, 0xb1,
+	0x60, 0x7b, 0xb5, 0x3b, 0x01, 0xe2, 0xbd, 0x92, 0xe6, 0xbd, 0x69, 0x3d, 0x93, 0x97, 0xd1, 0x1c,
+	0x9e, 0x83, 0xf0, 0x7e, 0x8b, 0xcc, 0xf2, 0x71, 0x03, 0x28, 0x8b, 0xe6, 0x36, 0x82, 0x61, 0xa7,
+	0xbd, 0xf4, 0xc9, 0xcc, 0x98, 0xcb, 0x67, 0xbc, 0x47, 0xe6, 0x12, 0xc6, 0xdb, 0xf5, 0x81, 0x58,
+	0xf7, 0x19, 0xce, 0x77, 0xc8, 0xcc, 0x96, 0x23, 0x6b, 0xda, 0xb7, 0xbb, 0x06, 0xf9, 0xf6, 0x5d,
+	0x35, 0x2a, 0x07, 0xe4, 0xd9, 0x6f, 0x88, 0xde, 0x23, 0x8b, 0x82, 0xdf, 0xf5, 0x66, 0x73, 0xf0,
+	0x06, 0xed, 0xc7, 0xf5, 0xdb, 0x64, 0x71, 0xcb, 0x89, 0x6f, 0xfb, 0xfe, 0xc3, 0x76, 0x90, 0xc7,
+	0x15, 0x61, 0xba, 0x76, 0x93, 0x50, 0x0d, 0xf2, 0xda, 0xc0, 0x21, 0x4b, 0xd0, 0xd0, 0x98, 0xfd,
+	0xa7, 0xbb, 0xb1, 0x07, 0x42, 0x54, 0xc4, 0x67, 0x32, 0xdd, 0xd5, 0xbd, 0x98, 0x3b, 0x84, 0xbc,
+	0xee, 0xc4, 0xb5, 0x43, 0x51, 0x82, 0x39, 0x76, 0x35, 0x62, 0x88, 0x56, 0x79, 0x9b, 0xcc, 0x55,
+	0x1c, 0x3b, 0xac, 0x1d, 0xe6, 0x35, 0x09, 0xc2, 0x8c, 0x30, 0x72, 0xef, 0x91, 0xb9, 0xfb, 0x41,
+	0x5d, 0x4d, 0xb7, 0xcc, 0x44, 0x43, 0xb8, 0xe1, 0x26, 0xda, 0xbc, 0x98, 0x9d, 0x15, 0x1e, 0xd3,
+	0x3e, 0x55, 0xe3, 0x7b, 0x07, 0x02, 0x6c, 0x4e, 0xe0, 0x67, 0x72, 0x69, 0x52, 0x8c, 0xdf, 0x26,
+	0x84, 0xb7, 0x7d, 0x1e, 0xdb, 0xfc, 0x11, 0xf7, 0xa9, 0x9c, 0x86, 0xc8, 0x65, 0x7d, 0x97, 0xcc,
+	0x6b, 0xd6, 0xe3, 0x99, 0xc4, 0x77, 0xc9, 0xec, 0x96, 0xa3, 0x2a, 0xdb, 0x77, 0xc6, 0x0d, 0xd4,
+	0x00, 0x77, 0xc8, 0xbc, 0x98, 0x76, 0x83, 0x72, 0xed, 0x37, 0xb6, 0xee, 0x93, 0xa5, 0x64, 0x1e,
+	0x0f, 0xd1, 0xac, 0xfd, 0xd8, 0xbe, 0x45, 0x2c, 0x39, 0x02, 0x02, 0xa7, 0x96, 0xac, 0x10, 0x57,
+	0xba, 0x84, 0x3a, 0x52, 0x5c, 0xd7, 0xbb, 0xe2, 0x13, 0xc6, 0xef, 0x92, 0xf3, 0x26, 0xe3, 0xe4,
+	0xe9, 0xb0, 0xab, 0x39, 0x99, 0xcd, 0x21, 0x36, 0x00, 0xfb, 0xfb, 0x42, 0x0b, 0x01, 0xcc, 0x40,
+	0xed, 0xf0, 0x6c, 0xde, 0xf0, 0xca, 0xb2, 0xbd, 0x23, 0xc7, 0xad, 0x78, 0x2c, 0x63, 0x0c, 0x43,
+	0x6b, 0x87, 0x4c, 0x6f, 0x39, 0xa2, 0x9a, 0x7d, 0x87, 0xc0, 0x00, 0x9f, 0xbd, 0x43, 0x88, 0x1c,
+	0x56, 0x03, 0x71, 0xec, 0xd7, 0xfb, 0x15, 0xb2, 0xa0, 0x07, 0xd5, 0xa0, 0x4d, 0xd9, 0x5f, 0x0a,
+	0x2e, 0x24, 0x6b, 0x03, 0x67, 0xfa, 0x4c, 0x8e, 0xec, 0x06, 0x44, 0xd7, 0xee, 0x91, 0x2f, 0xf6,
+	0x67, 0x3f, 0xff, 0x80, 0x2c, 0xea, 0x85, 0x81, 0xf3, 0xfe, 0x54, 0x17, 0xde, 0xa9, 0x65, 0xe1,
+	0xb9, 0x2e, 0xcb, 0x42, 0x6e, 0x13, 0xcf, 0x72, 0xe1, 0xcf, 0xd9, 0x5f, 0xcd, 0x2e, 0x0a, 0xa9,
+	0x9a, 0xf7, 0x6f, 0x62, 0x19, 0x29, 0x86, 0xf3, 0xeb, 0x37, 0xb1, 0x06, 0x1c, 0xa6, 0x35, 0x62,
+	0x69, 0xa6, 0xd1, 0x8d, 0x23, 0x7e, 0x5d, 0x39, 0xb5, 0x46, 0x66, 0x09, 0x86, 0x2c, 0x64, 0x4f,
+	0xad, 0x66, 0x9c, 0x47, 0xaa, 0xea, 0x00, 0x13, 0xd8, 0xfc, 0xd1, 0x8b, 0xf1, 0x58, 0xce, 0x56,
+	0xfc, 0x30, 0x16, 0xfc, 0xcc, 0xd3, 0xae, 0x09, 0x7c, 0xc8, 0x4a, 0xde, 0x25, 0x44, 0xac, 0x7d,
+	0x39, 0xdd, 0x75, 0xef, 0x40, 0xa3, 0x86, 0x98, 0x63, 0x4d, 0xa5, 0x35, 0x1b, 0x6f, 0xd9, 0x59,
+	0x9f, 0x4d, 0xe7, 0xc4, 0x58, 0x53, 0x7e, 0x7d, 0xa6, 0x17, 0x69, 0xaa, 0xb4, 0x06, 0x59, 0xe1,
+	0xc3, 0xd1, 0x28, 0x6b, 0x90, 0x69, 0xf8, 0xf9, 0xbc, 0x06, 0xea, 0x51, 0xd0, 0x37, 0x45, 0x00,
+	0x16, 0x93, 0x64, 0x2c, 0x32, 0xae, 0x4a, 0x96, 0xb7, 0x1c, 0x93, 0x71, 0x7f, 0xd1, 0x34, 0x4c,
+	0x1b, 0xed, 0x93, 0x55, 0x29, 0xf5, 0x86, 0x2b, 0xa3, 0xbf, 0x16, 0x7b, 0x5e, 0x8b, 0xbf, 0xa1,
+	0x3b, 0xa0, 0x1f, 0xf7, 0xbb, 0x84, 0x88, 0x61, 0xb1, 0xbf, 0xeb, 0xc4, 0x99, 0xa1, 0x09, 0xc0,
+	0xde, 0xab, 0x1e, 0x50, 0xe4, 0xaf, 0x7a, 0x9c, 0xe1, 0xa8, 0xab, 0x5e, 0x0e, 0x5b, 0xb9, 0xea,
+	0xed, 0x8b, 0x27, 0x9b, 0xc6, 0xb6, 0xea, 0xf1, 0x6a, 0x0e, 0xbd, 0xea, 0xe5, 0xd4, 0x2f, 0x59,
+	0xf5, 0x06, 0xe3, 0x38, 0xcc, 0xaa, 0x37, 0x70, 0x53, 0xf6, 0x61, 0xfa, 0xe2, 0x07, 0x17, 0xf8,
+	0x2e, 0xbe, 0xa2, 0xbb, 0x1d, 0xce, 0x02, 0x65, 0xba, 0x3d, 0xf3, 0x56, 0xde, 0xa5, 0xf5, 0x1c,
+	0x8a, 0xd4, 0xf7, 0x57, 0x44, 0xb7, 0x77, 0x65, 0xd8, 0xbf, 0xd3, 0x73, 0x98, 0xee, 0x88, 0x4e,
+	0xdf, 0x11, 0x26, 0xc4, 0xfe, 0x6c, 0xfb, 0x6e, 0x84, 0xe7, 0x36, 0xfc, 0x56, 0x1c, 0xfa, 0xcd,
+	0xee, 0xd5, 0xc4, 0x61, 0x7b, 0xfb, 0xf6, 0x52, 0x55, 0xac, 0xf5, 0xfa, 0x09, 0xa5, 0x01, 0xea,
+	0xf8, 0xd9, 0x2e, 0x9f, 0x9e, 0x7d, 0xee, 0x89, 0xab, 0xbe, 0xa0, 0xa7, 0x20, 0xfe, 0x4f, 0xe7,
+	0xf0, 0xef, 0xba, 0x43, 0xe9, 0xc1, 0xf8, 0x0e, 0x99, 0x93, 0x8c, 0x01, 0xd1, 0x8f, 0xed, 0x00,
+	0xfd, 0x7f, 0x5b, 0x6c, 0x79, 0x00, 0xc3, 0xdf, 0xc3, 0xe9, 0xc3, 0xb1, 0x4f, 0x4f, 0xdd, 0x52,
+	0xb3, 0x89, 0x77, 0x54, 0x1f, 0x5e, 0xfd, 0x85, 0x9c, 0x9e, 0x4b, 0x03, 0x8e, 0xcf, 0x7e, 0x2c,
+	0xef, 0xa8, 0x4d, 0x29, 0xff, 0xde, 0x1d, 0x2b, 0x1b, 0x73, 0xd8, 0x9c, 0x40, 0x4f, 0xe7, 0x9e,
+	0x36, 0x46, 0x0c, 0xdf, 0x21, 0x2b, 0x98, 0xa1, 0x90, 0xf0, 0xcf, 0x66, 0x72, 0xe5, 0x2c, 0xe4,
+	0x03, 0xf4, 0x0d, 0x18, 0xed, 0xf4, 0xb8, 0xcf, 0xad, 0xee, 0x70, 0xe3, 0xfe, 0x1e, 0x59, 0x92,
+	0xa3, 0x67, 0x7f, 0x47, 0x0e, 0xcc, 0x6c, 0x90, 0x6f, 0xd4, 0x9c, 0xb4, 0x47, 0x04, 0x70, 0x3c,
+	0xdb, 0x17, 0x12, 0xae, 0x7c, 0x54, 0xf6, 0xe4, 0xd9, 0xb7, 0x49, 0x6f, 0xa9, 0xed, 0xad, 0xfc,
+	0xe8, 0x9e, 0xdc, 0xfa, 0x7d, 0xf1, 0x01, 0x59, 0x48, 0x42, 0x59, 0xf2, 0x31, 0xf4, 0x5c, 0xf7,
+	0x80, 0xb6, 0x66, 0xff, 0x7c, 0xba, 0x77, 0x20, 0x6c, 0x43, 0x9a, 0xcc, 0x25, 0xa8, 0xfd, 0x1d,
+	0xeb, 0xb3, 0xdd, 0x33, 0xa6, 0x87, 0xd7, 0xc0, 0xda, 0xf2, 0xb4, 0x8c, 0x90, 0x95, 0xda, 0xef,
+	0xe4, 0x85, 0x68, 0xbb, 0x74, 0x35, 0xc3, 0x34, 0x15, 0x18, 0x8f, 0x8f, 0xac, 0x59, 0x09, 0xdc,
+	0xf7, 0x52, 0xc3, 0x35, 0x3f, 0x6c, 0x5a, 0x6a, 0xe2, 0x57, 0x62, 0x88, 0x05, 0x85, 0x18, 0xba,
+	0xe4, 0xb2, 0x8c, 0xf8, 0x95, 0x44, 0x54, 0xe0, 0x61, 0xc0, 0xee, 0xf9, 0x83, 0x56, 0x3b, 0x6b,
+	0xa4, 0xc9, 0x8b, 0x23, 0xc6, 0x57, 0xac, 0xf9, 0x2d, 0x47, 0x47, 0xd8, 0x48, 0x59, 0xc7, 0x71,
+	0x5c, 0x83, 0x4b, 0x9f, 0xce, 0xf0, 0xcc, 0x0d, 0xcc, 0xc1, 0x37, 0x96, 0x30, 0x33, 0xae, 0xa3,
+	0xea, 0x5b, 0x4f, 0x65, 0xf9, 0xea, 0x10, 0x0f, 0x43, 0xb0, 0x6e, 0x90, 0x8b, 0xdb, 0xc9, 0x73,
+	0x71, 0x6e, 0xec, 0x87, 0xa7, 0xd5, 0x30, 0xc2, 0x70, 0x2a, 0x0b, 0xe1, 0xaf, 0x2b, 0x5e, 0x49,
+	0x87, 0xe9, 0x31, 0xc3, 0xbd, 0x5c, 0xfa, 0x4c, 0x1e, 0x3e, 0x2f, 0x7a, 0x1a, 0x57, 0x94, 0x97,
+	0x34, 0x77, 0xb1, 0x29, 0xec, 0xc7, 0xfe, 0x99, 0x5c, 0xf6, 0x38, 0xc8, 0x16, 0xd7, 0x3b, 0x41,
+	0x7a, 0xa0, 0xb7, 0x79, 0x9e, 0x49, 0x9d, 0xe5, 0xca, 0x3e, 0xc1, 0x74, 0x69, 0xbd, 0x0b, 0x09,
+	0x62, 0xbb, 0x4d, 0x66, 0xb9, 0xc3, 0x63, 0x90, 0x85, 0xa8, 0x8f, 0xab, 0xe3, 0xa6, 0xf4, 0xc4,
+	0xec, 0x7b, 0xbd, 0x65, 0x51, 0x1f, 0x36, 0x55, 0xb2, 0xac, 0x97, 0x0a, 0x79, 0xdf, 0xf9, 0x93,
+	0x5d, 0x0e, 0x99, 0xf7, 0x12, 0x13, 0xf9, 0x51, 0x22, 0xe8, 0x27, 0x2c, 0x5b, 0x6b, 0x35, 0x7d,
+	0xd8, 0x9b, 0x8b, 0x66, 0xd6, 0x80, 0xd1, 0xb5, 0x88, 0xb7, 0x13, 0x51, 0x2f, 0x4b, 0x78, 0xa6,
+	0x4b, 0x09, 0x5d, 0x75, 0xc6, 0xae, 0xac, 0xef, 0x93, 0x65, 0x2d, 0xf6, 0x07, 0xe7, 0xde, 0x6f,
+	0x01, 0x78, 0x87, 0xac, 0x1a, 0x4a, 0xc4, 0x50, 0x2d, 0xd3, 0x4f, 0x31, 0xff, 0x27, 0xb3, 0x64,
+	0xfa, 0x7e, 0xec, 0x36, 0x21, 0xce, 0xef, 0x2d, 0xd1, 0xfa, 0xe8, 0x88, 0x78, 0x9e, 0xd7, 0x2f,
+	0x2b, 0xf1, 0xb3, 0xa7, 0xda, 0xd1, 0xa4, 0x48, 0x78, 0x3d, 0xd3, 0xe5, 0x64, 0x7b, 0x8f, 0x49,
+	0x91, 0xc3, 0x76, 0x43, 0xe8, 0xe5, 0xf2, 0x64, 0xf0, 0x60, 0x4e, 0x5a, 0xf3, 0x88, 0xb2, 0x98,
+	0x59, 0x5b, 0x8e, 0xe2, 0xf1, 0x74, 0xce, 0x11, 0xe5, 0xae, 0x53, 0x22, 0xc3, 0xaa, 0xa2, 0xd4,
+	0x31, 0xf9, 0x95, 0x57, 0x73, 0x8e, 0x71, 0xf6, 0xd2, 0x9a, 0xb2, 0xa7, 0x61, 0xe9, 0x27, 0xac,
+	0x2d, 0xf1, 0x91, 0xc3, 0x76, 0x42, 0x96, 0xd1, 0x0e, 0xff, 0x50, 0xc9, 0xe7, 0xe9, 0x9c, 0x82,
+	0x7b, 0x35, 0x7e, 0x96, 0xdd, 0x2d, 0x42, 0xb6, 0x5b, 0xee, 0x80, 0xfc, 0xfa, 0x7b, 0x87, 0x17,
+	0x80, 0xd9, 0xf5, 0x66, 0xb3, 0xc7, 0x77, 0xf6, 0x63, 0xf2, 0x1d, 0xb2, 0x86, 0x8e, 0x53, 0xaa,
+	0xbd, 0x69, 0xda, 0x1c, 0x97, 0x39, 0x8e, 0x71, 0xe9, 0x93, 0x79, 0xf8, 0xf4, 0x29, 0x50, 0xee,
+	0xc7, 0xb5, 0x92, 0x13, 0x56, 0x83, 0x73, 0xa7, 0xdd, 0xcf, 0x77, 0x21, 0xde, 0x4c, 0xf4, 0xb2,
+	0x38, 0xfc, 0x90, 0x6a, 0xcd, 0xf4, 0x89, 0x88, 0x9c, 0x0e, 0xcf, 0x1e, 0xbf, 0x48, 0x3a, 0x7c,
+	0x30, 0x96, 0xeb, 0x39, 0xe8, 0x0c, 0x3b, 0xa9, 0xc8, 0x0e, 0xc6, 0xb1, 0x5f, 0x6f, 0xed, 0x21,
+	0x2f, 0xcd, 0x58, 0x38, 0xde, 0x58, 0xfe, 0xc9, 0x4f, 0xaf, 0x14, 0xfe, 0xe0, 0xa7, 0x57, 0x0a,
+	0xff, 0xe9, 0xa7, 0x57, 0x0a, 0x7f, 0xf5, 0xbf, 0x5c, 0xf9, 0xc4, 0xc1, 0x54, 0x10, 0xfa, 0xb1,
+	0xff, 0xd2, 0xff, 0x1f, 0x00, 0x9e, 0x96, 0xe4, 0x3a, 0x77, 0xed, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -11646,6 +13697,7 @@ type MCIRClient interface {
 	FetchSpec(ctx context.Context, in *FetchSpecQryRequest, opts ...grpc.CallOption) (*MessageResponse, error)
 	FilterSpec(ctx context.Context, in *TbSpecInfoRequest, opts ...grpc.CallOption) (*ListTbSpecInfoResponse, error)
 	FilterSpecsByRange(ctx context.Context, in *FilterSpecsByRangeRequest, opts ...grpc.CallOption) (*ListTbSpecInfoResponse, error)
+	SearchSpecs(ctx context.Context, in *SpecSearchRequest, opts ...grpc.CallOption) (*SpecSearchResponse, error)
 	SortSpecs(ctx context.Context, in *SortSpecsRequest, opts ...grpc.CallOption) (*ListTbSpecInfoResponse, error)
 	UpdateSpec(ctx context.Context, in *TbUpdateSpecRequest, opts ...grpc.CallOption) (*TbSpecInfoResponse, error)
 	CreateSecurityGroup(ctx context.Context, in *TbSecurityGroupCreateRequest, opts ...grpc.CallOption) (*TbSecurityGroupInfoResponse, error)
@@ -11949,6 +14001,15 @@ func (c *mCIRClient) FilterSpecsByRange(ctx context.Context, in *FilterSpecsByRa
 	return out, nil
 }
 
+func (c *mCIRClient) SearchSpecs(ctx context.Context, in *SpecSearchRequest, opts ...grpc.CallOption) (*SpecSearchResponse, error) {
+	out := new(SpecSearchResponse)
+	err := c.cc.Invoke(ctx, "/cbtumblebug.MCIR/SearchSpecs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *mCIRClient) SortSpecs(ctx context.Context, in *SortSpecsRequest, opts ...grpc.CallOption) (*ListTbSpecInfoResponse, error) {
 	out := new(ListTbSpecInfoResponse)
 	err := c.cc.Invoke(ctx, "/cbtumblebug.MCIR/SortSpecs", in, out, opts...)
@@ -12108,6 +14169,7 @@ type MCIRServer interface {
 	FetchSpec(context.Context, *FetchSpecQryRequest) (*MessageResponse, error)
 	FilterSpec(context.Context, *TbSpecInfoRequest) (*ListTbSpecInfoResponse, error)
 	FilterSpecsByRange(context.Context, *FilterSpecsByRangeRequest) (*ListTbSpecInfoResponse, error)
+	SearchSpecs(context.Context, *SpecSearchRequest) (*SpecSearchResponse, error)
 	SortSpecs(context.Context, *SortSpecsRequest) (*ListTbSpecInfoResponse, error)
 	UpdateSpec(context.Context, *TbUpdateSpecRequest) (*TbSpecInfoResponse, error)
 	CreateSecurityGroup(context.Context, *TbSecurityGroupCreateRequest) (*TbSecurityGroupInfoResponse, error)
@@ -12221,6 +14283,9 @@ func (*UnimplementedMCIRServer) FilterSpec(ctx context.Context, req *TbSpecInfoR
 func (*UnimplementedMCIRServer) FilterSpecsByRange(ctx context.Context, req *FilterSpecsByRangeRequest) (*ListTbSpecInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FilterSpecsByRange not implemented")
 }
+func (*UnimplementedMCIRServer) SearchSpecs(ctx context.Context, req *SpecSearchRequest) (*SpecSearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchSpecs not implemented")
+}
 func (*UnimplementedMCIRServer) SortSpecs(ctx context.Context, req *SortSpecsRequest) (*ListTbSpecInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SortSpecs not implemented")
 }
@@ -12826,6 +14891,24 @@ func _MCIR_FilterSpecsByRange_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MCIR_SearchSpecs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SpecSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MCIRServer).SearchSpecs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cbtumblebug.MCIR/SearchSpecs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MCIRServer).SearchSpecs(ctx, req.(*SpecSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MCIR_SortSpecs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SortSpecsRequest)
 	if err := dec(in); err != nil {
@@ -13206,6 +15289,10 @@ var _MCIR_serviceDesc = grpc.ServiceDesc{
 			MethodName: "FilterSpecsByRange",
 			Handler:    _MCIR_FilterSpecsByRange_Handler,
 		},
+		{
+			MethodName: "SearchSpecs",
+			Handler:    _MCIR_SearchSpecs_Handler,
+		},
 		{
 			MethodName: "SortSpecs",
 			Handler:    _MCIR_SortSpecs_Handler,
@@ -13296,6 +15383,8 @@ type MCISClient interface {
 	GetAllBenchmark(ctx context.Context, in *BmQryAllRequest, opts ...grpc.CallOption) (*ListBenchmarkInfoResponse, error)
 	InstallMonitorAgentToMcis(ctx context.Context, in *McisCmdCreateRequest, opts ...grpc.CallOption) (*ListAgentInstallResponse, error)
 	GetMonitorData(ctx context.Context, in *MonitorQryRequest, opts ...grpc.CallOption) (*MonitorResultSimpleResponse, error)
+	GetMonitorRange(ctx context.Context, in *MonitorQryRequest, opts ...grpc.CallOption) (*MonitorRangeResponse, error)
+	GetCostReport(ctx context.Context, in *CostReportQryRequest, opts ...grpc.CallOption) (*CostReportResponse, error)
 	CheckMcis(ctx context.Context, in *TbMcisQryRequest, opts ...grpc.CallOption) (*ExistsResponse, error)
 	CheckVm(ctx context.Context, in *TbVmQryRequest, opts ...grpc.CallOption) (*ExistsResponse, error)
 	CreateMcisPolicy(ctx context.Context, in *McisPolicyCreateRequest, opts ...grpc.CallOption) (*McisPolicyInfoResponse, error)
@@ -13538,6 +15627,24 @@ func (c *mCISClient) GetMonitorData(ctx context.Context, in *MonitorQryRequest,
 	return out, nil
 }
 
+func (c *mCISClient) GetMonitorRange(ctx context.Context, in *MonitorQryRequest, opts ...grpc.CallOption) (*MonitorRangeResponse, error) {
+	out := new(MonitorRangeResponse)
+	err := c.cc.Invoke(ctx, "/cbtumblebug.MCIS/GetMonitorRange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mCISClient) GetCostReport(ctx context.Context, in *CostReportQryRequest, opts ...grpc.CallOption) (*CostReportResponse, error) {
+	out := new(CostReportResponse)
+	err := c.cc.Invoke(ctx, "/cbtumblebug.MCIS/GetCostReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *mCISClient) CheckMcis(ctx context.Context, in *TbMcisQryRequest, opts ...grpc.CallOption) (*ExistsResponse, error) {
 	out := new(ExistsResponse)
 	err := c.cc.Invoke(ctx, "/cbtumblebug.MCIS/CheckMcis", in, out, opts...)
@@ -13628,6 +15735,8 @@ type MCISServer interface {
 	GetAllBenchmark(context.Context, *BmQryAllRequest) (*ListBenchmarkInfoResponse, error)
 	InstallMonitorAgentToMcis(context.Context, *McisCmdCreateRequest) (*ListAgentInstallResponse, error)
 	GetMonitorData(context.Context, *MonitorQryRequest) (*MonitorResultSimpleResponse, error)
+	GetMonitorRange(context.Context, *MonitorQryRequest) (*MonitorRangeResponse, error)
+	GetCostReport(context.Context, *CostReportQryRequest) (*CostReportResponse, error)
 	CheckMcis(context.Context, *TbMcisQryRequest) (*ExistsResponse, error)
 	CheckVm(context.Context, *TbVmQryRequest) (*ExistsResponse, error)
 	CreateMcisPolicy(context.Context, *McisPolicyCreateRequest) (*McisPolicyInfoResponse, error)
@@ -13716,6 +15825,12 @@ func (*UnimplementedMCISServer) InstallMonitorAgentToMcis(ctx context.Context, r
 func (*UnimplementedMCISServer) GetMonitorData(ctx context.Context, req *MonitorQryRequest) (*MonitorResultSimpleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMonitorData not implemented")
 }
+func (*UnimplementedMCISServer) GetMonitorRange(ctx context.Context, req *MonitorQryRequest) (*MonitorRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMonitorRange not implemented")
+}
+func (*UnimplementedMCISServer) GetCostReport(ctx context.Context, req *CostReportQryRequest) (*CostReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCostReport not implemented")
+}
 func (*UnimplementedMCISServer) CheckMcis(ctx context.Context, req *TbMcisQryRequest) (*ExistsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CheckMcis not implemented")
 }
@@ -14192,6 +16307,42 @@ func _MCIS_GetMonitorData_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MCIS_GetMonitorRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MonitorQryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MCISServer).GetMonitorRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cbtumblebug.MCIS/GetMonitorRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MCISServer).GetMonitorRange(ctx, req.(*MonitorQryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MCIS_GetCostReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CostReportQryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MCISServer).GetCostReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cbtumblebug.MCIS/GetCostReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MCISServer).GetCostReport(ctx, req.(*CostReportQryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MCIS_CheckMcis_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(TbMcisQryRequest)
 	if err := dec(in); err != nil {
@@ -14422,6 +16573,14 @@ var _MCIS_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetMonitorData",
 			Handler:    _MCIS_GetMonitorData_Handler,
 		},
+		{
+			MethodName: "GetMonitorRange",
+			Handler:    _MCIS_GetMonitorRange_Handler,
+		},
+		{
+			MethodName: "GetCostReport",
+			Handler:    _MCIS_GetCostReport_Handler,
+		},
 		{
 			MethodName: "CheckMcis",
 			Handler:    _MCIS_CheckMcis_Handler,
@@ -15847,6 +18006,68 @@ func (m *TbImageInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Deprecated {
+		i--
+		if m.Deprecated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if len(m.BootMode) > 0 {
+		i -= len(m.BootMode)
+		copy(dAtA[i:], m.BootMode)
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(len(m.BootMode)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.VirtualizationType) > 0 {
+		i -= len(m.VirtualizationType)
+		copy(dAtA[i:], m.VirtualizationType)
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(len(m.VirtualizationType)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.OsArchitecture) > 0 {
+		i -= len(m.OsArchitecture)
+		copy(dAtA[i:], m.OsArchitecture)
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(len(m.OsArchitecture)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.OsVersion) > 0 {
+		i -= len(m.OsVersion)
+		copy(dAtA[i:], m.OsVersion)
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(len(m.OsVersion)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.OsDistribution) > 0 {
+		i -= len(m.OsDistribution)
+		copy(dAtA[i:], m.OsDistribution)
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(len(m.OsDistribution)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.OsFamily) > 0 {
+		i -= len(m.OsFamily)
+		copy(dAtA[i:], m.OsFamily)
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(len(m.OsFamily)))
+		i--
+		dAtA[i] = 0x72
+	}
 	if m.IsAutoGenerated {
 		i--
 		if m.IsAutoGenerated {
@@ -16119,6 +18340,62 @@ func (m *SearchImageQryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Os) > 0 {
+		i -= len(m.Os)
+		copy(dAtA[i:], m.Os)
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(len(m.Os)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.BootMode) > 0 {
+		i -= len(m.BootMode)
+		copy(dAtA[i:], m.BootMode)
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(len(m.BootMode)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.VirtualizationType) > 0 {
+		i -= len(m.VirtualizationType)
+		copy(dAtA[i:], m.VirtualizationType)
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(len(m.VirtualizationType)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.OsArchitecture) > 0 {
+		i -= len(m.OsArchitecture)
+		copy(dAtA[i:], m.OsArchitecture)
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(len(m.OsArchitecture)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.OsVersion) > 0 {
+		i -= len(m.OsVersion)
+		copy(dAtA[i:], m.OsVersion)
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(len(m.OsVersion)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.OsDistribution) > 0 {
+		i -= len(m.OsDistribution)
+		copy(dAtA[i:], m.OsDistribution)
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(len(m.OsDistribution)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.OsFamily) > 0 {
+		i -= len(m.OsFamily)
+		copy(dAtA[i:], m.OsFamily)
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(len(m.OsFamily)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ConnectionName) > 0 {
+		i -= len(m.ConnectionName)
+		copy(dAtA[i:], m.ConnectionName)
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(len(m.ConnectionName)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Keywords) > 0 {
 		for iNdEx := len(m.Keywords) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Keywords[iNdEx])
@@ -16920,6 +19197,42 @@ func (m *TbSpecInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.EvaluationTotalScore != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.EvaluationTotalScore))))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xed
+	}
+	if m.Deprecated {
+		i--
+		if m.Deprecated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xe0
+	}
+	if len(m.EvaluationBreakdown) > 0 {
+		for iNdEx := len(m.EvaluationBreakdown) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EvaluationBreakdown[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCbtumblebug(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xda
+		}
+	}
 	if m.IsAutoGenerated {
 		i--
 		if m.IsAutoGenerated {
@@ -17180,6 +19493,64 @@ func (m *TbSpecInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SpecEvaluationScore) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpecEvaluationScore) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpecEvaluationScore) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.WeightedScore != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.WeightedScore))))
+		i--
+		dAtA[i] = 0x2d
+	}
+	if m.Weight != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Weight))))
+		i--
+		dAtA[i] = 0x25
+	}
+	if m.Score != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Score))))
+		i--
+		dAtA[i] = 0x1d
+	}
+	if m.Value != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Value))))
+		i--
+		dAtA[i] = 0x15
+	}
+	if len(m.Metric) > 0 {
+		i -= len(m.Metric)
+		copy(dAtA[i:], m.Metric)
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(len(m.Metric)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *TbSpecCreateRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -17707,6 +20078,278 @@ func (m *FilterSpecsByRangeRequest) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *SpecSearchRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpecSearchRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpecSearchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Item != nil {
+		{
+			size, err := m.Item.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCbtumblebug(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NsId) > 0 {
+		i -= len(m.NsId)
+		copy(dAtA[i:], m.NsId)
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(len(m.NsId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SpecSearchReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpecSearchReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpecSearchReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Cursor) > 0 {
+		i -= len(m.Cursor)
+		copy(dAtA[i:], m.Cursor)
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(len(m.Cursor)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Offset != 0 {
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Limit != 0 {
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.OrderBy) > 0 {
+		for iNdEx := len(m.OrderBy) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OrderBy[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCbtumblebug(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Condition) > 0 {
+		for iNdEx := len(m.Condition) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Condition[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCbtumblebug(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SpecSearchCondition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpecSearchCondition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpecSearchCondition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Value) > 0 {
+		for iNdEx := len(m.Value) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Value[iNdEx])
+			copy(dAtA[i:], m.Value[iNdEx])
+			i = encodeVarintCbtumblebug(dAtA, i, uint64(len(m.Value[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Field) > 0 {
+		i -= len(m.Field)
+		copy(dAtA[i:], m.Field)
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(len(m.Field)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SpecSearchOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpecSearchOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpecSearchOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Direction) > 0 {
+		i -= len(m.Direction)
+		copy(dAtA[i:], m.Direction)
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(len(m.Direction)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Field) > 0 {
+		i -= len(m.Field)
+		copy(dAtA[i:], m.Field)
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(len(m.Field)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SpecSearchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpecSearchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpecSearchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.NextCursor) > 0 {
+		i -= len(m.NextCursor)
+		copy(dAtA[i:], m.NextCursor)
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(len(m.NextCursor)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Offset != 0 {
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Limit != 0 {
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TotalCount != 0 {
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(m.TotalCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Spec) > 0 {
+		for iNdEx := len(m.Spec) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Spec[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCbtumblebug(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *SpecRangeFilter) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -19215,6 +21858,38 @@ func (m *TbVmInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.UserDataEncoding) > 0 {
+		i -= len(m.UserDataEncoding)
+		copy(dAtA[i:], m.UserDataEncoding)
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(len(m.UserDataEncoding)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.PostCommand != nil {
+		{
+			size, err := m.PostCommand.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCbtumblebug(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.UserData) > 0 {
+		i -= len(m.UserData)
+		copy(dAtA[i:], m.UserData)
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(len(m.UserData)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xfa
+	}
 	if m.CspViewVmDetail != nil {
 		{
 			size, err := m.CspViewVmDetail.MarshalToSizedBuffer(dAtA[:i])
@@ -19602,6 +22277,15 @@ func (m *SpiderVMInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.UserData) > 0 {
+		i -= len(m.UserData)
+		copy(dAtA[i:], m.UserData)
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(len(m.UserData)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xda
+	}
 	if len(m.KeyValueList) > 0 {
 		for iNdEx := len(m.KeyValueList) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -20001,6 +22685,20 @@ func (m *TbVmReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.UserDataEncoding) > 0 {
+		i -= len(m.UserDataEncoding)
+		copy(dAtA[i:], m.UserDataEncoding)
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(len(m.UserDataEncoding)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.UserData) > 0 {
+		i -= len(m.UserData)
+		copy(dAtA[i:], m.UserData)
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(len(m.UserData)))
+		i--
+		dAtA[i] = 0x72
+	}
 	if len(m.VmUserPassword) > 0 {
 		i -= len(m.VmUserPassword)
 		copy(dAtA[i:], m.VmUserPassword)
@@ -20493,7 +23191,7 @@ func (m *TbMcisActionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *TbMcisQryRequest) Marshal() (dAtA []byte, err error) {
+func (m *CostReportQryRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -20503,12 +23201,12 @@ func (m *TbMcisQryRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TbMcisQryRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *CostReportQryRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TbMcisQryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CostReportQryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -20517,6 +23215,34 @@ func (m *TbMcisQryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Format) > 0 {
+		i -= len(m.Format)
+		copy(dAtA[i:], m.Format)
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(len(m.Format)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.GroupBy) > 0 {
+		i -= len(m.GroupBy)
+		copy(dAtA[i:], m.GroupBy)
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(len(m.GroupBy)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.McisId) > 0 {
 		i -= len(m.McisId)
 		copy(dAtA[i:], m.McisId)
@@ -20534,7 +23260,7 @@ func (m *TbMcisQryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *TbVmInfoResponse) Marshal() (dAtA []byte, err error) {
+func (m *CostReportResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -20544,12 +23270,12 @@ func (m *TbVmInfoResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TbVmInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *CostReportResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TbVmInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CostReportResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -20558,6 +23284,13 @@ func (m *TbVmInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Csv) > 0 {
+		i -= len(m.Csv)
+		copy(dAtA[i:], m.Csv)
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(len(m.Csv)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.Item != nil {
 		{
 			size, err := m.Item.MarshalToSizedBuffer(dAtA[:i])
@@ -20573,7 +23306,7 @@ func (m *TbVmInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *TbVmCreateRequest) Marshal() (dAtA []byte, err error) {
+func (m *TbCostReport) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -20583,12 +23316,12 @@ func (m *TbVmCreateRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TbVmCreateRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *TbCostReport) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TbVmCreateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TbCostReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -20597,14 +23330,256 @@ func (m *TbVmCreateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Item != nil {
-		{
-			size, err := m.Item.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if m.TotalProjectedMonthlyCost != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.TotalProjectedMonthlyCost))))
+		i--
+		dAtA[i] = 0x49
+	}
+	if m.TotalCurrentCostPerHour != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.TotalCurrentCostPerHour))))
+		i--
+		dAtA[i] = 0x41
+	}
+	if m.TotalCost != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.TotalCost))))
+		i--
+		dAtA[i] = 0x39
+	}
+	if len(m.Item) > 0 {
+		for iNdEx := len(m.Item) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Item[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCbtumblebug(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintCbtumblebug(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.GroupBy) > 0 {
+		i -= len(m.GroupBy)
+		copy(dAtA[i:], m.GroupBy)
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(len(m.GroupBy)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.McisId) > 0 {
+		i -= len(m.McisId)
+		copy(dAtA[i:], m.McisId)
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(len(m.McisId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NsId) > 0 {
+		i -= len(m.NsId)
+		copy(dAtA[i:], m.NsId)
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(len(m.NsId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TbCostReportItem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TbCostReportItem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TbCostReportItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ProjectedMonthlyCost != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.ProjectedMonthlyCost))))
+		i--
+		dAtA[i] = 0x39
+	}
+	if m.CurrentCostPerHour != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.CurrentCostPerHour))))
+		i--
+		dAtA[i] = 0x31
+	}
+	if m.Cost != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Cost))))
+		i--
+		dAtA[i] = 0x29
+	}
+	if m.RunningHours != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.RunningHours))))
+		i--
+		dAtA[i] = 0x21
+	}
+	if m.RunningVmNum != 0 {
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(m.RunningVmNum))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.VmNum != 0 {
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(m.VmNum))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Group) > 0 {
+		i -= len(m.Group)
+		copy(dAtA[i:], m.Group)
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(len(m.Group)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TbMcisQryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TbMcisQryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TbMcisQryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.McisId) > 0 {
+		i -= len(m.McisId)
+		copy(dAtA[i:], m.McisId)
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(len(m.McisId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NsId) > 0 {
+		i -= len(m.NsId)
+		copy(dAtA[i:], m.NsId)
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(len(m.NsId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TbVmInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TbVmInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TbVmInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Item != nil {
+		{
+			size, err := m.Item.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCbtumblebug(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TbVmCreateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TbVmCreateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TbVmCreateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Item != nil {
+		{
+			size, err := m.Item.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCbtumblebug(dAtA, i, uint64(size))
 		}
 		i--
 		dAtA[i] = 0x1a
@@ -22053,7 +25028,7 @@ func (m *MonResultSimple) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MonitorQryRequest) Marshal() (dAtA []byte, err error) {
+func (m *MonitorRangeResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -22063,12 +25038,12 @@ func (m *MonitorQryRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MonitorQryRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MonitorRangeResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MonitorQryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MonitorRangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -22077,6 +25052,106 @@ func (m *MonitorQryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Item != nil {
+		{
+			size, err := m.Item.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCbtumblebug(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MonRangeInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MonRangeInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MonRangeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.McisSeries) > 0 {
+		for iNdEx := len(m.McisSeries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.McisSeries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCbtumblebug(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.VmSeries) > 0 {
+		for iNdEx := len(m.VmSeries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VmSeries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCbtumblebug(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Resolution) > 0 {
+		i -= len(m.Resolution)
+		copy(dAtA[i:], m.Resolution)
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(len(m.Resolution)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Aggregation) > 0 {
+		i -= len(m.Aggregation)
+		copy(dAtA[i:], m.Aggregation)
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(len(m.Aggregation)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.StepSec != 0 {
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(m.StepSec))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Metric) > 0 {
 		i -= len(m.Metric)
 		copy(dAtA[i:], m.Metric)
@@ -22101,7 +25176,7 @@ func (m *MonitorQryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ListBenchmarkInfoResponse) Marshal() (dAtA []byte, err error) {
+func (m *MonVmSeries) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -22111,12 +25186,12 @@ func (m *ListBenchmarkInfoResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ListBenchmarkInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MonVmSeries) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ListBenchmarkInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MonVmSeries) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -22125,10 +25200,10 @@ func (m *ListBenchmarkInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Items) > 0 {
-		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Points) > 0 {
+		for iNdEx := len(m.Points) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Points[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -22136,13 +25211,20 @@ func (m *ListBenchmarkInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 				i = encodeVarintCbtumblebug(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x12
 		}
 	}
+	if len(m.VmId) > 0 {
+		i -= len(m.VmId)
+		copy(dAtA[i:], m.VmId)
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(len(m.VmId)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *BenchmarkInfo) Marshal() (dAtA []byte, err error) {
+func (m *MonPoint) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -22152,12 +25234,12 @@ func (m *BenchmarkInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BenchmarkInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *MonPoint) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BenchmarkInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MonPoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -22166,59 +25248,86 @@ func (m *BenchmarkInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.ResultArray) > 0 {
-		for iNdEx := len(m.ResultArray) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ResultArray[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintCbtumblebug(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x32
-		}
+	if m.Value != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Value))))
+		i--
+		dAtA[i] = 0x11
 	}
-	if len(m.SpecId) > 0 {
-		i -= len(m.SpecId)
-		copy(dAtA[i:], m.SpecId)
-		i = encodeVarintCbtumblebug(dAtA, i, uint64(len(m.SpecId)))
+	if len(m.Time) > 0 {
+		i -= len(m.Time)
+		copy(dAtA[i:], m.Time)
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(len(m.Time)))
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0xa
 	}
-	if len(m.Elapsed) > 0 {
-		i -= len(m.Elapsed)
-		copy(dAtA[i:], m.Elapsed)
-		i = encodeVarintCbtumblebug(dAtA, i, uint64(len(m.Elapsed)))
+	return len(dAtA) - i, nil
+}
+
+func (m *MonMcisPoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MonMcisPoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MonMcisPoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.VmCount != 0 {
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(m.VmCount))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x30
 	}
-	if len(m.Desc) > 0 {
-		i -= len(m.Desc)
-		copy(dAtA[i:], m.Desc)
-		i = encodeVarintCbtumblebug(dAtA, i, uint64(len(m.Desc)))
+	if m.Sum != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Sum))))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x29
 	}
-	if len(m.Unit) > 0 {
-		i -= len(m.Unit)
-		copy(dAtA[i:], m.Unit)
-		i = encodeVarintCbtumblebug(dAtA, i, uint64(len(m.Unit)))
+	if m.Max != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Max))))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x21
 	}
-	if len(m.Result) > 0 {
-		i -= len(m.Result)
-		copy(dAtA[i:], m.Result)
-		i = encodeVarintCbtumblebug(dAtA, i, uint64(len(m.Result)))
+	if m.Min != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Min))))
+		i--
+		dAtA[i] = 0x19
+	}
+	if m.Avg != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Avg))))
+		i--
+		dAtA[i] = 0x11
+	}
+	if len(m.Time) > 0 {
+		i -= len(m.Time)
+		copy(dAtA[i:], m.Time)
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(len(m.Time)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *BmQryAllRequest) Marshal() (dAtA []byte, err error) {
+func (m *MonitorQryRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -22228,12 +25337,12 @@ func (m *BmQryAllRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BmQryAllRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MonitorQryRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BmQryAllRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MonitorQryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -22242,15 +25351,208 @@ func (m *BmQryAllRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Item != nil {
-		{
-			size, err := m.Item.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCbtumblebug(dAtA, i, uint64(size))
-		}
+	if len(m.Agg) > 0 {
+		i -= len(m.Agg)
+		copy(dAtA[i:], m.Agg)
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(len(m.Agg)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Step) > 0 {
+		i -= len(m.Step)
+		copy(dAtA[i:], m.Step)
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(len(m.Step)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Metric) > 0 {
+		i -= len(m.Metric)
+		copy(dAtA[i:], m.Metric)
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(len(m.Metric)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.McisId) > 0 {
+		i -= len(m.McisId)
+		copy(dAtA[i:], m.McisId)
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(len(m.McisId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NsId) > 0 {
+		i -= len(m.NsId)
+		copy(dAtA[i:], m.NsId)
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(len(m.NsId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListBenchmarkInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListBenchmarkInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListBenchmarkInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCbtumblebug(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BenchmarkInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BenchmarkInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BenchmarkInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ResultArray) > 0 {
+		for iNdEx := len(m.ResultArray) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ResultArray[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCbtumblebug(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.SpecId) > 0 {
+		i -= len(m.SpecId)
+		copy(dAtA[i:], m.SpecId)
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(len(m.SpecId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Elapsed) > 0 {
+		i -= len(m.Elapsed)
+		copy(dAtA[i:], m.Elapsed)
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(len(m.Elapsed)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Desc) > 0 {
+		i -= len(m.Desc)
+		copy(dAtA[i:], m.Desc)
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(len(m.Desc)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Unit) > 0 {
+		i -= len(m.Unit)
+		copy(dAtA[i:], m.Unit)
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(len(m.Unit)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Result) > 0 {
+		i -= len(m.Result)
+		copy(dAtA[i:], m.Result)
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(len(m.Result)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BmQryAllRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BmQryAllRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BmQryAllRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Item != nil {
+		{
+			size, err := m.Item.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCbtumblebug(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -22469,6 +25771,32 @@ func (m *McisPolicyInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.MetricsSource != nil {
+		{
+			size, err := m.MetricsSource.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCbtumblebug(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.LastScaleInTime) > 0 {
+		i -= len(m.LastScaleInTime)
+		copy(dAtA[i:], m.LastScaleInTime)
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(len(m.LastScaleInTime)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.LastScaleOutTime) > 0 {
+		i -= len(m.LastScaleOutTime)
+		copy(dAtA[i:], m.LastScaleOutTime)
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(len(m.LastScaleOutTime)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.Description) > 0 {
 		i -= len(m.Description)
 		copy(dAtA[i:], m.Description)
@@ -22514,6 +25842,80 @@ func (m *McisPolicyInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MetricsSource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MetricsSource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MetricsSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.SshUserName) > 0 {
+		i -= len(m.SshUserName)
+		copy(dAtA[i:], m.SshUserName)
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(len(m.SshUserName)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.PrometheusVmLabel) > 0 {
+		i -= len(m.PrometheusVmLabel)
+		copy(dAtA[i:], m.PrometheusVmLabel)
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(len(m.PrometheusVmLabel)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PrometheusQuery) > 0 {
+		for k := range m.PrometheusQuery {
+			v := m.PrometheusQuery[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintCbtumblebug(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintCbtumblebug(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintCbtumblebug(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.PrometheusUrl) > 0 {
+		i -= len(m.PrometheusUrl)
+		copy(dAtA[i:], m.PrometheusUrl)
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(len(m.PrometheusUrl)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Policy) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -22538,6 +25940,30 @@ func (m *Policy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.AutoPredict != nil {
+		{
+			size, err := m.AutoPredict.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCbtumblebug(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.AutoSchedule != nil {
+		{
+			size, err := m.AutoSchedule.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCbtumblebug(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Status) > 0 {
 		i -= len(m.Status)
 		copy(dAtA[i:], m.Status)
@@ -22572,6 +25998,159 @@ func (m *Policy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AutoPredict) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoPredict) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoPredict) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ActionEnabled {
+		i--
+		if m.ActionEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.LeadTimeMin != 0 {
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(m.LeadTimeMin))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SeasonLength != 0 {
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(m.SeasonLength))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Model) > 0 {
+		i -= len(m.Model)
+		copy(dAtA[i:], m.Model)
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(len(m.Model)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AutoSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ActiveRuleTime) > 0 {
+		i -= len(m.ActiveRuleTime)
+		copy(dAtA[i:], m.ActiveRuleTime)
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(len(m.ActiveRuleTime)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ActiveRule != 0 {
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(m.ActiveRule))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Rules) > 0 {
+		for iNdEx := len(m.Rules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCbtumblebug(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.TimeZone) > 0 {
+		i -= len(m.TimeZone)
+		copy(dAtA[i:], m.TimeZone)
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(len(m.TimeZone)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AutoScheduleRule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoScheduleRule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoScheduleRule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.DesiredVmCount != 0 {
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(m.DesiredVmCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Cron) > 0 {
+		i -= len(m.Cron)
+		copy(dAtA[i:], m.Cron)
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(len(m.Cron)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *AutoCondition) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -22596,6 +26175,32 @@ func (m *AutoCondition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Window) > 0 {
+		i -= len(m.Window)
+		copy(dAtA[i:], m.Window)
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(len(m.Window)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Expression != nil {
+		{
+			size, err := m.Expression.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCbtumblebug(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Aggregation) > 0 {
+		i -= len(m.Aggregation)
+		copy(dAtA[i:], m.Aggregation)
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(len(m.Aggregation)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.EvaluationValue) > 0 {
 		for iNdEx := len(m.EvaluationValue) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.EvaluationValue[iNdEx])
@@ -22636,6 +26241,105 @@ func (m *AutoCondition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AutoConditionExpr) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoConditionExpr) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoConditionExpr) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Window) > 0 {
+		i -= len(m.Window)
+		copy(dAtA[i:], m.Window)
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(len(m.Window)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.EvaluationValue) > 0 {
+		for iNdEx := len(m.EvaluationValue) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.EvaluationValue[iNdEx])
+			copy(dAtA[i:], m.EvaluationValue[iNdEx])
+			i = encodeVarintCbtumblebug(dAtA, i, uint64(len(m.EvaluationValue[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.EvaluationPeriod) > 0 {
+		i -= len(m.EvaluationPeriod)
+		copy(dAtA[i:], m.EvaluationPeriod)
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(len(m.EvaluationPeriod)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Operand) > 0 {
+		i -= len(m.Operand)
+		copy(dAtA[i:], m.Operand)
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(len(m.Operand)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Aggregation) > 0 {
+		i -= len(m.Aggregation)
+		copy(dAtA[i:], m.Aggregation)
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(len(m.Aggregation)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Metric) > 0 {
+		i -= len(m.Metric)
+		copy(dAtA[i:], m.Metric)
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(len(m.Metric)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Children) > 0 {
+		for iNdEx := len(m.Children) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Children[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCbtumblebug(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Combinator) > 0 {
+		i -= len(m.Combinator)
+		copy(dAtA[i:], m.Combinator)
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(len(m.Combinator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *AutoAction) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -22660,6 +26364,61 @@ func (m *AutoAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.PlacementLocation) > 0 {
+		i -= len(m.PlacementLocation)
+		copy(dAtA[i:], m.PlacementLocation)
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(len(m.PlacementLocation)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.ScopeVmGroupId) > 0 {
+		i -= len(m.ScopeVmGroupId)
+		copy(dAtA[i:], m.ScopeVmGroupId)
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(len(m.ScopeVmGroupId)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.VictimStrategy) > 0 {
+		i -= len(m.VictimStrategy)
+		copy(dAtA[i:], m.VictimStrategy)
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(len(m.VictimStrategy)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.ScaleInCooldownSec != 0 {
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(m.ScaleInCooldownSec))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.ScaleOutCooldownSec != 0 {
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(m.ScaleOutCooldownSec))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.StepSize) > 0 {
+		i -= len(m.StepSize)
+		copy(dAtA[i:], m.StepSize)
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(len(m.StepSize)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.MaxVmCount != 0 {
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(m.MaxVmCount))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MinVmCount != 0 {
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(m.MinVmCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.ScopeLabel) > 0 {
+		i -= len(m.ScopeLabel)
+		copy(dAtA[i:], m.ScopeLabel)
+		i = encodeVarintCbtumblebug(dAtA, i, uint64(len(m.ScopeLabel)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.PlacementAlgo) > 0 {
 		i -= len(m.PlacementAlgo)
 		copy(dAtA[i:], m.PlacementAlgo)
@@ -24508,6 +28267,33 @@ func (m *TbImageInfo) Size() (n int) {
 	if m.IsAutoGenerated {
 		n += 2
 	}
+	l = len(m.OsFamily)
+	if l > 0 {
+		n += 1 + l + sovCbtumblebug(uint64(l))
+	}
+	l = len(m.OsDistribution)
+	if l > 0 {
+		n += 1 + l + sovCbtumblebug(uint64(l))
+	}
+	l = len(m.OsVersion)
+	if l > 0 {
+		n += 2 + l + sovCbtumblebug(uint64(l))
+	}
+	l = len(m.OsArchitecture)
+	if l > 0 {
+		n += 2 + l + sovCbtumblebug(uint64(l))
+	}
+	l = len(m.VirtualizationType)
+	if l > 0 {
+		n += 2 + l + sovCbtumblebug(uint64(l))
+	}
+	l = len(m.BootMode)
+	if l > 0 {
+		n += 2 + l + sovCbtumblebug(uint64(l))
+	}
+	if m.Deprecated {
+		n += 3
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -24598,6 +28384,38 @@ func (m *SearchImageQryRequest) Size() (n int) {
 			n += 1 + l + sovCbtumblebug(uint64(l))
 		}
 	}
+	l = len(m.ConnectionName)
+	if l > 0 {
+		n += 1 + l + sovCbtumblebug(uint64(l))
+	}
+	l = len(m.OsFamily)
+	if l > 0 {
+		n += 1 + l + sovCbtumblebug(uint64(l))
+	}
+	l = len(m.OsDistribution)
+	if l > 0 {
+		n += 1 + l + sovCbtumblebug(uint64(l))
+	}
+	l = len(m.OsVersion)
+	if l > 0 {
+		n += 1 + l + sovCbtumblebug(uint64(l))
+	}
+	l = len(m.OsArchitecture)
+	if l > 0 {
+		n += 1 + l + sovCbtumblebug(uint64(l))
+	}
+	l = len(m.VirtualizationType)
+	if l > 0 {
+		n += 1 + l + sovCbtumblebug(uint64(l))
+	}
+	l = len(m.BootMode)
+	if l > 0 {
+		n += 1 + l + sovCbtumblebug(uint64(l))
+	}
+	l = len(m.Os)
+	if l > 0 {
+		n += 1 + l + sovCbtumblebug(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -25075,6 +28893,46 @@ func (m *TbSpecInfo) Size() (n int) {
 	if m.IsAutoGenerated {
 		n += 3
 	}
+	if len(m.EvaluationBreakdown) > 0 {
+		for _, e := range m.EvaluationBreakdown {
+			l = e.Size()
+			n += 2 + l + sovCbtumblebug(uint64(l))
+		}
+	}
+	if m.Deprecated {
+		n += 3
+	}
+	if m.EvaluationTotalScore != 0 {
+		n += 6
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SpecEvaluationScore) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Metric)
+	if l > 0 {
+		n += 1 + l + sovCbtumblebug(uint64(l))
+	}
+	if m.Value != 0 {
+		n += 5
+	}
+	if m.Score != 0 {
+		n += 5
+	}
+	if m.Weight != 0 {
+		n += 5
+	}
+	if m.WeightedScore != 0 {
+		n += 5
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -25327,6 +29185,137 @@ func (m *FilterSpecsByRangeRequest) Size() (n int) {
 	return n
 }
 
+func (m *SpecSearchRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NsId)
+	if l > 0 {
+		n += 1 + l + sovCbtumblebug(uint64(l))
+	}
+	if m.Item != nil {
+		l = m.Item.Size()
+		n += 1 + l + sovCbtumblebug(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SpecSearchReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Condition) > 0 {
+		for _, e := range m.Condition {
+			l = e.Size()
+			n += 1 + l + sovCbtumblebug(uint64(l))
+		}
+	}
+	if len(m.OrderBy) > 0 {
+		for _, e := range m.OrderBy {
+			l = e.Size()
+			n += 1 + l + sovCbtumblebug(uint64(l))
+		}
+	}
+	if m.Limit != 0 {
+		n += 1 + sovCbtumblebug(uint64(m.Limit))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovCbtumblebug(uint64(m.Offset))
+	}
+	l = len(m.Cursor)
+	if l > 0 {
+		n += 1 + l + sovCbtumblebug(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SpecSearchCondition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Field)
+	if l > 0 {
+		n += 1 + l + sovCbtumblebug(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovCbtumblebug(uint64(l))
+	}
+	if len(m.Value) > 0 {
+		for _, s := range m.Value {
+			l = len(s)
+			n += 1 + l + sovCbtumblebug(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SpecSearchOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Field)
+	if l > 0 {
+		n += 1 + l + sovCbtumblebug(uint64(l))
+	}
+	l = len(m.Direction)
+	if l > 0 {
+		n += 1 + l + sovCbtumblebug(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SpecSearchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Spec) > 0 {
+		for _, e := range m.Spec {
+			l = e.Size()
+			n += 1 + l + sovCbtumblebug(uint64(l))
+		}
+	}
+	if m.TotalCount != 0 {
+		n += 1 + sovCbtumblebug(uint64(m.TotalCount))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovCbtumblebug(uint64(m.Limit))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovCbtumblebug(uint64(m.Offset))
+	}
+	l = len(m.NextCursor)
+	if l > 0 {
+		n += 1 + l + sovCbtumblebug(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *SpecRangeFilter) Size() (n int) {
 	if m == nil {
 		return 0
@@ -26109,6 +30098,18 @@ func (m *TbVmInfo) Size() (n int) {
 		l = m.CspViewVmDetail.Size()
 		n += 2 + l + sovCbtumblebug(uint64(l))
 	}
+	l = len(m.UserData)
+	if l > 0 {
+		n += 2 + l + sovCbtumblebug(uint64(l))
+	}
+	if m.PostCommand != nil {
+		l = m.PostCommand.Size()
+		n += 2 + l + sovCbtumblebug(uint64(l))
+	}
+	l = len(m.UserDataEncoding)
+	if l > 0 {
+		n += 2 + l + sovCbtumblebug(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -26283,6 +30284,10 @@ func (m *SpiderVMInfo) Size() (n int) {
 			n += 2 + l + sovCbtumblebug(uint64(l))
 		}
 	}
+	l = len(m.UserData)
+	if l > 0 {
+		n += 2 + l + sovCbtumblebug(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -26407,6 +30412,14 @@ func (m *TbVmReq) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovCbtumblebug(uint64(l))
 	}
+	l = len(m.UserData)
+	if l > 0 {
+		n += 1 + l + sovCbtumblebug(uint64(l))
+	}
+	l = len(m.UserDataEncoding)
+	if l > 0 {
+		n += 1 + l + sovCbtumblebug(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -26609,7 +30622,7 @@ func (m *TbMcisActionRequest) Size() (n int) {
 	return n
 }
 
-func (m *TbMcisQryRequest) Size() (n int) {
+func (m *CostReportQryRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -26623,13 +30636,29 @@ func (m *TbMcisQryRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovCbtumblebug(uint64(l))
 	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovCbtumblebug(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovCbtumblebug(uint64(l))
+	}
+	l = len(m.GroupBy)
+	if l > 0 {
+		n += 1 + l + sovCbtumblebug(uint64(l))
+	}
+	l = len(m.Format)
+	if l > 0 {
+		n += 1 + l + sovCbtumblebug(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *TbVmInfoResponse) Size() (n int) {
+func (m *CostReportResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -26639,13 +30668,17 @@ func (m *TbVmInfoResponse) Size() (n int) {
 		l = m.Item.Size()
 		n += 1 + l + sovCbtumblebug(uint64(l))
 	}
+	l = len(m.Csv)
+	if l > 0 {
+		n += 1 + l + sovCbtumblebug(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *TbVmCreateRequest) Size() (n int) {
+func (m *TbCostReport) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -26659,6 +30692,99 @@ func (m *TbVmCreateRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovCbtumblebug(uint64(l))
 	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovCbtumblebug(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovCbtumblebug(uint64(l))
+	}
+	l = len(m.GroupBy)
+	if l > 0 {
+		n += 1 + l + sovCbtumblebug(uint64(l))
+	}
+	if len(m.Item) > 0 {
+		for _, e := range m.Item {
+			l = e.Size()
+			n += 1 + l + sovCbtumblebug(uint64(l))
+		}
+	}
+	if m.TotalCost != 0 {
+		n += 9
+	}
+	if m.TotalCurrentCostPerHour != 0 {
+		n += 9
+	}
+	if m.TotalProjectedMonthlyCost != 0 {
+		n += 9
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TbCostReportItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Group)
+	if l > 0 {
+		n += 1 + l + sovCbtumblebug(uint64(l))
+	}
+	if m.VmNum != 0 {
+		n += 1 + sovCbtumblebug(uint64(m.VmNum))
+	}
+	if m.RunningVmNum != 0 {
+		n += 1 + sovCbtumblebug(uint64(m.RunningVmNum))
+	}
+	if m.RunningHours != 0 {
+		n += 9
+	}
+	if m.Cost != 0 {
+		n += 9
+	}
+	if m.CurrentCostPerHour != 0 {
+		n += 9
+	}
+	if m.ProjectedMonthlyCost != 0 {
+		n += 9
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TbMcisQryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NsId)
+	if l > 0 {
+		n += 1 + l + sovCbtumblebug(uint64(l))
+	}
+	l = len(m.McisId)
+	if l > 0 {
+		n += 1 + l + sovCbtumblebug(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TbVmInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	if m.Item != nil {
 		l = m.Item.Size()
 		n += 1 + l + sovCbtumblebug(uint64(l))
@@ -26669,7 +30795,31 @@ func (m *TbVmCreateRequest) Size() (n int) {
 	return n
 }
 
-func (m *TbVmGroupCreateRequest) Size() (n int) {
+func (m *TbVmCreateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NsId)
+	if l > 0 {
+		n += 1 + l + sovCbtumblebug(uint64(l))
+	}
+	l = len(m.McisId)
+	if l > 0 {
+		n += 1 + l + sovCbtumblebug(uint64(l))
+	}
+	if m.Item != nil {
+		l = m.Item.Size()
+		n += 1 + l + sovCbtumblebug(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TbVmGroupCreateRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -27327,6 +31477,149 @@ func (m *MonResultSimple) Size() (n int) {
 	return n
 }
 
+func (m *MonitorRangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Item != nil {
+		l = m.Item.Size()
+		n += 1 + l + sovCbtumblebug(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MonRangeInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NsId)
+	if l > 0 {
+		n += 1 + l + sovCbtumblebug(uint64(l))
+	}
+	l = len(m.McisId)
+	if l > 0 {
+		n += 1 + l + sovCbtumblebug(uint64(l))
+	}
+	l = len(m.Metric)
+	if l > 0 {
+		n += 1 + l + sovCbtumblebug(uint64(l))
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovCbtumblebug(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovCbtumblebug(uint64(l))
+	}
+	if m.StepSec != 0 {
+		n += 1 + sovCbtumblebug(uint64(m.StepSec))
+	}
+	l = len(m.Aggregation)
+	if l > 0 {
+		n += 1 + l + sovCbtumblebug(uint64(l))
+	}
+	l = len(m.Resolution)
+	if l > 0 {
+		n += 1 + l + sovCbtumblebug(uint64(l))
+	}
+	if len(m.VmSeries) > 0 {
+		for _, e := range m.VmSeries {
+			l = e.Size()
+			n += 1 + l + sovCbtumblebug(uint64(l))
+		}
+	}
+	if len(m.McisSeries) > 0 {
+		for _, e := range m.McisSeries {
+			l = e.Size()
+			n += 1 + l + sovCbtumblebug(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MonVmSeries) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VmId)
+	if l > 0 {
+		n += 1 + l + sovCbtumblebug(uint64(l))
+	}
+	if len(m.Points) > 0 {
+		for _, e := range m.Points {
+			l = e.Size()
+			n += 1 + l + sovCbtumblebug(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MonPoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Time)
+	if l > 0 {
+		n += 1 + l + sovCbtumblebug(uint64(l))
+	}
+	if m.Value != 0 {
+		n += 9
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MonMcisPoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Time)
+	if l > 0 {
+		n += 1 + l + sovCbtumblebug(uint64(l))
+	}
+	if m.Avg != 0 {
+		n += 9
+	}
+	if m.Min != 0 {
+		n += 9
+	}
+	if m.Max != 0 {
+		n += 9
+	}
+	if m.Sum != 0 {
+		n += 9
+	}
+	if m.VmCount != 0 {
+		n += 1 + sovCbtumblebug(uint64(m.VmCount))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *MonitorQryRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -27345,6 +31638,22 @@ func (m *MonitorQryRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovCbtumblebug(uint64(l))
 	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovCbtumblebug(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovCbtumblebug(uint64(l))
+	}
+	l = len(m.Step)
+	if l > 0 {
+		n += 1 + l + sovCbtumblebug(uint64(l))
+	}
+	l = len(m.Agg)
+	if l > 0 {
+		n += 1 + l + sovCbtumblebug(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -27537,6 +31846,54 @@ func (m *McisPolicyInfo) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovCbtumblebug(uint64(l))
 	}
+	l = len(m.LastScaleOutTime)
+	if l > 0 {
+		n += 1 + l + sovCbtumblebug(uint64(l))
+	}
+	l = len(m.LastScaleInTime)
+	if l > 0 {
+		n += 1 + l + sovCbtumblebug(uint64(l))
+	}
+	if m.MetricsSource != nil {
+		l = m.MetricsSource.Size()
+		n += 1 + l + sovCbtumblebug(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MetricsSource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovCbtumblebug(uint64(l))
+	}
+	l = len(m.PrometheusUrl)
+	if l > 0 {
+		n += 1 + l + sovCbtumblebug(uint64(l))
+	}
+	if len(m.PrometheusQuery) > 0 {
+		for k, v := range m.PrometheusQuery {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovCbtumblebug(uint64(len(k))) + 1 + len(v) + sovCbtumblebug(uint64(len(v)))
+			n += mapEntrySize + 1 + sovCbtumblebug(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.PrometheusVmLabel)
+	if l > 0 {
+		n += 1 + l + sovCbtumblebug(uint64(l))
+	}
+	l = len(m.SshUserName)
+	if l > 0 {
+		n += 1 + l + sovCbtumblebug(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -27561,6 +31918,87 @@ func (m *Policy) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovCbtumblebug(uint64(l))
 	}
+	if m.AutoSchedule != nil {
+		l = m.AutoSchedule.Size()
+		n += 1 + l + sovCbtumblebug(uint64(l))
+	}
+	if m.AutoPredict != nil {
+		l = m.AutoPredict.Size()
+		n += 1 + l + sovCbtumblebug(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AutoPredict) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Model)
+	if l > 0 {
+		n += 1 + l + sovCbtumblebug(uint64(l))
+	}
+	if m.SeasonLength != 0 {
+		n += 1 + sovCbtumblebug(uint64(m.SeasonLength))
+	}
+	if m.LeadTimeMin != 0 {
+		n += 1 + sovCbtumblebug(uint64(m.LeadTimeMin))
+	}
+	if m.ActionEnabled {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AutoSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TimeZone)
+	if l > 0 {
+		n += 1 + l + sovCbtumblebug(uint64(l))
+	}
+	if len(m.Rules) > 0 {
+		for _, e := range m.Rules {
+			l = e.Size()
+			n += 1 + l + sovCbtumblebug(uint64(l))
+		}
+	}
+	if m.ActiveRule != 0 {
+		n += 1 + sovCbtumblebug(uint64(m.ActiveRule))
+	}
+	l = len(m.ActiveRuleTime)
+	if l > 0 {
+		n += 1 + l + sovCbtumblebug(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AutoScheduleRule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Cron)
+	if l > 0 {
+		n += 1 + l + sovCbtumblebug(uint64(l))
+	}
+	if m.DesiredVmCount != 0 {
+		n += 1 + sovCbtumblebug(uint64(m.DesiredVmCount))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -27595,6 +32033,70 @@ func (m *AutoCondition) Size() (n int) {
 			n += 1 + l + sovCbtumblebug(uint64(l))
 		}
 	}
+	l = len(m.Aggregation)
+	if l > 0 {
+		n += 1 + l + sovCbtumblebug(uint64(l))
+	}
+	if m.Expression != nil {
+		l = m.Expression.Size()
+		n += 1 + l + sovCbtumblebug(uint64(l))
+	}
+	l = len(m.Window)
+	if l > 0 {
+		n += 1 + l + sovCbtumblebug(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AutoConditionExpr) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Combinator)
+	if l > 0 {
+		n += 1 + l + sovCbtumblebug(uint64(l))
+	}
+	if len(m.Children) > 0 {
+		for _, e := range m.Children {
+			l = e.Size()
+			n += 1 + l + sovCbtumblebug(uint64(l))
+		}
+	}
+	l = len(m.Metric)
+	if l > 0 {
+		n += 1 + l + sovCbtumblebug(uint64(l))
+	}
+	l = len(m.Aggregation)
+	if l > 0 {
+		n += 1 + l + sovCbtumblebug(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovCbtumblebug(uint64(l))
+	}
+	l = len(m.Operand)
+	if l > 0 {
+		n += 1 + l + sovCbtumblebug(uint64(l))
+	}
+	l = len(m.EvaluationPeriod)
+	if l > 0 {
+		n += 1 + l + sovCbtumblebug(uint64(l))
+	}
+	if len(m.EvaluationValue) > 0 {
+		for _, s := range m.EvaluationValue {
+			l = len(s)
+			n += 1 + l + sovCbtumblebug(uint64(l))
+		}
+	}
+	l = len(m.Window)
+	if l > 0 {
+		n += 1 + l + sovCbtumblebug(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -27623,6 +32125,38 @@ func (m *AutoAction) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovCbtumblebug(uint64(l))
 	}
+	l = len(m.ScopeLabel)
+	if l > 0 {
+		n += 1 + l + sovCbtumblebug(uint64(l))
+	}
+	if m.MinVmCount != 0 {
+		n += 1 + sovCbtumblebug(uint64(m.MinVmCount))
+	}
+	if m.MaxVmCount != 0 {
+		n += 1 + sovCbtumblebug(uint64(m.MaxVmCount))
+	}
+	l = len(m.StepSize)
+	if l > 0 {
+		n += 1 + l + sovCbtumblebug(uint64(l))
+	}
+	if m.ScaleOutCooldownSec != 0 {
+		n += 1 + sovCbtumblebug(uint64(m.ScaleOutCooldownSec))
+	}
+	if m.ScaleInCooldownSec != 0 {
+		n += 1 + sovCbtumblebug(uint64(m.ScaleInCooldownSec))
+	}
+	l = len(m.VictimStrategy)
+	if l > 0 {
+		n += 1 + l + sovCbtumblebug(uint64(l))
+	}
+	l = len(m.ScopeVmGroupId)
+	if l > 0 {
+		n += 1 + l + sovCbtumblebug(uint64(l))
+	}
+	l = len(m.PlacementLocation)
+	if l > 0 {
+		n += 1 + l + sovCbtumblebug(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -30680,60 +35214,9 @@ func (m *TbImageInfo) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.IsAutoGenerated = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TbImageCreateRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCbtumblebug
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TbImageCreateRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TbImageCreateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NsId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OsFamily", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -30761,98 +35244,11 @@ func (m *TbImageCreateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NsId = string(dAtA[iNdEx:postIndex])
+			m.OsFamily = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCbtumblebug
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Item == nil {
-				m.Item = &TbImageReq{}
-			}
-			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TbImageReq) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCbtumblebug
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TbImageReq: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TbImageReq: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OsDistribution", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -30880,11 +35276,11 @@ func (m *TbImageReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.OsDistribution = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OsVersion", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -30912,11 +35308,11 @@ func (m *TbImageReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConnectionName = string(dAtA[iNdEx:postIndex])
+			m.OsVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CspImageId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OsArchitecture", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -30944,11 +35340,11 @@ func (m *TbImageReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CspImageId = string(dAtA[iNdEx:postIndex])
+			m.OsArchitecture = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VirtualizationType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -30976,62 +35372,11 @@ func (m *TbImageReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.VirtualizationType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *FetchImageQryRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCbtumblebug
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: FetchImageQryRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FetchImageQryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BootMode", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -31059,13 +35404,13 @@ func (m *FetchImageQryRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConnectionName = string(dAtA[iNdEx:postIndex])
+			m.BootMode = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NsId", wireType)
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deprecated", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -31075,24 +35420,12 @@ func (m *FetchImageQryRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NsId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Deprecated = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
@@ -31115,7 +35448,7 @@ func (m *FetchImageQryRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SearchImageQryRequest) Unmarshal(dAtA []byte) error {
+func (m *TbImageCreateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -31138,10 +35471,10 @@ func (m *SearchImageQryRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SearchImageQryRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TbImageCreateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SearchImageQryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TbImageCreateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -31178,9 +35511,9 @@ func (m *SearchImageQryRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Keywords", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -31190,23 +35523,27 @@ func (m *SearchImageQryRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Keywords = append(m.Keywords, string(dAtA[iNdEx:postIndex]))
+			if m.Item == nil {
+				m.Item = &TbImageReq{}
+			}
+			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -31230,7 +35567,7 @@ func (m *SearchImageQryRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SpiderImageInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *TbImageReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -31253,17 +35590,17 @@ func (m *SpiderImageInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SpiderImageInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TbImageReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SpiderImageInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TbImageReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -31273,27 +35610,119 @@ func (m *SpiderImageInfoResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Item == nil {
-				m.Item = &SpiderImageInfo{}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionName", wireType)
 			}
-			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CspImageId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CspImageId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -31317,7 +35746,7 @@ func (m *SpiderImageInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListSpiderImageInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *FetchImageQryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -31340,17 +35769,17 @@ func (m *ListSpiderImageInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListSpiderImageInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: FetchImageQryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListSpiderImageInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FetchImageQryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -31360,25 +35789,55 @@ func (m *ListSpiderImageInfoResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, &SpiderImageInfo{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ConnectionName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NsId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
 			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NsId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -31402,7 +35861,7 @@ func (m *ListSpiderImageInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SpiderImageInfo) Unmarshal(dAtA []byte) error {
+func (m *SearchImageQryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -31425,17 +35884,17 @@ func (m *SpiderImageInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SpiderImageInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: SearchImageQryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SpiderImageInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SearchImageQryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Iid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NsId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -31445,31 +35904,27 @@ func (m *SpiderImageInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Iid == nil {
-				m.Iid = &IID{}
-			}
-			if err := m.Iid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NsId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GuestOs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Keywords", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -31497,11 +35952,11 @@ func (m *SpiderImageInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GuestOs = string(dAtA[iNdEx:postIndex])
+			m.Keywords = append(m.Keywords, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -31529,13 +35984,13 @@ func (m *SpiderImageInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = string(dAtA[iNdEx:postIndex])
+			m.ConnectionName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyValueList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OsFamily", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -31545,80 +36000,27 @@ func (m *SpiderImageInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KeyValueList = append(m.KeyValueList, &KeyValue{})
-			if err := m.KeyValueList[len(m.KeyValueList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.OsFamily = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *LookupImageListQryRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCbtumblebug
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: LookupImageListQryRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LookupImageListQryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OsDistribution", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -31646,62 +36048,11 @@ func (m *LookupImageListQryRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConnectionName = string(dAtA[iNdEx:postIndex])
+			m.OsDistribution = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *LookupImageQryRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCbtumblebug
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: LookupImageQryRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LookupImageQryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OsVersion", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -31729,11 +36080,11 @@ func (m *LookupImageQryRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConnectionName = string(dAtA[iNdEx:postIndex])
+			m.OsVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CspImageId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OsArchitecture", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -31761,62 +36112,11 @@ func (m *LookupImageQryRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CspImageId = string(dAtA[iNdEx:postIndex])
+			m.OsArchitecture = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TbUpdateImageRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCbtumblebug
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TbUpdateImageRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TbUpdateImageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NsId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VirtualizationType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -31844,11 +36144,11 @@ func (m *TbUpdateImageRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NsId = string(dAtA[iNdEx:postIndex])
+			m.VirtualizationType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ImageId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BootMode", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -31876,13 +36176,13 @@ func (m *TbUpdateImageRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ImageId = string(dAtA[iNdEx:postIndex])
+			m.BootMode = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Os", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -31892,27 +36192,23 @@ func (m *TbUpdateImageRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Item == nil {
-				m.Item = &TbImageInfo{}
-			}
-			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Os = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -31936,7 +36232,7 @@ func (m *TbUpdateImageRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TbSshKeyInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *SpiderImageInfoResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -31959,10 +36255,10 @@ func (m *TbSshKeyInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TbSshKeyInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SpiderImageInfoResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TbSshKeyInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SpiderImageInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -31995,7 +36291,7 @@ func (m *TbSshKeyInfoResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Item == nil {
-				m.Item = &TbSshKeyInfo{}
+				m.Item = &SpiderImageInfo{}
 			}
 			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -32023,7 +36319,7 @@ func (m *TbSshKeyInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListTbSshKeyInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *ListSpiderImageInfoResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -32046,10 +36342,10 @@ func (m *ListTbSshKeyInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListTbSshKeyInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListSpiderImageInfoResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListTbSshKeyInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListSpiderImageInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -32081,7 +36377,7 @@ func (m *ListTbSshKeyInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, &TbSshKeyInfo{})
+			m.Items = append(m.Items, &SpiderImageInfo{})
 			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -32108,7 +36404,7 @@ func (m *ListTbSshKeyInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TbSshKeyInfo) Unmarshal(dAtA []byte) error {
+func (m *SpiderImageInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -32131,17 +36427,17 @@ func (m *TbSshKeyInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TbSshKeyInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: SpiderImageInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TbSshKeyInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SpiderImageInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Iid", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -32151,27 +36447,31 @@ func (m *TbSshKeyInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			if m.Iid == nil {
+				m.Iid = &IID{}
+			}
+			if err := m.Iid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GuestOs", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -32199,11 +36499,11 @@ func (m *TbSshKeyInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.GuestOs = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -32231,233 +36531,9 @@ func (m *TbSshKeyInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConnectionName = string(dAtA[iNdEx:postIndex])
+			m.Status = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCbtumblebug
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CspSshKeyName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCbtumblebug
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CspSshKeyName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fingerprint", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCbtumblebug
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Fingerprint = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCbtumblebug
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Username = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VerifiedUserName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCbtumblebug
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.VerifiedUserName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCbtumblebug
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PublicKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrivateKey", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCbtumblebug
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PrivateKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 11:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field KeyValueList", wireType)
 			}
@@ -32491,58 +36567,6 @@ func (m *TbSshKeyInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssociatedObjectList", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCbtumblebug
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AssociatedObjectList = append(m.AssociatedObjectList, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsAutoGenerated", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCbtumblebug
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IsAutoGenerated = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
@@ -32565,7 +36589,7 @@ func (m *TbSshKeyInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TbSshKeyCreateRequest) Unmarshal(dAtA []byte) error {
+func (m *LookupImageListQryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -32588,15 +36612,15 @@ func (m *TbSshKeyCreateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TbSshKeyCreateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: LookupImageListQryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TbSshKeyCreateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LookupImageListQryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NsId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -32624,43 +36648,7 @@ func (m *TbSshKeyCreateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NsId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCbtumblebug
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Item == nil {
-				m.Item = &TbSshKeyReq{}
-			}
-			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ConnectionName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -32684,7 +36672,7 @@ func (m *TbSshKeyCreateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TbSshKeyReq) Unmarshal(dAtA []byte) error {
+func (m *LookupImageQryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -32707,45 +36695,13 @@ func (m *TbSshKeyReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TbSshKeyReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: LookupImageQryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TbSshKeyReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LookupImageQryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCbtumblebug
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionName", wireType)
 			}
@@ -32777,9 +36733,9 @@ func (m *TbSshKeyReq) Unmarshal(dAtA []byte) error {
 			}
 			m.ConnectionName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CspImageId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -32807,7 +36763,7 @@ func (m *TbSshKeyReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.CspImageId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -32831,7 +36787,7 @@ func (m *TbSshKeyReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TbUpdateSpecRequest) Unmarshal(dAtA []byte) error {
+func (m *TbUpdateImageRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -32854,10 +36810,10 @@ func (m *TbUpdateSpecRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TbUpdateSpecRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TbUpdateImageRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TbUpdateSpecRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TbUpdateImageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -32894,7 +36850,7 @@ func (m *TbUpdateSpecRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpecId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ImageId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -32922,7 +36878,7 @@ func (m *TbUpdateSpecRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpecId = string(dAtA[iNdEx:postIndex])
+			m.ImageId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
@@ -32954,7 +36910,7 @@ func (m *TbUpdateSpecRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Item == nil {
-				m.Item = &TbSpecInfo{}
+				m.Item = &TbImageInfo{}
 			}
 			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -32982,7 +36938,7 @@ func (m *TbUpdateSpecRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TbSpecInfoRequest) Unmarshal(dAtA []byte) error {
+func (m *TbSshKeyInfoResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -33005,45 +36961,13 @@ func (m *TbSpecInfoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TbSpecInfoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TbSshKeyInfoResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TbSpecInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TbSshKeyInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NsId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCbtumblebug
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NsId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
 			}
@@ -33073,7 +36997,7 @@ func (m *TbSpecInfoRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Item == nil {
-				m.Item = &TbSpecInfo{}
+				m.Item = &TbSshKeyInfo{}
 			}
 			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -33101,7 +37025,7 @@ func (m *TbSpecInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TbSpecInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *ListTbSshKeyInfoResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -33124,15 +37048,15 @@ func (m *TbSpecInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TbSpecInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListTbSshKeyInfoResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TbSpecInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListTbSshKeyInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -33159,10 +37083,8 @@ func (m *TbSpecInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Item == nil {
-				m.Item = &TbSpecInfo{}
-			}
-			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, &TbSshKeyInfo{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -33188,7 +37110,7 @@ func (m *TbSpecInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListTbSpecInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *TbSshKeyInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -33211,17 +37133,17 @@ func (m *ListTbSpecInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListTbSpecInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TbSshKeyInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListTbSpecInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TbSshKeyInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -33231,98 +37153,45 @@ func (m *ListTbSpecInfoResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, &TbSpecInfo{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TbSpecInfo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCbtumblebug
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TbSpecInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TbSpecInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCbtumblebug
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			postIndex := iNdEx + intStringLen
@@ -33332,11 +37201,11 @@ func (m *TbSpecInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -33364,11 +37233,11 @@ func (m *TbSpecInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.ConnectionName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -33396,11 +37265,11 @@ func (m *TbSpecInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CspSshKeyName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -33428,11 +37297,11 @@ func (m *TbSpecInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConnectionName = string(dAtA[iNdEx:postIndex])
+			m.CspSshKeyName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CspSpecName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fingerprint", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -33460,11 +37329,11 @@ func (m *TbSpecInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CspSpecName = string(dAtA[iNdEx:postIndex])
+			m.Fingerprint = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OsType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -33492,79 +37361,11 @@ func (m *TbSpecInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OsType = string(dAtA[iNdEx:postIndex])
+			m.Username = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumVcpu", wireType)
-			}
-			m.NumVcpu = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCbtumblebug
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NumVcpu |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumCore", wireType)
-			}
-			m.NumCore = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCbtumblebug
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NumCore |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 9:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MemGib", wireType)
-			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.MemGib = float32(math.Float32frombits(v))
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StorageGib", wireType)
-			}
-			m.StorageGib = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCbtumblebug
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.StorageGib |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifiedUserName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -33592,117 +37393,11 @@ func (m *TbSpecInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.VerifiedUserName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CostPerHour", wireType)
-			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.CostPerHour = float32(math.Float32frombits(v))
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumStorage", wireType)
-			}
-			m.NumStorage = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCbtumblebug
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NumStorage |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxNumStorage", wireType)
-			}
-			m.MaxNumStorage = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCbtumblebug
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaxNumStorage |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 15:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxTotalStorageTib", wireType)
-			}
-			m.MaxTotalStorageTib = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCbtumblebug
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaxTotalStorageTib |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 16:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetBwGbps", wireType)
-			}
-			m.NetBwGbps = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCbtumblebug
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NetBwGbps |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 17:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EbsBwMbps", wireType)
-			}
-			m.EbsBwMbps = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCbtumblebug
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EbsBwMbps |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 18:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GpuModel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -33730,41 +37425,11 @@ func (m *TbSpecInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GpuModel = string(dAtA[iNdEx:postIndex])
+			m.PublicKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 19:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumGpu", wireType)
-			}
-			m.NumGpu = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCbtumblebug
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NumGpu |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 20:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GpumemGib", wireType)
-			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.GpumemGib = float32(math.Float32frombits(v))
-		case 21:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GpuP2P", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PrivateKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -33792,32 +37457,13 @@ func (m *TbSpecInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GpuP2P = string(dAtA[iNdEx:postIndex])
+			m.PrivateKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 22:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderInFilteredResult", wireType)
-			}
-			m.OrderInFilteredResult = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCbtumblebug
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.OrderInFilteredResult |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 23:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EvaluationStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyValueList", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -33827,135 +37473,27 @@ func (m *TbSpecInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EvaluationStatus = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 31:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EvaluationScore_01", wireType)
-			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.EvaluationScore_01 = float32(math.Float32frombits(v))
-		case 32:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EvaluationScore_02", wireType)
-			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.EvaluationScore_02 = float32(math.Float32frombits(v))
-		case 33:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EvaluationScore_03", wireType)
-			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.EvaluationScore_03 = float32(math.Float32frombits(v))
-		case 34:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EvaluationScore_04", wireType)
-			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.EvaluationScore_04 = float32(math.Float32frombits(v))
-		case 35:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EvaluationScore_05", wireType)
-			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.EvaluationScore_05 = float32(math.Float32frombits(v))
-		case 36:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EvaluationScore_06", wireType)
-			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.EvaluationScore_06 = float32(math.Float32frombits(v))
-		case 37:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EvaluationScore_07", wireType)
-			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.EvaluationScore_07 = float32(math.Float32frombits(v))
-		case 38:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EvaluationScore_08", wireType)
-			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.EvaluationScore_08 = float32(math.Float32frombits(v))
-		case 39:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EvaluationScore_09", wireType)
-			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.EvaluationScore_09 = float32(math.Float32frombits(v))
-		case 40:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EvaluationScore_10", wireType)
-			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
+			m.KeyValueList = append(m.KeyValueList, &KeyValue{})
+			if err := m.KeyValueList[len(m.KeyValueList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.EvaluationScore_10 = float32(math.Float32frombits(v))
-		case 41:
+			iNdEx = postIndex
+		case 12:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field AssociatedObjectList", wireType)
 			}
@@ -33987,7 +37525,7 @@ func (m *TbSpecInfo) Unmarshal(dAtA []byte) error {
 			}
 			m.AssociatedObjectList = append(m.AssociatedObjectList, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 42:
+		case 13:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field IsAutoGenerated", wireType)
 			}
@@ -34029,7 +37567,7 @@ func (m *TbSpecInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TbSpecCreateRequest) Unmarshal(dAtA []byte) error {
+func (m *TbSshKeyCreateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -34052,10 +37590,10 @@ func (m *TbSpecCreateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TbSpecCreateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TbSshKeyCreateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TbSpecCreateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TbSshKeyCreateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -34120,7 +37658,7 @@ func (m *TbSpecCreateRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Item == nil {
-				m.Item = &TbSpecReq{}
+				m.Item = &TbSshKeyReq{}
 			}
 			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -34148,7 +37686,7 @@ func (m *TbSpecCreateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TbSpecReq) Unmarshal(dAtA []byte) error {
+func (m *TbSshKeyReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -34171,10 +37709,10 @@ func (m *TbSpecReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TbSpecReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: TbSshKeyReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TbSpecReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TbSshKeyReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -34243,7 +37781,7 @@ func (m *TbSpecReq) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CspSpecName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -34271,47 +37809,15 @@ func (m *TbSpecReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CspSpecName = string(dAtA[iNdEx:postIndex])
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCbtumblebug
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if (iNdEx + skippy) > l {
@@ -34327,7 +37833,7 @@ func (m *TbSpecReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FetchSpecQryRequest) Unmarshal(dAtA []byte) error {
+func (m *TbUpdateSpecRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -34350,15 +37856,15 @@ func (m *FetchSpecQryRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FetchSpecQryRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TbUpdateSpecRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FetchSpecQryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TbUpdateSpecRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NsId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -34386,11 +37892,11 @@ func (m *FetchSpecQryRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConnectionName = string(dAtA[iNdEx:postIndex])
+			m.NsId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NsId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -34418,60 +37924,9 @@ func (m *FetchSpecQryRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NsId = string(dAtA[iNdEx:postIndex])
+			m.SpecId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SpiderSpecInfoResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCbtumblebug
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SpiderSpecInfoResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SpiderSpecInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
 			}
@@ -34501,7 +37956,7 @@ func (m *SpiderSpecInfoResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Item == nil {
-				m.Item = &SpiderSpecInfo{}
+				m.Item = &TbSpecInfo{}
 			}
 			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -34529,7 +37984,7 @@ func (m *SpiderSpecInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListSpiderSpecInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *TbSpecInfoRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -34552,15 +38007,47 @@ func (m *ListSpiderSpecInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListSpiderSpecInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TbSpecInfoRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListSpiderSpecInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TbSpecInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NsId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NsId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -34587,8 +38074,10 @@ func (m *ListSpiderSpecInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, &SpiderSpecInfo{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Item == nil {
+				m.Item = &TbSpecInfo{}
+			}
+			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -34614,7 +38103,7 @@ func (m *ListSpiderSpecInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SpiderSpecInfo) Unmarshal(dAtA []byte) error {
+func (m *TbSpecInfoResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -34637,147 +38126,15 @@ func (m *SpiderSpecInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SpiderSpecInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: TbSpecInfoResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SpiderSpecInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TbSpecInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCbtumblebug
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Region = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCbtumblebug
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VCpu", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCbtumblebug
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.VCpu == nil {
-				m.VCpu = &SpiderVCpuInfo{}
-			}
-			if err := m.VCpu.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mem", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCbtumblebug
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Mem = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gpu", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -34804,42 +38161,10 @@ func (m *SpiderSpecInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Gpu = append(m.Gpu, &SpiderGpuInfo{})
-			if err := m.Gpu[len(m.Gpu)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyValueList", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCbtumblebug
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if m.Item == nil {
+				m.Item = &TbSpecInfo{}
 			}
-			m.KeyValueList = append(m.KeyValueList, &KeyValue{})
-			if err := m.KeyValueList[len(m.KeyValueList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -34865,7 +38190,7 @@ func (m *SpiderSpecInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SpiderVCpuInfo) Unmarshal(dAtA []byte) error {
+func (m *ListTbSpecInfoResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -34888,17 +38213,17 @@ func (m *SpiderVCpuInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SpiderVCpuInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListTbSpecInfoResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SpiderVCpuInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListTbSpecInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -34908,55 +38233,25 @@ func (m *SpiderVCpuInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Count = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Clock", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCbtumblebug
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Items = append(m.Items, &TbSpecInfo{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Clock = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -34980,7 +38275,7 @@ func (m *SpiderVCpuInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SpiderGpuInfo) Unmarshal(dAtA []byte) error {
+func (m *TbSpecInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -35003,15 +38298,15 @@ func (m *SpiderGpuInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SpiderGpuInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: TbSpecInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SpiderGpuInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TbSpecInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -35039,11 +38334,11 @@ func (m *SpiderGpuInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Count = string(dAtA[iNdEx:postIndex])
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mfr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -35071,11 +38366,11 @@ func (m *SpiderGpuInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Mfr = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Model", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -35103,11 +38398,11 @@ func (m *SpiderGpuInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Model = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mem", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -35135,62 +38430,11 @@ func (m *SpiderGpuInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Mem = string(dAtA[iNdEx:postIndex])
+			m.ConnectionName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *LookupSpecListQryRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCbtumblebug
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: LookupSpecListQryRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LookupSpecListQryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CspSpecName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -35218,62 +38462,11 @@ func (m *LookupSpecListQryRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConnectionName = string(dAtA[iNdEx:postIndex])
+			m.CspSpecName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *LookupSpecQryRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCbtumblebug
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: LookupSpecQryRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LookupSpecQryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OsType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -35301,13 +38494,13 @@ func (m *LookupSpecQryRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConnectionName = string(dAtA[iNdEx:postIndex])
+			m.OsType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CspSpecName", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumVcpu", wireType)
 			}
-			var stringLen uint64
+			m.NumVcpu = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -35317,14 +38510,5596 @@ func (m *LookupSpecQryRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.NumVcpu |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthCbtumblebug
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumCore", wireType)
+			}
+			m.NumCore = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumCore |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemGib", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.MemGib = float32(math.Float32frombits(v))
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageGib", wireType)
+			}
+			m.StorageGib = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StorageGib |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CostPerHour", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.CostPerHour = float32(math.Float32frombits(v))
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumStorage", wireType)
+			}
+			m.NumStorage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumStorage |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxNumStorage", wireType)
+			}
+			m.MaxNumStorage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxNumStorage |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTotalStorageTib", wireType)
+			}
+			m.MaxTotalStorageTib = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTotalStorageTib |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetBwGbps", wireType)
+			}
+			m.NetBwGbps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NetBwGbps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EbsBwMbps", wireType)
+			}
+			m.EbsBwMbps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EbsBwMbps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GpuModel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GpuModel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumGpu", wireType)
+			}
+			m.NumGpu = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumGpu |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GpumemGib", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.GpumemGib = float32(math.Float32frombits(v))
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GpuP2P", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GpuP2P = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderInFilteredResult", wireType)
+			}
+			m.OrderInFilteredResult = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderInFilteredResult |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvaluationStatus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvaluationStatus = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 31:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvaluationScore_01", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.EvaluationScore_01 = float32(math.Float32frombits(v))
+		case 32:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvaluationScore_02", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.EvaluationScore_02 = float32(math.Float32frombits(v))
+		case 33:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvaluationScore_03", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.EvaluationScore_03 = float32(math.Float32frombits(v))
+		case 34:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvaluationScore_04", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.EvaluationScore_04 = float32(math.Float32frombits(v))
+		case 35:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvaluationScore_05", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.EvaluationScore_05 = float32(math.Float32frombits(v))
+		case 36:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvaluationScore_06", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.EvaluationScore_06 = float32(math.Float32frombits(v))
+		case 37:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvaluationScore_07", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.EvaluationScore_07 = float32(math.Float32frombits(v))
+		case 38:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvaluationScore_08", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.EvaluationScore_08 = float32(math.Float32frombits(v))
+		case 39:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvaluationScore_09", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.EvaluationScore_09 = float32(math.Float32frombits(v))
+		case 40:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvaluationScore_10", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.EvaluationScore_10 = float32(math.Float32frombits(v))
+		case 41:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssociatedObjectList", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssociatedObjectList = append(m.AssociatedObjectList, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 42:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsAutoGenerated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsAutoGenerated = bool(v != 0)
+		case 43:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvaluationBreakdown", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvaluationBreakdown = append(m.EvaluationBreakdown, &SpecEvaluationScore{})
+			if err := m.EvaluationBreakdown[len(m.EvaluationBreakdown)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 44:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deprecated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Deprecated = bool(v != 0)
+		case 45:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvaluationTotalScore", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.EvaluationTotalScore = float32(math.Float32frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SpecEvaluationScore) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCbtumblebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpecEvaluationScore: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpecEvaluationScore: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metric", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metric = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Value = float32(math.Float32frombits(v))
+		case 3:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Score = float32(math.Float32frombits(v))
+		case 4:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Weight = float32(math.Float32frombits(v))
+		case 5:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightedScore", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.WeightedScore = float32(math.Float32frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TbSpecCreateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCbtumblebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TbSpecCreateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TbSpecCreateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NsId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NsId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Item == nil {
+				m.Item = &TbSpecReq{}
+			}
+			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TbSpecReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCbtumblebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TbSpecReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TbSpecReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CspSpecName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CspSpecName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FetchSpecQryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCbtumblebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FetchSpecQryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FetchSpecQryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NsId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NsId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SpiderSpecInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCbtumblebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpiderSpecInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpiderSpecInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Item == nil {
+				m.Item = &SpiderSpecInfo{}
+			}
+			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListSpiderSpecInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCbtumblebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListSpiderSpecInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListSpiderSpecInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, &SpiderSpecInfo{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SpiderSpecInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCbtumblebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpiderSpecInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpiderSpecInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Region = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VCpu", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VCpu == nil {
+				m.VCpu = &SpiderVCpuInfo{}
+			}
+			if err := m.VCpu.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mem", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mem = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gpu", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Gpu = append(m.Gpu, &SpiderGpuInfo{})
+			if err := m.Gpu[len(m.Gpu)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyValueList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyValueList = append(m.KeyValueList, &KeyValue{})
+			if err := m.KeyValueList[len(m.KeyValueList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SpiderVCpuInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCbtumblebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpiderVCpuInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpiderVCpuInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Count = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Clock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Clock = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SpiderGpuInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCbtumblebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpiderGpuInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpiderGpuInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Count = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mfr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mfr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Model", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Model = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mem", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mem = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LookupSpecListQryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCbtumblebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LookupSpecListQryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LookupSpecListQryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LookupSpecQryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCbtumblebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LookupSpecQryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LookupSpecQryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CspSpecName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CspSpecName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FilterSpecsByRangeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCbtumblebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FilterSpecsByRangeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FilterSpecsByRangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NsId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NsId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Filter == nil {
+				m.Filter = &SpecRangeFilter{}
+			}
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SpecSearchRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCbtumblebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpecSearchRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpecSearchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NsId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NsId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Item == nil {
+				m.Item = &SpecSearchReq{}
+			}
+			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SpecSearchReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCbtumblebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpecSearchReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpecSearchReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Condition", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Condition = append(m.Condition, &SpecSearchCondition{})
+			if err := m.Condition[len(m.Condition)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderBy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderBy = append(m.OrderBy, &SpecSearchOrder{})
+			if err := m.OrderBy[len(m.OrderBy)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cursor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cursor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SpecSearchCondition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCbtumblebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpecSearchCondition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpecSearchCondition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Field = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SpecSearchOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCbtumblebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpecSearchOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpecSearchOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Field = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Direction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SpecSearchResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCbtumblebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpecSearchResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpecSearchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spec = append(m.Spec, &TbSpecInfo{})
+			if err := m.Spec[len(m.Spec)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalCount", wireType)
+			}
+			m.TotalCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextCursor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextCursor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SpecRangeFilter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCbtumblebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpecRangeFilter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpecRangeFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CspSpecName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CspSpecName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OsType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OsType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumVCPU", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NumVCPU == nil {
+				m.NumVCPU = &Range{}
+			}
+			if err := m.NumVCPU.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumCore", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NumCore == nil {
+				m.NumCore = &Range{}
+			}
+			if err := m.NumCore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mem_GiB", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Mem_GiB == nil {
+				m.Mem_GiB = &Range{}
+			}
+			if err := m.Mem_GiB.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Storage_GiB", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Storage_GiB == nil {
+				m.Storage_GiB = &Range{}
+			}
+			if err := m.Storage_GiB.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CostPerHour", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CostPerHour == nil {
+				m.CostPerHour = &Range{}
+			}
+			if err := m.CostPerHour.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumStorage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NumStorage == nil {
+				m.NumStorage = &Range{}
+			}
+			if err := m.NumStorage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxNumStorage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MaxNumStorage == nil {
+				m.MaxNumStorage = &Range{}
+			}
+			if err := m.MaxNumStorage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTotalStorage_TiB", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MaxTotalStorage_TiB == nil {
+				m.MaxTotalStorage_TiB = &Range{}
+			}
+			if err := m.MaxTotalStorage_TiB.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetBw_Gbps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NetBw_Gbps == nil {
+				m.NetBw_Gbps = &Range{}
+			}
+			if err := m.NetBw_Gbps.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EbsBw_Mbps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EbsBw_Mbps == nil {
+				m.EbsBw_Mbps = &Range{}
+			}
+			if err := m.EbsBw_Mbps.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GpuModel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GpuModel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumGpu", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NumGpu == nil {
+				m.NumGpu = &Range{}
+			}
+			if err := m.NumGpu.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gpumem_GiB", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Gpumem_GiB == nil {
+				m.Gpumem_GiB = &Range{}
+			}
+			if err := m.Gpumem_GiB.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GpuP2P", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GpuP2P = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvaluationStatus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvaluationStatus = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvaluationScore_01", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EvaluationScore_01 == nil {
+				m.EvaluationScore_01 = &Range{}
+			}
+			if err := m.EvaluationScore_01.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 32:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvaluationScore_02", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EvaluationScore_02 == nil {
+				m.EvaluationScore_02 = &Range{}
+			}
+			if err := m.EvaluationScore_02.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 33:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvaluationScore_03", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EvaluationScore_03 == nil {
+				m.EvaluationScore_03 = &Range{}
+			}
+			if err := m.EvaluationScore_03.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 34:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvaluationScore_04", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EvaluationScore_04 == nil {
+				m.EvaluationScore_04 = &Range{}
+			}
+			if err := m.EvaluationScore_04.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 35:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvaluationScore_05", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EvaluationScore_05 == nil {
+				m.EvaluationScore_05 = &Range{}
+			}
+			if err := m.EvaluationScore_05.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 36:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvaluationScore_06", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EvaluationScore_06 == nil {
+				m.EvaluationScore_06 = &Range{}
+			}
+			if err := m.EvaluationScore_06.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 37:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvaluationScore_07", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EvaluationScore_07 == nil {
+				m.EvaluationScore_07 = &Range{}
+			}
+			if err := m.EvaluationScore_07.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 38:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvaluationScore_08", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EvaluationScore_08 == nil {
+				m.EvaluationScore_08 = &Range{}
+			}
+			if err := m.EvaluationScore_08.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 39:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvaluationScore_09", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EvaluationScore_09 == nil {
+				m.EvaluationScore_09 = &Range{}
+			}
+			if err := m.EvaluationScore_09.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 40:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvaluationScore_10", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EvaluationScore_10 == nil {
+				m.EvaluationScore_10 = &Range{}
+			}
+			if err := m.EvaluationScore_10.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Range) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCbtumblebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Range: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Range: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Min", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Min = float32(math.Float32frombits(v))
+		case 2:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Max = float32(math.Float32frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SortSpecsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCbtumblebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SortSpecsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SortSpecsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, &TbSpecInfo{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Direction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TbSecurityGroupInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCbtumblebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TbSecurityGroupInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TbSecurityGroupInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Item == nil {
+				m.Item = &TbSecurityGroupInfo{}
+			}
+			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListTbSecurityGroupInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCbtumblebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListTbSecurityGroupInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListTbSecurityGroupInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, &TbSecurityGroupInfo{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TbSecurityGroupInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCbtumblebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TbSecurityGroupInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TbSecurityGroupInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VnetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VnetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirewallRules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FirewallRules = append(m.FirewallRules, &SpiderSecurityRuleInfo{})
+			if err := m.FirewallRules[len(m.FirewallRules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CspSecurityGroupId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CspSecurityGroupId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CspSecurityGroupName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CspSecurityGroupName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyValueList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyValueList = append(m.KeyValueList, &KeyValue{})
+			if err := m.KeyValueList[len(m.KeyValueList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssociatedObjectList", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssociatedObjectList = append(m.AssociatedObjectList, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsAutoGenerated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsAutoGenerated = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SpiderSecurityRuleInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCbtumblebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpiderSecurityRuleInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpiderSecurityRuleInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromPort", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromPort = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToPort", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToPort = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IpProtocol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IpProtocol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Direction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cidr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cidr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TbSecurityGroupCreateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCbtumblebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TbSecurityGroupCreateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TbSecurityGroupCreateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NsId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NsId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Item == nil {
+				m.Item = &TbSecurityGroupReq{}
+			}
+			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TbSecurityGroupReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCbtumblebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TbSecurityGroupReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TbSecurityGroupReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VnetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VnetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirewallRules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FirewallRules = append(m.FirewallRules, &SpiderSecurityRuleInfo{})
+			if err := m.FirewallRules[len(m.FirewallRules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TbVNetInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCbtumblebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TbVNetInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TbVNetInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Item == nil {
+				m.Item = &TbVNetInfo{}
+			}
+			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListTbVNetInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCbtumblebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListTbVNetInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListTbVNetInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, &TbVNetInfo{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TbVNetInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCbtumblebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TbVNetInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TbVNetInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
 			}
 			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
@@ -35333,8 +44108,352 @@ func (m *LookupSpecQryRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CspSpecName = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CidrBlock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CidrBlock = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubnetInfoList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubnetInfoList = append(m.SubnetInfoList, &SpiderSubnetInfo{})
+			if err := m.SubnetInfoList[len(m.SubnetInfoList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CspVnetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CspVnetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CspVnetName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CspVnetName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyValueList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyValueList = append(m.KeyValueList, &KeyValue{})
+			if err := m.KeyValueList[len(m.KeyValueList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssociatedObjectList", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssociatedObjectList = append(m.AssociatedObjectList, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsAutoGenerated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsAutoGenerated = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
@@ -35357,7 +44476,7 @@ func (m *LookupSpecQryRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FilterSpecsByRangeRequest) Unmarshal(dAtA []byte) error {
+func (m *SpiderSubnetInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -35380,15 +44499,51 @@ func (m *FilterSpecsByRangeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FilterSpecsByRangeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SpiderSubnetInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FilterSpecsByRangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SpiderSubnetInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NsId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Iid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Iid == nil {
+				m.Iid = &IID{}
+			}
+			if err := m.Iid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ipv4Cidr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -35416,11 +44571,11 @@ func (m *FilterSpecsByRangeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NsId = string(dAtA[iNdEx:postIndex])
+			m.Ipv4Cidr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyValueList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -35447,10 +44602,8 @@ func (m *FilterSpecsByRangeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Filter == nil {
-				m.Filter = &SpecRangeFilter{}
-			}
-			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.KeyValueList = append(m.KeyValueList, &KeyValue{})
+			if err := m.KeyValueList[len(m.KeyValueList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -35476,7 +44629,7 @@ func (m *FilterSpecsByRangeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SpecRangeFilter) Unmarshal(dAtA []byte) error {
+func (m *TbVNetCreateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -35499,15 +44652,15 @@ func (m *SpecRangeFilter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SpecRangeFilter: wiretype end group for non-group")
+			return fmt.Errorf("proto: TbVNetCreateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SpecRangeFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TbVNetCreateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NsId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -35535,13 +44688,13 @@ func (m *SpecRangeFilter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.NsId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -35551,27 +44704,82 @@ func (m *SpecRangeFilter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.Item == nil {
+				m.Item = &TbVNetReq{}
+			}
+			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TbVNetReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCbtumblebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TbVNetReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TbVNetReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -35599,11 +44807,11 @@ func (m *SpecRangeFilter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConnectionName = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CspSpecName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -35631,11 +44839,11 @@ func (m *SpecRangeFilter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CspSpecName = string(dAtA[iNdEx:postIndex])
+			m.ConnectionName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OsType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CidrBlock", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -35663,11 +44871,11 @@ func (m *SpecRangeFilter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OsType = string(dAtA[iNdEx:postIndex])
+			m.CidrBlock = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumVCPU", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubnetInfoList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -35694,18 +44902,16 @@ func (m *SpecRangeFilter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NumVCPU == nil {
-				m.NumVCPU = &Range{}
-			}
-			if err := m.NumVCPU.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SubnetInfoList = append(m.SubnetInfoList, &SpiderSubnetReqInfo{})
+			if err := m.SubnetInfoList[len(m.SubnetInfoList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumCore", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -35715,69 +44921,80 @@ func (m *SpecRangeFilter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NumCore == nil {
-				m.NumCore = &Range{}
-			}
-			if err := m.NumCore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mem_GiB", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCbtumblebug
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthCbtumblebug
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Mem_GiB == nil {
-				m.Mem_GiB = &Range{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SpiderSubnetReqInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCbtumblebug
 			}
-			if err := m.Mem_GiB.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 9:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpiderSubnetReqInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpiderSubnetReqInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Storage_GiB", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -35787,31 +45004,27 @@ func (m *SpecRangeFilter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Storage_GiB == nil {
-				m.Storage_GiB = &Range{}
-			}
-			if err := m.Storage_GiB.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ipv4Cidr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -35839,11 +45052,11 @@ func (m *SpecRangeFilter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Ipv4Cidr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CostPerHour", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyValueList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -35870,16 +45083,65 @@ func (m *SpecRangeFilter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CostPerHour == nil {
-				m.CostPerHour = &Range{}
-			}
-			if err := m.CostPerHour.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.KeyValueList = append(m.KeyValueList, &KeyValue{})
+			if err := m.KeyValueList[len(m.KeyValueList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TbMcisInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCbtumblebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TbMcisInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TbMcisInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumStorage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -35906,16 +45168,67 @@ func (m *SpecRangeFilter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NumStorage == nil {
-				m.NumStorage = &Range{}
+			if m.Item == nil {
+				m.Item = &TbMcisInfo{}
 			}
-			if err := m.NumStorage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListTbMcisInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCbtumblebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListTbMcisInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListTbMcisInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxNumStorage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -35942,18 +45255,67 @@ func (m *SpecRangeFilter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MaxNumStorage == nil {
-				m.MaxNumStorage = &Range{}
-			}
-			if err := m.MaxNumStorage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, &TbMcisInfo{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 14:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TbMcisInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCbtumblebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TbMcisInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TbMcisInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxTotalStorage_TiB", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -35963,33 +45325,29 @@ func (m *SpecRangeFilter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MaxTotalStorage_TiB == nil {
-				m.MaxTotalStorage_TiB = &Range{}
-			}
-			if err := m.MaxTotalStorage_TiB.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 15:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetBw_Gbps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -35999,31 +45357,27 @@ func (m *SpecRangeFilter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NetBw_Gbps == nil {
-				m.NetBw_Gbps = &Range{}
-			}
-			if err := m.NetBw_Gbps.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 16:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EbsBw_Mbps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Vm", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -36048,18 +45402,16 @@ func (m *SpecRangeFilter) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.EbsBw_Mbps == nil {
-				m.EbsBw_Mbps = &Range{}
+				return io.ErrUnexpectedEOF
 			}
-			if err := m.EbsBw_Mbps.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Vm = append(m.Vm, &TbVmInfo{})
+			if err := m.Vm[len(m.Vm)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 17:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GpuModel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PlacementAlgo", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -36087,13 +45439,13 @@ func (m *SpecRangeFilter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GpuModel = string(dAtA[iNdEx:postIndex])
+			m.PlacementAlgo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 18:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumGpu", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -36103,33 +45455,29 @@ func (m *SpecRangeFilter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NumGpu == nil {
-				m.NumGpu = &Range{}
-			}
-			if err := m.NumGpu.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 19:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gpumem_GiB", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -36139,31 +45487,27 @@ func (m *SpecRangeFilter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Gpumem_GiB == nil {
-				m.Gpumem_GiB = &Range{}
-			}
-			if err := m.Gpumem_GiB.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Label = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 20:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GpuP2P", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -36191,11 +45535,11 @@ func (m *SpecRangeFilter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GpuP2P = string(dAtA[iNdEx:postIndex])
+			m.Status = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 21:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EvaluationStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetStatus", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -36223,13 +45567,13 @@ func (m *SpecRangeFilter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EvaluationStatus = string(dAtA[iNdEx:postIndex])
+			m.TargetStatus = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 31:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EvaluationScore_01", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetAction", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -36239,33 +45583,29 @@ func (m *SpecRangeFilter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EvaluationScore_01 == nil {
-				m.EvaluationScore_01 = &Range{}
-			}
-			if err := m.EvaluationScore_01.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TargetAction = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 32:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EvaluationScore_02", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InstallMonAgent", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -36275,33 +45615,80 @@ func (m *SpecRangeFilter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EvaluationScore_02 == nil {
-				m.EvaluationScore_02 = &Range{}
-			}
-			if err := m.EvaluationScore_02.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.InstallMonAgent = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 33:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TbVmInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCbtumblebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TbVmInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TbVmInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EvaluationScore_03", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -36311,33 +45698,29 @@ func (m *SpecRangeFilter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EvaluationScore_03 == nil {
-				m.EvaluationScore_03 = &Range{}
-			}
-			if err := m.EvaluationScore_03.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 34:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EvaluationScore_04", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -36347,33 +45730,29 @@ func (m *SpecRangeFilter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EvaluationScore_04 == nil {
-				m.EvaluationScore_04 = &Range{}
-			}
-			if err := m.EvaluationScore_04.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 35:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EvaluationScore_05", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VmGroupId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -36383,31 +45762,27 @@ func (m *SpecRangeFilter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EvaluationScore_05 == nil {
-				m.EvaluationScore_05 = &Range{}
-			}
-			if err := m.EvaluationScore_05.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.VmGroupId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 36:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EvaluationScore_06", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Location", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -36434,18 +45809,18 @@ func (m *SpecRangeFilter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EvaluationScore_06 == nil {
-				m.EvaluationScore_06 = &Range{}
+			if m.Location == nil {
+				m.Location = &GeoLocation{}
 			}
-			if err := m.EvaluationScore_06.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Location.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 37:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EvaluationScore_07", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -36455,33 +45830,29 @@ func (m *SpecRangeFilter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EvaluationScore_07 == nil {
-				m.EvaluationScore_07 = &Range{}
-			}
-			if err := m.EvaluationScore_07.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Status = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 38:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EvaluationScore_08", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetStatus", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -36491,33 +45862,29 @@ func (m *SpecRangeFilter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EvaluationScore_08 == nil {
-				m.EvaluationScore_08 = &Range{}
-			}
-			if err := m.EvaluationScore_08.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TargetStatus = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 39:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EvaluationScore_09", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetAction", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -36527,33 +45894,29 @@ func (m *SpecRangeFilter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EvaluationScore_09 == nil {
-				m.EvaluationScore_09 = &Range{}
-			}
-			if err := m.EvaluationScore_09.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TargetAction = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 40:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EvaluationScore_10", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MonAgentStatus", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -36563,157 +45926,29 @@ func (m *SpecRangeFilter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EvaluationScore_10 == nil {
-				m.EvaluationScore_10 = &Range{}
-			}
-			if err := m.EvaluationScore_10.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MonAgentStatus = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Range) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCbtumblebug
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Range: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Range: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Min", wireType)
-			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.Min = float32(math.Float32frombits(v))
-		case 2:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
-			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.Max = float32(math.Float32frombits(v))
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SortSpecsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCbtumblebug
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SortSpecsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SortSpecsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SystemMessage", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -36723,29 +45958,27 @@ func (m *SortSpecsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, &TbSpecInfo{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SystemMessage = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedTime", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -36773,11 +46006,11 @@ func (m *SortSpecsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OrderBy = string(dAtA[iNdEx:postIndex])
+			m.CreatedTime = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -36805,64 +46038,13 @@ func (m *SortSpecsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Direction = string(dAtA[iNdEx:postIndex])
+			m.Label = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TbSecurityGroupInfoResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCbtumblebug
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TbSecurityGroupInfoResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TbSecurityGroupInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -36872,82 +46054,27 @@ func (m *TbSecurityGroupInfoResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Item == nil {
-				m.Item = &TbSecurityGroupInfo{}
-			}
-			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ListTbSecurityGroupInfoResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCbtumblebug
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ListTbSecurityGroupInfoResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListTbSecurityGroupInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -36974,65 +46101,16 @@ func (m *ListTbSecurityGroupInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, &TbSecurityGroupInfo{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Region == nil {
+				m.Region = &RegionInfo{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Region.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TbSecurityGroupInfo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCbtumblebug
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TbSecurityGroupInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TbSecurityGroupInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicIp", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -37060,11 +46138,11 @@ func (m *TbSecurityGroupInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.PublicIp = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SshPort", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -37092,11 +46170,11 @@ func (m *TbSecurityGroupInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.SshPort = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicDns", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -37124,11 +46202,11 @@ func (m *TbSecurityGroupInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConnectionName = string(dAtA[iNdEx:postIndex])
+			m.PublicDns = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VnetId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PrivateIp", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -37156,11 +46234,11 @@ func (m *TbSecurityGroupInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VnetId = string(dAtA[iNdEx:postIndex])
+			m.PrivateIp = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PrivateDns", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -37188,13 +46266,13 @@ func (m *TbSecurityGroupInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.PrivateDns = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FirewallRules", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VmBootDisk", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -37204,29 +46282,27 @@ func (m *TbSecurityGroupInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FirewallRules = append(m.FirewallRules, &SpiderSecurityRuleInfo{})
-			if err := m.FirewallRules[len(m.FirewallRules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.VmBootDisk = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CspSecurityGroupId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VmBlockDisk", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -37254,11 +46330,11 @@ func (m *TbSecurityGroupInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CspSecurityGroupId = string(dAtA[iNdEx:postIndex])
+			m.VmBlockDisk = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CspSecurityGroupName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -37286,13 +46362,13 @@ func (m *TbSecurityGroupInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CspSecurityGroupName = string(dAtA[iNdEx:postIndex])
+			m.ConnectionName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyValueList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -37302,29 +46378,27 @@ func (m *TbSecurityGroupInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KeyValueList = append(m.KeyValueList, &KeyValue{})
-			if err := m.KeyValueList[len(m.KeyValueList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SpecId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 23:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssociatedObjectList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ImageId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -37352,82 +46426,11 @@ func (m *TbSecurityGroupInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AssociatedObjectList = append(m.AssociatedObjectList, string(dAtA[iNdEx:postIndex]))
+			m.ImageId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsAutoGenerated", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCbtumblebug
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IsAutoGenerated = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SpiderSecurityRuleInfo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCbtumblebug
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SpiderSecurityRuleInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SpiderSecurityRuleInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 24:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromPort", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VnetId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -37455,11 +46458,11 @@ func (m *SpiderSecurityRuleInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FromPort = string(dAtA[iNdEx:postIndex])
+			m.VnetId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 25:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToPort", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubnetId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -37487,11 +46490,11 @@ func (m *SpiderSecurityRuleInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ToPort = string(dAtA[iNdEx:postIndex])
+			m.SubnetId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 26:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IpProtocol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecurityGroupIds", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -37519,11 +46522,11 @@ func (m *SpiderSecurityRuleInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IpProtocol = string(dAtA[iNdEx:postIndex])
+			m.SecurityGroupIds = append(m.SecurityGroupIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
+		case 27:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SshKeyId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -37551,11 +46554,11 @@ func (m *SpiderSecurityRuleInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Direction = string(dAtA[iNdEx:postIndex])
+			m.SshKeyId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 28:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cidr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VmUserAccount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -37583,62 +46586,11 @@ func (m *SpiderSecurityRuleInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Cidr = string(dAtA[iNdEx:postIndex])
+			m.VmUserAccount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TbSecurityGroupCreateRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCbtumblebug
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TbSecurityGroupCreateRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TbSecurityGroupCreateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 29:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NsId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VmUserPassword", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -37666,11 +46618,11 @@ func (m *TbSecurityGroupCreateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NsId = string(dAtA[iNdEx:postIndex])
+			m.VmUserPassword = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 30:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CspViewVmDetail", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -37697,67 +46649,16 @@ func (m *TbSecurityGroupCreateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Item == nil {
-				m.Item = &TbSecurityGroupReq{}
+			if m.CspViewVmDetail == nil {
+				m.CspViewVmDetail = &SpiderVMInfo{}
 			}
-			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CspViewVmDetail.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TbSecurityGroupReq) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCbtumblebug
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TbSecurityGroupReq: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TbSecurityGroupReq: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 31:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UserData", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -37785,13 +46686,13 @@ func (m *TbSecurityGroupReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.UserData = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 32:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PostCommand", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -37801,27 +46702,31 @@ func (m *TbSecurityGroupReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConnectionName = string(dAtA[iNdEx:postIndex])
+			if m.PostCommand == nil {
+				m.PostCommand = &McisCmdReq{}
+			}
+			if err := m.PostCommand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 33:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VnetId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UserDataEncoding", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -37849,11 +46754,62 @@ func (m *TbSecurityGroupReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VnetId = string(dAtA[iNdEx:postIndex])
+			m.UserDataEncoding = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GeoLocation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCbtumblebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GeoLocation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GeoLocation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Latitude", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -37881,13 +46837,13 @@ func (m *TbSecurityGroupReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Latitude = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FirewallRules", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Longitude", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -37897,82 +46853,61 @@ func (m *TbSecurityGroupReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FirewallRules = append(m.FirewallRules, &SpiderSecurityRuleInfo{})
-			if err := m.FirewallRules[len(m.FirewallRules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Longitude = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BriefAddr", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthCbtumblebug
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TbVNetInfoResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCbtumblebug
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TbVNetInfoResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TbVNetInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.BriefAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CloudType", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -37982,27 +46917,55 @@ func (m *TbVNetInfoResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Item == nil {
-				m.Item = &TbVNetInfo{}
+			m.CloudType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeRegion", wireType)
 			}
-			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
 			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NativeRegion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -38026,7 +46989,7 @@ func (m *TbVNetInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListTbVNetInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *RegionInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -38049,17 +47012,17 @@ func (m *ListTbVNetInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListTbVNetInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RegionInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListTbVNetInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RegionInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -38069,25 +47032,55 @@ func (m *ListTbVNetInfoResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, &TbVNetInfo{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Region = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Zone", wireType)
 			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Zone = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -38111,7 +47104,7 @@ func (m *ListTbVNetInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TbVNetInfo) Unmarshal(dAtA []byte) error {
+func (m *SpiderVMInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -38134,15 +47127,15 @@ func (m *TbVNetInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TbVNetInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: SpiderVMInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TbVNetInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SpiderVMInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -38170,11 +47163,11 @@ func (m *TbVNetInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ImageName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -38202,11 +47195,11 @@ func (m *TbVNetInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.ImageName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VpcName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -38234,11 +47227,11 @@ func (m *TbVNetInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConnectionName = string(dAtA[iNdEx:postIndex])
+			m.VpcName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CidrBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubnetName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -38266,13 +47259,13 @@ func (m *TbVNetInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CidrBlock = string(dAtA[iNdEx:postIndex])
+			m.SubnetName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubnetInfoList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecurityGroupNames", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -38282,29 +47275,27 @@ func (m *TbVNetInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SubnetInfoList = append(m.SubnetInfoList, &SpiderSubnetInfo{})
-			if err := m.SubnetInfoList[len(m.SubnetInfoList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SecurityGroupNames = append(m.SecurityGroupNames, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyPairName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -38332,11 +47323,11 @@ func (m *TbVNetInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.KeyPairName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CspVnetId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VmSpecName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -38364,11 +47355,11 @@ func (m *TbVNetInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CspVnetId = string(dAtA[iNdEx:postIndex])
+			m.VmSpecName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CspVnetName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VmUserId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -38396,11 +47387,11 @@ func (m *TbVNetInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CspVnetName = string(dAtA[iNdEx:postIndex])
+			m.VmUserId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VmUserPasswd", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -38428,11 +47419,11 @@ func (m *TbVNetInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = string(dAtA[iNdEx:postIndex])
+			m.VmUserPasswd = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyValueList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Iid", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -38459,16 +47450,18 @@ func (m *TbVNetInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KeyValueList = append(m.KeyValueList, &KeyValue{})
-			if err := m.KeyValueList[len(m.KeyValueList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Iid == nil {
+				m.Iid = &IID{}
+			}
+			if err := m.Iid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssociatedObjectList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ImageIid", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -38478,29 +47471,33 @@ func (m *TbVNetInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AssociatedObjectList = append(m.AssociatedObjectList, string(dAtA[iNdEx:postIndex]))
+			if m.ImageIid == nil {
+				m.ImageIid = &IID{}
+			}
+			if err := m.ImageIid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsAutoGenerated", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VpcIid", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -38510,66 +47507,31 @@ func (m *TbVNetInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsAutoGenerated = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthCbtumblebug
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SpiderSubnetInfo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCbtumblebug
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.VpcIid == nil {
+				m.VpcIid = &IID{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.VpcIid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SpiderSubnetInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SpiderSubnetInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Iid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubnetIid", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -38596,18 +47558,18 @@ func (m *SpiderSubnetInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Iid == nil {
-				m.Iid = &IID{}
+			if m.SubnetIid == nil {
+				m.SubnetIid = &IID{}
 			}
-			if err := m.Iid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SubnetIid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ipv4Cidr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecurityGroupIids", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -38617,27 +47579,29 @@ func (m *SpiderSubnetInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ipv4Cidr = string(dAtA[iNdEx:postIndex])
+			m.SecurityGroupIids = append(m.SecurityGroupIids, &IID{})
+			if err := m.SecurityGroupIids[len(m.SecurityGroupIids)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyValueList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyPairIid", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -38664,65 +47628,16 @@ func (m *SpiderSubnetInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KeyValueList = append(m.KeyValueList, &KeyValue{})
-			if err := m.KeyValueList[len(m.KeyValueList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.KeyPairIid == nil {
+				m.KeyPairIid = &IID{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.KeyPairIid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TbVNetCreateRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCbtumblebug
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TbVNetCreateRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TbVNetCreateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NsId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -38750,11 +47665,11 @@ func (m *TbVNetCreateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NsId = string(dAtA[iNdEx:postIndex])
+			m.StartTime = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -38781,67 +47696,16 @@ func (m *TbVNetCreateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Item == nil {
-				m.Item = &TbVNetReq{}
+			if m.Region == nil {
+				m.Region = &RegionInfo{}
 			}
-			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Region.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TbVNetReq) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCbtumblebug
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TbVNetReq: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TbVNetReq: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkInterface", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -38869,11 +47733,11 @@ func (m *TbVNetReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.NetworkInterface = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicIp", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -38901,11 +47765,11 @@ func (m *TbVNetReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConnectionName = string(dAtA[iNdEx:postIndex])
+			m.PublicIp = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CidrBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicDns", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -38933,13 +47797,13 @@ func (m *TbVNetReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CidrBlock = string(dAtA[iNdEx:postIndex])
+			m.PublicDns = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubnetInfoList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PrivateIp", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -38949,29 +47813,27 @@ func (m *TbVNetReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SubnetInfoList = append(m.SubnetInfoList, &SpiderSubnetReqInfo{})
-			if err := m.SubnetInfoList[len(m.SubnetInfoList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PrivateIp = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PrivateDns", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -38999,62 +47861,11 @@ func (m *TbVNetReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.PrivateDns = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SpiderSubnetReqInfo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCbtumblebug
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SpiderSubnetReqInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SpiderSubnetReqInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 23:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VmBootDisk", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -39082,11 +47893,11 @@ func (m *SpiderSubnetReqInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.VmBootDisk = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 24:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ipv4Cidr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VmBlockDisk", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -39114,13 +47925,13 @@ func (m *SpiderSubnetReqInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ipv4Cidr = string(dAtA[iNdEx:postIndex])
+			m.VmBlockDisk = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 25:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyValueList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SshAccessPoint", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -39130,80 +47941,27 @@ func (m *SpiderSubnetReqInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KeyValueList = append(m.KeyValueList, &KeyValue{})
-			if err := m.KeyValueList[len(m.KeyValueList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SshAccessPoint = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TbMcisInfoResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCbtumblebug
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TbMcisInfoResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TbMcisInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 26:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyValueList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -39230,69 +47988,16 @@ func (m *TbMcisInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Item == nil {
-				m.Item = &TbMcisInfo{}
-			}
-			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.KeyValueList = append(m.KeyValueList, &KeyValue{})
+			if err := m.KeyValueList[len(m.KeyValueList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ListTbMcisInfoResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCbtumblebug
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ListTbMcisInfoResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListTbMcisInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 27:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UserData", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -39302,25 +48007,23 @@ func (m *ListTbMcisInfoResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, &TbMcisInfo{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.UserData = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -39344,7 +48047,7 @@ func (m *ListTbMcisInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TbMcisInfo) Unmarshal(dAtA []byte) error {
+func (m *TbMcisCreateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -39367,47 +48070,15 @@ func (m *TbMcisInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TbMcisInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: TbMcisCreateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TbMcisInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TbMcisCreateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCbtumblebug
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NsId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -39435,11 +48106,11 @@ func (m *TbMcisInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.NsId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Vm", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -39466,46 +48137,67 @@ func (m *TbMcisInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Vm = append(m.Vm, &TbVmInfo{})
-			if err := m.Vm[len(m.Vm)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Item == nil {
+				m.Item = &TbMcisReq{}
+			}
+			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PlacementAlgo", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCbtumblebug
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCbtumblebug
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TbMcisReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCbtumblebug
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PlacementAlgo = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TbMcisReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TbMcisReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -39533,11 +48225,11 @@ func (m *TbMcisInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InstallMonAgent", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -39565,11 +48257,11 @@ func (m *TbMcisInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Label = string(dAtA[iNdEx:postIndex])
+			m.InstallMonAgent = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -39597,11 +48289,11 @@ func (m *TbMcisInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = string(dAtA[iNdEx:postIndex])
+			m.Label = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PlacementAlgo", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -39629,11 +48321,11 @@ func (m *TbMcisInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TargetStatus = string(dAtA[iNdEx:postIndex])
+			m.PlacementAlgo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetAction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -39661,13 +48353,13 @@ func (m *TbMcisInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TargetAction = string(dAtA[iNdEx:postIndex])
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InstallMonAgent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Vm", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -39677,23 +48369,25 @@ func (m *TbMcisInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InstallMonAgent = string(dAtA[iNdEx:postIndex])
+			m.Vm = append(m.Vm, &TbVmReq{})
+			if err := m.Vm[len(m.Vm)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -39717,7 +48411,7 @@ func (m *TbMcisInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TbVmInfo) Unmarshal(dAtA []byte) error {
+func (m *TbVmReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -39740,15 +48434,15 @@ func (m *TbVmInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TbVmInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: TbVmReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TbVmInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TbVmReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -39776,11 +48470,11 @@ func (m *TbVmInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VmGroupSize", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -39808,11 +48502,11 @@ func (m *TbVmInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.VmGroupSize = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VmGroupId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -39840,111 +48534,11 @@ func (m *TbVmInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VmGroupId = string(dAtA[iNdEx:postIndex])
+			m.Label = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Location", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCbtumblebug
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Location == nil {
-				m.Location = &GeoLocation{}
-			}
-			if err := m.Location.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCbtumblebug
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Status = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetStatus", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCbtumblebug
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TargetStatus = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetAction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -39972,11 +48566,11 @@ func (m *TbVmInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TargetAction = string(dAtA[iNdEx:postIndex])
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MonAgentStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -40004,11 +48598,11 @@ func (m *TbVmInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MonAgentStatus = string(dAtA[iNdEx:postIndex])
+			m.ConnectionName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SystemMessage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -40036,11 +48630,11 @@ func (m *TbVmInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SystemMessage = string(dAtA[iNdEx:postIndex])
+			m.SpecId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ImageId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -40068,11 +48662,11 @@ func (m *TbVmInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CreatedTime = string(dAtA[iNdEx:postIndex])
+			m.ImageId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VnetId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -40100,11 +48694,11 @@ func (m *TbVmInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Label = string(dAtA[iNdEx:postIndex])
+			m.VnetId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubnetId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -40132,13 +48726,13 @@ func (m *TbVmInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.SubnetId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecurityGroupIds", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -40148,31 +48742,27 @@ func (m *TbVmInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Region == nil {
-				m.Region = &RegionInfo{}
-			}
-			if err := m.Region.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SecurityGroupIds = append(m.SecurityGroupIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 14:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicIp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SshKeyId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -40200,11 +48790,11 @@ func (m *TbVmInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PublicIp = string(dAtA[iNdEx:postIndex])
+			m.SshKeyId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 15:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SshPort", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VmUserAccount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -40232,11 +48822,11 @@ func (m *TbVmInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SshPort = string(dAtA[iNdEx:postIndex])
+			m.VmUserAccount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 16:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicDns", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VmUserPassword", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -40264,11 +48854,11 @@ func (m *TbVmInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PublicDns = string(dAtA[iNdEx:postIndex])
+			m.VmUserPassword = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 17:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrivateIp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UserData", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -40296,11 +48886,11 @@ func (m *TbVmInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PrivateIp = string(dAtA[iNdEx:postIndex])
+			m.UserData = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 18:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrivateDns", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UserDataEncoding", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -40328,13 +48918,64 @@ func (m *TbVmInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PrivateDns = string(dAtA[iNdEx:postIndex])
+			m.UserDataEncoding = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 19:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListTbMcisStatusInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCbtumblebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListTbMcisStatusInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListTbMcisStatusInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VmBootDisk", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -40344,29 +48985,82 @@ func (m *TbVmInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VmBootDisk = string(dAtA[iNdEx:postIndex])
+			m.Items = append(m.Items, &McisStatusInfo{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 20:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TbMcisStatusInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCbtumblebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TbMcisStatusInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TbMcisStatusInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VmBlockDisk", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -40376,27 +49070,82 @@ func (m *TbVmInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VmBlockDisk = string(dAtA[iNdEx:postIndex])
+			if m.Item == nil {
+				m.Item = &McisStatusInfo{}
+			}
+			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 21:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *McisStatusInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCbtumblebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: McisStatusInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: McisStatusInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -40424,11 +49173,11 @@ func (m *TbVmInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConnectionName = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 22:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpecId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -40456,11 +49205,11 @@ func (m *TbVmInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpecId = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 23:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ImageId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -40488,11 +49237,11 @@ func (m *TbVmInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ImageId = string(dAtA[iNdEx:postIndex])
+			m.Status = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 24:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VnetId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetStatus", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -40520,11 +49269,11 @@ func (m *TbVmInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VnetId = string(dAtA[iNdEx:postIndex])
+			m.TargetStatus = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 25:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubnetId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetAction", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -40552,11 +49301,11 @@ func (m *TbVmInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SubnetId = string(dAtA[iNdEx:postIndex])
+			m.TargetAction = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 26:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecurityGroupIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InstallMonAgent", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -40584,11 +49333,11 @@ func (m *TbVmInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SecurityGroupIds = append(m.SecurityGroupIds, string(dAtA[iNdEx:postIndex]))
+			m.InstallMonAgent = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 27:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SshKeyId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MasterVmId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -40616,11 +49365,11 @@ func (m *TbVmInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SshKeyId = string(dAtA[iNdEx:postIndex])
+			m.MasterVmId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 28:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VmUserAccount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MasterIp", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -40648,11 +49397,11 @@ func (m *TbVmInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VmUserAccount = string(dAtA[iNdEx:postIndex])
+			m.MasterIp = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 29:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VmUserPassword", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MasterSshPort", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -40680,11 +49429,11 @@ func (m *TbVmInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VmUserPassword = string(dAtA[iNdEx:postIndex])
+			m.MasterSshPort = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 30:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CspViewVmDetail", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Vm", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -40711,10 +49460,8 @@ func (m *TbVmInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CspViewVmDetail == nil {
-				m.CspViewVmDetail = &SpiderVMInfo{}
-			}
-			if err := m.CspViewVmDetail.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Vm = append(m.Vm, &TbVmStatusInfo{})
+			if err := m.Vm[len(m.Vm)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -40740,7 +49487,7 @@ func (m *TbVmInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GeoLocation) Unmarshal(dAtA []byte) error {
+func (m *TbVmStatusInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -40763,15 +49510,15 @@ func (m *GeoLocation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GeoLocation: wiretype end group for non-group")
+			return fmt.Errorf("proto: TbVmStatusInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GeoLocation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TbVmStatusInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Latitude", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -40799,11 +49546,11 @@ func (m *GeoLocation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Latitude = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Longitude", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -40831,11 +49578,11 @@ func (m *GeoLocation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Longitude = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BriefAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CspVmId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -40863,11 +49610,11 @@ func (m *GeoLocation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BriefAddr = string(dAtA[iNdEx:postIndex])
+			m.CspVmId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CloudType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -40895,11 +49642,11 @@ func (m *GeoLocation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CloudType = string(dAtA[iNdEx:postIndex])
+			m.Status = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NativeRegion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetStatus", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -40927,62 +49674,11 @@ func (m *GeoLocation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NativeRegion = string(dAtA[iNdEx:postIndex])
+			m.TargetStatus = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RegionInfo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCbtumblebug
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RegionInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegionInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetAction", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -41010,11 +49706,11 @@ func (m *RegionInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Region = string(dAtA[iNdEx:postIndex])
+			m.TargetAction = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Zone", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeStatus", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -41042,62 +49738,11 @@ func (m *RegionInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Zone = string(dAtA[iNdEx:postIndex])
+			m.NativeStatus = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SpiderVMInfo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCbtumblebug
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SpiderVMInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SpiderVMInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MonAgentStatus", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -41125,11 +49770,11 @@ func (m *SpiderVMInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.MonAgentStatus = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ImageName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SystemMessage", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -41157,11 +49802,11 @@ func (m *SpiderVMInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ImageName = string(dAtA[iNdEx:postIndex])
+			m.SystemMessage = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VpcName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedTime", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -41189,11 +49834,11 @@ func (m *SpiderVMInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VpcName = string(dAtA[iNdEx:postIndex])
+			m.CreatedTime = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubnetName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicIp", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -41221,11 +49866,11 @@ func (m *SpiderVMInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SubnetName = string(dAtA[iNdEx:postIndex])
+			m.PublicIp = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecurityGroupNames", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PrivateIp", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -41253,11 +49898,11 @@ func (m *SpiderVMInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SecurityGroupNames = append(m.SecurityGroupNames, string(dAtA[iNdEx:postIndex]))
+			m.PrivateIp = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyPairName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SshPort", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -41285,13 +49930,13 @@ func (m *SpiderVMInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KeyPairName = string(dAtA[iNdEx:postIndex])
+			m.SshPort = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VmSpecName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Location", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -41301,59 +49946,82 @@ func (m *SpiderVMInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VmSpecName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VmUserId", wireType)
+			if m.Location == nil {
+				m.Location = &GeoLocation{}
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCbtumblebug
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Location.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthCbtumblebug
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VmUserId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 9:
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TbMcisAllQryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCbtumblebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TbMcisAllQryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TbMcisAllQryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VmUserPasswd", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NsId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -41381,13 +50049,64 @@ func (m *SpiderVMInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VmUserPasswd = string(dAtA[iNdEx:postIndex])
+			m.NsId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TbMcisActionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCbtumblebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TbMcisActionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TbMcisActionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Iid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NsId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -41397,33 +50116,29 @@ func (m *SpiderVMInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Iid == nil {
-				m.Iid = &IID{}
-			}
-			if err := m.Iid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NsId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ImageIid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field McisId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -41433,33 +50148,29 @@ func (m *SpiderVMInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ImageIid == nil {
-				m.ImageIid = &IID{}
-			}
-			if err := m.ImageIid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.McisId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VpcIid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -41469,33 +50180,80 @@ func (m *SpiderVMInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VpcIid == nil {
-				m.VpcIid = &IID{}
-			}
-			if err := m.VpcIid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Action = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 13:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CostReportQryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCbtumblebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CostReportQryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CostReportQryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubnetIid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NsId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -41505,33 +50263,29 @@ func (m *SpiderVMInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SubnetIid == nil {
-				m.SubnetIid = &IID{}
-			}
-			if err := m.SubnetIid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NsId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 14:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecurityGroupIids", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field McisId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -41541,31 +50295,29 @@ func (m *SpiderVMInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SecurityGroupIids = append(m.SecurityGroupIids, &IID{})
-			if err := m.SecurityGroupIids[len(m.SecurityGroupIids)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.McisId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 15:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyPairIid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -41575,31 +50327,27 @@ func (m *SpiderVMInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.KeyPairIid == nil {
-				m.KeyPairIid = &IID{}
-			}
-			if err := m.KeyPairIid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.From = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 16:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -41627,13 +50375,13 @@ func (m *SpiderVMInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StartTime = string(dAtA[iNdEx:postIndex])
+			m.To = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 17:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupBy", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -41643,31 +50391,27 @@ func (m *SpiderVMInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Region == nil {
-				m.Region = &RegionInfo{}
-			}
-			if err := m.Region.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.GroupBy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 18:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetworkInterface", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -41695,13 +50439,64 @@ func (m *SpiderVMInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NetworkInterface = string(dAtA[iNdEx:postIndex])
+			m.Format = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 19:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CostReportResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCbtumblebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CostReportResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CostReportResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicIp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -41711,27 +50506,31 @@ func (m *SpiderVMInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PublicIp = string(dAtA[iNdEx:postIndex])
+			if m.Item == nil {
+				m.Item = &TbCostReport{}
+			}
+			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 20:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicDns", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Csv", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -41759,11 +50558,62 @@ func (m *SpiderVMInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PublicDns = string(dAtA[iNdEx:postIndex])
+			m.Csv = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 21:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TbCostReport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCbtumblebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TbCostReport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TbCostReport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrivateIp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NsId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -41791,11 +50641,11 @@ func (m *SpiderVMInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PrivateIp = string(dAtA[iNdEx:postIndex])
+			m.NsId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 22:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrivateDns", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field McisId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -41823,11 +50673,11 @@ func (m *SpiderVMInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PrivateDns = string(dAtA[iNdEx:postIndex])
+			m.McisId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 23:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VmBootDisk", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -41855,11 +50705,11 @@ func (m *SpiderVMInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VmBootDisk = string(dAtA[iNdEx:postIndex])
+			m.From = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 24:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VmBlockDisk", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -41887,11 +50737,11 @@ func (m *SpiderVMInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VmBlockDisk = string(dAtA[iNdEx:postIndex])
+			m.To = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 25:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SshAccessPoint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupBy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -41919,11 +50769,11 @@ func (m *SpiderVMInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SshAccessPoint = string(dAtA[iNdEx:postIndex])
+			m.GroupBy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 26:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyValueList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -41950,11 +50800,44 @@ func (m *SpiderVMInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KeyValueList = append(m.KeyValueList, &KeyValue{})
-			if err := m.KeyValueList[len(m.KeyValueList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Item = append(m.Item, &TbCostReportItem{})
+			if err := m.Item[len(m.Item)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalCost", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.TotalCost = float64(math.Float64frombits(v))
+		case 8:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalCurrentCostPerHour", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.TotalCurrentCostPerHour = float64(math.Float64frombits(v))
+		case 9:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalProjectedMonthlyCost", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.TotalProjectedMonthlyCost = float64(math.Float64frombits(v))
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
@@ -41977,7 +50860,7 @@ func (m *SpiderVMInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TbMcisCreateRequest) Unmarshal(dAtA []byte) error {
+func (m *TbCostReportItem) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -42000,15 +50883,15 @@ func (m *TbMcisCreateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TbMcisCreateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TbCostReportItem: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TbMcisCreateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TbCostReportItem: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NsId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -42036,13 +50919,13 @@ func (m *TbMcisCreateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NsId = string(dAtA[iNdEx:postIndex])
+			m.Group = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VmNum", wireType)
 			}
-			var msglen int
+			m.VmNum = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -42052,28 +50935,74 @@ func (m *TbMcisCreateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.VmNum |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthCbtumblebug
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunningVmNum", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthCbtumblebug
+			m.RunningVmNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RunningVmNum |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunningHours", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Item == nil {
-				m.Item = &TbMcisReq{}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.RunningHours = float64(math.Float64frombits(v))
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cost", wireType)
 			}
-			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Cost = float64(math.Float64frombits(v))
+		case 6:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentCostPerHour", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.CurrentCostPerHour = float64(math.Float64frombits(v))
+		case 7:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectedMonthlyCost", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.ProjectedMonthlyCost = float64(math.Float64frombits(v))
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
@@ -42096,7 +51025,7 @@ func (m *TbMcisCreateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TbMcisReq) Unmarshal(dAtA []byte) error {
+func (m *TbMcisQryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -42119,15 +51048,15 @@ func (m *TbMcisReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TbMcisReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: TbMcisQryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TbMcisReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TbMcisQryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NsId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -42155,11 +51084,11 @@ func (m *TbMcisReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.NsId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InstallMonAgent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field McisId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -42187,13 +51116,64 @@ func (m *TbMcisReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InstallMonAgent = string(dAtA[iNdEx:postIndex])
+			m.McisId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TbVmInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCbtumblebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TbVmInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TbVmInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -42203,27 +51183,82 @@ func (m *TbMcisReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Label = string(dAtA[iNdEx:postIndex])
+			if m.Item == nil {
+				m.Item = &TbVmInfo{}
+			}
+			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TbVmCreateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCbtumblebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TbVmCreateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TbVmCreateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PlacementAlgo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NsId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -42251,11 +51286,11 @@ func (m *TbMcisReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PlacementAlgo = string(dAtA[iNdEx:postIndex])
+			m.NsId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field McisId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -42283,11 +51318,11 @@ func (m *TbMcisReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.McisId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Vm", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -42314,8 +51349,10 @@ func (m *TbMcisReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Vm = append(m.Vm, &TbVmReq{})
-			if err := m.Vm[len(m.Vm)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Item == nil {
+				m.Item = &TbVmInfo{}
+			}
+			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -42341,7 +51378,7 @@ func (m *TbMcisReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TbVmReq) Unmarshal(dAtA []byte) error {
+func (m *TbVmGroupCreateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -42364,15 +51401,15 @@ func (m *TbVmReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TbVmReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: TbVmGroupCreateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TbVmReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TbVmGroupCreateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NsId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -42400,11 +51437,11 @@ func (m *TbVmReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.NsId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VmGroupSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field McisId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -42432,13 +51469,13 @@ func (m *TbVmReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VmGroupSize = string(dAtA[iNdEx:postIndex])
+			m.McisId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -42448,61 +51485,84 @@ func (m *TbVmReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Label = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			if m.Item == nil {
+				m.Item = &TbVmReq{}
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCbtumblebug
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthCbtumblebug
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TbVmStatusInfoesponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCbtumblebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TbVmStatusInfoesponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TbVmStatusInfoesponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -42512,59 +51572,82 @@ func (m *TbVmReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConnectionName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpecId", wireType)
+			if m.Item == nil {
+				m.Item = &TbVmStatusInfo{}
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCbtumblebug
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthCbtumblebug
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpecId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TbVmQryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCbtumblebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TbVmQryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TbVmQryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ImageId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NsId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -42592,11 +51675,11 @@ func (m *TbVmReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ImageId = string(dAtA[iNdEx:postIndex])
+			m.NsId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VnetId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field McisId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -42624,11 +51707,11 @@ func (m *TbVmReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VnetId = string(dAtA[iNdEx:postIndex])
+			m.McisId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubnetId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VmId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -42656,11 +51739,62 @@ func (m *TbVmReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SubnetId = string(dAtA[iNdEx:postIndex])
+			m.VmId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TbVmActionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCbtumblebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TbVmActionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TbVmActionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecurityGroupIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NsId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -42688,11 +51822,11 @@ func (m *TbVmReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SecurityGroupIds = append(m.SecurityGroupIds, string(dAtA[iNdEx:postIndex]))
+			m.NsId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SshKeyId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field McisId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -42720,11 +51854,11 @@ func (m *TbVmReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SshKeyId = string(dAtA[iNdEx:postIndex])
+			m.McisId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VmUserAccount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VmId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -42752,11 +51886,11 @@ func (m *TbVmReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VmUserAccount = string(dAtA[iNdEx:postIndex])
+			m.VmId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VmUserPassword", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -42784,7 +51918,7 @@ func (m *TbVmReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VmUserPassword = string(dAtA[iNdEx:postIndex])
+			m.Action = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -42808,7 +51942,7 @@ func (m *TbVmReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListTbMcisStatusInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *McisRecommendInfoResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -42831,15 +51965,15 @@ func (m *ListTbMcisStatusInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListTbMcisStatusInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: McisRecommendInfoResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListTbMcisStatusInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: McisRecommendInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -42866,8 +52000,10 @@ func (m *ListTbMcisStatusInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, &McisStatusInfo{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Item == nil {
+				m.Item = &McisRecommendInfo{}
+			}
+			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -42893,7 +52029,7 @@ func (m *ListTbMcisStatusInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TbMcisStatusInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *McisRecommendInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -42916,15 +52052,15 @@ func (m *TbMcisStatusInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TbMcisStatusInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: McisRecommendInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TbMcisStatusInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: McisRecommendInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VmRecommend", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -42951,99 +52087,14 @@ func (m *TbMcisStatusInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Item == nil {
-				m.Item = &McisStatusInfo{}
-			}
-			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
-			if err != nil {
+			m.VmRecommend = append(m.VmRecommend, &TbVmRecommendInfo{})
+			if err := m.VmRecommend[len(m.VmRecommend)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *McisStatusInfo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCbtumblebug
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: McisStatusInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: McisStatusInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCbtumblebug
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PlacementAlgo", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -43071,13 +52122,13 @@ func (m *McisStatusInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.PlacementAlgo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PlacementParam", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -43087,61 +52138,82 @@ func (m *McisStatusInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetStatus", wireType)
+			m.PlacementParam = append(m.PlacementParam, &KeyValue{})
+			if err := m.PlacementParam[len(m.PlacementParam)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCbtumblebug
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCbtumblebug
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TbVmRecommendInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCbtumblebug
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TargetStatus = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TbVmRecommendInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TbVmRecommendInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetAction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VmReq", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -43151,29 +52223,33 @@ func (m *McisStatusInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TargetAction = string(dAtA[iNdEx:postIndex])
+			if m.VmReq == nil {
+				m.VmReq = &TbVmRecommendReq{}
+			}
+			if err := m.VmReq.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InstallMonAgent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VmPriority", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -43183,27 +52259,29 @@ func (m *McisStatusInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InstallMonAgent = string(dAtA[iNdEx:postIndex])
+			m.VmPriority = append(m.VmPriority, &TbVmPriority{})
+			if err := m.VmPriority[len(m.VmPriority)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MasterVmId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PlacementAlgo", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -43231,13 +52309,13 @@ func (m *McisStatusInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MasterVmId = string(dAtA[iNdEx:postIndex])
+			m.PlacementAlgo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MasterIp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PlacementParam", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -43247,27 +52325,80 @@ func (m *McisStatusInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MasterIp = string(dAtA[iNdEx:postIndex])
+			m.PlacementParam = append(m.PlacementParam, &KeyValue{})
+			if err := m.PlacementParam[len(m.PlacementParam)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 9:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TbVmPriority) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCbtumblebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TbVmPriority: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TbVmPriority: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MasterSshPort", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -43295,11 +52426,11 @@ func (m *McisStatusInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MasterSshPort = string(dAtA[iNdEx:postIndex])
+			m.Priority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Vm", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VmSpec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -43326,8 +52457,10 @@ func (m *McisStatusInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Vm = append(m.Vm, &TbVmStatusInfo{})
-			if err := m.Vm[len(m.Vm)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.VmSpec == nil {
+				m.VmSpec = &TbSpecInfo{}
+			}
+			if err := m.VmSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -43353,7 +52486,7 @@ func (m *McisStatusInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TbVmStatusInfo) Unmarshal(dAtA []byte) error {
+func (m *McisRecommendCreateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -43376,15 +52509,15 @@ func (m *TbVmStatusInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TbVmStatusInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: McisRecommendCreateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TbVmStatusInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: McisRecommendCreateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NsId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -43412,13 +52545,13 @@ func (m *TbVmStatusInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.NsId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -43428,61 +52561,84 @@ func (m *TbVmStatusInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CspVmId", wireType)
+			if m.Item == nil {
+				m.Item = &McisRecommendReq{}
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCbtumblebug
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthCbtumblebug
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CspVmId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *McisRecommendReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCbtumblebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: McisRecommendReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: McisRecommendReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VmReq", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -43492,27 +52648,29 @@ func (m *TbVmStatusInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = string(dAtA[iNdEx:postIndex])
+			m.VmReq = append(m.VmReq, &TbVmRecommendReq{})
+			if err := m.VmReq[len(m.VmReq)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PlacementAlgo", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -43540,13 +52698,13 @@ func (m *TbVmStatusInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TargetStatus = string(dAtA[iNdEx:postIndex])
+			m.PlacementAlgo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetAction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PlacementParam", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -43556,27 +52714,29 @@ func (m *TbVmStatusInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TargetAction = string(dAtA[iNdEx:postIndex])
+			m.PlacementParam = append(m.PlacementParam, &KeyValue{})
+			if err := m.PlacementParam[len(m.PlacementParam)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NativeStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxResultNum", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -43604,11 +52764,62 @@ func (m *TbVmStatusInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NativeStatus = string(dAtA[iNdEx:postIndex])
+			m.MaxResultNum = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TbVmRecommendReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCbtumblebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TbVmRecommendReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TbVmRecommendReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MonAgentStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -43636,11 +52847,11 @@ func (m *TbVmStatusInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MonAgentStatus = string(dAtA[iNdEx:postIndex])
+			m.RequestName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SystemMessage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxResultNum", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -43668,11 +52879,11 @@ func (m *TbVmStatusInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SystemMessage = string(dAtA[iNdEx:postIndex])
+			m.MaxResultNum = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VcpuSize", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -43700,11 +52911,11 @@ func (m *TbVmStatusInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CreatedTime = string(dAtA[iNdEx:postIndex])
+			m.VcpuSize = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicIp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MemorySize", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -43732,11 +52943,11 @@ func (m *TbVmStatusInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PublicIp = string(dAtA[iNdEx:postIndex])
+			m.MemorySize = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrivateIp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DiskSize", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -43764,11 +52975,11 @@ func (m *TbVmStatusInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PrivateIp = string(dAtA[iNdEx:postIndex])
+			m.DiskSize = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SshPort", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PlacementAlgo", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -43796,11 +53007,11 @@ func (m *TbVmStatusInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SshPort = string(dAtA[iNdEx:postIndex])
+			m.PlacementAlgo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 14:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Location", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PlacementParam", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -43827,95 +53038,10 @@ func (m *TbVmStatusInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Location == nil {
-				m.Location = &GeoLocation{}
-			}
-			if err := m.Location.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
-			if err != nil {
+			m.PlacementParam = append(m.PlacementParam, &KeyValue{})
+			if err := m.PlacementParam[len(m.PlacementParam)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TbMcisAllQryRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCbtumblebug
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TbMcisAllQryRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TbMcisAllQryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NsId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCbtumblebug
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NsId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -43939,7 +53065,7 @@ func (m *TbMcisAllQryRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TbMcisActionRequest) Unmarshal(dAtA []byte) error {
+func (m *McisRecommendVmCreateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -43962,10 +53088,10 @@ func (m *TbMcisActionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TbMcisActionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: McisRecommendVmCreateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TbMcisActionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: McisRecommendVmCreateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -44002,9 +53128,9 @@ func (m *TbMcisActionRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field McisId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -44014,55 +53140,27 @@ func (m *TbMcisActionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.McisId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCbtumblebug
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCbtumblebug
+			if m.Item == nil {
+				m.Item = &DeploymentPlan{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Action = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -44086,7 +53184,7 @@ func (m *TbMcisActionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TbMcisQryRequest) Unmarshal(dAtA []byte) error {
+func (m *DeploymentPlan) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -44109,17 +53207,17 @@ func (m *TbMcisQryRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TbMcisQryRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeploymentPlan: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TbMcisQryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeploymentPlan: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NsId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -44129,27 +53227,67 @@ func (m *TbMcisQryRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NsId = string(dAtA[iNdEx:postIndex])
+			if m.Filter == nil {
+				m.Filter = &FilterInfo{}
+			}
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field McisId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Priority == nil {
+				m.Priority = &PriorityInfo{}
+			}
+			if err := m.Priority.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -44177,7 +53315,7 @@ func (m *TbMcisQryRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.McisId = string(dAtA[iNdEx:postIndex])
+			m.Limit = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -44201,7 +53339,7 @@ func (m *TbMcisQryRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TbVmInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *FilterInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -44224,15 +53362,15 @@ func (m *TbVmInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TbVmInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: FilterInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TbVmInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FilterInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -44259,10 +53397,8 @@ func (m *TbVmInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Item == nil {
-				m.Item = &TbVmInfo{}
-			}
-			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Policy = append(m.Policy, &FilterCondition{})
+			if err := m.Policy[len(m.Policy)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -44288,7 +53424,7 @@ func (m *TbVmInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TbVmCreateRequest) Unmarshal(dAtA []byte) error {
+func (m *FilterCondition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -44311,15 +53447,15 @@ func (m *TbVmCreateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TbVmCreateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: FilterCondition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TbVmCreateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FilterCondition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NsId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metric", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -44347,43 +53483,11 @@ func (m *TbVmCreateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NsId = string(dAtA[iNdEx:postIndex])
+			m.Metric = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field McisId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCbtumblebug
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.McisId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Condition", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -44410,10 +53514,8 @@ func (m *TbVmCreateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Item == nil {
-				m.Item = &TbVmInfo{}
-			}
-			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Condition = append(m.Condition, &Operation{})
+			if err := m.Condition[len(m.Condition)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -44439,7 +53541,7 @@ func (m *TbVmCreateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TbVmGroupCreateRequest) Unmarshal(dAtA []byte) error {
+func (m *Operation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -44462,15 +53564,15 @@ func (m *TbVmGroupCreateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TbVmGroupCreateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: Operation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TbVmGroupCreateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Operation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NsId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -44498,11 +53600,11 @@ func (m *TbVmGroupCreateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NsId = string(dAtA[iNdEx:postIndex])
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field McisId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Condition", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -44530,11 +53632,62 @@ func (m *TbVmGroupCreateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.McisId = string(dAtA[iNdEx:postIndex])
+			m.Condition = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriorityInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCbtumblebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriorityInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriorityInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -44561,10 +53714,8 @@ func (m *TbVmGroupCreateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Item == nil {
-				m.Item = &TbVmReq{}
-			}
-			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Policy = append(m.Policy, &PriorityCondition{})
+			if err := m.Policy[len(m.Policy)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -44590,7 +53741,7 @@ func (m *TbVmGroupCreateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TbVmStatusInfoesponse) Unmarshal(dAtA []byte) error {
+func (m *PriorityCondition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -44613,15 +53764,79 @@ func (m *TbVmStatusInfoesponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TbVmStatusInfoesponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PriorityCondition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TbVmStatusInfoesponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PriorityCondition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metric", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metric = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Weight = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameter", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -44648,10 +53863,8 @@ func (m *TbVmStatusInfoesponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Item == nil {
-				m.Item = &TbVmStatusInfo{}
-			}
-			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Parameter = append(m.Parameter, &ParameterKeyVal{})
+			if err := m.Parameter[len(m.Parameter)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -44677,7 +53890,7 @@ func (m *TbVmStatusInfoesponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TbVmQryRequest) Unmarshal(dAtA []byte) error {
+func (m *ParameterKeyVal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -44700,15 +53913,15 @@ func (m *TbVmQryRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TbVmQryRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ParameterKeyVal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TbVmQryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ParameterKeyVal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NsId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -44736,11 +53949,11 @@ func (m *TbVmQryRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NsId = string(dAtA[iNdEx:postIndex])
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field McisId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Val", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -44768,13 +53981,64 @@ func (m *TbVmQryRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.McisId = string(dAtA[iNdEx:postIndex])
+			m.Val = append(m.Val, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListCmdMcisResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCbtumblebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListCmdMcisResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListCmdMcisResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VmId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -44784,23 +54048,25 @@ func (m *TbVmQryRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VmId = string(dAtA[iNdEx:postIndex])
+			m.Items = append(m.Items, &CmdMcisResult{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -44824,7 +54090,7 @@ func (m *TbVmQryRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TbVmActionRequest) Unmarshal(dAtA []byte) error {
+func (m *CmdMcisResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -44847,15 +54113,15 @@ func (m *TbVmActionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TbVmActionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CmdMcisResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TbVmActionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CmdMcisResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NsId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field McisId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -44883,11 +54149,11 @@ func (m *TbVmActionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NsId = string(dAtA[iNdEx:postIndex])
+			m.McisId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field McisId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VmId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -44915,11 +54181,11 @@ func (m *TbVmActionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.McisId = string(dAtA[iNdEx:postIndex])
+			m.VmId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VmId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VmIp", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -44947,11 +54213,11 @@ func (m *TbVmActionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VmId = string(dAtA[iNdEx:postIndex])
+			m.VmIp = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -44979,94 +54245,7 @@ func (m *TbVmActionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Action = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *McisRecommendInfoResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCbtumblebug
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: McisRecommendInfoResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: McisRecommendInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCbtumblebug
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Item == nil {
-				m.Item = &McisRecommendInfo{}
-			}
-			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Result = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -45090,7 +54269,7 @@ func (m *McisRecommendInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *McisRecommendInfo) Unmarshal(dAtA []byte) error {
+func (m *McisCmdCreateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -45113,17 +54292,17 @@ func (m *McisRecommendInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: McisRecommendInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: McisCmdCreateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: McisRecommendInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: McisCmdCreateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VmRecommend", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NsId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -45133,29 +54312,27 @@ func (m *McisRecommendInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VmRecommend = append(m.VmRecommend, &TbVmRecommendInfo{})
-			if err := m.VmRecommend[len(m.VmRecommend)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NsId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PlacementAlgo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field McisId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -45183,11 +54360,11 @@ func (m *McisRecommendInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PlacementAlgo = string(dAtA[iNdEx:postIndex])
+			m.McisId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PlacementParam", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -45214,8 +54391,10 @@ func (m *McisRecommendInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PlacementParam = append(m.PlacementParam, &KeyValue{})
-			if err := m.PlacementParam[len(m.PlacementParam)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Item == nil {
+				m.Item = &McisCmdReq{}
+			}
+			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -45241,7 +54420,7 @@ func (m *McisRecommendInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TbVmRecommendInfo) Unmarshal(dAtA []byte) error {
+func (m *McisCmdVmCreateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -45264,17 +54443,17 @@ func (m *TbVmRecommendInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TbVmRecommendInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: McisCmdVmCreateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TbVmRecommendInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: McisCmdVmCreateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VmReq", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NsId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -45284,33 +54463,29 @@ func (m *TbVmRecommendInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VmReq == nil {
-				m.VmReq = &TbVmRecommendReq{}
-			}
-			if err := m.VmReq.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NsId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VmPriority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field McisId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -45320,29 +54495,27 @@ func (m *TbVmRecommendInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VmPriority = append(m.VmPriority, &TbVmPriority{})
-			if err := m.VmPriority[len(m.VmPriority)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.McisId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PlacementAlgo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VmId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -45370,11 +54543,11 @@ func (m *TbVmRecommendInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PlacementAlgo = string(dAtA[iNdEx:postIndex])
+			m.VmId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PlacementParam", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -45401,8 +54574,10 @@ func (m *TbVmRecommendInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PlacementParam = append(m.PlacementParam, &KeyValue{})
-			if err := m.PlacementParam[len(m.PlacementParam)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Item == nil {
+				m.Item = &McisCmdReq{}
+			}
+			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -45428,7 +54603,7 @@ func (m *TbVmRecommendInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TbVmPriority) Unmarshal(dAtA []byte) error {
+func (m *McisCmdReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -45451,15 +54626,15 @@ func (m *TbVmPriority) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TbVmPriority: wiretype end group for non-group")
+			return fmt.Errorf("proto: McisCmdReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TbVmPriority: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: McisCmdReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UserName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -45487,13 +54662,13 @@ func (m *TbVmPriority) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Priority = string(dAtA[iNdEx:postIndex])
+			m.UserName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VmSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Command", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -45503,27 +54678,23 @@ func (m *TbVmPriority) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VmSpec == nil {
-				m.VmSpec = &TbSpecInfo{}
-			}
-			if err := m.VmSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Command = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -45547,7 +54718,7 @@ func (m *TbVmPriority) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *McisRecommendCreateRequest) Unmarshal(dAtA []byte) error {
+func (m *ListAgentInstallResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -45570,47 +54741,15 @@ func (m *McisRecommendCreateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: McisRecommendCreateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListAgentInstallResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: McisRecommendCreateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListAgentInstallResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NsId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCbtumblebug
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NsId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -45637,10 +54776,8 @@ func (m *McisRecommendCreateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Item == nil {
-				m.Item = &McisRecommendReq{}
-			}
-			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, &CmdMcisResult{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -45666,7 +54803,7 @@ func (m *McisRecommendCreateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *McisRecommendReq) Unmarshal(dAtA []byte) error {
+func (m *MonitorResultSimpleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -45689,15 +54826,15 @@ func (m *McisRecommendReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: McisRecommendReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: MonitorResultSimpleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: McisRecommendReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MonitorResultSimpleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VmReq", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -45724,109 +54861,13 @@ func (m *McisRecommendReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VmReq = append(m.VmReq, &TbVmRecommendReq{})
-			if err := m.VmReq[len(m.VmReq)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PlacementAlgo", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCbtumblebug
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PlacementAlgo = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PlacementParam", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCbtumblebug
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if m.Item == nil {
+				m.Item = &MonResultSimpleInfo{}
 			}
-			m.PlacementParam = append(m.PlacementParam, &KeyValue{})
-			if err := m.PlacementParam[len(m.PlacementParam)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxResultNum", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCbtumblebug
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MaxResultNum = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
@@ -45849,7 +54890,7 @@ func (m *McisRecommendReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TbVmRecommendReq) Unmarshal(dAtA []byte) error {
+func (m *MonResultSimpleInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -45872,15 +54913,15 @@ func (m *TbVmRecommendReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TbVmRecommendReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: MonResultSimpleInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TbVmRecommendReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MonResultSimpleInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NsId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -45908,11 +54949,11 @@ func (m *TbVmRecommendReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RequestName = string(dAtA[iNdEx:postIndex])
+			m.NsId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxResultNum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field McisId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -45940,13 +54981,13 @@ func (m *TbVmRecommendReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MaxResultNum = string(dAtA[iNdEx:postIndex])
+			m.McisId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VcpuSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field McisMonitoring", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -45956,27 +54997,80 @@ func (m *TbVmRecommendReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VcpuSize = string(dAtA[iNdEx:postIndex])
+			m.McisMonitoring = append(m.McisMonitoring, &MonResultSimple{})
+			if err := m.McisMonitoring[len(m.McisMonitoring)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MonResultSimple) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCbtumblebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MonResultSimple: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MonResultSimple: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MemorySize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metric", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -46004,11 +55098,11 @@ func (m *TbVmRecommendReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MemorySize = string(dAtA[iNdEx:postIndex])
+			m.Metric = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DiskSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VmId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -46036,11 +55130,11 @@ func (m *TbVmRecommendReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DiskSize = string(dAtA[iNdEx:postIndex])
+			m.VmId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PlacementAlgo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -46068,13 +55162,13 @@ func (m *TbVmRecommendReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PlacementAlgo = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PlacementParam", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -46084,25 +55178,23 @@ func (m *TbVmRecommendReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PlacementParam = append(m.PlacementParam, &KeyValue{})
-			if err := m.PlacementParam[len(m.PlacementParam)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Err = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -46126,7 +55218,7 @@ func (m *TbVmRecommendReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *McisRecommendVmCreateRequest) Unmarshal(dAtA []byte) error {
+func (m *MonitorRangeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -46149,45 +55241,13 @@ func (m *McisRecommendVmCreateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: McisRecommendVmCreateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MonitorRangeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: McisRecommendVmCreateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MonitorRangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NsId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCbtumblebug
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NsId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
 			}
@@ -46217,7 +55277,7 @@ func (m *McisRecommendVmCreateRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Item == nil {
-				m.Item = &DeploymentPlan{}
+				m.Item = &MonRangeInfo{}
 			}
 			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -46245,7 +55305,7 @@ func (m *McisRecommendVmCreateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeploymentPlan) Unmarshal(dAtA []byte) error {
+func (m *MonRangeInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -46268,17 +55328,17 @@ func (m *DeploymentPlan) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeploymentPlan: wiretype end group for non-group")
+			return fmt.Errorf("proto: MonRangeInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeploymentPlan: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MonRangeInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NsId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -46288,33 +55348,29 @@ func (m *DeploymentPlan) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Filter == nil {
-				m.Filter = &FilterInfo{}
-			}
-			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NsId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field McisId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -46324,31 +55380,27 @@ func (m *DeploymentPlan) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Priority == nil {
-				m.Priority = &PriorityInfo{}
-			}
-			if err := m.Priority.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.McisId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metric", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -46376,64 +55428,45 @@ func (m *DeploymentPlan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Limit = string(dAtA[iNdEx:postIndex])
+			m.Metric = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthCbtumblebug
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *FilterInfo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCbtumblebug
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: FilterInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FilterInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -46443,80 +55476,78 @@ func (m *FilterInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Policy = append(m.Policy, &FilterCondition{})
-			if err := m.Policy[len(m.Policy)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.To = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StepSec", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthCbtumblebug
+			m.StepSec = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StepSec |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aggregation", wireType)
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *FilterCondition) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCbtumblebug
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: FilterCondition: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FilterCondition: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Aggregation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metric", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resolution", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -46544,11 +55575,11 @@ func (m *FilterCondition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metric = string(dAtA[iNdEx:postIndex])
+			m.Resolution = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Condition", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VmSeries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -46575,8 +55606,42 @@ func (m *FilterCondition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Condition = append(m.Condition, &Operation{})
-			if err := m.Condition[len(m.Condition)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.VmSeries = append(m.VmSeries, &MonVmSeries{})
+			if err := m.VmSeries[len(m.VmSeries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field McisSeries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.McisSeries = append(m.McisSeries, &MonMcisPoint{})
+			if err := m.McisSeries[len(m.McisSeries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -46602,7 +55667,7 @@ func (m *FilterCondition) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Operation) Unmarshal(dAtA []byte) error {
+func (m *MonVmSeries) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -46625,15 +55690,15 @@ func (m *Operation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Operation: wiretype end group for non-group")
+			return fmt.Errorf("proto: MonVmSeries: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Operation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MonVmSeries: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VmId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -46661,13 +55726,13 @@ func (m *Operation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operator = string(dAtA[iNdEx:postIndex])
+			m.VmId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Condition", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Points", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -46677,23 +55742,25 @@ func (m *Operation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Condition = string(dAtA[iNdEx:postIndex])
+			m.Points = append(m.Points, &MonPoint{})
+			if err := m.Points[len(m.Points)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -46717,7 +55784,7 @@ func (m *Operation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PriorityInfo) Unmarshal(dAtA []byte) error {
+func (m *MonPoint) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -46740,17 +55807,17 @@ func (m *PriorityInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PriorityInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: MonPoint: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PriorityInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MonPoint: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -46760,26 +55827,35 @@ func (m *PriorityInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Policy = append(m.Policy, &PriorityCondition{})
-			if err := m.Policy[len(m.Policy)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Time = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Value = float64(math.Float64frombits(v))
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
@@ -46802,7 +55878,7 @@ func (m *PriorityInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PriorityCondition) Unmarshal(dAtA []byte) error {
+func (m *MonMcisPoint) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -46825,15 +55901,15 @@ func (m *PriorityCondition) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PriorityCondition: wiretype end group for non-group")
+			return fmt.Errorf("proto: MonMcisPoint: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PriorityCondition: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MonMcisPoint: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metric", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -46861,45 +55937,57 @@ func (m *PriorityCondition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metric = string(dAtA[iNdEx:postIndex])
+			m.Time = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Avg", wireType)
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCbtumblebug
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthCbtumblebug
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Avg = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Min", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCbtumblebug
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Min = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Weight = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parameter", wireType)
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Max = float64(math.Float64frombits(v))
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sum", wireType)
 			}
-			var msglen int
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Sum = float64(math.Float64frombits(v))
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VmCount", wireType)
+			}
+			m.VmCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -46909,26 +55997,11 @@ func (m *PriorityCondition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.VmCount |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Parameter = append(m.Parameter, &ParameterKeyVal{})
-			if err := m.Parameter[len(m.Parameter)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
@@ -46951,7 +56024,7 @@ func (m *PriorityCondition) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ParameterKeyVal) Unmarshal(dAtA []byte) error {
+func (m *MonitorQryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -46974,15 +56047,15 @@ func (m *ParameterKeyVal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ParameterKeyVal: wiretype end group for non-group")
+			return fmt.Errorf("proto: MonitorQryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ParameterKeyVal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MonitorQryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NsId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -47010,11 +56083,11 @@ func (m *ParameterKeyVal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.NsId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Val", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field McisId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -47042,7 +56115,167 @@ func (m *ParameterKeyVal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Val = append(m.Val, string(dAtA[iNdEx:postIndex]))
+			m.McisId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metric", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metric = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Step", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Step = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Agg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Agg = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -47066,7 +56299,7 @@ func (m *ParameterKeyVal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListCmdMcisResponse) Unmarshal(dAtA []byte) error {
+func (m *ListBenchmarkInfoResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -47089,10 +56322,10 @@ func (m *ListCmdMcisResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListCmdMcisResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListBenchmarkInfoResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListCmdMcisResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListBenchmarkInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -47124,7 +56357,7 @@ func (m *ListCmdMcisResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, &CmdMcisResult{})
+			m.Items = append(m.Items, &BenchmarkInfo{})
 			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -47151,7 +56384,7 @@ func (m *ListCmdMcisResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CmdMcisResult) Unmarshal(dAtA []byte) error {
+func (m *BenchmarkInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -47174,15 +56407,15 @@ func (m *CmdMcisResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CmdMcisResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: BenchmarkInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CmdMcisResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BenchmarkInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field McisId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -47210,11 +56443,11 @@ func (m *CmdMcisResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.McisId = string(dAtA[iNdEx:postIndex])
+			m.Result = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VmId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Unit", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -47242,11 +56475,11 @@ func (m *CmdMcisResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VmId = string(dAtA[iNdEx:postIndex])
+			m.Unit = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VmIp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Desc", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -47274,11 +56507,11 @@ func (m *CmdMcisResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VmIp = string(dAtA[iNdEx:postIndex])
+			m.Desc = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Elapsed", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -47306,7 +56539,73 @@ func (m *CmdMcisResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Result = string(dAtA[iNdEx:postIndex])
+			m.Elapsed = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpecId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResultArray", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResultArray = append(m.ResultArray, &BenchmarkInfo{})
+			if err := m.ResultArray[len(m.ResultArray)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -47330,7 +56629,7 @@ func (m *CmdMcisResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *McisCmdCreateRequest) Unmarshal(dAtA []byte) error {
+func (m *BmQryAllRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -47353,10 +56652,10 @@ func (m *McisCmdCreateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: McisCmdCreateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: BmQryAllRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: McisCmdCreateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BmQryAllRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -47453,7 +56752,7 @@ func (m *McisCmdCreateRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Item == nil {
-				m.Item = &McisCmdReq{}
+				m.Item = &BmReq{}
 			}
 			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -47481,7 +56780,7 @@ func (m *McisCmdCreateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *McisCmdVmCreateRequest) Unmarshal(dAtA []byte) error {
+func (m *BmQryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -47504,10 +56803,10 @@ func (m *McisCmdVmCreateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: McisCmdVmCreateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: BmQryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: McisCmdVmCreateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BmQryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -47576,7 +56875,7 @@ func (m *McisCmdVmCreateRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VmId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -47604,7 +56903,7 @@ func (m *McisCmdVmCreateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VmId = string(dAtA[iNdEx:postIndex])
+			m.Action = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
@@ -47636,7 +56935,7 @@ func (m *McisCmdVmCreateRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Item == nil {
-				m.Item = &McisCmdReq{}
+				m.Item = &BmReq{}
 			}
 			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -47664,7 +56963,7 @@ func (m *McisCmdVmCreateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *McisCmdReq) Unmarshal(dAtA []byte) error {
+func (m *BmReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -47687,47 +56986,15 @@ func (m *McisCmdReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: McisCmdReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: BmReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: McisCmdReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BmReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCbtumblebug
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.UserName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Command", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Host", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -47755,7 +57022,7 @@ func (m *McisCmdReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Command = string(dAtA[iNdEx:postIndex])
+			m.Host = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -47779,7 +57046,7 @@ func (m *McisCmdReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListAgentInstallResponse) Unmarshal(dAtA []byte) error {
+func (m *McisPolicyInfoResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -47802,15 +57069,15 @@ func (m *ListAgentInstallResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListAgentInstallResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: McisPolicyInfoResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListAgentInstallResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: McisPolicyInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -47837,8 +57104,10 @@ func (m *ListAgentInstallResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, &CmdMcisResult{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Item == nil {
+				m.Item = &McisPolicyInfo{}
+			}
+			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -47864,7 +57133,7 @@ func (m *ListAgentInstallResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MonitorResultSimpleResponse) Unmarshal(dAtA []byte) error {
+func (m *ListMcisPolicyInfoResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -47887,15 +57156,15 @@ func (m *MonitorResultSimpleResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MonitorResultSimpleResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListMcisPolicyInfoResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MonitorResultSimpleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListMcisPolicyInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -47922,10 +57191,8 @@ func (m *MonitorResultSimpleResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Item == nil {
-				m.Item = &MonResultSimpleInfo{}
-			}
-			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, &McisPolicyInfo{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -47951,7 +57218,7 @@ func (m *MonitorResultSimpleResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MonResultSimpleInfo) Unmarshal(dAtA []byte) error {
+func (m *McisPolicyInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -47974,15 +57241,15 @@ func (m *MonResultSimpleInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MonResultSimpleInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: McisPolicyInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MonResultSimpleInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: McisPolicyInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NsId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -48010,11 +57277,11 @@ func (m *MonResultSimpleInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NsId = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field McisId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -48042,11 +57309,11 @@ func (m *MonResultSimpleInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.McisId = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field McisMonitoring", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -48073,65 +57340,46 @@ func (m *MonResultSimpleInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.McisMonitoring = append(m.McisMonitoring, &MonResultSimple{})
-			if err := m.McisMonitoring[len(m.McisMonitoring)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Policy = append(m.Policy, &Policy{})
+			if err := m.Policy[len(m.Policy)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionLog", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthCbtumblebug
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MonResultSimple) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCbtumblebug
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MonResultSimple: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MonResultSimple: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.ActionLog = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metric", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -48159,11 +57407,11 @@ func (m *MonResultSimple) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metric = string(dAtA[iNdEx:postIndex])
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VmId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastScaleOutTime", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -48191,11 +57439,11 @@ func (m *MonResultSimple) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VmId = string(dAtA[iNdEx:postIndex])
+			m.LastScaleOutTime = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastScaleInTime", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -48223,13 +57471,13 @@ func (m *MonResultSimple) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.LastScaleInTime = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MetricsSource", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -48239,23 +57487,27 @@ func (m *MonResultSimple) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Err = string(dAtA[iNdEx:postIndex])
+			if m.MetricsSource == nil {
+				m.MetricsSource = &MetricsSource{}
+			}
+			if err := m.MetricsSource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -48279,7 +57531,7 @@ func (m *MonResultSimple) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MonitorQryRequest) Unmarshal(dAtA []byte) error {
+func (m *MetricsSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -48302,15 +57554,15 @@ func (m *MonitorQryRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MonitorQryRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MetricsSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MonitorQryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MetricsSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NsId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -48338,11 +57590,11 @@ func (m *MonitorQryRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NsId = string(dAtA[iNdEx:postIndex])
+			m.Provider = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field McisId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PrometheusUrl", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -48370,13 +57622,13 @@ func (m *MonitorQryRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.McisId = string(dAtA[iNdEx:postIndex])
+			m.PrometheusUrl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metric", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PrometheusQuery", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -48386,80 +57638,156 @@ func (m *MonitorQryRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metric = string(dAtA[iNdEx:postIndex])
+			if m.PrometheusQuery == nil {
+				m.PrometheusQuery = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCbtumblebug
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCbtumblebug
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthCbtumblebug
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthCbtumblebug
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCbtumblebug
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthCbtumblebug
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthCbtumblebug
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipCbtumblebug(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthCbtumblebug
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.PrometheusQuery[mapkey] = mapvalue
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrometheusVmLabel", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthCbtumblebug
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ListBenchmarkInfoResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCbtumblebug
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ListBenchmarkInfoResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListBenchmarkInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.PrometheusVmLabel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SshUserName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -48469,25 +57797,23 @@ func (m *ListBenchmarkInfoResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, &BenchmarkInfo{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SshUserName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -48511,7 +57837,7 @@ func (m *ListBenchmarkInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BenchmarkInfo) Unmarshal(dAtA []byte) error {
+func (m *Policy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -48534,17 +57860,17 @@ func (m *BenchmarkInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BenchmarkInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: Policy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BenchmarkInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Policy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCondition", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -48554,29 +57880,33 @@ func (m *BenchmarkInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Result = string(dAtA[iNdEx:postIndex])
+			if m.AutoCondition == nil {
+				m.AutoCondition = &AutoCondition{}
+			}
+			if err := m.AutoCondition.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Unit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoAction", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -48586,27 +57916,31 @@ func (m *BenchmarkInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Unit = string(dAtA[iNdEx:postIndex])
+			if m.AutoAction == nil {
+				m.AutoAction = &AutoAction{}
+			}
+			if err := m.AutoAction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Desc", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -48634,13 +57968,13 @@ func (m *BenchmarkInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Desc = string(dAtA[iNdEx:postIndex])
+			m.Status = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Elapsed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoSchedule", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -48650,59 +57984,31 @@ func (m *BenchmarkInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Elapsed = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpecId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCbtumblebug
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCbtumblebug
+			if m.AutoSchedule == nil {
+				m.AutoSchedule = &AutoSchedule{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.AutoSchedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.SpecId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResultArray", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoPredict", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -48729,8 +58035,10 @@ func (m *BenchmarkInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ResultArray = append(m.ResultArray, &BenchmarkInfo{})
-			if err := m.ResultArray[len(m.ResultArray)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.AutoPredict == nil {
+				m.AutoPredict = &AutoPredict{}
+			}
+			if err := m.AutoPredict.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -48756,7 +58064,7 @@ func (m *BenchmarkInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BmQryAllRequest) Unmarshal(dAtA []byte) error {
+func (m *AutoPredict) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -48779,15 +58087,15 @@ func (m *BmQryAllRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BmQryAllRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AutoPredict: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BmQryAllRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AutoPredict: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NsId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Model", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -48815,13 +58123,13 @@ func (m *BmQryAllRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NsId = string(dAtA[iNdEx:postIndex])
+			m.Model = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field McisId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeasonLength", wireType)
 			}
-			var stringLen uint64
+			m.SeasonLength = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -48831,29 +58139,16 @@ func (m *BmQryAllRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.SeasonLength |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.McisId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeadTimeMin", wireType)
 			}
-			var msglen int
+			m.LeadTimeMin = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -48863,28 +58158,31 @@ func (m *BmQryAllRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.LeadTimeMin |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Item == nil {
-				m.Item = &BmReq{}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionEnabled", wireType)
 			}
-			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
+			m.ActionEnabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
@@ -48907,7 +58205,7 @@ func (m *BmQryAllRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BmQryRequest) Unmarshal(dAtA []byte) error {
+func (m *AutoSchedule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -48930,15 +58228,15 @@ func (m *BmQryRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BmQryRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AutoSchedule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BmQryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AutoSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NsId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeZone", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -48966,13 +58264,13 @@ func (m *BmQryRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NsId = string(dAtA[iNdEx:postIndex])
+			m.TimeZone = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field McisId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rules", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -48982,29 +58280,31 @@ func (m *BmQryRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.McisId = string(dAtA[iNdEx:postIndex])
+			m.Rules = append(m.Rules, &AutoScheduleRule{})
+			if err := m.Rules[len(m.Rules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveRule", wireType)
 			}
-			var stringLen uint64
+			m.ActiveRule = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -49014,29 +58314,16 @@ func (m *BmQryRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ActiveRule |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Action = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveRuleTime", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -49046,27 +58333,23 @@ func (m *BmQryRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Item == nil {
-				m.Item = &BmReq{}
-			}
-			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ActiveRuleTime = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -49090,7 +58373,7 @@ func (m *BmQryRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BmReq) Unmarshal(dAtA []byte) error {
+func (m *AutoScheduleRule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -49113,15 +58396,15 @@ func (m *BmReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BmReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: AutoScheduleRule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BmReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AutoScheduleRule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Host", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cron", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -49149,8 +58432,27 @@ func (m *BmReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Host = string(dAtA[iNdEx:postIndex])
+			m.Cron = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DesiredVmCount", wireType)
+			}
+			m.DesiredVmCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DesiredVmCount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
@@ -49173,7 +58475,7 @@ func (m *BmReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *McisPolicyInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *AutoCondition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -49196,17 +58498,17 @@ func (m *McisPolicyInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: McisPolicyInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AutoCondition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: McisPolicyInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AutoCondition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metric", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -49216,82 +58518,187 @@ func (m *McisPolicyInfoResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Item == nil {
-				m.Item = &McisPolicyInfo{}
+			m.Metric = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
-			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operand", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ListMcisPolicyInfoResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCbtumblebug
+			m.Operand = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvaluationPeriod", wireType)
 			}
-			if iNdEx >= l {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.EvaluationPeriod = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvaluationValue", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ListMcisPolicyInfoResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListMcisPolicyInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvaluationValue = append(m.EvaluationValue, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Aggregation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Aggregation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -49318,11 +58725,45 @@ func (m *ListMcisPolicyInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, &McisPolicyInfo{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Expression == nil {
+				m.Expression = &AutoConditionExpr{}
+			}
+			if err := m.Expression.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Window = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
@@ -49345,7 +58786,7 @@ func (m *ListMcisPolicyInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *McisPolicyInfo) Unmarshal(dAtA []byte) error {
+func (m *AutoConditionExpr) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -49368,15 +58809,15 @@ func (m *McisPolicyInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: McisPolicyInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: AutoConditionExpr: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: McisPolicyInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AutoConditionExpr: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Combinator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -49404,13 +58845,13 @@ func (m *McisPolicyInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Combinator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Children", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -49420,29 +58861,31 @@ func (m *McisPolicyInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Children = append(m.Children, &AutoConditionExpr{})
+			if err := m.Children[len(m.Children)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metric", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -49452,29 +58895,27 @@ func (m *McisPolicyInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Policy = append(m.Policy, &Policy{})
-			if err := m.Policy[len(m.Policy)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Metric = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActionLog", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Aggregation", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -49502,11 +58943,11 @@ func (m *McisPolicyInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ActionLog = string(dAtA[iNdEx:postIndex])
+			m.Aggregation = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -49534,64 +58975,45 @@ func (m *McisPolicyInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operand", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthCbtumblebug
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbtumblebug
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Policy) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCbtumblebug
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbtumblebug
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Policy: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Policy: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Operand = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AutoCondition", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EvaluationPeriod", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -49601,33 +59023,29 @@ func (m *Policy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AutoCondition == nil {
-				m.AutoCondition = &AutoCondition{}
-			}
-			if err := m.AutoCondition.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.EvaluationPeriod = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AutoAction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EvaluationValue", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -49637,31 +59055,27 @@ func (m *Policy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AutoAction == nil {
-				m.AutoAction = &AutoAction{}
-			}
-			if err := m.AutoAction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.EvaluationValue = append(m.EvaluationValue, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -49689,7 +59103,7 @@ func (m *Policy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = string(dAtA[iNdEx:postIndex])
+			m.Window = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -49713,7 +59127,7 @@ func (m *Policy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AutoCondition) Unmarshal(dAtA []byte) error {
+func (m *AutoAction) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -49736,15 +59150,15 @@ func (m *AutoCondition) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AutoCondition: wiretype end group for non-group")
+			return fmt.Errorf("proto: AutoAction: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AutoCondition: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AutoAction: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metric", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -49772,13 +59186,13 @@ func (m *AutoCondition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metric = string(dAtA[iNdEx:postIndex])
+			m.ActionType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Vm", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -49788,29 +59202,33 @@ func (m *AutoCondition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operator = string(dAtA[iNdEx:postIndex])
+			if m.Vm == nil {
+				m.Vm = &TbVmInfo{}
+			}
+			if err := m.Vm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operand", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PostCommand", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -49820,27 +59238,31 @@ func (m *AutoCondition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operand = string(dAtA[iNdEx:postIndex])
+			if m.PostCommand == nil {
+				m.PostCommand = &McisCmdReq{}
+			}
+			if err := m.PostCommand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EvaluationPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PlacementAlgo", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -49868,11 +59290,11 @@ func (m *AutoCondition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EvaluationPeriod = string(dAtA[iNdEx:postIndex])
+			m.PlacementAlgo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EvaluationValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeLabel", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -49900,62 +59322,49 @@ func (m *AutoCondition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EvaluationValue = append(m.EvaluationValue, string(dAtA[iNdEx:postIndex]))
+			m.ScopeLabel = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCbtumblebug(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthCbtumblebug
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinVmCount", wireType)
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AutoAction) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCbtumblebug
+			m.MinVmCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinVmCount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxVmCount", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.MaxVmCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxVmCount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AutoAction: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AutoAction: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActionType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StepSize", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -49983,13 +59392,51 @@ func (m *AutoAction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ActionType = string(dAtA[iNdEx:postIndex])
+			m.StepSize = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScaleOutCooldownSec", wireType)
+			}
+			m.ScaleOutCooldownSec = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScaleOutCooldownSec |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScaleInCooldownSec", wireType)
+			}
+			m.ScaleInCooldownSec = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbtumblebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScaleInCooldownSec |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Vm", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VictimStrategy", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -49999,33 +59446,29 @@ func (m *AutoAction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Vm == nil {
-				m.Vm = &TbVmInfo{}
-			}
-			if err := m.Vm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.VictimStrategy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PostCommand", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeVmGroupId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbtumblebug
@@ -50035,31 +59478,27 @@ func (m *AutoAction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbtumblebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PostCommand == nil {
-				m.PostCommand = &McisCmdReq{}
-			}
-			if err := m.PostCommand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ScopeVmGroupId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PlacementAlgo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PlacementLocation", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -50087,7 +59526,7 @@ func (m *AutoAction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PlacementAlgo = string(dAtA[iNdEx:postIndex])
+			m.PlacementLocation = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -284,6 +284,33 @@ func (r *MCIRRequest) FilterSpecsByRange() (string, error) {
 	return gc.ConvertToOutput(r.OutType, &resp)
 }
 
+// SearchSpecs
+func (r *MCIRRequest) SearchSpecs() (string, error) {
+	// Check input data
+	if r.InData == "" {
+		return "", errors.New("input data required")
+	}
+
+	// Unmarshal (json/yaml -> Request Input)
+	var item pb.SpecSearchRequest
+	err := gc.ConvertToMessage(r.InType, r.InData, &item)
+	if err != nil {
+		return "", err
+	}
+
+	// Request to server
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+
+	resp, err := r.Client.SearchSpecs(ctx, &item)
+	if err != nil {
+		return "", err
+	}
+
+	// Marshal (Response -> json/yaml)
+	return gc.ConvertToOutput(r.OutType, &resp)
+}
+
 // SortSpecs
 func (r *MCIRRequest) SortSpecs() (string, error) {
 	// Check input data
@@ -1112,6 +1112,16 @@ func (m *MCIRApi) FilterSpecsByRange(doc string) (string, error) {
 	return m.requestMCIR.FilterSpecsByRange()
 }
 
+// SearchSpecs is to search specs by field predicates with ordering and pagination
+func (m *MCIRApi) SearchSpecs(doc string) (string, error) {
+	if m.requestMCIR == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	m.requestMCIR.InData = doc
+	return m.requestMCIR.SearchSpecs()
+}
+
 // SortSpecs is to sort specs
 func (m *MCIRApi) SortSpecs(doc string) (string, error) {
 	if m.requestMCIR == nil {
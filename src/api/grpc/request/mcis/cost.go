@@ -0,0 +1,51 @@
+package mcis
+
+import (
+	"context"
+	"errors"
+
+	gc "github.com/cloud-barista/cb-tumblebug/src/api/grpc/common"
+	pb "github.com/cloud-barista/cb-tumblebug/src/api/grpc/protobuf/cbtumblebug"
+)
+
+// ===== [ Constants and Variables ] =====
+
+// ===== [ Types ] =====
+
+// ===== [ Implementations ] =====
+
+// GetCostReport
+func (r *MCISRequest) GetCostReport() (string, error) {
+	// Check input data
+	if r.InData == "" {
+		return "", errors.New("input data required")
+	}
+
+	// Unmarshal (json/yaml -> Request Input)
+	var item pb.CostReportQryRequest
+	err := gc.ConvertToMessage(r.InType, r.InData, &item)
+	if err != nil {
+		return "", err
+	}
+
+	// Request to server
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+
+	resp, err := r.Client.GetCostReport(ctx, &item)
+	if err != nil {
+		return "", err
+	}
+
+	// CSV is given by server as it is
+	if item.Format == "csv" {
+		return resp.Csv, nil
+	}
+
+	// Marshal (Response -> json/yaml)
+	return gc.ConvertToOutput(r.OutType, &resp.Item)
+}
+
+// ===== [ Private Functions ] =====
+
+// ===== [ Public Functions ] =====
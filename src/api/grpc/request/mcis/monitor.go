@@ -68,6 +68,33 @@ func (r *MCISRequest) GetMonitorData() (string, error) {
 	return gc.ConvertToOutput(r.OutType, &resp.Item)
 }
 
+// GetMonitorRange
+func (r *MCISRequest) GetMonitorRange() (string, error) {
+	// Check input data
+	if r.InData == "" {
+		return "", errors.New("input data required")
+	}
+
+	// Unmarshal (json/yaml -> Request Input)
+	var item pb.MonitorQryRequest
+	err := gc.ConvertToMessage(r.InType, r.InData, &item)
+	if err != nil {
+		return "", err
+	}
+
+	// Request to server
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+
+	resp, err := r.Client.GetMonitorRange(ctx, &item)
+	if err != nil {
+		return "", err
+	}
+
+	// Marshal (Response -> json/yaml)
+	return gc.ConvertToOutput(r.OutType, &resp.Item)
+}
+
 // ===== [ Private Functions ] =====
 
 // ===== [ Public Functions ] =====
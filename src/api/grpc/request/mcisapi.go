@@ -111,6 +111,8 @@ type TbVmInfo struct {
 	SshKeyId         string               `yaml:"sshKeyId" json:"sshKeyId"`
 	VmUserAccount    string               `yaml:"vmUserAccount" json:"vmUserAccount"`
 	VmUserPassword   string               `yaml:"vmUserPassword" json:"vmUserPassword"`
+	UserData         string               `yaml:"userData" json:"userData"`
+	UserDataEncoding string               `yaml:"userDataEncoding" json:"userDataEncoding"`
 
 	// StartTime 필드가 공백일 경우 json 객체 복사할 때 time format parsing 에러 방지
 	// CspViewVmDetail  SpiderVMInfo `yaml:"cspViewVmDetail" json:"cspViewVmDetail"`
@@ -1147,6 +1149,56 @@ func (m *MCISApi) GetMonitorDataByParam(nameSpaceID string, mcisID string, metri
 	return result, err
 }
 
+// GetMonitorRange is to MCIS Monitor 시계열 정보 조회
+func (m *MCISApi) GetMonitorRange(doc string) (string, error) {
+	if m.requestMCIS == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	m.requestMCIS.InData = doc
+	return m.requestMCIS.GetMonitorRange()
+}
+
+// GetMonitorRangeByParam is to MCIS Monitor 시계열 정보 조회 (from is relative to now like -1h when the range is 1h)
+func (m *MCISApi) GetMonitorRangeByParam(nameSpaceID string, mcisID string, metric string, from string, to string, step string, agg string) (string, error) {
+	if m.requestMCIS == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	holdType, _ := m.GetInType()
+	m.SetInType("json")
+	m.requestMCIS.InData = `{"nsId":"` + nameSpaceID + `", "mcisId":"` + mcisID + `", "metric": "` + metric + `", "from": "` + from + `", "to": "` + to + `", "step": "` + step + `", "agg": "` + agg + `"}`
+	result, err := m.requestMCIS.GetMonitorRange()
+	m.SetInType(holdType)
+
+	return result, err
+}
+
+// GetCostReport is to MCIS 비용 보고서 조회
+func (m *MCISApi) GetCostReport(doc string) (string, error) {
+	if m.requestMCIS == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	m.requestMCIS.InData = doc
+	return m.requestMCIS.GetCostReport()
+}
+
+// GetCostReportByParam is to MCIS 비용 보고서 조회 (all MCIS in the namespace if mcisID is empty)
+func (m *MCISApi) GetCostReportByParam(nameSpaceID string, mcisID string, from string, to string, groupBy string, format string) (string, error) {
+	if m.requestMCIS == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	holdType, _ := m.GetInType()
+	m.SetInType("json")
+	m.requestMCIS.InData = `{"nsId":"` + nameSpaceID + `", "mcisId":"` + mcisID + `", "from": "` + from + `", "to": "` + to + `", "groupBy": "` + groupBy + `", "format": "` + format + `"}`
+	result, err := m.requestMCIS.GetCostReport()
+	m.SetInType(holdType)
+
+	return result, err
+}
+
 // GetBenchmark is to Benchmark 조회
 func (m *MCISApi) GetBenchmark(doc string) (string, error) {
 	if m.requestMCIS == nil {
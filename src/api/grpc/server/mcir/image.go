@@ -203,7 +203,17 @@ func (s *MCIRService) SearchImage(ctx context.Context, req *pb.SearchImageQryReq
 
 	logger.Debug("calling MCIRService.SearchImage()")
 
-	content, err := mcir.SearchImage(req.NsId, req.Keywords...)
+	cond := mcir.TbImageOsInfo{
+		OsFamily:           req.OsFamily,
+		OsDistribution:     req.OsDistribution,
+		OsVersion:          req.OsVersion,
+		OsArchitecture:     req.OsArchitecture,
+		VirtualizationType: req.VirtualizationType,
+		BootMode:           req.BootMode,
+	}
+	cond = mcir.AddImageOsQuery(cond, req.Os)
+
+	content, err := mcir.SearchImageByOs(req.NsId, req.ConnectionName, cond, req.Keywords...)
 	if err != nil {
 		return nil, gc.ConvGrpcStatusErr(err, "", "MCIRService.SearchImage()")
 	}
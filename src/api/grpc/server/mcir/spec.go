@@ -260,6 +260,34 @@ func (s *MCIRService) FilterSpecsByRange(ctx context.Context, req *pb.FilterSpec
 	return resp, nil
 }
 
+// SearchSpecs is to search specs by field predicates with ordering and pagination
+func (s *MCIRService) SearchSpecs(ctx context.Context, req *pb.SpecSearchRequest) (*pb.SpecSearchResponse, error) {
+	logger := logger.NewLogger()
+
+	logger.Debug("calling MCIRService.SearchSpecs()")
+
+	// GRPC 메시지에서 MCIR 객체로 복사
+	var search mcir.SpecSearchReq
+	err := gc.CopySrcToDest(&req.Item, &search)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "MCIRService.SearchSpecs()")
+	}
+
+	result, err := mcir.SearchSpecs(req.NsId, search)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "MCIRService.SearchSpecs()")
+	}
+
+	// MCIR 객체에서 GRPC 메시지로 복사
+	var grpcObj pb.SpecSearchResponse
+	err = gc.CopySrcToDest(&result, &grpcObj)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "MCIRService.SearchSpecs()")
+	}
+
+	return &grpcObj, nil
+}
+
 // SortSpecs is to sort specs
 func (s *MCIRService) SortSpecs(ctx context.Context, req *pb.SortSpecsRequest) (*pb.ListTbSpecInfoResponse, error) {
 	logger := logger.NewLogger()
@@ -7,6 +7,7 @@ import (
 	"github.com/cloud-barista/cb-tumblebug/src/api/grpc/logger"
 	pb "github.com/cloud-barista/cb-tumblebug/src/api/grpc/protobuf/cbtumblebug"
 
+	"github.com/cloud-barista/cb-tumblebug/src/core/common"
 	"github.com/cloud-barista/cb-tumblebug/src/core/mcir"
 )
 
@@ -55,6 +56,7 @@ func (s *MCIRService) ListSshKey(ctx context.Context, req *pb.ResourceAllQryRequ
 	if err != nil {
 		return nil, gc.ConvGrpcStatusErr(err, "", "MCIRService.ListSshKey()")
 	}
+	resourceList = common.RedactSecrets(resourceList)
 
 	// MCIR 객체에서 GRPC 메시지로 복사
 	var grpcObj []*pb.TbSshKeyInfo
@@ -99,6 +101,7 @@ func (s *MCIRService) GetSshKey(ctx context.Context, req *pb.ResourceQryRequest)
 	if err != nil {
 		return nil, gc.ConvGrpcStatusErr(err, "", "MCIRService.GetSshKey()")
 	}
+	res = common.RedactSecrets(res)
 
 	// MCIR 객체에서 GRPC 메시지로 복사
 	var grpcObj pb.TbSshKeyInfo
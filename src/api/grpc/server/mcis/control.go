@@ -8,6 +8,7 @@ import (
 	pb "github.com/cloud-barista/cb-tumblebug/src/api/grpc/protobuf/cbtumblebug"
 
 	rest_mcis "github.com/cloud-barista/cb-tumblebug/src/api/rest/server/mcis"
+	"github.com/cloud-barista/cb-tumblebug/src/core/common"
 	"github.com/cloud-barista/cb-tumblebug/src/core/mcis"
 )
 
@@ -56,6 +57,7 @@ func (s *MCISService) ListMcis(ctx context.Context, req *pb.TbMcisAllQryRequest)
 	if err != nil {
 		return nil, gc.ConvGrpcStatusErr(err, "", "MCISService.ListMcis()")
 	}
+	result = common.RedactSecrets(result).([]mcis.TbMcisInfo)
 
 	// MCIS 객체에서 GRPC 메시지로 복사
 	var grpcObj []*pb.TbMcisInfo
@@ -159,6 +161,7 @@ func (s *MCISService) GetMcisInfo(ctx context.Context, req *pb.TbMcisQryRequest)
 	if err != nil {
 		return nil, gc.ConvGrpcStatusErr(err, "", "MCISService.GetMcisInfo()")
 	}
+	result = common.RedactSecrets(result).(*mcis.TbMcisInfo)
 
 	// MCIS 객체에서 GRPC 메시지로 복사
 	var grpcObj pb.TbMcisInfo
@@ -328,6 +331,7 @@ func (s *MCISService) GetMcisVMInfo(ctx context.Context, req *pb.TbVmQryRequest)
 	if err != nil {
 		return nil, gc.ConvGrpcStatusErr(err, "", "MCISService.GetMcisVMInfo()")
 	}
+	result = common.RedactSecrets(result).(*mcis.TbVmInfo)
 
 	// MCIS 객체에서 GRPC 메시지로 복사
 	var grpcObj pb.TbVmInfo
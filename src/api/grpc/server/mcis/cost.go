@@ -0,0 +1,49 @@
+package mcis
+
+import (
+	"context"
+
+	gc "github.com/cloud-barista/cb-tumblebug/src/api/grpc/common"
+	"github.com/cloud-barista/cb-tumblebug/src/api/grpc/logger"
+	pb "github.com/cloud-barista/cb-tumblebug/src/api/grpc/protobuf/cbtumblebug"
+
+	"github.com/cloud-barista/cb-tumblebug/src/core/mcis"
+)
+
+// ===== [ Constants and Variables ] =====
+
+// ===== [ Types ] =====
+
+// ===== [ Implementations ] =====
+
+// GetCostReport is to MCIS 비용 보고서 조회
+func (s *MCISService) GetCostReport(ctx context.Context, req *pb.CostReportQryRequest) (*pb.CostReportResponse, error) {
+	logger := logger.NewLogger()
+
+	logger.Debug("calling MCISService.GetCostReport()")
+
+	content, err := mcis.GetCostReport(req.NsId, req.McisId, req.From, req.To, req.GroupBy)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "MCISService.GetCostReport()")
+	}
+
+	// MCIS 객체에서 GRPC 메시지로 복사
+	var grpcObj pb.TbCostReport
+	err = gc.CopySrcToDest(&content, &grpcObj)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "MCISService.GetCostReport()")
+	}
+
+	resp := &pb.CostReportResponse{Item: &grpcObj}
+	if req.Format == "csv" {
+		resp.Csv, err = mcis.ConvCostReportToCsv(content)
+		if err != nil {
+			return nil, gc.ConvGrpcStatusErr(err, "", "MCISService.GetCostReport()")
+		}
+	}
+	return resp, nil
+}
+
+// ===== [ Private Functions ] =====
+
+// ===== [ Public Functions ] =====
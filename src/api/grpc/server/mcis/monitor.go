@@ -67,6 +67,28 @@ func (s *MCISService) GetMonitorData(ctx context.Context, req *pb.MonitorQryRequ
 	return resp, nil
 }
 
+// GetMonitorRange is to MCIS Monitor 시계열 정보 조회
+func (s *MCISService) GetMonitorRange(ctx context.Context, req *pb.MonitorQryRequest) (*pb.MonitorRangeResponse, error) {
+	logger := logger.NewLogger()
+
+	logger.Debug("calling MCISService.GetMonitorRange()")
+
+	content, err := mcis.GetMonitoringRange(req.NsId, req.McisId, req.Metric, req.From, req.To, req.Step, req.Agg)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "MCISService.GetMonitorRange()")
+	}
+
+	// MCIS 객체에서 GRPC 메시지로 복사
+	var grpcObj pb.MonRangeInfo
+	err = gc.CopySrcToDest(&content, &grpcObj)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "MCISService.GetMonitorRange()")
+	}
+
+	resp := &pb.MonitorRangeResponse{Item: &grpcObj}
+	return resp, nil
+}
+
 // ===== [ Private Functions ] =====
 
 // ===== [ Public Functions ] =====
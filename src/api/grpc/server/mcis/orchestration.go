@@ -7,6 +7,7 @@ import (
 	"github.com/cloud-barista/cb-tumblebug/src/api/grpc/logger"
 	pb "github.com/cloud-barista/cb-tumblebug/src/api/grpc/protobuf/cbtumblebug"
 
+	"github.com/cloud-barista/cb-tumblebug/src/core/common"
 	"github.com/cloud-barista/cb-tumblebug/src/core/mcis"
 )
 
@@ -33,6 +34,7 @@ func (s *MCISService) CreateMcisPolicy(ctx context.Context, req *pb.McisPolicyCr
 	if err != nil {
 		return nil, gc.ConvGrpcStatusErr(err, "", "MCISService.CreateMcisPolicy()")
 	}
+	content = common.RedactSecrets(content).(mcis.McisPolicyInfo)
 
 	// MCIS 객체에서 GRPC 메시지로 복사
 	var grpcObj pb.McisPolicyInfo
@@ -55,6 +57,7 @@ func (s *MCISService) ListMcisPolicy(ctx context.Context, req *pb.McisPolicyAllQ
 	if err != nil {
 		return nil, gc.ConvGrpcStatusErr(err, "", "MCISService.ListMcisPolicy()")
 	}
+	result = common.RedactSecrets(result).([]mcis.McisPolicyInfo)
 
 	// MCIS 객체에서 GRPC 메시지로 복사
 	var grpcObj []*pb.McisPolicyInfo
@@ -81,6 +84,7 @@ func (s *MCISService) GetMcisPolicy(ctx context.Context, req *pb.McisPolicyQryRe
 	if result.Id == "" {
 		return nil, gc.NewGrpcStatusErr("Failed to find McisPolicyObject : "+req.McisId, "", "MCISService.GetMcisPolicy()")
 	}
+	result = common.RedactSecrets(result).(mcis.McisPolicyInfo)
 
 	// MCIS 객체에서 GRPC 메시지로 복사
 	var grpcObj pb.McisPolicyInfo
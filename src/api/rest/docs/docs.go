@@ -337,6 +337,59 @@ var doc = `{
                 }
             }
         },
+        "/importPrice": {
+            "post": {
+                "description": "Import price catalog in the request body.\ncsv: header connectionName,cspSpecName,osType,pricingModel,currency,pricePerHour,effectiveDate / json: mcir.TbPriceList\naws: AWS Price List (AmazonEC2 offer file in CSV) / azure: response of Azure Retail Prices API\n(prices of aws and azure are registered to connections in the region)",
+                "consumes": [
+                    "text/plain"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "[Admin] Multi-Cloud environment configuration"
+                ],
+                "summary": "Import price catalog",
+                "parameters": [
+                    {
+                        "enum": [
+                            "csv",
+                            "json",
+                            "aws",
+                            "azure"
+                        ],
+                        "type": "string",
+                        "description": "Format of price catalog",
+                        "name": "format",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "description": "Price catalog",
+                        "name": "catalog",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/mcir.TbPriceImportResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/common.SimpleMsg"
+                        }
+                    }
+                }
+            }
+        },
         "/inspectResources": {
             "post": {
                 "description": "Inspect Resources (vNet, securityGroup, sshKey, vm) registered in CB-Tumblebug, CB-Spider, CSP",
@@ -383,6 +436,109 @@ var doc = `{
                 }
             }
         },
+        "/latency": {
+            "get": {
+                "description": "Get inter-region latency matrix (measured by mrtt benchmark, imported from assets/cloudlatency.csv or registered by user)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "[Admin] Multi-Cloud environment configuration"
+                ],
+                "summary": "Get inter-region latency matrix",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "aws/ap-northeast-2",
+                        "description": "Source region (cloudType/nativeRegion) to filter",
+                        "name": "source",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/mcis.LatencyMatrix"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/common.SimpleMsg"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Register measured round trip time between regions (cloudType/nativeRegion) or user endpoints in the latency matrix",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "[Admin] Multi-Cloud environment configuration"
+                ],
+                "summary": "Register inter-region latency",
+                "parameters": [
+                    {
+                        "description": "List of latency information",
+                        "name": "latencyMatrix",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/mcis.LatencyMatrix"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/mcis.LatencyMatrix"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/common.SimpleMsg"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete all information in inter-region latency matrix",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "[Admin] Multi-Cloud environment configuration"
+                ],
+                "summary": "Delete inter-region latency matrix",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/common.SimpleMsg"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/common.SimpleMsg"
+                        }
+                    }
+                }
+            }
+        },
         "/loadCommonResource": {
             "get": {
                 "description": "Load Common Resources from internal asset files (Spec, Image)",
@@ -412,6 +568,64 @@ var doc = `{
                 }
             }
         },
+        "/loadLatencyMatrix": {
+            "get": {
+                "description": "Load inter-region latency matrix from internal asset file (assets/cloudlatency.csv, no measurement by default). Latencies without measurement are estimated by geographical distance",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "[Admin] Multi-Cloud environment configuration"
+                ],
+                "summary": "Load inter-region latency matrix from internal asset file",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/mcis.LatencyMatrix"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/common.SimpleMsg"
+                        }
+                    }
+                }
+            }
+        },
+        "/loadPriceCatalog": {
+            "get": {
+                "description": "Load price catalog from internal asset file (assets/cloudprice.csv)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "[Admin] Multi-Cloud environment configuration"
+                ],
+                "summary": "Load price catalog from internal asset file",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/mcir.TbPriceImportResult"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/common.SimpleMsg"
+                        }
+                    }
+                }
+            }
+        },
         "/lookupImage": {
             "post": {
                 "description": "Lookup image",
@@ -956,9 +1170,9 @@ var doc = `{
                 }
             }
         },
-        "/ns/{nsId}/cmd/mcis/{mcisId}": {
-            "post": {
-                "description": "Send a command to specified MCIS",
+        "/ns/{nsId}/budget": {
+            "get": {
+                "description": "Get monthly budget of the namespace with the cost of this month",
                 "consumes": [
                     "application/json"
                 ],
@@ -966,9 +1180,9 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "[Infra service] MCIS Remote command"
+                    "[Infra service] MCIS Provisioning management"
                 ],
-                "summary": "Send a command to specified MCIS",
+                "summary": "Get monthly budget of namespace",
                 "parameters": [
                     {
                         "type": "string",
@@ -977,30 +1191,13 @@ var doc = `{
                         "name": "nsId",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "default": "mcis01",
-                        "description": "MCIS ID",
-                        "name": "mcisId",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "MCIS Command Request",
-                        "name": "mcisCmdReq",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/mcis.McisCmdReq"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/mcis.RestPostCmdMcisResponseWrapper"
+                            "$ref": "#/definitions/mcis.TbBudgetInfo"
                         }
                     },
                     "404": {
@@ -1008,19 +1205,11 @@ var doc = `{
                         "schema": {
                             "$ref": "#/definitions/common.SimpleMsg"
                         }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/common.SimpleMsg"
-                        }
                     }
                 }
-            }
-        },
-        "/ns/{nsId}/cmd/mcis/{mcisId}/vm/{vmId}": {
-            "post": {
-                "description": "Send a command to specified VM",
+            },
+            "put": {
+                "description": "Set monthly budget of the namespace. Alerts are recorded when the cost of this month crosses thresholds (50,80,100% by default).\nWhen the budget is exhausted, new VMs can be blocked and MCISs with the suspend label can be suspended by the enforcement options.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1028,9 +1217,9 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "[Infra service] MCIS Remote command"
+                    "[Infra service] MCIS Provisioning management"
                 ],
-                "summary": "Send a command to specified VM",
+                "summary": "Set monthly budget of namespace",
                 "parameters": [
                     {
                         "type": "string",
@@ -1041,28 +1230,12 @@ var doc = `{
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "default": "mcis01",
-                        "description": "MCIS ID",
-                        "name": "mcisId",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "default": "vm01",
-                        "description": "VM ID",
-                        "name": "vmId",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "MCIS Command Request",
-                        "name": "mcisCmdReq",
+                        "description": "Monthly budget",
+                        "name": "budgetReq",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/mcis.McisCmdReq"
+                            "$ref": "#/definitions/mcis.TbBudgetReq"
                         }
                     }
                 ],
@@ -1070,27 +1243,19 @@ var doc = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/mcis.RestPostCmdMcisVmResponse"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/common.SimpleMsg"
+                            "$ref": "#/definitions/mcis.TbBudgetInfo"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/common.SimpleMsg"
                         }
                     }
                 }
-            }
-        },
-        "/ns/{nsId}/control/mcis/{mcisId}": {
-            "get": {
-                "description": "Control the lifecycle of MCIS (refine, suspend, resume, reboot, terminate)",
+            },
+            "delete": {
+                "description": "Delete monthly budget of the namespace with its alerts",
                 "consumes": [
                     "application/json"
                 ],
@@ -1098,9 +1263,9 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "[Infra service] MCIS Control lifecycle"
+                    "[Infra service] MCIS Provisioning management"
                 ],
-                "summary": "Control the lifecycle of MCIS (refine, suspend, resume, reboot, terminate)",
+                "summary": "Delete monthly budget of namespace",
                 "parameters": [
                     {
                         "type": "string",
@@ -1109,28 +1274,6 @@ var doc = `{
                         "name": "nsId",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "default": "mcis01",
-                        "description": "MCIS ID",
-                        "name": "mcisId",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "enum": [
-                            "suspend",
-                            "resume",
-                            "reboot",
-                            "terminate",
-                            "refine"
-                        ],
-                        "type": "string",
-                        "description": "Action to MCIS",
-                        "name": "action",
-                        "in": "query",
-                        "required": true
                     }
                 ],
                 "responses": {
@@ -1145,19 +1288,13 @@ var doc = `{
                         "schema": {
                             "$ref": "#/definitions/common.SimpleMsg"
                         }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/common.SimpleMsg"
-                        }
                     }
                 }
             }
         },
-        "/ns/{nsId}/control/mcis/{mcisId}/vm/{vmId}": {
+        "/ns/{nsId}/budget/alert": {
             "get": {
-                "description": "Control the lifecycle of VM (suspend, resume, reboot, terminate)",
+                "description": "List alert events (threshold, enforcement) of the namespace budget (latest first)",
                 "consumes": [
                     "application/json"
                 ],
@@ -1165,9 +1302,9 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "[Infra service] MCIS Control lifecycle"
+                    "[Infra service] MCIS Provisioning management"
                 ],
-                "summary": "Control the lifecycle of VM (suspend, resume, reboot, terminate)",
+                "summary": "List alerts of namespace budget",
                 "parameters": [
                     {
                         "type": "string",
@@ -1176,48 +1313,13 @@ var doc = `{
                         "name": "nsId",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "default": "mcis01",
-                        "description": "MCIS ID",
-                        "name": "mcisId",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "default": "vm01",
-                        "description": "VM ID",
-                        "name": "vmId",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "enum": [
-                            "suspend",
-                            "resume",
-                            "reboot",
-                            "terminate"
-                        ],
-                        "type": "string",
-                        "description": "Action to MCIS",
-                        "name": "action",
-                        "in": "query",
-                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/common.SimpleMsg"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/common.SimpleMsg"
+                            "$ref": "#/definitions/mcis.JSONResultListBudgetAlert"
                         }
                     },
                     "500": {
@@ -1229,9 +1331,9 @@ var doc = `{
                 }
             }
         },
-        "/ns/{nsId}/defaultResources": {
-            "delete": {
-                "description": "Delete all Default Resource Objects in the given namespace",
+        "/ns/{nsId}/catalogChange": {
+            "get": {
+                "description": "List changes of spec and image catalog detected by catalog sync (latest first)",
                 "consumes": [
                     "application/json"
                 ],
@@ -1241,26 +1343,63 @@ var doc = `{
                 "tags": [
                     "[Infra resource] MCIR Common"
                 ],
-                "summary": "Delete all Default Resource Objects in the given namespace",
+                "summary": "List catalog changes",
                 "parameters": [
                     {
                         "type": "string",
-                        "default": "ns01",
+                        "default": "common",
                         "description": "Namespace ID",
                         "name": "nsId",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "aws-ap-northeast-2",
+                        "description": "Connection to filter",
+                        "name": "connectionName",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "spec",
+                            "image"
+                        ],
+                        "type": "string",
+                        "description": "Resource type to filter",
+                        "name": "resourceType",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "added",
+                            "changed",
+                            "removed",
+                            "restored",
+                            "deleted"
+                        ],
+                        "type": "string",
+                        "description": "Change type to filter",
+                        "name": "changeType",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "2022-01-01T00:00:00Z",
+                        "description": "Changes after the time (RFC3339)",
+                        "name": "since",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/common.IdList"
+                            "$ref": "#/definitions/mcir.TbCatalogChangeList"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/common.SimpleMsg"
                         }
@@ -1268,9 +1407,9 @@ var doc = `{
                 }
             }
         },
-        "/ns/{nsId}/installBenchmarkAgent/mcis/{mcisId}": {
+        "/ns/{nsId}/catalogSync": {
             "post": {
-                "description": "Install the benchmark agent to specified MCIS",
+                "description": "Sync specs and images of the namespace with CSPs now. Added ones are registered, changed ones are updated,\nand removed ones are marked as deprecated (deleted if purgeUnreferenced is true and they are not referenced). Changes are recorded to the catalog changelog.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1278,13 +1417,13 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "[Infra service] MCIS Performance benchmarking (WIP)"
+                    "[Infra resource] MCIR Common"
                 ],
-                "summary": "Install the benchmark agent to specified MCIS",
+                "summary": "Sync spec and image catalog with CSPs",
                 "parameters": [
                     {
                         "type": "string",
-                        "default": "ns01",
+                        "default": "common",
                         "description": "Namespace ID",
                         "name": "nsId",
                         "in": "path",
@@ -1292,37 +1431,41 @@ var doc = `{
                     },
                     {
                         "type": "string",
-                        "default": "mcis01",
-                        "description": "MCIS ID",
-                        "name": "mcisId",
-                        "in": "path",
-                        "required": true
+                        "default": "aws-ap-northeast-2",
+                        "description": "Connection to sync (all connections if empty)",
+                        "name": "connectionName",
+                        "in": "query"
                     },
                     {
-                        "description": "MCIS Command Request",
-                        "name": "mcisCmdReq",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/mcis.McisCmdReq"
-                        }
+                        "enum": [
+                            "spec",
+                            "image"
+                        ],
+                        "type": "string",
+                        "description": "Resource type to sync (spec and image if empty)",
+                        "name": "resourceType",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "true",
+                            "false"
+                        ],
+                        "type": "string",
+                        "description": "Delete removed specs and images not referenced by any object",
+                        "name": "purgeUnreferenced",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/mcis.RestPostCmdMcisResponseWrapper"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/common.SimpleMsg"
+                            "$ref": "#/definitions/mcir.TbCatalogSyncResult"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/common.SimpleMsg"
                         }
@@ -1330,9 +1473,9 @@ var doc = `{
                 }
             }
         },
-        "/ns/{nsId}/loadDefaultResource": {
+        "/ns/{nsId}/catalogSyncPolicy": {
             "get": {
-                "description": "Load Default Resource from internal asset file",
+                "description": "Get the policy of periodic sync of spec and image catalog with CSPs",
                 "consumes": [
                     "application/json"
                 ],
@@ -1342,56 +1485,34 @@ var doc = `{
                 "tags": [
                     "[Infra resource] MCIR Common"
                 ],
-                "summary": "Load Default Resource from internal asset file",
+                "summary": "Get catalog sync policy of namespace",
                 "parameters": [
                     {
                         "type": "string",
-                        "default": "ns01",
+                        "default": "common",
                         "description": "Namespace ID",
                         "name": "nsId",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "enum": [
-                            "all",
-                            "vnet",
-                            "sg",
-                            "sshkey"
-                        ],
-                        "type": "string",
-                        "description": "Option",
-                        "name": "option",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "default": "",
-                        "description": "connectionName of cloud for designated resource",
-                        "name": "connectionName",
-                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/common.SimpleMsg"
+                            "$ref": "#/definitions/mcir.TbCatalogSyncPolicy"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/common.SimpleMsg"
                         }
                     }
                 }
-            }
-        },
-        "/ns/{nsId}/mcis": {
-            "get": {
-                "description": "List all MCISs or MCISs' ID",
+            },
+            "put": {
+                "description": "Set the policy of periodic sync of spec and image catalog with CSPs (interval in minutes, target connections and resource types)",
                 "consumes": [
                     "application/json"
                 ],
@@ -1399,74 +1520,47 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "[Infra service] MCIS Provisioning management"
+                    "[Infra resource] MCIR Common"
                 ],
-                "summary": "List all MCISs or MCISs' ID",
+                "summary": "Set catalog sync policy of namespace",
                 "parameters": [
                     {
                         "type": "string",
-                        "default": "ns01",
+                        "default": "common",
                         "description": "Namespace ID",
                         "name": "nsId",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "enum": [
-                            "id",
-                            "simple",
-                            "status"
-                        ],
-                        "type": "string",
-                        "description": "Option",
-                        "name": "option",
-                        "in": "query"
+                        "description": "Catalog sync policy",
+                        "name": "catalogSyncPolicy",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/mcir.TbCatalogSyncPolicy"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Different return structures by the given option param",
-                        "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/mcis.JSONResult"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "[DEFAULT]": {
-                                            "$ref": "#/definitions/mcis.RestGetAllMcisResponse"
-                                        },
-                                        "[ID]": {
-                                            "$ref": "#/definitions/common.IdList"
-                                        },
-                                        "[SIMPLE]": {
-                                            "$ref": "#/definitions/mcis.RestGetAllMcisResponse"
-                                        },
-                                        "[STATUS]": {
-                                            "$ref": "#/definitions/mcis.RestGetAllMcisStatusResponse"
-                                        }
-                                    }
-                                }
-                            ]
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/common.SimpleMsg"
+                            "$ref": "#/definitions/mcir.TbCatalogSyncPolicy"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/common.SimpleMsg"
                         }
                     }
                 }
-            },
+            }
+        },
+        "/ns/{nsId}/cmd/mcis/{mcisId}": {
             "post": {
-                "description": "Create MCIS",
+                "description": "Send a command to specified MCIS",
                 "consumes": [
                     "application/json"
                 ],
@@ -1474,9 +1568,9 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "[Infra service] MCIS Provisioning management"
+                    "[Infra service] MCIS Remote command"
                 ],
-                "summary": "Create MCIS",
+                "summary": "Send a command to specified MCIS",
                 "parameters": [
                     {
                         "type": "string",
@@ -1487,12 +1581,20 @@ var doc = `{
                         "required": true
                     },
                     {
-                        "description": "Details for an MCIS object",
-                        "name": "mcisReq",
+                        "type": "string",
+                        "default": "mcis01",
+                        "description": "MCIS ID",
+                        "name": "mcisId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "MCIS Command Request",
+                        "name": "mcisCmdReq",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/mcis.TbMcisReq"
+                            "$ref": "#/definitions/mcis.McisCmdReq"
                         }
                     }
                 ],
@@ -1500,7 +1602,7 @@ var doc = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/mcis.TbMcisInfo"
+                            "$ref": "#/definitions/mcis.RestPostCmdMcisResponseWrapper"
                         }
                     },
                     "404": {
@@ -1516,57 +1618,11 @@ var doc = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Delete all MCISs",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "[Infra service] MCIS Provisioning management"
-                ],
-                "summary": "Delete all MCISs",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "default": "ns01",
-                        "description": "Namespace ID",
-                        "name": "nsId",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "enum": [
-                            "force"
-                        ],
-                        "type": "string",
-                        "description": "Option for delete MCIS (support force delete)",
-                        "name": "option",
-                        "in": "query"
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/common.SimpleMsg"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/common.SimpleMsg"
-                        }
-                    }
-                }
             }
         },
-        "/ns/{nsId}/mcis/{mcisId}": {
-            "get": {
-                "description": "Get MCIS object (option: status, vmID)",
+        "/ns/{nsId}/cmd/mcis/{mcisId}/vm/{vmId}": {
+            "post": {
+                "description": "Send a command to specified VM",
                 "consumes": [
                     "application/json"
                 ],
@@ -1574,9 +1630,9 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "[Infra service] MCIS Provisioning management"
+                    "[Infra service] MCIS Remote command"
                 ],
-                "summary": "Get MCIS object (option: status, vmID)",
+                "summary": "Send a command to specified VM",
                 "parameters": [
                     {
                         "type": "string",
@@ -1595,40 +1651,28 @@ var doc = `{
                         "required": true
                     },
                     {
-                        "enum": [
-                            "default",
-                            "id",
-                            "status"
-                        ],
                         "type": "string",
-                        "description": "Option",
-                        "name": "option",
-                        "in": "query"
+                        "default": "vm01",
+                        "description": "VM ID",
+                        "name": "vmId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "MCIS Command Request",
+                        "name": "mcisCmdReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/mcis.McisCmdReq"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Different return structures by the given action param",
+                        "description": "OK",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/mcis.JSONResult"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "[DEFAULT]": {
-                                            "$ref": "#/definitions/mcis.TbMcisInfo"
-                                        },
-                                        "[ID]": {
-                                            "$ref": "#/definitions/common.IdList"
-                                        },
-                                        "[STATUS]": {
-                                            "$ref": "#/definitions/mcis.McisStatusInfo"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/mcis.RestPostCmdMcisVmResponse"
                         }
                     },
                     "404": {
@@ -1644,9 +1688,11 @@ var doc = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Delete MCIS",
+            }
+        },
+        "/ns/{nsId}/control/mcis/{mcisId}": {
+            "get": {
+                "description": "Control the lifecycle of MCIS (refine, suspend, resume, reboot, terminate)",
                 "consumes": [
                     "application/json"
                 ],
@@ -1654,9 +1700,9 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "[Infra service] MCIS Provisioning management"
+                    "[Infra service] MCIS Control lifecycle"
                 ],
-                "summary": "Delete MCIS",
+                "summary": "Control the lifecycle of MCIS (refine, suspend, resume, reboot, terminate)",
                 "parameters": [
                     {
                         "type": "string",
@@ -1676,13 +1722,17 @@ var doc = `{
                     },
                     {
                         "enum": [
+                            "suspend",
+                            "resume",
+                            "reboot",
                             "terminate",
-                            "force"
+                            "refine"
                         ],
                         "type": "string",
-                        "description": "Option for delete MCIS (support force delete)",
-                        "name": "option",
-                        "in": "query"
+                        "description": "Action to MCIS",
+                        "name": "action",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -1697,13 +1747,19 @@ var doc = `{
                         "schema": {
                             "$ref": "#/definitions/common.SimpleMsg"
                         }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/common.SimpleMsg"
+                        }
                     }
                 }
             }
         },
-        "/ns/{nsId}/mcis/{mcisId}/vm": {
-            "post": {
-                "description": "Create VM in specified MCIS",
+        "/ns/{nsId}/control/mcis/{mcisId}/vm/{vmId}": {
+            "get": {
+                "description": "Control the lifecycle of VM (suspend, resume, reboot, terminate)",
                 "consumes": [
                     "application/json"
                 ],
@@ -1711,9 +1767,9 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "[Infra service] MCIS Provisioning management"
+                    "[Infra service] MCIS Control lifecycle"
                 ],
-                "summary": "Create VM in specified MCIS",
+                "summary": "Control the lifecycle of VM (suspend, resume, reboot, terminate)",
                 "parameters": [
                     {
                         "type": "string",
@@ -1732,20 +1788,32 @@ var doc = `{
                         "required": true
                     },
                     {
-                        "description": "Details for an VM object",
-                        "name": "vmReq",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/mcis.TbVmReq"
-                        }
+                        "type": "string",
+                        "default": "vm01",
+                        "description": "VM ID",
+                        "name": "vmId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "suspend",
+                            "resume",
+                            "reboot",
+                            "terminate"
+                        ],
+                        "type": "string",
+                        "description": "Action to MCIS",
+                        "name": "action",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/mcis.TbVmInfo"
+                            "$ref": "#/definitions/common.SimpleMsg"
                         }
                     },
                     "404": {
@@ -1763,9 +1831,9 @@ var doc = `{
                 }
             }
         },
-        "/ns/{nsId}/mcis/{mcisId}/vm/{vmId}": {
+        "/ns/{nsId}/costReport": {
             "get": {
-                "description": "Get VM in specified MCIS",
+                "description": "Get cost of VMs in the namespace (or MCIS) in the time range, calculated from running intervals of VMs and CostPerHour of specs.\nThe report is grouped by vm, mcis, ns, connection or label, and projects the monthly cost of the current state.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1775,7 +1843,7 @@ var doc = `{
                 "tags": [
                     "[Infra service] MCIS Provisioning management"
                 ],
-                "summary": "Get VM in specified MCIS",
+                "summary": "Get cost report of VMs",
                 "parameters": [
                     {
                         "type": "string",
@@ -1788,68 +1856,68 @@ var doc = `{
                     {
                         "type": "string",
                         "default": "mcis01",
-                        "description": "MCIS ID",
+                        "description": "MCIS ID to get cost report of the MCIS only",
                         "name": "mcisId",
-                        "in": "path",
-                        "required": true
+                        "in": "query"
                     },
                     {
                         "type": "string",
-                        "default": "vm01",
-                        "description": "VM ID",
-                        "name": "vmId",
-                        "in": "path",
-                        "required": true
+                        "default": "2022-01-01",
+                        "description": "Start of time range (RFC3339 or YYYY-MM-DD, the first day of this month if empty)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "End of time range (RFC3339 or YYYY-MM-DD, now if empty)",
+                        "name": "to",
+                        "in": "query"
                     },
                     {
                         "enum": [
-                            "default",
-                            "status"
+                            "vm",
+                            "mcis",
+                            "ns",
+                            "connection",
+                            "label"
                         ],
                         "type": "string",
-                        "description": "Option for MCIS",
-                        "name": "option",
+                        "default": "mcis",
+                        "description": "Group of cost",
+                        "name": "groupBy",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "csv"
+                        ],
+                        "type": "string",
+                        "default": "json",
+                        "description": "Format of report",
+                        "name": "format",
                         "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Different return structures by the given option param",
-                        "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/mcis.JSONResult"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "[DEFAULT]": {
-                                            "$ref": "#/definitions/mcis.TbVmInfo"
-                                        },
-                                        "[STATUS]": {
-                                            "$ref": "#/definitions/mcis.TbVmStatusInfo"
-                                        }
-                                    }
-                                }
-                            ]
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/common.SimpleMsg"
+                            "$ref": "#/definitions/mcis.TbCostReport"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/common.SimpleMsg"
                         }
                     }
                 }
-            },
+            }
+        },
+        "/ns/{nsId}/defaultResources": {
             "delete": {
-                "description": "Delete VM in specified MCIS",
+                "description": "Delete all Default Resource Objects in the given namespace",
                 "consumes": [
                     "application/json"
                 ],
@@ -1857,9 +1925,9 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "[Infra service] MCIS Provisioning management"
+                    "[Infra resource] MCIR Common"
                 ],
-                "summary": "Delete VM in specified MCIS",
+                "summary": "Delete all Default Resource Objects in the given namespace",
                 "parameters": [
                     {
                         "type": "string",
@@ -1868,38 +1936,13 @@ var doc = `{
                         "name": "nsId",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "default": "mcis01",
-                        "description": "MCIS ID",
-                        "name": "mcisId",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "default": "vm01",
-                        "description": "VM ID",
-                        "name": "vmId",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "enum": [
-                            "force"
-                        ],
-                        "type": "string",
-                        "description": "Option for delete VM (support force delete)",
-                        "name": "option",
-                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/common.SimpleMsg"
+                            "$ref": "#/definitions/common.IdList"
                         }
                     },
                     "404": {
@@ -1911,9 +1954,9 @@ var doc = `{
                 }
             }
         },
-        "/ns/{nsId}/mcis/{mcisId}/vmgroup": {
-            "post": {
-                "description": "Create multiple VMs by VM group in specified MCIS",
+        "/ns/{nsId}/healthPolicy/mcis": {
+            "get": {
+                "description": "List all MCIS health policies in the namespace",
                 "consumes": [
                     "application/json"
                 ],
@@ -1921,9 +1964,9 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "[Infra service] MCIS Provisioning management"
+                    "[Infra service] MCIS Health policy management"
                 ],
-                "summary": "Create multiple VMs by VM group in specified MCIS",
+                "summary": "List all MCIS health policies",
                 "parameters": [
                     {
                         "type": "string",
@@ -1932,30 +1975,13 @@ var doc = `{
                         "name": "nsId",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "default": "mcis01",
-                        "description": "MCIS ID",
-                        "name": "mcisId",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Details for VM Group",
-                        "name": "vmReq",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/mcis.TbVmReq"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/mcis.TbMcisInfo"
+                            "$ref": "#/definitions/mcis.RestGetAllMcisHealthPolicyResponse"
                         }
                     },
                     "404": {
@@ -1973,9 +1999,9 @@ var doc = `{
                 }
             }
         },
-        "/ns/{nsId}/mcisDynamic": {
-            "post": {
-                "description": "Create MCIS Dynamically from common spec and image",
+        "/ns/{nsId}/healthPolicy/mcis/{mcisId}": {
+            "get": {
+                "description": "Get MCIS health policy with the health of VMs",
                 "consumes": [
                     "application/json"
                 ],
@@ -1983,9 +2009,9 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "[Infra service] MCIS Provisioning management"
+                    "[Infra service] MCIS Health policy management"
                 ],
-                "summary": "Create MCIS Dynamically",
+                "summary": "Get MCIS health policy",
                 "parameters": [
                     {
                         "type": "string",
@@ -1996,20 +2022,19 @@ var doc = `{
                         "required": true
                     },
                     {
-                        "description": "Details for MCIS object",
-                        "name": "mcisReq",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/mcis.TbMcisDynamicReq"
-                        }
+                        "type": "string",
+                        "default": "mcis01",
+                        "description": "MCIS ID",
+                        "name": "mcisId",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/mcis.TbMcisInfo"
+                            "$ref": "#/definitions/mcis.McisHealthPolicy"
                         }
                     },
                     "404": {
@@ -2025,11 +2050,9 @@ var doc = `{
                         }
                     }
                 }
-            }
-        },
-        "/ns/{nsId}/mcisRecommendVm": {
+            },
             "post": {
-                "description": "Recommend MCIS plan (filter and priority)",
+                "description": "Create MCIS health policy to check VMs (cspStatus, tcp, command) and remediate unhealthy VMs (reboot, replace)",
                 "consumes": [
                     "application/json"
                 ],
@@ -2037,24 +2060,33 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "[Infra service] MCIS Provisioning management"
+                    "[Infra service] MCIS Health policy management"
                 ],
-                "summary": "Recommend MCIS plan (filter and priority)",
+                "summary": "Create MCIS health policy",
                 "parameters": [
                     {
                         "type": "string",
-                        "default": "common",
+                        "default": "ns01",
                         "description": "Namespace ID",
                         "name": "nsId",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Recommend MCIS plan (filter and priority)",
-                        "name": "deploymentPlan",
+                        "type": "string",
+                        "default": "mcis01",
+                        "description": "MCIS ID",
+                        "name": "mcisId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Details for MCIS health policy",
+                        "name": "healthPolicy",
                         "in": "body",
+                        "required": true,
                         "schema": {
-                            "$ref": "#/definitions/mcis.DeploymentPlan"
+                            "$ref": "#/definitions/mcis.McisHealthPolicy"
                         }
                     }
                 ],
@@ -2062,10 +2094,7 @@ var doc = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/mcir.TbSpecInfo"
-                            }
+                            "$ref": "#/definitions/mcis.McisHealthPolicy"
                         }
                     },
                     "404": {
@@ -2081,11 +2110,9 @@ var doc = `{
                         }
                     }
                 }
-            }
-        },
-        "/ns/{nsId}/monitoring/install/mcis/{mcisId}": {
-            "post": {
-                "description": "Install monitoring agent (CB-Dragonfly agent) to MCIS",
+            },
+            "delete": {
+                "description": "Delete MCIS health policy with remediation logs",
                 "consumes": [
                     "application/json"
                 ],
@@ -2093,9 +2120,9 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "[Infra service] MCIS Resource monitor (for developer)"
+                    "[Infra service] MCIS Health policy management"
                 ],
-                "summary": "Install monitoring agent (CB-Dragonfly agent) to MCIS",
+                "summary": "Delete MCIS health policy",
                 "parameters": [
                     {
                         "type": "string",
@@ -2112,22 +2139,13 @@ var doc = `{
                         "name": "mcisId",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Details for an MCIS object",
-                        "name": "mcisInfo",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/mcis.McisCmdReq"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/mcis.AgentInstallContentWrapper"
+                            "$ref": "#/definitions/common.SimpleMsg"
                         }
                     },
                     "404": {
@@ -2135,19 +2153,13 @@ var doc = `{
                         "schema": {
                             "$ref": "#/definitions/common.SimpleMsg"
                         }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/common.SimpleMsg"
-                        }
                     }
                 }
             }
         },
-        "/ns/{nsId}/monitoring/mcis/{mcisId}/metric/{metric}": {
+        "/ns/{nsId}/healthPolicy/mcis/{mcisId}/log": {
             "get": {
-                "description": "Get monitoring data of specified MCIS for specified monitoring metric (cpu, memory, disk, network)",
+                "description": "List remediation logs (reboot, replace) of MCIS health policy (latest first)",
                 "consumes": [
                     "application/json"
                 ],
@@ -2155,9 +2167,9 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "[Infra service] MCIS Resource monitor (for developer)"
+                    "[Infra service] MCIS Health policy management"
                 ],
-                "summary": "Get monitoring data of specified MCIS for specified monitoring metric (cpu, memory, disk, network)",
+                "summary": "List remediation logs of MCIS health policy",
                 "parameters": [
                     {
                         "type": "string",
@@ -2174,20 +2186,13 @@ var doc = `{
                         "name": "mcisId",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Metric type: cpu, memory, disk, network",
-                        "name": "metric",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/mcis.MonResultSimpleResponse"
+                            "$ref": "#/definitions/mcis.RestGetMcisHealthLogResponse"
                         }
                     },
                     "404": {
@@ -2205,9 +2210,9 @@ var doc = `{
                 }
             }
         },
-        "/ns/{nsId}/policy/mcis": {
-            "get": {
-                "description": "List all MCIS policies",
+        "/ns/{nsId}/installBenchmarkAgent/mcis/{mcisId}": {
+            "post": {
+                "description": "Install the benchmark agent to specified MCIS",
                 "consumes": [
                     "application/json"
                 ],
@@ -2215,9 +2220,9 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "[Infra service] MCIS Auto control policy management (WIP)"
+                    "[Infra service] MCIS Performance benchmarking (WIP)"
                 ],
-                "summary": "List all MCIS policies",
+                "summary": "Install the benchmark agent to specified MCIS",
                 "parameters": [
                     {
                         "type": "string",
@@ -2226,13 +2231,30 @@ var doc = `{
                         "name": "nsId",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "mcis01",
+                        "description": "MCIS ID",
+                        "name": "mcisId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "MCIS Command Request",
+                        "name": "mcisCmdReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/mcis.McisCmdReq"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/mcis.RestGetAllMcisPolicyResponse"
+                            "$ref": "#/definitions/mcis.RestPostCmdMcisResponseWrapper"
                         }
                     },
                     "404": {
@@ -2248,9 +2270,11 @@ var doc = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Delete all MCIS policies",
+            }
+        },
+        "/ns/{nsId}/loadDefaultResource": {
+            "get": {
+                "description": "Load Default Resource from internal asset file",
                 "consumes": [
                     "application/json"
                 ],
@@ -2258,9 +2282,9 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "[Infra service] MCIS Auto control policy management (WIP)"
+                    "[Infra resource] MCIR Common"
                 ],
-                "summary": "Delete all MCIS policies",
+                "summary": "Load Default Resource from internal asset file",
                 "parameters": [
                     {
                         "type": "string",
@@ -2269,6 +2293,26 @@ var doc = `{
                         "name": "nsId",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "enum": [
+                            "all",
+                            "vnet",
+                            "sg",
+                            "sshkey"
+                        ],
+                        "type": "string",
+                        "description": "Option",
+                        "name": "option",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "",
+                        "description": "connectionName of cloud for designated resource",
+                        "name": "connectionName",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -2287,9 +2331,9 @@ var doc = `{
                 }
             }
         },
-        "/ns/{nsId}/policy/mcis/{mcisId}": {
+        "/ns/{nsId}/mcis": {
             "get": {
-                "description": "Get MCIS Policy",
+                "description": "List all MCISs or MCISs' ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -2297,9 +2341,9 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "[Infra service] MCIS Auto control policy management (WIP)"
+                    "[Infra service] MCIS Provisioning management"
                 ],
-                "summary": "Get MCIS Policy",
+                "summary": "List all MCISs or MCISs' ID",
                 "parameters": [
                     {
                         "type": "string",
@@ -2310,19 +2354,52 @@ var doc = `{
                         "required": true
                     },
                     {
+                        "enum": [
+                            "id",
+                            "simple",
+                            "status"
+                        ],
+                        "type": "string",
+                        "description": "Option",
+                        "name": "option",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "true"
+                        ],
                         "type": "string",
-                        "default": "mcis01",
-                        "description": "MCIS ID",
-                        "name": "mcisId",
-                        "in": "path",
-                        "required": true
+                        "description": "Show decrypted secrets such as vmUserPassword (X-Secret-Token header is required)",
+                        "name": "showSecret",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Different return structures by the given option param",
                         "schema": {
-                            "$ref": "#/definitions/mcis.McisPolicyInfo"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/mcis.JSONResult"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "[DEFAULT]": {
+                                            "$ref": "#/definitions/mcis.RestGetAllMcisResponse"
+                                        },
+                                        "[ID]": {
+                                            "$ref": "#/definitions/common.IdList"
+                                        },
+                                        "[SIMPLE]": {
+                                            "$ref": "#/definitions/mcis.RestGetAllMcisResponse"
+                                        },
+                                        "[STATUS]": {
+                                            "$ref": "#/definitions/mcis.RestGetAllMcisStatusResponse"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "404": {
@@ -2340,7 +2417,7 @@ var doc = `{
                 }
             },
             "post": {
-                "description": "Create MCIS Automation policy",
+                "description": "Create MCIS",
                 "consumes": [
                     "application/json"
                 ],
@@ -2348,9 +2425,9 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "[Infra service] MCIS Auto control policy management (WIP)"
+                    "[Infra service] MCIS Provisioning management"
                 ],
-                "summary": "Create MCIS Automation policy",
+                "summary": "Create MCIS",
                 "parameters": [
                     {
                         "type": "string",
@@ -2360,21 +2437,13 @@ var doc = `{
                         "in": "path",
                         "required": true
                     },
-                    {
-                        "type": "string",
-                        "default": "mcis01",
-                        "description": "MCIS ID",
-                        "name": "mcisId",
-                        "in": "path",
-                        "required": true
-                    },
                     {
                         "description": "Details for an MCIS object",
-                        "name": "mcisInfo",
+                        "name": "mcisReq",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/mcis.McisPolicyInfo"
+                            "$ref": "#/definitions/mcis.TbMcisReq"
                         }
                     }
                 ],
@@ -2382,7 +2451,7 @@ var doc = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/mcis.McisPolicyInfo"
+                            "$ref": "#/definitions/mcis.TbMcisInfo"
                         }
                     },
                     "404": {
@@ -2400,7 +2469,7 @@ var doc = `{
                 }
             },
             "delete": {
-                "description": "Delete MCIS Policy",
+                "description": "Delete all MCISs",
                 "consumes": [
                     "application/json"
                 ],
@@ -2408,9 +2477,9 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "[Infra service] MCIS Auto control policy management (WIP)"
+                    "[Infra service] MCIS Provisioning management"
                 ],
-                "summary": "Delete MCIS Policy",
+                "summary": "Delete all MCISs",
                 "parameters": [
                     {
                         "type": "string",
@@ -2421,12 +2490,13 @@ var doc = `{
                         "required": true
                     },
                     {
+                        "enum": [
+                            "force"
+                        ],
                         "type": "string",
-                        "default": "mcis01",
-                        "description": "MCIS ID",
-                        "name": "mcisId",
-                        "in": "path",
-                        "required": true
+                        "description": "Option for delete MCIS (support force delete)",
+                        "name": "option",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -2445,9 +2515,9 @@ var doc = `{
                 }
             }
         },
-        "/ns/{nsId}/resources/fetchImages": {
+        "/ns/{nsId}/mcis/validate": {
             "post": {
-                "description": "Fetch images",
+                "description": "Pre-flight validation of MCIS request without creating it.\nChecks that spec, image, vNet, subnet, securityGroups and sshKey exist, belong to the connection of each VM,\nand that the architecture and OS of image are compatible with spec. All problems are returned at once.",
                 "consumes": [
                     "application/json"
                 ],
@@ -2455,9 +2525,9 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "[Infra resource] MCIR Image management"
+                    "[Infra service] MCIS Provisioning management"
                 ],
-                "summary": "Fetch images",
+                "summary": "Validate MCIS request",
                 "parameters": [
                     {
                         "type": "string",
@@ -2466,23 +2536,26 @@ var doc = `{
                         "name": "nsId",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Details for an MCIS object",
+                        "name": "mcisReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/mcis.TbMcisReq"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/common.SimpleMsg"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/common.SimpleMsg"
+                            "$ref": "#/definitions/mcis.TbValidationResult"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/common.SimpleMsg"
                         }
@@ -2490,9 +2563,9 @@ var doc = `{
                 }
             }
         },
-        "/ns/{nsId}/resources/fetchSpecs": {
-            "post": {
-                "description": "Fetch specs",
+        "/ns/{nsId}/mcis/{mcisId}": {
+            "get": {
+                "description": "Get MCIS object (option: status, vmID)",
                 "consumes": [
                     "application/json"
                 ],
@@ -2500,9 +2573,9 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "[Infra resource] MCIR Spec management"
+                    "[Infra service] MCIS Provisioning management"
                 ],
-                "summary": "Fetch specs",
+                "summary": "Get MCIS object (option: status, vmID)",
                 "parameters": [
                     {
                         "type": "string",
@@ -2511,13 +2584,59 @@ var doc = `{
                         "name": "nsId",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "mcis01",
+                        "description": "MCIS ID",
+                        "name": "mcisId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "default",
+                            "id",
+                            "status"
+                        ],
+                        "type": "string",
+                        "description": "Option",
+                        "name": "option",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "true"
+                        ],
+                        "type": "string",
+                        "description": "Show decrypted secrets such as vmUserPassword (X-Secret-Token header is required)",
+                        "name": "showSecret",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Different return structures by the given action param",
                         "schema": {
-                            "$ref": "#/definitions/common.SimpleMsg"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/mcis.JSONResult"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "[DEFAULT]": {
+                                            "$ref": "#/definitions/mcis.TbMcisInfo"
+                                        },
+                                        "[ID]": {
+                                            "$ref": "#/definitions/common.IdList"
+                                        },
+                                        "[STATUS]": {
+                                            "$ref": "#/definitions/mcis.McisStatusInfo"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "404": {
@@ -2533,11 +2652,9 @@ var doc = `{
                         }
                     }
                 }
-            }
-        },
-        "/ns/{nsId}/resources/filterSpecs": {
-            "post": {
-                "description": "Filter specs",
+            },
+            "delete": {
+                "description": "Delete MCIS",
                 "consumes": [
                     "application/json"
                 ],
@@ -2545,9 +2662,9 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "[Infra resource] MCIR Spec management"
+                    "[Infra service] MCIS Provisioning management"
                 ],
-                "summary": "Filter specs",
+                "summary": "Delete MCIS",
                 "parameters": [
                     {
                         "type": "string",
@@ -2558,19 +2675,29 @@ var doc = `{
                         "required": true
                     },
                     {
-                        "description": "Filter for filtering specs",
-                        "name": "specFilter",
-                        "in": "body",
-                        "schema": {
-                            "$ref": "#/definitions/mcir.TbSpecInfo"
-                        }
+                        "type": "string",
+                        "default": "mcis01",
+                        "description": "MCIS ID",
+                        "name": "mcisId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "terminate",
+                            "force"
+                        ],
+                        "type": "string",
+                        "description": "Option for delete MCIS (support force delete)",
+                        "name": "option",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/mcir.RestFilterSpecsResponse"
+                            "$ref": "#/definitions/common.SimpleMsg"
                         }
                     },
                     "404": {
@@ -2578,19 +2705,13 @@ var doc = `{
                         "schema": {
                             "$ref": "#/definitions/common.SimpleMsg"
                         }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/common.SimpleMsg"
-                        }
                     }
                 }
             }
         },
-        "/ns/{nsId}/resources/filterSpecsByRange": {
+        "/ns/{nsId}/mcis/{mcisId}/vm": {
             "post": {
-                "description": "Filter specs by range",
+                "description": "Create VM in specified MCIS",
                 "consumes": [
                     "application/json"
                 ],
@@ -2598,9 +2719,9 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "[Infra resource] MCIR Spec management"
+                    "[Infra service] MCIS Provisioning management"
                 ],
-                "summary": "Filter specs by range",
+                "summary": "Create VM in specified MCIS",
                 "parameters": [
                     {
                         "type": "string",
@@ -2611,11 +2732,20 @@ var doc = `{
                         "required": true
                     },
                     {
-                        "description": "Filter for range-filtering specs",
-                        "name": "specRangeFilter",
+                        "type": "string",
+                        "default": "mcis01",
+                        "description": "MCIS ID",
+                        "name": "mcisId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Details for an VM object",
+                        "name": "vmReq",
                         "in": "body",
+                        "required": true,
                         "schema": {
-                            "$ref": "#/definitions/mcir.FilterSpecsByRangeRequest"
+                            "$ref": "#/definitions/mcis.TbVmReq"
                         }
                     }
                 ],
@@ -2623,7 +2753,7 @@ var doc = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/mcir.RestFilterSpecsResponse"
+                            "$ref": "#/definitions/mcis.TbVmInfo"
                         }
                     },
                     "404": {
@@ -2641,9 +2771,9 @@ var doc = `{
                 }
             }
         },
-        "/ns/{nsId}/resources/image": {
+        "/ns/{nsId}/mcis/{mcisId}/vm/{vmId}": {
             "get": {
-                "description": "List all images or images' ID",
+                "description": "Get VM in specified MCIS",
                 "consumes": [
                     "application/json"
                 ],
@@ -2651,9 +2781,9 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "[Infra resource] MCIR Image management"
+                    "[Infra service] MCIS Provisioning management"
                 ],
-                "summary": "List all images or images' ID",
+                "summary": "Get VM in specified MCIS",
                 "parameters": [
                     {
                         "type": "string",
@@ -2663,14 +2793,40 @@ var doc = `{
                         "in": "path",
                         "required": true
                     },
+                    {
+                        "type": "string",
+                        "default": "mcis01",
+                        "description": "MCIS ID",
+                        "name": "mcisId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "vm01",
+                        "description": "VM ID",
+                        "name": "vmId",
+                        "in": "path",
+                        "required": true
+                    },
                     {
                         "enum": [
-                            "id"
+                            "default",
+                            "status"
                         ],
                         "type": "string",
-                        "description": "Option",
+                        "description": "Option for MCIS",
                         "name": "option",
                         "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "true"
+                        ],
+                        "type": "string",
+                        "description": "Show decrypted secrets such as vmUserPassword (X-Secret-Token header is required)",
+                        "name": "showSecret",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -2679,16 +2835,16 @@ var doc = `{
                         "schema": {
                             "allOf": [
                                 {
-                                    "$ref": "#/definitions/mcir.JSONResult"
+                                    "$ref": "#/definitions/mcis.JSONResult"
                                 },
                                 {
                                     "type": "object",
                                     "properties": {
                                         "[DEFAULT]": {
-                                            "$ref": "#/definitions/mcir.RestGetAllImageResponse"
+                                            "$ref": "#/definitions/mcis.TbVmInfo"
                                         },
-                                        "[ID]": {
-                                            "$ref": "#/definitions/common.IdList"
+                                        "[STATUS]": {
+                                            "$ref": "#/definitions/mcis.TbVmStatusInfo"
                                         }
                                     }
                                 }
@@ -2709,8 +2865,8 @@ var doc = `{
                     }
                 }
             },
-            "post": {
-                "description": "Register image",
+            "delete": {
+                "description": "Delete VM in specified MCIS",
                 "consumes": [
                     "application/json"
                 ],
@@ -2718,47 +2874,49 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "[Infra resource] MCIR Image management"
+                    "[Infra service] MCIS Provisioning management"
                 ],
-                "summary": "Register image",
+                "summary": "Delete VM in specified MCIS",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "registerWithInfo or registerWithId",
-                        "name": "registeringMethod",
-                        "in": "query",
+                        "default": "ns01",
+                        "description": "Namespace ID",
+                        "name": "nsId",
+                        "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "default": "ns01",
-                        "description": "Namespace ID",
-                        "name": "nsId",
+                        "default": "mcis01",
+                        "description": "MCIS ID",
+                        "name": "mcisId",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Details for an image object",
-                        "name": "imageInfo",
-                        "in": "body",
-                        "schema": {
-                            "$ref": "#/definitions/mcir.TbImageInfo"
-                        }
+                        "type": "string",
+                        "default": "vm01",
+                        "description": "VM ID",
+                        "name": "vmId",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "description": "name, connectionName and cspImageId",
-                        "name": "imageId",
-                        "in": "body",
-                        "schema": {
-                            "$ref": "#/definitions/mcir.TbImageReq"
-                        }
+                        "enum": [
+                            "force"
+                        ],
+                        "type": "string",
+                        "description": "Option for delete VM (support force delete)",
+                        "name": "option",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/mcir.TbImageInfo"
+                            "$ref": "#/definitions/common.SimpleMsg"
                         }
                     },
                     "404": {
@@ -2766,17 +2924,13 @@ var doc = `{
                         "schema": {
                             "$ref": "#/definitions/common.SimpleMsg"
                         }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/common.SimpleMsg"
-                        }
                     }
                 }
-            },
-            "delete": {
-                "description": "Delete all images",
+            }
+        },
+        "/ns/{nsId}/mcis/{mcisId}/vmgroup": {
+            "post": {
+                "description": "Create multiple VMs by VM group in specified MCIS",
                 "consumes": [
                     "application/json"
                 ],
@@ -2784,9 +2938,9 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "[Infra resource] MCIR Image management"
+                    "[Infra service] MCIS Provisioning management"
                 ],
-                "summary": "Delete all images",
+                "summary": "Create multiple VMs by VM group in specified MCIS",
                 "parameters": [
                     {
                         "type": "string",
@@ -2798,17 +2952,27 @@ var doc = `{
                     },
                     {
                         "type": "string",
-                        "default": "",
-                        "description": "Delete resources containing matched ID-substring only",
-                        "name": "match",
-                        "in": "query"
+                        "default": "mcis01",
+                        "description": "MCIS ID",
+                        "name": "mcisId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Details for VM Group",
+                        "name": "vmReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/mcis.TbVmReq"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/common.IdList"
+                            "$ref": "#/definitions/mcis.TbMcisInfo"
                         }
                     },
                     "404": {
@@ -2816,13 +2980,19 @@ var doc = `{
                         "schema": {
                             "$ref": "#/definitions/common.SimpleMsg"
                         }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/common.SimpleMsg"
+                        }
                     }
                 }
             }
         },
-        "/ns/{nsId}/resources/image/{imageId}": {
-            "get": {
-                "description": "Get image",
+        "/ns/{nsId}/mcisDynamic": {
+            "post": {
+                "description": "Create MCIS Dynamically from common spec and image",
                 "consumes": [
                     "application/json"
                 ],
@@ -2830,9 +3000,9 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "[Infra resource] MCIR Image management"
+                    "[Infra service] MCIS Provisioning management"
                 ],
-                "summary": "Get image",
+                "summary": "Create MCIS Dynamically",
                 "parameters": [
                     {
                         "type": "string",
@@ -2843,18 +3013,20 @@ var doc = `{
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "Image ID",
-                        "name": "imageId",
-                        "in": "path",
-                        "required": true
+                        "description": "Details for MCIS object",
+                        "name": "mcisReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/mcis.TbMcisDynamicReq"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/mcir.TbImageInfo"
+                            "$ref": "#/definitions/mcis.TbMcisInfo"
                         }
                     },
                     "404": {
@@ -2870,9 +3042,11 @@ var doc = `{
                         }
                     }
                 }
-            },
-            "put": {
-                "description": "Update image",
+            }
+        },
+        "/ns/{nsId}/mcisRecommend": {
+            "post": {
+                "description": "Recommend specs for all VM requirements jointly under MCIS constraints (budget per hour, min number of providers, same region).\nIt returns a ready-to-submit request for POST /ns/{nsId}/mcisDynamic. MCIS will be created if deploy=true.",
                 "consumes": [
                     "application/json"
                 ],
@@ -2880,19 +3054,10 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "[Infra resource] MCIR Image management"
+                    "[Infra service] MCIS Provisioning management"
                 ],
-                "summary": "Update image",
+                "summary": "Recommend MCIS plan for multiple VM requirements",
                 "parameters": [
-                    {
-                        "description": "Details for an image object",
-                        "name": "imageInfo",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/mcir.TbImageInfo"
-                        }
-                    },
                     {
                         "type": "string",
                         "default": "ns01",
@@ -2902,18 +3067,27 @@ var doc = `{
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "Image ID",
-                        "name": "imageId",
-                        "in": "path",
-                        "required": true
+                        "description": "VM requirements and MCIS constraints",
+                        "name": "mcisRecommendReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/mcis.McisRecommendPlanReq"
+                        }
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Create MCIS with the recommended plan",
+                        "name": "deploy",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/mcir.TbImageInfo"
+                            "$ref": "#/definitions/mcis.McisRecommendPlanInfo"
                         }
                     },
                     "404": {
@@ -2929,9 +3103,11 @@ var doc = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Delete image",
+            }
+        },
+        "/ns/{nsId}/mcisRecommendVm": {
+            "post": {
+                "description": "Recommend MCIS plan (filter and priority). Specs are ranked by the weighted sum of normalized priority metrics (evaluationTotalScore) with a per-metric breakdown (evaluationBreakdown). Specs without price get 0 for the cost metric.",
                 "consumes": [
                     "application/json"
                 ],
@@ -2939,31 +3115,35 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "[Infra resource] MCIR Image management"
+                    "[Infra service] MCIS Provisioning management"
                 ],
-                "summary": "Delete image",
+                "summary": "Recommend MCIS plan (filter and priority)",
                 "parameters": [
                     {
                         "type": "string",
-                        "default": "ns01",
+                        "default": "common",
                         "description": "Namespace ID",
                         "name": "nsId",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "Image ID",
-                        "name": "imageId",
-                        "in": "path",
-                        "required": true
+                        "description": "Recommend MCIS plan (filter and priority)",
+                        "name": "deploymentPlan",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/mcis.DeploymentPlan"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/common.SimpleMsg"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/mcir.TbSpecInfo"
+                            }
                         }
                     },
                     "404": {
@@ -2971,13 +3151,19 @@ var doc = `{
                         "schema": {
                             "$ref": "#/definitions/common.SimpleMsg"
                         }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/common.SimpleMsg"
+                        }
                     }
                 }
             }
         },
-        "/ns/{nsId}/resources/searchImage": {
+        "/ns/{nsId}/monitoring/install/mcis/{mcisId}": {
             "post": {
-                "description": "Search image",
+                "description": "Install monitoring agent (CB-Dragonfly agent) to MCIS",
                 "consumes": [
                     "application/json"
                 ],
@@ -2985,9 +3171,9 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "[Infra resource] MCIR Image management"
+                    "[Infra service] MCIS Resource monitor (for developer)"
                 ],
-                "summary": "Search image",
+                "summary": "Install monitoring agent (CB-Dragonfly agent) to MCIS",
                 "parameters": [
                     {
                         "type": "string",
@@ -2998,12 +3184,20 @@ var doc = `{
                         "required": true
                     },
                     {
-                        "description": "Keywords",
-                        "name": "keywords",
+                        "type": "string",
+                        "default": "mcis01",
+                        "description": "MCIS ID",
+                        "name": "mcisId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Details for an MCIS object",
+                        "name": "mcisInfo",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/mcir.RestSearchImageRequest"
+                            "$ref": "#/definitions/mcis.McisCmdReq"
                         }
                     }
                 ],
@@ -3011,7 +3205,7 @@ var doc = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/mcir.RestGetAllImageResponse"
+                            "$ref": "#/definitions/mcis.AgentInstallContentWrapper"
                         }
                     },
                     "404": {
@@ -3029,9 +3223,9 @@ var doc = `{
                 }
             }
         },
-        "/ns/{nsId}/resources/securityGroup": {
+        "/ns/{nsId}/monitoring/mcis/{mcisId}/metric/{metric}": {
             "get": {
-                "description": "List all Security Groups or Security Groups' ID",
+                "description": "Get monitoring data of specified MCIS for specified monitoring metric (cpu, memory, disk, network). For VMs without the monitoring agent (monAgentStatus: notInstalled), the latest sample of the agentless (SSH) collector is returned.\nWith from, to, step or agg, retained samples in the time range are returned as mcis.MonRangeResponse (per-VM time series and MCIS-level aggregates). Samples are retained by the agentless collector periodically (VMs without the agent) and only by queries of the monitoring agent otherwise.",
                 "consumes": [
                     "application/json"
                 ],
@@ -3039,9 +3233,9 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "[Infra resource] MCIR Security group management"
+                    "[Infra service] MCIS Resource monitor (for developer)"
                 ],
-                "summary": "List all Security Groups or Security Groups' ID",
+                "summary": "Get monitoring data of specified MCIS for specified monitoring metric (cpu, memory, disk, network)",
                 "parameters": [
                     {
                         "type": "string",
@@ -3051,36 +3245,58 @@ var doc = `{
                         "in": "path",
                         "required": true
                     },
+                    {
+                        "type": "string",
+                        "default": "mcis01",
+                        "description": "MCIS ID",
+                        "name": "mcisId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Metric type: cpu, memory, disk, network (load is available for the agentless collector)",
+                        "name": "metric",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Start of the range: RFC3339, Unix time or relative to now (default: -1h)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "End of the range: RFC3339, Unix time or relative to now (default: now)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Step of points: duration (5m) or seconds (default: about 100 points)",
+                        "name": "step",
+                        "in": "query"
+                    },
                     {
                         "enum": [
-                            "id"
+                            "avg",
+                            "min",
+                            "max",
+                            "sum",
+                            "count"
                         ],
                         "type": "string",
-                        "description": "Option",
-                        "name": "option",
+                        "description": "Aggregation of samples of each VM in a step",
+                        "name": "agg",
                         "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Different return structures by the given option param",
+                        "description": "OK",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/mcir.JSONResult"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "[DEFAULT]": {
-                                            "$ref": "#/definitions/mcir.RestGetAllSecurityGroupResponse"
-                                        },
-                                        "[ID]": {
-                                            "$ref": "#/definitions/common.IdList"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/mcis.MonResultSimpleResponse"
                         }
                     },
                     "404": {
@@ -3096,9 +3312,11 @@ var doc = `{
                         }
                     }
                 }
-            },
-            "post": {
-                "description": "Create Security Group",
+            }
+        },
+        "/ns/{nsId}/monitoring/mcis/{mcisId}/metric/{metric}/forecast": {
+            "get": {
+                "description": "Forecast hourly values of the metric (by the monitoring samples retained from previous queries and the collector) with the backtest accuracy of the model",
                 "consumes": [
                     "application/json"
                 ],
@@ -3106,9 +3324,9 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "[Infra resource] MCIR Security group management"
+                    "[Infra service] MCIS Resource monitor (for developer)"
                 ],
-                "summary": "Create Security Group",
+                "summary": "Get forecasts of specified MCIS for specified monitoring metric with the accuracy of the model",
                 "parameters": [
                     {
                         "type": "string",
@@ -3119,20 +3337,51 @@ var doc = `{
                         "required": true
                     },
                     {
-                        "description": "Details for an securityGroup object",
-                        "name": "securityGroupReq",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/mcir.TbSecurityGroupReq"
-                        }
+                        "type": "string",
+                        "default": "mcis01",
+                        "description": "MCIS ID",
+                        "name": "mcisId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Metric type: cpu, memory, disk, network",
+                        "name": "metric",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "holtWinters",
+                            "lastWeek"
+                        ],
+                        "type": "string",
+                        "default": "holtWinters",
+                        "description": "Forecast model",
+                        "name": "model",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 24,
+                        "description": "Length of season in hours (for holtWinters)",
+                        "name": "seasonLength",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 24,
+                        "description": "Number of hours to forecast",
+                        "name": "horizon",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/mcir.TbSecurityGroupInfo"
+                            "$ref": "#/definitions/mcis.McisMetricForecast"
                         }
                     },
                     "404": {
@@ -3148,9 +3397,11 @@ var doc = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Delete all Security Groups",
+            }
+        },
+        "/ns/{nsId}/policy/mcis": {
+            "get": {
+                "description": "List all MCIS policies",
                 "consumes": [
                     "application/json"
                 ],
@@ -3158,9 +3409,9 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "[Infra resource] MCIR Security group management"
+                    "[Infra service] MCIS Auto control policy management (WIP)"
                 ],
-                "summary": "Delete all Security Groups",
+                "summary": "List all MCIS policies",
                 "parameters": [
                     {
                         "type": "string",
@@ -3171,10 +3422,12 @@ var doc = `{
                         "required": true
                     },
                     {
+                        "enum": [
+                            "true"
+                        ],
                         "type": "string",
-                        "default": "",
-                        "description": "Delete resources containing matched ID-substring only",
-                        "name": "match",
+                        "description": "Show decrypted secrets such as vmUserPassword (X-Secret-Token header is required)",
+                        "name": "showSecret",
                         "in": "query"
                     }
                 ],
@@ -3182,53 +3435,7 @@ var doc = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/common.IdList"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/common.SimpleMsg"
-                        }
-                    }
-                }
-            }
-        },
-        "/ns/{nsId}/resources/securityGroup/{securityGroupId}": {
-            "get": {
-                "description": "Get Security Group",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "[Infra resource] MCIR Security group management"
-                ],
-                "summary": "Get Security Group",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "default": "ns01",
-                        "description": "Namespace ID",
-                        "name": "nsId",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Security Group ID",
-                        "name": "securityGroupId",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/mcir.TbSecurityGroupInfo"
+                            "$ref": "#/definitions/mcis.RestGetAllMcisPolicyResponse"
                         }
                     },
                     "404": {
@@ -3246,7 +3453,7 @@ var doc = `{
                 }
             },
             "delete": {
-                "description": "Delete Security Group",
+                "description": "Delete all MCIS policies",
                 "consumes": [
                     "application/json"
                 ],
@@ -3254,9 +3461,9 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "[Infra resource] MCIR Security group management"
+                    "[Infra service] MCIS Auto control policy management (WIP)"
                 ],
-                "summary": "Delete Security Group",
+                "summary": "Delete all MCIS policies",
                 "parameters": [
                     {
                         "type": "string",
@@ -3265,13 +3472,6 @@ var doc = `{
                         "name": "nsId",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Security Group ID",
-                        "name": "securityGroupId",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
@@ -3290,9 +3490,9 @@ var doc = `{
                 }
             }
         },
-        "/ns/{nsId}/resources/spec": {
+        "/ns/{nsId}/policy/mcis/{mcisId}": {
             "get": {
-                "description": "List all specs or specs' ID",
+                "description": "Get MCIS Policy",
                 "consumes": [
                     "application/json"
                 ],
@@ -3300,9 +3500,9 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "[Infra resource] MCIR Spec management"
+                    "[Infra service] MCIS Auto control policy management (WIP)"
                 ],
-                "summary": "List all specs or specs' ID",
+                "summary": "Get MCIS Policy",
                 "parameters": [
                     {
                         "type": "string",
@@ -3312,36 +3512,29 @@ var doc = `{
                         "in": "path",
                         "required": true
                     },
+                    {
+                        "type": "string",
+                        "default": "mcis01",
+                        "description": "MCIS ID",
+                        "name": "mcisId",
+                        "in": "path",
+                        "required": true
+                    },
                     {
                         "enum": [
-                            "id"
+                            "true"
                         ],
                         "type": "string",
-                        "description": "Option",
-                        "name": "option",
+                        "description": "Show decrypted secrets such as vmUserPassword (X-Secret-Token header is required)",
+                        "name": "showSecret",
                         "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Different return structures by the given option param",
+                        "description": "OK",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/mcir.JSONResult"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "[DEFAULT]": {
-                                            "$ref": "#/definitions/mcir.RestGetAllSpecResponse"
-                                        },
-                                        "[ID]": {
-                                            "$ref": "#/definitions/common.IdList"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/mcis.McisPolicyInfo"
                         }
                     },
                     "404": {
@@ -3359,7 +3552,7 @@ var doc = `{
                 }
             },
             "post": {
-                "description": "Register spec",
+                "description": "Create MCIS Automation policy",
                 "consumes": [
                     "application/json"
                 ],
@@ -3367,17 +3560,10 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "[Infra resource] MCIR Spec management"
+                    "[Infra service] MCIS Auto control policy management (WIP)"
                 ],
-                "summary": "Register spec",
+                "summary": "Create MCIS Automation policy",
                 "parameters": [
-                    {
-                        "type": "string",
-                        "description": "registerWithInfo or else",
-                        "name": "registeringMethod",
-                        "in": "query",
-                        "required": true
-                    },
                     {
                         "type": "string",
                         "default": "ns01",
@@ -3387,19 +3573,20 @@ var doc = `{
                         "required": true
                     },
                     {
-                        "description": "Details for an spec object",
-                        "name": "specInfo",
-                        "in": "body",
-                        "schema": {
-                            "$ref": "#/definitions/mcir.TbSpecInfo"
-                        }
+                        "type": "string",
+                        "default": "mcis01",
+                        "description": "MCIS ID",
+                        "name": "mcisId",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "description": "name, connectionName and cspSpecName",
-                        "name": "specName",
+                        "description": "Details for an MCIS object",
+                        "name": "mcisInfo",
                         "in": "body",
+                        "required": true,
                         "schema": {
-                            "$ref": "#/definitions/mcir.TbSpecReq"
+                            "$ref": "#/definitions/mcis.McisPolicyInfo"
                         }
                     }
                 ],
@@ -3407,7 +3594,7 @@ var doc = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/mcir.TbSpecInfo"
+                            "$ref": "#/definitions/mcis.McisPolicyInfo"
                         }
                     },
                     "404": {
@@ -3425,7 +3612,7 @@ var doc = `{
                 }
             },
             "delete": {
-                "description": "Delete all specs",
+                "description": "Delete MCIS Policy",
                 "consumes": [
                     "application/json"
                 ],
@@ -3433,9 +3620,9 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "[Infra resource] MCIR Spec management"
+                    "[Infra service] MCIS Auto control policy management (WIP)"
                 ],
-                "summary": "Delete all specs",
+                "summary": "Delete MCIS Policy",
                 "parameters": [
                     {
                         "type": "string",
@@ -3447,17 +3634,18 @@ var doc = `{
                     },
                     {
                         "type": "string",
-                        "default": "",
-                        "description": "Delete resources containing matched ID-substring only",
-                        "name": "match",
-                        "in": "query"
+                        "default": "mcis01",
+                        "description": "MCIS ID",
+                        "name": "mcisId",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/common.IdList"
+                            "$ref": "#/definitions/common.SimpleMsg"
                         }
                     },
                     "404": {
@@ -3469,9 +3657,9 @@ var doc = `{
                 }
             }
         },
-        "/ns/{nsId}/resources/spec/{specId}": {
+        "/ns/{nsId}/policy/mcis/{mcisId}/history": {
             "get": {
-                "description": "Get spec",
+                "description": "Get history records (actions and condition evaluations whose decision is changed, with observed metric values, decisions, VMs and errors) of MCIS Policy in the latest first order",
                 "consumes": [
                     "application/json"
                 ],
@@ -3479,9 +3667,9 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "[Infra resource] MCIR Spec management"
+                    "[Infra service] MCIS Auto control policy management (WIP)"
                 ],
-                "summary": "Get spec",
+                "summary": "Get history of MCIS Policy",
                 "parameters": [
                     {
                         "type": "string",
@@ -3493,17 +3681,32 @@ var doc = `{
                     },
                     {
                         "type": "string",
-                        "description": "Spec ID",
-                        "name": "specId",
+                        "default": "mcis01",
+                        "description": "MCIS ID",
+                        "name": "mcisId",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Number of records to skip",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 100,
+                        "description": "Max number of records to return (max 1000)",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/mcir.TbSpecInfo"
+                            "$ref": "#/definitions/mcis.McisPolicyHistoryList"
                         }
                     },
                     "404": {
@@ -3519,9 +3722,11 @@ var doc = `{
                         }
                     }
                 }
-            },
-            "put": {
-                "description": "Update spec",
+            }
+        },
+        "/ns/{nsId}/pricingPolicy": {
+            "get": {
+                "description": "Get pricing policy (pricing model, OS type, currency) to get CostPerHour of specs for the namespace",
                 "consumes": [
                     "application/json"
                 ],
@@ -3531,17 +3736,8 @@ var doc = `{
                 "tags": [
                     "[Infra resource] MCIR Spec management"
                 ],
-                "summary": "Update spec",
+                "summary": "Get pricing policy of namespace",
                 "parameters": [
-                    {
-                        "description": "Details for an spec object",
-                        "name": "specInfo",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/mcir.TbSpecInfo"
-                        }
-                    },
                     {
                         "type": "string",
                         "default": "ns01",
@@ -3549,26 +3745,13 @@ var doc = `{
                         "name": "nsId",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Spec ID",
-                        "name": "specId",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/mcir.TbSpecInfo"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/common.SimpleMsg"
+                            "$ref": "#/definitions/mcir.TbPricingPolicy"
                         }
                     },
                     "500": {
@@ -3579,8 +3762,8 @@ var doc = `{
                     }
                 }
             },
-            "delete": {
-                "description": "Delete spec",
+            "put": {
+                "description": "Set pricing policy (pricing model, OS type, currency) to get CostPerHour of specs for the namespace.\nRecommendation in the namespace uses the prices by the policy (onDemand price is used if the spec has no price in the model).",
                 "consumes": [
                     "application/json"
                 ],
@@ -3590,7 +3773,7 @@ var doc = `{
                 "tags": [
                     "[Infra resource] MCIR Spec management"
                 ],
-                "summary": "Delete spec",
+                "summary": "Set pricing policy of namespace",
                 "parameters": [
                     {
                         "type": "string",
@@ -3601,22 +3784,24 @@ var doc = `{
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "Spec ID",
-                        "name": "specId",
-                        "in": "path",
-                        "required": true
+                        "description": "Pricing policy",
+                        "name": "pricingPolicy",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/mcir.TbPricingPolicy"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/common.SimpleMsg"
+                            "$ref": "#/definitions/mcir.TbPricingPolicy"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/common.SimpleMsg"
                         }
@@ -3624,9 +3809,9 @@ var doc = `{
                 }
             }
         },
-        "/ns/{nsId}/resources/sshKey": {
-            "get": {
-                "description": "List all SSH Keys or SSH Keys' ID",
+        "/ns/{nsId}/resources/fetchImages": {
+            "post": {
+                "description": "Fetch images",
                 "consumes": [
                     "application/json"
                 ],
@@ -3634,9 +3819,9 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "[Infra resource] MCIR Access key management"
+                    "[Infra resource] MCIR Image management"
                 ],
-                "summary": "List all SSH Keys or SSH Keys' ID",
+                "summary": "Fetch images",
                 "parameters": [
                     {
                         "type": "string",
@@ -3645,37 +3830,13 @@ var doc = `{
                         "name": "nsId",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "enum": [
-                            "id"
-                        ],
-                        "type": "string",
-                        "description": "Option",
-                        "name": "option",
-                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Different return structures by the given option param",
+                        "description": "OK",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/mcir.JSONResult"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "[DEFAULT]": {
-                                            "$ref": "#/definitions/mcir.RestGetAllSshKeyResponse"
-                                        },
-                                        "[ID]": {
-                                            "$ref": "#/definitions/common.IdList"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/common.SimpleMsg"
                         }
                     },
                     "404": {
@@ -3691,9 +3852,11 @@ var doc = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/ns/{nsId}/resources/fetchSpecs": {
             "post": {
-                "description": "Create SSH Key",
+                "description": "Fetch specs",
                 "consumes": [
                     "application/json"
                 ],
@@ -3701,9 +3864,9 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "[Infra resource] MCIR Access key management"
+                    "[Infra resource] MCIR Spec management"
                 ],
-                "summary": "Create SSH Key",
+                "summary": "Fetch specs",
                 "parameters": [
                     {
                         "type": "string",
@@ -3712,22 +3875,13 @@ var doc = `{
                         "name": "nsId",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Details for an SSH Key object",
-                        "name": "sshKeyInfo",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/mcir.TbSshKeyReq"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/mcir.TbSshKeyInfo"
+                            "$ref": "#/definitions/common.SimpleMsg"
                         }
                     },
                     "404": {
@@ -3743,55 +3897,11 @@ var doc = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Delete all SSH Keys",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "[Infra resource] MCIR Access key management"
-                ],
-                "summary": "Delete all SSH Keys",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "default": "ns01",
-                        "description": "Namespace ID",
-                        "name": "nsId",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "default": "",
-                        "description": "Delete resources containing matched ID-substring only",
-                        "name": "match",
-                        "in": "query"
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/common.IdList"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/common.SimpleMsg"
-                        }
-                    }
-                }
             }
         },
-        "/ns/{nsId}/resources/sshKey/{sshKeyId}": {
-            "get": {
-                "description": "Get SSH Key",
+        "/ns/{nsId}/resources/filterSpecs": {
+            "post": {
+                "description": "Filter specs",
                 "consumes": [
                     "application/json"
                 ],
@@ -3799,9 +3909,9 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "[Infra resource] MCIR Access key management"
+                    "[Infra resource] MCIR Spec management"
                 ],
-                "summary": "Get SSH Key",
+                "summary": "Filter specs",
                 "parameters": [
                     {
                         "type": "string",
@@ -3812,18 +3922,19 @@ var doc = `{
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "SSH Key ID",
-                        "name": "sshKeyId",
-                        "in": "path",
-                        "required": true
+                        "description": "Filter for filtering specs",
+                        "name": "specFilter",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/mcir.TbSpecInfo"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/mcir.TbSshKeyInfo"
+                            "$ref": "#/definitions/mcir.RestFilterSpecsResponse"
                         }
                     },
                     "404": {
@@ -3839,9 +3950,11 @@ var doc = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Delete SSH Key",
+            }
+        },
+        "/ns/{nsId}/resources/filterSpecsByRange": {
+            "post": {
+                "description": "Filter specs by range",
                 "consumes": [
                     "application/json"
                 ],
@@ -3849,9 +3962,9 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "[Infra resource] MCIR Access key management"
+                    "[Infra resource] MCIR Spec management"
                 ],
-                "summary": "Delete SSH Key",
+                "summary": "Filter specs by range",
                 "parameters": [
                     {
                         "type": "string",
@@ -3862,18 +3975,19 @@ var doc = `{
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "SSH Key ID",
-                        "name": "sshKeyId",
-                        "in": "path",
-                        "required": true
+                        "description": "Filter for range-filtering specs",
+                        "name": "specRangeFilter",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/mcir.FilterSpecsByRangeRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/common.SimpleMsg"
+                            "$ref": "#/definitions/mcir.RestFilterSpecsResponse"
                         }
                     },
                     "404": {
@@ -3881,13 +3995,19 @@ var doc = `{
                         "schema": {
                             "$ref": "#/definitions/common.SimpleMsg"
                         }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/common.SimpleMsg"
+                        }
                     }
                 }
             }
         },
-        "/ns/{nsId}/resources/vNet": {
+        "/ns/{nsId}/resources/image": {
             "get": {
-                "description": "List all VNets or VNets' ID",
+                "description": "List all images or images' ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -3895,9 +4015,9 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "[Infra resource] MCIR Network management"
+                    "[Infra resource] MCIR Image management"
                 ],
-                "summary": "List all VNets or VNets' ID",
+                "summary": "List all images or images' ID",
                 "parameters": [
                     {
                         "type": "string",
@@ -3929,7 +4049,7 @@ var doc = `{
                                     "type": "object",
                                     "properties": {
                                         "[DEFAULT]": {
-                                            "$ref": "#/definitions/mcir.RestGetAllVNetResponse"
+                                            "$ref": "#/definitions/mcir.RestGetAllImageResponse"
                                         },
                                         "[ID]": {
                                             "$ref": "#/definitions/common.IdList"
@@ -3954,7 +4074,7 @@ var doc = `{
                 }
             },
             "post": {
-                "description": "Create VNet",
+                "description": "Register image",
                 "consumes": [
                     "application/json"
                 ],
@@ -3962,10 +4082,17 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "[Infra resource] MCIR Network management"
+                    "[Infra resource] MCIR Image management"
                 ],
-                "summary": "Create VNet",
+                "summary": "Register image",
                 "parameters": [
+                    {
+                        "type": "string",
+                        "description": "registerWithInfo or registerWithId",
+                        "name": "registeringMethod",
+                        "in": "query",
+                        "required": true
+                    },
                     {
                         "type": "string",
                         "default": "ns01",
@@ -3975,21 +4102,19 @@ var doc = `{
                         "required": true
                     },
                     {
-                        "enum": [
-                            "register"
-                        ],
-                        "type": "string",
-                        "description": "Option",
-                        "name": "option",
-                        "in": "query"
+                        "description": "Details for an image object",
+                        "name": "imageInfo",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/mcir.TbImageInfo"
+                        }
                     },
                     {
-                        "description": "Details for an VNet object",
-                        "name": "vNetReq",
+                        "description": "name, connectionName and cspImageId",
+                        "name": "imageId",
                         "in": "body",
-                        "required": true,
                         "schema": {
-                            "$ref": "#/definitions/mcir.TbVNetReq"
+                            "$ref": "#/definitions/mcir.TbImageReq"
                         }
                     }
                 ],
@@ -3997,7 +4122,7 @@ var doc = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/mcir.TbVNetInfo"
+                            "$ref": "#/definitions/mcir.TbImageInfo"
                         }
                     },
                     "404": {
@@ -4015,7 +4140,7 @@ var doc = `{
                 }
             },
             "delete": {
-                "description": "Delete all VNets",
+                "description": "Delete all images",
                 "consumes": [
                     "application/json"
                 ],
@@ -4023,9 +4148,9 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "[Infra resource] MCIR Network management"
+                    "[Infra resource] MCIR Image management"
                 ],
-                "summary": "Delete all VNets",
+                "summary": "Delete all images",
                 "parameters": [
                     {
                         "type": "string",
@@ -4059,9 +4184,9 @@ var doc = `{
                 }
             }
         },
-        "/ns/{nsId}/resources/vNet/{vNetId}": {
+        "/ns/{nsId}/resources/image/{imageId}": {
             "get": {
-                "description": "Get VNet",
+                "description": "Get image",
                 "consumes": [
                     "application/json"
                 ],
@@ -4069,9 +4194,9 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "[Infra resource] MCIR Network management"
+                    "[Infra resource] MCIR Image management"
                 ],
-                "summary": "Get VNet",
+                "summary": "Get image",
                 "parameters": [
                     {
                         "type": "string",
@@ -4083,8 +4208,8 @@ var doc = `{
                     },
                     {
                         "type": "string",
-                        "description": "VNet ID",
-                        "name": "vNetId",
+                        "description": "Image ID",
+                        "name": "imageId",
                         "in": "path",
                         "required": true
                     }
@@ -4093,7 +4218,7 @@ var doc = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/mcir.TbVNetInfo"
+                            "$ref": "#/definitions/mcir.TbImageInfo"
                         }
                     },
                     "404": {
@@ -4110,8 +4235,8 @@ var doc = `{
                     }
                 }
             },
-            "delete": {
-                "description": "Delete VNet",
+            "put": {
+                "description": "Update image",
                 "consumes": [
                     "application/json"
                 ],
@@ -4119,10 +4244,19 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "[Infra resource] MCIR Network management"
+                    "[Infra resource] MCIR Image management"
                 ],
-                "summary": "Delete VNet",
+                "summary": "Update image",
                 "parameters": [
+                    {
+                        "description": "Details for an image object",
+                        "name": "imageInfo",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/mcir.TbImageInfo"
+                        }
+                    },
                     {
                         "type": "string",
                         "default": "ns01",
@@ -4133,8 +4267,8 @@ var doc = `{
                     },
                     {
                         "type": "string",
-                        "description": "VNet ID",
-                        "name": "vNetId",
+                        "description": "Image ID",
+                        "name": "imageId",
                         "in": "path",
                         "required": true
                     }
@@ -4143,7 +4277,7 @@ var doc = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/common.SimpleMsg"
+                            "$ref": "#/definitions/mcir.TbImageInfo"
                         }
                     },
                     "404": {
@@ -4151,13 +4285,17 @@ var doc = `{
                         "schema": {
                             "$ref": "#/definitions/common.SimpleMsg"
                         }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/common.SimpleMsg"
+                        }
                     }
                 }
-            }
-        },
-        "/ns/{nsId}/resources/vNet/{vNetId}/subnet": {
-            "post": {
-                "description": "Create Subnet",
+            },
+            "delete": {
+                "description": "Delete image",
                 "consumes": [
                     "application/json"
                 ],
@@ -4165,9 +4303,9 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "[Infra resource] MCIR Network management"
+                    "[Infra resource] MCIR Image management"
                 ],
-                "summary": "Create Subnet",
+                "summary": "Delete image",
                 "parameters": [
                     {
                         "type": "string",
@@ -4178,20 +4316,18 @@ var doc = `{
                         "required": true
                     },
                     {
-                        "description": "Details for an Subnet object",
-                        "name": "subnetReq",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/mcir.TbSubnetReq"
-                        }
+                        "type": "string",
+                        "description": "Image ID",
+                        "name": "imageId",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/mcir.TbSubnetInfo"
+                            "$ref": "#/definitions/common.SimpleMsg"
                         }
                     },
                     "404": {
@@ -4199,19 +4335,13 @@ var doc = `{
                         "schema": {
                             "$ref": "#/definitions/common.SimpleMsg"
                         }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/common.SimpleMsg"
-                        }
                     }
                 }
             }
         },
-        "/ns/{nsId}/resources/vNet/{vNetId}/subnet/{subnetId}": {
-            "delete": {
-                "description": "Delete Subnet",
+        "/ns/{nsId}/resources/searchImage": {
+            "post": {
+                "description": "Search image by keywords of name and conditions of OS information (family, distribution, version, architecture, boot type)",
                 "consumes": [
                     "application/json"
                 ],
@@ -4219,9 +4349,9 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "[Infra resource] MCIR Network management"
+                    "[Infra resource] MCIR Image management"
                 ],
-                "summary": "Delete Subnet",
+                "summary": "Search image",
                 "parameters": [
                     {
                         "type": "string",
@@ -4232,18 +4362,20 @@ var doc = `{
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "Subnet ID",
-                        "name": "subnetId",
-                        "in": "path",
-                        "required": true
+                        "description": "Keywords",
+                        "name": "keywords",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/mcir.RestSearchImageRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/common.SimpleMsg"
+                            "$ref": "#/definitions/mcir.RestGetAllImageResponse"
                         }
                     },
                     "404": {
@@ -4251,13 +4383,19 @@ var doc = `{
                         "schema": {
                             "$ref": "#/definitions/common.SimpleMsg"
                         }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/common.SimpleMsg"
+                        }
                     }
                 }
             }
         },
-        "/object": {
-            "get": {
-                "description": "Get value of an object",
+        "/ns/{nsId}/resources/searchSpecs": {
+            "post": {
+                "description": "Search specs by predicates on any field (==, !=, \u003e, \u003e=, \u003c, \u003c=, in, like, between) with multi-key ordering.\nUse limit/offset or cursor (nextCursor of the previous page) for pagination.",
                 "consumes": [
                     "application/json"
                 ],
@@ -4265,41 +4403,47 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "[Admin] System management"
+                    "[Infra resource] MCIR Spec management"
                 ],
-                "summary": "Get value of an object",
+                "summary": "Search specs",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "get object value by key",
-                        "name": "key",
-                        "in": "query",
+                        "default": "ns01",
+                        "description": "Namespace ID",
+                        "name": "nsId",
+                        "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Conditions, ordering and pagination for searching specs",
+                        "name": "specSearchReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/mcir.SpecSearchReq"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/common.SimpleMsg"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/common.SimpleMsg"
+                            "$ref": "#/definitions/mcir.SpecSearchResult"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/common.SimpleMsg"
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Delete an object",
+            }
+        },
+        "/ns/{nsId}/resources/securityGroup": {
+            "get": {
+                "description": "List all Security Groups or Security Groups' ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -4307,23 +4451,48 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "[Admin] System management"
+                    "[Infra resource] MCIR Security group management"
                 ],
-                "summary": "Delete an object",
+                "summary": "List all Security Groups or Security Groups' ID",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "delete object value by key",
-                        "name": "key",
-                        "in": "query",
+                        "default": "ns01",
+                        "description": "Namespace ID",
+                        "name": "nsId",
+                        "in": "path",
                         "required": true
+                    },
+                    {
+                        "enum": [
+                            "id"
+                        ],
+                        "type": "string",
+                        "description": "Option",
+                        "name": "option",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Different return structures by the given option param",
                         "schema": {
-                            "$ref": "#/definitions/common.SimpleMsg"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/mcir.JSONResult"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "[DEFAULT]": {
+                                            "$ref": "#/definitions/mcir.RestGetAllSecurityGroupResponse"
+                                        },
+                                        "[ID]": {
+                                            "$ref": "#/definitions/common.IdList"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "404": {
@@ -4339,11 +4508,9 @@ var doc = `{
                         }
                     }
                 }
-            }
-        },
-        "/objects": {
-            "get": {
-                "description": "List all objects for a given key",
+            },
+            "post": {
+                "description": "Create Security Group",
                 "consumes": [
                     "application/json"
                 ],
@@ -4351,23 +4518,33 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "[Admin] System management"
+                    "[Infra resource] MCIR Security group management"
                 ],
-                "summary": "List all objects for a given key",
+                "summary": "Create Security Group",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "retrieve objects by key",
-                        "name": "key",
-                        "in": "query",
+                        "default": "ns01",
+                        "description": "Namespace ID",
+                        "name": "nsId",
+                        "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Details for an securityGroup object",
+                        "name": "securityGroupReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/mcir.TbSecurityGroupReq"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/common.SimpleMsg"
+                            "$ref": "#/definitions/mcir.TbSecurityGroupInfo"
                         }
                     },
                     "404": {
@@ -4385,7 +4562,7 @@ var doc = `{
                 }
             },
             "delete": {
-                "description": "Delete child objects along with the given object",
+                "description": "Delete all Security Groups",
                 "consumes": [
                     "application/json"
                 ],
@@ -4393,23 +4570,31 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "[Admin] System management"
+                    "[Infra resource] MCIR Security group management"
                 ],
-                "summary": "Delete child objects along with the given object",
+                "summary": "Delete all Security Groups",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Delete child objects based on the given key string",
-                        "name": "key",
-                        "in": "query",
+                        "default": "ns01",
+                        "description": "Namespace ID",
+                        "name": "nsId",
+                        "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "",
+                        "description": "Delete resources containing matched ID-substring only",
+                        "name": "match",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/common.SimpleMsg"
+                            "$ref": "#/definitions/common.IdList"
                         }
                     },
                     "404": {
@@ -4417,19 +4602,13 @@ var doc = `{
                         "schema": {
                             "$ref": "#/definitions/common.SimpleMsg"
                         }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/common.SimpleMsg"
-                        }
                     }
                 }
             }
         },
-        "/region": {
+        "/ns/{nsId}/resources/securityGroup/{securityGroupId}": {
             "get": {
-                "description": "List all registered regions",
+                "description": "Get Security Group",
                 "consumes": [
                     "application/json"
                 ],
@@ -4437,14 +4616,31 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "[Admin] Multi-Cloud environment configuration"
+                    "[Infra resource] MCIR Security group management"
+                ],
+                "summary": "Get Security Group",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "ns01",
+                        "description": "Namespace ID",
+                        "name": "nsId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Security Group ID",
+                        "name": "securityGroupId",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "List all registered regions",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/common.RegionList"
+                            "$ref": "#/definitions/mcir.TbSecurityGroupInfo"
                         }
                     },
                     "404": {
@@ -4460,11 +4656,9 @@ var doc = `{
                         }
                     }
                 }
-            }
-        },
-        "/region/{regionName}": {
-            "get": {
-                "description": "Get registered region info",
+            },
+            "delete": {
+                "description": "Delete Security Group",
                 "consumes": [
                     "application/json"
                 ],
@@ -4472,14 +4666,22 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "[Admin] Multi-Cloud environment configuration"
+                    "[Infra resource] MCIR Security group management"
                 ],
-                "summary": "Get registered region info",
+                "summary": "Delete Security Group",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Name of region to retrieve",
-                        "name": "regionName",
+                        "default": "ns01",
+                        "description": "Namespace ID",
+                        "name": "nsId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Security Group ID",
+                        "name": "securityGroupId",
                         "in": "path",
                         "required": true
                     }
@@ -4488,7 +4690,7 @@ var doc = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/common.Region"
+                            "$ref": "#/definitions/common.SimpleMsg"
                         }
                     },
                     "404": {
@@ -4496,19 +4698,13 @@ var doc = `{
                         "schema": {
                             "$ref": "#/definitions/common.SimpleMsg"
                         }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/common.SimpleMsg"
-                        }
                     }
                 }
             }
         },
-        "/swaggerActive": {
+        "/ns/{nsId}/resources/spec": {
             "get": {
-                "description": "Get API document web",
+                "description": "List all specs or specs' ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -4516,14 +4712,48 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "[Admin] System management"
+                    "[Infra resource] MCIR Spec management"
+                ],
+                "summary": "List all specs or specs' ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "ns01",
+                        "description": "Namespace ID",
+                        "name": "nsId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "id"
+                        ],
+                        "type": "string",
+                        "description": "Option",
+                        "name": "option",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Get API document web",
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Different return structures by the given option param",
                         "schema": {
-                            "$ref": "#/definitions/common.SimpleMsg"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/mcir.JSONResult"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "[DEFAULT]": {
+                                            "$ref": "#/definitions/mcir.RestGetAllSpecResponse"
+                                        },
+                                        "[ID]": {
+                                            "$ref": "#/definitions/common.IdList"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "404": {
@@ -4539,11 +4769,9 @@ var doc = `{
                         }
                     }
                 }
-            }
-        },
-        "/{nsId}/checkResource/{resourceType}/{resourceId}": {
-            "get": {
-                "description": "Check resources' existence",
+            },
+            "post": {
+                "description": "Register spec",
                 "consumes": [
                     "application/json"
                 ],
@@ -4551,10 +4779,17 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "[Infra resource] MCIR Common"
+                    "[Infra resource] MCIR Spec management"
                 ],
-                "summary": "Check resources' existence",
+                "summary": "Register spec",
                 "parameters": [
+                    {
+                        "type": "string",
+                        "description": "registerWithInfo or else",
+                        "name": "registeringMethod",
+                        "in": "query",
+                        "required": true
+                    },
                     {
                         "type": "string",
                         "default": "ns01",
@@ -4563,17 +4798,115 @@ var doc = `{
                         "in": "path",
                         "required": true
                     },
+                    {
+                        "description": "Details for an spec object",
+                        "name": "specInfo",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/mcir.TbSpecInfo"
+                        }
+                    },
+                    {
+                        "description": "name, connectionName and cspSpecName",
+                        "name": "specName",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/mcir.TbSpecReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/mcir.TbSpecInfo"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/common.SimpleMsg"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/common.SimpleMsg"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete all specs",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "[Infra resource] MCIR Spec management"
+                ],
+                "summary": "Delete all specs",
+                "parameters": [
                     {
                         "type": "string",
-                        "description": "Resource Type",
-                        "name": "resourceType",
+                        "default": "ns01",
+                        "description": "Namespace ID",
+                        "name": "nsId",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Resource ID",
-                        "name": "resourceId",
+                        "default": "",
+                        "description": "Delete resources containing matched ID-substring only",
+                        "name": "match",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/common.IdList"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/common.SimpleMsg"
+                        }
+                    }
+                }
+            }
+        },
+        "/ns/{nsId}/resources/spec/{specId}": {
+            "get": {
+                "description": "Get spec",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "[Infra resource] MCIR Spec management"
+                ],
+                "summary": "Get spec",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "ns01",
+                        "description": "Namespace ID",
+                        "name": "nsId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Spec ID",
+                        "name": "specId",
                         "in": "path",
                         "required": true
                     }
@@ -4582,7 +4915,7 @@ var doc = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/common.SimpleMsg"
+                            "$ref": "#/definitions/mcir.TbSpecInfo"
                         }
                     },
                     "404": {
@@ -4590,1085 +4923,4642 @@ var doc = `{
                         "schema": {
                             "$ref": "#/definitions/common.SimpleMsg"
                         }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/common.SimpleMsg"
+                        }
                     }
                 }
-            }
-        }
-    },
-    "definitions": {
-        "common.ConfigInfo": {
-            "type": "object",
-            "properties": {
-                "id": {
-                    "type": "string",
-                    "example": "SPIDER_REST_URL"
-                },
-                "name": {
-                    "type": "string",
-                    "example": "SPIDER_REST_URL"
-                },
-                "value": {
-                    "type": "string",
-                    "example": "http://localhost:1024/spider"
+            },
+            "put": {
+                "description": "Update spec",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "[Infra resource] MCIR Spec management"
+                ],
+                "summary": "Update spec",
+                "parameters": [
+                    {
+                        "description": "Details for an spec object",
+                        "name": "specInfo",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/mcir.TbSpecInfo"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "default": "ns01",
+                        "description": "Namespace ID",
+                        "name": "nsId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Spec ID",
+                        "name": "specId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/mcir.TbSpecInfo"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/common.SimpleMsg"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/common.SimpleMsg"
+                        }
+                    }
                 }
-            }
-        },
-        "common.ConfigReq": {
-            "type": "object",
-            "properties": {
-                "name": {
-                    "type": "string",
-                    "example": "SPIDER_REST_URL"
-                },
-                "value": {
-                    "type": "string",
-                    "example": "http://localhost:1024/spider"
+            },
+            "delete": {
+                "description": "Delete spec",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "[Infra resource] MCIR Spec management"
+                ],
+                "summary": "Delete spec",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "ns01",
+                        "description": "Namespace ID",
+                        "name": "nsId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Spec ID",
+                        "name": "specId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/common.SimpleMsg"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/common.SimpleMsg"
+                        }
+                    }
                 }
             }
         },
-        "common.ConnConfig": {
-            "type": "object",
-            "properties": {
+        "/ns/{nsId}/resources/sshKey": {
+            "get": {
+                "description": "List all SSH Keys or SSH Keys' ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "[Infra resource] MCIR Access key management"
+                ],
+                "summary": "List all SSH Keys or SSH Keys' ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "ns01",
+                        "description": "Namespace ID",
+                        "name": "nsId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "id"
+                        ],
+                        "type": "string",
+                        "description": "Option",
+                        "name": "option",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "true"
+                        ],
+                        "type": "string",
+                        "description": "Show decrypted privateKey (X-Secret-Token header is required)",
+                        "name": "showSecret",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Different return structures by the given option param",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/mcir.JSONResult"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "[DEFAULT]": {
+                                            "$ref": "#/definitions/mcir.RestGetAllSshKeyResponse"
+                                        },
+                                        "[ID]": {
+                                            "$ref": "#/definitions/common.IdList"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/common.SimpleMsg"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/common.SimpleMsg"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create SSH Key",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "[Infra resource] MCIR Access key management"
+                ],
+                "summary": "Create SSH Key",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "ns01",
+                        "description": "Namespace ID",
+                        "name": "nsId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Details for an SSH Key object",
+                        "name": "sshKeyInfo",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/mcir.TbSshKeyReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/mcir.TbSshKeyInfo"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/common.SimpleMsg"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/common.SimpleMsg"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete all SSH Keys",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "[Infra resource] MCIR Access key management"
+                ],
+                "summary": "Delete all SSH Keys",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "ns01",
+                        "description": "Namespace ID",
+                        "name": "nsId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "",
+                        "description": "Delete resources containing matched ID-substring only",
+                        "name": "match",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/common.IdList"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/common.SimpleMsg"
+                        }
+                    }
+                }
+            }
+        },
+        "/ns/{nsId}/resources/sshKey/{sshKeyId}": {
+            "get": {
+                "description": "Get SSH Key",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "[Infra resource] MCIR Access key management"
+                ],
+                "summary": "Get SSH Key",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "ns01",
+                        "description": "Namespace ID",
+                        "name": "nsId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "SSH Key ID",
+                        "name": "sshKeyId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "true"
+                        ],
+                        "type": "string",
+                        "description": "Show decrypted privateKey (X-Secret-Token header is required)",
+                        "name": "showSecret",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/mcir.TbSshKeyInfo"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/common.SimpleMsg"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/common.SimpleMsg"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete SSH Key",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "[Infra resource] MCIR Access key management"
+                ],
+                "summary": "Delete SSH Key",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "ns01",
+                        "description": "Namespace ID",
+                        "name": "nsId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "SSH Key ID",
+                        "name": "sshKeyId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/common.SimpleMsg"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/common.SimpleMsg"
+                        }
+                    }
+                }
+            }
+        },
+        "/ns/{nsId}/resources/sshKey/{sshKeyId}/rotate": {
+            "post": {
+                "description": "Rotate SSH Key on all the VMs associated with the key. The new public key is installed and verified on each VM, the stored private key is replaced, and then the old key is removed from each VM. If any VM fails or the new key cannot be stored, the new key is removed from the VMs and the stored key is not changed.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "[Infra resource] MCIR Access key management"
+                ],
+                "summary": "Rotate SSH Key",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "ns01",
+                        "description": "Namespace ID",
+                        "name": "nsId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "SSH Key ID",
+                        "name": "sshKeyId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "New private key (a new key pair is generated if empty)",
+                        "name": "sshKeyRotateReq",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/mcis.TbSshKeyRotateReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/mcis.TbSshKeyRotateResult"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/common.SimpleMsg"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/mcis.TbSshKeyRotateResult"
+                        }
+                    }
+                }
+            }
+        },
+        "/ns/{nsId}/resources/updateImageOsInfo": {
+            "post": {
+                "description": "Parse OS information (family, distribution, version, architecture, boot type) of all images in the namespace again and update the changed images",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "[Infra resource] MCIR Image management"
+                ],
+                "summary": "Update OS information of images",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "ns01",
+                        "description": "Namespace ID",
+                        "name": "nsId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/common.IdList"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/common.SimpleMsg"
+                        }
+                    }
+                }
+            }
+        },
+        "/ns/{nsId}/resources/updateSpecPrice": {
+            "post": {
+                "description": "Update CostPerHour of all specs in the namespace by the price catalog and the pricing policy of the namespace",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "[Infra resource] MCIR Spec management"
+                ],
+                "summary": "Update CostPerHour of specs by price catalog",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "ns01",
+                        "description": "Namespace ID",
+                        "name": "nsId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/common.IdList"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/common.SimpleMsg"
+                        }
+                    }
+                }
+            }
+        },
+        "/ns/{nsId}/resources/vNet": {
+            "get": {
+                "description": "List all VNets or VNets' ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "[Infra resource] MCIR Network management"
+                ],
+                "summary": "List all VNets or VNets' ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "ns01",
+                        "description": "Namespace ID",
+                        "name": "nsId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "id"
+                        ],
+                        "type": "string",
+                        "description": "Option",
+                        "name": "option",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Different return structures by the given option param",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/mcir.JSONResult"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "[DEFAULT]": {
+                                            "$ref": "#/definitions/mcir.RestGetAllVNetResponse"
+                                        },
+                                        "[ID]": {
+                                            "$ref": "#/definitions/common.IdList"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/common.SimpleMsg"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/common.SimpleMsg"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create VNet",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "[Infra resource] MCIR Network management"
+                ],
+                "summary": "Create VNet",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "ns01",
+                        "description": "Namespace ID",
+                        "name": "nsId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "register"
+                        ],
+                        "type": "string",
+                        "description": "Option",
+                        "name": "option",
+                        "in": "query"
+                    },
+                    {
+                        "description": "Details for an VNet object",
+                        "name": "vNetReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/mcir.TbVNetReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/mcir.TbVNetInfo"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/common.SimpleMsg"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/common.SimpleMsg"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete all VNets",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "[Infra resource] MCIR Network management"
+                ],
+                "summary": "Delete all VNets",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "ns01",
+                        "description": "Namespace ID",
+                        "name": "nsId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "",
+                        "description": "Delete resources containing matched ID-substring only",
+                        "name": "match",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/common.IdList"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/common.SimpleMsg"
+                        }
+                    }
+                }
+            }
+        },
+        "/ns/{nsId}/resources/vNet/{vNetId}": {
+            "get": {
+                "description": "Get VNet",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "[Infra resource] MCIR Network management"
+                ],
+                "summary": "Get VNet",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "ns01",
+                        "description": "Namespace ID",
+                        "name": "nsId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "VNet ID",
+                        "name": "vNetId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/mcir.TbVNetInfo"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/common.SimpleMsg"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/common.SimpleMsg"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete VNet",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "[Infra resource] MCIR Network management"
+                ],
+                "summary": "Delete VNet",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "ns01",
+                        "description": "Namespace ID",
+                        "name": "nsId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "VNet ID",
+                        "name": "vNetId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/common.SimpleMsg"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/common.SimpleMsg"
+                        }
+                    }
+                }
+            }
+        },
+        "/ns/{nsId}/resources/vNet/{vNetId}/subnet": {
+            "post": {
+                "description": "Create Subnet",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "[Infra resource] MCIR Network management"
+                ],
+                "summary": "Create Subnet",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "ns01",
+                        "description": "Namespace ID",
+                        "name": "nsId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Details for an Subnet object",
+                        "name": "subnetReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/mcir.TbSubnetReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/mcir.TbSubnetInfo"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/common.SimpleMsg"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/common.SimpleMsg"
+                        }
+                    }
+                }
+            }
+        },
+        "/ns/{nsId}/resources/vNet/{vNetId}/subnet/{subnetId}": {
+            "delete": {
+                "description": "Delete Subnet",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "[Infra resource] MCIR Network management"
+                ],
+                "summary": "Delete Subnet",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "ns01",
+                        "description": "Namespace ID",
+                        "name": "nsId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Subnet ID",
+                        "name": "subnetId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/common.SimpleMsg"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/common.SimpleMsg"
+                        }
+                    }
+                }
+            }
+        },
+        "/ns/{nsId}/script": {
+            "get": {
+                "description": "List all command scripts in the namespace",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "[Infra service] MCIS Command script library"
+                ],
+                "summary": "List all command scripts",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "ns01",
+                        "description": "Namespace ID",
+                        "name": "nsId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/mcis.RestGetAllScriptResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/common.SimpleMsg"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/common.SimpleMsg"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a command script. The body can have Go-template placeholders such as {{.VmId}}, {{.PublicIP}}, {{.PrivateIP}}, {{.McisId}} and {{.Param.key}}. User params and the label are rendered as single-quoted shell words.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "[Infra service] MCIS Command script library"
+                ],
+                "summary": "Create a command script",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "ns01",
+                        "description": "Namespace ID",
+                        "name": "nsId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Details for a command script object",
+                        "name": "scriptReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/mcis.TbScriptReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/mcis.TbScriptInfo"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/common.SimpleMsg"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/common.SimpleMsg"
+                        }
+                    }
+                }
+            }
+        },
+        "/ns/{nsId}/script/{scriptId}": {
+            "get": {
+                "description": "Get a command script (the latest version)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "[Infra service] MCIS Command script library"
+                ],
+                "summary": "Get a command script",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "ns01",
+                        "description": "Namespace ID",
+                        "name": "nsId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "script01",
+                        "description": "Script ID",
+                        "name": "scriptId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/mcis.TbScriptInfo"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/common.SimpleMsg"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/common.SimpleMsg"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update a command script. The previous version is kept in the version history.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "[Infra service] MCIS Command script library"
+                ],
+                "summary": "Update a command script",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "ns01",
+                        "description": "Namespace ID",
+                        "name": "nsId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "script01",
+                        "description": "Script ID",
+                        "name": "scriptId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Details for a command script object",
+                        "name": "scriptReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/mcis.TbScriptReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/mcis.TbScriptInfo"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/common.SimpleMsg"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/common.SimpleMsg"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a command script with its version and run history",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "[Infra service] MCIS Command script library"
+                ],
+                "summary": "Delete a command script",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "ns01",
+                        "description": "Namespace ID",
+                        "name": "nsId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "script01",
+                        "description": "Script ID",
+                        "name": "scriptId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/common.SimpleMsg"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/common.SimpleMsg"
+                        }
+                    }
+                }
+            }
+        },
+        "/ns/{nsId}/script/{scriptId}/run": {
+            "get": {
+                "description": "List the run history of a command script (the latest 100 runs are kept)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "[Infra service] MCIS Command script library"
+                ],
+                "summary": "List runs of a command script",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "ns01",
+                        "description": "Namespace ID",
+                        "name": "nsId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "script01",
+                        "description": "Script ID",
+                        "name": "scriptId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/mcis.RestGetScriptRunResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/common.SimpleMsg"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Render a command script for each VM selected by MCIS, VM group and label, and run it by SSH",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "[Infra service] MCIS Command script library"
+                ],
+                "summary": "Run a command script",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "ns01",
+                        "description": "Namespace ID",
+                        "name": "nsId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "script01",
+                        "description": "Script ID",
+                        "name": "scriptId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Target VMs and params to run the script",
+                        "name": "scriptRunReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/mcis.TbScriptRunReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/mcis.TbScriptRunInfo"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/common.SimpleMsg"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/common.SimpleMsg"
+                        }
+                    }
+                }
+            }
+        },
+        "/ns/{nsId}/script/{scriptId}/version": {
+            "get": {
+                "description": "List the version history of a command script",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "[Infra service] MCIS Command script library"
+                ],
+                "summary": "List versions of a command script",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "ns01",
+                        "description": "Namespace ID",
+                        "name": "nsId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "script01",
+                        "description": "Script ID",
+                        "name": "scriptId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/mcis.RestGetScriptVersionResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/common.SimpleMsg"
+                        }
+                    }
+                }
+            }
+        },
+        "/object": {
+            "get": {
+                "description": "Get value of an object",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "[Admin] System management"
+                ],
+                "summary": "Get value of an object",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "get object value by key",
+                        "name": "key",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/common.SimpleMsg"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/common.SimpleMsg"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/common.SimpleMsg"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete an object",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "[Admin] System management"
+                ],
+                "summary": "Delete an object",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "delete object value by key",
+                        "name": "key",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/common.SimpleMsg"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/common.SimpleMsg"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/common.SimpleMsg"
+                        }
+                    }
+                }
+            }
+        },
+        "/objects": {
+            "get": {
+                "description": "List all objects for a given key",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "[Admin] System management"
+                ],
+                "summary": "List all objects for a given key",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "retrieve objects by key",
+                        "name": "key",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/common.SimpleMsg"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/common.SimpleMsg"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/common.SimpleMsg"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete child objects along with the given object",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "[Admin] System management"
+                ],
+                "summary": "Delete child objects along with the given object",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Delete child objects based on the given key string",
+                        "name": "key",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/common.SimpleMsg"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/common.SimpleMsg"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/common.SimpleMsg"
+                        }
+                    }
+                }
+            }
+        },
+        "/price": {
+            "get": {
+                "description": "List prices of specs effective today (all prices including past and future ones if history is true)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "[Admin] Multi-Cloud environment configuration"
+                ],
+                "summary": "List prices of specs",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "aws-ap-northeast-2",
+                        "description": "Connection name to filter",
+                        "name": "connectionName",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "t2.micro",
+                        "description": "CSP spec name to filter (with connectionName)",
+                        "name": "cspSpecName",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "true",
+                            "false"
+                        ],
+                        "type": "string",
+                        "description": "Include price history",
+                        "name": "history",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/mcir.TbPriceList"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/common.SimpleMsg"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Register prices of specs (a price with the same connection, spec, OS, pricing model, currency and effective date is overwritten)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "[Admin] Multi-Cloud environment configuration"
+                ],
+                "summary": "Register prices of specs",
+                "parameters": [
+                    {
+                        "description": "List of prices",
+                        "name": "priceList",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/mcir.TbPriceList"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/mcir.TbPriceList"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/common.SimpleMsg"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete all prices of specs including history",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "[Admin] Multi-Cloud environment configuration"
+                ],
+                "summary": "Delete all prices of specs",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/common.SimpleMsg"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/common.SimpleMsg"
+                        }
+                    }
+                }
+            }
+        },
+        "/region": {
+            "get": {
+                "description": "List all registered regions",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "[Admin] Multi-Cloud environment configuration"
+                ],
+                "summary": "List all registered regions",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/common.RegionList"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/common.SimpleMsg"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/common.SimpleMsg"
+                        }
+                    }
+                }
+            }
+        },
+        "/region/{regionName}": {
+            "get": {
+                "description": "Get registered region info",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "[Admin] Multi-Cloud environment configuration"
+                ],
+                "summary": "Get registered region info",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Name of region to retrieve",
+                        "name": "regionName",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/common.Region"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/common.SimpleMsg"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/common.SimpleMsg"
+                        }
+                    }
+                }
+            }
+        },
+        "/secret/reEncrypt": {
+            "post": {
+                "description": "Re-encrypt all secrets stored in CB-Tumblebug with the active master key (the first key of SECRET_KEY). Use this after rotating master keys.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "[Admin] System management"
+                ],
+                "summary": "Re-encrypt secrets with the active master key",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/common.SecretReEncryptResult"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/common.SimpleMsg"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/common.SimpleMsg"
+                        }
+                    }
+                }
+            }
+        },
+        "/swaggerActive": {
+            "get": {
+                "description": "Get API document web",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "[Admin] System management"
+                ],
+                "summary": "Get API document web",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/common.SimpleMsg"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/common.SimpleMsg"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/common.SimpleMsg"
+                        }
+                    }
+                }
+            }
+        },
+        "/{nsId}/checkResource/{resourceType}/{resourceId}": {
+            "get": {
+                "description": "Check resources' existence",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "[Infra resource] MCIR Common"
+                ],
+                "summary": "Check resources' existence",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "ns01",
+                        "description": "Namespace ID",
+                        "name": "nsId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Resource Type",
+                        "name": "resourceType",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Resource ID",
+                        "name": "resourceId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/common.SimpleMsg"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/common.SimpleMsg"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "common.ConfigInfo": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string",
+                    "example": "SPIDER_REST_URL"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "SPIDER_REST_URL"
+                },
+                "value": {
+                    "type": "string",
+                    "example": "http://localhost:1024/spider"
+                }
+            }
+        },
+        "common.ConfigReq": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "example": "SPIDER_REST_URL"
+                },
+                "value": {
+                    "type": "string",
+                    "example": "http://localhost:1024/spider"
+                }
+            }
+        },
+        "common.ConnConfig": {
+            "type": "object",
+            "properties": {
                 "configName": {
                     "type": "string"
                 },
-                "credentialName": {
+                "credentialName": {
+                    "type": "string"
+                },
+                "driverName": {
+                    "type": "string"
+                },
+                "location": {
+                    "$ref": "#/definitions/common.GeoLocation"
+                },
+                "providerName": {
+                    "type": "string"
+                },
+                "regionName": {
+                    "type": "string"
+                }
+            }
+        },
+        "common.ConnConfigList": {
+            "type": "object",
+            "properties": {
+                "connectionconfig": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/common.ConnConfig"
+                    }
+                }
+            }
+        },
+        "common.GeoLocation": {
+            "type": "object",
+            "properties": {
+                "briefAddr": {
+                    "type": "string"
+                },
+                "cloudType": {
+                    "type": "string"
+                },
+                "latitude": {
+                    "type": "string"
+                },
+                "longitude": {
+                    "type": "string"
+                },
+                "nativeRegion": {
+                    "type": "string"
+                }
+            }
+        },
+        "common.IID": {
+            "type": "object",
+            "properties": {
+                "nameId": {
+                    "description": "NameID by user",
+                    "type": "string"
+                },
+                "systemId": {
+                    "description": "SystemID by CloudOS",
+                    "type": "string"
+                }
+            }
+        },
+        "common.IdList": {
+            "type": "object",
+            "properties": {
+                "output": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "common.JSONResult": {
+            "type": "object"
+        },
+        "common.KeyValue": {
+            "type": "object",
+            "properties": {
+                "key": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "string"
+                }
+            }
+        },
+        "common.NsInfo": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "example": "Description for this namespace"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "ns01"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "ns01"
+                }
+            }
+        },
+        "common.NsReq": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "example": "Description for this namespace"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "ns01"
+                }
+            }
+        },
+        "common.Region": {
+            "type": "object",
+            "properties": {
+                "keyValueInfoList": {
+                    "description": "ex) { {region, us-east1}, {zone, us-east1-c} }",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/common.KeyValue"
+                    }
+                },
+                "providerName": {
+                    "description": "ex) \"GCP\"",
+                    "type": "string"
+                },
+                "regionName": {
+                    "description": "ex) \"region01\"",
+                    "type": "string"
+                }
+            }
+        },
+        "common.RegionList": {
+            "type": "object",
+            "properties": {
+                "region": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/common.Region"
+                    }
+                }
+            }
+        },
+        "common.RestGetAllConfigResponse": {
+            "type": "object",
+            "properties": {
+                "config": {
+                    "description": "Name string     ` + "`" + `json:\"name\"` + "`" + `",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/common.ConfigInfo"
+                    }
+                }
+            }
+        },
+        "common.RestGetAllNsResponse": {
+            "type": "object",
+            "properties": {
+                "ns": {
+                    "description": "Name string     ` + "`" + `json:\"name\"` + "`" + `",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/common.NsInfo"
+                    }
+                }
+            }
+        },
+        "common.RestInspectResourcesRequest": {
+            "type": "object",
+            "properties": {
+                "connectionName": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "vNet",
+                        "securityGroup",
+                        "sshKey",
+                        "vm"
+                    ],
+                    "example": "vNet"
+                }
+            }
+        },
+        "common.SecretReEncryptResult": {
+            "type": "object",
+            "properties": {
+                "activeKeyId": {
+                    "type": "string"
+                },
+                "failed": {
+                    "description": "Failed is a list of object keys failed to re-encrypt",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "reEncrypted": {
+                    "description": "ReEncrypted is the number of objects whose secrets have been re-encrypted with the active key",
+                    "type": "integer"
+                }
+            }
+        },
+        "common.SimpleMsg": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string",
+                    "example": "Any message"
+                }
+            }
+        },
+        "common.TbConnectionName": {
+            "type": "object",
+            "properties": {
+                "connectionName": {
+                    "type": "string"
+                }
+            }
+        },
+        "mcir.FilterSpecsByRangeRequest": {
+            "type": "object",
+            "properties": {
+                "connectionName": {
+                    "type": "string"
+                },
+                "costPerHour": {
+                    "$ref": "#/definitions/mcir.Range"
+                },
+                "cspSpecName": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "ebsBwMbps": {
+                    "$ref": "#/definitions/mcir.Range"
+                },
+                "evaluationScore01": {
+                    "$ref": "#/definitions/mcir.Range"
+                },
+                "evaluationScore02": {
+                    "$ref": "#/definitions/mcir.Range"
+                },
+                "evaluationScore03": {
+                    "$ref": "#/definitions/mcir.Range"
+                },
+                "evaluationScore04": {
+                    "$ref": "#/definitions/mcir.Range"
+                },
+                "evaluationScore05": {
+                    "$ref": "#/definitions/mcir.Range"
+                },
+                "evaluationScore06": {
+                    "$ref": "#/definitions/mcir.Range"
+                },
+                "evaluationScore07": {
+                    "$ref": "#/definitions/mcir.Range"
+                },
+                "evaluationScore08": {
+                    "$ref": "#/definitions/mcir.Range"
+                },
+                "evaluationScore09": {
+                    "$ref": "#/definitions/mcir.Range"
+                },
+                "evaluationScore10": {
+                    "$ref": "#/definitions/mcir.Range"
+                },
+                "evaluationStatus": {
+                    "type": "string"
+                },
+                "gpuMemGiB": {
+                    "$ref": "#/definitions/mcir.Range"
+                },
+                "gpuModel": {
+                    "type": "string"
+                },
+                "gpuP2p": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "maxNumStorage": {
+                    "$ref": "#/definitions/mcir.Range"
+                },
+                "maxTotalStorageTiB": {
+                    "$ref": "#/definitions/mcir.Range"
+                },
+                "memGiB": {
+                    "$ref": "#/definitions/mcir.Range"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "netBwGbps": {
+                    "$ref": "#/definitions/mcir.Range"
+                },
+                "numGpu": {
+                    "$ref": "#/definitions/mcir.Range"
+                },
+                "numStorage": {
+                    "$ref": "#/definitions/mcir.Range"
+                },
+                "numcore": {
+                    "$ref": "#/definitions/mcir.Range"
+                },
+                "numvCPU": {
+                    "$ref": "#/definitions/mcir.Range"
+                },
+                "osType": {
+                    "type": "string"
+                },
+                "storageGiB": {
+                    "$ref": "#/definitions/mcir.Range"
+                }
+            }
+        },
+        "mcir.JSONResult": {
+            "type": "object"
+        },
+        "mcir.Range": {
+            "type": "object",
+            "properties": {
+                "max": {
+                    "type": "number"
+                },
+                "min": {
+                    "type": "number"
+                }
+            }
+        },
+        "mcir.RestFilterSpecsResponse": {
+            "type": "object",
+            "properties": {
+                "spec": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/mcir.TbSpecInfo"
+                    }
+                }
+            }
+        },
+        "mcir.RestGetAllImageResponse": {
+            "type": "object",
+            "properties": {
+                "image": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/mcir.TbImageInfo"
+                    }
+                }
+            }
+        },
+        "mcir.RestGetAllSecurityGroupResponse": {
+            "type": "object",
+            "properties": {
+                "securityGroup": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/mcir.TbSecurityGroupInfo"
+                    }
+                }
+            }
+        },
+        "mcir.RestGetAllSpecResponse": {
+            "type": "object",
+            "properties": {
+                "spec": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/mcir.TbSpecInfo"
+                    }
+                }
+            }
+        },
+        "mcir.RestGetAllSshKeyResponse": {
+            "type": "object",
+            "properties": {
+                "sshKey": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/mcir.TbSshKeyInfo"
+                    }
+                }
+            }
+        },
+        "mcir.RestGetAllVNetResponse": {
+            "type": "object",
+            "properties": {
+                "vNet": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/mcir.TbVNetInfo"
+                    }
+                }
+            }
+        },
+        "mcir.RestLookupImageRequest": {
+            "type": "object",
+            "properties": {
+                "connectionName": {
+                    "type": "string"
+                },
+                "cspImageId": {
+                    "type": "string"
+                }
+            }
+        },
+        "mcir.RestLookupSpecRequest": {
+            "type": "object",
+            "properties": {
+                "connectionName": {
+                    "type": "string"
+                },
+                "cspSpecName": {
+                    "type": "string"
+                }
+            }
+        },
+        "mcir.RestSearchImageRequest": {
+            "type": "object",
+            "properties": {
+                "bootMode": {
+                    "type": "string",
+                    "enum": [
+                        "bios",
+                        "uefi"
+                    ],
+                    "example": "uefi"
+                },
+                "connectionName": {
+                    "description": "ConnectionName and OS information are optional conditions (osVersion 22 matches 22.04)",
+                    "type": "string",
+                    "example": "aws-ap-northeast-2"
+                },
+                "keywords": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "os": {
+                    "description": "Os is an OS query parsed to OS information conditions (ex: ubuntu 22.04 arm64)",
+                    "type": "string",
+                    "example": "ubuntu 22.04 arm64"
+                },
+                "osArchitecture": {
+                    "type": "string",
+                    "enum": [
+                        "x86_64",
+                        "arm64",
+                        "x86"
+                    ],
+                    "example": "x86_64"
+                },
+                "osDistribution": {
+                    "type": "string",
+                    "example": "ubuntu"
+                },
+                "osFamily": {
+                    "type": "string",
+                    "example": "linux"
+                },
+                "osVersion": {
+                    "type": "string",
+                    "example": "22.04"
+                },
+                "virtualizationType": {
+                    "type": "string",
+                    "example": "hvm"
+                }
+            }
+        },
+        "mcir.SpecEvaluationScore": {
+            "type": "object",
+            "properties": {
+                "metric": {
+                    "type": "string",
+                    "example": "cost"
+                },
+                "score": {
+                    "description": "normalized score (0 ~ 1, higher is better)",
+                    "type": "number",
+                    "example": 0.85
+                },
+                "value": {
+                    "description": "raw value of the criterion (ex: costPerHour, distance in km)",
+                    "type": "number",
+                    "example": 0.0116
+                },
+                "weight": {
+                    "description": "normalized weight (sum of weights is 1)",
+                    "type": "number",
+                    "example": 0.3
+                },
+                "weightedScore": {
+                    "description": "score * weight",
+                    "type": "number",
+                    "example": 0.255
+                }
+            }
+        },
+        "mcir.SpecSearchCondition": {
+            "type": "object",
+            "properties": {
+                "field": {
+                    "description": "Field is the name of TbSpecInfo field (json name, ex: memGiB, cspSpecName)",
+                    "type": "string",
+                    "example": "memGiB"
+                },
+                "operator": {
+                    "type": "string",
+                    "enum": [
+                        "==",
+                        "!=",
+                        "\u003e",
+                        "\u003e=",
+                        "\u003c",
+                        "\u003c=",
+                        "in",
+                        "like",
+                        "between"
+                    ],
+                    "example": "\u003e="
+                },
+                "value": {
+                    "description": "Value is the operand (2 values for between, 1 or more values for in)",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "4"
+                    ]
+                }
+            }
+        },
+        "mcir.SpecSearchOrder": {
+            "type": "object",
+            "properties": {
+                "direction": {
+                    "type": "string",
+                    "enum": [
+                        "ascending",
+                        "descending"
+                    ],
+                    "example": "ascending"
+                },
+                "field": {
+                    "type": "string",
+                    "example": "costPerHour"
+                }
+            }
+        },
+        "mcir.SpecSearchReq": {
+            "type": "object",
+            "properties": {
+                "condition": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/mcir.SpecSearchCondition"
+                    }
+                },
+                "cursor": {
+                    "description": "Cursor is the nextCursor of the previous page (keyset pagination)",
+                    "type": "string"
+                },
+                "limit": {
+                    "description": "Limit is the max number of specs in a page (0: no limit)",
+                    "type": "integer",
+                    "example": 20
+                },
+                "offset": {
+                    "description": "Offset is the number of specs to skip (ignored if cursor is given)",
+                    "type": "integer",
+                    "example": 0
+                },
+                "orderBy": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/mcir.SpecSearchOrder"
+                    }
+                }
+            }
+        },
+        "mcir.SpecSearchResult": {
+            "type": "object",
+            "properties": {
+                "limit": {
+                    "type": "integer"
+                },
+                "nextCursor": {
+                    "description": "NextCursor is to get the next page (empty if it is the last page or limit is 0)",
+                    "type": "string"
+                },
+                "offset": {
+                    "type": "integer"
+                },
+                "spec": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/mcir.TbSpecInfo"
+                    }
+                },
+                "totalCount": {
+                    "type": "integer"
+                }
+            }
+        },
+        "mcir.SpiderGpuInfo": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "string"
+                },
+                "mem": {
+                    "type": "string"
+                },
+                "mfr": {
+                    "type": "string"
+                },
+                "model": {
+                    "type": "string"
+                }
+            }
+        },
+        "mcir.SpiderImageInfo": {
+            "type": "object",
+            "properties": {
+                "guestOS": {
+                    "description": "Windows7, Ubuntu etc.",
+                    "type": "string"
+                },
+                "iid": {
+                    "description": "Fields for response",
+                    "$ref": "#/definitions/common.IID"
+                },
+                "keyValueList": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/common.KeyValue"
+                    }
+                },
+                "name": {
+                    "description": "Fields for request",
+                    "type": "string"
+                },
+                "status": {
+                    "description": "available, unavailable",
+                    "type": "string"
+                }
+            }
+        },
+        "mcir.SpiderImageList": {
+            "type": "object",
+            "properties": {
+                "image": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/mcir.SpiderImageInfo"
+                    }
+                }
+            }
+        },
+        "mcir.SpiderSecurityRuleInfo": {
+            "type": "object",
+            "properties": {
+                "cidr": {
+                    "type": "string"
+                },
+                "direction": {
+                    "description": "` + "`" + `json:\"direction\"` + "`" + `",
+                    "type": "string"
+                },
+                "fromPort": {
+                    "description": "` + "`" + `json:\"fromPort\"` + "`" + `",
+                    "type": "string"
+                },
+                "ipprotocol": {
+                    "description": "` + "`" + `json:\"ipProtocol\"` + "`" + `",
+                    "type": "string"
+                },
+                "toPort": {
+                    "description": "` + "`" + `json:\"toPort\"` + "`" + `",
+                    "type": "string"
+                }
+            }
+        },
+        "mcir.SpiderSpecInfo": {
+            "type": "object",
+            "properties": {
+                "gpu": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/mcir.SpiderGpuInfo"
+                    }
+                },
+                "keyValueList": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/common.KeyValue"
+                    }
+                },
+                "mem": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "region": {
+                    "type": "string"
+                },
+                "vcpu": {
+                    "$ref": "#/definitions/mcir.SpiderVCpuInfo"
+                }
+            }
+        },
+        "mcir.SpiderSpecList": {
+            "type": "object",
+            "properties": {
+                "vmspec": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/mcir.SpiderSpecInfo"
+                    }
+                }
+            }
+        },
+        "mcir.SpiderVCpuInfo": {
+            "type": "object",
+            "properties": {
+                "clock": {
+                    "description": "GHz",
+                    "type": "string"
+                },
+                "count": {
+                    "type": "string"
+                }
+            }
+        },
+        "mcir.TbCatalogChange": {
+            "type": "object",
+            "properties": {
+                "changeType": {
+                    "type": "string",
+                    "enum": [
+                        "added",
+                        "changed",
+                        "removed",
+                        "restored",
+                        "deleted"
+                    ]
+                },
+                "connectionName": {
+                    "type": "string",
+                    "example": "aws-ap-northeast-2"
+                },
+                "createdTime": {
+                    "type": "string"
+                },
+                "cspResourceName": {
+                    "type": "string",
+                    "example": "t2.small"
+                },
+                "detail": {
+                    "description": "Detail is changed fields (ex: numvCPU: 1 -\u003e 2) or the reason",
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "nsId": {
+                    "type": "string"
+                },
+                "resourceId": {
+                    "type": "string",
+                    "example": "aws-ap-northeast-2-t2-small"
+                },
+                "resourceType": {
+                    "type": "string",
+                    "enum": [
+                        "spec",
+                        "image"
+                    ]
+                }
+            }
+        },
+        "mcir.TbCatalogChangeList": {
+            "type": "object",
+            "properties": {
+                "change": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/mcir.TbCatalogChange"
+                    }
+                }
+            }
+        },
+        "mcir.TbCatalogSyncPolicy": {
+            "type": "object",
+            "properties": {
+                "connectionName": {
+                    "description": "ConnectionName is the list of connections to sync (all connections if empty)",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "enabled": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "intervalMin": {
+                    "description": "IntervalMin is the interval of sync in minutes (1440 if 0, 10 at least)",
+                    "type": "integer",
+                    "example": 1440
+                },
+                "lastSyncTime": {
+                    "type": "string"
+                },
+                "purgeUnreferenced": {
+                    "description": "PurgeUnreferenced is to delete removed specs and images not referenced by any object (they are kept as deprecated if false)",
+                    "type": "boolean",
+                    "example": false
+                },
+                "resourceType": {
+                    "description": "ResourceType is the list of resource types to sync (spec and image if empty)",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "spec",
+                        "image"
+                    ]
+                }
+            }
+        },
+        "mcir.TbCatalogSyncResult": {
+            "type": "object",
+            "properties": {
+                "addedNum": {
+                    "type": "integer"
+                },
+                "change": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/mcir.TbCatalogChange"
+                    }
+                },
+                "changedNum": {
+                    "type": "integer"
+                },
+                "deletedNum": {
+                    "type": "integer"
+                },
+                "failed": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "nsId": {
+                    "type": "string"
+                },
+                "removedNum": {
+                    "type": "integer"
+                },
+                "restoredNum": {
+                    "type": "integer"
+                }
+            }
+        },
+        "mcir.TbImageInfo": {
+            "type": "object",
+            "properties": {
+                "associatedObjectList": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "bootMode": {
+                    "type": "string",
+                    "enum": [
+                        "bios",
+                        "uefi"
+                    ],
+                    "example": "uefi"
+                },
+                "connectionName": {
+                    "type": "string"
+                },
+                "creationDate": {
+                    "type": "string"
+                },
+                "cspImageId": {
+                    "type": "string"
+                },
+                "cspImageName": {
+                    "type": "string"
+                },
+                "deprecated": {
+                    "description": "Deprecated is true if the image is not provided by CSP anymore (marked by catalog sync)",
+                    "type": "boolean"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "guestOS": {
+                    "description": "Windows7, Ubuntu etc.",
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "isAutoGenerated": {
+                    "type": "boolean"
+                },
+                "keyValueList": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/common.KeyValue"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "namespace": {
+                    "description": "required to save in RDB",
+                    "type": "string"
+                },
+                "osArchitecture": {
+                    "type": "string",
+                    "enum": [
+                        "x86_64",
+                        "arm64",
+                        "x86"
+                    ],
+                    "example": "x86_64"
+                },
+                "osDistribution": {
+                    "type": "string",
+                    "example": "ubuntu"
+                },
+                "osFamily": {
+                    "description": "OS information parsed from GuestOS, names and KeyValueList (searchable)",
+                    "type": "string",
+                    "example": "linux"
+                },
+                "osVersion": {
+                    "type": "string",
+                    "example": "22.04"
+                },
+                "status": {
+                    "description": "available, unavailable",
+                    "type": "string"
+                },
+                "virtualizationType": {
+                    "type": "string",
+                    "example": "hvm"
+                }
+            }
+        },
+        "mcir.TbImageReq": {
+            "type": "object",
+            "required": [
+                "connectionName",
+                "cspImageId",
+                "name"
+            ],
+            "properties": {
+                "connectionName": {
+                    "type": "string"
+                },
+                "cspImageId": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "mcir.TbPriceImportResult": {
+            "type": "object",
+            "properties": {
+                "price": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/mcir.TbPriceInfo"
+                    }
+                },
+                "skippedNum": {
+                    "description": "SkippedNum is the number of entries skipped (not a VM price per hour, unknown region, invalid value)",
+                    "type": "integer"
+                }
+            }
+        },
+        "mcir.TbPriceInfo": {
+            "type": "object",
+            "properties": {
+                "connectionName": {
+                    "type": "string",
+                    "example": "aws-ap-northeast-2"
+                },
+                "cspSpecName": {
+                    "type": "string",
+                    "example": "t2.micro"
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "USD"
+                },
+                "effectiveDate": {
+                    "description": "EffectiveDate is the date from which the price is applied (YYYY-MM-DD)",
+                    "type": "string",
+                    "example": "2022-01-01"
+                },
+                "origin": {
+                    "description": "Origin is where the price comes from (user, or the format of imported catalog)",
+                    "type": "string",
+                    "example": "user"
+                },
+                "osType": {
+                    "type": "string",
+                    "example": "linux"
+                },
+                "pricePerHour": {
+                    "type": "number",
+                    "example": 0.0144
+                },
+                "pricingModel": {
+                    "type": "string",
+                    "enum": [
+                        "onDemand",
+                        "spot",
+                        "reserved"
+                    ],
+                    "example": "onDemand"
+                },
+                "updatedTime": {
+                    "type": "string"
+                }
+            }
+        },
+        "mcir.TbPriceList": {
+            "type": "object",
+            "properties": {
+                "price": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/mcir.TbPriceInfo"
+                    }
+                }
+            }
+        },
+        "mcir.TbPricingPolicy": {
+            "type": "object",
+            "properties": {
+                "currency": {
+                    "type": "string",
+                    "example": "USD"
+                },
+                "osType": {
+                    "type": "string",
+                    "example": "linux"
+                },
+                "pricingModel": {
+                    "description": "PricingModel to apply (falls back to onDemand if the spec has no price in the model)",
+                    "type": "string",
+                    "enum": [
+                        "onDemand",
+                        "spot",
+                        "reserved"
+                    ],
+                    "example": "onDemand"
+                }
+            }
+        },
+        "mcir.TbSecurityGroupInfo": {
+            "type": "object",
+            "properties": {
+                "associatedObjectList": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "connectionName": {
+                    "type": "string"
+                },
+                "cspSecurityGroupId": {
+                    "type": "string"
+                },
+                "cspSecurityGroupName": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "firewallRules": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/mcir.SpiderSecurityRuleInfo"
+                    }
+                },
+                "id": {
+                    "type": "string"
+                },
+                "isAutoGenerated": {
+                    "type": "boolean"
+                },
+                "keyValueList": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/common.KeyValue"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "vNetId": {
+                    "type": "string"
+                }
+            }
+        },
+        "mcir.TbSecurityGroupReq": {
+            "type": "object",
+            "required": [
+                "connectionName",
+                "firewallRules",
+                "name",
+                "vNetId"
+            ],
+            "properties": {
+                "connectionName": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "firewallRules": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/mcir.SpiderSecurityRuleInfo"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "vNetId": {
+                    "type": "string"
+                }
+            }
+        },
+        "mcir.TbSpecInfo": {
+            "type": "object",
+            "properties": {
+                "associatedObjectList": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "connectionName": {
+                    "type": "string"
+                },
+                "costPerHour": {
+                    "type": "number"
+                },
+                "cspSpecName": {
+                    "type": "string"
+                },
+                "deprecated": {
+                    "description": "Deprecated is true if the spec is not provided by CSP anymore (marked by catalog sync)",
+                    "type": "boolean"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "ebsBwMbps": {
+                    "type": "integer"
+                },
+                "evaluationBreakdown": {
+                    "description": "EvaluationBreakdown is the per-criterion score of the spec in the last recommendation (not stored)",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/mcir.SpecEvaluationScore"
+                    }
+                },
+                "evaluationScore01": {
+                    "type": "number"
+                },
+                "evaluationScore02": {
+                    "type": "number"
+                },
+                "evaluationScore03": {
+                    "type": "number"
+                },
+                "evaluationScore04": {
+                    "type": "number"
+                },
+                "evaluationScore05": {
+                    "type": "number"
+                },
+                "evaluationScore06": {
+                    "type": "number"
+                },
+                "evaluationScore07": {
+                    "type": "number"
+                },
+                "evaluationScore08": {
+                    "type": "number"
+                },
+                "evaluationScore09": {
+                    "type": "number"
+                },
+                "evaluationScore10": {
+                    "type": "number"
+                },
+                "evaluationStatus": {
+                    "type": "string"
+                },
+                "evaluationTotalScore": {
+                    "description": "EvaluationTotalScore is the weighted total score of the spec in the last recommendation (not stored)",
+                    "type": "number"
+                },
+                "gpuMemGiB": {
+                    "type": "number"
+                },
+                "gpuModel": {
+                    "type": "string"
+                },
+                "gpuP2p": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "isAutoGenerated": {
+                    "type": "boolean"
+                },
+                "maxNumStorage": {
+                    "type": "integer"
+                },
+                "maxTotalStorageTiB": {
+                    "type": "integer"
+                },
+                "memGiB": {
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "namespace": {
+                    "description": "required to save in RDB",
+                    "type": "string"
+                },
+                "netBwGbps": {
+                    "type": "integer"
+                },
+                "numCore": {
+                    "type": "integer"
+                },
+                "numGpu": {
+                    "type": "integer"
+                },
+                "numStorage": {
+                    "type": "integer"
+                },
+                "numvCPU": {
+                    "type": "integer"
+                },
+                "orderInFilteredResult": {
+                    "type": "integer"
+                },
+                "osType": {
+                    "type": "string"
+                },
+                "storageGiB": {
+                    "type": "integer"
+                }
+            }
+        },
+        "mcir.TbSpecReq": {
+            "type": "object",
+            "required": [
+                "connectionName",
+                "cspSpecName",
+                "name"
+            ],
+            "properties": {
+                "connectionName": {
+                    "type": "string"
+                },
+                "cspSpecName": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "mcir.TbSshKeyInfo": {
+            "type": "object",
+            "properties": {
+                "associatedObjectList": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "connectionName": {
                     "type": "string"
                 },
-                "driverName": {
+                "cspSshKeyName": {
                     "type": "string"
                 },
-                "location": {
-                    "$ref": "#/definitions/common.GeoLocation"
+                "description": {
+                    "type": "string"
                 },
-                "providerName": {
+                "fingerprint": {
                     "type": "string"
                 },
-                "regionName": {
+                "id": {
                     "type": "string"
-                }
-            }
-        },
-        "common.ConnConfigList": {
-            "type": "object",
-            "properties": {
-                "connectionconfig": {
+                },
+                "isAutoGenerated": {
+                    "type": "boolean"
+                },
+                "keyValueList": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/common.ConnConfig"
+                        "$ref": "#/definitions/common.KeyValue"
                     }
-                }
-            }
-        },
-        "common.GeoLocation": {
-            "type": "object",
-            "properties": {
-                "briefAddr": {
+                },
+                "name": {
                     "type": "string"
                 },
-                "cloudType": {
+                "privateKey": {
                     "type": "string"
                 },
-                "latitude": {
+                "publicKey": {
                     "type": "string"
                 },
-                "longitude": {
+                "username": {
                     "type": "string"
                 },
-                "nativeRegion": {
+                "verifiedUsername": {
                     "type": "string"
                 }
             }
         },
-        "common.IID": {
+        "mcir.TbSshKeyReq": {
             "type": "object",
+            "required": [
+                "connectionName",
+                "name"
+            ],
             "properties": {
-                "nameId": {
-                    "description": "NameID by user",
+                "connectionName": {
                     "type": "string"
                 },
-                "systemId": {
-                    "description": "SystemID by CloudOS",
+                "description": {
+                    "type": "string"
+                },
+                "name": {
                     "type": "string"
                 }
             }
         },
-        "common.IdList": {
+        "mcir.TbSubnetInfo": {
             "type": "object",
+            "required": [
+                "ipv4_CIDR",
+                "name"
+            ],
             "properties": {
-                "output": {
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "ipv4_CIDR": {
+                    "type": "string"
+                },
+                "keyValueList": {
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/common.KeyValue"
                     }
-                }
-            }
-        },
-        "common.JSONResult": {
-            "type": "object"
-        },
-        "common.KeyValue": {
-            "type": "object",
-            "properties": {
-                "key": {
-                    "type": "string"
                 },
-                "value": {
+                "name": {
                     "type": "string"
                 }
             }
         },
-        "common.NsInfo": {
+        "mcir.TbSubnetReq": {
             "type": "object",
+            "required": [
+                "ipv4_CIDR",
+                "name"
+            ],
             "properties": {
                 "description": {
-                    "type": "string",
-                    "example": "Description for this namespace"
+                    "type": "string"
                 },
-                "id": {
-                    "type": "string",
-                    "example": "ns01"
+                "ipv4_CIDR": {
+                    "type": "string"
+                },
+                "keyValueList": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/common.KeyValue"
+                    }
                 },
                 "name": {
-                    "type": "string",
-                    "example": "ns01"
+                    "type": "string"
                 }
             }
         },
-        "common.NsReq": {
+        "mcir.TbVNetInfo": {
             "type": "object",
             "properties": {
+                "associatedObjectList": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "cidrBlock": {
+                    "type": "string"
+                },
+                "connectionName": {
+                    "type": "string"
+                },
+                "cspVNetId": {
+                    "type": "string"
+                },
+                "cspVNetName": {
+                    "type": "string"
+                },
                 "description": {
-                    "type": "string",
-                    "example": "Description for this namespace"
+                    "type": "string"
                 },
-                "name": {
-                    "type": "string",
-                    "example": "ns01"
-                }
-            }
-        },
-        "common.Region": {
-            "type": "object",
-            "properties": {
-                "keyValueInfoList": {
-                    "description": "ex) { {region, us-east1}, {zone, us-east1-c} }",
+                "id": {
+                    "type": "string"
+                },
+                "isAutoGenerated": {
+                    "type": "boolean"
+                },
+                "keyValueList": {
                     "type": "array",
                     "items": {
                         "$ref": "#/definitions/common.KeyValue"
                     }
                 },
-                "providerName": {
-                    "description": "ex) \"GCP\"",
+                "name": {
                     "type": "string"
                 },
-                "regionName": {
-                    "description": "ex) \"region01\"",
+                "status": {
                     "type": "string"
+                },
+                "subnetInfoList": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/mcir.TbSubnetInfo"
+                    }
+                },
+                "systemLabel": {
+                    "description": "SystemLabel is for describing the MCIR in a keyword (any string can be used) for special System purpose",
+                    "type": "string",
+                    "example": "Managed by CB-Tumblebug"
                 }
             }
         },
-        "common.RegionList": {
+        "mcir.TbVNetReq": {
             "type": "object",
+            "required": [
+                "connectionName",
+                "name"
+            ],
             "properties": {
-                "region": {
+                "cidrBlock": {
+                    "type": "string"
+                },
+                "connectionName": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "subnetInfoList": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/common.Region"
+                        "$ref": "#/definitions/mcir.TbSubnetReq"
                     }
                 }
             }
         },
-        "common.RestGetAllConfigResponse": {
+        "mcis.AgentInstallContent": {
             "type": "object",
             "properties": {
-                "config": {
-                    "description": "Name string     ` + "`" + `json:\"name\"` + "`" + `",
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/common.ConfigInfo"
-                    }
+                "mcisId": {
+                    "type": "string"
+                },
+                "result": {
+                    "type": "string"
+                },
+                "vmId": {
+                    "type": "string"
+                },
+                "vmIp": {
+                    "type": "string"
                 }
             }
         },
-        "common.RestGetAllNsResponse": {
+        "mcis.AgentInstallContentWrapper": {
             "type": "object",
             "properties": {
-                "ns": {
-                    "description": "Name string     ` + "`" + `json:\"name\"` + "`" + `",
+                "resultArray": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/common.NsInfo"
+                        "$ref": "#/definitions/mcis.AgentInstallContent"
                     }
                 }
             }
         },
-        "common.RestInspectResourcesRequest": {
+        "mcis.AutoAction": {
             "type": "object",
             "properties": {
-                "connectionName": {
+                "actionType": {
                     "type": "string"
                 },
-                "type": {
+                "maxVmCount": {
+                    "description": "0 means no limit",
+                    "type": "integer",
+                    "example": 10
+                },
+                "minVmCount": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "placementAlgo": {
                     "type": "string",
                     "enum": [
-                        "vNet",
-                        "securityGroup",
-                        "sshKey",
-                        "vm"
+                        "random",
+                        "spread",
+                        "cheapest",
+                        "closest",
+                        "benchmark"
                     ],
-                    "example": "vNet"
-                }
-            }
-        },
-        "common.SimpleMsg": {
-            "type": "object",
-            "properties": {
-                "message": {
+                    "example": "random"
+                },
+                "placementLocation": {
+                    "description": "PlacementLocation is the geo point (\"latitude/longitude\") for the closest placement",
                     "type": "string",
-                    "example": "Any message"
-                }
-            }
-        },
-        "common.TbConnectionName": {
-            "type": "object",
-            "properties": {
-                "connectionName": {
+                    "example": "37.5665/126.9780"
+                },
+                "postCommand": {
+                    "$ref": "#/definitions/mcis.McisCmdReq"
+                },
+                "scaleInCooldownSec": {
+                    "description": "min interval after the last ScaleOut or ScaleIn (for ScaleIn action)",
+                    "type": "integer",
+                    "example": 600
+                },
+                "scaleOutCooldownSec": {
+                    "description": "min interval after the last ScaleOut (for ScaleOut action)",
+                    "type": "integer",
+                    "example": 300
+                },
+                "scopeLabel": {
+                    "description": "ScopeLabel is the label of VMs to scale. MinVmCount and MaxVmCount are applied to VMs with this label (all VMs in MCIS if empty).\nVMs added by ScaleOut get this label (AutoGen if empty) and only VMs with this label (AutoGen if empty) are removed by ScaleIn.",
+                    "type": "string"
+                },
+                "scopeVmGroupId": {
+                    "description": "ScopeVmGroupId is the VM group to scale. VMs added by ScaleOut join the VM group (by the template of VMs in the group),\nand any VM in the VM group can be removed by ScaleIn.",
                     "type": "string"
+                },
+                "stepSize": {
+                    "description": "number of VMs (\"2\") or percentage of VMs in scope (\"20%\") to add or remove (default: 1)",
+                    "type": "string",
+                    "example": "1"
+                },
+                "victimStrategy": {
+                    "type": "string",
+                    "enum": [
+                        "newest",
+                        "oldest",
+                        "highestCost"
+                    ],
+                    "example": "newest"
+                },
+                "vm": {
+                    "$ref": "#/definitions/mcis.TbVmInfo"
                 }
             }
         },
-        "mcir.FilterSpecsByRangeRequest": {
+        "mcis.AutoCondition": {
             "type": "object",
             "properties": {
-                "connectionName": {
+                "aggregation": {
+                    "description": "aggregation of values across VMs (default: avg)",
+                    "type": "string",
+                    "enum": [
+                        "avg",
+                        "max",
+                        "min",
+                        "p95"
+                    ],
+                    "example": "avg"
+                },
+                "evaluationPeriod": {
+                    "description": "evaluationPeriod",
                     "type": "string"
                 },
-                "costPerHour": {
-                    "$ref": "#/definitions/mcir.Range"
+                "evaluationValue": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "cspSpecName": {
+                "expression": {
+                    "description": "condition expression tree (overrides the single metric condition)",
+                    "$ref": "#/definitions/mcis.AutoConditionExpr"
+                },
+                "metric": {
                     "type": "string"
                 },
-                "description": {
+                "operand": {
+                    "description": "10, 70, 80, 98, ...",
                     "type": "string"
                 },
-                "ebsBwMbps": {
-                    "$ref": "#/definitions/mcir.Range"
+                "operator": {
+                    "description": "\u003c, \u003c=, \u003e, \u003e=, ...",
+                    "type": "string"
                 },
-                "evaluationScore01": {
-                    "$ref": "#/definitions/mcir.Range"
+                "window": {
+                    "description": "use the average of retained samples of each VM in the window (instead of the latest values)",
+                    "type": "string",
+                    "example": "5m"
+                }
+            }
+        },
+        "mcis.AutoConditionExpr": {
+            "type": "object",
+            "properties": {
+                "aggregation": {
+                    "description": "aggregation of values across VMs (default: avg)",
+                    "type": "string",
+                    "enum": [
+                        "avg",
+                        "max",
+                        "min",
+                        "p95"
+                    ],
+                    "example": "avg"
                 },
-                "evaluationScore02": {
-                    "$ref": "#/definitions/mcir.Range"
+                "children": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/mcis.AutoConditionExpr"
+                    }
                 },
-                "evaluationScore03": {
-                    "$ref": "#/definitions/mcir.Range"
+                "combinator": {
+                    "description": "Fields for a combinator node",
+                    "type": "string",
+                    "enum": [
+                        "and",
+                        "or"
+                    ],
+                    "example": "and"
                 },
-                "evaluationScore04": {
-                    "$ref": "#/definitions/mcir.Range"
+                "evaluationPeriod": {
+                    "description": "number of periods to evaluate (average of aggregated values)",
+                    "type": "string",
+                    "example": "3"
                 },
-                "evaluationScore05": {
-                    "$ref": "#/definitions/mcir.Range"
+                "evaluationValue": {
+                    "description": "history of aggregated values (latest first)",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "evaluationScore06": {
-                    "$ref": "#/definitions/mcir.Range"
+                "metric": {
+                    "description": "Fields for a leaf node",
+                    "type": "string",
+                    "enum": [
+                        "cpu",
+                        "mem",
+                        "disk",
+                        "net",
+                        "load",
+                        "pendingVm"
+                    ],
+                    "example": "cpu"
                 },
-                "evaluationScore07": {
-                    "$ref": "#/definitions/mcir.Range"
+                "operand": {
+                    "description": "10, 70, 80, 98, ...",
+                    "type": "string",
+                    "example": "80"
                 },
-                "evaluationScore08": {
-                    "$ref": "#/definitions/mcir.Range"
+                "operator": {
+                    "description": "\u003c, \u003c=, \u003e, \u003e=",
+                    "type": "string",
+                    "example": "\u003e"
                 },
-                "evaluationScore09": {
-                    "$ref": "#/definitions/mcir.Range"
+                "window": {
+                    "description": "use the average of retained samples of each VM in the window (instead of the latest values)",
+                    "type": "string",
+                    "example": "5m"
+                }
+            }
+        },
+        "mcis.AutoConditionLeafResult": {
+            "type": "object",
+            "properties": {
+                "aggregation": {
+                    "type": "string"
                 },
-                "evaluationScore10": {
-                    "$ref": "#/definitions/mcir.Range"
+                "filled": {
+                    "description": "number of values collected for the evaluation period",
+                    "type": "integer"
                 },
-                "evaluationStatus": {
+                "metric": {
                     "type": "string"
                 },
-                "gpuMemGiB": {
-                    "$ref": "#/definitions/mcir.Range"
+                "operand": {
+                    "type": "number"
                 },
-                "gpuModel": {
+                "operator": {
                     "type": "string"
                 },
-                "gpuP2p": {
-                    "type": "string"
+                "period": {
+                    "type": "integer"
                 },
-                "id": {
+                "result": {
+                    "description": "true, false, unknown (not enough data)",
                     "type": "string"
                 },
-                "maxNumStorage": {
-                    "$ref": "#/definitions/mcir.Range"
+                "value": {
+                    "description": "average of aggregated values for the evaluation period",
+                    "type": "number"
+                }
+            }
+        },
+        "mcis.AutoPredict": {
+            "type": "object",
+            "properties": {
+                "actionEnabled": {
+                    "description": "ActionEnabled is to act on forecasts. If false, forecasts are only recorded in the policy history (to evaluate them first).",
+                    "type": "boolean"
                 },
-                "maxTotalStorageTiB": {
-                    "$ref": "#/definitions/mcir.Range"
+                "leadTimeMin": {
+                    "description": "how early to act before the forecasted load (default: 60)",
+                    "type": "integer",
+                    "example": 60
                 },
-                "memGiB": {
-                    "$ref": "#/definitions/mcir.Range"
+                "model": {
+                    "type": "string",
+                    "enum": [
+                        "holtWinters",
+                        "lastWeek"
+                    ],
+                    "example": "holtWinters"
                 },
-                "name": {
+                "seasonLength": {
+                    "description": "length of season in hours for holtWinters (default: 24)",
+                    "type": "integer",
+                    "example": 24
+                }
+            }
+        },
+        "mcis.AutoSchedule": {
+            "type": "object",
+            "properties": {
+                "activeRule": {
+                    "description": "ActiveRule is the index of the rule applied currently (-1 if no rule has been triggered)",
+                    "type": "integer"
+                },
+                "activeRuleTime": {
+                    "description": "ActiveRuleTime is the trigger time of the rule applied currently (RFC3339)",
                     "type": "string"
                 },
-                "netBwGbps": {
-                    "$ref": "#/definitions/mcir.Range"
+                "rules": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/mcis.AutoScheduleRule"
+                    }
                 },
-                "numGpu": {
-                    "$ref": "#/definitions/mcir.Range"
+                "timeZone": {
+                    "description": "time zone of cron expressions (default: UTC)",
+                    "type": "string",
+                    "example": "Asia/Seoul"
+                }
+            }
+        },
+        "mcis.AutoScheduleRule": {
+            "type": "object",
+            "properties": {
+                "cron": {
+                    "description": "minute hour day-of-month month day-of-week",
+                    "type": "string",
+                    "example": "0 9 * * 1-5"
                 },
-                "numStorage": {
-                    "$ref": "#/definitions/mcir.Range"
+                "desiredVmCount": {
+                    "type": "integer",
+                    "example": 10
+                }
+            }
+        },
+        "mcis.BenchmarkInfo": {
+            "type": "object",
+            "properties": {
+                "desc": {
+                    "type": "string"
                 },
-                "numcore": {
-                    "$ref": "#/definitions/mcir.Range"
+                "elapsed": {
+                    "type": "string"
                 },
-                "numvCPU": {
-                    "$ref": "#/definitions/mcir.Range"
+                "result": {
+                    "type": "string"
                 },
-                "osType": {
+                "resultarray": {
+                    "description": "struct-element cycle ?",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/mcis.BenchmarkInfo"
+                    }
+                },
+                "specid": {
                     "type": "string"
                 },
-                "storageGiB": {
-                    "$ref": "#/definitions/mcir.Range"
+                "unit": {
+                    "type": "string"
                 }
             }
         },
-        "mcir.JSONResult": {
-            "type": "object"
+        "mcis.BenchmarkInfoArray": {
+            "type": "object",
+            "properties": {
+                "resultarray": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/mcis.BenchmarkInfo"
+                    }
+                }
+            }
         },
-        "mcir.Range": {
+        "mcis.DeploymentPlan": {
             "type": "object",
             "properties": {
-                "max": {
-                    "type": "number"
+                "filter": {
+                    "$ref": "#/definitions/mcis.FilterInfo"
                 },
-                "min": {
-                    "type": "number"
+                "limit": {
+                    "type": "string",
+                    "enum": [
+                        "1",
+                        "2",
+                        "...",
+                        "30",
+                        "..."
+                    ],
+                    "example": "5"
+                },
+                "priority": {
+                    "$ref": "#/definitions/mcis.PriorityInfo"
                 }
             }
         },
-        "mcir.RestFilterSpecsResponse": {
+        "mcis.FilterCondition": {
             "type": "object",
             "properties": {
-                "spec": {
+                "condition": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/mcir.TbSpecInfo"
+                        "$ref": "#/definitions/mcis.Operation"
                     }
+                },
+                "metric": {
+                    "type": "string",
+                    "enum": [
+                        "cpu",
+                        "memory",
+                        "cost"
+                    ],
+                    "example": "cpu"
                 }
             }
         },
-        "mcir.RestGetAllImageResponse": {
+        "mcis.FilterInfo": {
             "type": "object",
             "properties": {
-                "image": {
+                "policy": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/mcir.TbImageInfo"
+                        "$ref": "#/definitions/mcis.FilterCondition"
                     }
                 }
             }
         },
-        "mcir.RestGetAllSecurityGroupResponse": {
+        "mcis.JSONResult": {
+            "type": "object"
+        },
+        "mcis.JSONResultListBudgetAlert": {
             "type": "object",
             "properties": {
-                "securityGroup": {
+                "alert": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/mcir.TbSecurityGroupInfo"
+                        "$ref": "#/definitions/mcis.TbBudgetAlert"
                     }
                 }
             }
         },
-        "mcir.RestGetAllSpecResponse": {
+        "mcis.LatencyInfo": {
             "type": "object",
             "properties": {
-                "spec": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/mcir.TbSpecInfo"
-                    }
+                "origin": {
+                    "type": "string",
+                    "enum": [
+                        "benchmark",
+                        "csv",
+                        "user"
+                    ],
+                    "example": "benchmark"
+                },
+                "rttMs": {
+                    "type": "number",
+                    "example": 32.5
+                },
+                "source": {
+                    "type": "string",
+                    "example": "aws/ap-northeast-2"
+                },
+                "target": {
+                    "type": "string",
+                    "example": "gcp/asia-northeast3"
+                },
+                "updatedTime": {
+                    "type": "string",
+                    "example": "2021-05-11T09:14:33Z"
                 }
             }
         },
-        "mcir.RestGetAllSshKeyResponse": {
+        "mcis.LatencyMatrix": {
             "type": "object",
             "properties": {
-                "sshKey": {
+                "latency": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/mcir.TbSshKeyInfo"
+                        "$ref": "#/definitions/mcis.LatencyInfo"
                     }
                 }
             }
         },
-        "mcir.RestGetAllVNetResponse": {
+        "mcis.McisCmdReq": {
             "type": "object",
+            "required": [
+                "command"
+            ],
             "properties": {
-                "vNet": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/mcir.TbVNetInfo"
-                    }
+                "command": {
+                    "type": "string",
+                    "example": "sudo apt-get install ..."
+                },
+                "userName": {
+                    "type": "string",
+                    "example": "cb-user"
                 }
             }
         },
-        "mcir.RestLookupImageRequest": {
+        "mcis.McisHealthLog": {
             "type": "object",
             "properties": {
-                "connectionName": {
+                "endTime": {
+                    "description": "RFC3339",
                     "type": "string"
                 },
-                "cspImageId": {
+                "error": {
                     "type": "string"
-                }
-            }
-        },
-        "mcir.RestLookupSpecRequest": {
-            "type": "object",
-            "properties": {
-                "connectionName": {
+                },
+                "id": {
                     "type": "string"
                 },
-                "cspSpecName": {
+                "mcisId": {
+                    "type": "string"
+                },
+                "newVmId": {
+                    "description": "ID of the replacement VM",
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "remediation": {
+                    "type": "string",
+                    "enum": [
+                        "reboot",
+                        "replace"
+                    ],
+                    "example": "reboot"
+                },
+                "result": {
+                    "type": "string",
+                    "enum": [
+                        "Succeeded",
+                        "Failed"
+                    ],
+                    "example": "Succeeded"
+                },
+                "startTime": {
+                    "description": "RFC3339",
+                    "type": "string"
+                },
+                "vmId": {
                     "type": "string"
                 }
             }
         },
-        "mcir.RestSearchImageRequest": {
+        "mcis.McisHealthPolicy": {
             "type": "object",
             "properties": {
-                "keywords": {
+                "checkType": {
+                    "type": "string",
+                    "enum": [
+                        "cspStatus",
+                        "tcp",
+                        "command"
+                    ],
+                    "example": "tcp"
+                },
+                "command": {
+                    "description": "Command is the remote command for command check (exit code 0 is healthy)",
+                    "$ref": "#/definitions/mcis.McisCmdReq"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "failureThreshold": {
+                    "description": "consecutive failures to remediate (default: 3)",
+                    "type": "integer",
+                    "example": 3
+                },
+                "gracePeriodSec": {
+                    "description": "period to skip checks after a remediation (default: 180)",
+                    "type": "integer",
+                    "example": 180
+                },
+                "id": {
+                    "description": "MCIS ID",
+                    "type": "string"
+                },
+                "intervalSec": {
+                    "description": "interval between checks (default: 60)",
+                    "type": "integer",
+                    "example": 60
+                },
+                "lastCheckTime": {
+                    "description": "RFC3339",
+                    "type": "string"
+                },
+                "postCommand": {
+                    "description": "PostCommand is the command to run on the replacement VM if the unhealthy VM has no post command",
+                    "$ref": "#/definitions/mcis.McisCmdReq"
+                },
+                "remediation": {
+                    "description": "Remediation is the steps of remediation in order. The next step is taken if the VM is still unhealthy after a step. (default: reboot, replace)",
                     "type": "array",
                     "items": {
                         "type": "string"
+                    },
+                    "example": [
+                        "reboot",
+                        "replace"
+                    ]
+                },
+                "tcpPort": {
+                    "description": "TcpPort is the port to check for tcp check (default: SSH port of VM)",
+                    "type": "string",
+                    "example": "80"
+                },
+                "timeoutSec": {
+                    "description": "timeout of tcp check (default: 5)",
+                    "type": "integer",
+                    "example": 5
+                },
+                "vmHealth": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/mcis.VmHealthInfo"
                     }
                 }
             }
         },
-        "mcir.SpiderGpuInfo": {
+        "mcis.McisMetricForecast": {
             "type": "object",
             "properties": {
-                "count": {
-                    "type": "string"
+                "accuracy": {
+                    "$ref": "#/definitions/mcis.MetricForecastAccuracy"
                 },
-                "mem": {
+                "bucketSec": {
+                    "type": "integer"
+                },
+                "forecast": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/mcis.MetricForecastPoint"
+                    }
+                },
+                "mcisId": {
                     "type": "string"
                 },
-                "mfr": {
+                "metric": {
                     "type": "string"
                 },
                 "model": {
                     "type": "string"
+                },
+                "samples": {
+                    "description": "number of buckets with samples",
+                    "type": "integer"
+                },
+                "seasonLength": {
+                    "description": "hours",
+                    "type": "integer"
                 }
             }
         },
-        "mcir.SpiderImageInfo": {
+        "mcis.McisPolicyHistory": {
             "type": "object",
             "properties": {
-                "guestOS": {
-                    "description": "Windows7, Ubuntu etc.",
+                "actionType": {
+                    "description": "Fields for action records",
                     "type": "string"
                 },
-                "iid": {
-                    "description": "Fields for response",
-                    "$ref": "#/definitions/common.IID"
-                },
-                "keyValueList": {
+                "addedVms": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/common.KeyValue"
+                        "type": "string"
                     }
                 },
-                "name": {
-                    "description": "Fields for request",
+                "conditionResult": {
+                    "description": "Fields for evaluation records (metric values observed)",
                     "type": "string"
                 },
-                "status": {
-                    "description": "available, unavailable",
-                    "type": "string"
-                }
-            }
-        },
-        "mcir.SpiderImageList": {
-            "type": "object",
-            "properties": {
-                "image": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/mcir.SpiderImageInfo"
-                    }
-                }
-            }
-        },
-        "mcir.SpiderSecurityRuleInfo": {
-            "type": "object",
-            "properties": {
-                "cidr": {
+                "decision": {
+                    "type": "string",
+                    "enum": [
+                        "Detected",
+                        "NotDetected",
+                        "NotEnoughData",
+                        "Scaled",
+                        "NoChange",
+                        "Cooldown",
+                        "Error"
+                    ],
+                    "example": "Detected"
+                },
+                "elapsedMs": {
+                    "type": "integer"
+                },
+                "endTime": {
+                    "description": "RFC3339",
                     "type": "string"
                 },
-                "direction": {
-                    "description": "` + "`" + `json:\"direction\"` + "`" + `",
+                "error": {
                     "type": "string"
                 },
-                "fromPort": {
-                    "description": "` + "`" + `json:\"fromPort\"` + "`" + `",
+                "id": {
                     "type": "string"
                 },
-                "ipprotocol": {
-                    "description": "` + "`" + `json:\"ipProtocol\"` + "`" + `",
+                "leafResults": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/mcis.AutoConditionLeafResult"
+                    }
+                },
+                "mcisId": {
                     "type": "string"
                 },
-                "toPort": {
-                    "description": "` + "`" + `json:\"toPort\"` + "`" + `",
+                "message": {
                     "type": "string"
-                }
-            }
-        },
-        "mcir.SpiderSpecInfo": {
-            "type": "object",
-            "properties": {
-                "gpu": {
+                },
+                "placement": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/mcir.SpiderGpuInfo"
+                        "type": "string"
                     }
                 },
-                "keyValueList": {
+                "placementAlgo": {
+                    "description": "placement of added VMs (vmId: connectionName) by PlacementAlgo",
+                    "type": "string"
+                },
+                "policyIndex": {
+                    "type": "integer"
+                },
+                "removedVms": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/common.KeyValue"
+                        "type": "string"
                     }
                 },
-                "mem": {
+                "startTime": {
+                    "description": "RFC3339",
                     "type": "string"
                 },
-                "name": {
+                "statusFrom": {
                     "type": "string"
                 },
-                "region": {
+                "statusTo": {
                     "type": "string"
                 },
-                "vcpu": {
-                    "$ref": "#/definitions/mcir.SpiderVCpuInfo"
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "evaluation",
+                        "action"
+                    ],
+                    "example": "evaluation"
                 }
             }
         },
-        "mcir.SpiderSpecList": {
+        "mcis.McisPolicyHistoryList": {
             "type": "object",
             "properties": {
-                "vmspec": {
+                "hasMore": {
+                    "description": "more records exist after this page",
+                    "type": "boolean"
+                },
+                "history": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/mcir.SpiderSpecInfo"
+                        "$ref": "#/definitions/mcis.McisPolicyHistory"
                     }
-                }
-            }
-        },
-        "mcir.SpiderVCpuInfo": {
-            "type": "object",
-            "properties": {
-                "clock": {
-                    "description": "GHz",
-                    "type": "string"
                 },
-                "count": {
-                    "type": "string"
+                "limit": {
+                    "type": "integer"
+                },
+                "offset": {
+                    "type": "integer"
                 }
             }
         },
-        "mcir.TbImageInfo": {
+        "mcis.McisPolicyInfo": {
             "type": "object",
             "properties": {
-                "associatedObjectList": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
-                },
-                "connectionName": {
-                    "type": "string"
-                },
-                "creationDate": {
+                "Id": {
+                    "description": "MCIS Id (generated ID by the Name)",
                     "type": "string"
                 },
-                "cspImageId": {
+                "Name": {
+                    "description": "MCIS Name (for request)",
                     "type": "string"
                 },
-                "cspImageName": {
+                "actionLog": {
+                    "description": "summary of the latest history record (see ListMcisPolicyHistory for all records)",
                     "type": "string"
                 },
                 "description": {
                     "type": "string"
                 },
-                "guestOS": {
-                    "description": "Windows7, Ubuntu etc.",
+                "lastScaleInTime": {
+                    "description": "RFC3339",
                     "type": "string"
                 },
-                "id": {
+                "lastScaleOutTime": {
+                    "description": "RFC3339",
                     "type": "string"
                 },
-                "isAutoGenerated": {
-                    "type": "boolean"
+                "metricsSource": {
+                    "description": "MetricsSource is the source of VM metrics to evaluate AutoCondition (CB-Dragonfly if empty)",
+                    "$ref": "#/definitions/mcis.MetricsSource"
                 },
-                "keyValueList": {
+                "policy": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/common.KeyValue"
+                        "$ref": "#/definitions/mcis.Policy"
                     }
-                },
-                "name": {
-                    "type": "string"
-                },
-                "namespace": {
-                    "description": "required to save in RDB",
-                    "type": "string"
-                },
-                "status": {
-                    "description": "available, unavailable",
-                    "type": "string"
                 }
             }
         },
-        "mcir.TbImageReq": {
+        "mcis.McisRecommendConstraint": {
             "type": "object",
-            "required": [
-                "connectionName",
-                "cspImageId",
-                "name"
-            ],
             "properties": {
-                "connectionName": {
-                    "type": "string"
+                "candidateNum": {
+                    "description": "CandidateNum is the number of spec candidates considered for each VM requirement (default: 10)",
+                    "type": "integer",
+                    "example": 10
                 },
-                "cspImageId": {
-                    "type": "string"
+                "maxCostPerHour": {
+                    "description": "MaxCostPerHour is the budget of all VMs per hour (0: no limit). Specs without price are not selected if given.",
+                    "type": "number",
+                    "example": 1.5
                 },
-                "description": {
-                    "type": "string"
+                "minProviderNum": {
+                    "description": "MinProviderNum is the min number of cloud providers the VMs are spread across (0: no limit)",
+                    "type": "integer",
+                    "example": 2
                 },
-                "name": {
-                    "type": "string"
+                "sameRegion": {
+                    "description": "SameRegion is to place all VMs in one region",
+                    "type": "boolean",
+                    "example": false
                 }
             }
         },
-        "mcir.TbSecurityGroupInfo": {
+        "mcis.McisRecommendPlanInfo": {
             "type": "object",
             "properties": {
-                "associatedObjectList": {
+                "mcis": {
+                    "description": "Mcis is the created MCIS (deploy=true)",
+                    "$ref": "#/definitions/mcis.TbMcisInfo"
+                },
+                "mcisReq": {
+                    "description": "McisReq is ready to be submitted to POST /ns/{nsId}/mcisDynamic",
+                    "$ref": "#/definitions/mcis.TbMcisDynamicReq"
+                },
+                "providers": {
                     "type": "array",
                     "items": {
                         "type": "string"
                     }
                 },
-                "connectionName": {
-                    "type": "string"
-                },
-                "cspSecurityGroupId": {
-                    "type": "string"
-                },
-                "cspSecurityGroupName": {
-                    "type": "string"
-                },
-                "description": {
-                    "type": "string"
-                },
-                "firewallRules": {
+                "regions": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/mcir.SpiderSecurityRuleInfo"
+                        "type": "string"
                     }
                 },
-                "id": {
-                    "type": "string"
+                "score": {
+                    "type": "number"
                 },
-                "isAutoGenerated": {
-                    "type": "boolean"
+                "totalCostPerHour": {
+                    "type": "number"
                 },
-                "keyValueList": {
+                "vm": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/common.KeyValue"
+                        "$ref": "#/definitions/mcis.VmRecommendPlanInfo"
                     }
-                },
-                "name": {
-                    "type": "string"
-                },
-                "vNetId": {
-                    "type": "string"
                 }
             }
         },
-        "mcir.TbSecurityGroupReq": {
+        "mcis.McisRecommendPlanReq": {
             "type": "object",
             "required": [
-                "connectionName",
-                "firewallRules",
                 "name",
-                "vNetId"
+                "vm"
             ],
             "properties": {
-                "connectionName": {
-                    "type": "string"
+                "constraint": {
+                    "$ref": "#/definitions/mcis.McisRecommendConstraint"
                 },
                 "description": {
-                    "type": "string"
+                    "type": "string",
+                    "example": "Made in CB-TB"
                 },
-                "firewallRules": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/mcir.SpiderSecurityRuleInfo"
-                    }
+                "installMonAgent": {
+                    "type": "string",
+                    "default": "yes",
+                    "enum": [
+                        "yes",
+                        "no"
+                    ],
+                    "example": "no"
+                },
+                "label": {
+                    "type": "string",
+                    "example": "RecommendedMcis"
                 },
                 "name": {
-                    "type": "string"
+                    "type": "string",
+                    "example": "mcis01"
                 },
-                "vNetId": {
-                    "type": "string"
+                "vm": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/mcis.VmRecommendPlanReq"
+                    }
                 }
             }
         },
-        "mcir.TbSpecInfo": {
+        "mcis.McisStatusInfo": {
             "type": "object",
             "properties": {
-                "associatedObjectList": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "id": {
+                    "type": "string"
                 },
-                "connectionName": {
+                "installMonAgent": {
+                    "description": "InstallMonAgent Option for CB-Dragonfly agent installation ([yes/no] default:yes)",
+                    "type": "string",
+                    "example": "[yes, no]"
+                },
+                "label": {
+                    "description": "Label is for describing the mcis in a keyword (any string can be used)",
+                    "type": "string",
+                    "example": "User custom label"
+                },
+                "masterIp": {
+                    "type": "string",
+                    "example": "32.201.134.113"
+                },
+                "masterSSHPort": {
                     "type": "string"
                 },
-                "costPerHour": {
-                    "type": "number"
+                "masterVmId": {
+                    "type": "string",
+                    "example": "vm-asiaeast1-cb-01"
                 },
-                "cspSpecName": {
+                "name": {
                     "type": "string"
                 },
-                "description": {
+                "status": {
                     "type": "string"
                 },
-                "ebsBwMbps": {
-                    "type": "integer"
+                "statusCount": {
+                    "$ref": "#/definitions/mcis.StatusCountInfo"
                 },
-                "evaluationScore01": {
-                    "type": "number"
+                "systemLabel": {
+                    "description": "SystemLabel is for describing the mcis in a keyword (any string can be used) for special System purpose",
+                    "type": "string",
+                    "example": "Managed by CB-Tumblebug"
                 },
-                "evaluationScore02": {
-                    "type": "number"
+                "targetAction": {
+                    "type": "string"
                 },
-                "evaluationScore03": {
-                    "type": "number"
+                "targetStatus": {
+                    "type": "string"
                 },
-                "evaluationScore04": {
-                    "type": "number"
+                "vm": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/mcis.TbVmStatusInfo"
+                    }
+                }
+            }
+        },
+        "mcis.MetricForecastAccuracy": {
+            "type": "object",
+            "properties": {
+                "leadBucket": {
+                    "description": "how many buckets ahead the backtest forecasts",
+                    "type": "integer"
                 },
-                "evaluationScore05": {
+                "mae": {
+                    "description": "mean absolute error",
                     "type": "number"
                 },
-                "evaluationScore06": {
+                "mape": {
+                    "description": "mean absolute percentage error (%, buckets with 0 are excluded)",
                     "type": "number"
                 },
-                "evaluationScore07": {
+                "rmse": {
+                    "description": "root mean square error",
                     "type": "number"
                 },
-                "evaluationScore08": {
-                    "type": "number"
+                "samples": {
+                    "description": "number of buckets compared",
+                    "type": "integer"
+                }
+            }
+        },
+        "mcis.MetricForecastPoint": {
+            "type": "object",
+            "properties": {
+                "time": {
+                    "description": "start of the bucket (RFC3339)",
+                    "type": "string"
                 },
-                "evaluationScore09": {
+                "value": {
                     "type": "number"
+                }
+            }
+        },
+        "mcis.MetricsSource": {
+            "type": "object",
+            "properties": {
+                "prometheusQuery": {
+                    "description": "PrometheusQuery is PromQL for each metric (cpu, mem, disk, net, load) to override the default query for node_exporter.\n{{nsId}} and {{mcisId}} in the query are replaced. The net should be a rate (bytes/s) since values are retained and averaged.",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
                 },
-                "evaluationScore10": {
-                    "type": "number"
+                "prometheusUrl": {
+                    "description": "Fields for prometheus provider",
+                    "type": "string",
+                    "example": "http://localhost:9090"
                 },
-                "evaluationStatus": {
-                    "type": "string"
+                "prometheusVmLabel": {
+                    "description": "PrometheusVmLabel is the label of the result to find the VM by VM ID or IP (default: instance)",
+                    "type": "string",
+                    "example": "instance"
                 },
-                "gpuMemGiB": {
-                    "type": "number"
+                "provider": {
+                    "type": "string",
+                    "enum": [
+                        "dragonfly",
+                        "prometheus",
+                        "ssh"
+                    ],
+                    "example": "dragonfly"
                 },
-                "gpuModel": {
+                "sshUserName": {
+                    "description": "Fields for ssh provider",
                     "type": "string"
-                },
-                "gpuP2p": {
+                }
+            }
+        },
+        "mcis.MonResultSimple": {
+            "type": "object",
+            "properties": {
+                "err": {
                     "type": "string"
                 },
-                "id": {
+                "metric": {
                     "type": "string"
                 },
-                "isAutoGenerated": {
-                    "type": "boolean"
-                },
-                "maxNumStorage": {
-                    "type": "integer"
-                },
-                "maxTotalStorageTiB": {
-                    "type": "integer"
-                },
-                "memGiB": {
-                    "type": "number"
-                },
-                "name": {
+                "value": {
                     "type": "string"
                 },
-                "namespace": {
-                    "description": "required to save in RDB",
+                "vmId": {
+                    "type": "string"
+                }
+            }
+        },
+        "mcis.MonResultSimpleResponse": {
+            "type": "object",
+            "properties": {
+                "mcisId": {
                     "type": "string"
                 },
-                "netBwGbps": {
-                    "type": "integer"
-                },
-                "numCore": {
-                    "type": "integer"
-                },
-                "numGpu": {
-                    "type": "integer"
-                },
-                "numStorage": {
-                    "type": "integer"
-                },
-                "numvCPU": {
-                    "type": "integer"
-                },
-                "orderInFilteredResult": {
-                    "type": "integer"
+                "mcisMonitoring": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/mcis.MonResultSimple"
+                    }
                 },
-                "osType": {
+                "nsId": {
                     "type": "string"
+                }
+            }
+        },
+        "mcis.Operation": {
+            "type": "object",
+            "properties": {
+                "operand": {
+                    "description": "10, 70, 80, 98, ...",
+                    "type": "string",
+                    "enum": [
+                        "4",
+                        "8",
+                        ".."
+                    ],
+                    "example": "4"
+                },
+                "operator": {
+                    "description": "\u003e=, \u003c=, ==",
+                    "type": "string",
+                    "enum": [
+                        "\u003e=",
+                        "\u003c=",
+                        "=="
+                    ],
+                    "example": "\u003c="
+                }
+            }
+        },
+        "mcis.ParameterKeyVal": {
+            "type": "object",
+            "properties": {
+                "key": {
+                    "description": "coordinate",
+                    "type": "string",
+                    "enum": [
+                        "coordinateClose",
+                        "coordinateWithin",
+                        "coordinateFair",
+                        "latencyTo"
+                    ],
+                    "example": "coordinateClose"
                 },
-                "storageGiB": {
-                    "type": "integer"
+                "val": {
+                    "description": "[\"Latitude,Longitude\",\"12,543\",..,\"31,433\"], latencyTo: [\"aws/ap-northeast-2\",\"37.5/127.0\"] (endpoints without registered latency are skipped)",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "44.146838/-116.411403"
+                    ]
                 }
             }
         },
-        "mcir.TbSpecReq": {
+        "mcis.Policy": {
             "type": "object",
-            "required": [
-                "connectionName",
-                "cspSpecName",
-                "name"
-            ],
             "properties": {
-                "connectionName": {
-                    "type": "string"
+                "autoAction": {
+                    "$ref": "#/definitions/mcis.AutoAction"
                 },
-                "cspSpecName": {
-                    "type": "string"
+                "autoCondition": {
+                    "$ref": "#/definitions/mcis.AutoCondition"
                 },
-                "description": {
-                    "type": "string"
+                "autoPredict": {
+                    "$ref": "#/definitions/mcis.AutoPredict"
                 },
-                "name": {
+                "autoSchedule": {
+                    "$ref": "#/definitions/mcis.AutoSchedule"
+                },
+                "status": {
                     "type": "string"
                 }
             }
         },
-        "mcir.TbSshKeyInfo": {
+        "mcis.PriorityCondition": {
             "type": "object",
             "properties": {
-                "associatedObjectList": {
+                "metric": {
+                    "type": "string",
+                    "enum": [
+                        "location",
+                        "cost",
+                        "performance",
+                        "latency",
+                        "evaluationScore01",
+                        "...",
+                        "evaluationScore10"
+                    ],
+                    "example": "location"
+                },
+                "parameter": {
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/mcis.ParameterKeyVal"
                     }
                 },
-                "connectionName": {
-                    "type": "string"
-                },
-                "cspSshKeyName": {
-                    "type": "string"
-                },
-                "description": {
-                    "type": "string"
-                },
-                "fingerprint": {
-                    "type": "string"
-                },
-                "id": {
-                    "type": "string"
-                },
-                "isAutoGenerated": {
-                    "type": "boolean"
-                },
-                "keyValueList": {
+                "weight": {
+                    "description": "0.3 (relative, 1 if empty)",
+                    "type": "string",
+                    "enum": [
+                        "0.1",
+                        "0.2",
+                        "..."
+                    ],
+                    "example": "0.3"
+                }
+            }
+        },
+        "mcis.PriorityInfo": {
+            "type": "object",
+            "properties": {
+                "policy": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/common.KeyValue"
+                        "$ref": "#/definitions/mcis.PriorityCondition"
                     }
-                },
-                "name": {
-                    "type": "string"
-                },
-                "privateKey": {
-                    "type": "string"
-                },
-                "publicKey": {
-                    "type": "string"
-                },
-                "username": {
-                    "type": "string"
-                },
-                "verifiedUsername": {
-                    "type": "string"
                 }
             }
         },
-        "mcir.TbSshKeyReq": {
+        "mcis.RegionInfo": {
             "type": "object",
-            "required": [
-                "connectionName",
-                "name"
-            ],
             "properties": {
-                "connectionName": {
-                    "type": "string"
-                },
-                "description": {
+                "region": {
                     "type": "string"
                 },
-                "name": {
+                "zone": {
                     "type": "string"
                 }
             }
         },
-        "mcir.TbSubnetInfo": {
+        "mcis.RestGetAllBenchmarkRequest": {
             "type": "object",
-            "required": [
-                "ipv4_CIDR",
-                "name"
-            ],
             "properties": {
-                "description": {
-                    "type": "string"
-                },
-                "id": {
-                    "type": "string"
-                },
-                "ipv4_CIDR": {
+                "host": {
                     "type": "string"
-                },
-                "keyValueList": {
+                }
+            }
+        },
+        "mcis.RestGetAllMcisHealthPolicyResponse": {
+            "type": "object",
+            "properties": {
+                "healthPolicy": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/common.KeyValue"
+                        "$ref": "#/definitions/mcis.McisHealthPolicy"
                     }
-                },
-                "name": {
-                    "type": "string"
                 }
             }
         },
-        "mcir.TbSubnetReq": {
+        "mcis.RestGetAllMcisPolicyResponse": {
             "type": "object",
-            "required": [
-                "ipv4_CIDR",
-                "name"
-            ],
             "properties": {
-                "description": {
-                    "type": "string"
-                },
-                "ipv4_CIDR": {
-                    "type": "string"
-                },
-                "keyValueList": {
+                "mcisPolicy": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/common.KeyValue"
+                        "$ref": "#/definitions/mcis.McisPolicyInfo"
                     }
-                },
-                "name": {
-                    "type": "string"
                 }
             }
         },
-        "mcir.TbVNetInfo": {
+        "mcis.RestGetAllMcisResponse": {
             "type": "object",
             "properties": {
-                "associatedObjectList": {
+                "mcis": {
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/mcis.TbMcisInfo"
                     }
-                },
-                "cidrBlock": {
-                    "type": "string"
-                },
-                "connectionName": {
-                    "type": "string"
-                },
-                "cspVNetId": {
-                    "type": "string"
-                },
-                "cspVNetName": {
-                    "type": "string"
-                },
-                "description": {
-                    "type": "string"
-                },
-                "id": {
-                    "type": "string"
-                },
-                "isAutoGenerated": {
-                    "type": "boolean"
-                },
-                "keyValueList": {
+                }
+            }
+        },
+        "mcis.RestGetAllMcisStatusResponse": {
+            "type": "object",
+            "properties": {
+                "mcis": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/common.KeyValue"
+                        "$ref": "#/definitions/mcis.McisStatusInfo"
                     }
-                },
-                "name": {
-                    "type": "string"
-                },
-                "status": {
-                    "type": "string"
-                },
-                "subnetInfoList": {
+                }
+            }
+        },
+        "mcis.RestGetAllScriptResponse": {
+            "type": "object",
+            "properties": {
+                "script": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/mcir.TbSubnetInfo"
+                        "$ref": "#/definitions/mcis.TbScriptInfo"
                     }
-                },
-                "systemLabel": {
-                    "description": "SystemLabel is for describing the MCIR in a keyword (any string can be used) for special System purpose",
-                    "type": "string",
-                    "example": "Managed by CB-Tumblebug"
                 }
             }
         },
-        "mcir.TbVNetReq": {
+        "mcis.RestGetBenchmarkRequest": {
             "type": "object",
-            "required": [
-                "connectionName",
-                "name"
-            ],
             "properties": {
-                "cidrBlock": {
-                    "type": "string"
-                },
-                "connectionName": {
-                    "type": "string"
-                },
-                "description": {
-                    "type": "string"
-                },
-                "name": {
+                "host": {
                     "type": "string"
-                },
-                "subnetInfoList": {
+                }
+            }
+        },
+        "mcis.RestGetMcisHealthLogResponse": {
+            "type": "object",
+            "properties": {
+                "log": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/mcis.McisHealthLog"
+                    }
+                }
+            }
+        },
+        "mcis.RestGetScriptRunResponse": {
+            "type": "object",
+            "properties": {
+                "run": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/mcis.TbScriptRunInfo"
+                    }
+                }
+            }
+        },
+        "mcis.RestGetScriptVersionResponse": {
+            "type": "object",
+            "properties": {
+                "version": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/mcir.TbSubnetReq"
+                        "$ref": "#/definitions/mcis.TbScriptInfo"
                     }
                 }
             }
         },
-        "mcis.AgentInstallContent": {
+        "mcis.RestPostCmdMcisResponse": {
             "type": "object",
             "properties": {
                 "mcisId": {
@@ -5685,801 +9575,833 @@ var doc = `{
                 }
             }
         },
-        "mcis.AgentInstallContentWrapper": {
+        "mcis.RestPostCmdMcisResponseWrapper": {
             "type": "object",
             "properties": {
                 "resultArray": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/mcis.AgentInstallContent"
+                        "$ref": "#/definitions/mcis.RestPostCmdMcisResponse"
                     }
                 }
             }
         },
-        "mcis.AutoAction": {
+        "mcis.RestPostCmdMcisVmResponse": {
             "type": "object",
             "properties": {
-                "actionType": {
-                    "type": "string"
-                },
-                "placementAlgo": {
+                "result": {
                     "type": "string"
-                },
-                "postCommand": {
-                    "$ref": "#/definitions/mcis.McisCmdReq"
-                },
-                "vm": {
-                    "$ref": "#/definitions/mcis.TbVmInfo"
                 }
             }
         },
-        "mcis.AutoCondition": {
+        "mcis.SpiderVMInfo": {
             "type": "object",
             "properties": {
-                "evaluationPeriod": {
-                    "description": "evaluationPeriod",
+                "iid": {
+                    "description": "Fields for response",
+                    "$ref": "#/definitions/common.IID"
+                },
+                "imageIId": {
+                    "$ref": "#/definitions/common.IID"
+                },
+                "imageName": {
                     "type": "string"
                 },
-                "evaluationValue": {
+                "keyPairIId": {
+                    "$ref": "#/definitions/common.IID"
+                },
+                "keyPairName": {
+                    "type": "string"
+                },
+                "keyValueList": {
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/common.KeyValue"
                     }
                 },
-                "metric": {
+                "name": {
+                    "description": "Fields for request",
                     "type": "string"
                 },
-                "operand": {
-                    "description": "10, 70, 80, 98, ...",
+                "networkInterface": {
+                    "description": "ex) eth0",
                     "type": "string"
                 },
-                "operator": {
-                    "description": "\u003c, \u003c=, \u003e, \u003e=, ...",
+                "privateDNS": {
                     "type": "string"
-                }
-            }
-        },
-        "mcis.BenchmarkInfo": {
-            "type": "object",
-            "properties": {
-                "desc": {
+                },
+                "privateIP": {
                     "type": "string"
                 },
-                "elapsed": {
+                "publicDNS": {
                     "type": "string"
                 },
-                "result": {
+                "publicIP": {
                     "type": "string"
                 },
-                "resultarray": {
-                    "description": "struct-element cycle ?",
+                "region": {
+                    "description": "ex) {us-east1, us-east1-c} or {ap-northeast-2}",
+                    "$ref": "#/definitions/mcis.RegionInfo"
+                },
+                "securityGroupIIds": {
+                    "description": "AWS, ex) sg-0b7452563e1121bb6",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/mcis.BenchmarkInfo"
+                        "$ref": "#/definitions/common.IID"
                     }
                 },
-                "specid": {
-                    "type": "string"
-                },
-                "unit": {
-                    "type": "string"
-                }
-            }
-        },
-        "mcis.BenchmarkInfoArray": {
-            "type": "object",
-            "properties": {
-                "resultarray": {
+                "securityGroupNames": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/mcis.BenchmarkInfo"
+                        "type": "string"
                     }
-                }
-            }
-        },
-        "mcis.DeploymentPlan": {
-            "type": "object",
-            "properties": {
-                "filter": {
-                    "$ref": "#/definitions/mcis.FilterInfo"
                 },
-                "limit": {
-                    "type": "string",
-                    "enum": [
-                        "1",
-                        "2",
-                        "...",
-                        "30",
-                        "..."
-                    ],
-                    "example": "5"
+                "sshaccessPoint": {
+                    "type": "string"
                 },
-                "priority": {
-                    "$ref": "#/definitions/mcis.PriorityInfo"
-                }
-            }
-        },
-        "mcis.FilterCondition": {
-            "type": "object",
-            "properties": {
-                "condition": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/mcis.Operation"
-                    }
+                "startTime": {
+                    "description": "Timezone: based on cloud-barista server location.",
+                    "type": "string"
                 },
-                "metric": {
-                    "type": "string",
-                    "enum": [
-                        "cpu",
-                        "memory",
-                        "cost"
-                    ],
-                    "example": "cpu"
-                }
-            }
-        },
-        "mcis.FilterInfo": {
-            "type": "object",
-            "properties": {
-                "policy": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/mcis.FilterCondition"
-                    }
+                "subnetIID": {
+                    "description": "AWS, ex) subnet-8c4a53e4",
+                    "$ref": "#/definitions/common.IID"
+                },
+                "subnetName": {
+                    "type": "string"
+                },
+                "userData": {
+                    "description": "base64 encoded cloud-init user data",
+                    "type": "string"
+                },
+                "vmblockDisk": {
+                    "description": "ex)",
+                    "type": "string"
+                },
+                "vmbootDisk": {
+                    "description": "ex) /dev/sda1",
+                    "type": "string"
+                },
+                "vmspecName": {
+                    "description": "Fields for both request and response",
+                    "type": "string"
+                },
+                "vmuserId": {
+                    "description": "ex) user1",
+                    "type": "string"
+                },
+                "vmuserPasswd": {
+                    "type": "string"
+                },
+                "vpcIID": {
+                    "$ref": "#/definitions/common.IID"
+                },
+                "vpcname": {
+                    "type": "string"
                 }
             }
         },
-        "mcis.JSONResult": {
-            "type": "object"
-        },
-        "mcis.McisCmdReq": {
+        "mcis.SshKeyRotateVmResult": {
             "type": "object",
-            "required": [
-                "command"
-            ],
             "properties": {
-                "command": {
-                    "type": "string",
-                    "example": "sudo apt-get install ..."
+                "mcisId": {
+                    "type": "string"
                 },
-                "userName": {
+                "message": {
+                    "type": "string"
+                },
+                "status": {
                     "type": "string",
-                    "example": "cb-user"
+                    "example": "Rotated"
+                },
+                "vmId": {
+                    "type": "string"
+                },
+                "vmIp": {
+                    "type": "string"
                 }
             }
         },
-        "mcis.McisPolicyInfo": {
+        "mcis.StatusCountInfo": {
             "type": "object",
             "properties": {
-                "Id": {
-                    "description": "MCIS Id (generated ID by the Name)",
-                    "type": "string"
+                "countCreating": {
+                    "description": "CountCreating is for counting Creating",
+                    "type": "integer"
                 },
-                "Name": {
-                    "description": "MCIS Name (for request)",
-                    "type": "string"
+                "countFailed": {
+                    "description": "CountFailed is for counting Failed",
+                    "type": "integer"
                 },
-                "actionLog": {
-                    "type": "string"
+                "countRebooting": {
+                    "description": "CountRebooting is for counting Rebooting",
+                    "type": "integer"
                 },
-                "description": {
-                    "type": "string"
+                "countResuming": {
+                    "description": "CountResuming is for counting Resuming",
+                    "type": "integer"
+                },
+                "countRunning": {
+                    "description": "CountRunning is for counting Running",
+                    "type": "integer"
+                },
+                "countSuspended": {
+                    "description": "CountSuspended is for counting Suspended",
+                    "type": "integer"
+                },
+                "countSuspending": {
+                    "description": "CountSuspending is for counting Suspending",
+                    "type": "integer"
+                },
+                "countTerminated": {
+                    "description": "CountTerminated is for counting Terminated",
+                    "type": "integer"
+                },
+                "countTerminating": {
+                    "description": "CountTerminating is for counting Terminating",
+                    "type": "integer"
+                },
+                "countTotal": {
+                    "description": "CountTotal is for Total VMs",
+                    "type": "integer"
                 },
-                "policy": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/mcis.Policy"
-                    }
+                "countUndefined": {
+                    "description": "CountUndefined is for counting Undefined",
+                    "type": "integer"
                 }
             }
         },
-        "mcis.McisStatusInfo": {
+        "mcis.TbBudgetAlert": {
             "type": "object",
             "properties": {
-                "id": {
+                "createdTime": {
                     "type": "string"
                 },
-                "installMonAgent": {
-                    "description": "InstallMonAgent Option for CB-Dragonfly agent installation ([yes/no] default:yes)",
-                    "type": "string",
-                    "example": "[yes, no]"
-                },
-                "label": {
-                    "description": "Label is for describing the mcis in a keyword (any string can be used)",
-                    "type": "string",
-                    "example": "User custom label"
-                },
-                "masterIp": {
-                    "type": "string",
-                    "example": "32.201.134.113"
+                "id": {
+                    "type": "string"
                 },
-                "masterSSHPort": {
+                "message": {
                     "type": "string"
                 },
-                "masterVmId": {
+                "month": {
                     "type": "string",
-                    "example": "vm-asiaeast1-cb-01"
+                    "example": "2022-01"
                 },
-                "name": {
-                    "type": "string"
+                "monthlyLimit": {
+                    "type": "number"
                 },
-                "status": {
+                "nsId": {
                     "type": "string"
                 },
-                "statusCount": {
-                    "$ref": "#/definitions/mcis.StatusCountInfo"
-                },
-                "systemLabel": {
-                    "description": "SystemLabel is for describing the mcis in a keyword (any string can be used) for special System purpose",
-                    "type": "string",
-                    "example": "Managed by CB-Tumblebug"
+                "spent": {
+                    "type": "number"
                 },
-                "targetAction": {
-                    "type": "string"
+                "threshold": {
+                    "type": "number",
+                    "example": 80
                 },
-                "targetStatus": {
-                    "type": "string"
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "threshold",
+                        "enforcement"
+                    ]
                 },
-                "vm": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/mcis.TbVmStatusInfo"
-                    }
+                "usedPercent": {
+                    "type": "number"
                 }
             }
         },
-        "mcis.MonResultSimple": {
+        "mcis.TbBudgetEnforcement": {
             "type": "object",
             "properties": {
-                "err": {
-                    "type": "string"
-                },
-                "metric": {
-                    "type": "string"
+                "blockProvisioning": {
+                    "description": "BlockProvisioning is to reject new VMs (CreateMcis, adding VMs and ScaleOut of auto control)",
+                    "type": "boolean",
+                    "example": true
                 },
-                "value": {
-                    "type": "string"
-                },
-                "vmId": {
-                    "type": "string"
+                "suspendLabel": {
+                    "description": "SuspendLabel is to suspend MCISs with the label (no suspension if empty)",
+                    "type": "string",
+                    "example": "non-critical"
                 }
             }
         },
-        "mcis.MonResultSimpleResponse": {
+        "mcis.TbBudgetInfo": {
             "type": "object",
             "properties": {
-                "mcisId": {
-                    "type": "string"
-                },
-                "mcisMonitoring": {
+                "alertedThreshold": {
+                    "description": "AlertedThreshold is the thresholds already alerted in this month",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/mcis.MonResultSimple"
+                        "type": "number"
                     }
                 },
-                "nsId": {
+                "enforcement": {
+                    "$ref": "#/definitions/mcis.TbBudgetEnforcement"
+                },
+                "exhausted": {
+                    "type": "boolean"
+                },
+                "lastCheckTime": {
                     "type": "string"
-                }
-            }
-        },
-        "mcis.Operation": {
-            "type": "object",
-            "properties": {
-                "operand": {
-                    "description": "10, 70, 80, 98, ...",
-                    "type": "string",
-                    "enum": [
-                        "4",
-                        "8",
-                        ".."
-                    ],
-                    "example": "4"
                 },
-                "operator": {
-                    "description": "\u003e=, \u003c=, ==",
-                    "type": "string",
-                    "enum": [
-                        "\u003e=",
-                        "\u003c=",
-                        "=="
-                    ],
-                    "example": "\u003c="
-                }
-            }
-        },
-        "mcis.ParameterKeyVal": {
-            "type": "object",
-            "properties": {
-                "key": {
-                    "description": "coordinate",
+                "month": {
+                    "description": "Month is the month of Spent and AlertedThreshold (YYYY-MM, UTC)",
                     "type": "string",
-                    "enum": [
-                        "coordinateClose",
-                        "coordinateWithin",
-                        "coordinateFair"
-                    ],
-                    "example": "coordinateClose"
+                    "example": "2022-01"
                 },
-                "val": {
-                    "description": "[\"Latitude,Longitude\",\"12,543\",..,\"31,433\"]",
+                "monthlyLimit": {
+                    "description": "MonthlyLimit is the limit of cost in a month (currency of the pricing policy)",
+                    "type": "number",
+                    "example": 1000
+                },
+                "nsId": {
+                    "type": "string"
+                },
+                "projectedMonthlyCost": {
+                    "description": "ProjectedMonthlyCost is Spent + the cost of running VMs until the end of this month",
+                    "type": "number"
+                },
+                "spent": {
+                    "description": "Spent is the cost of this month so far",
+                    "type": "number"
+                },
+                "threshold": {
+                    "description": "Threshold is the list of percentages of MonthlyLimit to record alerts (50,80,100 if empty)",
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "type": "number"
                     },
                     "example": [
-                        "44.146838/-116.411403"
+                        50,
+                        80,
+                        100
                     ]
+                },
+                "usedPercent": {
+                    "description": "UsedPercent is Spent / MonthlyLimit * 100",
+                    "type": "number"
                 }
             }
         },
-        "mcis.Policy": {
+        "mcis.TbBudgetReq": {
             "type": "object",
             "properties": {
-                "autoAction": {
-                    "$ref": "#/definitions/mcis.AutoAction"
+                "enforcement": {
+                    "$ref": "#/definitions/mcis.TbBudgetEnforcement"
                 },
-                "autoCondition": {
-                    "$ref": "#/definitions/mcis.AutoCondition"
+                "monthlyLimit": {
+                    "description": "MonthlyLimit is the limit of cost in a month (currency of the pricing policy)",
+                    "type": "number",
+                    "example": 1000
                 },
-                "status": {
-                    "type": "string"
+                "threshold": {
+                    "description": "Threshold is the list of percentages of MonthlyLimit to record alerts (50,80,100 if empty)",
+                    "type": "array",
+                    "items": {
+                        "type": "number"
+                    },
+                    "example": [
+                        50,
+                        80,
+                        100
+                    ]
                 }
             }
         },
-        "mcis.PriorityCondition": {
+        "mcis.TbCostReport": {
             "type": "object",
             "properties": {
-                "metric": {
-                    "description": "location,cost,latency",
+                "from": {
+                    "type": "string"
+                },
+                "groupBy": {
                     "type": "string",
                     "enum": [
-                        "location",
-                        "cost",
-                        "latency"
-                    ],
-                    "example": "location"
+                        "vm",
+                        "mcis",
+                        "ns",
+                        "connection",
+                        "label"
+                    ]
                 },
-                "parameter": {
+                "item": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/mcis.ParameterKeyVal"
+                        "$ref": "#/definitions/mcis.TbCostReportItem"
                     }
                 },
-                "weight": {
-                    "description": "0.3",
-                    "type": "string",
-                    "enum": [
-                        "0.1",
-                        "0.2",
-                        "..."
-                    ],
-                    "example": "0.3"
-                }
-            }
-        },
-        "mcis.PriorityInfo": {
-            "type": "object",
-            "properties": {
-                "policy": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/mcis.PriorityCondition"
-                    }
-                }
-            }
-        },
-        "mcis.RegionInfo": {
-            "type": "object",
-            "properties": {
-                "region": {
+                "mcisId": {
                     "type": "string"
                 },
-                "zone": {
+                "nsId": {
                     "type": "string"
-                }
-            }
-        },
-        "mcis.RestGetAllBenchmarkRequest": {
-            "type": "object",
-            "properties": {
-                "host": {
+                },
+                "to": {
                     "type": "string"
+                },
+                "totalCost": {
+                    "type": "number"
+                },
+                "totalCurrentCostPerHour": {
+                    "type": "number"
+                },
+                "totalProjectedMonthlyCost": {
+                    "type": "number"
                 }
             }
         },
-        "mcis.RestGetAllMcisPolicyResponse": {
+        "mcis.TbCostReportItem": {
             "type": "object",
             "properties": {
-                "mcisPolicy": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/mcis.McisPolicyInfo"
-                    }
+                "cost": {
+                    "description": "Cost is the cost of running intervals in the time range of report",
+                    "type": "number"
+                },
+                "currentCostPerHour": {
+                    "description": "CurrentCostPerHour is the sum of CostPerHour of running VMs",
+                    "type": "number"
+                },
+                "group": {
+                    "description": "Group is mcisId/vmId (vm), mcisId (mcis), nsId (ns), connectionName (connection) or label (label)",
+                    "type": "string",
+                    "example": "mcis01"
+                },
+                "projectedMonthlyCost": {
+                    "description": "ProjectedMonthlyCost is the monthly cost if the current state continues (CurrentCostPerHour * 730)",
+                    "type": "number"
+                },
+                "runningHours": {
+                    "type": "number"
+                },
+                "runningVmNum": {
+                    "type": "integer"
+                },
+                "vmNum": {
+                    "type": "integer"
                 }
             }
         },
-        "mcis.RestGetAllMcisResponse": {
+        "mcis.TbInspectResourcesResponse": {
             "type": "object",
             "properties": {
-                "mcis": {
+                "resourcesOnCsp": {
+                    "description": "ResourcesOnCsp       interface{} ` + "`" + `json:\"resourcesOnCsp\"` + "`" + `\nResourcesOnSpider    interface{} ` + "`" + `json:\"resourcesOnSpider\"` + "`" + `\nResourcesOnTumblebug interface{} ` + "`" + `json:\"resourcesOnTumblebug\"` + "`" + `",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/mcis.TbMcisInfo"
+                        "$ref": "#/definitions/mcis.resourceOnCspOrSpider"
                     }
-                }
-            }
-        },
-        "mcis.RestGetAllMcisStatusResponse": {
-            "type": "object",
-            "properties": {
-                "mcis": {
+                },
+                "resourcesOnSpider": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/mcis.McisStatusInfo"
+                        "$ref": "#/definitions/mcis.resourceOnCspOrSpider"
                     }
-                }
-            }
-        },
-        "mcis.RestGetBenchmarkRequest": {
-            "type": "object",
-            "properties": {
-                "host": {
-                    "type": "string"
-                }
-            }
-        },
-        "mcis.RestPostCmdMcisResponse": {
-            "type": "object",
-            "properties": {
-                "mcisId": {
-                    "type": "string"
-                },
-                "result": {
-                    "type": "string"
-                },
-                "vmId": {
-                    "type": "string"
                 },
-                "vmIp": {
-                    "type": "string"
-                }
-            }
-        },
-        "mcis.RestPostCmdMcisResponseWrapper": {
-            "type": "object",
-            "properties": {
-                "resultArray": {
+                "resourcesOnTumblebug": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/mcis.RestPostCmdMcisResponse"
+                        "$ref": "#/definitions/mcis.resourceOnTumblebug"
                     }
                 }
             }
         },
-        "mcis.RestPostCmdMcisVmResponse": {
+        "mcis.TbMcisDynamicReq": {
             "type": "object",
+            "required": [
+                "name",
+                "vm"
+            ],
             "properties": {
-                "result": {
+                "description": {
+                    "type": "string",
+                    "example": "Made in CB-TB"
+                },
+                "installMonAgent": {
+                    "description": "InstallMonAgent Option for CB-Dragonfly agent installation ([yes/no] default:yes)",
+                    "type": "string",
+                    "default": "yes",
+                    "enum": [
+                        "yes",
+                        "no"
+                    ],
+                    "example": "no"
+                },
+                "label": {
+                    "description": "Label is for describing the mcis in a keyword (any string can be used)",
+                    "type": "string",
+                    "example": "DynamicVM"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "mcis01"
+                },
+                "systemLabel": {
+                    "description": "SystemLabel is for describing the mcis in a keyword (any string can be used) for special System purpose",
                     "type": "string"
+                },
+                "vm": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/mcis.TbVmDynamicReq"
+                    }
                 }
             }
         },
-        "mcis.SpiderVMInfo": {
+        "mcis.TbMcisInfo": {
             "type": "object",
             "properties": {
-                "iid": {
-                    "description": "Fields for response",
-                    "$ref": "#/definitions/common.IID"
-                },
-                "imageIId": {
-                    "$ref": "#/definitions/common.IID"
-                },
-                "imageName": {
+                "description": {
                     "type": "string"
                 },
-                "keyPairIId": {
-                    "$ref": "#/definitions/common.IID"
-                },
-                "keyPairName": {
+                "id": {
                     "type": "string"
                 },
-                "keyValueList": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/common.KeyValue"
-                    }
+                "installMonAgent": {
+                    "description": "InstallMonAgent Option for CB-Dragonfly agent installation ([yes/no] default:yes)",
+                    "type": "string",
+                    "default": "yes",
+                    "enum": [
+                        "yes",
+                        "no"
+                    ],
+                    "example": "yes"
+                },
+                "label": {
+                    "description": "Label is for describing the mcis in a keyword (any string can be used)",
+                    "type": "string",
+                    "example": "User custom label"
                 },
                 "name": {
-                    "description": "Fields for request",
                     "type": "string"
                 },
-                "networkInterface": {
-                    "description": "ex) eth0",
+                "placementAlgo": {
                     "type": "string"
                 },
-                "privateDNS": {
+                "status": {
                     "type": "string"
                 },
-                "privateIP": {
-                    "type": "string"
+                "statusCount": {
+                    "$ref": "#/definitions/mcis.StatusCountInfo"
                 },
-                "publicDNS": {
-                    "type": "string"
+                "systemLabel": {
+                    "description": "SystemLabel is for describing the mcis in a keyword (any string can be used) for special System purpose",
+                    "type": "string",
+                    "example": "Managed by CB-Tumblebug"
                 },
-                "publicIP": {
+                "targetAction": {
                     "type": "string"
                 },
-                "region": {
-                    "description": "ex) {us-east1, us-east1-c} or {ap-northeast-2}",
-                    "$ref": "#/definitions/mcis.RegionInfo"
-                },
-                "securityGroupIIds": {
-                    "description": "AWS, ex) sg-0b7452563e1121bb6",
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/common.IID"
-                    }
+                "targetStatus": {
+                    "type": "string"
                 },
-                "securityGroupNames": {
+                "vm": {
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/mcis.TbVmInfo"
                     }
+                }
+            }
+        },
+        "mcis.TbMcisReq": {
+            "type": "object",
+            "required": [
+                "name",
+                "vm"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "example": "Made in CB-TB"
                 },
-                "sshaccessPoint": {
-                    "type": "string"
+                "installMonAgent": {
+                    "description": "InstallMonAgent Option for CB-Dragonfly agent installation ([yes/no] default:yes)",
+                    "type": "string",
+                    "default": "yes",
+                    "enum": [
+                        "yes",
+                        "no"
+                    ],
+                    "example": "no"
                 },
-                "startTime": {
-                    "description": "Timezone: based on cloud-barista server location.",
-                    "type": "string"
+                "label": {
+                    "description": "Label is for describing the mcis in a keyword (any string can be used)",
+                    "type": "string",
+                    "example": "custom tag"
                 },
-                "subnetIID": {
-                    "description": "AWS, ex) subnet-8c4a53e4",
-                    "$ref": "#/definitions/common.IID"
+                "name": {
+                    "type": "string",
+                    "example": "mcis01"
                 },
-                "subnetName": {
+                "placementAlgo": {
                     "type": "string"
                 },
-                "vmblockDisk": {
-                    "description": "ex)",
+                "systemLabel": {
+                    "description": "SystemLabel is for describing the mcis in a keyword (any string can be used) for special System purpose",
                     "type": "string"
                 },
-                "vmbootDisk": {
-                    "description": "ex) /dev/sda1",
+                "vm": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/mcis.TbVmReq"
+                    }
+                }
+            }
+        },
+        "mcis.TbScriptInfo": {
+            "type": "object",
+            "properties": {
+                "body": {
                     "type": "string"
                 },
-                "vmspecName": {
-                    "description": "Fields for both request and response",
+                "createdTime": {
+                    "type": "string",
+                    "example": "2022-11-10 23:00:00"
+                },
+                "description": {
                     "type": "string"
                 },
-                "vmuserId": {
-                    "description": "ex) user1",
+                "id": {
                     "type": "string"
                 },
-                "vmuserPasswd": {
+                "name": {
                     "type": "string"
                 },
-                "vpcIID": {
-                    "$ref": "#/definitions/common.IID"
+                "params": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
                 },
-                "vpcname": {
+                "targetOs": {
                     "type": "string"
+                },
+                "updatedTime": {
+                    "type": "string",
+                    "example": "2022-11-10 23:00:00"
+                },
+                "version": {
+                    "description": "Version is increased whenever the script is updated",
+                    "type": "integer"
                 }
             }
         },
-        "mcis.StatusCountInfo": {
+        "mcis.TbScriptReq": {
             "type": "object",
+            "required": [
+                "body",
+                "name"
+            ],
             "properties": {
-                "countCreating": {
-                    "description": "CountCreating is for counting Creating",
-                    "type": "integer"
-                },
-                "countFailed": {
-                    "description": "CountFailed is for counting Failed",
-                    "type": "integer"
-                },
-                "countRebooting": {
-                    "description": "CountRebooting is for counting Rebooting",
-                    "type": "integer"
-                },
-                "countResuming": {
-                    "description": "CountResuming is for counting Resuming",
-                    "type": "integer"
-                },
-                "countRunning": {
-                    "description": "CountRunning is for counting Running",
-                    "type": "integer"
-                },
-                "countSuspended": {
-                    "description": "CountSuspended is for counting Suspended",
-                    "type": "integer"
-                },
-                "countSuspending": {
-                    "description": "CountSuspending is for counting Suspending",
-                    "type": "integer"
+                "body": {
+                    "description": "Body is a shell script with Go-template placeholders such as {{.VmId}}, {{.PublicIP}}, {{.PrivateIP}}, {{.McisId}} and {{.Param.port}}\n(user params and the label are rendered as single-quoted shell words)",
+                    "type": "string",
+                    "example": "sudo apt-get install -y nginx; echo {{.VmId}} {{.Param.port}}"
                 },
-                "countTerminated": {
-                    "description": "CountTerminated is for counting Terminated",
-                    "type": "integer"
+                "description": {
+                    "type": "string"
                 },
-                "countTerminating": {
-                    "description": "CountTerminating is for counting Terminating",
-                    "type": "integer"
+                "name": {
+                    "type": "string",
+                    "example": "install-nginx"
                 },
-                "countTotal": {
-                    "description": "CountTotal is for Total VMs",
-                    "type": "integer"
+                "params": {
+                    "description": "Params is a map of default values for user params ({{.Param.key}})",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
                 },
-                "countUndefined": {
-                    "description": "CountUndefined is for counting Undefined",
-                    "type": "integer"
+                "targetOs": {
+                    "description": "TargetOs is a hint of the guest OS the script is written for (ex: ubuntu, centos). VMs with another known OS are skipped.",
+                    "type": "string",
+                    "example": "ubuntu"
                 }
             }
         },
-        "mcis.TbInspectResourcesResponse": {
+        "mcis.TbScriptRunInfo": {
             "type": "object",
             "properties": {
-                "resourcesOnCsp": {
-                    "description": "ResourcesOnCsp       interface{} ` + "`" + `json:\"resourcesOnCsp\"` + "`" + `\nResourcesOnSpider    interface{} ` + "`" + `json:\"resourcesOnSpider\"` + "`" + `\nResourcesOnTumblebug interface{} ` + "`" + `json:\"resourcesOnTumblebug\"` + "`" + `",
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/mcis.resourceOnCspOrSpider"
-                    }
+                "finishedTime": {
+                    "type": "string",
+                    "example": "2022-11-10 23:00:10"
+                },
+                "id": {
+                    "type": "string"
                 },
-                "resourcesOnSpider": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/mcis.resourceOnCspOrSpider"
+                "label": {
+                    "type": "string"
+                },
+                "mcisId": {
+                    "type": "string"
+                },
+                "params": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
                     }
                 },
-                "resourcesOnTumblebug": {
+                "results": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/mcis.resourceOnTumblebug"
+                        "$ref": "#/definitions/mcis.TbScriptRunResult"
                     }
+                },
+                "scriptId": {
+                    "type": "string"
+                },
+                "scriptVersion": {
+                    "type": "integer"
+                },
+                "startedTime": {
+                    "type": "string",
+                    "example": "2022-11-10 23:00:00"
+                },
+                "status": {
+                    "description": "Status is one of [Succeeded, PartiallyFailed, Failed]",
+                    "type": "string",
+                    "example": "Succeeded"
+                },
+                "vmGroupId": {
+                    "type": "string"
                 }
             }
         },
-        "mcis.TbMcisDynamicReq": {
+        "mcis.TbScriptRunReq": {
             "type": "object",
             "required": [
-                "name",
-                "vm"
+                "mcisId"
             ],
             "properties": {
-                "description": {
+                "label": {
+                    "description": "Label selects VMs whose label has all the comma separated terms (optional)",
                     "type": "string",
-                    "example": "Made in CB-TB"
+                    "example": "role=web"
                 },
-                "installMonAgent": {
-                    "description": "InstallMonAgent Option for CB-Dragonfly agent installation ([yes/no] default:yes)",
+                "mcisId": {
                     "type": "string",
-                    "default": "yes",
-                    "enum": [
-                        "yes",
-                        "no"
-                    ],
-                    "example": "no"
+                    "example": "mcis01"
                 },
-                "label": {
-                    "description": "Label is for describing the mcis in a keyword (any string can be used)",
-                    "type": "string",
-                    "example": "DynamicVM"
+                "params": {
+                    "description": "Params overrides default values of user params in the script",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
                 },
-                "name": {
+                "userName": {
                     "type": "string",
-                    "example": "mcis01"
+                    "example": "cb-user"
                 },
-                "systemLabel": {
-                    "description": "SystemLabel is for describing the mcis in a keyword (any string can be used) for special System purpose",
-                    "type": "string"
+                "version": {
+                    "description": "Version selects a version of the script (the latest if 0)",
+                    "type": "integer",
+                    "example": 0
                 },
-                "vm": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/mcis.TbVmDynamicReq"
-                    }
+                "vmGroupId": {
+                    "description": "VmGroupId selects VMs in the VM group (optional)",
+                    "type": "string",
+                    "example": "group-1"
                 }
             }
         },
-        "mcis.TbMcisInfo": {
+        "mcis.TbScriptRunResult": {
             "type": "object",
             "properties": {
-                "description": {
+                "err": {
                     "type": "string"
                 },
-                "id": {
+                "result": {
                     "type": "string"
                 },
-                "installMonAgent": {
-                    "description": "InstallMonAgent Option for CB-Dragonfly agent installation ([yes/no] default:yes)",
-                    "type": "string",
-                    "default": "yes",
-                    "enum": [
-                        "yes",
-                        "no"
-                    ],
-                    "example": "yes"
-                },
-                "label": {
-                    "description": "Label is for describing the mcis in a keyword (any string can be used)",
-                    "type": "string",
-                    "example": "User custom label"
-                },
-                "name": {
+                "vmId": {
                     "type": "string"
                 },
-                "placementAlgo": {
+                "vmIp": {
                     "type": "string"
-                },
-                "status": {
+                }
+            }
+        },
+        "mcis.TbSshKeyRotateReq": {
+            "type": "object",
+            "properties": {
+                "privateKey": {
+                    "description": "PrivateKey is a new private key in PEM format (a new RSA key pair is generated if empty)",
                     "type": "string"
                 },
-                "statusCount": {
-                    "$ref": "#/definitions/mcis.StatusCountInfo"
-                },
-                "systemLabel": {
-                    "description": "SystemLabel is for describing the mcis in a keyword (any string can be used) for special System purpose",
+                "userName": {
+                    "description": "UserName is the VM user name to log in (the verified user name of the key is used if empty)",
                     "type": "string",
-                    "example": "Managed by CB-Tumblebug"
-                },
-                "targetAction": {
+                    "example": "cb-user"
+                }
+            }
+        },
+        "mcis.TbSshKeyRotateResult": {
+            "type": "object",
+            "properties": {
+                "fingerprint": {
                     "type": "string"
                 },
-                "targetStatus": {
+                "sshKeyId": {
                     "type": "string"
                 },
+                "status": {
+                    "description": "Status is Succeeded if the stored key has been replaced with the new key, otherwise Failed",
+                    "type": "string",
+                    "example": "Succeeded"
+                },
                 "vm": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/mcis.TbVmInfo"
+                        "$ref": "#/definitions/mcis.SshKeyRotateVmResult"
                     }
                 }
             }
         },
-        "mcis.TbMcisReq": {
+        "mcis.TbValidationProblem": {
             "type": "object",
-            "required": [
-                "name",
-                "vm"
-            ],
             "properties": {
-                "description": {
-                    "type": "string",
-                    "example": "Made in CB-TB"
-                },
-                "installMonAgent": {
-                    "description": "InstallMonAgent Option for CB-Dragonfly agent installation ([yes/no] default:yes)",
+                "code": {
                     "type": "string",
-                    "default": "yes",
                     "enum": [
-                        "yes",
-                        "no"
+                        "required",
+                        "notFound",
+                        "alreadyExists",
+                        "duplicated",
+                        "connectionMismatch",
+                        "vNetMismatch",
+                        "architectureMismatch",
+                        "osMismatch",
+                        "diskTooSmall"
                     ],
-                    "example": "no"
+                    "example": "architectureMismatch"
                 },
-                "label": {
-                    "description": "Label is for describing the mcis in a keyword (any string can be used)",
+                "field": {
                     "type": "string",
-                    "example": "custom tag"
+                    "example": "imageId"
                 },
-                "name": {
+                "message": {
                     "type": "string",
-                    "example": "mcis01"
-                },
-                "placementAlgo": {
-                    "type": "string"
-                },
-                "systemLabel": {
-                    "description": "SystemLabel is for describing the mcis in a keyword (any string can be used) for special System purpose",
-                    "type": "string"
+                    "example": "The architecture of image (arm64) is not compatible with spec (x86_64)"
                 },
-                "vm": {
+                "vmName": {
+                    "type": "string",
+                    "example": "vm01"
+                }
+            }
+        },
+        "mcis.TbValidationResult": {
+            "type": "object",
+            "properties": {
+                "problem": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/mcis.TbVmReq"
+                        "$ref": "#/definitions/mcis.TbValidationProblem"
                     }
+                },
+                "valid": {
+                    "type": "boolean"
                 }
             }
         },
@@ -6491,7 +10413,7 @@ var doc = `{
             ],
             "properties": {
                 "commonImage": {
-                    "description": "CommonImage is field for id of a image in common namespace",
+                    "description": "CommonImage is field for id of a image in common namespace (ex: ubuntu18.04)\nor an OS query with distribution, version and architecture (ex: ubuntu 22.04 arm64)",
                     "type": "string",
                     "example": "ubuntu18.04"
                 },
@@ -6513,6 +10435,20 @@ var doc = `{
                     "type": "string",
                     "example": "vm01"
                 },
+                "userData": {
+                    "description": "UserData is cloud-init user data executed at VM boot. cloud-config YAML is validated.",
+                    "type": "string",
+                    "example": "#cloud-config\npackages:\n  - nginx"
+                },
+                "userDataEncoding": {
+                    "description": "UserDataEncoding is the encoding of UserData (raw if empty)",
+                    "type": "string",
+                    "enum": [
+                        "raw",
+                        "base64"
+                    ],
+                    "example": "raw"
+                },
                 "vmGroupSize": {
                     "description": "if vmGroupSize is (not empty) \u0026\u0026 (\u003e 0), VM group will be gernetad. VMs will be created accordingly.",
                     "type": "string",
@@ -6557,6 +10493,10 @@ var doc = `{
                 "name": {
                     "type": "string"
                 },
+                "postCommand": {
+                    "description": "PostCommand is the command run on the VM after creation (rerun on the replacement VM by the health policy)",
+                    "$ref": "#/definitions/mcis.McisCmdReq"
+                },
                 "privateDNS": {
                     "type": "string"
                 },
@@ -6606,6 +10546,18 @@ var doc = `{
                 "targetStatus": {
                     "type": "string"
                 },
+                "userData": {
+                    "description": "UserData is cloud-init user data given at VM creation (base64 encoded after creation)",
+                    "type": "string"
+                },
+                "userDataEncoding": {
+                    "description": "UserDataEncoding is the encoding of UserData (raw if empty)",
+                    "type": "string",
+                    "enum": [
+                        "raw",
+                        "base64"
+                    ]
+                },
                 "vNetId": {
                     "type": "string"
                 },
@@ -6674,6 +10626,20 @@ var doc = `{
                 "subnetId": {
                     "type": "string"
                 },
+                "userData": {
+                    "description": "UserData is cloud-init user data executed at VM boot. cloud-config YAML is validated.",
+                    "type": "string",
+                    "example": "#cloud-config\npackages:\n  - nginx"
+                },
+                "userDataEncoding": {
+                    "description": "UserDataEncoding is the encoding of UserData (raw if empty)",
+                    "type": "string",
+                    "enum": [
+                        "raw",
+                        "base64"
+                    ],
+                    "example": "raw"
+                },
                 "vNetId": {
                     "type": "string"
                 },
@@ -6743,6 +10709,110 @@ var doc = `{
                 }
             }
         },
+        "mcis.VmHealthInfo": {
+            "type": "object",
+            "properties": {
+                "consecutiveFailures": {
+                    "type": "integer"
+                },
+                "graceUntil": {
+                    "description": "checks are skipped until this time (RFC3339)",
+                    "type": "string"
+                },
+                "lastCheckTime": {
+                    "type": "string"
+                },
+                "lastError": {
+                    "type": "string"
+                },
+                "remediationStep": {
+                    "description": "index of the next remediation step",
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "Healthy",
+                        "Unhealthy",
+                        "Unrecoverable"
+                    ],
+                    "example": "Healthy"
+                }
+            }
+        },
+        "mcis.VmRecommendPlanInfo": {
+            "type": "object",
+            "properties": {
+                "candidateCount": {
+                    "type": "integer"
+                },
+                "costPerHour": {
+                    "description": "cost of all VMs in the group",
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "providerName": {
+                    "type": "string"
+                },
+                "regionName": {
+                    "type": "string"
+                },
+                "spec": {
+                    "$ref": "#/definitions/mcir.TbSpecInfo"
+                },
+                "vmCount": {
+                    "type": "integer"
+                }
+            }
+        },
+        "mcis.VmRecommendPlanReq": {
+            "type": "object",
+            "required": [
+                "commonImage"
+            ],
+            "properties": {
+                "commonImage": {
+                    "description": "CommonImage is field for id of a image in common namespace (ex: ubuntu18.04) or an OS query (ex: ubuntu 22.04 arm64)",
+                    "type": "string",
+                    "example": "ubuntu18.04"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Description"
+                },
+                "filter": {
+                    "$ref": "#/definitions/mcis.FilterInfo"
+                },
+                "label": {
+                    "type": "string",
+                    "example": "RecommendedVm"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "vm01"
+                },
+                "priority": {
+                    "$ref": "#/definitions/mcis.PriorityInfo"
+                },
+                "userData": {
+                    "type": "string"
+                },
+                "userDataEncoding": {
+                    "description": "UserDataEncoding is the encoding of UserData (raw if empty)",
+                    "type": "string",
+                    "enum": [
+                        "raw",
+                        "base64"
+                    ]
+                },
+                "vmGroupSize": {
+                    "type": "string",
+                    "example": "3"
+                }
+            }
+        },
         "mcis.resourceOnCspOrSpider": {
             "type": "object",
             "properties": {
@@ -372,3 +372,25 @@ func RestInspectResources(c echo.Context) error {
 	return c.JSON(http.StatusOK, &content)
 
 }
+
+// RestPostReEncryptSecret godoc
+// @Summary Re-encrypt secrets with the active master key
+// @Description Re-encrypt all secrets stored in CB-Tumblebug with the active master key (the first key of SECRET_KEY). Use this after rotating master keys.
+// @Tags [Admin] System management
+// @Accept  json
+// @Produce  json
+// @Success 200 {object} common.SecretReEncryptResult
+// @Failure 404 {object} common.SimpleMsg
+// @Failure 500 {object} common.SimpleMsg
+// @Router /secret/reEncrypt [post]
+func RestPostReEncryptSecret(c echo.Context) error {
+
+	result, err := common.ReEncryptSecrets()
+	if err != nil {
+		common.CBLog.Error(err)
+		mapA := map[string]string{"message": err.Error()}
+		return c.JSON(http.StatusInternalServerError, &mapA)
+	}
+
+	return c.JSON(http.StatusOK, &result)
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2019 The Cloud-Barista Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package mcir is to handle REST API for mcir
+package mcir
+
+import (
+	"net/http"
+
+	"github.com/cloud-barista/cb-tumblebug/src/core/common"
+	"github.com/cloud-barista/cb-tumblebug/src/core/mcir"
+	"github.com/labstack/echo/v4"
+)
+
+// RestPostCatalogSync godoc
+// @Summary Sync spec and image catalog with CSPs
+// @Description Sync specs and images of the namespace with CSPs now. Added ones are registered, changed ones are updated,
+// @Description and removed ones are marked as deprecated (deleted if purgeUnreferenced is true and they are not referenced). Changes are recorded to the catalog changelog.
+// @Tags [Infra resource] MCIR Common
+// @Accept  json
+// @Produce  json
+// @Param nsId path string true "Namespace ID" default(common)
+// @Param connectionName query string false "Connection to sync (all connections if empty)" default(aws-ap-northeast-2)
+// @Param resourceType query string false "Resource type to sync (spec and image if empty)" Enums(spec, image)
+// @Param purgeUnreferenced query string false "Delete removed specs and images not referenced by any object" Enums(true, false)
+// @Success 200 {object} mcir.TbCatalogSyncResult
+// @Failure 400 {object} common.SimpleMsg
+// @Router /ns/{nsId}/catalogSync [post]
+func RestPostCatalogSync(c echo.Context) error {
+
+	nsId := c.Param("nsId")
+	connectionName := []string{}
+	if c.QueryParam("connectionName") != "" {
+		connectionName = append(connectionName, c.QueryParam("connectionName"))
+	}
+	resourceType := []string{}
+	if c.QueryParam("resourceType") != "" {
+		resourceType = append(resourceType, c.QueryParam("resourceType"))
+	}
+	purge := c.QueryParam("purgeUnreferenced") == "true"
+
+	result, err := mcir.SyncCatalog(nsId, connectionName, resourceType, purge)
+	if err != nil {
+		common.CBLog.Error(err)
+		mapA := map[string]string{"message": err.Error()}
+		return c.JSON(http.StatusBadRequest, &mapA)
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+// RestGetCatalogSyncPolicy godoc
+// @Summary Get catalog sync policy of namespace
+// @Description Get the policy of periodic sync of spec and image catalog with CSPs
+// @Tags [Infra resource] MCIR Common
+// @Accept  json
+// @Produce  json
+// @Param nsId path string true "Namespace ID" default(common)
+// @Success 200 {object} mcir.TbCatalogSyncPolicy
+// @Failure 500 {object} common.SimpleMsg
+// @Router /ns/{nsId}/catalogSyncPolicy [get]
+func RestGetCatalogSyncPolicy(c echo.Context) error {
+
+	nsId := c.Param("nsId")
+
+	result, err := mcir.GetCatalogSyncPolicy(nsId)
+	if err != nil {
+		common.CBLog.Error(err)
+		mapA := map[string]string{"message": err.Error()}
+		return c.JSON(http.StatusInternalServerError, &mapA)
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+// RestPutCatalogSyncPolicy godoc
+// @Summary Set catalog sync policy of namespace
+// @Description Set the policy of periodic sync of spec and image catalog with CSPs (interval in minutes, target connections and resource types)
+// @Tags [Infra resource] MCIR Common
+// @Accept  json
+// @Produce  json
+// @Param nsId path string true "Namespace ID" default(common)
+// @Param catalogSyncPolicy body mcir.TbCatalogSyncPolicy true "Catalog sync policy"
+// @Success 200 {object} mcir.TbCatalogSyncPolicy
+// @Failure 400 {object} common.SimpleMsg
+// @Router /ns/{nsId}/catalogSyncPolicy [put]
+func RestPutCatalogSyncPolicy(c echo.Context) error {
+
+	nsId := c.Param("nsId")
+
+	req := &mcir.TbCatalogSyncPolicy{}
+	if err := c.Bind(req); err != nil {
+		return err
+	}
+
+	result, err := mcir.SetCatalogSyncPolicy(nsId, *req)
+	if err != nil {
+		common.CBLog.Error(err)
+		mapA := map[string]string{"message": err.Error()}
+		return c.JSON(http.StatusBadRequest, &mapA)
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+// RestGetCatalogChange godoc
+// @Summary List catalog changes
+// @Description List changes of spec and image catalog detected by catalog sync (latest first)
+// @Tags [Infra resource] MCIR Common
+// @Accept  json
+// @Produce  json
+// @Param nsId path string true "Namespace ID" default(common)
+// @Param connectionName query string false "Connection to filter" default(aws-ap-northeast-2)
+// @Param resourceType query string false "Resource type to filter" Enums(spec, image)
+// @Param changeType query string false "Change type to filter" Enums(added, changed, removed, restored, deleted)
+// @Param since query string false "Changes after the time (RFC3339)" default(2022-01-01T00:00:00Z)
+// @Success 200 {object} mcir.TbCatalogChangeList
+// @Failure 400 {object} common.SimpleMsg
+// @Router /ns/{nsId}/catalogChange [get]
+func RestGetCatalogChange(c echo.Context) error {
+
+	nsId := c.Param("nsId")
+
+	result, err := mcir.ListCatalogChange(nsId, c.QueryParam("connectionName"), c.QueryParam("resourceType"), c.QueryParam("changeType"), c.QueryParam("since"))
+	if err != nil {
+		common.CBLog.Error(err)
+		mapA := map[string]string{"message": err.Error()}
+		return c.JSON(http.StatusBadRequest, &mapA)
+	}
+
+	content := mcir.TbCatalogChangeList{Change: result}
+	return c.JSON(http.StatusOK, &content)
+}
@@ -175,6 +175,9 @@ func RestGetAllResources(c echo.Context) error {
 
 			// When err == nil && resourceList != nil
 			content.SshKey = resourceList.([]mcir.TbSshKeyInfo) // type assertion (interface{} -> array)
+			if !common.CheckSecretAccess(c.QueryParam("showSecret"), c.Request().Header.Get("X-Secret-Token")) {
+				content.SshKey = common.RedactSecrets(content.SshKey).([]mcir.TbSshKeyInfo)
+			}
 			return c.JSON(http.StatusOK, &content)
 		case common.StrVNet:
 			var content struct {
@@ -211,6 +214,9 @@ func RestGetResource(c echo.Context) error {
 		mapA := map[string]string{"message": "Failed to find " + resourceType + " " + resourceId}
 		return c.JSON(http.StatusNotFound, &mapA)
 	} else {
+		if !common.CheckSecretAccess(c.QueryParam("showSecret"), c.Request().Header.Get("X-Secret-Token")) {
+			res = common.RedactSecrets(res)
+		}
 		return c.JSON(http.StatusOK, &res)
 	}
 }
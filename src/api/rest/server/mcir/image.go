@@ -316,11 +316,17 @@ func RestDelAllImage(c echo.Context) error {
 // Response structure for RestSearchImage
 type RestSearchImageRequest struct {
 	Keywords []string `json:"keywords"`
+
+	// ConnectionName and OS information are optional conditions (osVersion 22 matches 22.04)
+	ConnectionName string `json:"connectionName,omitempty" example:"aws-ap-northeast-2"`
+	mcir.TbImageOsInfo
+	// Os is an OS query parsed to OS information conditions (ex: ubuntu 22.04 arm64)
+	Os string `json:"os,omitempty" example:"ubuntu 22.04 arm64"`
 }
 
 // RestSearchImage godoc
 // @Summary Search image
-// @Description Search image
+// @Description Search image by keywords of name and conditions of OS information (family, distribution, version, architecture, boot type)
 // @Tags [Infra resource] MCIR Image management
 // @Accept  json
 // @Produce  json
@@ -341,7 +347,9 @@ func RestSearchImage(c echo.Context) error {
 	//fmt.Println("RestSearchImage called; keywords: ") // for debug
 	//fmt.Println(u.Keywords) // for debug
 
-	content, err := mcir.SearchImage(nsId, u.Keywords...)
+	cond := mcir.AddImageOsQuery(u.TbImageOsInfo, u.Os)
+
+	content, err := mcir.SearchImageByOs(nsId, u.ConnectionName, cond, u.Keywords...)
 	if err != nil {
 		common.CBLog.Error(err)
 		mapA := map[string]string{
@@ -353,3 +361,27 @@ func RestSearchImage(c echo.Context) error {
 	result.Image = content
 	return c.JSON(http.StatusOK, &result)
 }
+
+// RestUpdateImageOsInfo godoc
+// @Summary Update OS information of images
+// @Description Parse OS information (family, distribution, version, architecture, boot type) of all images in the namespace again and update the changed images
+// @Tags [Infra resource] MCIR Image management
+// @Accept  json
+// @Produce  json
+// @Param nsId path string true "Namespace ID" default(ns01)
+// @Success 200 {object} common.IdList
+// @Failure 500 {object} common.SimpleMsg
+// @Router /ns/{nsId}/resources/updateImageOsInfo [post]
+func RestUpdateImageOsInfo(c echo.Context) error {
+
+	nsId := c.Param("nsId")
+
+	result, err := mcir.UpdateImageOsInfo(nsId)
+	if err != nil {
+		common.CBLog.Error(err)
+		mapA := map[string]string{"message": err.Error()}
+		return c.JSON(http.StatusInternalServerError, &mapA)
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
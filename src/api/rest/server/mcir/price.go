@@ -0,0 +1,236 @@
+/*
+Copyright 2019 The Cloud-Barista Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package mcir is to handle REST API for mcir
+package mcir
+
+import (
+	"io/ioutil"
+	"net/http"
+
+	"github.com/cloud-barista/cb-tumblebug/src/core/common"
+	"github.com/cloud-barista/cb-tumblebug/src/core/mcir"
+	"github.com/labstack/echo/v4"
+)
+
+// RestPostPrice godoc
+// @Summary Register prices of specs
+// @Description Register prices of specs (a price with the same connection, spec, OS, pricing model, currency and effective date is overwritten)
+// @Tags [Admin] Multi-Cloud environment configuration
+// @Accept  json
+// @Produce  json
+// @Param priceList body mcir.TbPriceList true "List of prices"
+// @Success 200 {object} mcir.TbPriceList
+// @Failure 400 {object} common.SimpleMsg
+// @Router /price [post]
+func RestPostPrice(c echo.Context) error {
+
+	req := &mcir.TbPriceList{}
+	if err := c.Bind(req); err != nil {
+		return err
+	}
+
+	result, err := mcir.RegisterPriceList(req)
+	if err != nil {
+		common.CBLog.Error(err)
+		mapA := map[string]string{"message": err.Error()}
+		return c.JSON(http.StatusBadRequest, &mapA)
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+// RestGetPrice godoc
+// @Summary List prices of specs
+// @Description List prices of specs effective today (all prices including past and future ones if history is true)
+// @Tags [Admin] Multi-Cloud environment configuration
+// @Accept  json
+// @Produce  json
+// @Param connectionName query string false "Connection name to filter" default(aws-ap-northeast-2)
+// @Param cspSpecName query string false "CSP spec name to filter (with connectionName)" default(t2.micro)
+// @Param history query string false "Include price history" Enums(true, false)
+// @Success 200 {object} mcir.TbPriceList
+// @Failure 500 {object} common.SimpleMsg
+// @Router /price [get]
+func RestGetPrice(c echo.Context) error {
+
+	connectionName := c.QueryParam("connectionName")
+	cspSpecName := c.QueryParam("cspSpecName")
+	history := c.QueryParam("history") == "true"
+
+	result, err := mcir.ListPrice(connectionName, cspSpecName, history)
+	if err != nil {
+		common.CBLog.Error(err)
+		mapA := map[string]string{"message": err.Error()}
+		return c.JSON(http.StatusInternalServerError, &mapA)
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+// RestDelAllPrice godoc
+// @Summary Delete all prices of specs
+// @Description Delete all prices of specs including history
+// @Tags [Admin] Multi-Cloud environment configuration
+// @Accept  json
+// @Produce  json
+// @Success 200 {object} common.SimpleMsg
+// @Failure 500 {object} common.SimpleMsg
+// @Router /price [delete]
+func RestDelAllPrice(c echo.Context) error {
+
+	err := mcir.DelAllPrice()
+	if err != nil {
+		common.CBLog.Error(err)
+		mapA := map[string]string{"message": err.Error()}
+		return c.JSON(http.StatusInternalServerError, &mapA)
+	}
+
+	mapA := map[string]string{"message": "Deleted all prices"}
+	return c.JSON(http.StatusOK, &mapA)
+}
+
+// RestImportPrice godoc
+// @Summary Import price catalog
+// @Description Import price catalog in the request body.
+// @Description csv: header connectionName,cspSpecName,osType,pricingModel,currency,pricePerHour,effectiveDate / json: mcir.TbPriceList
+// @Description aws: AWS Price List (AmazonEC2 offer file in CSV) / azure: response of Azure Retail Prices API
+// @Description (prices of aws and azure are registered to connections in the region)
+// @Tags [Admin] Multi-Cloud environment configuration
+// @Accept  plain
+// @Produce  json
+// @Param format query string true "Format of price catalog" Enums(csv, json, aws, azure)
+// @Param catalog body string true "Price catalog"
+// @Success 200 {object} mcir.TbPriceImportResult
+// @Failure 400 {object} common.SimpleMsg
+// @Router /importPrice [post]
+func RestImportPrice(c echo.Context) error {
+
+	format := c.QueryParam("format")
+
+	data, err := ioutil.ReadAll(c.Request().Body)
+	if err != nil {
+		common.CBLog.Error(err)
+		mapA := map[string]string{"message": err.Error()}
+		return c.JSON(http.StatusBadRequest, &mapA)
+	}
+
+	result, err := mcir.ImportPriceCatalog(format, data)
+	if err != nil {
+		common.CBLog.Error(err)
+		mapA := map[string]string{"message": err.Error()}
+		return c.JSON(http.StatusBadRequest, &mapA)
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+// RestLoadPriceCatalog godoc
+// @Summary Load price catalog from internal asset file
+// @Description Load price catalog from internal asset file (assets/cloudprice.csv)
+// @Tags [Admin] Multi-Cloud environment configuration
+// @Accept  json
+// @Produce  json
+// @Success 200 {object} mcir.TbPriceImportResult
+// @Failure 404 {object} common.SimpleMsg
+// @Router /loadPriceCatalog [get]
+func RestLoadPriceCatalog(c echo.Context) error {
+
+	result, err := mcir.LoadPriceCatalog()
+	if err != nil {
+		common.CBLog.Error(err)
+		mapA := map[string]string{"message": err.Error()}
+		return c.JSON(http.StatusNotFound, &mapA)
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+// RestGetPricingPolicy godoc
+// @Summary Get pricing policy of namespace
+// @Description Get pricing policy (pricing model, OS type, currency) to get CostPerHour of specs for the namespace
+// @Tags [Infra resource] MCIR Spec management
+// @Accept  json
+// @Produce  json
+// @Param nsId path string true "Namespace ID" default(ns01)
+// @Success 200 {object} mcir.TbPricingPolicy
+// @Failure 500 {object} common.SimpleMsg
+// @Router /ns/{nsId}/pricingPolicy [get]
+func RestGetPricingPolicy(c echo.Context) error {
+
+	nsId := c.Param("nsId")
+
+	result, err := mcir.GetPricingPolicy(nsId)
+	if err != nil {
+		common.CBLog.Error(err)
+		mapA := map[string]string{"message": err.Error()}
+		return c.JSON(http.StatusInternalServerError, &mapA)
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+// RestPutPricingPolicy godoc
+// @Summary Set pricing policy of namespace
+// @Description Set pricing policy (pricing model, OS type, currency) to get CostPerHour of specs for the namespace.
+// @Description Recommendation in the namespace uses the prices by the policy (onDemand price is used if the spec has no price in the model).
+// @Tags [Infra resource] MCIR Spec management
+// @Accept  json
+// @Produce  json
+// @Param nsId path string true "Namespace ID" default(ns01)
+// @Param pricingPolicy body mcir.TbPricingPolicy true "Pricing policy"
+// @Success 200 {object} mcir.TbPricingPolicy
+// @Failure 400 {object} common.SimpleMsg
+// @Router /ns/{nsId}/pricingPolicy [put]
+func RestPutPricingPolicy(c echo.Context) error {
+
+	nsId := c.Param("nsId")
+
+	req := &mcir.TbPricingPolicy{}
+	if err := c.Bind(req); err != nil {
+		return err
+	}
+
+	result, err := mcir.SetPricingPolicy(nsId, *req)
+	if err != nil {
+		common.CBLog.Error(err)
+		mapA := map[string]string{"message": err.Error()}
+		return c.JSON(http.StatusBadRequest, &mapA)
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+// RestUpdateSpecPrice godoc
+// @Summary Update CostPerHour of specs by price catalog
+// @Description Update CostPerHour of all specs in the namespace by the price catalog and the pricing policy of the namespace
+// @Tags [Infra resource] MCIR Spec management
+// @Accept  json
+// @Produce  json
+// @Param nsId path string true "Namespace ID" default(ns01)
+// @Success 200 {object} common.IdList
+// @Failure 500 {object} common.SimpleMsg
+// @Router /ns/{nsId}/resources/updateSpecPrice [post]
+func RestUpdateSpecPrice(c echo.Context) error {
+
+	nsId := c.Param("nsId")
+
+	result, err := mcir.UpdateSpecPrice(nsId)
+	if err != nil {
+		common.CBLog.Error(err)
+		mapA := map[string]string{"message": err.Error()}
+		return c.JSON(http.StatusInternalServerError, &mapA)
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
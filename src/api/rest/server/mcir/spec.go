@@ -301,6 +301,38 @@ func RestFilterSpecsByRange(c echo.Context) error {
 	return c.JSON(http.StatusOK, &result)
 }
 
+// RestSearchSpecs godoc
+// @Summary Search specs
+// @Description Search specs by predicates on any field (==, !=, >, >=, <, <=, in, like, between) with multi-key ordering.
+// @Description Use limit/offset or cursor (nextCursor of the previous page) for pagination.
+// @Tags [Infra resource] MCIR Spec management
+// @Accept  json
+// @Produce  json
+// @Param nsId path string true "Namespace ID" default(ns01)
+// @Param specSearchReq body mcir.SpecSearchReq true "Conditions, ordering and pagination for searching specs"
+// @Success 200 {object} mcir.SpecSearchResult
+// @Failure 400 {object} common.SimpleMsg
+// @Router /ns/{nsId}/resources/searchSpecs [post]
+func RestSearchSpecs(c echo.Context) error {
+
+	nsId := c.Param("nsId")
+
+	u := &mcir.SpecSearchReq{}
+	if err := c.Bind(u); err != nil {
+		return err
+	}
+
+	fmt.Println("[Search specs]")
+	result, err := mcir.SearchSpecs(nsId, *u)
+	if err != nil {
+		common.CBLog.Error(err)
+		mapA := map[string]string{"message": err.Error()}
+		return c.JSON(http.StatusBadRequest, &mapA)
+	}
+
+	return c.JSON(http.StatusOK, &result)
+}
+
 func RestTestSortSpecs(c echo.Context) error {
 
 	nsId := c.Param("nsId")
@@ -20,6 +20,7 @@ import (
 
 	"github.com/cloud-barista/cb-tumblebug/src/core/common"
 	"github.com/cloud-barista/cb-tumblebug/src/core/mcir"
+	"github.com/cloud-barista/cb-tumblebug/src/core/mcis"
 	"github.com/labstack/echo/v4"
 )
 
@@ -75,6 +76,42 @@ func RestPutSshKey(c echo.Context) error {
 	return nil
 }
 
+// RestPostRotateSshKey godoc
+// @Summary Rotate SSH Key
+// @Description Rotate SSH Key on all the VMs associated with the key. The new public key is installed and verified on each VM, the stored private key is replaced, and then the old key is removed from each VM. If any VM fails or the new key cannot be stored, the new key is removed from the VMs and the stored key is not changed.
+// @Tags [Infra resource] MCIR Access key management
+// @Accept  json
+// @Produce  json
+// @Param nsId path string true "Namespace ID" default(ns01)
+// @Param sshKeyId path string true "SSH Key ID"
+// @Param sshKeyRotateReq body mcis.TbSshKeyRotateReq false "New private key (a new key pair is generated if empty)"
+// @Success 200 {object} mcis.TbSshKeyRotateResult
+// @Failure 404 {object} common.SimpleMsg
+// @Failure 500 {object} mcis.TbSshKeyRotateResult
+// @Router /ns/{nsId}/resources/sshKey/{sshKeyId}/rotate [post]
+func RestPostRotateSshKey(c echo.Context) error {
+
+	nsId := c.Param("nsId")
+	sshKeyId := c.Param("resourceId")
+
+	req := &mcis.TbSshKeyRotateReq{}
+	if err := c.Bind(req); err != nil {
+		return err
+	}
+
+	content, err := mcis.RotateSshKey(nsId, sshKeyId, req)
+	if err != nil {
+		common.CBLog.Error(err)
+		if content.SshKeyId == "" {
+			mapA := map[string]string{"message": err.Error()}
+			return c.JSON(http.StatusInternalServerError, &mapA)
+		}
+		return c.JSON(http.StatusInternalServerError, content)
+	}
+
+	return c.JSON(http.StatusOK, content)
+}
+
 // RestGetSshKey godoc
 // @Summary Get SSH Key
 // @Description Get SSH Key
@@ -83,6 +120,7 @@ func RestPutSshKey(c echo.Context) error {
 // @Produce  json
 // @Param nsId path string true "Namespace ID" default(ns01)
 // @Param sshKeyId path string true "SSH Key ID"
+// @Param showSecret query string false "Show decrypted privateKey (X-Secret-Token header is required)" Enums(true)
 // @Success 200 {object} mcir.TbSshKeyInfo
 // @Failure 404 {object} common.SimpleMsg
 // @Failure 500 {object} common.SimpleMsg
@@ -105,6 +143,7 @@ type RestGetAllSshKeyResponse struct {
 // @Produce  json
 // @Param nsId path string true "Namespace ID" default(ns01)
 // @Param option query string false "Option" Enums(id)
+// @Param showSecret query string false "Show decrypted privateKey (X-Secret-Token header is required)" Enums(true)
 // @Success 200 {object} JSONResult{[DEFAULT]=RestGetAllSshKeyResponse,[ID]=common.IdList} "Different return structures by the given option param"
 // @Failure 404 {object} common.SimpleMsg
 // @Failure 500 {object} common.SimpleMsg
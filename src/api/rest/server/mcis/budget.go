@@ -0,0 +1,133 @@
+/*
+Copyright 2019 The Cloud-Barista Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package mcis is to handle REST API for mcis
+package mcis
+
+import (
+	"net/http"
+
+	"github.com/cloud-barista/cb-tumblebug/src/core/common"
+	"github.com/cloud-barista/cb-tumblebug/src/core/mcis"
+	"github.com/labstack/echo/v4"
+)
+
+// RestPutBudget godoc
+// @Summary Set monthly budget of namespace
+// @Description Set monthly budget of the namespace. Alerts are recorded when the cost of this month crosses thresholds (50,80,100% by default).
+// @Description When the budget is exhausted, new VMs can be blocked and MCISs with the suspend label can be suspended by the enforcement options.
+// @Tags [Infra service] MCIS Provisioning management
+// @Accept  json
+// @Produce  json
+// @Param nsId path string true "Namespace ID" default(ns01)
+// @Param budgetReq body mcis.TbBudgetReq true "Monthly budget"
+// @Success 200 {object} mcis.TbBudgetInfo
+// @Failure 400 {object} common.SimpleMsg
+// @Router /ns/{nsId}/budget [put]
+func RestPutBudget(c echo.Context) error {
+
+	nsId := c.Param("nsId")
+
+	req := &mcis.TbBudgetReq{}
+	if err := c.Bind(req); err != nil {
+		return err
+	}
+
+	result, err := mcis.SetBudget(nsId, req)
+	if err != nil {
+		common.CBLog.Error(err)
+		mapA := map[string]string{"message": err.Error()}
+		return c.JSON(http.StatusBadRequest, &mapA)
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+// RestGetBudget godoc
+// @Summary Get monthly budget of namespace
+// @Description Get monthly budget of the namespace with the cost of this month
+// @Tags [Infra service] MCIS Provisioning management
+// @Accept  json
+// @Produce  json
+// @Param nsId path string true "Namespace ID" default(ns01)
+// @Success 200 {object} mcis.TbBudgetInfo
+// @Failure 404 {object} common.SimpleMsg
+// @Router /ns/{nsId}/budget [get]
+func RestGetBudget(c echo.Context) error {
+
+	nsId := c.Param("nsId")
+
+	result, err := mcis.GetBudget(nsId)
+	if err != nil {
+		common.CBLog.Error(err)
+		mapA := map[string]string{"message": err.Error()}
+		return c.JSON(http.StatusNotFound, &mapA)
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+// RestDelBudget godoc
+// @Summary Delete monthly budget of namespace
+// @Description Delete monthly budget of the namespace with its alerts
+// @Tags [Infra service] MCIS Provisioning management
+// @Accept  json
+// @Produce  json
+// @Param nsId path string true "Namespace ID" default(ns01)
+// @Success 200 {object} common.SimpleMsg
+// @Failure 404 {object} common.SimpleMsg
+// @Router /ns/{nsId}/budget [delete]
+func RestDelBudget(c echo.Context) error {
+
+	nsId := c.Param("nsId")
+
+	err := mcis.DelBudget(nsId)
+	if err != nil {
+		common.CBLog.Error(err)
+		mapA := map[string]string{"message": err.Error()}
+		return c.JSON(http.StatusNotFound, &mapA)
+	}
+
+	mapA := map[string]string{"message": "Deleted the budget of namespace " + nsId}
+	return c.JSON(http.StatusOK, &mapA)
+}
+
+// JSONResultListBudgetAlert is struct for the list of budget alerts
+type JSONResultListBudgetAlert struct {
+	Alert []mcis.TbBudgetAlert `json:"alert"`
+}
+
+// RestGetBudgetAlert godoc
+// @Summary List alerts of namespace budget
+// @Description List alert events (threshold, enforcement) of the namespace budget (latest first)
+// @Tags [Infra service] MCIS Provisioning management
+// @Accept  json
+// @Produce  json
+// @Param nsId path string true "Namespace ID" default(ns01)
+// @Success 200 {object} JSONResultListBudgetAlert
+// @Failure 500 {object} common.SimpleMsg
+// @Router /ns/{nsId}/budget/alert [get]
+func RestGetBudgetAlert(c echo.Context) error {
+
+	nsId := c.Param("nsId")
+
+	result, err := mcis.ListBudgetAlert(nsId)
+	if err != nil {
+		common.CBLog.Error(err)
+		mapA := map[string]string{"message": err.Error()}
+		return c.JSON(http.StatusInternalServerError, &mapA)
+	}
+
+	content := JSONResultListBudgetAlert{Alert: result}
+	return c.JSON(http.StatusOK, &content)
+}
@@ -108,6 +108,7 @@ type JSONResult struct {
 // @Param nsId path string true "Namespace ID" default(ns01)
 // @Param mcisId path string true "MCIS ID" default(mcis01)
 // @Param option query string false "Option" Enums(default, id, status)
+// @Param showSecret query string false "Show decrypted secrets such as vmUserPassword (X-Secret-Token header is required)" Enums(true)
 // @success 200 {object} JSONResult{[DEFAULT]=mcis.TbMcisInfo,[ID]=common.IdList,[STATUS]=mcis.McisStatusInfo} "Different return structures by the given action param"
 // @Failure 404 {object} common.SimpleMsg
 // @Failure 500 {object} common.SimpleMsg
@@ -152,6 +153,9 @@ func RestGetMcis(c echo.Context) error {
 			mapA := map[string]string{"message": err.Error()}
 			return c.JSON(http.StatusNotFound, &mapA)
 		}
+		if !common.CheckSecretAccess(c.QueryParam("showSecret"), c.Request().Header.Get("X-Secret-Token")) {
+			result = common.RedactSecrets(result).(*mcis.TbMcisInfo)
+		}
 
 		common.PrintJsonPretty(*result)
 		return c.JSON(http.StatusOK, result)
@@ -177,6 +181,7 @@ type RestGetAllMcisStatusResponse struct {
 // @Produce  json
 // @Param nsId path string true "Namespace ID" default(ns01)
 // @Param option query string false "Option" Enums(id, simple, status)
+// @Param showSecret query string false "Show decrypted secrets such as vmUserPassword (X-Secret-Token header is required)" Enums(true)
 // @Success 200 {object} JSONResult{[DEFAULT]=RestGetAllMcisResponse,[SIMPLE]=RestGetAllMcisResponse,[ID]=common.IdList,[STATUS]=RestGetAllMcisStatusResponse} "Different return structures by the given option param"
 // @Failure 404 {object} common.SimpleMsg
 // @Failure 500 {object} common.SimpleMsg
@@ -218,6 +223,9 @@ func RestGetAllMcis(c echo.Context) error {
 		}
 		content := RestGetAllMcisResponse{}
 		content.Mcis = result
+		if !common.CheckSecretAccess(c.QueryParam("showSecret"), c.Request().Header.Get("X-Secret-Token")) {
+			content.Mcis = common.RedactSecrets(result).([]mcis.TbMcisInfo)
+		}
 		//common.PrintJsonPretty(content)
 		return c.JSON(http.StatusOK, &content)
 	} else {
@@ -229,6 +237,9 @@ func RestGetAllMcis(c echo.Context) error {
 		}
 		content := RestGetAllMcisResponse{}
 		content.Mcis = result
+		if !common.CheckSecretAccess(c.QueryParam("showSecret"), c.Request().Header.Get("X-Secret-Token")) {
+			content.Mcis = common.RedactSecrets(result).([]mcis.TbMcisInfo)
+		}
 		//common.PrintJsonPretty(content)
 		return c.JSON(http.StatusOK, &content)
 	}
@@ -654,6 +665,7 @@ func RestPostMcisVmGroup(c echo.Context) error {
 // @Param mcisId path string true "MCIS ID" default(mcis01)
 // @Param vmId path string true "VM ID" default(vm01)
 // @Param option query string false "Option for MCIS" Enums(default, status)
+// @Param showSecret query string false "Show decrypted secrets such as vmUserPassword (X-Secret-Token header is required)" Enums(true)
 // @success 200 {object} JSONResult{[DEFAULT]=mcis.TbVmInfo,[STATUS]=mcis.TbVmStatusInfo} "Different return structures by the given option param"
 // @Failure 404 {object} common.SimpleMsg
 // @Failure 500 {object} common.SimpleMsg
@@ -687,6 +699,9 @@ func RestGetMcisVm(c echo.Context) error {
 			mapA := map[string]string{"message": err.Error()}
 			return c.JSON(http.StatusNotFound, &mapA)
 		}
+		if !common.CheckSecretAccess(c.QueryParam("showSecret"), c.Request().Header.Get("X-Secret-Token")) {
+			result = common.RedactSecrets(result).(*mcis.TbVmInfo)
+		}
 
 		//fmt.Printf("%+v\n", *result)
 		common.PrintJsonPretty(*result)
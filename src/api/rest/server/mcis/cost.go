@@ -0,0 +1,68 @@
+/*
+Copyright 2019 The Cloud-Barista Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package mcis is to handle REST API for mcis
+package mcis
+
+import (
+	"net/http"
+
+	"github.com/cloud-barista/cb-tumblebug/src/core/common"
+	"github.com/cloud-barista/cb-tumblebug/src/core/mcis"
+	"github.com/labstack/echo/v4"
+)
+
+// RestGetCostReport godoc
+// @Summary Get cost report of VMs
+// @Description Get cost of VMs in the namespace (or MCIS) in the time range, calculated from running intervals of VMs and CostPerHour of specs.
+// @Description The report is grouped by vm, mcis, ns, connection or label, and projects the monthly cost of the current state.
+// @Tags [Infra service] MCIS Provisioning management
+// @Accept  json
+// @Produce  json
+// @Param nsId path string true "Namespace ID" default(ns01)
+// @Param mcisId query string false "MCIS ID to get cost report of the MCIS only" default(mcis01)
+// @Param from query string false "Start of time range (RFC3339 or YYYY-MM-DD, the first day of this month if empty)" default(2022-01-01)
+// @Param to query string false "End of time range (RFC3339 or YYYY-MM-DD, now if empty)"
+// @Param groupBy query string false "Group of cost" Enums(vm, mcis, ns, connection, label) default(mcis)
+// @Param format query string false "Format of report" Enums(json, csv) default(json)
+// @Success 200 {object} mcis.TbCostReport
+// @Failure 400 {object} common.SimpleMsg
+// @Router /ns/{nsId}/costReport [get]
+func RestGetCostReport(c echo.Context) error {
+
+	nsId := c.Param("nsId")
+	mcisId := c.QueryParam("mcisId")
+	from := c.QueryParam("from")
+	to := c.QueryParam("to")
+	groupBy := c.QueryParam("groupBy")
+	format := c.QueryParam("format")
+
+	result, err := mcis.GetCostReport(nsId, mcisId, from, to, groupBy)
+	if err != nil {
+		common.CBLog.Error(err)
+		mapA := map[string]string{"message": err.Error()}
+		return c.JSON(http.StatusBadRequest, &mapA)
+	}
+
+	if format == "csv" {
+		content, err := mcis.ConvCostReportToCsv(result)
+		if err != nil {
+			common.CBLog.Error(err)
+			mapA := map[string]string{"message": err.Error()}
+			return c.JSON(http.StatusInternalServerError, &mapA)
+		}
+		return c.Blob(http.StatusOK, "text/csv", []byte(content))
+	}
+
+	return c.JSON(http.StatusOK, &result)
+}
@@ -0,0 +1,171 @@
+/*
+Copyright 2019 The Cloud-Barista Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package mcis is to handle REST API for mcis
+package mcis
+
+import (
+	"net/http"
+
+	"github.com/cloud-barista/cb-tumblebug/src/core/common"
+	"github.com/cloud-barista/cb-tumblebug/src/core/mcis"
+	"github.com/labstack/echo/v4"
+)
+
+// RestPostMcisHealthPolicy godoc
+// @Summary Create MCIS health policy
+// @Description Create MCIS health policy to check VMs (cspStatus, tcp, command) and remediate unhealthy VMs (reboot, replace)
+// @Tags [Infra service] MCIS Health policy management
+// @Accept  json
+// @Produce  json
+// @Param nsId path string true "Namespace ID" default(ns01)
+// @Param mcisId path string true "MCIS ID" default(mcis01)
+// @Param healthPolicy body mcis.McisHealthPolicy true "Details for MCIS health policy"
+// @Success 200 {object} mcis.McisHealthPolicy
+// @Failure 404 {object} common.SimpleMsg
+// @Failure 500 {object} common.SimpleMsg
+// @Router /ns/{nsId}/healthPolicy/mcis/{mcisId} [post]
+func RestPostMcisHealthPolicy(c echo.Context) error {
+
+	nsId := c.Param("nsId")
+	mcisId := c.Param("mcisId")
+
+	req := &mcis.McisHealthPolicy{}
+	if err := c.Bind(req); err != nil {
+		return err
+	}
+
+	content, err := mcis.CreateMcisHealthPolicy(nsId, mcisId, req)
+	if err != nil {
+		common.CBLog.Error(err)
+		mapA := map[string]string{"message": err.Error()}
+		return c.JSON(http.StatusInternalServerError, &mapA)
+	}
+
+	return c.JSON(http.StatusOK, content)
+}
+
+// RestGetMcisHealthPolicy godoc
+// @Summary Get MCIS health policy
+// @Description Get MCIS health policy with the health of VMs
+// @Tags [Infra service] MCIS Health policy management
+// @Accept  json
+// @Produce  json
+// @Param nsId path string true "Namespace ID" default(ns01)
+// @Param mcisId path string true "MCIS ID" default(mcis01)
+// @Success 200 {object} mcis.McisHealthPolicy
+// @Failure 404 {object} common.SimpleMsg
+// @Failure 500 {object} common.SimpleMsg
+// @Router /ns/{nsId}/healthPolicy/mcis/{mcisId} [get]
+func RestGetMcisHealthPolicy(c echo.Context) error {
+
+	nsId := c.Param("nsId")
+	mcisId := c.Param("mcisId")
+
+	content, err := mcis.GetMcisHealthPolicy(nsId, mcisId)
+	if err != nil {
+		mapA := map[string]string{"message": err.Error()}
+		return c.JSON(http.StatusNotFound, &mapA)
+	}
+
+	return c.JSON(http.StatusOK, content)
+}
+
+// Response structure for RestGetAllMcisHealthPolicy
+type RestGetAllMcisHealthPolicyResponse struct {
+	HealthPolicy []mcis.McisHealthPolicy `json:"healthPolicy"`
+}
+
+// RestGetAllMcisHealthPolicy godoc
+// @Summary List all MCIS health policies
+// @Description List all MCIS health policies in the namespace
+// @Tags [Infra service] MCIS Health policy management
+// @Accept  json
+// @Produce  json
+// @Param nsId path string true "Namespace ID" default(ns01)
+// @Success 200 {object} RestGetAllMcisHealthPolicyResponse
+// @Failure 404 {object} common.SimpleMsg
+// @Failure 500 {object} common.SimpleMsg
+// @Router /ns/{nsId}/healthPolicy/mcis [get]
+func RestGetAllMcisHealthPolicy(c echo.Context) error {
+
+	nsId := c.Param("nsId")
+
+	result, err := mcis.ListMcisHealthPolicy(nsId)
+	if err != nil {
+		mapA := map[string]string{"message": err.Error()}
+		return c.JSON(http.StatusInternalServerError, &mapA)
+	}
+
+	content := RestGetAllMcisHealthPolicyResponse{HealthPolicy: result}
+	return c.JSON(http.StatusOK, &content)
+}
+
+// RestDelMcisHealthPolicy godoc
+// @Summary Delete MCIS health policy
+// @Description Delete MCIS health policy with remediation logs
+// @Tags [Infra service] MCIS Health policy management
+// @Accept  json
+// @Produce  json
+// @Param nsId path string true "Namespace ID" default(ns01)
+// @Param mcisId path string true "MCIS ID" default(mcis01)
+// @Success 200 {object} common.SimpleMsg
+// @Failure 404 {object} common.SimpleMsg
+// @Router /ns/{nsId}/healthPolicy/mcis/{mcisId} [delete]
+func RestDelMcisHealthPolicy(c echo.Context) error {
+
+	nsId := c.Param("nsId")
+	mcisId := c.Param("mcisId")
+
+	err := mcis.DelMcisHealthPolicy(nsId, mcisId)
+	if err != nil {
+		common.CBLog.Error(err)
+		mapA := map[string]string{"message": err.Error()}
+		return c.JSON(http.StatusNotFound, &mapA)
+	}
+
+	mapA := map[string]string{"message": "Deleting the health policy of MCIS " + mcisId + " info"}
+	return c.JSON(http.StatusOK, &mapA)
+}
+
+// Response structure for RestGetMcisHealthLog
+type RestGetMcisHealthLogResponse struct {
+	Log []mcis.McisHealthLog `json:"log"`
+}
+
+// RestGetMcisHealthLog godoc
+// @Summary List remediation logs of MCIS health policy
+// @Description List remediation logs (reboot, replace) of MCIS health policy (latest first)
+// @Tags [Infra service] MCIS Health policy management
+// @Accept  json
+// @Produce  json
+// @Param nsId path string true "Namespace ID" default(ns01)
+// @Param mcisId path string true "MCIS ID" default(mcis01)
+// @Success 200 {object} RestGetMcisHealthLogResponse
+// @Failure 404 {object} common.SimpleMsg
+// @Failure 500 {object} common.SimpleMsg
+// @Router /ns/{nsId}/healthPolicy/mcis/{mcisId}/log [get]
+func RestGetMcisHealthLog(c echo.Context) error {
+
+	nsId := c.Param("nsId")
+	mcisId := c.Param("mcisId")
+
+	result, err := mcis.ListMcisHealthLog(nsId, mcisId)
+	if err != nil {
+		mapA := map[string]string{"message": err.Error()}
+		return c.JSON(http.StatusInternalServerError, &mapA)
+	}
+
+	content := RestGetMcisHealthLogResponse{Log: result}
+	return c.JSON(http.StatusOK, &content)
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2019 The Cloud-Barista Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package mcis is to handle REST API for mcis
+package mcis
+
+import (
+	"net/http"
+
+	"github.com/cloud-barista/cb-tumblebug/src/core/common"
+	"github.com/cloud-barista/cb-tumblebug/src/core/mcis"
+	"github.com/labstack/echo/v4"
+)
+
+// RestPostLatency godoc
+// @Summary Register inter-region latency
+// @Description Register measured round trip time between regions (cloudType/nativeRegion) or user endpoints in the latency matrix
+// @Tags [Admin] Multi-Cloud environment configuration
+// @Accept  json
+// @Produce  json
+// @Param latencyMatrix body mcis.LatencyMatrix true "List of latency information"
+// @Success 200 {object} mcis.LatencyMatrix
+// @Failure 400 {object} common.SimpleMsg
+// @Router /latency [post]
+func RestPostLatency(c echo.Context) error {
+
+	req := &mcis.LatencyMatrix{}
+	if err := c.Bind(req); err != nil {
+		return err
+	}
+
+	result, err := mcis.RegisterLatencyMatrix(req)
+	if err != nil {
+		common.CBLog.Error(err)
+		mapA := map[string]string{"message": err.Error()}
+		return c.JSON(http.StatusBadRequest, &mapA)
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+// RestGetLatencyMatrix godoc
+// @Summary Get inter-region latency matrix
+// @Description Get inter-region latency matrix (measured by mrtt benchmark, imported from assets/cloudlatency.csv or registered by user)
+// @Tags [Admin] Multi-Cloud environment configuration
+// @Accept  json
+// @Produce  json
+// @Param source query string false "Source region (cloudType/nativeRegion) to filter" default(aws/ap-northeast-2)
+// @Success 200 {object} mcis.LatencyMatrix
+// @Failure 500 {object} common.SimpleMsg
+// @Router /latency [get]
+func RestGetLatencyMatrix(c echo.Context) error {
+
+	source := c.QueryParam("source")
+
+	result, err := mcis.GetLatencyMatrix(source)
+	if err != nil {
+		common.CBLog.Error(err)
+		mapA := map[string]string{"message": err.Error()}
+		return c.JSON(http.StatusInternalServerError, &mapA)
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+// RestDelLatencyMatrix godoc
+// @Summary Delete inter-region latency matrix
+// @Description Delete all information in inter-region latency matrix
+// @Tags [Admin] Multi-Cloud environment configuration
+// @Accept  json
+// @Produce  json
+// @Success 200 {object} common.SimpleMsg
+// @Failure 500 {object} common.SimpleMsg
+// @Router /latency [delete]
+func RestDelLatencyMatrix(c echo.Context) error {
+
+	err := mcis.DelLatencyMatrix()
+	if err != nil {
+		common.CBLog.Error(err)
+		mapA := map[string]string{"message": err.Error()}
+		return c.JSON(http.StatusInternalServerError, &mapA)
+	}
+
+	mapA := map[string]string{"message": "Deleted the latency matrix"}
+	return c.JSON(http.StatusOK, &mapA)
+}
+
+// RestLoadLatencyMatrix godoc
+// @Summary Load inter-region latency matrix from internal asset file
+// @Description Load inter-region latency matrix from internal asset file (assets/cloudlatency.csv, no measurement by default). Latencies without measurement are estimated by geographical distance
+// @Tags [Admin] Multi-Cloud environment configuration
+// @Accept  json
+// @Produce  json
+// @Success 200 {object} mcis.LatencyMatrix
+// @Failure 404 {object} common.SimpleMsg
+// @Router /loadLatencyMatrix [get]
+func RestLoadLatencyMatrix(c echo.Context) error {
+
+	result, err := mcis.LoadLatencyMatrix()
+	if err != nil {
+		common.CBLog.Error(err)
+		mapA := map[string]string{"message": err.Error()}
+		return c.JSON(http.StatusNotFound, &mapA)
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
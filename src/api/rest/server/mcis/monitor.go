@@ -16,6 +16,7 @@ package mcis
 
 import (
 	"net/http"
+	"strconv"
 
 	"github.com/cloud-barista/cb-tumblebug/src/core/common"
 	"github.com/cloud-barista/cb-tumblebug/src/core/mcis"
@@ -57,13 +58,18 @@ func RestPostInstallMonitorAgentToMcis(c echo.Context) error {
 
 // RestGetMonitorData godoc
 // @Summary Get monitoring data of specified MCIS for specified monitoring metric (cpu, memory, disk, network)
-// @Description Get monitoring data of specified MCIS for specified monitoring metric (cpu, memory, disk, network)
+// @Description Get monitoring data of specified MCIS for specified monitoring metric (cpu, memory, disk, network). For VMs without the monitoring agent (monAgentStatus: notInstalled), the latest sample of the agentless (SSH) collector is returned.
+// @Description With from, to, step or agg, retained samples in the time range are returned as mcis.MonRangeResponse (per-VM time series and MCIS-level aggregates). Samples are retained by the agentless collector periodically (VMs without the agent) and only by queries of the monitoring agent otherwise.
 // @Tags [Infra service] MCIS Resource monitor (for developer)
 // @Accept  json
 // @Produce  json
 // @Param nsId path string true "Namespace ID" default(ns01)
 // @Param mcisId path string true "MCIS ID" default(mcis01)
-// @Param metric path string true "Metric type: cpu, memory, disk, network"
+// @Param metric path string true "Metric type: cpu, memory, disk, network (load is available for the agentless collector)"
+// @Param from query string false "Start of the range: RFC3339, Unix time or relative to now (default: -1h)"
+// @Param to query string false "End of the range: RFC3339, Unix time or relative to now (default: now)"
+// @Param step query string false "Step of points: duration (5m) or seconds (default: about 100 points)"
+// @Param agg query string false "Aggregation of samples of each VM in a step" Enums(avg, min, max, sum, count)
 // @Success 200 {object} mcis.MonResultSimpleResponse
 // @Failure 404 {object} common.SimpleMsg
 // @Failure 500 {object} common.SimpleMsg
@@ -74,6 +80,20 @@ func RestGetMonitorData(c echo.Context) error {
 	mcisId := c.Param("mcisId")
 	metric := c.Param("metric")
 
+	from := c.QueryParam("from")
+	to := c.QueryParam("to")
+	step := c.QueryParam("step")
+	agg := c.QueryParam("agg")
+	if from != "" || to != "" || step != "" || agg != "" {
+		content, err := mcis.GetMonitoringRange(nsId, mcisId, metric, from, to, step, agg)
+		if err != nil {
+			common.CBLog.Error(err)
+			mapA := map[string]string{"message": err.Error()}
+			return c.JSON(http.StatusBadRequest, &mapA)
+		}
+		return c.JSON(http.StatusOK, content)
+	}
+
 	req := &mcis.McisCmdReq{}
 	if err := c.Bind(req); err != nil {
 		return err
@@ -87,3 +107,39 @@ func RestGetMonitorData(c echo.Context) error {
 
 	return c.JSON(http.StatusOK, content)
 }
+
+// RestGetMonitorForecast godoc
+// @Summary Get forecasts of specified MCIS for specified monitoring metric with the accuracy of the model
+// @Description Forecast hourly values of the metric (by the monitoring samples retained from previous queries and the collector) with the backtest accuracy of the model
+// @Tags [Infra service] MCIS Resource monitor (for developer)
+// @Accept  json
+// @Produce  json
+// @Param nsId path string true "Namespace ID" default(ns01)
+// @Param mcisId path string true "MCIS ID" default(mcis01)
+// @Param metric path string true "Metric type: cpu, memory, disk, network"
+// @Param model query string false "Forecast model" Enums(holtWinters, lastWeek) default(holtWinters)
+// @Param seasonLength query int false "Length of season in hours (for holtWinters)" default(24)
+// @Param horizon query int false "Number of hours to forecast" default(24)
+// @Success 200 {object} mcis.McisMetricForecast
+// @Failure 404 {object} common.SimpleMsg
+// @Failure 500 {object} common.SimpleMsg
+// @Router /ns/{nsId}/monitoring/mcis/{mcisId}/metric/{metric}/forecast [get]
+func RestGetMonitorForecast(c echo.Context) error {
+
+	nsId := c.Param("nsId")
+	mcisId := c.Param("mcisId")
+	metric := c.Param("metric")
+
+	model := c.QueryParam("model")
+	seasonLength, _ := strconv.Atoi(c.QueryParam("seasonLength"))
+	horizon, _ := strconv.Atoi(c.QueryParam("horizon"))
+
+	content, err := mcis.GetMcisMetricForecast(nsId, mcisId, metric, model, seasonLength, horizon)
+	if err != nil {
+		common.CBLog.Error(err)
+		mapA := map[string]string{"message": err.Error()}
+		return c.JSON(http.StatusInternalServerError, &mapA)
+	}
+
+	return c.JSON(http.StatusOK, content)
+}
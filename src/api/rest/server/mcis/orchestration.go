@@ -17,6 +17,7 @@ package mcis
 import (
 	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/cloud-barista/cb-tumblebug/src/core/common"
 	"github.com/cloud-barista/cb-tumblebug/src/core/mcis"
@@ -51,6 +52,7 @@ func RestPostMcisPolicy(c echo.Context) error {
 		common.CBLog.Error(err)
 		return err
 	}
+	content = common.RedactSecrets(content).(mcis.McisPolicyInfo)
 
 	return c.JSON(http.StatusOK, content)
 }
@@ -63,6 +65,7 @@ func RestPostMcisPolicy(c echo.Context) error {
 // @Produce  json
 // @Param nsId path string true "Namespace ID" default(ns01)
 // @Param mcisId path string true "MCIS ID" default(mcis01)
+// @Param showSecret query string false "Show decrypted secrets such as vmUserPassword (X-Secret-Token header is required)" Enums(true)
 // @Success 200 {object} mcis.McisPolicyInfo
 // @Failure 404 {object} common.SimpleMsg
 // @Failure 500 {object} common.SimpleMsg
@@ -83,12 +86,46 @@ func RestGetMcisPolicy(c echo.Context) error {
 		mapA := map[string]string{"message": "Failed to find McisPolicyObject : " + mcisId}
 		return c.JSON(http.StatusNotFound, &mapA)
 	}
+	if !common.CheckSecretAccess(c.QueryParam("showSecret"), c.Request().Header.Get("X-Secret-Token")) {
+		result = common.RedactSecrets(result).(mcis.McisPolicyInfo)
+	}
 
 	common.PrintJsonPretty(result)
 	return c.JSON(http.StatusOK, result)
 
 }
 
+// RestGetMcisPolicyHistory godoc
+// @Summary Get history of MCIS Policy
+// @Description Get history records (actions and condition evaluations whose decision is changed, with observed metric values, decisions, VMs and errors) of MCIS Policy in the latest first order
+// @Tags [Infra service] MCIS Auto control policy management (WIP)
+// @Accept  json
+// @Produce  json
+// @Param nsId path string true "Namespace ID" default(ns01)
+// @Param mcisId path string true "MCIS ID" default(mcis01)
+// @Param offset query int false "Number of records to skip" default(0)
+// @Param limit query int false "Max number of records to return (max 1000)" default(100)
+// @Success 200 {object} mcis.McisPolicyHistoryList
+// @Failure 404 {object} common.SimpleMsg
+// @Failure 500 {object} common.SimpleMsg
+// @Router /ns/{nsId}/policy/mcis/{mcisId}/history [get]
+func RestGetMcisPolicyHistory(c echo.Context) error {
+
+	nsId := c.Param("nsId")
+	mcisId := c.Param("mcisId")
+
+	offset, _ := strconv.Atoi(c.QueryParam("offset"))
+	limit, _ := strconv.Atoi(c.QueryParam("limit"))
+
+	result, err := mcis.ListMcisPolicyHistory(nsId, mcisId, offset, limit)
+	if err != nil {
+		mapA := map[string]string{"message": err.Error()}
+		return c.JSON(http.StatusNotFound, &mapA)
+	}
+
+	return c.JSON(http.StatusOK, &result)
+}
+
 // Response structure for RestGetAllMcisPolicy
 type RestGetAllMcisPolicyResponse struct {
 	McisPolicy []mcis.McisPolicyInfo `json:"mcisPolicy"`
@@ -101,6 +138,7 @@ type RestGetAllMcisPolicyResponse struct {
 // @Accept  json
 // @Produce  json
 // @Param nsId path string true "Namespace ID" default(ns01)
+// @Param showSecret query string false "Show decrypted secrets such as vmUserPassword (X-Secret-Token header is required)" Enums(true)
 // @Success 200 {object} RestGetAllMcisPolicyResponse
 // @Failure 404 {object} common.SimpleMsg
 // @Failure 500 {object} common.SimpleMsg
@@ -115,6 +153,9 @@ func RestGetAllMcisPolicy(c echo.Context) error {
 		mapA := map[string]string{"message": err.Error()}
 		return c.JSON(http.StatusNotFound, &mapA)
 	}
+	if !common.CheckSecretAccess(c.QueryParam("showSecret"), c.Request().Header.Get("X-Secret-Token")) {
+		result = common.RedactSecrets(result).([]mcis.McisPolicyInfo)
+	}
 
 	content := RestGetAllMcisPolicyResponse{}
 	content.McisPolicy = result
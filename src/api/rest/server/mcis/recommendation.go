@@ -24,7 +24,7 @@ import (
 
 // RestRecommendVm godoc
 // @Summary Recommend MCIS plan (filter and priority)
-// @Description Recommend MCIS plan (filter and priority)
+// @Description Recommend MCIS plan (filter and priority). Specs are ranked by the weighted sum of normalized priority metrics (evaluationTotalScore) with a per-metric breakdown (evaluationBreakdown). Specs without price get 0 for the cost metric.
 // @Tags [Infra service] MCIS Provisioning management
 // @Accept  json
 // @Produce  json
@@ -54,3 +54,38 @@ func RestRecommendVm(c echo.Context) error {
 	// result.Spec = content
 	return c.JSON(http.StatusOK, &content)
 }
+
+// RestRecommendMcis godoc
+// @Summary Recommend MCIS plan for multiple VM requirements
+// @Description Recommend specs for all VM requirements jointly under MCIS constraints (budget per hour, min number of providers, same region).
+// @Description It returns a ready-to-submit request for POST /ns/{nsId}/mcisDynamic. MCIS will be created if deploy=true.
+// @Tags [Infra service] MCIS Provisioning management
+// @Accept  json
+// @Produce  json
+// @Param nsId path string true "Namespace ID" default(ns01)
+// @Param mcisRecommendReq body mcis.McisRecommendPlanReq true "VM requirements and MCIS constraints"
+// @Param deploy query bool false "Create MCIS with the recommended plan" default(false)
+// @Success 200 {object} mcis.McisRecommendPlanInfo
+// @Failure 404 {object} common.SimpleMsg
+// @Failure 500 {object} common.SimpleMsg
+// @Router /ns/{nsId}/mcisRecommend [post]
+func RestRecommendMcis(c echo.Context) error {
+
+	nsId := c.Param("nsId")
+	deploy := c.QueryParam("deploy") == "true"
+
+	req := &mcis.McisRecommendPlanReq{}
+	if err := c.Bind(req); err != nil {
+		return err
+	}
+
+	result, err := mcis.RecommendMcis(nsId, req, deploy)
+	if err != nil {
+		common.CBLog.Error(err)
+		mapA := map[string]string{"message": err.Error()}
+		return c.JSON(http.StatusNotFound, &mapA)
+	}
+
+	common.PrintJsonPretty(result)
+	return c.JSON(http.StatusOK, result)
+}
@@ -0,0 +1,269 @@
+/*
+Copyright 2019 The Cloud-Barista Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package mcis is to handle REST API for mcis
+package mcis
+
+import (
+	"net/http"
+
+	"github.com/cloud-barista/cb-tumblebug/src/core/common"
+	"github.com/cloud-barista/cb-tumblebug/src/core/mcis"
+	"github.com/labstack/echo/v4"
+)
+
+// RestPostScript godoc
+// @Summary Create a command script
+// @Description Create a command script. The body can have Go-template placeholders such as {{.VmId}}, {{.PublicIP}}, {{.PrivateIP}}, {{.McisId}} and {{.Param.key}}. User params and the label are rendered as single-quoted shell words.
+// @Tags [Infra service] MCIS Command script library
+// @Accept  json
+// @Produce  json
+// @Param nsId path string true "Namespace ID" default(ns01)
+// @Param scriptReq body mcis.TbScriptReq true "Details for a command script object"
+// @Success 200 {object} mcis.TbScriptInfo
+// @Failure 404 {object} common.SimpleMsg
+// @Failure 500 {object} common.SimpleMsg
+// @Router /ns/{nsId}/script [post]
+func RestPostScript(c echo.Context) error {
+
+	nsId := c.Param("nsId")
+
+	req := &mcis.TbScriptReq{}
+	if err := c.Bind(req); err != nil {
+		return err
+	}
+
+	content, err := mcis.CreateScript(nsId, req)
+	if err != nil {
+		common.CBLog.Error(err)
+		mapA := map[string]string{"message": err.Error()}
+		return c.JSON(http.StatusInternalServerError, &mapA)
+	}
+
+	return c.JSON(http.StatusOK, content)
+}
+
+// RestGetScript godoc
+// @Summary Get a command script
+// @Description Get a command script (the latest version)
+// @Tags [Infra service] MCIS Command script library
+// @Accept  json
+// @Produce  json
+// @Param nsId path string true "Namespace ID" default(ns01)
+// @Param scriptId path string true "Script ID" default(script01)
+// @Success 200 {object} mcis.TbScriptInfo
+// @Failure 404 {object} common.SimpleMsg
+// @Failure 500 {object} common.SimpleMsg
+// @Router /ns/{nsId}/script/{scriptId} [get]
+func RestGetScript(c echo.Context) error {
+
+	nsId := c.Param("nsId")
+	scriptId := c.Param("scriptId")
+
+	result, err := mcis.GetScript(nsId, scriptId)
+	if err != nil {
+		mapA := map[string]string{"message": err.Error()}
+		return c.JSON(http.StatusNotFound, &mapA)
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+// Response structure for RestGetAllScript
+type RestGetAllScriptResponse struct {
+	Script []mcis.TbScriptInfo `json:"script"`
+}
+
+// RestGetAllScript godoc
+// @Summary List all command scripts
+// @Description List all command scripts in the namespace
+// @Tags [Infra service] MCIS Command script library
+// @Accept  json
+// @Produce  json
+// @Param nsId path string true "Namespace ID" default(ns01)
+// @Success 200 {object} RestGetAllScriptResponse
+// @Failure 404 {object} common.SimpleMsg
+// @Failure 500 {object} common.SimpleMsg
+// @Router /ns/{nsId}/script [get]
+func RestGetAllScript(c echo.Context) error {
+
+	nsId := c.Param("nsId")
+
+	result, err := mcis.ListScript(nsId)
+	if err != nil {
+		mapA := map[string]string{"message": err.Error()}
+		return c.JSON(http.StatusNotFound, &mapA)
+	}
+
+	content := RestGetAllScriptResponse{}
+	content.Script = result
+	return c.JSON(http.StatusOK, &content)
+}
+
+// RestPutScript godoc
+// @Summary Update a command script
+// @Description Update a command script. The previous version is kept in the version history.
+// @Tags [Infra service] MCIS Command script library
+// @Accept  json
+// @Produce  json
+// @Param nsId path string true "Namespace ID" default(ns01)
+// @Param scriptId path string true "Script ID" default(script01)
+// @Param scriptReq body mcis.TbScriptReq true "Details for a command script object"
+// @Success 200 {object} mcis.TbScriptInfo
+// @Failure 404 {object} common.SimpleMsg
+// @Failure 500 {object} common.SimpleMsg
+// @Router /ns/{nsId}/script/{scriptId} [put]
+func RestPutScript(c echo.Context) error {
+
+	nsId := c.Param("nsId")
+	scriptId := c.Param("scriptId")
+
+	req := &mcis.TbScriptReq{}
+	if err := c.Bind(req); err != nil {
+		return err
+	}
+
+	content, err := mcis.UpdateScript(nsId, scriptId, req)
+	if err != nil {
+		common.CBLog.Error(err)
+		mapA := map[string]string{"message": err.Error()}
+		return c.JSON(http.StatusInternalServerError, &mapA)
+	}
+
+	return c.JSON(http.StatusOK, content)
+}
+
+// RestDelScript godoc
+// @Summary Delete a command script
+// @Description Delete a command script with its version and run history
+// @Tags [Infra service] MCIS Command script library
+// @Accept  json
+// @Produce  json
+// @Param nsId path string true "Namespace ID" default(ns01)
+// @Param scriptId path string true "Script ID" default(script01)
+// @Success 200 {object} common.SimpleMsg
+// @Failure 404 {object} common.SimpleMsg
+// @Router /ns/{nsId}/script/{scriptId} [delete]
+func RestDelScript(c echo.Context) error {
+
+	nsId := c.Param("nsId")
+	scriptId := c.Param("scriptId")
+
+	err := mcis.DelScript(nsId, scriptId)
+	if err != nil {
+		common.CBLog.Error(err)
+		mapA := map[string]string{"message": err.Error()}
+		return c.JSON(http.StatusInternalServerError, &mapA)
+	}
+
+	mapA := map[string]string{"message": "Deleted the script " + scriptId}
+	return c.JSON(http.StatusOK, &mapA)
+}
+
+// Response structure for RestGetScriptVersion
+type RestGetScriptVersionResponse struct {
+	Version []mcis.TbScriptInfo `json:"version"`
+}
+
+// RestGetScriptVersion godoc
+// @Summary List versions of a command script
+// @Description List the version history of a command script
+// @Tags [Infra service] MCIS Command script library
+// @Accept  json
+// @Produce  json
+// @Param nsId path string true "Namespace ID" default(ns01)
+// @Param scriptId path string true "Script ID" default(script01)
+// @Success 200 {object} RestGetScriptVersionResponse
+// @Failure 404 {object} common.SimpleMsg
+// @Router /ns/{nsId}/script/{scriptId}/version [get]
+func RestGetScriptVersion(c echo.Context) error {
+
+	nsId := c.Param("nsId")
+	scriptId := c.Param("scriptId")
+
+	result, err := mcis.ListScriptVersion(nsId, scriptId)
+	if err != nil {
+		mapA := map[string]string{"message": err.Error()}
+		return c.JSON(http.StatusNotFound, &mapA)
+	}
+
+	content := RestGetScriptVersionResponse{}
+	content.Version = result
+	return c.JSON(http.StatusOK, &content)
+}
+
+// RestPostRunScript godoc
+// @Summary Run a command script
+// @Description Render a command script for each VM selected by MCIS, VM group and label, and run it by SSH
+// @Tags [Infra service] MCIS Command script library
+// @Accept  json
+// @Produce  json
+// @Param nsId path string true "Namespace ID" default(ns01)
+// @Param scriptId path string true "Script ID" default(script01)
+// @Param scriptRunReq body mcis.TbScriptRunReq true "Target VMs and params to run the script"
+// @Success 200 {object} mcis.TbScriptRunInfo
+// @Failure 404 {object} common.SimpleMsg
+// @Failure 500 {object} common.SimpleMsg
+// @Router /ns/{nsId}/script/{scriptId}/run [post]
+func RestPostRunScript(c echo.Context) error {
+
+	nsId := c.Param("nsId")
+	scriptId := c.Param("scriptId")
+
+	req := &mcis.TbScriptRunReq{}
+	if err := c.Bind(req); err != nil {
+		return err
+	}
+
+	result, err := mcis.RunScript(nsId, scriptId, req)
+	if err != nil {
+		common.CBLog.Error(err)
+		mapA := map[string]string{"message": err.Error()}
+		return c.JSON(http.StatusInternalServerError, &mapA)
+	}
+
+	common.PrintJsonPretty(result)
+	return c.JSON(http.StatusOK, result)
+}
+
+// Response structure for RestGetScriptRun
+type RestGetScriptRunResponse struct {
+	Run []mcis.TbScriptRunInfo `json:"run"`
+}
+
+// RestGetScriptRun godoc
+// @Summary List runs of a command script
+// @Description List the run history of a command script (the latest 100 runs are kept)
+// @Tags [Infra service] MCIS Command script library
+// @Accept  json
+// @Produce  json
+// @Param nsId path string true "Namespace ID" default(ns01)
+// @Param scriptId path string true "Script ID" default(script01)
+// @Success 200 {object} RestGetScriptRunResponse
+// @Failure 404 {object} common.SimpleMsg
+// @Router /ns/{nsId}/script/{scriptId}/run [get]
+func RestGetScriptRun(c echo.Context) error {
+
+	nsId := c.Param("nsId")
+	scriptId := c.Param("scriptId")
+
+	result, err := mcis.ListScriptRun(nsId, scriptId)
+	if err != nil {
+		mapA := map[string]string{"message": err.Error()}
+		return c.JSON(http.StatusNotFound, &mapA)
+	}
+
+	content := RestGetScriptRunResponse{}
+	content.Run = result
+	return c.JSON(http.StatusOK, &content)
+}
@@ -0,0 +1,55 @@
+/*
+Copyright 2019 The Cloud-Barista Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package mcis is to handle REST API for mcis
+package mcis
+
+import (
+	"net/http"
+
+	"github.com/cloud-barista/cb-tumblebug/src/core/common"
+	"github.com/cloud-barista/cb-tumblebug/src/core/mcis"
+	"github.com/labstack/echo/v4"
+)
+
+// RestPostMcisValidate godoc
+// @Summary Validate MCIS request
+// @Description Pre-flight validation of MCIS request without creating it.
+// @Description Checks that spec, image, vNet, subnet, securityGroups and sshKey exist, belong to the connection of each VM,
+// @Description and that the architecture and OS of image are compatible with spec. All problems are returned at once.
+// @Tags [Infra service] MCIS Provisioning management
+// @Accept  json
+// @Produce  json
+// @Param nsId path string true "Namespace ID" default(ns01)
+// @Param mcisReq body mcis.TbMcisReq true "Details for an MCIS object"
+// @Success 200 {object} mcis.TbValidationResult
+// @Failure 400 {object} common.SimpleMsg
+// @Router /ns/{nsId}/mcis/validate [post]
+func RestPostMcisValidate(c echo.Context) error {
+
+	nsId := c.Param("nsId")
+
+	req := &mcis.TbMcisReq{}
+	if err := c.Bind(req); err != nil {
+		return err
+	}
+
+	result, err := mcis.ValidateMcisReq(nsId, req)
+	if err != nil {
+		common.CBLog.Error(err)
+		mapA := map[string]string{"message": err.Error()}
+		return c.JSON(http.StatusBadRequest, &mapA)
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
@@ -136,7 +136,18 @@ func RunServer(port string) {
 	e.DELETE("/tumblebug/object", rest_common.RestDeleteObject)
 	e.DELETE("/tumblebug/objects", rest_common.RestDeleteObjects)
 
+	e.POST("/tumblebug/secret/reEncrypt", rest_common.RestPostReEncryptSecret)
+
 	e.GET("/tumblebug/loadCommonResource", rest_mcir.RestLoadCommonResource)
+	e.GET("/tumblebug/loadLatencyMatrix", rest_mcis.RestLoadLatencyMatrix)
+	e.POST("/tumblebug/latency", rest_mcis.RestPostLatency)
+	e.GET("/tumblebug/latency", rest_mcis.RestGetLatencyMatrix)
+	e.DELETE("/tumblebug/latency", rest_mcis.RestDelLatencyMatrix)
+	e.GET("/tumblebug/loadPriceCatalog", rest_mcir.RestLoadPriceCatalog)
+	e.POST("/tumblebug/importPrice", rest_mcir.RestImportPrice)
+	e.POST("/tumblebug/price", rest_mcir.RestPostPrice)
+	e.GET("/tumblebug/price", rest_mcir.RestGetPrice)
+	e.DELETE("/tumblebug/price", rest_mcir.RestDelAllPrice)
 	e.GET("/tumblebug/ns/:nsId/loadDefaultResource", rest_mcir.RestLoadDefaultResource)
 	e.DELETE("/tumblebug/ns/:nsId/defaultResources", rest_mcir.RestDelAllDefaultResources)
 
@@ -153,6 +164,7 @@ func RunServer(port string) {
 
 	//MCIS Management
 	g.POST("/:nsId/mcis", rest_mcis.RestPostMcis)
+	g.POST("/:nsId/mcis/validate", rest_mcis.RestPostMcisValidate)
 	g.POST("/:nsId/mcisDynamic", rest_mcis.RestPostMcisDynamic)
 	g.GET("/:nsId/mcis/:mcisId", rest_mcis.RestGetMcis)
 	g.GET("/:nsId/mcis", rest_mcis.RestGetAllMcis)
@@ -171,6 +183,13 @@ func RunServer(port string) {
 	//g.POST("/:nsId/mcis/recommend", rest_mcis.RestPostMcisRecommend)
 
 	g.POST("/:nsId/mcisRecommendVm", rest_mcis.RestRecommendVm)
+	g.POST("/:nsId/mcisRecommend", rest_mcis.RestRecommendMcis)
+	g.GET("/:nsId/costReport", rest_mcis.RestGetCostReport)
+
+	g.PUT("/:nsId/budget", rest_mcis.RestPutBudget)
+	g.GET("/:nsId/budget", rest_mcis.RestGetBudget)
+	g.DELETE("/:nsId/budget", rest_mcis.RestDelBudget)
+	g.GET("/:nsId/budget/alert", rest_mcis.RestGetBudgetAlert)
 
 	g.GET("/:nsId/control/mcis/:mcisId", rest_mcis.RestGetControlMcis)
 	g.GET("/:nsId/control/mcis/:mcisId/vm/:vmId", rest_mcis.RestGetControlMcisVm)
@@ -181,16 +200,34 @@ func RunServer(port string) {
 	g.POST("/:nsId/benchmark/mcis/:mcisId", rest_mcis.RestGetBenchmark)
 	g.POST("/:nsId/benchmarkAll/mcis/:mcisId", rest_mcis.RestGetAllBenchmark)
 
+	//MCIS command script library
+	g.POST("/:nsId/script", rest_mcis.RestPostScript)
+	g.GET("/:nsId/script", rest_mcis.RestGetAllScript)
+	g.GET("/:nsId/script/:scriptId", rest_mcis.RestGetScript)
+	g.PUT("/:nsId/script/:scriptId", rest_mcis.RestPutScript)
+	g.DELETE("/:nsId/script/:scriptId", rest_mcis.RestDelScript)
+	g.GET("/:nsId/script/:scriptId/version", rest_mcis.RestGetScriptVersion)
+	g.POST("/:nsId/script/:scriptId/run", rest_mcis.RestPostRunScript)
+	g.GET("/:nsId/script/:scriptId/run", rest_mcis.RestGetScriptRun)
+
 	//MCIS AUTO Policy
 	g.POST("/:nsId/policy/mcis/:mcisId", rest_mcis.RestPostMcisPolicy)
 	g.GET("/:nsId/policy/mcis/:mcisId", rest_mcis.RestGetMcisPolicy)
+	g.GET("/:nsId/policy/mcis/:mcisId/history", rest_mcis.RestGetMcisPolicyHistory)
 	g.GET("/:nsId/policy/mcis", rest_mcis.RestGetAllMcisPolicy)
 	g.PUT("/:nsId/policy/mcis/:mcisId", rest_mcis.RestPutMcisPolicy)
 	g.DELETE("/:nsId/policy/mcis/:mcisId", rest_mcis.RestDelMcisPolicy)
 	g.DELETE("/:nsId/policy/mcis", rest_mcis.RestDelAllMcisPolicy)
 
+	g.POST("/:nsId/healthPolicy/mcis/:mcisId", rest_mcis.RestPostMcisHealthPolicy)
+	g.GET("/:nsId/healthPolicy/mcis/:mcisId", rest_mcis.RestGetMcisHealthPolicy)
+	g.GET("/:nsId/healthPolicy/mcis/:mcisId/log", rest_mcis.RestGetMcisHealthLog)
+	g.GET("/:nsId/healthPolicy/mcis", rest_mcis.RestGetAllMcisHealthPolicy)
+	g.DELETE("/:nsId/healthPolicy/mcis/:mcisId", rest_mcis.RestDelMcisHealthPolicy)
+
 	g.POST("/:nsId/monitoring/install/mcis/:mcisId", rest_mcis.RestPostInstallMonitorAgentToMcis)
 	g.GET("/:nsId/monitoring/mcis/:mcisId/metric/:metric", rest_mcis.RestGetMonitorData)
+	g.GET("/:nsId/monitoring/mcis/:mcisId/metric/:metric/forecast", rest_mcis.RestGetMonitorForecast)
 
 	//MCIR Management
 	g.POST("/:nsId/resources/image", rest_mcir.RestPostImage)
@@ -204,6 +241,7 @@ func RunServer(port string) {
 	g.GET("/:nsId/resources/sshKey/:resourceId", rest_mcir.RestGetResource)
 	g.GET("/:nsId/resources/sshKey", rest_mcir.RestGetAllResources)
 	g.PUT("/:nsId/resources/sshKey/:resourceId", rest_mcir.RestPutSshKey)
+	g.POST("/:nsId/resources/sshKey/:resourceId/rotate", rest_mcir.RestPostRotateSshKey)
 	g.DELETE("/:nsId/resources/sshKey/:resourceId", rest_mcir.RestDelResource)
 	g.DELETE("/:nsId/resources/sshKey", rest_mcir.RestDelAllResources)
 
@@ -217,10 +255,20 @@ func RunServer(port string) {
 	g.POST("/:nsId/resources/fetchSpecs", rest_mcir.RestFetchSpecs)
 	g.POST("/:nsId/resources/filterSpecs", rest_mcir.RestFilterSpecs)
 	g.POST("/:nsId/resources/filterSpecsByRange", rest_mcir.RestFilterSpecsByRange)
+	g.POST("/:nsId/resources/searchSpecs", rest_mcir.RestSearchSpecs)
+	g.POST("/:nsId/resources/updateSpecPrice", rest_mcir.RestUpdateSpecPrice)
+	g.GET("/:nsId/pricingPolicy", rest_mcir.RestGetPricingPolicy)
+	g.PUT("/:nsId/pricingPolicy", rest_mcir.RestPutPricingPolicy)
 	g.POST("/:nsId/resources/testSortSpecs", rest_mcir.RestTestSortSpecs)
 
 	g.POST("/:nsId/resources/fetchImages", rest_mcir.RestFetchImages)
 	g.POST("/:nsId/resources/searchImage", rest_mcir.RestSearchImage)
+	g.POST("/:nsId/resources/updateImageOsInfo", rest_mcir.RestUpdateImageOsInfo)
+
+	g.POST("/:nsId/catalogSync", rest_mcir.RestPostCatalogSync)
+	g.GET("/:nsId/catalogSyncPolicy", rest_mcir.RestGetCatalogSyncPolicy)
+	g.PUT("/:nsId/catalogSyncPolicy", rest_mcir.RestPutCatalogSyncPolicy)
+	g.GET("/:nsId/catalogChange", rest_mcir.RestGetCatalogChange)
 
 	g.POST("/:nsId/resources/securityGroup", rest_mcir.RestPostSecurityGroup)
 	g.GET("/:nsId/resources/securityGroup/:resourceId", rest_mcir.RestGetResource)
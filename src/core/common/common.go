@@ -45,6 +45,12 @@ var DBDatabase string
 var DBUser string
 var DBPassword string
 var AutocontrolDurationMs string
+var SshConcurrencyLimit string
+var SshIdleTimeoutSec string
+var SshSessionTimeoutSec string
+var PolicyHistoryMaxCount string
+var PolicyHistoryRetentionHour string
+var MonCollectorIntervalSec string
 var MyDB *sql.DB
 var err error
 var ORM *xorm.Engine
@@ -57,6 +63,12 @@ const (
 	StrDBUser                     string = "DB_USER"
 	StrDBPassword                 string = "DB_PASSWORD"
 	StrAutocontrolDurationMs      string = "AUTOCONTROL_DURATION_MS"
+	StrSshConcurrencyLimit        string = "SSH_CONCURRENCY_LIMIT"
+	StrSshIdleTimeoutSec          string = "SSH_IDLE_TIMEOUT_SEC"
+	StrSshSessionTimeoutSec       string = "SSH_SESSION_TIMEOUT_SEC"
+	StrPolicyHistoryMaxCount      string = "POLICY_HISTORY_MAX_COUNT"
+	StrPolicyHistoryRetentionHour string = "POLICY_HISTORY_RETENTION_HOUR"
+	StrMonCollectorIntervalSec    string = "MON_COLLECTOR_INTERVAL_SEC"
 	CbStoreKeyNotFoundErrorString string = "key not found"
 	StrAdd                        string = "add"
 	StrDelete                     string = "delete"
@@ -73,7 +85,8 @@ var StartTime string
 
 func init() {
 	CBLog = config.Cblogger
-	CBStore = cbstore.GetStore()
+	// sensitive fields are encrypted at rest by secretStore (see secret.go)
+	CBStore = &secretStore{cbstore.GetStore()}
 
 	StartTime = time.Now().Format("2006.01.02 15:04:05 Mon")
 }
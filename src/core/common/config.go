@@ -97,6 +97,24 @@ func UpdateGlobalVariable(id string) error {
 	case StrAutocontrolDurationMs:
 		AutocontrolDurationMs = configInfo.Value
 		fmt.Println("<AUTOCONTROL_DURATION_MS> " + AutocontrolDurationMs)
+	case StrSshConcurrencyLimit:
+		SshConcurrencyLimit = configInfo.Value
+		fmt.Println("<SSH_CONCURRENCY_LIMIT> " + SshConcurrencyLimit)
+	case StrSshIdleTimeoutSec:
+		SshIdleTimeoutSec = configInfo.Value
+		fmt.Println("<SSH_IDLE_TIMEOUT_SEC> " + SshIdleTimeoutSec)
+	case StrSshSessionTimeoutSec:
+		SshSessionTimeoutSec = configInfo.Value
+		fmt.Println("<SSH_SESSION_TIMEOUT_SEC> " + SshSessionTimeoutSec)
+	case StrPolicyHistoryMaxCount:
+		PolicyHistoryMaxCount = configInfo.Value
+		fmt.Println("<POLICY_HISTORY_MAX_COUNT> " + PolicyHistoryMaxCount)
+	case StrPolicyHistoryRetentionHour:
+		PolicyHistoryRetentionHour = configInfo.Value
+		fmt.Println("<POLICY_HISTORY_RETENTION_HOUR> " + PolicyHistoryRetentionHour)
+	case StrMonCollectorIntervalSec:
+		MonCollectorIntervalSec = configInfo.Value
+		fmt.Println("<MON_COLLECTOR_INTERVAL_SEC> " + MonCollectorIntervalSec)
 	default:
 
 	}
@@ -128,6 +146,24 @@ func InitConfig(id string) error {
 	case StrAutocontrolDurationMs:
 		AutocontrolDurationMs = NVL(os.Getenv("AUTOCONTROL_DURATION_MS"), "10000")
 		fmt.Println("<AUTOCONTROL_DURATION_MS> " + AutocontrolDurationMs)
+	case StrSshConcurrencyLimit:
+		SshConcurrencyLimit = NVL(os.Getenv("SSH_CONCURRENCY_LIMIT"), "20")
+		fmt.Println("<SSH_CONCURRENCY_LIMIT> " + SshConcurrencyLimit)
+	case StrSshIdleTimeoutSec:
+		SshIdleTimeoutSec = NVL(os.Getenv("SSH_IDLE_TIMEOUT_SEC"), "300")
+		fmt.Println("<SSH_IDLE_TIMEOUT_SEC> " + SshIdleTimeoutSec)
+	case StrSshSessionTimeoutSec:
+		SshSessionTimeoutSec = NVL(os.Getenv("SSH_SESSION_TIMEOUT_SEC"), "1800")
+		fmt.Println("<SSH_SESSION_TIMEOUT_SEC> " + SshSessionTimeoutSec)
+	case StrPolicyHistoryMaxCount:
+		PolicyHistoryMaxCount = NVL(os.Getenv("POLICY_HISTORY_MAX_COUNT"), "10000")
+		fmt.Println("<POLICY_HISTORY_MAX_COUNT> " + PolicyHistoryMaxCount)
+	case StrPolicyHistoryRetentionHour:
+		PolicyHistoryRetentionHour = NVL(os.Getenv("POLICY_HISTORY_RETENTION_HOUR"), "168")
+		fmt.Println("<POLICY_HISTORY_RETENTION_HOUR> " + PolicyHistoryRetentionHour)
+	case StrMonCollectorIntervalSec:
+		MonCollectorIntervalSec = NVL(os.Getenv("MON_COLLECTOR_INTERVAL_SEC"), "60")
+		fmt.Println("<MON_COLLECTOR_INTERVAL_SEC> " + MonCollectorIntervalSec)
 	default:
 
 	}
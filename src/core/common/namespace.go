@@ -249,6 +249,20 @@ func ListNsId() ([]string, error) {
 
 }
 
+// nsScopedKeyList is list of key prefixes (under /ns/{nsId}) of settings and records deleted with the namespace
+var nsScopedKeyList = []string{
+	"/policyHistory/",
+	"/healthPolicy/",
+	"/healthLog/",
+	"/monSample/",
+	"/pricingPolicy",
+	"/vmCost/",
+	"/budget",
+	"/budgetAlert/",
+	"/catalogSyncPolicy",
+	"/catalogChange/",
+}
+
 func DelNs(id string) error {
 
 	err := CheckString(id)
@@ -282,6 +296,7 @@ func DelNs(id string) error {
 	securityGroupList := GetChildIdList(key + "/resources/securityGroup")
 	specList := GetChildIdList(key + "/resources/spec")
 	sshKeyList := GetChildIdList(key + "/resources/sshKey")
+	scriptList := GetChildIdList(key + "/script")
 	//vNicList := GetChildIdList(key + "/resources/vNic")
 
 	if len(mcisList)+
@@ -290,8 +305,9 @@ func DelNs(id string) error {
 		//len(subnetList)
 		len(securityGroupList)+
 		len(specList)+
-		len(sshKeyList) > 0 {
-		errString := "Cannot delete NS " + id + ", which is not empty. There exists at least one MCIS or one of resources."
+		len(sshKeyList)+
+		len(scriptList) > 0 {
+		errString := "Cannot delete NS " + id + ", which is not empty. There exists at least one MCIS, one of resources or one script."
 		errString += " \n len(mcisList): " + strconv.Itoa(len(mcisList))
 		errString += " \n len(imageList): " + strconv.Itoa(len(imageList))
 		errString += " \n len(vNetList): " + strconv.Itoa(len(vNetList))
@@ -299,6 +315,7 @@ func DelNs(id string) error {
 		errString += " \n len(securityGroupList): " + strconv.Itoa(len(securityGroupList))
 		errString += " \n len(specList): " + strconv.Itoa(len(specList))
 		errString += " \n len(sshKeyList): " + strconv.Itoa(len(sshKeyList))
+		errString += " \n len(scriptList): " + strconv.Itoa(len(scriptList))
 		//errString += " \n len(subnetList): " + strconv.Itoa(len(subnetList))
 		//errString += " \n len(vNicList): " + strconv.Itoa(len(vNicList))
 
@@ -307,6 +324,16 @@ func DelNs(id string) error {
 		return err
 	}
 
+	// delete ns-scoped settings and records which are not managed as objects of the ns
+	// so that a re-created ns does not inherit them (budget, cost, history, samples, etc.)
+	for _, v := range nsScopedKeyList {
+		err = DeleteObjects(key + v)
+		if err != nil {
+			CBLog.Error(err)
+			return err
+		}
+	}
+
 	// delete ns info
 	err = CBStore.Delete(key)
 	if err != nil {
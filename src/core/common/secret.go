@@ -0,0 +1,557 @@
+/*
+Copyright 2019 The Cloud-Barista Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package common is to include common methods for managing multi-cloud infra
+package common
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"reflect"
+	"strings"
+	"sync"
+
+	icbs "github.com/cloud-barista/cb-store/interfaces"
+)
+
+const (
+	// StrSecretKey is env name for master keys to encrypt secrets ("keyId:base64Key", comma separated, the first one is active)
+	StrSecretKey string = "SECRET_KEY"
+
+	// StrSecretKeyFile is env name for the file containing master keys (same format as SECRET_KEY, one key per line)
+	StrSecretKeyFile string = "SECRET_KEY_FILE"
+
+	// StrSecretAccessToken is env name for the token to get decrypted secrets in GET responses (disabled if empty)
+	StrSecretAccessToken string = "SECRET_ACCESS_TOKEN"
+
+	// SecretRedacted is the value shown instead of a secret
+	SecretRedacted string = "********"
+
+	// secretPrefix is the prefix of an encrypted secret ("enc:v1:{keyId}:{wrapped data key}:{ciphertext}")
+	secretPrefix string = "enc:v1:"
+)
+
+// secretKeyRing is struct for master keys to wrap data keys of secrets
+type secretKeyRing struct {
+	mutex       sync.RWMutex
+	activeId    string
+	keys        map[string][]byte
+	accessToken string
+}
+
+var secretKeys = &secretKeyRing{keys: make(map[string][]byte)}
+
+// secretPathAll is the path element for all elements of an array
+const secretPathAll string = "[]"
+
+// secretFieldRule is struct for JSON fields to be encrypted in objects whose key matches
+// (secretPathAll in a path means all elements of the array)
+type secretFieldRule struct {
+	match func(key string) bool
+	paths [][]string
+}
+
+// secretFieldRules are the sensitive fields of objects stored in CBStore
+var secretFieldRules = []secretFieldRule{
+	{
+		// /ns/{nsId}/resources/sshKey/{sshKeyId}
+		match: func(key string) bool {
+			keyParts := strings.Split(key, "/")
+			return len(keyParts) == 6 && keyParts[3] == "resources" && keyParts[4] == StrSSHKey
+		},
+		paths: [][]string{{"privateKey"}},
+	},
+	{
+		// /ns/{nsId}/mcis/{mcisId}/vm/{vmId}
+		match: func(key string) bool {
+			keyParts := strings.Split(key, "/")
+			return len(keyParts) == 7 && keyParts[3] == "mcis" && keyParts[5] == "vm"
+		},
+		paths: [][]string{{"vmUserPassword"}, {"cspViewVmDetail", "VMUserPasswd"}},
+	},
+	{
+		// /ns/{nsId}/policy/mcis/{mcisId} (VM template of the auto-control action)
+		match: func(key string) bool {
+			keyParts := strings.Split(key, "/")
+			return len(keyParts) == 6 && keyParts[3] == "policy" && keyParts[4] == "mcis"
+		},
+		paths: [][]string{
+			{"policy", secretPathAll, "autoAction", "vm", "vmUserPassword"},
+			{"policy", secretPathAll, "autoAction", "vm", "cspViewVmDetail", "VMUserPasswd"},
+		},
+	},
+}
+
+// parseSecretKeys is func to parse master keys ("keyId:base64Key" separated by comma or newline)
+func parseSecretKeys(spec string) (string, map[string][]byte, error) {
+	activeId := ""
+	keys := make(map[string][]byte)
+	spec = strings.ReplaceAll(spec, "\n", ",")
+	for _, v := range strings.Split(spec, ",") {
+		v = strings.TrimSpace(v)
+		if v == "" || strings.HasPrefix(v, "#") {
+			continue
+		}
+		keyParts := strings.SplitN(v, ":", 2)
+		if len(keyParts) != 2 || keyParts[0] == "" {
+			return "", nil, fmt.Errorf("Invalid master key format. Use keyId:base64Key")
+		}
+		key, err := base64.StdEncoding.DecodeString(keyParts[1])
+		if err != nil {
+			return "", nil, fmt.Errorf("Invalid master key " + keyParts[0] + ": " + err.Error())
+		}
+		if len(key) != 32 {
+			return "", nil, fmt.Errorf("Invalid master key " + keyParts[0] + ": 32 bytes (AES-256) key is required")
+		}
+		if activeId == "" {
+			activeId = keyParts[0]
+		}
+		keys[keyParts[0]] = key
+	}
+	return activeId, keys, nil
+}
+
+// InitSecretKey is func to load master keys and the secret access token from env (SECRET_KEY, SECRET_KEY_FILE, SECRET_ACCESS_TOKEN)
+func InitSecretKey(secretKey string, secretKeyFile string, accessToken string) error {
+	spec := secretKey
+	if secretKeyFile != "" {
+		data, err := ioutil.ReadFile(secretKeyFile)
+		if err != nil {
+			CBLog.Error(err)
+			return err
+		}
+		spec = string(data) + "," + spec
+	}
+
+	activeId, keys, err := parseSecretKeys(spec)
+	if err != nil {
+		CBLog.Error(err)
+		return err
+	}
+
+	secretKeys.mutex.Lock()
+	defer secretKeys.mutex.Unlock()
+	secretKeys.activeId = activeId
+	secretKeys.keys = keys
+	secretKeys.accessToken = accessToken
+
+	if activeId == "" {
+		CBLog.Info("[Secret] No master key is given. Secrets are stored without encryption.")
+	} else {
+		fmt.Println("<SECRET_KEY> active key: " + activeId + ", number of keys: " + fmt.Sprint(len(keys)))
+	}
+	return nil
+}
+
+// CheckSecretAccess is func to check whether the caller is permitted to get decrypted secrets
+func CheckSecretAccess(showSecret string, token string) bool {
+	if showSecret != "true" {
+		return false
+	}
+	secretKeys.mutex.RLock()
+	defer secretKeys.mutex.RUnlock()
+	if secretKeys.accessToken == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(token), []byte(secretKeys.accessToken)) == 1
+}
+
+func sealAesGcm(key []byte, plain []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	return gcm.Seal(nonce, nonce, plain, nil), nil
+}
+
+func openAesGcm(key []byte, sealed []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	if len(sealed) < gcm.NonceSize() {
+		return nil, fmt.Errorf("The encrypted secret is too short")
+	}
+	return gcm.Open(nil, sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():], nil)
+}
+
+// IsEncryptedSecret is func to check whether the value is an encrypted secret
+func IsEncryptedSecret(value string) bool {
+	return strings.HasPrefix(value, secretPrefix)
+}
+
+// getSecretKeyId is func to get the master key ID of an encrypted secret
+func getSecretKeyId(value string) string {
+	secretParts := strings.Split(strings.TrimPrefix(value, secretPrefix), ":")
+	return secretParts[0]
+}
+
+// EncryptSecret is func to encrypt a secret with a new data key wrapped by the active master key (envelope encryption)
+func EncryptSecret(plain string) (string, error) {
+	if plain == "" || IsEncryptedSecret(plain) {
+		return plain, nil
+	}
+
+	secretKeys.mutex.RLock()
+	activeId := secretKeys.activeId
+	masterKey := secretKeys.keys[activeId]
+	secretKeys.mutex.RUnlock()
+
+	if activeId == "" {
+		return plain, nil
+	}
+
+	dataKey := make([]byte, 32)
+	if _, err := io.ReadFull(rand.Reader, dataKey); err != nil {
+		return "", err
+	}
+	wrappedKey, err := sealAesGcm(masterKey, dataKey)
+	if err != nil {
+		return "", err
+	}
+	cipherText, err := sealAesGcm(dataKey, []byte(plain))
+	if err != nil {
+		return "", err
+	}
+	return secretPrefix + activeId + ":" + base64.StdEncoding.EncodeToString(wrappedKey) + ":" + base64.StdEncoding.EncodeToString(cipherText), nil
+}
+
+// DecryptSecret is func to decrypt a secret (a value without encryption is returned as it is)
+func DecryptSecret(value string) (string, error) {
+	if !IsEncryptedSecret(value) {
+		return value, nil
+	}
+
+	secretParts := strings.Split(strings.TrimPrefix(value, secretPrefix), ":")
+	if len(secretParts) != 3 {
+		return "", fmt.Errorf("Invalid format of the encrypted secret")
+	}
+
+	secretKeys.mutex.RLock()
+	masterKey, exist := secretKeys.keys[secretParts[0]]
+	secretKeys.mutex.RUnlock()
+	if !exist {
+		return "", fmt.Errorf("The master key " + secretParts[0] + " to decrypt the secret is not given")
+	}
+
+	wrappedKey, err := base64.StdEncoding.DecodeString(secretParts[1])
+	if err != nil {
+		return "", err
+	}
+	cipherText, err := base64.StdEncoding.DecodeString(secretParts[2])
+	if err != nil {
+		return "", err
+	}
+	dataKey, err := openAesGcm(masterKey, wrappedKey)
+	if err != nil {
+		return "", err
+	}
+	plain, err := openAesGcm(dataKey, cipherText)
+	if err != nil {
+		return "", err
+	}
+	return string(plain), nil
+}
+
+// transformSecretFields is func to apply the given function to the sensitive fields of an object in CBStore
+func transformSecretFields(key string, value string, transform func(string) (string, error)) (string, error) {
+	var paths [][]string
+	for _, rule := range secretFieldRules {
+		if rule.match(key) {
+			paths = append(paths, rule.paths...)
+		}
+	}
+	if len(paths) == 0 || value == "" {
+		return value, nil
+	}
+
+	var object map[string]interface{}
+	decoder := json.NewDecoder(bytes.NewReader([]byte(value)))
+	decoder.UseNumber()
+	if err := decoder.Decode(&object); err != nil {
+		// not a JSON object. leave it as it is.
+		return value, nil
+	}
+
+	changed := false
+	for _, path := range paths {
+		pathChanged, err := transformSecretPath(object, path, transform)
+		if err != nil {
+			return value, err
+		}
+		changed = changed || pathChanged
+	}
+	if !changed {
+		return value, nil
+	}
+
+	result, err := json.Marshal(object)
+	if err != nil {
+		return value, err
+	}
+	return string(result), nil
+}
+
+// transformSecretPath is func to apply the given function to the string field at the path of a JSON node
+func transformSecretPath(node interface{}, path []string, transform func(string) (string, error)) (bool, error) {
+	if path[0] == secretPathAll {
+		elements, ok := node.([]interface{})
+		if !ok {
+			return false, nil
+		}
+		changed := false
+		for _, element := range elements {
+			elementChanged, err := transformSecretPath(element, path[1:], transform)
+			if err != nil {
+				return false, err
+			}
+			changed = changed || elementChanged
+		}
+		return changed, nil
+	}
+
+	object, ok := node.(map[string]interface{})
+	if !ok {
+		return false, nil
+	}
+	if len(path) > 1 {
+		return transformSecretPath(object[path[0]], path[1:], transform)
+	}
+	fieldValue, ok := object[path[0]].(string)
+	if !ok || fieldValue == "" {
+		return false, nil
+	}
+	newValue, err := transform(fieldValue)
+	if err != nil {
+		return false, err
+	}
+	if newValue == fieldValue {
+		return false, nil
+	}
+	object[path[0]] = newValue
+	return true, nil
+}
+
+// RedactSecretFields is func to redact the sensitive fields of an object value in CBStore (for generic object APIs)
+func RedactSecretFields(key string, value string) string {
+	result, _ := transformSecretFields(key, value, func(string) (string, error) {
+		return SecretRedacted, nil
+	})
+	return result
+}
+
+// RedactSecrets is func to return a deep copy of the object with string fields tagged `secret:"true"` redacted
+// (the given object is not changed since it may share slices, maps and pointers with cached or stored objects)
+func RedactSecrets(object interface{}) interface{} {
+	if object == nil {
+		return nil
+	}
+	return redactSecretValue(reflect.ValueOf(object)).Interface()
+}
+
+// redactSecretValue is func to return a redacted deep copy of the value
+func redactSecretValue(value reflect.Value) reflect.Value {
+	switch value.Kind() {
+	case reflect.Ptr:
+		if value.IsNil() {
+			return value
+		}
+		copied := reflect.New(value.Type().Elem())
+		copied.Elem().Set(redactSecretValue(value.Elem()))
+		return copied
+	case reflect.Interface:
+		if value.IsNil() {
+			return value
+		}
+		copied := reflect.New(value.Type()).Elem()
+		copied.Set(redactSecretValue(value.Elem()))
+		return copied
+	case reflect.Slice:
+		if value.IsNil() {
+			return value
+		}
+		copied := reflect.MakeSlice(value.Type(), value.Len(), value.Len())
+		for i := 0; i < value.Len(); i++ {
+			copied.Index(i).Set(redactSecretValue(value.Index(i)))
+		}
+		return copied
+	case reflect.Array:
+		copied := reflect.New(value.Type()).Elem()
+		for i := 0; i < value.Len(); i++ {
+			copied.Index(i).Set(redactSecretValue(value.Index(i)))
+		}
+		return copied
+	case reflect.Map:
+		if value.IsNil() {
+			return value
+		}
+		copied := reflect.MakeMapWithSize(value.Type(), value.Len())
+		iter := value.MapRange()
+		for iter.Next() {
+			copied.SetMapIndex(iter.Key(), redactSecretValue(iter.Value()))
+		}
+		return copied
+	case reflect.Struct:
+		// unexported fields are copied as they are
+		copied := reflect.New(value.Type()).Elem()
+		copied.Set(value)
+		for i := 0; i < value.NumField(); i++ {
+			field := copied.Field(i)
+			if !field.CanSet() {
+				continue
+			}
+			if value.Type().Field(i).Tag.Get("secret") == "true" && field.Kind() == reflect.String {
+				if field.String() != "" {
+					field.SetString(SecretRedacted)
+				}
+				continue
+			}
+			field.Set(redactSecretValue(value.Field(i)))
+		}
+		return copied
+	default:
+		return value
+	}
+}
+
+// secretStore is a CBStore wrapper which encrypts sensitive fields on Put and decrypts them on Get
+type secretStore struct {
+	icbs.Store
+}
+
+// Put is func to store the value with sensitive fields encrypted
+func (s *secretStore) Put(key string, value string) error {
+	encrypted, err := transformSecretFields(key, value, EncryptSecret)
+	if err != nil {
+		CBLog.Error(err)
+		return err
+	}
+	return s.Store.Put(key, encrypted)
+}
+
+// Get is func to get the value with sensitive fields decrypted
+func (s *secretStore) Get(key string) (*icbs.KeyValue, error) {
+	keyValue, err := s.Store.Get(key)
+	if err != nil || keyValue == nil {
+		return keyValue, err
+	}
+	decrypted, err := transformSecretFields(keyValue.Key, keyValue.Value, DecryptSecret)
+	if err != nil {
+		CBLog.Error(err)
+		return nil, err
+	}
+	return &icbs.KeyValue{Key: keyValue.Key, Value: decrypted}, nil
+}
+
+// GetList is func to get values with sensitive fields decrypted
+func (s *secretStore) GetList(key string, sortAscend bool) ([]*icbs.KeyValue, error) {
+	keyValueList, err := s.Store.GetList(key, sortAscend)
+	if err != nil {
+		return keyValueList, err
+	}
+	result := make([]*icbs.KeyValue, 0, len(keyValueList))
+	for _, v := range keyValueList {
+		decrypted, err := transformSecretFields(v.Key, v.Value, DecryptSecret)
+		if err != nil {
+			CBLog.Error(err)
+			return nil, err
+		}
+		result = append(result, &icbs.KeyValue{Key: v.Key, Value: decrypted})
+	}
+	return result, nil
+}
+
+// SecretReEncryptResult is struct for the result of secret re-encryption
+type SecretReEncryptResult struct {
+	ActiveKeyId string `json:"activeKeyId"`
+
+	// ReEncrypted is the number of objects whose secrets have been re-encrypted with the active key
+	ReEncrypted int `json:"reEncrypted"`
+
+	// Failed is a list of object keys failed to re-encrypt
+	Failed []string `json:"failed"`
+}
+
+// ReEncryptSecrets is func to re-encrypt all stored secrets with the active master key (after master key rotation)
+func ReEncryptSecrets() (SecretReEncryptResult, error) {
+	secretKeys.mutex.RLock()
+	activeId := secretKeys.activeId
+	secretKeys.mutex.RUnlock()
+
+	result := SecretReEncryptResult{ActiveKeyId: activeId, Failed: []string{}}
+	if activeId == "" {
+		return result, fmt.Errorf("No master key is given. Set " + StrSecretKey + " or " + StrSecretKeyFile + ".")
+	}
+
+	store, ok := CBStore.(*secretStore)
+	if !ok {
+		return result, fmt.Errorf("CBStore does not support secret encryption")
+	}
+
+	keyValueList, err := store.Store.GetList("/ns", true)
+	if err != nil {
+		CBLog.Error(err)
+		return result, err
+	}
+
+	for _, v := range keyValueList {
+		needed := false
+		_, err := transformSecretFields(v.Key, v.Value, func(value string) (string, error) {
+			if !IsEncryptedSecret(value) || getSecretKeyId(value) != activeId {
+				needed = true
+			}
+			return value, nil
+		})
+		if err != nil || !needed {
+			continue
+		}
+
+		reEncrypted, err := transformSecretFields(v.Key, v.Value, func(value string) (string, error) {
+			plain, err := DecryptSecret(value)
+			if err != nil {
+				return "", err
+			}
+			return EncryptSecret(plain)
+		})
+		if err == nil {
+			err = store.Store.Put(v.Key, reEncrypted)
+		}
+		if err != nil {
+			CBLog.Error(err)
+			result.Failed = append(result.Failed, v.Key)
+			continue
+		}
+		result.ReEncrypted++
+	}
+	return result, nil
+}
@@ -119,6 +119,112 @@ func GenMcisPolicyKey(nsId string, mcisId string, vmId string) string {
 	}
 }
 
+// GenScriptKey is func to generate a key for a command script object (with version or run record if given)
+func GenScriptKey(nsId string, scriptId string, childType string, childId string) string {
+	if childType != "" && childId != "" {
+		return "/ns/" + nsId + "/script/" + scriptId + "/" + childType + "/" + childId
+	} else if childType != "" {
+		return "/ns/" + nsId + "/script/" + scriptId + "/" + childType
+	} else if scriptId != "" {
+		return "/ns/" + nsId + "/script/" + scriptId
+	} else {
+		return "/ns/" + nsId + "/script"
+	}
+}
+
+// GenMcisPolicyHistoryKey is func to generate a key for a history record of MCIS policy
+func GenMcisPolicyHistoryKey(nsId string, mcisId string, recordId string) string {
+	if recordId != "" {
+		return "/ns/" + nsId + "/policyHistory/mcis/" + mcisId + "/" + recordId
+	} else {
+		return "/ns/" + nsId + "/policyHistory/mcis/" + mcisId + "/"
+	}
+}
+
+// GenMcisHealthPolicyKey is func to generate a key for the health policy of MCIS (all health policies in the namespace if mcisId is empty)
+func GenMcisHealthPolicyKey(nsId string, mcisId string) string {
+	if mcisId != "" {
+		return "/ns/" + nsId + "/healthPolicy/mcis/" + mcisId
+	} else {
+		return "/ns/" + nsId + "/healthPolicy/mcis/"
+	}
+}
+
+// GenMcisHealthLogKey is func to generate a key for a remediation log of MCIS health policy
+func GenMcisHealthLogKey(nsId string, mcisId string, logId string) string {
+	if logId != "" {
+		return "/ns/" + nsId + "/healthLog/mcis/" + mcisId + "/" + logId
+	} else {
+		return "/ns/" + nsId + "/healthLog/mcis/" + mcisId + "/"
+	}
+}
+
+// GenMcisMonSampleKey is func to generate a key for retained monitoring samples of a VM metric
+// (all metrics of the VM if metric is empty, all VMs of MCIS if vmId is empty)
+func GenMcisMonSampleKey(nsId string, mcisId string, vmId string, metric string) string {
+	if vmId == "" {
+		return "/ns/" + nsId + "/monSample/mcis/" + mcisId + "/"
+	} else if metric == "" {
+		return "/ns/" + nsId + "/monSample/mcis/" + mcisId + "/" + vmId + "/"
+	} else {
+		return "/ns/" + nsId + "/monSample/mcis/" + mcisId + "/" + vmId + "/" + metric
+	}
+}
+
+// GenMcisVmCostKey is func to generate a key for the cost record (running intervals) of a VM
+// (all VMs of MCIS if vmId is empty, all MCIS in the namespace if mcisId is empty)
+func GenMcisVmCostKey(nsId string, mcisId string, vmId string) string {
+	if mcisId == "" {
+		return "/ns/" + nsId + "/vmCost/mcis/"
+	} else if vmId == "" {
+		return "/ns/" + nsId + "/vmCost/mcis/" + mcisId + "/"
+	} else {
+		return "/ns/" + nsId + "/vmCost/mcis/" + mcisId + "/" + vmId
+	}
+}
+
+// GenNsBudgetAlertKey is func to generate a key for an alert event of the namespace budget (all alerts if alertId is empty)
+func GenNsBudgetAlertKey(nsId string, alertId string) string {
+	if alertId != "" {
+		return "/ns/" + nsId + "/budgetAlert/" + alertId
+	} else {
+		return "/ns/" + nsId + "/budgetAlert/"
+	}
+}
+
+// GenCatalogChangeKey is func to generate a key for a change of spec and image catalog (all changes if changeId is empty)
+func GenCatalogChangeKey(nsId string, changeId string) string {
+	if changeId != "" {
+		return "/ns/" + nsId + "/catalogChange/" + changeId
+	} else {
+		return "/ns/" + nsId + "/catalogChange/"
+	}
+}
+
+// GenLatencyKey is func to generate a key for the latency between two regions
+// (all latency from the source if target is empty, all latency if source is empty)
+func GenLatencyKey(source string, target string) string {
+	if source == "" {
+		return "/latency/"
+	} else if target == "" {
+		return "/latency/" + source + "/"
+	} else {
+		return "/latency/" + source + "/" + target
+	}
+}
+
+// GenPriceKey is func to generate a key for the price of a spec in a connection
+// (all prices of the connection if cspSpecName is empty, all prices if connectionName is empty)
+func GenPriceKey(connectionName string, cspSpecName string) string {
+	if connectionName == "" {
+		return "/price/"
+	} else if cspSpecName == "" {
+		return "/price/" + connectionName + "/"
+	} else {
+		return "/price/" + connectionName + "/" + cspSpecName + "/"
+	}
+}
+
 // LookupKeyValueList is func to lookup KeyValue list
 func LookupKeyValueList(kvl []KeyValue, key string) string {
 	for _, v := range kvl {
@@ -868,7 +974,8 @@ func GetObjectValue(key string) (string, error) {
 	if keyValue == nil {
 		return "", nil
 	}
-	return keyValue.Value, nil
+	// never expose secrets by the generic object API
+	return RedactSecretFields(keyValue.Key, keyValue.Value), nil
 }
 
 // DeleteObject is func to delete the object
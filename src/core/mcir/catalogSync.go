@@ -0,0 +1,663 @@
+/*
+Copyright 2019 The Cloud-Barista Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package mcir is to manage multi-cloud infra resource
+package mcir
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/cloud-barista/cb-tumblebug/src/core/common"
+)
+
+// Types of catalog change
+const (
+	CatalogChangeAdded    string = "added"
+	CatalogChangeChanged  string = "changed"
+	CatalogChangeRemoved  string = "removed"  // not provided by CSP anymore (marked as deprecated)
+	CatalogChangeRestored string = "restored" // provided by CSP again (deprecation is cleared)
+	CatalogChangeDeleted  string = "deleted"  // removed and not referenced (deleted by purgeUnreferenced)
+)
+
+// catalogSyncDefaultIntervalMin is the default interval of periodic catalog sync
+const catalogSyncDefaultIntervalMin = 1440
+
+// catalogSyncMinIntervalMin is the minimum interval of periodic catalog sync
+const catalogSyncMinIntervalMin = 10
+
+// catalogSyncControllerMutex is to prevent concurrent runs of CatalogSyncController
+var catalogSyncControllerMutex sync.Mutex
+var catalogSyncControllerRunning bool
+
+// vmKeyRegex is to find VM objects in the key-value store
+var vmKeyRegex = regexp.MustCompile(`^/ns/[^/]+/mcis/[^/]+/vm/[^/]+$`)
+
+// TbCatalogSyncPolicy is struct for the periodic sync of spec and image catalog of a namespace with CSPs
+type TbCatalogSyncPolicy struct {
+	Enabled bool `json:"enabled" example:"true"`
+	// IntervalMin is the interval of sync in minutes (1440 if 0, 10 at least)
+	IntervalMin int `json:"intervalMin" example:"1440"`
+	// ConnectionName is the list of connections to sync (all connections if empty)
+	ConnectionName []string `json:"connectionName"`
+	// ResourceType is the list of resource types to sync (spec and image if empty)
+	ResourceType []string `json:"resourceType" example:"spec,image"`
+	// PurgeUnreferenced is to delete removed specs and images not referenced by any object (they are kept as deprecated if false)
+	PurgeUnreferenced bool   `json:"purgeUnreferenced" example:"false"`
+	LastSyncTime      string `json:"lastSyncTime"`
+}
+
+// TbCatalogChange is struct for a change of spec and image catalog detected by catalog sync
+type TbCatalogChange struct {
+	Id              string `json:"id"`
+	NsId            string `json:"nsId"`
+	ConnectionName  string `json:"connectionName" example:"aws-ap-northeast-2"`
+	ResourceType    string `json:"resourceType" enums:"spec,image"`
+	ResourceId      string `json:"resourceId" example:"aws-ap-northeast-2-t2-small"`
+	CspResourceName string `json:"cspResourceName" example:"t2.small"`
+	ChangeType      string `json:"changeType" enums:"added,changed,removed,restored,deleted"`
+	// Detail is changed fields (ex: numvCPU: 1 -> 2) or the reason
+	Detail      string `json:"detail"`
+	CreatedTime string `json:"createdTime"`
+}
+
+// TbCatalogChangeList is struct for the list of catalog changes
+type TbCatalogChangeList struct {
+	Change []TbCatalogChange `json:"change"`
+}
+
+// TbCatalogSyncResult is struct for the result of catalog sync
+type TbCatalogSyncResult struct {
+	NsId        string            `json:"nsId"`
+	AddedNum    int               `json:"addedNum"`
+	ChangedNum  int               `json:"changedNum"`
+	RemovedNum  int               `json:"removedNum"`
+	RestoredNum int               `json:"restoredNum"`
+	DeletedNum  int               `json:"deletedNum"`
+	Failed      []string          `json:"failed"`
+	Change      []TbCatalogChange `json:"change"`
+}
+
+// genCatalogSyncPolicyKey is func to generate the key of the catalog sync policy of a namespace
+func genCatalogSyncPolicyKey(nsId string) string {
+	return "/ns/" + nsId + "/catalogSyncPolicy"
+}
+
+// GetCatalogSyncPolicy is func to get the catalog sync policy of a namespace (disabled if not set)
+func GetCatalogSyncPolicy(nsId string) (TbCatalogSyncPolicy, error) {
+	policy := TbCatalogSyncPolicy{IntervalMin: catalogSyncDefaultIntervalMin, ConnectionName: []string{}, ResourceType: []string{}}
+
+	err := common.CheckString(nsId)
+	if err != nil {
+		common.CBLog.Error(err)
+		return policy, err
+	}
+
+	keyValue, err := common.CBStore.Get(genCatalogSyncPolicyKey(nsId))
+	if err != nil {
+		common.CBLog.Error(err)
+		return policy, err
+	}
+	if keyValue == nil {
+		return policy, nil
+	}
+	err = json.Unmarshal([]byte(keyValue.Value), &policy)
+	if err != nil {
+		common.CBLog.Error(err)
+		return policy, err
+	}
+	return policy, nil
+}
+
+// SetCatalogSyncPolicy is func to set the catalog sync policy of a namespace
+func SetCatalogSyncPolicy(nsId string, policy TbCatalogSyncPolicy) (TbCatalogSyncPolicy, error) {
+
+	err := common.CheckString(nsId)
+	if err != nil {
+		common.CBLog.Error(err)
+		return policy, err
+	}
+	check, err := common.CheckNs(nsId)
+	if !check {
+		return policy, fmt.Errorf("The namespace " + nsId + " does not exist.")
+	}
+	if err != nil {
+		common.CBLog.Error(err)
+		return policy, err
+	}
+
+	if policy.IntervalMin == 0 {
+		policy.IntervalMin = catalogSyncDefaultIntervalMin
+	}
+	if policy.IntervalMin < catalogSyncMinIntervalMin {
+		return policy, fmt.Errorf("The intervalMin of catalog sync should be at least " + strconv.Itoa(catalogSyncMinIntervalMin))
+	}
+	if policy.ConnectionName == nil {
+		policy.ConnectionName = []string{}
+	}
+	if policy.ResourceType == nil {
+		policy.ResourceType = []string{}
+	}
+	for _, v := range policy.ResourceType {
+		if v != common.StrSpec && v != common.StrImage {
+			return policy, fmt.Errorf("The resourceType of catalog sync should be " + common.StrSpec + " or " + common.StrImage)
+		}
+	}
+
+	// keep the last sync time to follow the interval
+	existing, err := GetCatalogSyncPolicy(nsId)
+	if err == nil {
+		policy.LastSyncTime = existing.LastSyncTime
+	}
+
+	val, _ := json.Marshal(policy)
+	err = common.CBStore.Put(genCatalogSyncPolicyKey(nsId), string(val))
+	if err != nil {
+		common.CBLog.Error(err)
+		return policy, err
+	}
+	return policy, nil
+}
+
+// ListCatalogChange is func to list catalog changes of a namespace (latest first)
+// with optional filters of connection, resource type, change type and the start time (RFC3339)
+func ListCatalogChange(nsId string, connectionName string, resourceType string, changeType string, since string) ([]TbCatalogChange, error) {
+	content := []TbCatalogChange{}
+
+	err := common.CheckString(nsId)
+	if err != nil {
+		common.CBLog.Error(err)
+		return content, err
+	}
+	sinceTime := time.Time{}
+	if since != "" {
+		sinceTime, err = time.Parse(time.RFC3339, since)
+		if err != nil {
+			return content, fmt.Errorf("The since (" + since + ") should be RFC3339 format")
+		}
+	}
+
+	keyValue, err := common.CBStore.GetList(common.GenCatalogChangeKey(nsId, ""), false)
+	if err != nil {
+		common.CBLog.Error(err)
+		return content, err
+	}
+	for _, v := range keyValue {
+		change := TbCatalogChange{}
+		err = json.Unmarshal([]byte(v.Value), &change)
+		if err != nil {
+			common.CBLog.Error(err)
+			continue
+		}
+		if connectionName != "" && change.ConnectionName != connectionName {
+			continue
+		}
+		if resourceType != "" && change.ResourceType != resourceType {
+			continue
+		}
+		if changeType != "" && change.ChangeType != changeType {
+			continue
+		}
+		if !sinceTime.IsZero() {
+			createdTime, _ := time.Parse(time.RFC3339, change.CreatedTime)
+			if createdTime.Before(sinceTime) {
+				continue
+			}
+		}
+		content = append(content, change)
+	}
+	return content, nil
+}
+
+// recordCatalogChanges is func to store catalog changes with ids in the order of changes
+func recordCatalogChanges(nsId string, changes []TbCatalogChange) {
+	now := time.Now()
+	for i := range changes {
+		changes[i].Id = fmt.Sprintf("%020d-%06d", now.UnixNano(), i)
+		changes[i].NsId = nsId
+		changes[i].CreatedTime = now.Format(time.RFC3339)
+		val, _ := json.Marshal(changes[i])
+		err := common.CBStore.Put(common.GenCatalogChangeKey(nsId, changes[i].Id), string(val))
+		if err != nil {
+			common.CBLog.Error(err)
+		}
+	}
+}
+
+// listCatalogResourceInUse is func to get specs and images referenced by VMs in all namespaces
+// (VMs may use specs and images in common namespace without associating them)
+func listCatalogResourceInUse() map[string]bool {
+	inUse := map[string]bool{}
+
+	nsList, err := common.ListNsId()
+	if err != nil {
+		common.CBLog.Error(err)
+		return inUse
+	}
+	for _, nsId := range nsList {
+		keyValue, err := common.CBStore.GetList(common.GenMcisKey(nsId, "", "")+"/mcis/", true)
+		if err != nil {
+			common.CBLog.Error(err)
+			continue
+		}
+		for _, v := range keyValue {
+			if !vmKeyRegex.MatchString(v.Key) {
+				continue
+			}
+			vm := struct {
+				SpecId  string `json:"specId"`
+				ImageId string `json:"imageId"`
+			}{}
+			err := json.Unmarshal([]byte(v.Value), &vm)
+			if err != nil {
+				continue
+			}
+			inUse[common.StrSpec+"/"+vm.SpecId] = true
+			inUse[common.StrImage+"/"+vm.ImageId] = true
+		}
+	}
+	return inUse
+}
+
+// setCatalogDeprecated is func to mark (or clear) deprecation of a spec or an image (in both the key-value store and the table)
+func setCatalogDeprecated(nsId string, resourceType string, resourceId string, deprecated bool) error {
+	key := common.GenResourceKey(nsId, resourceType, resourceId)
+	keyValue, err := common.CBStore.Get(key)
+	if err != nil {
+		common.CBLog.Error(err)
+		return err
+	}
+	if keyValue == nil {
+		return fmt.Errorf("The " + resourceType + " " + resourceId + " does not exist.")
+	}
+
+	content := map[string]interface{}{}
+	err = json.Unmarshal([]byte(keyValue.Value), &content)
+	if err != nil {
+		common.CBLog.Error(err)
+		return err
+	}
+	if deprecated {
+		content["deprecated"] = true
+	} else {
+		delete(content, "deprecated")
+	}
+	val, _ := json.Marshal(content)
+	err = common.CBStore.Put(key, string(val))
+	if err != nil {
+		common.CBLog.Error(err)
+		return err
+	}
+
+	var table interface{}
+	if resourceType == common.StrSpec {
+		table = new(TbSpecInfo)
+	} else {
+		table = new(TbImageInfo)
+	}
+	_, err = common.ORM.Table(table).Where("Namespace = ? AND Id = ?", nsId, resourceId).Update(map[string]interface{}{"Deprecated": deprecated})
+	if err != nil {
+		common.CBLog.Error(err)
+		// restore the object not to leave the store and the table inconsistent
+		restoreErr := common.CBStore.Put(key, keyValue.Value)
+		if restoreErr != nil {
+			common.CBLog.Error(restoreErr)
+		}
+		return err
+	}
+	return nil
+}
+
+// removeCatalogResource is func to handle a spec or an image not provided by CSP anymore.
+// It is deleted only if purge is true and it is not referenced by any object, otherwise it is marked as deprecated.
+func removeCatalogResource(nsId string, resourceType string, resourceId string, associated []string, purge bool, inUse map[string]bool) (string, string, error) {
+	if purge && len(associated) == 0 && !inUse[resourceType+"/"+resourceId] {
+		err := DelResource(nsId, resourceType, resourceId, "false")
+		if err == nil {
+			return CatalogChangeDeleted, "not provided by CSP and not referenced", nil
+		}
+		common.CBLog.Error(err)
+	}
+	err := setCatalogDeprecated(nsId, resourceType, resourceId, true)
+	if err != nil {
+		return "", "", err
+	}
+	detail := "not provided by CSP"
+	if len(associated) > 0 || inUse[resourceType+"/"+resourceId] {
+		detail += " (kept since referenced)"
+	}
+	return CatalogChangeRemoved, detail, nil
+}
+
+// syncSpecCatalog is func to sync specs of a connection in the namespace with the CSP and returns changes
+func syncSpecCatalog(nsId string, connConfig string, purge bool, inUse map[string]bool) ([]TbCatalogChange, error) {
+	changes := []TbCatalogChange{}
+
+	spiderSpecList, err := LookupSpecList(connConfig)
+	if err != nil {
+		common.CBLog.Error(err)
+		return changes, err
+	}
+
+	resourceList, err := ListResource(nsId, common.StrSpec)
+	if err != nil {
+		common.CBLog.Error(err)
+		return changes, err
+	}
+	storedList, _ := resourceList.([]TbSpecInfo)
+	stored := map[string]TbSpecInfo{}
+	for _, v := range storedList {
+		if v.ConnectionName == connConfig {
+			stored[v.CspSpecName] = v
+		}
+	}
+	// an empty list from CSP is regarded as a failure to avoid deprecating all specs
+	if len(spiderSpecList.Vmspec) == 0 && len(stored) > 0 {
+		return changes, fmt.Errorf("No spec is provided by " + connConfig + "; skip to keep the catalog")
+	}
+
+	fetched := map[string]bool{}
+	for _, spiderSpec := range spiderSpecList.Vmspec {
+		fetchedSpec, err := ConvertSpiderSpecToTumblebugSpec(spiderSpec)
+		if err != nil {
+			common.CBLog.Error(err)
+			continue
+		}
+		fetched[fetchedSpec.CspSpecName] = true
+		change := TbCatalogChange{ConnectionName: connConfig, ResourceType: common.StrSpec, CspResourceName: fetchedSpec.CspSpecName}
+
+		storedSpec, ok := stored[fetchedSpec.CspSpecName]
+		if !ok {
+			fetchedSpec.Name = connConfig + "-" + RefineSpecName(fetchedSpec.Name)
+			fetchedSpec.ConnectionName = connConfig
+			registered, err := RegisterSpecWithInfo(nsId, &fetchedSpec)
+			if err != nil {
+				common.CBLog.Error(err)
+				continue
+			}
+			change.ResourceId = registered.Id
+			change.ChangeType = CatalogChangeAdded
+			changes = append(changes, change)
+			continue
+		}
+		change.ResourceId = storedSpec.Id
+
+		if storedSpec.Deprecated {
+			err := setCatalogDeprecated(nsId, common.StrSpec, storedSpec.Id, false)
+			if err != nil {
+				continue
+			}
+			change.ChangeType = CatalogChangeRestored
+			changes = append(changes, change)
+		}
+
+		detail := ""
+		fieldsToUpdate := TbSpecInfo{}
+		if fetchedSpec.NumvCPU != 0 && fetchedSpec.NumvCPU != storedSpec.NumvCPU {
+			detail += fmt.Sprintf("numvCPU: %d -> %d; ", storedSpec.NumvCPU, fetchedSpec.NumvCPU)
+			fieldsToUpdate.NumvCPU = fetchedSpec.NumvCPU
+		}
+		if fetchedSpec.MemGiB != 0 && fetchedSpec.MemGiB != storedSpec.MemGiB {
+			detail += fmt.Sprintf("memGiB: %v -> %v; ", storedSpec.MemGiB, fetchedSpec.MemGiB)
+			fieldsToUpdate.MemGiB = fetchedSpec.MemGiB
+		}
+		if detail != "" {
+			_, err := UpdateSpec(nsId, storedSpec.Id, fieldsToUpdate)
+			if err != nil {
+				common.CBLog.Error(err)
+				continue
+			}
+			change.ChangeType = CatalogChangeChanged
+			change.Detail = detail[:len(detail)-2]
+			changes = append(changes, change)
+		}
+	}
+
+	for cspSpecName, storedSpec := range stored {
+		if fetched[cspSpecName] || storedSpec.Deprecated {
+			continue
+		}
+		changeType, detail, err := removeCatalogResource(nsId, common.StrSpec, storedSpec.Id, storedSpec.AssociatedObjectList, purge, inUse)
+		if err != nil {
+			continue
+		}
+		changes = append(changes, TbCatalogChange{ConnectionName: connConfig, ResourceType: common.StrSpec, ResourceId: storedSpec.Id, CspResourceName: cspSpecName, ChangeType: changeType, Detail: detail})
+	}
+	return changes, nil
+}
+
+// syncImageCatalog is func to sync images of a connection in the namespace with the CSP and returns changes
+func syncImageCatalog(nsId string, connConfig string, purge bool, inUse map[string]bool) ([]TbCatalogChange, error) {
+	changes := []TbCatalogChange{}
+
+	spiderImageList, err := LookupImageList(connConfig)
+	if err != nil {
+		common.CBLog.Error(err)
+		return changes, err
+	}
+
+	resourceList, err := ListResource(nsId, common.StrImage)
+	if err != nil {
+		common.CBLog.Error(err)
+		return changes, err
+	}
+	storedList, _ := resourceList.([]TbImageInfo)
+	stored := map[string]TbImageInfo{}
+	for _, v := range storedList {
+		if v.ConnectionName == connConfig {
+			stored[v.CspImageId] = v
+		}
+	}
+	// an empty list from CSP is regarded as a failure to avoid deprecating all images
+	if len(spiderImageList.Image) == 0 && len(stored) > 0 {
+		return changes, fmt.Errorf("No image is provided by " + connConfig + "; skip to keep the catalog")
+	}
+
+	fetched := map[string]bool{}
+	for _, spiderImage := range spiderImageList.Image {
+		fetchedImage, err := ConvertSpiderImageToTumblebugImage(spiderImage)
+		if err != nil {
+			common.CBLog.Error(err)
+			continue
+		}
+		fetched[fetchedImage.CspImageId] = true
+		change := TbCatalogChange{ConnectionName: connConfig, ResourceType: common.StrImage, CspResourceName: fetchedImage.CspImageId}
+
+		storedImage, ok := stored[fetchedImage.CspImageId]
+		if !ok {
+			fetchedImage.Name = connConfig + "-" + RefineImageName(fetchedImage.Name)
+			fetchedImage.ConnectionName = connConfig
+			registered, err := RegisterImageWithInfo(nsId, &fetchedImage)
+			if err != nil {
+				common.CBLog.Error(err)
+				continue
+			}
+			change.ResourceId = registered.Id
+			change.ChangeType = CatalogChangeAdded
+			changes = append(changes, change)
+			continue
+		}
+		change.ResourceId = storedImage.Id
+
+		if storedImage.Deprecated {
+			err := setCatalogDeprecated(nsId, common.StrImage, storedImage.Id, false)
+			if err != nil {
+				continue
+			}
+			change.ChangeType = CatalogChangeRestored
+			changes = append(changes, change)
+		}
+
+		detail := ""
+		fieldsToUpdate := TbImageInfo{}
+		if fetchedImage.CspImageName != "" && fetchedImage.CspImageName != storedImage.CspImageName {
+			detail += "cspImageName: " + storedImage.CspImageName + " -> " + fetchedImage.CspImageName + "; "
+			fieldsToUpdate.CspImageName = fetchedImage.CspImageName
+		}
+		if fetchedImage.Status != "" && fetchedImage.Status != storedImage.Status {
+			detail += "status: " + storedImage.Status + " -> " + fetchedImage.Status + "; "
+			fieldsToUpdate.Status = fetchedImage.Status
+		}
+		if detail != "" {
+			_, err := UpdateImage(nsId, storedImage.Id, fieldsToUpdate)
+			if err != nil {
+				common.CBLog.Error(err)
+				continue
+			}
+			change.ChangeType = CatalogChangeChanged
+			change.Detail = detail[:len(detail)-2]
+			changes = append(changes, change)
+		}
+	}
+
+	for cspImageId, storedImage := range stored {
+		if fetched[cspImageId] || storedImage.Deprecated {
+			continue
+		}
+		changeType, detail, err := removeCatalogResource(nsId, common.StrImage, storedImage.Id, storedImage.AssociatedObjectList, purge, inUse)
+		if err != nil {
+			continue
+		}
+		changes = append(changes, TbCatalogChange{ConnectionName: connConfig, ResourceType: common.StrImage, ResourceId: storedImage.Id, CspResourceName: cspImageId, ChangeType: changeType, Detail: detail})
+	}
+	return changes, nil
+}
+
+// SyncCatalog is func to sync specs and images of the namespace with CSPs.
+// Added ones are registered, changed ones are updated and removed ones are marked as deprecated
+// (or deleted if purge is true and they are not referenced). Changes are recorded to the catalog changelog.
+func SyncCatalog(nsId string, connectionName []string, resourceType []string, purge bool) (TbCatalogSyncResult, error) {
+	result := TbCatalogSyncResult{NsId: nsId, Failed: []string{}, Change: []TbCatalogChange{}}
+
+	err := common.CheckString(nsId)
+	if err != nil {
+		common.CBLog.Error(err)
+		return result, err
+	}
+
+	if len(connectionName) == 0 {
+		connConfigs, err := common.GetConnConfigList()
+		if err != nil {
+			common.CBLog.Error(err)
+			return result, err
+		}
+		for _, v := range connConfigs.Connectionconfig {
+			connectionName = append(connectionName, v.ConfigName)
+		}
+	}
+	syncSpec, syncImage := len(resourceType) == 0, len(resourceType) == 0
+	for _, v := range resourceType {
+		switch v {
+		case common.StrSpec:
+			syncSpec = true
+		case common.StrImage:
+			syncImage = true
+		default:
+			return result, fmt.Errorf("The resourceType of catalog sync should be " + common.StrSpec + " or " + common.StrImage)
+		}
+	}
+
+	inUse := listCatalogResourceInUse()
+	for _, connConfig := range connectionName {
+		fmt.Println("[Catalog Sync] NS[" + nsId + "] " + connConfig)
+		if syncSpec {
+			changes, err := syncSpecCatalog(nsId, connConfig, purge, inUse)
+			if err != nil {
+				result.Failed = append(result.Failed, connConfig+" ("+common.StrSpec+"): "+err.Error())
+			}
+			result.Change = append(result.Change, changes...)
+		}
+		if syncImage {
+			changes, err := syncImageCatalog(nsId, connConfig, purge, inUse)
+			if err != nil {
+				result.Failed = append(result.Failed, connConfig+" ("+common.StrImage+"): "+err.Error())
+			}
+			result.Change = append(result.Change, changes...)
+		}
+	}
+
+	recordCatalogChanges(nsId, result.Change)
+	for _, v := range result.Change {
+		switch v.ChangeType {
+		case CatalogChangeAdded:
+			result.AddedNum++
+		case CatalogChangeChanged:
+			result.ChangedNum++
+		case CatalogChangeRemoved:
+			result.RemovedNum++
+		case CatalogChangeRestored:
+			result.RestoredNum++
+		case CatalogChangeDeleted:
+			result.DeletedNum++
+		}
+	}
+	return result, nil
+}
+
+// CatalogSyncController is func to sync catalogs of namespaces with enabled catalog sync policies.
+// CatalogSyncController will be periodically invoked by a time.NewTicker in main.go.
+func CatalogSyncController() {
+	catalogSyncControllerMutex.Lock()
+	if catalogSyncControllerRunning {
+		catalogSyncControllerMutex.Unlock()
+		return
+	}
+	catalogSyncControllerRunning = true
+	catalogSyncControllerMutex.Unlock()
+
+	defer func() {
+		catalogSyncControllerMutex.Lock()
+		catalogSyncControllerRunning = false
+		catalogSyncControllerMutex.Unlock()
+	}()
+
+	nsList, err := common.ListNsId()
+	if err != nil {
+		common.CBLog.Error(err)
+		return
+	}
+
+	for _, nsId := range nsList {
+		policy, err := GetCatalogSyncPolicy(nsId)
+		if err != nil || !policy.Enabled {
+			continue
+		}
+		lastSyncTime, _ := time.Parse(time.RFC3339, policy.LastSyncTime)
+		if time.Since(lastSyncTime) < time.Duration(policy.IntervalMin)*time.Minute {
+			continue
+		}
+
+		result, err := SyncCatalog(nsId, policy.ConnectionName, policy.ResourceType, policy.PurgeUnreferenced)
+		if err != nil {
+			common.CBLog.Error(err)
+		}
+		fmt.Printf("[Catalog Sync] NS[%s] added: %d, changed: %d, removed: %d, restored: %d, deleted: %d, failed: %d\n",
+			nsId, result.AddedNum, result.ChangedNum, result.RemovedNum, result.RestoredNum, result.DeletedNum, len(result.Failed))
+
+		// the policy may have been updated during sync
+		current, err := GetCatalogSyncPolicy(nsId)
+		if err != nil {
+			continue
+		}
+		current.LastSyncTime = time.Now().Format(time.RFC3339)
+		val, _ := json.Marshal(current)
+		err = common.CBStore.Put(genCatalogSyncPolicyKey(nsId), string(val))
+		if err != nil {
+			common.CBLog.Error(err)
+		}
+	}
+}
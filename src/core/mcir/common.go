@@ -1490,6 +1490,16 @@ func GetResource(nsId string, resourceType string, resourceId string) (interface
 	return nil, err
 }
 
+// GetResourceWithCommon returns the requested TB MCIR object in the namespace, or in common namespace if not found
+// (VMs of dynamic MCIS use specs and images in common namespace)
+func GetResourceWithCommon(nsId string, resourceType string, resourceId string) (interface{}, error) {
+	result, err := GetResource(nsId, resourceType, resourceId)
+	if err != nil {
+		return GetResource("common", resourceType, resourceId)
+	}
+	return result, nil
+}
+
 // CheckResource returns the existence of the TB MCIR resource in bool form.
 func CheckResource(nsId string, resourceType string, resourceId string) (bool, error) {
 
@@ -83,6 +83,17 @@ type TbImageInfo struct {
 	KeyValueList         []common.KeyValue `json:"keyValueList,omitempty"`
 	AssociatedObjectList []string          `json:"associatedObjectList,omitempty"`
 	IsAutoGenerated      bool              `json:"isAutoGenerated,omitempty"`
+
+	// OS information parsed from GuestOS, names and KeyValueList (searchable)
+	OsFamily           string `json:"osFamily,omitempty" example:"linux"`
+	OsDistribution     string `json:"osDistribution,omitempty" example:"ubuntu"`
+	OsVersion          string `json:"osVersion,omitempty" example:"22.04"`
+	OsArchitecture     string `json:"osArchitecture,omitempty" example:"x86_64" enums:"x86_64,arm64,x86"`
+	VirtualizationType string `json:"virtualizationType,omitempty" example:"hvm"`
+	BootMode           string `json:"bootMode,omitempty" example:"uefi" enums:"bios,uefi"`
+
+	// Deprecated is true if the image is not provided by CSP anymore (marked by catalog sync)
+	Deprecated bool `json:"deprecated,omitempty"`
 }
 
 // ConvertSpiderImageToTumblebugImage accepts an Spider image object, converts to and returns an TB image object
@@ -110,6 +121,7 @@ func ConvertSpiderImageToTumblebugImage(spiderImage SpiderImageInfo) (TbImageInf
 	tumblebugImage.GuestOS = spiderImage.GuestOS
 	tumblebugImage.Status = spiderImage.Status
 	tumblebugImage.KeyValueList = spiderImage.KeyValueList
+	EnrichImageOsInfo(&tumblebugImage)
 
 	return tumblebugImage, nil
 }
@@ -255,6 +267,7 @@ func RegisterImageWithInfo(nsId string, content *TbImageInfo) (TbImageInfo, erro
 	//content.Id = common.GenUid()
 	content.Id = content.Name
 	content.AssociatedObjectList = []string{}
+	EnrichImageOsInfo(content)
 
 	fmt.Println("=========================== PUT registerImage")
 	Key := common.GenResourceKey(nsId, resourceType, content.Id)
@@ -620,6 +633,18 @@ func UpdateImage(nsId string, imageId string, fieldsToUpdate TbImageInfo) (TbIma
 	toBeImageJSON, _ := json.Marshal(fieldsToUpdate)
 	err = json.Unmarshal(toBeImageJSON, &toBeImage)
 
+	// parse OS information again if the sources of OS information are updated
+	if fieldsToUpdate.GuestOS != "" || fieldsToUpdate.CspImageName != "" || len(fieldsToUpdate.KeyValueList) > 0 {
+		updatedOsInfo := imageOsInfoOf(fieldsToUpdate)
+		toBeImage.OsFamily = updatedOsInfo.OsFamily
+		toBeImage.OsDistribution = updatedOsInfo.OsDistribution
+		toBeImage.OsVersion = updatedOsInfo.OsVersion
+		toBeImage.OsArchitecture = updatedOsInfo.OsArchitecture
+		toBeImage.VirtualizationType = updatedOsInfo.VirtualizationType
+		toBeImage.BootMode = updatedOsInfo.BootMode
+	}
+	EnrichImageOsInfo(&toBeImage)
+
 	// cb-store
 	fmt.Println("=========================== PUT UpdateImage")
 	Key := common.GenResourceKey(nsId, resourceType, toBeImage.Id)
@@ -642,7 +667,8 @@ func UpdateImage(nsId string, imageId string, fieldsToUpdate TbImageInfo) (TbIma
 	fmt.Println("===========================")
 
 	// "UPDATE `image` SET `id`='" + imageId + "', ... WHERE `namespace`='" + nsId + "' AND `id`='" + imageId + "';"
-	_, err = common.ORM.Update(&toBeImage, &TbImageInfo{Namespace: nsId, Id: imageId})
+	// OS information columns are updated even if empty since they can be cleared by parsing again
+	_, err = common.ORM.MustCols("OsFamily", "OsDistribution", "OsVersion", "OsArchitecture", "VirtualizationType", "BootMode").Update(&toBeImage, &TbImageInfo{Namespace: nsId, Id: imageId})
 	if err != nil {
 		fmt.Println(err.Error())
 	} else {
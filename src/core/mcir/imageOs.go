@@ -0,0 +1,546 @@
+/*
+Copyright 2019 The Cloud-Barista Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package mcir is to manage multi-cloud infra resource
+package mcir
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/cloud-barista/cb-tumblebug/src/core/common"
+)
+
+// OS families of image
+const (
+	OsFamilyLinux   string = "linux"
+	OsFamilyWindows string = "windows"
+	OsFamilyBsd     string = "bsd"
+)
+
+// Architectures of image
+const (
+	OsArchX86_64 string = "x86_64"
+	OsArchArm64  string = "arm64"
+	OsArchX86    string = "x86"
+)
+
+// Boot modes of image
+const (
+	BootModeBios string = "bios"
+	BootModeUefi string = "uefi"
+)
+
+// TbImageOsInfo is struct for OS information parsed from image names and key-value lists
+type TbImageOsInfo struct {
+	OsFamily           string `json:"osFamily,omitempty" example:"linux"`
+	OsDistribution     string `json:"osDistribution,omitempty" example:"ubuntu"`
+	OsVersion          string `json:"osVersion,omitempty" example:"22.04"`
+	OsArchitecture     string `json:"osArchitecture,omitempty" example:"x86_64" enums:"x86_64,arm64,x86"`
+	VirtualizationType string `json:"virtualizationType,omitempty" example:"hvm"`
+	BootMode           string `json:"bootMode,omitempty" example:"uefi" enums:"bios,uefi"`
+}
+
+// osDistributionKeyword is a keyword to detect an OS distribution (version is implied by some keywords)
+type osDistributionKeyword struct {
+	keyword      string
+	distribution string
+	family       string
+	version      string
+}
+
+// osDistributionKeywords is ordered from specific keywords to generic ones
+var osDistributionKeywords = []osDistributionKeyword{
+	{"al2023", "amazonlinux", OsFamilyLinux, "2023"},
+	{"amzn2", "amazonlinux", OsFamilyLinux, "2"},
+	{"amazon linux", "amazonlinux", OsFamilyLinux, ""},
+	{"amazonlinux", "amazonlinux", OsFamilyLinux, ""},
+	{"amzn", "amazonlinux", OsFamilyLinux, ""},
+	{"ubuntu", "ubuntu", OsFamilyLinux, ""},
+	{"debian", "debian", OsFamilyLinux, ""},
+	{"centos", "centos", OsFamilyLinux, ""},
+	{"rocky", "rocky", OsFamilyLinux, ""},
+	{"almalinux", "almalinux", OsFamilyLinux, ""},
+	{"red hat", "rhel", OsFamilyLinux, ""},
+	{"redhat", "rhel", OsFamilyLinux, ""},
+	{"rhel", "rhel", OsFamilyLinux, ""},
+	{"sles", "suse", OsFamilyLinux, ""},
+	{"suse", "suse", OsFamilyLinux, ""},
+	{"fedora", "fedora", OsFamilyLinux, ""},
+	{"oracle linux", "oraclelinux", OsFamilyLinux, ""},
+	{"oraclelinux", "oraclelinux", OsFamilyLinux, ""},
+	{"cirros", "cirros", OsFamilyLinux, ""},
+	{"windows", "windows", OsFamilyWindows, ""},
+	{"freebsd", "freebsd", OsFamilyBsd, ""},
+}
+
+// osCodename is a codename of a distribution version
+type osCodename struct {
+	codename string
+	version  string
+}
+
+// osCodenames is to get versions from codenames in image names (ex: ubuntu-jammy)
+// Codenames are checked in order (newest first) to get the same version for the same name
+var osCodenames = map[string][]osCodename{
+	"ubuntu": {{"noble", "24.04"}, {"jammy", "22.04"}, {"focal", "20.04"}, {"bionic", "18.04"}, {"xenial", "16.04"}},
+	"debian": {{"bookworm", "12"}, {"bullseye", "11"}, {"buster", "10"}, {"stretch", "9"}},
+}
+
+// osVersionRegex finds a version (ex: 22.04, 22_04, 7, 2019) not being a part of a longer number (ex: 20230115)
+var osVersionRegex = regexp.MustCompile(`(^|[^0-9])([0-9]{1,4}(?:[._][0-9]{1,2}){0,2})([^0-9]|$)`)
+
+// ubuntuCompactVersionRegex is for ubuntu versions without separator (ex: ubuntu-2204-lts)
+var ubuntuCompactVersionRegex = regexp.MustCompile(`^(1[4-9]|2[0-9])(04|10)$`)
+
+// osVersionWindow is the number of characters after a distribution keyword to find its version
+const osVersionWindow = 40
+
+// imageOsKeys is keys of image key-value lists (CSP specific) containing OS information
+var imageOsKeys = []string{"Name", "Description", "ImageLocation", "PlatformDetails", "Platform", "Publisher", "Offer", "Sku", "Family", "OsType", "OSType"}
+
+// lookupKeyValueIgnoreCase is func to get the value of a key in a key-value list without case sensitivity
+func lookupKeyValueIgnoreCase(kvs []common.KeyValue, key string) string {
+	for _, v := range kvs {
+		if strings.EqualFold(v.Key, key) {
+			return v.Value
+		}
+	}
+	return ""
+}
+
+// findOsVersion is func to find the version of the distribution in text after the keyword index
+func findOsVersion(text string, distribution string, from int) string {
+	window := text[from:]
+	if len(window) > osVersionWindow {
+		window = window[:osVersionWindow]
+	}
+	for _, c := range osCodenames[distribution] {
+		if strings.Contains(window, c.codename) {
+			return c.version
+		}
+	}
+	found := osVersionRegex.FindStringSubmatch(window)
+	if found == nil {
+		return ""
+	}
+	version := strings.ReplaceAll(found[2], "_", ".")
+	if distribution == "ubuntu" {
+		if compact := ubuntuCompactVersionRegex.FindStringSubmatch(version); compact != nil {
+			version = compact[1] + "." + compact[2]
+		}
+	}
+	return version
+}
+
+// normalizeOsArchitecture is func to get an architecture of image from text (empty if unknown)
+func normalizeOsArchitecture(text string) string {
+	text = strings.ToLower(text)
+	switch {
+	case strings.Contains(text, "arm64") || strings.Contains(text, "aarch64"):
+		return OsArchArm64
+	case strings.Contains(text, "x86_64") || strings.Contains(text, "x86-64") || strings.Contains(text, "amd64") || strings.Contains(text, "x64"):
+		return OsArchX86_64
+	case strings.Contains(text, "i386") || strings.Contains(text, "i686") || text == "x86":
+		return OsArchX86
+	}
+	return ""
+}
+
+// ParseImageOsInfo is func to parse OS information (family, distribution, version, architecture, boot type)
+// from GuestOS, names, description and the key-value list of an image
+func ParseImageOsInfo(image TbImageInfo) TbImageOsInfo {
+	result := TbImageOsInfo{}
+
+	sources := []string{image.GuestOS, image.CspImageName, image.Name, image.Description}
+	for _, key := range imageOsKeys {
+		sources = append(sources, lookupKeyValueIgnoreCase(image.KeyValueList, key))
+	}
+
+	// distribution and version
+	for _, source := range sources {
+		text := strings.ToLower(source)
+		if text == "" {
+			continue
+		}
+		for _, k := range osDistributionKeywords {
+			if result.OsDistribution != "" && k.distribution != result.OsDistribution {
+				continue
+			}
+			index := strings.Index(text, k.keyword)
+			if index < 0 {
+				continue
+			}
+			if result.OsDistribution == "" {
+				result.OsDistribution = k.distribution
+				result.OsFamily = k.family
+			}
+			if result.OsVersion == "" {
+				result.OsVersion = k.version
+			}
+			if result.OsVersion == "" {
+				result.OsVersion = findOsVersion(text, k.distribution, index+len(k.keyword))
+			}
+			break
+		}
+		if result.OsDistribution != "" && result.OsVersion != "" {
+			break
+		}
+	}
+	if result.OsFamily == "" {
+		for _, source := range sources {
+			text := strings.ToLower(source)
+			if strings.Contains(text, "linux") {
+				result.OsFamily = OsFamilyLinux
+				break
+			}
+		}
+	}
+
+	// architecture (the key-value list of CSP is more reliable than names)
+	result.OsArchitecture = normalizeOsArchitecture(lookupKeyValueIgnoreCase(image.KeyValueList, "Architecture"))
+	if result.OsArchitecture == "" {
+		for _, source := range sources {
+			result.OsArchitecture = normalizeOsArchitecture(source)
+			if result.OsArchitecture != "" {
+				break
+			}
+		}
+	}
+
+	// virtualization type and boot mode
+	result.VirtualizationType = strings.ToLower(lookupKeyValueIgnoreCase(image.KeyValueList, "VirtualizationType"))
+	bootMode := strings.ToLower(lookupKeyValueIgnoreCase(image.KeyValueList, "BootMode"))
+	hyperVGeneration := strings.ToLower(lookupKeyValueIgnoreCase(image.KeyValueList, "HyperVGeneration"))
+	switch {
+	case strings.Contains(bootMode, "uefi") || hyperVGeneration == "v2":
+		result.BootMode = BootModeUefi
+	case strings.Contains(bootMode, "bios") || hyperVGeneration == "v1":
+		result.BootMode = BootModeBios
+	}
+	for _, source := range sources {
+		text := strings.ToLower(source)
+		if result.VirtualizationType == "" {
+			if strings.Contains(text, "paravirtual") {
+				result.VirtualizationType = "paravirtual"
+			} else if strings.Contains(text, "hvm") {
+				result.VirtualizationType = "hvm"
+			}
+		}
+		if result.BootMode == "" && strings.Contains(text, "uefi") {
+			result.BootMode = BootModeUefi
+		}
+	}
+
+	return result
+}
+
+// ParseImageOsQuery is func to parse a query for images (ex: "ubuntu 22.04 arm64") to OS information
+func ParseImageOsQuery(query string) TbImageOsInfo {
+	return ParseImageOsInfo(TbImageInfo{GuestOS: query})
+}
+
+// AddImageOsQuery is func to fill empty OS conditions by an OS query (ex: ubuntu 22.04 arm64)
+func AddImageOsQuery(cond TbImageOsInfo, query string) TbImageOsInfo {
+	if query == "" {
+		return cond
+	}
+	parsed := ParseImageOsQuery(query)
+	if cond.OsFamily == "" && parsed.OsDistribution == "" {
+		cond.OsFamily = parsed.OsFamily
+	}
+	if cond.OsDistribution == "" {
+		cond.OsDistribution = parsed.OsDistribution
+	}
+	if cond.OsVersion == "" {
+		cond.OsVersion = parsed.OsVersion
+	}
+	if cond.OsArchitecture == "" {
+		cond.OsArchitecture = parsed.OsArchitecture
+	}
+	return cond
+}
+
+// EnrichImageOsInfo is func to fill empty OS information fields of an image by parsing the image
+func EnrichImageOsInfo(image *TbImageInfo) {
+	parsed := ParseImageOsInfo(*image)
+	if image.OsFamily == "" {
+		image.OsFamily = parsed.OsFamily
+	}
+	if image.OsDistribution == "" {
+		image.OsDistribution = parsed.OsDistribution
+	}
+	if image.OsVersion == "" {
+		image.OsVersion = parsed.OsVersion
+	}
+	if image.OsArchitecture == "" {
+		image.OsArchitecture = parsed.OsArchitecture
+	}
+	if image.VirtualizationType == "" {
+		image.VirtualizationType = parsed.VirtualizationType
+	}
+	if image.BootMode == "" {
+		image.BootMode = parsed.BootMode
+	}
+}
+
+// compareOsVersion is func to compare versions by numeric parts (returns 1 if a > b, -1 if a < b)
+func compareOsVersion(a string, b string) int {
+	aParts := strings.Split(a, ".")
+	bParts := strings.Split(b, ".")
+	for i := 0; i < len(aParts) || i < len(bParts); i++ {
+		aNum, bNum := -1, -1
+		if i < len(aParts) {
+			aNum, _ = strconv.Atoi(aParts[i])
+		}
+		if i < len(bParts) {
+			bNum, _ = strconv.Atoi(bParts[i])
+		}
+		if aNum > bNum {
+			return 1
+		} else if aNum < bNum {
+			return -1
+		}
+	}
+	return 0
+}
+
+// SearchImageByOs accepts OS conditions and keywords, and returns the list of matched TB image objects
+// (the version condition matches the version and its minor versions, ex: 22 matches 22.04)
+func SearchImageByOs(nsId string, connectionName string, cond TbImageOsInfo, keywords ...string) ([]TbImageInfo, error) {
+
+	err := common.CheckString(nsId)
+	if err != nil {
+		common.CBLog.Error(err)
+		return nil, err
+	}
+
+	tempList := []TbImageInfo{}
+
+	sqlQuery := common.ORM.Where("Namespace = ?", nsId)
+	if connectionName != "" {
+		sqlQuery = sqlQuery.And("ConnectionName = ?", connectionName)
+	}
+	if cond.OsFamily != "" {
+		sqlQuery = sqlQuery.And("OsFamily = ?", strings.ToLower(cond.OsFamily))
+	}
+	if cond.OsDistribution != "" {
+		sqlQuery = sqlQuery.And("OsDistribution = ?", strings.ToLower(cond.OsDistribution))
+	}
+	if cond.OsVersion != "" {
+		sqlQuery = sqlQuery.And("(OsVersion = ? OR OsVersion LIKE ?)", cond.OsVersion, cond.OsVersion+".%")
+	}
+	if cond.OsArchitecture != "" {
+		arch := normalizeOsArchitecture(cond.OsArchitecture)
+		if arch == "" {
+			arch = strings.ToLower(cond.OsArchitecture)
+		}
+		sqlQuery = sqlQuery.And("OsArchitecture = ?", arch)
+	}
+	if cond.VirtualizationType != "" {
+		sqlQuery = sqlQuery.And("VirtualizationType = ?", strings.ToLower(cond.VirtualizationType))
+	}
+	if cond.BootMode != "" {
+		sqlQuery = sqlQuery.And("BootMode = ?", strings.ToLower(cond.BootMode))
+	}
+	for _, keyword := range keywords {
+		keyword = RefineImageName(keyword)
+		sqlQuery = sqlQuery.And("Name LIKE ?", "%"+keyword+"%")
+	}
+
+	err = sqlQuery.Find(&tempList)
+	if err != nil {
+		common.CBLog.Error(err)
+		return tempList, err
+	}
+	return tempList, nil
+}
+
+// FindImageByOsQuery is func to find the best image in the connection for a query (ex: "ubuntu 22.04 arm64").
+// x86_64 images are preferred if the query has no architecture, and the latest version is preferred if the version is partial.
+func FindImageByOsQuery(nsId string, connectionName string, query string) (TbImageInfo, error) {
+
+	cond := ParseImageOsQuery(query)
+	if cond.OsDistribution == "" && cond.OsFamily == "" {
+		return TbImageInfo{}, fmt.Errorf("Cannot recognize the OS of the image query (" + query + ")")
+	}
+	if cond.OsDistribution != "" {
+		// family is implied by the distribution
+		cond.OsFamily = ""
+	}
+	// boot type is not a requirement in a query
+	cond.VirtualizationType = ""
+	cond.BootMode = ""
+
+	imageList, err := SearchImageByOs(nsId, connectionName, cond)
+	if err != nil {
+		return TbImageInfo{}, err
+	}
+
+	candidates := sortImagesByOsQuery(imageList, cond)
+	if len(candidates) == 0 {
+		return TbImageInfo{}, fmt.Errorf("No image in " + connectionName + " matches the image query (" + query + ")")
+	}
+	return candidates[0], nil
+}
+
+// sortImagesByOsQuery is func to get candidate images for OS conditions of a query in order of preference
+// (the exact version, later versions, x86_64 and later creation date first)
+func sortImagesByOsQuery(imageList []TbImageInfo, cond TbImageOsInfo) []TbImageInfo {
+	candidates := []TbImageInfo{}
+	for _, v := range imageList {
+		// images retired by CSP are not selected
+		if v.Deprecated {
+			continue
+		}
+		if cond.OsArchitecture == "" && v.OsArchitecture != "" && v.OsArchitecture != OsArchX86_64 {
+			continue
+		}
+		candidates = append(candidates, v)
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+		if (a.OsVersion == cond.OsVersion) != (b.OsVersion == cond.OsVersion) {
+			return a.OsVersion == cond.OsVersion
+		}
+		if c := compareOsVersion(a.OsVersion, b.OsVersion); c != 0 {
+			return c > 0
+		}
+		if (a.OsArchitecture == OsArchX86_64) != (b.OsArchitecture == OsArchX86_64) {
+			return a.OsArchitecture == OsArchX86_64
+		}
+		if a.CreationDate != b.CreationDate {
+			return a.CreationDate > b.CreationDate
+		}
+		return a.Id < b.Id
+	})
+	return candidates
+}
+
+// GetCommonImageForConnection is func to get an image for the connection in common namespace
+// by the common image name (ex: ubuntu18.04) or by an OS query (ex: "ubuntu 22.04 arm64")
+func GetCommonImageForConnection(connectionName string, commonImage string) (TbImageInfo, error) {
+	commonNS := "common"
+
+	imageId := ToNamingRuleCompatible(connectionName + "-" + commonImage)
+	if common.CheckString(imageId) == nil {
+		tempInterface, err := GetResource(commonNS, common.StrImage, imageId)
+		if err == nil {
+			imageInfo := TbImageInfo{}
+			err = common.CopySrcToDest(&tempInterface, &imageInfo)
+			if err == nil {
+				return imageInfo, nil
+			}
+		}
+	}
+
+	return FindImageByOsQuery(commonNS, connectionName, commonImage)
+}
+
+// UpdateImageOsInfo is func to parse OS information of all images in the namespace again and update them
+func UpdateImageOsInfo(nsId string) (common.IdList, error) {
+	result := common.IdList{}
+
+	err := common.CheckString(nsId)
+	if err != nil {
+		common.CBLog.Error(err)
+		return result, err
+	}
+
+	resourceList, err := ListResource(nsId, common.StrImage)
+	if err != nil {
+		common.CBLog.Error(err)
+		return result, err
+	}
+	imageList, ok := resourceList.([]TbImageInfo)
+	if !ok {
+		return result, fmt.Errorf("Failed to list images in " + nsId)
+	}
+
+	for _, v := range imageList {
+		parsed := ParseImageOsInfo(v)
+		if parsed == imageOsInfoOf(v) {
+			continue
+		}
+		_, err := UpdateImage(nsId, v.Id, TbImageInfo{
+			OsFamily:           parsed.OsFamily,
+			OsDistribution:     parsed.OsDistribution,
+			OsVersion:          parsed.OsVersion,
+			OsArchitecture:     parsed.OsArchitecture,
+			VirtualizationType: parsed.VirtualizationType,
+			BootMode:           parsed.BootMode,
+		})
+		if err != nil {
+			common.CBLog.Error(err)
+			continue
+		}
+		result.IdList = append(result.IdList, v.Id)
+	}
+	return result, nil
+}
+
+// imageOsInfoOf is func to get OS information fields of an image
+func imageOsInfoOf(image TbImageInfo) TbImageOsInfo {
+	return TbImageOsInfo{
+		OsFamily:           image.OsFamily,
+		OsDistribution:     image.OsDistribution,
+		OsVersion:          image.OsVersion,
+		OsArchitecture:     image.OsArchitecture,
+		VirtualizationType: image.VirtualizationType,
+		BootMode:           image.BootMode,
+	}
+}
+
+// specArchPatterns is to infer architectures of specs from CSP spec names
+// (aws: t4g.small, a1.large / azure: Standard_D2ps_v5 / gcp: t2a-standard-1 / alibaba: ecs.g8y.large)
+var (
+	awsSpecRegex     = regexp.MustCompile(`^([a-z]+)([0-9]+)([a-z\-]*)\.[a-z0-9]+$`)
+	azureSpecRegex   = regexp.MustCompile(`^standard_[a-z]+[0-9]+([a-z]*)(_v[0-9]+)?$`)
+	gcpSpecRegex     = regexp.MustCompile(`^([a-z][0-9][a-z]?)-[a-z]+`)
+	alibabaSpecRegex = regexp.MustCompile(`^ecs\.[a-z]+[0-9]+([a-z\-]*)\.[a-z0-9]+$`)
+)
+
+// GetSpecArchitecture is func to infer the architecture of a spec from the CSP spec name (empty if unknown)
+func GetSpecArchitecture(spec TbSpecInfo) string {
+	name := strings.ToLower(spec.CspSpecName)
+
+	if found := alibabaSpecRegex.FindStringSubmatch(name); found != nil {
+		if strings.ContainsAny(found[1], "ry") {
+			return OsArchArm64
+		}
+		return OsArchX86_64
+	}
+	if found := azureSpecRegex.FindStringSubmatch(name); found != nil {
+		if strings.Contains(found[1], "p") {
+			return OsArchArm64
+		}
+		return OsArchX86_64
+	}
+	if found := awsSpecRegex.FindStringSubmatch(name); found != nil {
+		if found[1]+found[2] == "a1" || strings.Contains(found[3], "g") {
+			return OsArchArm64
+		}
+		return OsArchX86_64
+	}
+	if found := gcpSpecRegex.FindStringSubmatch(name); found != nil {
+		if found[1] == "t2a" || found[1] == "c4a" {
+			return OsArchArm64
+		}
+		return OsArchX86_64
+	}
+	return ""
+}
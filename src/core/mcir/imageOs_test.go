@@ -0,0 +1,170 @@
+/*
+Copyright 2019 The Cloud-Barista Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package mcir is to manage multi-cloud infra resource
+package mcir
+
+import (
+	"testing"
+
+	"github.com/cloud-barista/cb-tumblebug/src/core/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseImageOsInfo(t *testing.T) {
+	tests := []struct {
+		name     string
+		image    TbImageInfo
+		expected TbImageOsInfo
+	}{
+		{
+			name: "aws ubuntu with codename",
+			image: TbImageInfo{
+				CspImageName: "ubuntu/images/hvm-ssd/ubuntu-jammy-22.04-amd64-server-20230115",
+				KeyValueList: []common.KeyValue{{Key: "Architecture", Value: "x86_64"}, {Key: "VirtualizationType", Value: "hvm"}},
+			},
+			expected: TbImageOsInfo{OsFamily: OsFamilyLinux, OsDistribution: "ubuntu", OsVersion: "22.04", OsArchitecture: OsArchX86_64, VirtualizationType: "hvm"},
+		},
+		{
+			name: "aws amazon linux 2 on arm64",
+			image: TbImageInfo{
+				CspImageName: "amzn2-ami-kernel-5.10-hvm-2.0.20230119.1-arm64-gp2",
+			},
+			expected: TbImageOsInfo{OsFamily: OsFamilyLinux, OsDistribution: "amazonlinux", OsVersion: "2", OsArchitecture: OsArchArm64, VirtualizationType: "hvm"},
+		},
+		{
+			name: "azure urn with hyper-v generation 2",
+			image: TbImageInfo{
+				CspImageName: "Canonical:0001-com-ubuntu-server-jammy:22_04-lts-gen2:latest",
+				KeyValueList: []common.KeyValue{{Key: "HyperVGeneration", Value: "V2"}},
+			},
+			expected: TbImageOsInfo{OsFamily: OsFamilyLinux, OsDistribution: "ubuntu", OsVersion: "22.04", BootMode: BootModeUefi},
+		},
+		{
+			name:     "gcp ubuntu with compact version",
+			image:    TbImageInfo{CspImageName: "ubuntu-minimal-2204-lts-v20230110"},
+			expected: TbImageOsInfo{OsFamily: OsFamilyLinux, OsDistribution: "ubuntu", OsVersion: "22.04"},
+		},
+		{
+			name: "windows with platform key",
+			image: TbImageInfo{
+				CspImageName: "Windows_Server-2019-English-Full-Base-2023.01.11",
+				KeyValueList: []common.KeyValue{{Key: "platform", Value: "windows"}},
+			},
+			expected: TbImageOsInfo{OsFamily: OsFamilyWindows, OsDistribution: "windows", OsVersion: "2019"},
+		},
+		{
+			name:     "version in a later source",
+			image:    TbImageInfo{GuestOS: "CentOS", Description: "CentOS 7.9 UEFI"},
+			expected: TbImageOsInfo{OsFamily: OsFamilyLinux, OsDistribution: "centos", OsVersion: "7.9", BootMode: BootModeUefi},
+		},
+		{
+			name:     "linux without distribution",
+			image:    TbImageInfo{Description: "Custom Linux image"},
+			expected: TbImageOsInfo{OsFamily: OsFamilyLinux},
+		},
+		{
+			name:     "unknown",
+			image:    TbImageInfo{CspImageName: "my-image-01"},
+			expected: TbImageOsInfo{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, ParseImageOsInfo(tt.image))
+		})
+	}
+}
+
+func TestFindOsVersion(t *testing.T) {
+	tests := []struct {
+		name         string
+		text         string
+		distribution string
+		expected     string
+	}{
+		{name: "codename", text: "ubuntu-focal-server", distribution: "ubuntu", expected: "20.04"},
+		{name: "codenames checked in order", text: "ubuntu-focal-to-jammy-upgrade", distribution: "ubuntu", expected: "22.04"},
+		{name: "codename before version", text: "debian-bookworm-12-amd64", distribution: "debian", expected: "12"},
+		{name: "version with dots", text: "centos-7.9.2009-x86_64", distribution: "centos", expected: "7.9"},
+		{name: "version with underscore", text: "rhel-8_6-hvm", distribution: "rhel", expected: "8.6"},
+		{name: "ubuntu compact version", text: "ubuntu-2004-lts", distribution: "ubuntu", expected: "20.04"},
+		{name: "date is not a version", text: "ubuntu-20230115", distribution: "ubuntu", expected: ""},
+		{name: "version out of window", text: "ubuntu-server-minimal-cloud-image-for-testing-22.04", distribution: "ubuntu", expected: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			from := len(tt.distribution)
+			for i := 0; i < 10; i++ {
+				// the same version for every call
+				assert.Equal(t, tt.expected, findOsVersion(tt.text, tt.distribution, from))
+			}
+		})
+	}
+}
+
+func TestGetSpecArchitecture(t *testing.T) {
+	tests := []struct {
+		cspSpecName string
+		expected    string
+	}{
+		{"t3.micro", OsArchX86_64},
+		{"t4g.small", OsArchArm64},
+		{"a1.large", OsArchArm64},
+		{"m6gd.large", OsArchArm64},
+		{"Standard_B2s", OsArchX86_64},
+		{"Standard_D2ps_v5", OsArchArm64},
+		{"n2-standard-2", OsArchX86_64},
+		{"t2a-standard-1", OsArchArm64},
+		{"ecs.g7.large", OsArchX86_64},
+		{"ecs.g8y.large", OsArchArm64},
+		{"custom spec", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.cspSpecName, func(t *testing.T) {
+			assert.Equal(t, tt.expected, GetSpecArchitecture(TbSpecInfo{CspSpecName: tt.cspSpecName}))
+		})
+	}
+}
+
+func TestFindImageByOsQuery(t *testing.T) {
+	_, err := FindImageByOsQuery("common", "aws-seoul", "my-custom-image")
+	assert.NotNil(t, err)
+
+	imageList := []TbImageInfo{
+		{Id: "u2004", OsVersion: "20.04", OsArchitecture: OsArchX86_64},
+		{Id: "u2204-arm", OsVersion: "22.04", OsArchitecture: OsArchArm64},
+		{Id: "u2204-old", OsVersion: "22.04", OsArchitecture: OsArchX86_64, CreationDate: "2022-05-01"},
+		{Id: "u2204", OsVersion: "22.04", OsArchitecture: OsArchX86_64, CreationDate: "2023-01-15"},
+		{Id: "u2210-deprecated", OsVersion: "22.10", OsArchitecture: OsArchX86_64, Deprecated: true},
+		{Id: "u2404-unknown-arch", OsVersion: "24.04"},
+	}
+	tests := []struct {
+		name     string
+		query    string
+		expected []string
+	}{
+		{name: "exact version first", query: "ubuntu 22.04", expected: []string{"u2204", "u2204-old", "u2404-unknown-arch", "u2004"}},
+		{name: "latest version if partial", query: "ubuntu", expected: []string{"u2404-unknown-arch", "u2204", "u2204-old", "u2004"}},
+		{name: "other architectures kept if the query has one", query: "ubuntu 22.04 arm64", expected: []string{"u2204", "u2204-old", "u2204-arm", "u2404-unknown-arch", "u2004"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ids := []string{}
+			for _, v := range sortImagesByOsQuery(imageList, ParseImageOsQuery(tt.query)) {
+				ids = append(ids, v.Id)
+			}
+			assert.Equal(t, tt.expected, ids)
+		})
+	}
+}
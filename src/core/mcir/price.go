@@ -0,0 +1,769 @@
+/*
+Copyright 2019 The Cloud-Barista Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package mcir is to manage multi-cloud infra resource
+package mcir
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloud-barista/cb-tumblebug/src/core/common"
+)
+
+// Pricing models of TbPriceInfo
+const (
+	PricingModelOnDemand string = "onDemand"
+	PricingModelSpot     string = "spot"
+	PricingModelReserved string = "reserved"
+)
+
+// Formats of price catalog to import
+const (
+	// PriceFormatCsv is CSV with the header connectionName,cspSpecName,osType,pricingModel,currency,pricePerHour,effectiveDate
+	PriceFormatCsv string = "csv"
+	// PriceFormatJson is JSON of TbPriceList
+	PriceFormatJson string = "json"
+	// PriceFormatAws is CSV of AWS Price List (AmazonEC2 offer file)
+	PriceFormatAws string = "aws"
+	// PriceFormatAzure is JSON of Azure Retail Prices API ({"Items": [...]})
+	PriceFormatAzure string = "azure"
+)
+
+// priceCatalogFile is the internal asset file of price catalog (PriceFormatCsv)
+const priceCatalogFile string = "../assets/cloudprice.csv"
+
+// priceDateLayout is the layout of EffectiveDate
+const priceDateLayout string = "2006-01-02"
+
+// defaults of price and pricing policy
+const (
+	defaultPriceOsType   string = "linux"
+	defaultPriceCurrency string = "USD"
+)
+
+// TbPriceInfo is struct for the price of a spec in a connection with an OS type and a pricing model
+type TbPriceInfo struct {
+	ConnectionName string  `json:"connectionName" example:"aws-ap-northeast-2"`
+	CspSpecName    string  `json:"cspSpecName" example:"t2.micro"`
+	OsType         string  `json:"osType" example:"linux"`
+	PricingModel   string  `json:"pricingModel" example:"onDemand" enums:"onDemand,spot,reserved"`
+	Currency       string  `json:"currency" example:"USD"`
+	PricePerHour   float32 `json:"pricePerHour" example:"0.0144"`
+	// EffectiveDate is the date from which the price is applied (YYYY-MM-DD)
+	EffectiveDate string `json:"effectiveDate" example:"2022-01-01"`
+	// Origin is where the price comes from (user, or the format of imported catalog)
+	Origin      string `json:"origin,omitempty" example:"user"`
+	UpdatedTime string `json:"updatedTime,omitempty"`
+}
+
+// TbPriceList is struct for a list of prices
+type TbPriceList struct {
+	Price []TbPriceInfo `json:"price"`
+}
+
+// TbPriceImportResult is struct for the result of importing a price catalog
+type TbPriceImportResult struct {
+	Price []TbPriceInfo `json:"price"`
+	// SkippedNum is the number of entries skipped (not a VM price per hour, unknown region, invalid value)
+	SkippedNum int `json:"skippedNum"`
+}
+
+// TbPricingPolicy is struct for the pricing model of a namespace to get CostPerHour of specs
+type TbPricingPolicy struct {
+	// PricingModel to apply (falls back to onDemand if the spec has no price in the model)
+	PricingModel string `json:"pricingModel" example:"onDemand" enums:"onDemand,spot,reserved"`
+	OsType       string `json:"osType" example:"linux"`
+	Currency     string `json:"currency" example:"USD"`
+}
+
+// normalizePricingModel is func to get PricingModelOnDemand, PricingModelSpot or PricingModelReserved from user input
+func normalizePricingModel(model string) (string, error) {
+	switch strings.ToLower(strings.NewReplacer(" ", "", "-", "", "_", "").Replace(model)) {
+	case "", "ondemand":
+		return PricingModelOnDemand, nil
+	case "spot":
+		return PricingModelSpot, nil
+	case "reserved":
+		return PricingModelReserved, nil
+	default:
+		return "", fmt.Errorf("Not available pricing model " + model + " (onDemand, spot, reserved)")
+	}
+}
+
+// normalizePriceDate is func to convert a date (YYYY-MM-DD or RFC3339) into YYYY-MM-DD (today if empty)
+func normalizePriceDate(date string) (string, error) {
+	date = strings.TrimSpace(date)
+	if date == "" {
+		return time.Now().UTC().Format(priceDateLayout), nil
+	}
+	if t, err := time.Parse(priceDateLayout, date); err == nil {
+		return t.Format(priceDateLayout), nil
+	}
+	if t, err := time.Parse(time.RFC3339, date); err == nil {
+		return t.UTC().Format(priceDateLayout), nil
+	}
+	return "", fmt.Errorf("The date " + date + " is not in the form of YYYY-MM-DD")
+}
+
+// normalizePricingPolicy is func to fill default values of pricing policy
+func normalizePricingPolicy(policy TbPricingPolicy) (TbPricingPolicy, error) {
+	var err error
+	policy.PricingModel, err = normalizePricingModel(policy.PricingModel)
+	if err != nil {
+		return policy, err
+	}
+	policy.OsType = strings.ToLower(strings.TrimSpace(policy.OsType))
+	if policy.OsType == "" {
+		policy.OsType = defaultPriceOsType
+	}
+	policy.Currency = strings.ToUpper(strings.TrimSpace(policy.Currency))
+	if policy.Currency == "" {
+		policy.Currency = defaultPriceCurrency
+	}
+	return policy, nil
+}
+
+// genPriceEntryKey is func to generate the key of a price (including OS, pricing model, currency and effective date)
+func genPriceEntryKey(price TbPriceInfo) string {
+	return common.GenPriceKey(price.ConnectionName, price.CspSpecName) +
+		price.OsType + "/" + price.PricingModel + "/" + price.Currency + "/" + price.EffectiveDate
+}
+
+// genPriceGroupKey is func to generate the key of prices which differ only in effective date
+func genPriceGroupKey(connectionName string, cspSpecName string, osType string, pricingModel string, currency string) string {
+	return connectionName + "/" + cspSpecName + "/" + osType + "/" + pricingModel + "/" + currency
+}
+
+// PutPrice is func to register a price (a price with the same effective date is overwritten, others are kept as history)
+func PutPrice(price TbPriceInfo) (TbPriceInfo, error) {
+	price.ConnectionName = strings.TrimSpace(price.ConnectionName)
+	price.CspSpecName = strings.TrimSpace(price.CspSpecName)
+	if price.ConnectionName == "" || price.CspSpecName == "" {
+		return price, fmt.Errorf("The connectionName and cspSpecName of price are required")
+	}
+	if strings.Contains(price.ConnectionName, "/") || strings.Contains(price.CspSpecName, "/") {
+		return price, fmt.Errorf("The connectionName and cspSpecName of price should not contain '/'")
+	}
+	if price.PricePerHour < 0 {
+		return price, fmt.Errorf("The pricePerHour of " + price.ConnectionName + "/" + price.CspSpecName + " should not be negative")
+	}
+
+	policy, err := normalizePricingPolicy(TbPricingPolicy{PricingModel: price.PricingModel, OsType: price.OsType, Currency: price.Currency})
+	if err != nil {
+		return price, err
+	}
+	price.PricingModel = policy.PricingModel
+	price.OsType = policy.OsType
+	price.Currency = policy.Currency
+
+	price.EffectiveDate, err = normalizePriceDate(price.EffectiveDate)
+	if err != nil {
+		return price, err
+	}
+	if price.Origin == "" {
+		price.Origin = "user"
+	}
+	price.UpdatedTime = time.Now().UTC().Format(time.RFC3339)
+
+	val, _ := json.Marshal(price)
+	err = common.CBStore.Put(genPriceEntryKey(price), string(val))
+	if err != nil {
+		common.CBLog.Error(err)
+		return price, err
+	}
+	return price, nil
+}
+
+// RegisterPriceList is func to register a list of prices
+func RegisterPriceList(req *TbPriceList) (TbPriceList, error) {
+	result := TbPriceList{Price: []TbPriceInfo{}}
+	for _, v := range req.Price {
+		price, err := PutPrice(v)
+		if err != nil {
+			return result, err
+		}
+		result.Price = append(result.Price, price)
+	}
+	return result, nil
+}
+
+// currentPrices is func to get the price effective today for each (connection, spec, OS, pricing model, currency)
+func currentPrices(prices []TbPriceInfo) []TbPriceInfo {
+	today := time.Now().UTC().Format(priceDateLayout)
+	current := map[string]int{}
+	result := []TbPriceInfo{}
+	for _, v := range prices {
+		if v.EffectiveDate > today {
+			// not effective yet
+			continue
+		}
+		groupKey := genPriceGroupKey(v.ConnectionName, v.CspSpecName, v.OsType, v.PricingModel, v.Currency)
+		i, ok := current[groupKey]
+		if !ok {
+			current[groupKey] = len(result)
+			result = append(result, v)
+		} else if v.EffectiveDate > result[i].EffectiveDate {
+			result[i] = v
+		}
+	}
+	return result
+}
+
+// ListPrice is func to list prices of specs (only the prices effective today if history is false)
+func ListPrice(connectionName string, cspSpecName string, history bool) (TbPriceList, error) {
+	result := TbPriceList{Price: []TbPriceInfo{}}
+
+	keyValue, err := common.CBStore.GetList(common.GenPriceKey(connectionName, cspSpecName), true)
+	if err != nil {
+		common.CBLog.Error(err)
+		return result, err
+	}
+	for _, v := range keyValue {
+		price := TbPriceInfo{}
+		err = json.Unmarshal([]byte(v.Value), &price)
+		if err != nil {
+			common.CBLog.Error(err)
+			continue
+		}
+		if cspSpecName != "" && price.CspSpecName != cspSpecName {
+			continue
+		}
+		result.Price = append(result.Price, price)
+	}
+
+	sort.SliceStable(result.Price, func(i, j int) bool {
+		return genPriceEntryKey(result.Price[i]) < genPriceEntryKey(result.Price[j])
+	})
+	if !history {
+		result.Price = currentPrices(result.Price)
+	}
+	return result, nil
+}
+
+// DelAllPrice is func to delete all prices including history
+func DelAllPrice() error {
+	keyValue, err := common.CBStore.GetList(common.GenPriceKey("", ""), true)
+	if err != nil {
+		common.CBLog.Error(err)
+		return err
+	}
+	for _, v := range keyValue {
+		err = common.CBStore.Delete(v.Key)
+		if err != nil {
+			common.CBLog.Error(err)
+			return err
+		}
+	}
+	return nil
+}
+
+// GetPricingPolicy is func to get the pricing policy of a namespace (onDemand, linux, USD if not set)
+func GetPricingPolicy(nsId string) (TbPricingPolicy, error) {
+	policy := TbPricingPolicy{}
+
+	err := common.CheckString(nsId)
+	if err != nil {
+		common.CBLog.Error(err)
+		return policy, err
+	}
+
+	keyValue, err := common.CBStore.Get("/ns/" + nsId + "/pricingPolicy")
+	if err != nil {
+		common.CBLog.Error(err)
+		return policy, err
+	}
+	if keyValue != nil {
+		err = json.Unmarshal([]byte(keyValue.Value), &policy)
+		if err != nil {
+			common.CBLog.Error(err)
+			return policy, err
+		}
+	}
+	return normalizePricingPolicy(policy)
+}
+
+// SetPricingPolicy is func to set the pricing policy of a namespace
+func SetPricingPolicy(nsId string, policy TbPricingPolicy) (TbPricingPolicy, error) {
+	err := common.CheckString(nsId)
+	if err != nil {
+		common.CBLog.Error(err)
+		return policy, err
+	}
+	check, err := common.CheckNs(nsId)
+	if !check {
+		return policy, fmt.Errorf("The namespace " + nsId + " does not exist.")
+	}
+	if err != nil {
+		common.CBLog.Error(err)
+		return policy, err
+	}
+
+	policy, err = normalizePricingPolicy(policy)
+	if err != nil {
+		return policy, err
+	}
+
+	val, _ := json.Marshal(policy)
+	err = common.CBStore.Put("/ns/"+nsId+"/pricingPolicy", string(val))
+	if err != nil {
+		common.CBLog.Error(err)
+		return policy, err
+	}
+	return policy, nil
+}
+
+// lookupPrice is func to find the price of a spec by the pricing policy in current prices (by genPriceGroupKey)
+func lookupPrice(prices map[string]TbPriceInfo, connectionName string, cspSpecName string, policy TbPricingPolicy) (TbPriceInfo, bool) {
+	price, ok := prices[genPriceGroupKey(connectionName, cspSpecName, policy.OsType, policy.PricingModel, policy.Currency)]
+	if !ok && policy.PricingModel != PricingModelOnDemand {
+		price, ok = prices[genPriceGroupKey(connectionName, cspSpecName, policy.OsType, PricingModelOnDemand, policy.Currency)]
+	}
+	return price, ok
+}
+
+// getPriceTable is func to get current prices by genPriceGroupKey
+func getPriceTable(connectionName string, cspSpecName string) (map[string]TbPriceInfo, error) {
+	priceList, err := ListPrice(connectionName, cspSpecName, false)
+	if err != nil {
+		return nil, err
+	}
+	prices := map[string]TbPriceInfo{}
+	for _, v := range priceList.Price {
+		prices[genPriceGroupKey(v.ConnectionName, v.CspSpecName, v.OsType, v.PricingModel, v.Currency)] = v
+	}
+	return prices, nil
+}
+
+// GetSpecPrice is func to get the current price of a spec by the pricing policy of a namespace
+func GetSpecPrice(nsId string, connectionName string, cspSpecName string) (TbPriceInfo, error) {
+	policy, err := GetPricingPolicy(nsId)
+	if err != nil {
+		return TbPriceInfo{}, err
+	}
+	prices, err := getPriceTable(connectionName, cspSpecName)
+	if err != nil {
+		return TbPriceInfo{}, err
+	}
+	price, ok := lookupPrice(prices, connectionName, cspSpecName, policy)
+	if !ok {
+		return TbPriceInfo{}, fmt.Errorf("No " + policy.PricingModel + " price (" + policy.OsType + ", " + policy.Currency + ") for spec " + cspSpecName + " in " + connectionName)
+	}
+	return price, nil
+}
+
+// ApplyPriceToSpecs is func to override CostPerHour of specs by the price catalog and the pricing policy of a namespace
+// (specs without a price in the catalog keep CostPerHour)
+func ApplyPriceToSpecs(nsId string, specList []TbSpecInfo) ([]TbSpecInfo, error) {
+	result := make([]TbSpecInfo, len(specList))
+	copy(result, specList)
+
+	policy, err := GetPricingPolicy(nsId)
+	if err != nil {
+		return result, err
+	}
+	// query the prices of each spec (not the whole catalog including history)
+	priceTables := map[string]map[string]TbPriceInfo{}
+	for i, spec := range result {
+		if spec.ConnectionName == "" || spec.CspSpecName == "" ||
+			strings.Contains(spec.ConnectionName, "/") || strings.Contains(spec.CspSpecName, "/") {
+			// not available as a key of price
+			continue
+		}
+		tableKey := common.GenPriceKey(spec.ConnectionName, spec.CspSpecName)
+		prices, ok := priceTables[tableKey]
+		if !ok {
+			prices, err = getPriceTable(spec.ConnectionName, spec.CspSpecName)
+			if err != nil {
+				return result, err
+			}
+			priceTables[tableKey] = prices
+		}
+		price, ok := lookupPrice(prices, spec.ConnectionName, spec.CspSpecName, policy)
+		if ok {
+			result[i].CostPerHour = price.PricePerHour
+		}
+	}
+	return result, nil
+}
+
+// GetSpecWithPrice is func to get a spec in the namespace (or in common namespace if not found)
+// with CostPerHour by the price catalog and the pricing policy of the namespace
+// (specs of dynamic MCIS are in common namespace, but prices follow the pricing policy of the namespace)
+func GetSpecWithPrice(nsId string, specId string) (TbSpecInfo, error) {
+	specInfo := TbSpecInfo{}
+	tempInterface, err := GetResourceWithCommon(nsId, common.StrSpec, specId)
+	if err != nil {
+		return specInfo, err
+	}
+	err = common.CopySrcToDest(&tempInterface, &specInfo)
+	if err != nil {
+		return specInfo, err
+	}
+	specList, err := ApplyPriceToSpecs(nsId, []TbSpecInfo{specInfo})
+	if err != nil {
+		// CostPerHour of the spec is used
+		common.CBLog.Error(err)
+		return specInfo, nil
+	}
+	return specList[0], nil
+}
+
+// UpdateSpecPrice is func to update CostPerHour of all specs in a namespace by the price catalog and its pricing policy
+func UpdateSpecPrice(nsId string) (common.IdList, error) {
+	result := common.IdList{IdList: []string{}}
+
+	err := common.CheckString(nsId)
+	if err != nil {
+		common.CBLog.Error(err)
+		return result, err
+	}
+
+	specList := []TbSpecInfo{}
+	err = common.ORM.Where("Namespace = ?", nsId).Find(&specList)
+	if err != nil {
+		common.CBLog.Error(err)
+		return result, err
+	}
+
+	pricedList, err := ApplyPriceToSpecs(nsId, specList)
+	if err != nil {
+		return result, err
+	}
+	for i, spec := range pricedList {
+		if spec.CostPerHour == specList[i].CostPerHour || spec.CostPerHour == 0 {
+			continue
+		}
+		_, err := UpdateSpec(nsId, spec.Id, TbSpecInfo{CostPerHour: spec.CostPerHour})
+		if err != nil {
+			common.CBLog.Error(err)
+			continue
+		}
+		result.IdList = append(result.IdList, spec.Id)
+	}
+	return result, nil
+}
+
+// getRegionConnections is func to get connection names by provider/nativeRegion (lower case)
+// to map the region of provider price lists to connections
+func getRegionConnections() (map[string][]string, error) {
+	result := map[string][]string{}
+
+	connConfigList, err := common.GetConnConfigList()
+	if err != nil {
+		common.CBLog.Error(err)
+		return result, err
+	}
+	nativeRegions := map[string]string{}
+	for _, connConfig := range connConfigList.Connectionconfig {
+		nativeRegion, ok := nativeRegions[connConfig.RegionName]
+		if !ok {
+			region, err := common.GetRegion(connConfig.RegionName)
+			if err != nil {
+				common.CBLog.Error(err)
+				continue
+			}
+			for _, v := range region.KeyValueInfoList {
+				if strings.ToLower(v.Key) == "region" || strings.ToLower(v.Key) == "location" {
+					nativeRegion = v.Value
+					break
+				}
+			}
+			nativeRegions[connConfig.RegionName] = nativeRegion
+		}
+		if nativeRegion == "" {
+			continue
+		}
+		key := strings.ToLower(connConfig.ProviderName + "/" + nativeRegion)
+		result[key] = append(result[key], connConfig.ConfigName)
+	}
+	return result, nil
+}
+
+// getCsvColumns is func to get the column index by lower-cased header name
+func getCsvColumns(header []string) map[string]int {
+	columns := map[string]int{}
+	for i, v := range header {
+		columns[strings.ToLower(strings.TrimSpace(v))] = i
+	}
+	return columns
+}
+
+// getCsvValue is func to get the value of a column in a row (empty if not exist)
+func getCsvValue(row []string, columns map[string]int, column string) string {
+	i, ok := columns[column]
+	if !ok || i >= len(row) {
+		return ""
+	}
+	return strings.TrimSpace(row[i])
+}
+
+// parsePriceCsv is func to parse a price catalog in PriceFormatCsv
+func parsePriceCsv(data []byte) ([]TbPriceInfo, int, error) {
+	prices := []TbPriceInfo{}
+	skipped := 0
+
+	rdr := csv.NewReader(bytes.NewReader(data))
+	rdr.FieldsPerRecord = -1
+	rows, err := rdr.ReadAll()
+	if err != nil {
+		return prices, skipped, err
+	}
+	if len(rows) == 0 {
+		return prices, skipped, nil
+	}
+
+	columns := getCsvColumns(rows[0])
+	for _, column := range []string{"connectionname", "cspspecname", "priceperhour"} {
+		if _, ok := columns[column]; !ok {
+			return prices, skipped, fmt.Errorf("The column " + column + " is required in the header of price catalog")
+		}
+	}
+	for i, row := range rows[1:] {
+		pricePerHour, err := strconv.ParseFloat(getCsvValue(row, columns, "priceperhour"), 32)
+		if err != nil {
+			fmt.Printf("[Import price] skip line %d: %v\n", i+2, row)
+			skipped++
+			continue
+		}
+		prices = append(prices, TbPriceInfo{
+			ConnectionName: getCsvValue(row, columns, "connectionname"),
+			CspSpecName:    getCsvValue(row, columns, "cspspecname"),
+			OsType:         getCsvValue(row, columns, "ostype"),
+			PricingModel:   getCsvValue(row, columns, "pricingmodel"),
+			Currency:       getCsvValue(row, columns, "currency"),
+			PricePerHour:   float32(pricePerHour),
+			EffectiveDate:  getCsvValue(row, columns, "effectivedate"),
+		})
+	}
+	return prices, skipped, nil
+}
+
+// parseAwsPriceList is func to parse the AWS Price List (AmazonEC2 offer file in CSV)
+// Only hourly prices of shared tenancy VMs without pre-installed software are imported
+// (reserved: 1yr No Upfront which is charged per hour)
+// (regionConnections: connection names by provider/nativeRegion from getRegionConnections)
+func parseAwsPriceList(data []byte, regionConnections map[string][]string) ([]TbPriceInfo, int, error) {
+	prices := []TbPriceInfo{}
+	skipped := 0
+
+	rdr := csv.NewReader(bytes.NewReader(data))
+	rdr.FieldsPerRecord = -1
+	rdr.LazyQuotes = true
+	rows, err := rdr.ReadAll()
+	if err != nil {
+		return prices, skipped, err
+	}
+
+	// the offer file has metadata lines (FormatVersion, Disclaimer, ...) before the header
+	headerIndex := -1
+	for i, row := range rows {
+		if _, ok := getCsvColumns(row)["termtype"]; ok {
+			headerIndex = i
+			break
+		}
+	}
+	if headerIndex < 0 {
+		return prices, skipped, fmt.Errorf("The header (TermType, ...) of AWS Price List is not found")
+	}
+	columns := getCsvColumns(rows[headerIndex])
+	if _, ok := columns["region code"]; !ok {
+		return prices, skipped, fmt.Errorf("The column Region Code is required in AWS Price List")
+	}
+
+	for _, row := range rows[headerIndex+1:] {
+		if strings.ToLower(getCsvValue(row, columns, "unit")) != "hrs" ||
+			strings.ToLower(getCsvValue(row, columns, "tenancy")) != "shared" ||
+			!strings.EqualFold(getCsvValue(row, columns, "pre installed s/w"), "NA") ||
+			getCsvValue(row, columns, "instance type") == "" {
+			skipped++
+			continue
+		}
+		if capacityStatus := getCsvValue(row, columns, "capacitystatus"); capacityStatus != "" && capacityStatus != "Used" {
+			skipped++
+			continue
+		}
+
+		pricingModel := ""
+		switch getCsvValue(row, columns, "termtype") {
+		case "OnDemand":
+			pricingModel = PricingModelOnDemand
+		case "Reserved":
+			if getCsvValue(row, columns, "leasecontractlength") != "1yr" ||
+				getCsvValue(row, columns, "purchaseoption") != "No Upfront" ||
+				getCsvValue(row, columns, "offeringclass") == "convertible" {
+				skipped++
+				continue
+			}
+			pricingModel = PricingModelReserved
+		default:
+			skipped++
+			continue
+		}
+
+		pricePerHour, err := strconv.ParseFloat(getCsvValue(row, columns, "priceperunit"), 32)
+		if err != nil {
+			skipped++
+			continue
+		}
+		connections := regionConnections["aws/"+strings.ToLower(getCsvValue(row, columns, "region code"))]
+		if len(connections) == 0 {
+			skipped++
+			continue
+		}
+		for _, connectionName := range connections {
+			prices = append(prices, TbPriceInfo{
+				ConnectionName: connectionName,
+				CspSpecName:    getCsvValue(row, columns, "instance type"),
+				OsType:         getCsvValue(row, columns, "operating system"),
+				PricingModel:   pricingModel,
+				Currency:       getCsvValue(row, columns, "currency"),
+				PricePerHour:   float32(pricePerHour),
+				EffectiveDate:  getCsvValue(row, columns, "effectivedate"),
+			})
+		}
+	}
+	return prices, skipped, nil
+}
+
+// azureRetailPrice is struct for an item of Azure Retail Prices API
+type azureRetailPrice struct {
+	CurrencyCode       string  `json:"currencyCode"`
+	RetailPrice        float64 `json:"retailPrice"`
+	ArmRegionName      string  `json:"armRegionName"`
+	ArmSkuName         string  `json:"armSkuName"`
+	EffectiveStartDate string  `json:"effectiveStartDate"`
+	Type               string  `json:"type"`
+	ProductName        string  `json:"productName"`
+	SkuName            string  `json:"skuName"`
+	ServiceName        string  `json:"serviceName"`
+	UnitOfMeasure      string  `json:"unitOfMeasure"`
+}
+
+// parseAzurePriceList is func to parse the response of Azure Retail Prices API ({"Items": [...]})
+// Only hourly prices of VMs are imported (Consumption: onDemand or spot, Low Priority is excluded)
+// (regionConnections: connection names by provider/nativeRegion from getRegionConnections)
+func parseAzurePriceList(data []byte, regionConnections map[string][]string) ([]TbPriceInfo, int, error) {
+	prices := []TbPriceInfo{}
+	skipped := 0
+
+	priceList := struct {
+		Items []azureRetailPrice `json:"Items"`
+	}{}
+	err := json.Unmarshal(data, &priceList)
+	if err != nil {
+		return prices, skipped, err
+	}
+
+	for _, item := range priceList.Items {
+		if item.ServiceName != "Virtual Machines" || item.UnitOfMeasure != "1 Hour" ||
+			item.Type != "Consumption" || item.ArmSkuName == "" ||
+			strings.Contains(item.SkuName, "Low Priority") {
+			skipped++
+			continue
+		}
+		pricingModel := PricingModelOnDemand
+		if strings.Contains(item.SkuName, "Spot") {
+			pricingModel = PricingModelSpot
+		}
+		osType := defaultPriceOsType
+		if strings.Contains(item.ProductName, "Windows") {
+			osType = "windows"
+		}
+
+		connections := regionConnections["azure/"+strings.ToLower(item.ArmRegionName)]
+		if len(connections) == 0 {
+			skipped++
+			continue
+		}
+		for _, connectionName := range connections {
+			prices = append(prices, TbPriceInfo{
+				ConnectionName: connectionName,
+				CspSpecName:    item.ArmSkuName,
+				OsType:         osType,
+				PricingModel:   pricingModel,
+				Currency:       item.CurrencyCode,
+				PricePerHour:   float32(item.RetailPrice),
+				EffectiveDate:  item.EffectiveStartDate,
+			})
+		}
+	}
+	return prices, skipped, nil
+}
+
+// ImportPriceCatalog is func to import a price catalog (PriceFormatCsv, PriceFormatJson, PriceFormatAws, PriceFormatAzure)
+func ImportPriceCatalog(format string, data []byte) (TbPriceImportResult, error) {
+	result := TbPriceImportResult{Price: []TbPriceInfo{}}
+
+	prices := []TbPriceInfo{}
+	var err error
+	format = strings.ToLower(strings.TrimSpace(format))
+	switch format {
+	case PriceFormatCsv:
+		prices, result.SkippedNum, err = parsePriceCsv(data)
+	case PriceFormatJson:
+		priceList := TbPriceList{}
+		err = json.Unmarshal(data, &priceList)
+		prices = priceList.Price
+	case PriceFormatAws, PriceFormatAzure:
+		// map the regions of provider price lists to connections
+		var regionConnections map[string][]string
+		regionConnections, err = getRegionConnections()
+		if err != nil {
+			break
+		}
+		if format == PriceFormatAws {
+			prices, result.SkippedNum, err = parseAwsPriceList(data, regionConnections)
+		} else {
+			prices, result.SkippedNum, err = parseAzurePriceList(data, regionConnections)
+		}
+	default:
+		return result, fmt.Errorf("Not available price catalog format " + format + " (csv, json, aws, azure)")
+	}
+	if err != nil {
+		common.CBLog.Error(err)
+		return result, err
+	}
+
+	for _, v := range prices {
+		if v.Origin == "" {
+			v.Origin = format
+		}
+		price, err := PutPrice(v)
+		if err != nil {
+			fmt.Printf("[Import price] skip %s/%s: %v\n", v.ConnectionName, v.CspSpecName, err)
+			result.SkippedNum++
+			continue
+		}
+		result.Price = append(result.Price, price)
+	}
+	fmt.Printf("[Import price] %d imported, %d skipped\n", len(result.Price), result.SkippedNum)
+	return result, nil
+}
+
+// LoadPriceCatalog is func to import the price catalog from the internal asset file (assets/cloudprice.csv)
+func LoadPriceCatalog() (TbPriceImportResult, error) {
+	data, err := ioutil.ReadFile(priceCatalogFile)
+	if err != nil {
+		common.CBLog.Error(err)
+		return TbPriceImportResult{Price: []TbPriceInfo{}}, err
+	}
+	return ImportPriceCatalog(PriceFormatCsv, data)
+}
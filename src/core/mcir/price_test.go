@@ -0,0 +1,177 @@
+/*
+Copyright 2019 The Cloud-Barista Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package mcir is to manage multi-cloud infra resource
+package mcir
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePriceCsv(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		prices  []TbPriceInfo
+		skipped int
+		wantErr bool
+	}{
+		{
+			name: "all columns",
+			data: "connectionName,cspSpecName,osType,pricingModel,currency,pricePerHour,effectiveDate\n" +
+				"aws-seoul,t3.micro,linux,onDemand,USD,0.013,2021-05-01\n",
+			prices: []TbPriceInfo{{ConnectionName: "aws-seoul", CspSpecName: "t3.micro", OsType: "linux", PricingModel: "onDemand", Currency: "USD", PricePerHour: 0.013, EffectiveDate: "2021-05-01"}},
+		},
+		{
+			name: "columns in any order and case with optional columns omitted",
+			data: "PricePerHour, CspSpecName ,ConnectionName\n" +
+				"0.5,Standard_B2s,azure-korea\n" +
+				"free,Standard_B1s,azure-korea\n",
+			prices:  []TbPriceInfo{{ConnectionName: "azure-korea", CspSpecName: "Standard_B2s", PricePerHour: 0.5}},
+			skipped: 1,
+		},
+		{
+			name:   "empty",
+			data:   "",
+			prices: []TbPriceInfo{},
+		},
+		{
+			name:    "required column missing",
+			data:    "connectionName,cspSpecName\naws-seoul,t3.micro\n",
+			prices:  []TbPriceInfo{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prices, skipped, err := parsePriceCsv([]byte(tt.data))
+			assert.Equal(t, tt.wantErr, err != nil)
+			assert.Equal(t, tt.prices, prices)
+			assert.Equal(t, tt.skipped, skipped)
+		})
+	}
+}
+
+func TestParseAwsPriceList(t *testing.T) {
+	regionConnections := map[string][]string{"aws/ap-northeast-2": {"aws-seoul", "aws-seoul2"}}
+	header := "\"FormatVersion\",\"v1.0\"\n\"Disclaimer\",\"...\"\n" +
+		"SKU,TermType,EffectiveDate,Unit,PricePerUnit,Currency,LeaseContractLength,PurchaseOption,OfferingClass,Instance Type,Region Code,Tenancy,Operating System,Pre Installed S/W,CapacityStatus\n"
+
+	tests := []struct {
+		name    string
+		data    string
+		prices  []TbPriceInfo
+		skipped int
+		wantErr bool
+	}{
+		{
+			name: "on-demand for each connection of the region",
+			data: header + "A1,OnDemand,2021-05-01,Hrs,0.0104,USD,,,,t3.micro,ap-northeast-2,Shared,Linux,NA,Used\n",
+			prices: []TbPriceInfo{
+				{ConnectionName: "aws-seoul", CspSpecName: "t3.micro", OsType: "Linux", PricingModel: PricingModelOnDemand, Currency: "USD", PricePerHour: 0.0104, EffectiveDate: "2021-05-01"},
+				{ConnectionName: "aws-seoul2", CspSpecName: "t3.micro", OsType: "Linux", PricingModel: PricingModelOnDemand, Currency: "USD", PricePerHour: 0.0104, EffectiveDate: "2021-05-01"},
+			},
+		},
+		{
+			name: "only 1yr no upfront standard reserved",
+			data: header +
+				"A2,Reserved,2021-05-01,Hrs,0.008,USD,1yr,No Upfront,standard,t3.micro,ap-northeast-2,Shared,Linux,NA,Used\n" +
+				"A3,Reserved,2021-05-01,Hrs,0.007,USD,3yr,No Upfront,standard,t3.micro,ap-northeast-2,Shared,Linux,NA,Used\n" +
+				"A4,Reserved,2021-05-01,Hrs,0.009,USD,1yr,No Upfront,convertible,t3.micro,ap-northeast-2,Shared,Linux,NA,Used\n",
+			prices: []TbPriceInfo{
+				{ConnectionName: "aws-seoul", CspSpecName: "t3.micro", OsType: "Linux", PricingModel: PricingModelReserved, Currency: "USD", PricePerHour: 0.008, EffectiveDate: "2021-05-01"},
+				{ConnectionName: "aws-seoul2", CspSpecName: "t3.micro", OsType: "Linux", PricingModel: PricingModelReserved, Currency: "USD", PricePerHour: 0.008, EffectiveDate: "2021-05-01"},
+			},
+			skipped: 2,
+		},
+		{
+			name: "skip other tenancy, software, capacity, unit and region",
+			data: header +
+				"B1,OnDemand,2021-05-01,Hrs,0.02,USD,,,,t3.micro,ap-northeast-2,Dedicated,Linux,NA,Used\n" +
+				"B2,OnDemand,2021-05-01,Hrs,0.02,USD,,,,t3.micro,ap-northeast-2,Shared,Linux,SQL Web,Used\n" +
+				"B3,OnDemand,2021-05-01,Hrs,0.02,USD,,,,t3.micro,ap-northeast-2,Shared,Linux,NA,UnusedCapacityReservation\n" +
+				"B4,OnDemand,2021-05-01,Quantity,0.02,USD,,,,t3.micro,ap-northeast-2,Shared,Linux,NA,Used\n" +
+				"B5,OnDemand,2021-05-01,Hrs,0.02,USD,,,,t3.micro,us-east-1,Shared,Linux,NA,Used\n",
+			prices:  []TbPriceInfo{},
+			skipped: 5,
+		},
+		{
+			name:    "header not found",
+			data:    "\"FormatVersion\",\"v1.0\"\n",
+			prices:  []TbPriceInfo{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prices, skipped, err := parseAwsPriceList([]byte(tt.data), regionConnections)
+			assert.Equal(t, tt.wantErr, err != nil)
+			assert.Equal(t, tt.prices, prices)
+			assert.Equal(t, tt.skipped, skipped)
+		})
+	}
+}
+
+func TestParseAzurePriceList(t *testing.T) {
+	regionConnections := map[string][]string{"azure/koreacentral": {"azure-korea"}}
+	item := func(sku string, product string, region string, unit string, priceType string) string {
+		return `{"currencyCode":"USD","retailPrice":0.05,"armRegionName":"` + region + `","armSkuName":"Standard_B2s",` +
+			`"effectiveStartDate":"2021-05-01T00:00:00Z","type":"` + priceType + `","productName":"` + product + `",` +
+			`"skuName":"` + sku + `","serviceName":"Virtual Machines","unitOfMeasure":"` + unit + `"}`
+	}
+	price := func(osType string, pricingModel string) TbPriceInfo {
+		return TbPriceInfo{ConnectionName: "azure-korea", CspSpecName: "Standard_B2s", OsType: osType, PricingModel: pricingModel, Currency: "USD", PricePerHour: 0.05, EffectiveDate: "2021-05-01T00:00:00Z"}
+	}
+
+	tests := []struct {
+		name    string
+		data    string
+		prices  []TbPriceInfo
+		skipped int
+		wantErr bool
+	}{
+		{
+			name: "on-demand and spot of linux and windows",
+			data: `{"Items":[` +
+				item("B2s", "Virtual Machines BS Series", "koreacentral", "1 Hour", "Consumption") + `,` +
+				item("B2s Spot", "Virtual Machines BS Series Windows", "koreacentral", "1 Hour", "Consumption") + `]}`,
+			prices: []TbPriceInfo{price(defaultPriceOsType, PricingModelOnDemand), price("windows", PricingModelSpot)},
+		},
+		{
+			name: "skip low priority, reservation, other unit and region",
+			data: `{"Items":[` +
+				item("B2s Low Priority", "Virtual Machines BS Series", "koreacentral", "1 Hour", "Consumption") + `,` +
+				item("B2s", "Virtual Machines BS Series", "koreacentral", "1 Hour", "Reservation") + `,` +
+				item("B2s", "Virtual Machines BS Series", "koreacentral", "1 Month", "Consumption") + `,` +
+				item("B2s", "Virtual Machines BS Series", "eastus", "1 Hour", "Consumption") + `]}`,
+			prices:  []TbPriceInfo{},
+			skipped: 4,
+		},
+		{
+			name:    "not JSON",
+			data:    "Items",
+			prices:  []TbPriceInfo{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prices, skipped, err := parseAzurePriceList([]byte(tt.data), regionConnections)
+			assert.Equal(t, tt.wantErr, err != nil)
+			assert.Equal(t, tt.prices, prices)
+			assert.Equal(t, tt.skipped, skipped)
+		})
+	}
+}
@@ -112,6 +112,24 @@ type TbSpecInfo struct { // Tumblebug
 	EvaluationScore10     float32  `json:"evaluationScore10"`
 	AssociatedObjectList  []string `json:"associatedObjectList,omitempty"`
 	IsAutoGenerated       bool     `json:"isAutoGenerated,omitempty"`
+
+	// Deprecated is true if the spec is not provided by CSP anymore (marked by catalog sync)
+	Deprecated bool `json:"deprecated,omitempty"`
+
+	// EvaluationTotalScore is the weighted total score of the spec in the last recommendation (not stored)
+	EvaluationTotalScore float32 `json:"evaluationTotalScore,omitempty" xorm:"-"`
+
+	// EvaluationBreakdown is the per-criterion score of the spec in the last recommendation (not stored)
+	EvaluationBreakdown []SpecEvaluationScore `json:"evaluationBreakdown,omitempty" xorm:"-"`
+}
+
+// SpecEvaluationScore is struct for the score of a spec on a recommendation criterion
+type SpecEvaluationScore struct {
+	Metric        string  `json:"metric" example:"cost"`
+	Value         float32 `json:"value" example:"0.0116"`        // raw value of the criterion (ex: costPerHour, distance in km)
+	Score         float32 `json:"score" example:"0.85"`          // normalized score (0 ~ 1, higher is better)
+	Weight        float32 `json:"weight" example:"0.3"`          // normalized weight (sum of weights is 1)
+	WeightedScore float32 `json:"weightedScore" example:"0.255"` // score * weight
 }
 
 // ConvertSpiderSpecToTumblebugSpec accepts an Spider spec object, converts to and returns an TB spec object
@@ -1027,137 +1045,26 @@ func FilterSpecsByRange(nsId string, filter FilterSpecsByRangeRequest) ([]TbSpec
 // SortSpecs accepts the list of TB spec objects, criteria and sorting direction,
 // sorts and returns the sorted list of TB spec objects
 func SortSpecs(specList []TbSpecInfo, orderBy string, direction string) ([]TbSpecInfo, error) {
-	var err error = nil
-
-	sort.Slice(specList, func(i, j int) bool {
-		if orderBy == "numvCPU" {
-			if direction == "descending" {
-				return specList[i].NumvCPU > specList[j].NumvCPU
-			} else if direction == "ascending" {
-				return specList[i].NumvCPU < specList[j].NumvCPU
-			} else {
-				err = fmt.Errorf("'direction' should one of these: ascending, descending")
-				return true
-			}
-		} else if orderBy == "memGiB" {
-			if direction == "descending" {
-				return specList[i].MemGiB > specList[j].MemGiB
-			} else if direction == "ascending" {
-				return specList[i].MemGiB < specList[j].MemGiB
-			} else {
-				err = fmt.Errorf("'direction' should one of these: ascending, descending")
-				return true
-			}
-		} else if orderBy == "storageGiB" {
-			if direction == "descending" {
-				return specList[i].StorageGiB > specList[j].StorageGiB
-			} else if direction == "ascending" {
-				return specList[i].StorageGiB < specList[j].StorageGiB
-			} else {
-				err = fmt.Errorf("'direction' should one of these: ascending, descending")
-				return true
-			}
-		} else if orderBy == "evaluationScore01" {
-			if direction == "descending" {
-				return specList[i].EvaluationScore01 > specList[j].EvaluationScore01
-			} else if direction == "ascending" {
-				return specList[i].EvaluationScore01 < specList[j].EvaluationScore01
-			} else {
-				err = fmt.Errorf("'direction' should one of these: ascending, descending")
-				return true
-			}
-		} else if orderBy == "evaluationScore02" {
-			if direction == "descending" {
-				return specList[i].EvaluationScore02 > specList[j].EvaluationScore02
-			} else if direction == "ascending" {
-				return specList[i].EvaluationScore02 < specList[j].EvaluationScore02
-			} else {
-				err = fmt.Errorf("'direction' should one of these: ascending, descending")
-				return true
-			}
-		} else if orderBy == "evaluationScore03" {
-			if direction == "descending" {
-				return specList[i].EvaluationScore03 > specList[j].EvaluationScore03
-			} else if direction == "ascending" {
-				return specList[i].EvaluationScore03 < specList[j].EvaluationScore03
-			} else {
-				err = fmt.Errorf("'direction' should one of these: ascending, descending")
-				return true
-			}
-		} else if orderBy == "evaluationScore04" {
-			if direction == "descending" {
-				return specList[i].EvaluationScore04 > specList[j].EvaluationScore04
-			} else if direction == "ascending" {
-				return specList[i].EvaluationScore04 < specList[j].EvaluationScore04
-			} else {
-				err = fmt.Errorf("'direction' should one of these: ascending, descending")
-				return true
-			}
-		} else if orderBy == "evaluationScore05" {
-			if direction == "descending" {
-				return specList[i].EvaluationScore05 > specList[j].EvaluationScore05
-			} else if direction == "ascending" {
-				return specList[i].EvaluationScore05 < specList[j].EvaluationScore05
-			} else {
-				err = fmt.Errorf("'direction' should one of these: ascending, descending")
-				return true
-			}
-		} else if orderBy == "evaluationScore06" {
-			if direction == "descending" {
-				return specList[i].EvaluationScore06 > specList[j].EvaluationScore06
-			} else if direction == "ascending" {
-				return specList[i].EvaluationScore06 < specList[j].EvaluationScore06
-			} else {
-				err = fmt.Errorf("'direction' should one of these: ascending, descending")
-				return true
-			}
-		} else if orderBy == "evaluationScore07" {
-			if direction == "descending" {
-				return specList[i].EvaluationScore07 > specList[j].EvaluationScore07
-			} else if direction == "ascending" {
-				return specList[i].EvaluationScore07 < specList[j].EvaluationScore07
-			} else {
-				err = fmt.Errorf("'direction' should one of these: ascending, descending")
-				return true
-			}
-		} else if orderBy == "evaluationScore08" {
-			if direction == "descending" {
-				return specList[i].EvaluationScore08 > specList[j].EvaluationScore08
-			} else if direction == "ascending" {
-				return specList[i].EvaluationScore08 < specList[j].EvaluationScore08
-			} else {
-				err = fmt.Errorf("'direction' should one of these: ascending, descending")
-				return true
-			}
-		} else if orderBy == "evaluationScore09" {
-			if direction == "descending" {
-				return specList[i].EvaluationScore09 > specList[j].EvaluationScore09
-			} else if direction == "ascending" {
-				return specList[i].EvaluationScore09 < specList[j].EvaluationScore09
-			} else {
-				err = fmt.Errorf("'direction' should one of these: ascending, descending")
-				return true
-			}
-		} else if orderBy == "evaluationScore10" {
-			if direction == "descending" {
-				return specList[i].EvaluationScore10 > specList[j].EvaluationScore10
-			} else if direction == "ascending" {
-				return specList[i].EvaluationScore10 < specList[j].EvaluationScore10
-			} else {
-				err = fmt.Errorf("'direction' should one of these: ascending, descending")
-				return true
-			}
-		} else {
-			err = fmt.Errorf("'orderBy' should one of these: numvCPU, memGiB, storageGiB")
-			return true
+	field, err := getSpecSearchField(orderBy)
+	if err != nil {
+		return specList, err
+	}
+	if direction != "descending" && direction != "ascending" {
+		return specList, fmt.Errorf("'direction' should one of these: ascending, descending")
+	}
+
+	sort.SliceStable(specList, func(i, j int) bool {
+		if direction == "descending" {
+			return field.compare(specList[i], specList[j]) > 0
 		}
+		return field.compare(specList[i], specList[j]) < 0
 	})
 
 	for i := range specList {
 		specList[i].OrderInFilteredResult = uint16(i + 1)
 	}
 
-	return specList, err
+	return specList, nil
 }
 
 // UpdateSpec accepts to-be TB spec objects,
@@ -0,0 +1,367 @@
+/*
+Copyright 2019 The Cloud-Barista Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package mcir is to manage multi-cloud infra resource
+package mcir
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/cloud-barista/cb-tumblebug/src/core/common"
+	"xorm.io/xorm"
+)
+
+// SpecSearchReq is struct for searching specs with field predicates, multi-key ordering and pagination
+type SpecSearchReq struct {
+	Condition []SpecSearchCondition `json:"condition"`
+	OrderBy   []SpecSearchOrder     `json:"orderBy"`
+
+	// Limit is the max number of specs in a page (0: no limit)
+	Limit int `json:"limit" example:"20"`
+	// Offset is the number of specs to skip (ignored if cursor is given)
+	Offset int `json:"offset" example:"0"`
+	// Cursor is the nextCursor of the previous page (keyset pagination)
+	Cursor string `json:"cursor,omitempty" example:""`
+}
+
+// SpecSearchCondition is struct for a predicate on a field of TbSpecInfo
+type SpecSearchCondition struct {
+	// Field is the name of TbSpecInfo field (json name, ex: memGiB, cspSpecName)
+	Field    string `json:"field" example:"memGiB"`
+	Operator string `json:"operator" example:">=" enums:"==,!=,>,>=,<,<=,in,like,between"`
+	// Value is the operand (2 values for between, 1 or more values for in)
+	Value []string `json:"value" example:"4"`
+}
+
+// SpecSearchOrder is struct for an ordering key of spec search
+type SpecSearchOrder struct {
+	Field     string `json:"field" example:"costPerHour"`
+	Direction string `json:"direction" example:"ascending" enums:"ascending,descending"`
+}
+
+// SpecSearchResult is struct for a page of spec search
+type SpecSearchResult struct {
+	Spec       []TbSpecInfo `json:"spec"`
+	TotalCount int64        `json:"totalCount"`
+	Limit      int          `json:"limit"`
+	Offset     int          `json:"offset"`
+	// NextCursor is to get the next page (empty if it is the last page or limit is 0)
+	NextCursor string `json:"nextCursor,omitempty"`
+}
+
+// specSearchField is struct for a searchable field of TbSpecInfo
+type specSearchField struct {
+	name  string // field (column) name
+	kind  reflect.Kind
+	index int
+}
+
+// specSearchFields is the searchable fields of TbSpecInfo by lower-cased json name and field name
+var specSearchFields = func() map[string]specSearchField {
+	fields := map[string]specSearchField{}
+	t := reflect.TypeOf(TbSpecInfo{})
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.Tag.Get("xorm") == "-" {
+			continue
+		}
+		switch f.Type.Kind() {
+		case reflect.String, reflect.Bool, reflect.Float32, reflect.Float64,
+			reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Int, reflect.Int64:
+		default:
+			continue
+		}
+		field := specSearchField{name: f.Name, kind: f.Type.Kind(), index: i}
+		fields[strings.ToLower(f.Name)] = field
+		jsonName := strings.Split(f.Tag.Get("json"), ",")[0]
+		if jsonName != "" {
+			fields[strings.ToLower(jsonName)] = field
+		}
+	}
+	return fields
+}()
+
+// getSpecSearchField is func to get a searchable field of TbSpecInfo by name
+func getSpecSearchField(name string) (specSearchField, error) {
+	field, ok := specSearchFields[strings.ToLower(strings.TrimSpace(name))]
+	if !ok {
+		return field, fmt.Errorf("The field " + name + " is not a searchable field of spec")
+	}
+	return field, nil
+}
+
+// value is func to convert a string operand to the type of field
+func (f specSearchField) value(operand string) (interface{}, error) {
+	operand = strings.TrimSpace(operand)
+	switch f.kind {
+	case reflect.String:
+		return operand, nil
+	case reflect.Bool:
+		v, err := strconv.ParseBool(operand)
+		if err != nil {
+			return nil, fmt.Errorf("The value (" + operand + ") of field " + f.name + " is not a boolean")
+		}
+		return v, nil
+	default:
+		v, err := strconv.ParseFloat(operand, 64)
+		if err != nil {
+			return nil, fmt.Errorf("The value (" + operand + ") of field " + f.name + " is not a number")
+		}
+		return v, nil
+	}
+}
+
+// compare is func to compare the field of two specs (-1, 0, 1)
+func (f specSearchField) compare(a TbSpecInfo, b TbSpecInfo) int {
+	va := reflect.ValueOf(a).Field(f.index)
+	vb := reflect.ValueOf(b).Field(f.index)
+	switch f.kind {
+	case reflect.String:
+		return strings.Compare(va.String(), vb.String())
+	case reflect.Bool:
+		if va.Bool() == vb.Bool() {
+			return 0
+		} else if vb.Bool() {
+			return -1
+		}
+		return 1
+	case reflect.Float32, reflect.Float64:
+		if va.Float() < vb.Float() {
+			return -1
+		} else if va.Float() > vb.Float() {
+			return 1
+		}
+		return 0
+	case reflect.Int, reflect.Int64:
+		if va.Int() < vb.Int() {
+			return -1
+		} else if va.Int() > vb.Int() {
+			return 1
+		}
+		return 0
+	default:
+		if va.Uint() < vb.Uint() {
+			return -1
+		} else if va.Uint() > vb.Uint() {
+			return 1
+		}
+		return 0
+	}
+}
+
+// applySpecSearchCondition is func to add predicates of spec search to the xorm session
+func applySpecSearchCondition(session *xorm.Session, conditions []SpecSearchCondition) (*xorm.Session, error) {
+	for _, c := range conditions {
+		field, err := getSpecSearchField(c.Field)
+		if err != nil {
+			return session, err
+		}
+		if len(c.Value) == 0 {
+			return session, fmt.Errorf("The value of condition on field " + c.Field + " is required")
+		}
+		column := "`" + field.name + "`"
+
+		operator := strings.ToLower(strings.TrimSpace(c.Operator))
+		switch operator {
+		case "==", "=", "!=", ">", ">=", "<", "<=":
+			if operator == "==" {
+				operator = "="
+			}
+			v, err := field.value(c.Value[0])
+			if err != nil {
+				return session, err
+			}
+			session = session.And(column+" "+operator+" ?", v)
+		case "between":
+			if len(c.Value) != 2 {
+				return session, fmt.Errorf("The operator between on field " + c.Field + " requires 2 values (min, max)")
+			}
+			min, err := field.value(c.Value[0])
+			if err != nil {
+				return session, err
+			}
+			max, err := field.value(c.Value[1])
+			if err != nil {
+				return session, err
+			}
+			session = session.And(column+" >= ? AND "+column+" <= ?", min, max)
+		case "in":
+			values := []interface{}{}
+			for _, operand := range c.Value {
+				v, err := field.value(operand)
+				if err != nil {
+					return session, err
+				}
+				values = append(values, v)
+			}
+			session = session.In(column, values...)
+		case "like":
+			if field.kind != reflect.String {
+				return session, fmt.Errorf("The operator like is only for string fields (" + c.Field + ")")
+			}
+			pattern := c.Value[0]
+			if !strings.ContainsAny(pattern, "%_") {
+				pattern = "%" + pattern + "%"
+			}
+			session = session.And(column+" LIKE ?", pattern)
+		default:
+			return session, fmt.Errorf("Not available operator " + c.Operator + " (==, !=, >, >=, <, <=, in, like, between)")
+		}
+	}
+	return session, nil
+}
+
+// encodeSpecSearchCursor is func to make the cursor from the ordering values of the last spec in a page
+func encodeSpecSearchCursor(spec TbSpecInfo, orderFields []specSearchField) string {
+	values := []interface{}{}
+	v := reflect.ValueOf(spec)
+	for _, f := range orderFields {
+		if f.kind == reflect.Float32 || f.kind == reflect.Float64 {
+			// keep the exact value stored in DB (float32 is stored as float64)
+			values = append(values, v.Field(f.index).Float())
+		} else {
+			values = append(values, v.Field(f.index).Interface())
+		}
+	}
+	cursor, _ := json.Marshal(values)
+	return base64.URLEncoding.EncodeToString(cursor)
+}
+
+// decodeSpecSearchCursor is func to get the ordering values from the cursor
+func decodeSpecSearchCursor(cursor string, orderFields []specSearchField) ([]interface{}, error) {
+	values := []interface{}{}
+	decoded, err := base64.URLEncoding.DecodeString(cursor)
+	if err == nil {
+		err = json.Unmarshal(decoded, &values)
+	}
+	if err != nil || len(values) != len(orderFields) {
+		return nil, fmt.Errorf("The cursor is not valid for the given orderBy")
+	}
+	return values, nil
+}
+
+// SearchSpecs is func to search specs in the namespace by field predicates with multi-key ordering and pagination
+func SearchSpecs(nsId string, req SpecSearchReq) (SpecSearchResult, error) {
+	result := SpecSearchResult{Spec: []TbSpecInfo{}, Limit: req.Limit, Offset: req.Offset}
+
+	err := common.CheckString(nsId)
+	if err != nil {
+		common.CBLog.Error(err)
+		return result, err
+	}
+	if req.Limit < 0 || req.Offset < 0 {
+		return result, fmt.Errorf("The limit and offset should not be negative")
+	}
+
+	// ordering keys (Id is always added as the last key to make the order total for the cursor)
+	orderFields := []specSearchField{}
+	descending := []bool{}
+	hasId := false
+	for _, o := range req.OrderBy {
+		field, err := getSpecSearchField(o.Field)
+		if err != nil {
+			return result, err
+		}
+		switch strings.ToLower(o.Direction) {
+		case "", "ascending", "asc":
+			descending = append(descending, false)
+		case "descending", "desc":
+			descending = append(descending, true)
+		default:
+			return result, fmt.Errorf("'direction' should one of these: ascending, descending")
+		}
+		orderFields = append(orderFields, field)
+		if field.name == "Id" {
+			hasId = true
+		}
+	}
+	if !hasId {
+		field, _ := getSpecSearchField("Id")
+		orderFields = append(orderFields, field)
+		descending = append(descending, false)
+	}
+
+	// total count
+	countSession, err := applySpecSearchCondition(common.ORM.Where("Namespace = ?", nsId), req.Condition)
+	if err != nil {
+		return result, err
+	}
+	result.TotalCount, err = countSession.Count(new(TbSpecInfo))
+	if err != nil {
+		common.CBLog.Error(err)
+		return result, err
+	}
+
+	// page
+	session, err := applySpecSearchCondition(common.ORM.Where("Namespace = ?", nsId), req.Condition)
+	if err != nil {
+		return result, err
+	}
+	if req.Cursor != "" {
+		// keyset: (k1 > v1) OR (k1 = v1 AND k2 > v2) OR ...
+		values, err := decodeSpecSearchCursor(req.Cursor, orderFields)
+		if err != nil {
+			return result, err
+		}
+		clauses := []string{}
+		args := []interface{}{}
+		for i := range orderFields {
+			clause := []string{}
+			for j := 0; j < i; j++ {
+				clause = append(clause, "`"+orderFields[j].name+"` = ?")
+				args = append(args, values[j])
+			}
+			operator := " > ?"
+			if descending[i] {
+				operator = " < ?"
+			}
+			clause = append(clause, "`"+orderFields[i].name+"`"+operator)
+			args = append(args, values[i])
+			clauses = append(clauses, "("+strings.Join(clause, " AND ")+")")
+		}
+		session = session.And("("+strings.Join(clauses, " OR ")+")", args...)
+		result.Offset = 0
+	}
+	for i, f := range orderFields {
+		if descending[i] {
+			session = session.Desc(f.name)
+		} else {
+			session = session.Asc(f.name)
+		}
+	}
+	if req.Limit > 0 {
+		if req.Cursor != "" {
+			session = session.Limit(req.Limit)
+		} else {
+			session = session.Limit(req.Limit, req.Offset)
+		}
+	} else if req.Offset > 0 && req.Cursor == "" {
+		session = session.Limit(-1, req.Offset)
+	}
+
+	err = session.Find(&result.Spec)
+	if err != nil {
+		common.CBLog.Error(err)
+		return result, err
+	}
+
+	if req.Limit > 0 && len(result.Spec) == req.Limit {
+		result.NextCursor = encodeSpecSearchCursor(result.Spec[len(result.Spec)-1], orderFields)
+	}
+	return result, nil
+}
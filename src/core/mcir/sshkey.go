@@ -71,7 +71,7 @@ type TbSshKeyInfo struct {
 	Username             string            `json:"username"`
 	VerifiedUsername     string            `json:"verifiedUsername"`
 	PublicKey            string            `json:"publicKey"`
-	PrivateKey           string            `json:"privateKey"`
+	PrivateKey           string            `json:"privateKey" secret:"true"`
 	KeyValueList         []common.KeyValue `json:"keyValueList"`
 	AssociatedObjectList []string          `json:"associatedObjectList"`
 	IsAutoGenerated      bool              `json:"isAutoGenerated"`
@@ -0,0 +1,374 @@
+/*
+Copyright 2019 The Cloud-Barista Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package mcis is to manage multi-cloud infra service
+package mcis
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloud-barista/cb-tumblebug/src/core/common"
+	"github.com/cloud-barista/cb-tumblebug/src/core/mcir"
+)
+
+// Victim strategies for ScaleIn action
+const (
+	// AutoVictimNewest is const for "newest" victim strategy (default).
+	AutoVictimNewest string = "newest"
+
+	// AutoVictimOldest is const for "oldest" victim strategy.
+	AutoVictimOldest string = "oldest"
+
+	// AutoVictimHighestCost is const for "highestCost" victim strategy.
+	AutoVictimHighestCost string = "highestCost"
+)
+
+// getAutoActionLabel is func to get the label of VMs managed by AutoAction (default: AutoGen)
+func getAutoActionLabel(autoAction *AutoAction) string {
+	if autoAction.ScopeLabel != "" {
+		return autoAction.ScopeLabel
+	}
+	return labelAutoGen
+}
+
+// ValidateAutoAction is func to validate AutoAction
+func ValidateAutoAction(autoAction *AutoAction) error {
+	if autoAction.ActionType != AutoActionScaleOut && autoAction.ActionType != AutoActionScaleIn && autoAction.ActionType != AutoActionScaleTo {
+		return fmt.Errorf("The actionType (" + autoAction.ActionType + ") is not available. Use " + AutoActionScaleOut + ", " + AutoActionScaleIn + ", " + AutoActionScaleTo)
+	}
+	if autoAction.MinVmCount < 0 || autoAction.MaxVmCount < 0 {
+		return fmt.Errorf("The minVmCount and maxVmCount should not be negative")
+	}
+	if autoAction.MaxVmCount != 0 && autoAction.MinVmCount > autoAction.MaxVmCount {
+		return fmt.Errorf("The minVmCount (" + strconv.Itoa(autoAction.MinVmCount) + ") is greater than maxVmCount (" + strconv.Itoa(autoAction.MaxVmCount) + ")")
+	}
+	if _, err := getAutoStep(autoAction.StepSize, 0); err != nil {
+		return err
+	}
+	if autoAction.ScaleOutCooldownSec < 0 || autoAction.ScaleInCooldownSec < 0 {
+		return fmt.Errorf("The cooldown should not be negative")
+	}
+	switch autoAction.VictimStrategy {
+	case "", AutoVictimNewest, AutoVictimOldest, AutoVictimHighestCost:
+	default:
+		return fmt.Errorf("The victimStrategy (" + autoAction.VictimStrategy + ") is not available. Use " + AutoVictimNewest + ", " + AutoVictimOldest + ", " + AutoVictimHighestCost)
+	}
+	return validateAutoPlacement(autoAction)
+}
+
+// getAutoStep is func to get the number of VMs to add or remove by the step size (absolute "2" or percentage "20%")
+func getAutoStep(stepSize string, currentCount int) (int, error) {
+	stepSize = strings.TrimSpace(stepSize)
+	if stepSize == "" {
+		return 1, nil
+	}
+	if strings.HasSuffix(stepSize, "%") {
+		percent, err := strconv.ParseFloat(strings.TrimSuffix(stepSize, "%"), 64)
+		if err != nil || percent <= 0 {
+			return 0, fmt.Errorf("The stepSize (%s) should be a positive number or percentage", stepSize)
+		}
+		step := int(math.Ceil(float64(currentCount) * percent / 100))
+		if step < 1 {
+			step = 1
+		}
+		return step, nil
+	}
+	step, err := strconv.Atoi(stepSize)
+	if err != nil || step <= 0 {
+		return 0, fmt.Errorf("The stepSize (%s) should be a positive number or percentage", stepSize)
+	}
+	return step, nil
+}
+
+// getAutoScopeVmList is func to get VMs in the scope of AutoAction (VMs in ScopeVmGroupId and with ScopeLabel, all VMs in MCIS if both are empty)
+func getAutoScopeVmList(nsId string, mcisId string, autoAction *AutoAction) ([]string, error) {
+	if autoAction.ScopeVmGroupId == "" {
+		if autoAction.ScopeLabel == "" {
+			return ListVmId(nsId, mcisId)
+		}
+		return GetVmListByLabel(nsId, mcisId, autoAction.ScopeLabel)
+	}
+
+	vmList, err := ListVmId(nsId, mcisId)
+	if err != nil {
+		return nil, err
+	}
+	vmListInScope := []string{}
+	for _, vmId := range vmList {
+		vmObj, err := GetVmObject(nsId, mcisId, vmId)
+		if err != nil {
+			return nil, err
+		}
+		if vmObj.VmGroupId != autoAction.ScopeVmGroupId {
+			continue
+		}
+		if autoAction.ScopeLabel != "" && vmObj.Label != autoAction.ScopeLabel {
+			continue
+		}
+		vmListInScope = append(vmListInScope, vmObj.Id)
+	}
+	return vmListInScope, nil
+}
+
+// getAutoScopeVmCount is func to get the number of VMs in the scope of AutoAction
+func getAutoScopeVmCount(nsId string, mcisId string, autoAction *AutoAction) (int, error) {
+	vmList, err := getAutoScopeVmList(nsId, mcisId, autoAction)
+	return len(vmList), err
+}
+
+// updateVmGroupMember is func to add or remove a VM in the VM group object of MCIS
+func updateVmGroupMember(nsId string, mcisId string, vmGroupId string, vmId string, add bool) {
+	key := common.GenMcisVmGroupKey(nsId, mcisId, vmGroupId)
+	keyValue, err := common.CBStore.Get(key)
+	if err != nil || keyValue == nil {
+		fmt.Println("[updateVmGroupMember] Cannot find VM group " + vmGroupId)
+		return
+	}
+	vmGroupInfo := TbVmGroupInfo{}
+	json.Unmarshal([]byte(keyValue.Value), &vmGroupInfo)
+
+	vmIdList := []string{}
+	for _, v := range vmGroupInfo.VmId {
+		if v != vmId {
+			vmIdList = append(vmIdList, v)
+		}
+	}
+	if add {
+		vmIdList = append(vmIdList, vmId)
+	}
+	vmGroupInfo.VmId = vmIdList
+	vmGroupInfo.VmGroupSize = strconv.Itoa(len(vmIdList))
+
+	val, _ := json.Marshal(vmGroupInfo)
+	err = common.CBStore.Put(key, string(val))
+	if err != nil {
+		common.CBLog.Error(err)
+	}
+}
+
+// getAutoCooldownRemaining is func to get the remaining cooldown of AutoAction in the MCIS.
+// ScaleOut waits for ScaleOutCooldownSec after the last ScaleOut,
+// ScaleIn waits for ScaleInCooldownSec after the last scaling (ScaleOut or ScaleIn).
+func getAutoCooldownRemaining(mcisPolicy *McisPolicyInfo, autoAction *AutoAction) time.Duration {
+	var last time.Time
+	var cooldown time.Duration
+
+	lastScaleOut, _ := time.Parse(time.RFC3339, mcisPolicy.LastScaleOutTime)
+	lastScaleIn, _ := time.Parse(time.RFC3339, mcisPolicy.LastScaleInTime)
+
+	switch autoAction.ActionType {
+	case AutoActionScaleOut:
+		last = lastScaleOut
+		cooldown = time.Duration(autoAction.ScaleOutCooldownSec) * time.Second
+	case AutoActionScaleIn:
+		last = lastScaleOut
+		if lastScaleIn.After(last) {
+			last = lastScaleIn
+		}
+		cooldown = time.Duration(autoAction.ScaleInCooldownSec) * time.Second
+	}
+	if last.IsZero() {
+		return 0
+	}
+	remaining := cooldown - time.Since(last)
+	if remaining < 0 {
+		return 0
+	}
+	return remaining
+}
+
+// ScaleOutMcis is func to add VMs to MCIS by AutoAction (within maxVmCount). It returns the IDs of added VMs.
+func ScaleOutMcis(nsId string, mcisId string, autoAction *AutoAction) ([]string, error) {
+	addedVms := []string{}
+
+	currentCount, err := getAutoScopeVmCount(nsId, mcisId, autoAction)
+	if err != nil {
+		common.CBLog.Error(err)
+		return addedVms, err
+	}
+	step, err := getAutoStep(autoAction.StepSize, currentCount)
+	if err != nil {
+		return addedVms, err
+	}
+	if autoAction.MaxVmCount != 0 && currentCount+step > autoAction.MaxVmCount {
+		step = autoAction.MaxVmCount - currentCount
+	}
+	fmt.Printf("[ScaleOut] current: %d, step: %d, max: %d\n", currentCount, step, autoAction.MaxVmCount)
+	if step <= 0 {
+		return addedVms, nil
+	}
+	err = CheckBudgetForProvisioning(nsId)
+	if err != nil {
+		common.CBLog.Error(err)
+		return addedVms, err
+	}
+
+	label := getAutoActionLabel(autoAction)
+	// the number of VMs added to each connection in this action (for placement)
+	placed := map[string]int{}
+	for i := 0; i < step; i++ {
+		vmReq, err := getAutoPlacementVm(nsId, mcisId, autoAction, placed)
+		if err != nil {
+			common.CBLog.Error(err)
+			return addedVms, err
+		}
+		vmReq.Label = label
+		// keep the post command in the VM (to rerun on the replacement by the health policy)
+		vmReq.PostCommand = autoAction.PostCommand
+
+		common.PrintJsonPretty(vmReq)
+
+		// ScaleOut MCIS according to the VM requirement.
+		fmt.Println("[Generating VM]")
+		result, err := CorePostMcisVm(nsId, mcisId, &vmReq)
+		if err != nil {
+			common.CBLog.Error(err)
+			return addedVms, err
+		}
+		common.PrintJsonPretty(*result)
+		addedVms = append(addedVms, result.Id)
+		if vmReq.VmGroupId != "" {
+			updateVmGroupMember(nsId, mcisId, vmReq.VmGroupId, result.Id, true)
+		}
+
+		nullMcisCmdReq := McisCmdReq{}
+		if autoAction.PostCommand != nullMcisCmdReq {
+			fmt.Println("[Post Command to VM] " + autoAction.PostCommand.Command)
+			_, err := RemoteCommandToMcisVm(nsId, mcisId, result.Id, &autoAction.PostCommand)
+			if err != nil {
+				common.CBLog.Error(err)
+				return addedVms, err
+			}
+		}
+	}
+	return addedVms, nil
+}
+
+// ScaleInMcis is func to remove VMs from MCIS by AutoAction (within minVmCount). It returns the IDs of removed VMs.
+// Only VMs with the label of AutoAction (ScopeLabel or AutoGen) or VMs in ScopeVmGroupId can be removed.
+func ScaleInMcis(nsId string, mcisId string, autoAction *AutoAction) ([]string, error) {
+	removedVms := []string{}
+
+	currentCount, err := getAutoScopeVmCount(nsId, mcisId, autoAction)
+	if err != nil {
+		common.CBLog.Error(err)
+		return removedVms, err
+	}
+	step, err := getAutoStep(autoAction.StepSize, currentCount)
+	if err != nil {
+		return removedVms, err
+	}
+	if currentCount-step < autoAction.MinVmCount {
+		step = currentCount - autoAction.MinVmCount
+	}
+	fmt.Printf("[ScaleIn] current: %d, step: %d, min: %d\n", currentCount, step, autoAction.MinVmCount)
+	if step <= 0 {
+		return removedVms, nil
+	}
+
+	victims, err := getAutoVictims(nsId, mcisId, autoAction)
+	if err != nil {
+		common.CBLog.Error(err)
+		return removedVms, err
+	}
+	if len(victims) > step {
+		victims = victims[:step]
+	}
+
+	for _, vmId := range victims {
+		fmt.Println("[Removing VM ID] " + vmId)
+		err := DelMcisVm(nsId, mcisId, vmId, "")
+		if err != nil {
+			common.CBLog.Error(err)
+			return removedVms, err
+		}
+		removedVms = append(removedVms, vmId)
+		if autoAction.ScopeVmGroupId != "" {
+			updateVmGroupMember(nsId, mcisId, autoAction.ScopeVmGroupId, vmId, false)
+		}
+	}
+	return removedVms, nil
+}
+
+// getAutoVictims is func to get VMs to remove by ScaleIn in order of the victim strategy
+func getAutoVictims(nsId string, mcisId string, autoAction *AutoAction) ([]string, error) {
+	var vmList []string
+	var err error
+	if autoAction.ScopeVmGroupId != "" {
+		// any VM in the VM group (with ScopeLabel if given)
+		vmList, err = getAutoScopeVmList(nsId, mcisId, autoAction)
+	} else {
+		vmList, err = GetVmListByLabel(nsId, mcisId, getAutoActionLabel(autoAction))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	type victim struct {
+		id          string
+		createdTime string
+		costPerHour float32
+	}
+	victims := []victim{}
+	specIdList := []string{}
+	for _, vmId := range vmList {
+		vmObj, err := GetVmObject(nsId, mcisId, vmId)
+		if err != nil {
+			return nil, err
+		}
+		victims = append(victims, victim{id: vmObj.Id, createdTime: vmObj.CreatedTime})
+		specIdList = append(specIdList, vmObj.SpecId)
+	}
+	if autoAction.VictimStrategy == AutoVictimHighestCost {
+		costs := map[string]float32{}
+		for i, specId := range specIdList {
+			cost, ok := costs[specId]
+			if !ok {
+				specInfo, err := mcir.GetSpecWithPrice(nsId, specId)
+				if err != nil {
+					common.CBLog.Error(err)
+				}
+				cost = specInfo.CostPerHour
+				costs[specId] = cost
+			}
+			victims[i].costPerHour = cost
+		}
+	}
+
+	// CreatedTime (2006-01-02 15:04:05) is sortable as a string
+	sort.SliceStable(victims, func(i, j int) bool {
+		switch autoAction.VictimStrategy {
+		case AutoVictimOldest:
+			return victims[i].createdTime < victims[j].createdTime
+		case AutoVictimHighestCost:
+			if victims[i].costPerHour != victims[j].costPerHour {
+				return victims[i].costPerHour > victims[j].costPerHour
+			}
+			return victims[i].createdTime > victims[j].createdTime
+		default:
+			return victims[i].createdTime > victims[j].createdTime
+		}
+	})
+
+	result := []string{}
+	for _, v := range victims {
+		result = append(result, v.id)
+	}
+	return result, nil
+}
@@ -0,0 +1,414 @@
+/*
+Copyright 2019 The Cloud-Barista Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package mcis is to manage multi-cloud infra service
+package mcis
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Combinators for auto-control condition expression
+const (
+	// AutoCombinatorAnd is const for "and" combinator.
+	AutoCombinatorAnd string = "and"
+
+	// AutoCombinatorOr is const for "or" combinator.
+	AutoCombinatorOr string = "or"
+)
+
+// Aggregations for auto-control condition (to aggregate metric values across VMs in MCIS)
+const (
+	// AutoAggregationAvg is const for "avg" aggregation (default).
+	AutoAggregationAvg string = "avg"
+
+	// AutoAggregationMax is const for "max" aggregation.
+	AutoAggregationMax string = "max"
+
+	// AutoAggregationMin is const for "min" aggregation.
+	AutoAggregationMin string = "min"
+
+	// AutoAggregationP95 is const for "p95" aggregation.
+	AutoAggregationP95 string = "p95"
+)
+
+// AutoMetricPendingVm is const for the metric of the number of VMs in Creating status in MCIS
+const AutoMetricPendingVm string = "pendingVm"
+
+// autoConditionMaxDepth is the max depth of an auto-control condition expression tree
+const autoConditionMaxDepth int = 8
+
+// autoConditionMetrics are the metrics available for auto-control conditions
+var autoConditionMetrics = []string{monMetricCpu, monMetricMem, monMetricDisk, monMetricNet, monMetricLoad, AutoMetricPendingVm}
+
+// autoConditionOperators are the operators available for auto-control conditions
+var autoConditionOperators = []string{">=", ">", "<=", "<"}
+
+// AutoConditionExpr is struct for a node of MCIS auto-control condition expression tree.
+// A node is a combinator (with combinator and children) or a leaf (with metric, operator, operand).
+type AutoConditionExpr struct {
+	// Fields for a combinator node
+	Combinator string              `json:"combinator,omitempty" example:"and" enums:"and,or"`
+	Children   []AutoConditionExpr `json:"children,omitempty"`
+
+	// Fields for a leaf node
+	Metric           string   `json:"metric,omitempty" example:"cpu" enums:"cpu,mem,disk,net,load,pendingVm"`
+	Aggregation      string   `json:"aggregation,omitempty" example:"avg" enums:"avg,max,min,p95"` // aggregation of values across VMs (default: avg)
+	Operator         string   `json:"operator,omitempty" example:">"`                              // <, <=, >, >=
+	Operand          string   `json:"operand,omitempty" example:"80"`                              // 10, 70, 80, 98, ...
+	EvaluationPeriod string   `json:"evaluationPeriod,omitempty" example:"3"`                      // number of periods to evaluate (average of aggregated values)
+	EvaluationValue  []string `json:"evaluationValue,omitempty"`                                   // history of aggregated values (latest first)
+	Window           string   `json:"window,omitempty" example:"5m"`                               // use the average of retained samples of each VM in the window (instead of the latest values)
+}
+
+// AutoConditionLeafResult is struct for the evaluation result of a leaf of auto-control condition
+type AutoConditionLeafResult struct {
+	Metric      string  `json:"metric"`
+	Aggregation string  `json:"aggregation"`
+	Operator    string  `json:"operator"`
+	Operand     float64 `json:"operand"`
+	Value       float64 `json:"value"`  // average of aggregated values for the evaluation period
+	Filled      int     `json:"filled"` // number of values collected for the evaluation period
+	Period      int     `json:"period"`
+	Result      string  `json:"result"` // true, false, unknown (not enough data)
+}
+
+// String is func to get a log line of AutoConditionLeafResult
+func (r AutoConditionLeafResult) String() string {
+	return fmt.Sprintf("%s(%s)=%.2f %s %g [%d/%d] %s", r.Metric, r.Aggregation, r.Value, r.Operator, r.Operand, r.Filled, r.Period, r.Result)
+}
+
+// Three-valued results of auto-control condition evaluation
+const (
+	autoResultTrue    string = "true"
+	autoResultFalse   string = "false"
+	autoResultUnknown string = "unknown"
+)
+
+// getAutoConditionExpr is func to get the expression tree of AutoCondition (a single metric condition is converted to a leaf)
+func getAutoConditionExpr(condition *AutoCondition) *AutoConditionExpr {
+	if condition.Expression != nil {
+		return condition.Expression
+	}
+	return &AutoConditionExpr{
+		Metric:           condition.Metric,
+		Aggregation:      condition.Aggregation,
+		Operator:         condition.Operator,
+		Operand:          condition.Operand,
+		EvaluationPeriod: condition.EvaluationPeriod,
+		EvaluationValue:  condition.EvaluationValue,
+		Window:           condition.Window,
+	}
+}
+
+// ValidateAutoCondition is func to validate AutoCondition (a single metric condition or an expression tree)
+func ValidateAutoCondition(condition *AutoCondition) error {
+	return validateAutoConditionExpr(getAutoConditionExpr(condition), "autoCondition", 1)
+}
+
+func validateAutoConditionExpr(expr *AutoConditionExpr, path string, depth int) error {
+	if depth > autoConditionMaxDepth {
+		return fmt.Errorf("The condition " + path + " is deeper than " + strconv.Itoa(autoConditionMaxDepth) + " levels")
+	}
+
+	if expr.Combinator != "" {
+		combinator := strings.ToLower(expr.Combinator)
+		if combinator != AutoCombinatorAnd && combinator != AutoCombinatorOr {
+			return fmt.Errorf("The combinator of " + path + " (" + expr.Combinator + ") is not available. Use and, or")
+		}
+		if expr.Metric != "" {
+			return fmt.Errorf("The condition " + path + " cannot have both combinator and metric")
+		}
+		if len(expr.Children) == 0 {
+			return fmt.Errorf("The combinator of " + path + " has no children")
+		}
+		for i := range expr.Children {
+			err := validateAutoConditionExpr(&expr.Children[i], path+".children["+strconv.Itoa(i)+"]", depth+1)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if len(expr.Children) != 0 {
+		return fmt.Errorf("The condition " + path + " has children without combinator")
+	}
+	if !contains(autoConditionMetrics, expr.Metric) {
+		return fmt.Errorf("The metric of " + path + " (" + expr.Metric + ") is not available. Use " + strings.Join(autoConditionMetrics, ", "))
+	}
+	aggregation := strings.ToLower(expr.Aggregation)
+	if aggregation != "" && aggregation != AutoAggregationAvg && aggregation != AutoAggregationMax && aggregation != AutoAggregationMin && aggregation != AutoAggregationP95 {
+		return fmt.Errorf("The aggregation of " + path + " (" + expr.Aggregation + ") is not available. Use avg, max, min, p95")
+	}
+	if !contains(autoConditionOperators, expr.Operator) {
+		return fmt.Errorf("The operator of " + path + " (" + expr.Operator + ") is not available. Use " + strings.Join(autoConditionOperators, ", "))
+	}
+	if _, err := strconv.ParseFloat(expr.Operand, 64); err != nil {
+		return fmt.Errorf("The operand of " + path + " (" + expr.Operand + ") is not a number")
+	}
+	period, err := strconv.Atoi(expr.EvaluationPeriod)
+	if err != nil || period <= 0 {
+		return fmt.Errorf("The evaluationPeriod of " + path + " (" + expr.EvaluationPeriod + ") should be a positive integer")
+	}
+	if expr.Window != "" {
+		window, err := parseMonDuration(expr.Window)
+		maxWindow := time.Duration(monRetentionTiers[len(monRetentionTiers)-1].retention) * time.Second
+		if err != nil || window <= 0 || window > maxWindow {
+			return fmt.Errorf("The window of " + path + " (" + expr.Window + ") should be a duration (5m) within the retention (" + maxWindow.String() + ")")
+		}
+		if expr.Metric == AutoMetricPendingVm {
+			return fmt.Errorf("The window of " + path + " is not available for " + AutoMetricPendingVm)
+		}
+	}
+	return nil
+}
+
+// aggregateValues is func to aggregate metric values across VMs
+func aggregateValues(values []float64, aggregation string) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	sorted := append([]float64{}, values...)
+	sort.Float64s(sorted)
+
+	switch strings.ToLower(aggregation) {
+	case AutoAggregationMax:
+		return sorted[len(sorted)-1]
+	case AutoAggregationMin:
+		return sorted[0]
+	case AutoAggregationP95:
+		// nearest-rank percentile
+		rank := int(math.Ceil(0.95*float64(len(sorted)))) - 1
+		if rank < 0 {
+			rank = 0
+		}
+		return sorted[rank]
+	default:
+		sum := 0.0
+		for _, v := range sorted {
+			sum += v
+		}
+		return sum / float64(len(sorted))
+	}
+}
+
+// compareAutoOperand is func to compare a value with an operand by the operator
+func compareAutoOperand(value float64, operator string, operand float64) bool {
+	switch operator {
+	case ">=":
+		return value >= operand
+	case ">":
+		return value > operand
+	case "<=":
+		return value <= operand
+	case "<":
+		return value < operand
+	}
+	return false
+}
+
+// autoMetricCollector is struct to collect metric values of VMs in MCIS once for each evaluation
+type autoMetricCollector struct {
+	nsId   string
+	mcisId string
+	source *MetricsSource
+	values map[string][]float64
+}
+
+// getValues is func to get metric values of VMs in MCIS (cached for an evaluation).
+// With window, the values are the averages of retained samples of VMs in the window.
+func (c *autoMetricCollector) getValues(metric string, window string) ([]float64, error) {
+	if window != "" {
+		if values, ok := c.values[metric+"/"+window]; ok {
+			return values, nil
+		}
+		// collect the latest values (to be retained) before reading the window
+		if _, err := c.getValues(metric, ""); err != nil {
+			return nil, err
+		}
+		d, _ := parseMonDuration(window)
+		values, err := getVmMonitoringWindowValues(c.nsId, c.mcisId, metric, d)
+		if err != nil {
+			return nil, err
+		}
+		c.values[metric+"/"+window] = values
+		return values, nil
+	}
+	if values, ok := c.values[metric]; ok {
+		return values, nil
+	}
+
+	values := []float64{}
+	if metric == AutoMetricPendingVm {
+		vmList, err := ListVmId(c.nsId, c.mcisId)
+		if err != nil {
+			return nil, err
+		}
+		pending := 0
+		for _, vmId := range vmList {
+			vmObj, err := GetVmObject(c.nsId, c.mcisId, vmId)
+			if err == nil && vmObj.Status == StatusCreating {
+				pending++
+			}
+		}
+		values = append(values, float64(pending))
+	} else {
+		content, err := GetMcisMetricBySource(c.nsId, c.mcisId, metric, c.source)
+		if err != nil {
+			return nil, err
+		}
+		for _, monData := range content.McisMonitoring {
+			if monData.Err != "" {
+				continue
+			}
+			monDataValue, err := strconv.ParseFloat(monData.Value, 64)
+			if err != nil {
+				continue
+			}
+			values = append(values, monDataValue)
+		}
+	}
+	c.values[metric] = values
+	return values, nil
+}
+
+// EvaluateAutoCondition is func to evaluate AutoCondition with the current metrics of MCIS (from MetricsSource).
+// It returns "true", "false" or "unknown" (not enough evaluation data) with the results of leaves.
+func EvaluateAutoCondition(nsId string, mcisId string, condition *AutoCondition, source *MetricsSource) (string, []AutoConditionLeafResult, error) {
+	collector := &autoMetricCollector{nsId: nsId, mcisId: mcisId, source: source, values: map[string][]float64{}}
+	expr := getAutoConditionExpr(condition)
+
+	leafResults := []AutoConditionLeafResult{}
+	result, err := evaluateAutoConditionExpr(expr, collector, &leafResults)
+
+	if condition.Expression == nil {
+		// keep the history of a single metric condition in the original fields
+		condition.EvaluationValue = expr.EvaluationValue
+	}
+	return result, leafResults, err
+}
+
+func evaluateAutoConditionExpr(expr *AutoConditionExpr, collector *autoMetricCollector, leafResults *[]AutoConditionLeafResult) (string, error) {
+	if expr.Combinator != "" {
+		// evaluate all children (without short-circuit) to keep the history of every leaf
+		childResults := []string{}
+		for i := range expr.Children {
+			childResult, err := evaluateAutoConditionExpr(&expr.Children[i], collector, leafResults)
+			if err != nil {
+				return autoResultUnknown, err
+			}
+			childResults = append(childResults, childResult)
+		}
+		return combineAutoResults(expr.Combinator, childResults), nil
+	}
+
+	aggregation := strings.ToLower(expr.Aggregation)
+	if aggregation == "" {
+		aggregation = AutoAggregationAvg
+	}
+	period, _ := strconv.Atoi(expr.EvaluationPeriod)
+	operand, _ := strconv.ParseFloat(expr.Operand, 64)
+	leafResult := AutoConditionLeafResult{Metric: expr.Metric, Aggregation: aggregation, Operator: expr.Operator, Operand: operand, Period: period, Result: autoResultUnknown}
+
+	values, err := collector.getValues(expr.Metric, expr.Window)
+	if err != nil {
+		return autoResultUnknown, err
+	}
+
+	if len(values) != 0 {
+		current := aggregateValues(values, aggregation)
+		expr.EvaluationValue = append([]string{fmt.Sprintf("%f", current)}, expr.EvaluationValue...) // prepend current value
+	}
+	if len(expr.EvaluationValue) > period {
+		expr.EvaluationValue = expr.EvaluationValue[:period]
+	}
+
+	leafResult.Filled = len(expr.EvaluationValue)
+	if period > 0 && len(expr.EvaluationValue) >= period {
+		sum := 0.0
+		for _, v := range expr.EvaluationValue {
+			f, _ := strconv.ParseFloat(v, 64)
+			sum += f
+		}
+		leafResult.Value = sum / float64(period)
+		if compareAutoOperand(leafResult.Value, expr.Operator, operand) {
+			leafResult.Result = autoResultTrue
+		} else {
+			leafResult.Result = autoResultFalse
+		}
+	}
+
+	fmt.Println("[Evaluation] " + leafResult.String())
+	*leafResults = append(*leafResults, leafResult)
+	return leafResult.Result, nil
+}
+
+// combineAutoResults is func to combine three-valued results of children by the combinator (and, or)
+func combineAutoResults(combinator string, results []string) string {
+	isAnd := strings.ToLower(combinator) == AutoCombinatorAnd
+	unknown := false
+	decided := false
+	for _, result := range results {
+		switch {
+		case result == autoResultUnknown:
+			unknown = true
+		case isAnd && result == autoResultFalse:
+			decided = true
+		case !isAnd && result == autoResultTrue:
+			decided = true
+		}
+	}
+	switch {
+	case decided && isAnd:
+		return autoResultFalse
+	case decided:
+		return autoResultTrue
+	case unknown:
+		return autoResultUnknown
+	case isAnd:
+		return autoResultTrue
+	default:
+		return autoResultFalse
+	}
+}
+
+// resetAutoConditionHistory is func to clear the evaluation history of AutoCondition (to stabilize MCIS after an action)
+func resetAutoConditionHistory(condition *AutoCondition) {
+	condition.EvaluationValue = nil
+	if condition.Expression != nil {
+		resetAutoConditionExprHistory(condition.Expression)
+	}
+}
+
+func resetAutoConditionExprHistory(expr *AutoConditionExpr) {
+	expr.EvaluationValue = nil
+	for i := range expr.Children {
+		resetAutoConditionExprHistory(&expr.Children[i])
+	}
+}
+
+// contains is func to check whether the list has the value
+func contains(list []string, value string) bool {
+	for _, v := range list {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,184 @@
+/*
+Copyright 2019 The Cloud-Barista Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package mcis is to manage multi-cloud infra service
+package mcis
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCombineAutoResults(t *testing.T) {
+	T, F, U := autoResultTrue, autoResultFalse, autoResultUnknown
+	testCases := []struct {
+		combinator string
+		results    []string
+		expected   string
+	}{
+		{AutoCombinatorAnd, []string{T, T}, T},
+		{AutoCombinatorAnd, []string{T, F}, F},
+		{AutoCombinatorAnd, []string{T, U}, U},
+		{AutoCombinatorAnd, []string{F, U}, F},
+		{AutoCombinatorAnd, []string{U, U}, U},
+		{AutoCombinatorAnd, []string{T}, T},
+		{AutoCombinatorOr, []string{F, F}, F},
+		{AutoCombinatorOr, []string{T, F}, T},
+		{AutoCombinatorOr, []string{F, U}, U},
+		{AutoCombinatorOr, []string{T, U}, T},
+		{AutoCombinatorOr, []string{U, U}, U},
+		{"AND", []string{T, F}, F},
+		{"OR", []string{T, F}, T},
+	}
+
+	for _, tc := range testCases {
+		result := combineAutoResults(tc.combinator, tc.results)
+		assert.Equal(t, tc.expected, result, "%s %v", tc.combinator, tc.results)
+	}
+}
+
+func TestAggregateValues(t *testing.T) {
+	values := []float64{10, 50, 30, 90, 20}
+	testCases := []struct {
+		aggregation string
+		expected    float64
+	}{
+		{AutoAggregationAvg, 40},
+		{"", 40},
+		{AutoAggregationMax, 90},
+		{AutoAggregationMin, 10},
+		{AutoAggregationP95, 90},
+		{"MAX", 90},
+	}
+	for _, tc := range testCases {
+		assert.Equal(t, tc.expected, aggregateValues(values, tc.aggregation), tc.aggregation)
+	}
+	assert.Equal(t, 0.0, aggregateValues(nil, AutoAggregationAvg))
+	// values are not sorted in place
+	assert.Equal(t, []float64{10, 50, 30, 90, 20}, values)
+}
+
+func TestValidateAutoCondition(t *testing.T) {
+	leaf := func(metric string, operator string, operand string, period string) AutoConditionExpr {
+		return AutoConditionExpr{Metric: metric, Operator: operator, Operand: operand, EvaluationPeriod: period}
+	}
+	deep := leaf(monMetricCpu, ">", "80", "1")
+	for i := 0; i < autoConditionMaxDepth; i++ {
+		deep = AutoConditionExpr{Combinator: AutoCombinatorAnd, Children: []AutoConditionExpr{deep}}
+	}
+
+	testCases := []struct {
+		name      string
+		condition AutoCondition
+		isErr     bool
+	}{
+		{name: "single metric", condition: AutoCondition{Metric: monMetricCpu, Operator: ">=", Operand: "80", EvaluationPeriod: "3"}},
+		{name: "tree", condition: AutoCondition{Expression: &AutoConditionExpr{Combinator: AutoCombinatorOr, Children: []AutoConditionExpr{
+			leaf(monMetricCpu, ">", "80", "3"),
+			{Combinator: AutoCombinatorAnd, Children: []AutoConditionExpr{leaf(monMetricMem, ">", "70", "2"), leaf(AutoMetricPendingVm, "<", "1", "1")}},
+		}}}},
+		{name: "unknown metric", condition: AutoCondition{Metric: "gpu", Operator: ">", Operand: "80", EvaluationPeriod: "3"}, isErr: true},
+		{name: "unknown operator", condition: AutoCondition{Metric: monMetricCpu, Operator: "==", Operand: "80", EvaluationPeriod: "3"}, isErr: true},
+		{name: "operand not a number", condition: AutoCondition{Metric: monMetricCpu, Operator: ">", Operand: "high", EvaluationPeriod: "3"}, isErr: true},
+		{name: "zero period", condition: AutoCondition{Metric: monMetricCpu, Operator: ">", Operand: "80", EvaluationPeriod: "0"}, isErr: true},
+		{name: "unknown aggregation", condition: AutoCondition{Metric: monMetricCpu, Aggregation: "sum", Operator: ">", Operand: "80", EvaluationPeriod: "3"}, isErr: true},
+		{name: "unknown combinator", condition: AutoCondition{Expression: &AutoConditionExpr{Combinator: "xor", Children: []AutoConditionExpr{leaf(monMetricCpu, ">", "80", "3")}}}, isErr: true},
+		{name: "combinator without children", condition: AutoCondition{Expression: &AutoConditionExpr{Combinator: AutoCombinatorAnd}}, isErr: true},
+		{name: "combinator with metric", condition: AutoCondition{Expression: &AutoConditionExpr{Combinator: AutoCombinatorAnd, Metric: monMetricCpu, Children: []AutoConditionExpr{leaf(monMetricCpu, ">", "80", "3")}}}, isErr: true},
+		{name: "invalid child", condition: AutoCondition{Expression: &AutoConditionExpr{Combinator: AutoCombinatorAnd, Children: []AutoConditionExpr{leaf(monMetricCpu, ">", "80", "x")}}}, isErr: true},
+		{name: "too deep", condition: AutoCondition{Expression: &deep}, isErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateAutoCondition(&tc.condition)
+			if tc.isErr {
+				assert.NotNil(t, err)
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestEvaluateAutoConditionExpr(t *testing.T) {
+	// (cpu(avg) > 80 for 2 periods) or (mem(max) > 70 and pendingVm < 1)
+	expr := AutoConditionExpr{Combinator: AutoCombinatorOr, Children: []AutoConditionExpr{
+		{Metric: monMetricCpu, Operator: ">", Operand: "80", EvaluationPeriod: "2"},
+		{Combinator: AutoCombinatorAnd, Children: []AutoConditionExpr{
+			{Metric: monMetricMem, Aggregation: AutoAggregationMax, Operator: ">", Operand: "70", EvaluationPeriod: "1"},
+			{Metric: AutoMetricPendingVm, Operator: "<", Operand: "1", EvaluationPeriod: "1"},
+		}},
+	}}
+
+	testCases := []struct {
+		name     string
+		values   map[string][]float64
+		expected string
+		leaves   []string
+	}{
+		{
+			// cpu needs 2 periods, and mem is not high
+			name:     "unknown until the period is filled",
+			values:   map[string][]float64{monMetricCpu: {90, 100}, monMetricMem: {10, 20}, AutoMetricPendingVm: {0}},
+			expected: autoResultUnknown,
+			leaves:   []string{autoResultUnknown, autoResultFalse, autoResultTrue},
+		},
+		{
+			name:     "true when cpu is high for the period",
+			values:   map[string][]float64{monMetricCpu: {80, 90}, monMetricMem: {10, 20}, AutoMetricPendingVm: {0}},
+			expected: autoResultTrue,
+			leaves:   []string{autoResultTrue, autoResultFalse, autoResultTrue},
+		},
+		{
+			// average of the history (10, 85) is not high
+			name:     "false when cpu drops",
+			values:   map[string][]float64{monMetricCpu: {10}, monMetricMem: {10, 80}, AutoMetricPendingVm: {1}},
+			expected: autoResultFalse,
+			leaves:   []string{autoResultFalse, autoResultTrue, autoResultFalse},
+		},
+		{
+			name:     "true by the other branch",
+			values:   map[string][]float64{monMetricCpu: {10}, monMetricMem: {10, 80}, AutoMetricPendingVm: {0}},
+			expected: autoResultTrue,
+			leaves:   []string{autoResultFalse, autoResultTrue, autoResultTrue},
+		},
+		{
+			// no value is collected for mem, and its history (period 1) still decides
+			name:     "history is kept when no value is collected",
+			values:   map[string][]float64{monMetricCpu: {10}, monMetricMem: {}, AutoMetricPendingVm: {0}},
+			expected: autoResultTrue,
+			leaves:   []string{autoResultFalse, autoResultTrue, autoResultTrue},
+		},
+	}
+
+	// the cases are evaluated in order since the history of each leaf is kept in the expression
+	for _, tc := range testCases {
+		collector := &autoMetricCollector{values: tc.values}
+		leafResults := []AutoConditionLeafResult{}
+		result, err := evaluateAutoConditionExpr(&expr, collector, &leafResults)
+		assert.Nil(t, err, tc.name)
+		assert.Equal(t, tc.expected, result, tc.name)
+		leaves := []string{}
+		for _, v := range leafResults {
+			leaves = append(leaves, v.Result)
+		}
+		assert.Equal(t, tc.leaves, leaves, tc.name)
+	}
+
+	assert.Equal(t, 2, len(expr.Children[0].EvaluationValue))
+	resetAutoConditionExprHistory(&expr)
+	assert.Equal(t, 0, len(expr.Children[0].EvaluationValue))
+	assert.Equal(t, 0, len(expr.Children[1].Children[0].EvaluationValue))
+}
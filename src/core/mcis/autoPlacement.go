@@ -0,0 +1,272 @@
+/*
+Copyright 2019 The Cloud-Barista Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package mcis is to manage multi-cloud infra service
+package mcis
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloud-barista/cb-tumblebug/src/core/common"
+	"github.com/cloud-barista/cb-tumblebug/src/core/mcir"
+)
+
+// Placement algorithms for ScaleOut action
+const (
+	// AutoPlacementRandom is const for cloning a random VM in MCIS.
+	AutoPlacementRandom string = "random"
+
+	// AutoPlacementSpread is const for spreading VMs evenly across connections (regions).
+	AutoPlacementSpread string = "spread"
+
+	// AutoPlacementCheapest is const for filling the connection with the cheapest spec (CostPerHour) first.
+	AutoPlacementCheapest string = "cheapest"
+
+	// AutoPlacementClosest is const for the connection closest to PlacementLocation.
+	AutoPlacementClosest string = "closest"
+
+	// AutoPlacementBenchmark is const for choosing a connection randomly weighted by the benchmark score of spec.
+	AutoPlacementBenchmark string = "benchmark"
+)
+
+// autoPlacementCandidate is struct for a candidate connection to place a new VM
+type autoPlacementCandidate struct {
+	connectionName string
+	vmList         []string // VMs in the connection (to clone)
+	vmCount        int      // VMs in the scope of AutoAction (including VMs added in this action)
+	costPerHour    float32
+	score          float32 // benchmark score of spec (EvaluationScore01)
+	distance       float64 // km from PlacementLocation
+}
+
+// parsePlacementLocation is func to parse the geo point of PlacementLocation ("latitude/longitude")
+func parsePlacementLocation(location string) (float64, float64, error) {
+	slice := strings.Split(location, "/")
+	if len(slice) != 2 {
+		return 0, 0, fmt.Errorf("The placementLocation (" + location + ") should be latitude/longitude (ex: 37.5665/126.9780)")
+	}
+	latitude, err := strconv.ParseFloat(strings.ReplaceAll(slice[0], " ", ""), 64)
+	if err != nil || latitude < -90 || latitude > 90 {
+		return 0, 0, fmt.Errorf("The latitude of placementLocation (" + location + ") is not valid")
+	}
+	longitude, err := strconv.ParseFloat(strings.ReplaceAll(slice[1], " ", ""), 64)
+	if err != nil || longitude < -180 || longitude > 180 {
+		return 0, 0, fmt.Errorf("The longitude of placementLocation (" + location + ") is not valid")
+	}
+	return latitude, longitude, nil
+}
+
+// validateAutoPlacement is func to validate the placement algorithm of AutoAction
+func validateAutoPlacement(autoAction *AutoAction) error {
+	switch autoAction.PlacementAlgo {
+	case "", AutoPlacementRandom, AutoPlacementSpread, AutoPlacementCheapest, AutoPlacementBenchmark:
+	case AutoPlacementClosest:
+		_, _, err := parsePlacementLocation(autoAction.PlacementLocation)
+		return err
+	default:
+		return fmt.Errorf("The placementAlgo (" + autoAction.PlacementAlgo + ") is not available. Use " +
+			strings.Join([]string{AutoPlacementRandom, AutoPlacementSpread, AutoPlacementCheapest, AutoPlacementClosest, AutoPlacementBenchmark}, ", "))
+	}
+	return nil
+}
+
+// getAutoPlacementCandidates is func to get candidate connections from VMs (templates) with the number of VMs in scope
+func getAutoPlacementCandidates(nsId string, mcisId string, templateVmList []string, scopeVmList []string, autoAction *AutoAction) ([]autoPlacementCandidate, error) {
+	candidateMap := map[string]*autoPlacementCandidate{}
+	candidates := []*autoPlacementCandidate{}
+
+	for _, vmId := range templateVmList {
+		vmObj, err := GetVmObject(nsId, mcisId, vmId)
+		if err != nil {
+			return nil, err
+		}
+		candidate, ok := candidateMap[vmObj.ConnectionName]
+		if !ok {
+			candidate = &autoPlacementCandidate{connectionName: vmObj.ConnectionName}
+			candidateMap[vmObj.ConnectionName] = candidate
+			candidates = append(candidates, candidate)
+
+			specInfo, err := mcir.GetSpecWithPrice(nsId, vmObj.SpecId)
+			if err != nil {
+				common.CBLog.Error(err)
+			}
+			candidate.costPerHour = specInfo.CostPerHour
+			candidate.score = specInfo.EvaluationScore01
+			if autoAction.PlacementAlgo == AutoPlacementClosest {
+				latitude, longitude, _ := parsePlacementLocation(autoAction.PlacementLocation)
+				candidate.distance, err = getDistance(latitude, longitude, vmObj.ConnectionName)
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+		candidate.vmList = append(candidate.vmList, vmId)
+	}
+
+	for _, vmId := range scopeVmList {
+		vmObj, err := GetVmObject(nsId, mcisId, vmId)
+		if err != nil {
+			return nil, err
+		}
+		if candidate, ok := candidateMap[vmObj.ConnectionName]; ok {
+			candidate.vmCount++
+		}
+	}
+
+	result := []autoPlacementCandidate{}
+	for _, candidate := range candidates {
+		result = append(result, *candidate)
+	}
+	// in order of connection name (for stable results)
+	sort.SliceStable(result, func(i, j int) bool { return result[i].connectionName < result[j].connectionName })
+	return result, nil
+}
+
+// selectAutoPlacementCandidate is func to select a candidate connection by the placement algorithm
+func selectAutoPlacementCandidate(algo string, candidates []autoPlacementCandidate) int {
+	selected := 0
+	switch algo {
+	case AutoPlacementSpread:
+		for i := range candidates {
+			if candidates[i].vmCount < candidates[selected].vmCount {
+				selected = i
+			}
+		}
+	case AutoPlacementCheapest:
+		// a connection without price (costPerHour 0) is not regarded as free but selected last
+		for i := range candidates {
+			cost, selectedCost := candidates[i].costPerHour, candidates[selected].costPerHour
+			if cost > 0 && (selectedCost <= 0 || cost < selectedCost) {
+				selected = i
+			}
+		}
+	case AutoPlacementClosest:
+		for i := range candidates {
+			if candidates[i].distance < candidates[selected].distance {
+				selected = i
+			}
+		}
+	case AutoPlacementBenchmark:
+		total := float32(0)
+		for i := range candidates {
+			if candidates[i].score > 0 {
+				total += candidates[i].score
+			}
+		}
+		random := rand.New(rand.NewSource(time.Now().UnixNano()))
+		if total == 0 {
+			// no benchmark score, so all connections have the same weight
+			return random.Intn(len(candidates))
+		}
+		pick := random.Float32() * total
+		for i := range candidates {
+			if candidates[i].score <= 0 {
+				continue
+			}
+			selected = i
+			pick -= candidates[i].score
+			if pick < 0 {
+				break
+			}
+		}
+	}
+	return selected
+}
+
+// getAutoPlacementVm is func to get the VM requirement to add by ScaleOut according to the placement algorithm.
+// With ScopeVmGroupId, the VM is cloned from VMs in the VM group. Otherwise, from VMs in MCIS.
+// placed is the number of VMs added to each connection in this action (updated by the selection).
+func getAutoPlacementVm(nsId string, mcisId string, autoAction *AutoAction, placed map[string]int) (TbVmInfo, error) {
+	algo := autoAction.PlacementAlgo
+	if algo == "" && autoAction.ScopeVmGroupId == "" && autoAction.Vm.SpecId != "" {
+		// the VM given in AutoAction
+		vmReq := autoAction.Vm
+		vmReq.Name = autoAction.Vm.Name + "-" + common.GenUid()
+		return vmReq, nil
+	}
+
+	scopeVmList, err := getAutoScopeVmList(nsId, mcisId, autoAction)
+	if err != nil {
+		return TbVmInfo{}, err
+	}
+	templateVmList := scopeVmList
+	if autoAction.ScopeVmGroupId == "" {
+		templateVmList, err = ListVmId(nsId, mcisId)
+		if err != nil {
+			return TbVmInfo{}, err
+		}
+	}
+
+	var vmReq TbVmInfo
+	if algo == "" || algo == AutoPlacementRandom {
+		vmReq, err = getVmTemplateFromList(nsId, mcisId, templateVmList)
+		if err != nil {
+			return TbVmInfo{}, err
+		}
+	} else {
+		candidates, err := getAutoPlacementCandidates(nsId, mcisId, templateVmList, scopeVmList, autoAction)
+		if err != nil {
+			return TbVmInfo{}, err
+		}
+		if len(candidates) == 0 {
+			return TbVmInfo{}, fmt.Errorf("No VM to place by " + algo + " in the scope of autoAction")
+		}
+		for i := range candidates {
+			candidates[i].vmCount += placed[candidates[i].connectionName]
+		}
+		selected := candidates[selectAutoPlacementCandidate(algo, candidates)]
+		fmt.Printf("[Placement] %s: %s (VMs: %d, cost: %g, score: %g, distance: %.1f km)\n", algo, selected.connectionName, selected.vmCount, selected.costPerHour, selected.score, selected.distance)
+
+		vmReq, err = getVmTemplateFromList(nsId, mcisId, selected.vmList)
+		if err != nil {
+			return TbVmInfo{}, err
+		}
+	}
+	if vmReq.SpecId == "" && autoAction.ScopeVmGroupId != "" {
+		// no VM in the VM group yet, so use the VM given in AutoAction
+		vmReq = autoAction.Vm
+	}
+	if vmReq.SpecId == "" {
+		return TbVmInfo{}, fmt.Errorf("No VM template in the scope of autoAction. Specify vm of autoAction.")
+	}
+	placed[vmReq.ConnectionName]++
+
+	switch {
+	case autoAction.ScopeVmGroupId != "":
+		vmReq.Name = autoAction.ScopeVmGroupId + "-" + common.GenUid()
+		vmReq.VmGroupId = autoAction.ScopeVmGroupId
+	case algo == "" || algo == AutoPlacementRandom:
+		vmReq.Name = vmReq.Name + "-Random-" + common.GenUid()
+	default:
+		vmReq.Name = vmReq.Name + "-" + common.GenUid()
+	}
+	return vmReq, nil
+}
+
+// getVmPlacement is func to get the placement (connection) of VMs for the action history
+func getVmPlacement(nsId string, mcisId string, vmList []string) []string {
+	placement := []string{}
+	for _, vmId := range vmList {
+		vmObj, err := GetVmObject(nsId, mcisId, vmId)
+		if err != nil {
+			continue
+		}
+		placement = append(placement, vmId+": "+vmObj.ConnectionName)
+	}
+	return placement
+}
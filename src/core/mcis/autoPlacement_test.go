@@ -0,0 +1,95 @@
+/*
+Copyright 2019 The Cloud-Barista Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package mcis is to manage multi-cloud infra service
+package mcis
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSelectAutoPlacementCandidate(t *testing.T) {
+	testCases := []struct {
+		name       string
+		algo       string
+		candidates []autoPlacementCandidate
+		expected   string
+	}{
+		{
+			name: "spread",
+			algo: AutoPlacementSpread,
+			candidates: []autoPlacementCandidate{
+				{connectionName: "aws", vmCount: 3},
+				{connectionName: "azure", vmCount: 1},
+				{connectionName: "gcp", vmCount: 2},
+			},
+			expected: "azure",
+		},
+		{
+			name: "cheapest",
+			algo: AutoPlacementCheapest,
+			candidates: []autoPlacementCandidate{
+				{connectionName: "aws", costPerHour: 0.2},
+				{connectionName: "azure", costPerHour: 0.1},
+				{connectionName: "gcp", costPerHour: 0.3},
+			},
+			expected: "azure",
+		},
+		{
+			name: "cheapest ranks unpriced last",
+			algo: AutoPlacementCheapest,
+			candidates: []autoPlacementCandidate{
+				{connectionName: "aws", costPerHour: 0},
+				{connectionName: "azure", costPerHour: 0.3},
+				{connectionName: "gcp", costPerHour: 0},
+			},
+			expected: "azure",
+		},
+		{
+			name: "cheapest without any price",
+			algo: AutoPlacementCheapest,
+			candidates: []autoPlacementCandidate{
+				{connectionName: "aws"},
+				{connectionName: "azure"},
+			},
+			expected: "aws",
+		},
+		{
+			name: "closest",
+			algo: AutoPlacementClosest,
+			candidates: []autoPlacementCandidate{
+				{connectionName: "aws", distance: 8000},
+				{connectionName: "azure", distance: 300},
+			},
+			expected: "azure",
+		},
+		{
+			name: "benchmark picks only scored connections",
+			algo: AutoPlacementBenchmark,
+			candidates: []autoPlacementCandidate{
+				{connectionName: "aws", score: 0},
+				{connectionName: "azure", score: 10},
+			},
+			expected: "azure",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			selected := selectAutoPlacementCandidate(tc.algo, tc.candidates)
+			assert.Equal(t, tc.expected, tc.candidates[selected].connectionName)
+		})
+	}
+}
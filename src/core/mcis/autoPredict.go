@@ -0,0 +1,362 @@
+/*
+Copyright 2019 The Cloud-Barista Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package mcis is to manage multi-cloud infra service
+package mcis
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Models for predictive auto-control
+const (
+	// AutoPredictModelHoltWinters is const for additive Holt-Winters model (seasonal with level and trend).
+	AutoPredictModelHoltWinters string = "holtWinters"
+
+	// AutoPredictModelLastWeek is const for same-hour-last-week baseline model.
+	AutoPredictModelLastWeek string = "lastWeek"
+)
+
+// Parameters of Holt-Winters model (smoothing factors of level, trend and season)
+const (
+	holtWintersAlpha float64 = 0.5
+	holtWintersBeta  float64 = 0.1
+	holtWintersGamma float64 = 0.3
+)
+
+// autoPredictBacktestCount is the number of latest buckets to measure the accuracy of forecasts
+const autoPredictBacktestCount int = 48
+
+// autoPredictTier is the tier of retained monitoring samples for forecasts (1 hour buckets for 30 days)
+var autoPredictTier = len(monRetentionTiers) - 1
+
+// AutoPredict is struct for predictive MCIS auto-control.
+// The condition is evaluated with the forecasted metric values at LeadTimeMin later,
+// so that MCIS is scaled out ahead of the forecasted load.
+type AutoPredict struct {
+	Model        string `json:"model" example:"holtWinters" enums:"holtWinters,lastWeek"`
+	SeasonLength int    `json:"seasonLength,omitempty" example:"24"` // length of season in hours for holtWinters (default: 24)
+	LeadTimeMin  int    `json:"leadTimeMin,omitempty" example:"60"`  // how early to act before the forecasted load (default: 60)
+
+	// ActionEnabled is to act on forecasts. If false, forecasts are only recorded in the policy history (to evaluate them first).
+	ActionEnabled bool `json:"actionEnabled"`
+}
+
+// McisMetricForecast is struct for forecasts of a metric of MCIS
+type McisMetricForecast struct {
+	McisId       string                 `json:"mcisId"`
+	Metric       string                 `json:"metric"`
+	Model        string                 `json:"model"`
+	SeasonLength int                    `json:"seasonLength"` // hours
+	BucketSec    int64                  `json:"bucketSec"`
+	Samples      int                    `json:"samples"` // number of buckets with samples
+	Forecast     []MetricForecastPoint  `json:"forecast"`
+	Accuracy     MetricForecastAccuracy `json:"accuracy"`
+}
+
+// MetricForecastPoint is struct for a forecasted value
+type MetricForecastPoint struct {
+	Time  string  `json:"time"` // start of the bucket (RFC3339)
+	Value float64 `json:"value"`
+}
+
+// MetricForecastAccuracy is struct for the accuracy of forecasts (backtest on the latest buckets with samples)
+type MetricForecastAccuracy struct {
+	LeadBucket int     `json:"leadBucket"` // how many buckets ahead the backtest forecasts
+	Samples    int     `json:"samples"`    // number of buckets compared
+	Mae        float64 `json:"mae"`        // mean absolute error
+	Rmse       float64 `json:"rmse"`       // root mean square error
+	Mape       float64 `json:"mape"`       // mean absolute percentage error (%, buckets with 0 are excluded)
+}
+
+// ValidateAutoPredict is func to validate AutoPredict
+func ValidateAutoPredict(predict *AutoPredict) error {
+	switch predict.Model {
+	case AutoPredictModelHoltWinters, AutoPredictModelLastWeek:
+	default:
+		return fmt.Errorf("The model (" + predict.Model + ") is not available. Use " + AutoPredictModelHoltWinters + ", " + AutoPredictModelLastWeek)
+	}
+	// holtWinters requires two seasons of retained samples
+	tier := monRetentionTiers[autoPredictTier]
+	maxSeasonLength := int(tier.retention/tier.resolution) / 2
+	if predict.SeasonLength < 0 || predict.SeasonLength > maxSeasonLength {
+		return fmt.Errorf("The seasonLength should be in 0-" + strconv.Itoa(maxSeasonLength) + " hours")
+	}
+	if predict.LeadTimeMin < 0 || predict.LeadTimeMin > 24*60 {
+		return fmt.Errorf("The leadTimeMin should be in 0-1440 minutes")
+	}
+	return nil
+}
+
+// getAutoPredictSeasonLength is func to get the season length (in buckets) of the model
+func getAutoPredictSeasonLength(model string, seasonLength int) int {
+	if model == AutoPredictModelLastWeek {
+		return 24 * 7
+	}
+	if seasonLength <= 0 {
+		return 24
+	}
+	return seasonLength
+}
+
+// denseMetricSeries is func to get average values of buckets (in order of time) for every bucket until now (missing buckets are filled with the previous value).
+// It returns the values, whether each value is sampled (not filled), and the time of the first bucket.
+func denseMetricSeries(buckets []MonBucket, bucketSec int64, now time.Time) ([]float64, []bool, int64) {
+	if len(buckets) == 0 {
+		return nil, nil, 0
+	}
+	first := buckets[0].Time
+	last := now.Unix() - now.Unix()%bucketSec
+	if lastSampled := buckets[len(buckets)-1].Time; lastSampled > last {
+		last = lastSampled
+	}
+	length := int((last-first)/bucketSec) + 1
+
+	values := make([]float64, length)
+	sampled := make([]bool, length)
+	for _, bucket := range buckets {
+		i := int((bucket.Time - first) / bucketSec)
+		values[i] = bucket.value(MonAggregationAvg)
+		sampled[i] = true
+	}
+	for i := 1; i < length; i++ {
+		if !sampled[i] {
+			values[i] = values[i-1]
+		}
+	}
+	return values, sampled, first
+}
+
+// forecastHoltWinters is func to forecast values for the next horizon buckets by additive Holt-Winters model
+func forecastHoltWinters(values []float64, seasonLength int, horizon int) ([]float64, error) {
+	n := len(values)
+	if seasonLength < 1 || n < 2*seasonLength {
+		return nil, fmt.Errorf("Not enough data for holtWinters: %d hours (requires %d hours)", n, 2*seasonLength)
+	}
+
+	// initialize level, trend and season by the first two seasons
+	mean1, mean2 := 0.0, 0.0
+	for i := 0; i < seasonLength; i++ {
+		mean1 += values[i]
+		mean2 += values[seasonLength+i]
+	}
+	mean1 /= float64(seasonLength)
+	mean2 /= float64(seasonLength)
+
+	level := mean1
+	trend := (mean2 - mean1) / float64(seasonLength)
+	season := make([]float64, n)
+	for i := 0; i < seasonLength; i++ {
+		season[i] = values[i] - mean1
+	}
+
+	for t := seasonLength; t < n; t++ {
+		prevLevel := level
+		level = holtWintersAlpha*(values[t]-season[t-seasonLength]) + (1-holtWintersAlpha)*(level+trend)
+		trend = holtWintersBeta*(level-prevLevel) + (1-holtWintersBeta)*trend
+		season[t] = holtWintersGamma*(values[t]-level) + (1-holtWintersGamma)*season[t-seasonLength]
+	}
+
+	forecast := make([]float64, horizon)
+	for h := 1; h <= horizon; h++ {
+		seasonIndex := n - 1 + h - seasonLength*((h-1)/seasonLength+1)
+		forecast[h-1] = math.Max(0, level+float64(h)*trend+season[seasonIndex])
+	}
+	return forecast, nil
+}
+
+// forecastLastWeek is func to forecast values for the next horizon buckets by the values at the same hour last week
+func forecastLastWeek(values []float64, horizon int) ([]float64, error) {
+	week := 24 * 7
+	n := len(values)
+	if n < week {
+		return nil, fmt.Errorf("Not enough data for lastWeek: %d hours (requires %d hours)", n, week)
+	}
+	forecast := make([]float64, horizon)
+	for h := 1; h <= horizon; h++ {
+		// repeat the last week for the horizon longer than a week
+		forecast[h-1] = values[n-1+h-week*((h-1)/week+1)]
+	}
+	return forecast, nil
+}
+
+// forecastMetricValues is func to forecast values for the next horizon buckets by the model
+func forecastMetricValues(values []float64, model string, seasonLength int, horizon int) ([]float64, error) {
+	switch model {
+	case AutoPredictModelLastWeek:
+		return forecastLastWeek(values, horizon)
+	default:
+		return forecastHoltWinters(values, seasonLength, horizon)
+	}
+}
+
+// backtestMetricForecast is func to measure the accuracy of forecasts made leadBucket buckets before each of the latest sampled buckets
+func backtestMetricForecast(values []float64, sampled []bool, model string, seasonLength int, leadBucket int) MetricForecastAccuracy {
+	accuracy := MetricForecastAccuracy{LeadBucket: leadBucket}
+	sumAbs, sumSquare, sumPercent := 0.0, 0.0, 0.0
+	percentSamples := 0
+
+	// the latest bucket is in progress, so it is excluded
+	for t := len(values) - 2; t >= 0 && accuracy.Samples < autoPredictBacktestCount; t-- {
+		if !sampled[t] || t-leadBucket+1 <= 0 {
+			continue
+		}
+		forecast, err := forecastMetricValues(values[:t-leadBucket+1], model, seasonLength, leadBucket)
+		if err != nil {
+			break
+		}
+		diff := forecast[leadBucket-1] - values[t]
+		sumAbs += math.Abs(diff)
+		sumSquare += diff * diff
+		if values[t] != 0 {
+			sumPercent += math.Abs(diff / values[t])
+			percentSamples++
+		}
+		accuracy.Samples++
+	}
+	if accuracy.Samples != 0 {
+		accuracy.Mae = sumAbs / float64(accuracy.Samples)
+		accuracy.Rmse = math.Sqrt(sumSquare / float64(accuracy.Samples))
+	}
+	if percentSamples != 0 {
+		accuracy.Mape = 100 * sumPercent / float64(percentSamples)
+	}
+	return accuracy
+}
+
+// GetMcisMetricForecast is func to forecast a metric of MCIS for the next horizon hours with the accuracy of the model
+func GetMcisMetricForecast(nsId string, mcisId string, metric string, model string, seasonLength int, horizon int) (McisMetricForecast, error) {
+	if model == "" {
+		model = AutoPredictModelHoltWinters
+	}
+	err := ValidateAutoPredict(&AutoPredict{Model: model, SeasonLength: seasonLength})
+	if err != nil {
+		return McisMetricForecast{}, err
+	}
+	if horizon <= 0 || horizon > 24*7 {
+		horizon = 24
+	}
+
+	buckets, err := getMcisMonitoringBuckets(nsId, mcisId, metric, autoPredictTier)
+	if err != nil {
+		return McisMetricForecast{}, err
+	}
+	seasonLength = getAutoPredictSeasonLength(model, seasonLength)
+	bucketSec := monRetentionTiers[autoPredictTier].resolution
+
+	content := McisMetricForecast{
+		McisId:       mcisId,
+		Metric:       metric,
+		Model:        model,
+		SeasonLength: seasonLength,
+		BucketSec:    bucketSec,
+		Samples:      len(buckets),
+		Forecast:     []MetricForecastPoint{},
+	}
+
+	values, sampled, first := denseMetricSeries(buckets, bucketSec, time.Now())
+	forecast, err := forecastMetricValues(values, model, seasonLength, horizon)
+	if err != nil {
+		return content, err
+	}
+	for h, value := range forecast {
+		bucketTime := first + int64(len(values)+h)*bucketSec
+		content.Forecast = append(content.Forecast, MetricForecastPoint{Time: time.Unix(bucketTime, 0).UTC().Format(time.RFC3339), Value: value})
+	}
+	content.Accuracy = backtestMetricForecast(values, sampled, model, seasonLength, 1)
+	return content, nil
+}
+
+// EvaluateAutoPredict is func to evaluate AutoCondition with the forecasted metric values at LeadTimeMin later.
+// Leaves without forecasts (not enough data or metrics not retained) are unknown.
+func EvaluateAutoPredict(nsId string, mcisId string, condition *AutoCondition, predict *AutoPredict) (string, []AutoConditionLeafResult) {
+	leadTimeMin := predict.LeadTimeMin
+	if leadTimeMin == 0 {
+		leadTimeMin = 60
+	}
+	seasonLength := getAutoPredictSeasonLength(predict.Model, predict.SeasonLength)
+	bucketSec := monRetentionTiers[autoPredictTier].resolution
+	now := time.Now()
+
+	// forecasted value for each metric (cached for an evaluation)
+	forecasts := map[string]*float64{}
+	getForecast := func(metric string) *float64 {
+		if value, ok := forecasts[metric]; ok {
+			return value
+		}
+		forecasts[metric] = nil
+		buckets, err := getMcisMonitoringBuckets(nsId, mcisId, metric, autoPredictTier)
+		if err != nil || len(buckets) == 0 {
+			return nil
+		}
+		values, _, first := denseMetricSeries(buckets, bucketSec, now)
+		target := now.Add(time.Duration(leadTimeMin) * time.Minute).Unix()
+		horizon := int((target-first)/bucketSec) - len(values) + 1
+		if horizon < 1 {
+			horizon = 1
+		}
+		forecast, err := forecastMetricValues(values, predict.Model, seasonLength, horizon)
+		if err != nil {
+			fmt.Println("[Forecast] " + metric + ": " + err.Error())
+			return nil
+		}
+		forecasts[metric] = &forecast[horizon-1]
+		return forecasts[metric]
+	}
+
+	leafResults := []AutoConditionLeafResult{}
+	var evaluate func(expr *AutoConditionExpr) string
+	evaluate = func(expr *AutoConditionExpr) string {
+		if expr.Combinator != "" {
+			childResults := []string{}
+			for i := range expr.Children {
+				childResults = append(childResults, evaluate(&expr.Children[i]))
+			}
+			return combineAutoResults(expr.Combinator, childResults)
+		}
+		operand, _ := strconv.ParseFloat(expr.Operand, 64)
+		leafResult := AutoConditionLeafResult{Metric: expr.Metric, Aggregation: "forecast(" + predict.Model + ")", Operator: expr.Operator, Operand: operand, Period: leadTimeMin, Result: autoResultUnknown}
+		if value := getForecast(expr.Metric); value != nil {
+			leafResult.Value = *value
+			leafResult.Filled = leadTimeMin
+			if compareAutoOperand(leafResult.Value, expr.Operator, operand) {
+				leafResult.Result = autoResultTrue
+			} else {
+				leafResult.Result = autoResultFalse
+			}
+		}
+		fmt.Println("[Forecast] " + leafResult.String())
+		leafResults = append(leafResults, leafResult)
+		return leafResult.Result
+	}
+
+	expr := getAutoConditionExpr(condition)
+	return evaluate(expr), leafResults
+}
+
+// combineAutoPredictResult is func to combine the results of the current metrics and forecasts by the action type.
+// ScaleOut acts if either is satisfied (ahead of the forecasted load),
+// ScaleIn acts only if both are satisfied (not to remove VMs before the forecasted load).
+func combineAutoPredictResult(actionType string, result string, predicted string) string {
+	if predicted == autoResultUnknown {
+		return result
+	}
+	if strings.EqualFold(actionType, AutoActionScaleIn) {
+		return combineAutoResults(AutoCombinatorAnd, []string{result, predicted})
+	}
+	return combineAutoResults(AutoCombinatorOr, []string{result, predicted})
+}
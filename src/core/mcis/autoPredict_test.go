@@ -0,0 +1,177 @@
+/*
+Copyright 2019 The Cloud-Barista Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package mcis is to manage multi-cloud infra service
+package mcis
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// seasonalValue is func to generate an hourly value with a daily season and a linear trend
+func seasonalValue(i int, base float64, trend float64, amplitude float64) float64 {
+	return base + trend*float64(i) + amplitude*math.Sin(2*math.Pi*float64(i%24)/24)
+}
+
+func TestForecastHoltWinters(t *testing.T) {
+	testCases := []struct {
+		name      string
+		n         int
+		season    int
+		base      float64
+		trend     float64
+		amplitude float64
+		delta     float64
+		isErr     bool
+	}{
+		{name: "flat", n: 24 * 7, season: 24, base: 50, delta: 1e-9},
+		{name: "daily season", n: 24 * 7, season: 24, base: 50, amplitude: 20, delta: 1},
+		// the trend is smoothed slowly (beta), so the forecast lags behind a steep trend
+		{name: "daily season with trend", n: 24 * 7, season: 24, base: 20, trend: 0.1, amplitude: 10, delta: 5},
+		{name: "not enough data", n: 24*2 - 1, season: 24, base: 50, amplitude: 20, isErr: true},
+		{name: "invalid season", n: 24 * 7, season: 0, base: 50, isErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			values := make([]float64, tc.n)
+			for i := range values {
+				values[i] = seasonalValue(i, tc.base, tc.trend, tc.amplitude)
+			}
+			horizon := 30
+			forecast, err := forecastHoltWinters(values, tc.season, horizon)
+			if tc.isErr {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, horizon, len(forecast))
+			for h, v := range forecast {
+				assert.InDelta(t, seasonalValue(tc.n+h, tc.base, tc.trend, tc.amplitude), v, tc.delta, "h=%d", h+1)
+			}
+		})
+	}
+
+	// forecasts are not negative
+	decreasing := make([]float64, 48)
+	for i := range decreasing {
+		decreasing[i] = float64(48 - i)
+	}
+	forecast, err := forecastHoltWinters(decreasing, 24, 100)
+	assert.Nil(t, err)
+	for _, v := range forecast {
+		assert.GreaterOrEqual(t, v, 0.0)
+	}
+}
+
+func TestForecastLastWeek(t *testing.T) {
+	week := 24 * 7
+	values := make([]float64, week+10)
+	for i := range values {
+		values[i] = float64(i)
+	}
+
+	testCases := []struct {
+		horizon  int
+		expected []float64
+	}{
+		{horizon: 1, expected: []float64{10}},
+		{horizon: 3, expected: []float64{10, 11, 12}},
+		// the last week is repeated for the horizon longer than a week
+		{horizon: week + 2, expected: append(append([]float64{}, values[10:week+10]...), 10, 11)},
+	}
+
+	for _, tc := range testCases {
+		forecast, err := forecastLastWeek(values, tc.horizon)
+		assert.Nil(t, err)
+		assert.Equal(t, tc.expected, forecast, "horizon=%d", tc.horizon)
+	}
+
+	_, err := forecastLastWeek(values[:week-1], 1)
+	assert.NotNil(t, err)
+}
+
+func TestDenseMetricSeries(t *testing.T) {
+	bucketSec := int64(3600)
+	first := int64(1600000000) - int64(1600000000)%bucketSec
+	now := time.Unix(first+4*bucketSec+10, 0)
+
+	// buckets at 0, 1 and 3 hours (averages 10, 30, 50)
+	buckets := []MonBucket{
+		{Time: first, Sum: 20, Count: 2},
+		{Time: first + bucketSec, Sum: 30, Count: 1},
+		{Time: first + 3*bucketSec, Sum: 100, Count: 2},
+	}
+	values, sampled, start := denseMetricSeries(buckets, bucketSec, now)
+	assert.Equal(t, first, start)
+	assert.Equal(t, []float64{10, 30, 30, 50, 50}, values)
+	assert.Equal(t, []bool{true, true, false, true, false}, sampled)
+
+	values, sampled, start = denseMetricSeries(nil, bucketSec, now)
+	assert.Nil(t, values)
+	assert.Nil(t, sampled)
+	assert.Equal(t, int64(0), start)
+}
+
+func TestBacktestMetricForecast(t *testing.T) {
+	week := 24 * 7
+	values := make([]float64, 2*week)
+	sampled := make([]bool, len(values))
+	for i := range values {
+		values[i] = seasonalValue(i, 50, 0, 20)
+		sampled[i] = true
+	}
+
+	// lastWeek is exact for a weekly (and daily) season
+	accuracy := backtestMetricForecast(values, sampled, AutoPredictModelLastWeek, week, 1)
+	assert.Equal(t, autoPredictBacktestCount, accuracy.Samples)
+	assert.InDelta(t, 0, accuracy.Mae, 1e-9)
+	assert.InDelta(t, 0, accuracy.Rmse, 1e-9)
+
+	// filled buckets are not compared
+	for i := range sampled {
+		sampled[i] = i%2 == 0
+	}
+	accuracy = backtestMetricForecast(values, sampled, AutoPredictModelHoltWinters, 24, 1)
+	assert.Equal(t, autoPredictBacktestCount, accuracy.Samples)
+	assert.Less(t, accuracy.Mae, 1.0)
+}
+
+func TestValidateAutoPredict(t *testing.T) {
+	testCases := []struct {
+		predict AutoPredict
+		isErr   bool
+	}{
+		{predict: AutoPredict{Model: AutoPredictModelHoltWinters}},
+		{predict: AutoPredict{Model: AutoPredictModelHoltWinters, SeasonLength: 24 * 15, LeadTimeMin: 1440}},
+		{predict: AutoPredict{Model: AutoPredictModelLastWeek}},
+		{predict: AutoPredict{Model: "arima"}, isErr: true},
+		// two seasons should be retained in the 1h tier (30 days)
+		{predict: AutoPredict{Model: AutoPredictModelHoltWinters, SeasonLength: 24*15 + 1}, isErr: true},
+		{predict: AutoPredict{Model: AutoPredictModelHoltWinters, SeasonLength: -1}, isErr: true},
+		{predict: AutoPredict{Model: AutoPredictModelHoltWinters, LeadTimeMin: 1441}, isErr: true},
+	}
+
+	for _, tc := range testCases {
+		err := ValidateAutoPredict(&tc.predict)
+		if tc.isErr {
+			assert.NotNil(t, err, "%+v", tc.predict)
+		} else {
+			assert.Nil(t, err, "%+v", tc.predict)
+		}
+	}
+}
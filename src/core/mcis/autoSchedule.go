@@ -0,0 +1,381 @@
+/*
+Copyright 2019 The Cloud-Barista Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package mcis is to manage multi-cloud infra service
+package mcis
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloud-barista/cb-tumblebug/src/core/common"
+)
+
+// autoScheduleLookbackYears is the max period (years) to look back for the latest trigger of a cron expression
+// (long enough for yearly rules including Feb 29)
+const autoScheduleLookbackYears int = 8
+
+// cronMaxDays is the max number of days of each month (index 1-12)
+var cronMaxDays = []int{0, 31, 29, 31, 30, 31, 30, 31, 31, 30, 31, 30, 31}
+
+// AutoSchedule is struct for time-based MCIS auto-control.
+// The desired VM count is set by the rule triggered most recently (ex: "0 9 * * 1-5" for 10 VMs and "0 18 * * 1-5" for 2 VMs).
+type AutoSchedule struct {
+	TimeZone string             `json:"timeZone,omitempty" example:"Asia/Seoul"` // time zone of cron expressions (default: UTC)
+	Rules    []AutoScheduleRule `json:"rules"`
+
+	// ActiveRule is the index of the rule applied currently (-1 if no rule has been triggered)
+	ActiveRule int `json:"activeRule"`
+	// ActiveRuleTime is the trigger time of the rule applied currently (RFC3339)
+	ActiveRuleTime string `json:"activeRuleTime,omitempty"`
+}
+
+// AutoScheduleRule is struct for a rule of AutoSchedule (cron expression and desired VM count)
+type AutoScheduleRule struct {
+	Cron           string `json:"cron" example:"0 9 * * 1-5"` // minute hour day-of-month month day-of-week
+	DesiredVmCount int    `json:"desiredVmCount" example:"10"`
+}
+
+// cronField is struct for the allowed values of a field of cron expression
+type cronField struct {
+	min    int
+	max    int
+	values map[int]bool
+	any    bool
+}
+
+// cronSchedule is struct for a parsed cron expression
+type cronSchedule struct {
+	minute cronField
+	hour   cronField
+	dom    cronField
+	month  cronField
+	dow    cronField
+}
+
+// parseCronField is func to parse a field of cron expression (*, */n, a, a-b, a-b/n and lists of them)
+func parseCronField(field string, min int, max int) (cronField, error) {
+	result := cronField{min: min, max: max, values: map[int]bool{}, any: field == "*"}
+
+	for _, part := range strings.Split(field, ",") {
+		rangePart := part
+		step := 1
+		if i := strings.Index(part, "/"); i >= 0 {
+			var err error
+			step, err = strconv.Atoi(part[i+1:])
+			if err != nil || step <= 0 {
+				return result, fmt.Errorf("Invalid step in cron field: %s", field)
+			}
+			rangePart = part[:i]
+		}
+
+		start, end := min, max
+		if rangePart != "*" {
+			bounds := strings.SplitN(rangePart, "-", 2)
+			var err error
+			start, err = strconv.Atoi(bounds[0])
+			if err != nil {
+				return result, fmt.Errorf("Invalid value in cron field: %s", field)
+			}
+			end = start
+			if len(bounds) == 2 {
+				end, err = strconv.Atoi(bounds[1])
+				if err != nil {
+					return result, fmt.Errorf("Invalid range in cron field: %s", field)
+				}
+			} else if step != 1 {
+				end = max
+			}
+		}
+		if start < min || end > max || start > end {
+			return result, fmt.Errorf("Out of range in cron field: %s (%d-%d)", field, min, max)
+		}
+		for v := start; v <= end; v += step {
+			result.values[v] = true
+		}
+	}
+	return result, nil
+}
+
+// parseCron is func to parse a cron expression (minute hour day-of-month month day-of-week)
+func parseCron(expr string) (cronSchedule, error) {
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return cronSchedule{}, fmt.Errorf("The cron expression (%s) should have 5 fields: minute hour day-of-month month day-of-week", expr)
+	}
+
+	var schedule cronSchedule
+	var err error
+	if schedule.minute, err = parseCronField(fields[0], 0, 59); err != nil {
+		return schedule, err
+	}
+	if schedule.hour, err = parseCronField(fields[1], 0, 23); err != nil {
+		return schedule, err
+	}
+	if schedule.dom, err = parseCronField(fields[2], 1, 31); err != nil {
+		return schedule, err
+	}
+	if schedule.month, err = parseCronField(fields[3], 1, 12); err != nil {
+		return schedule, err
+	}
+	if schedule.dow, err = parseCronField(fields[4], 0, 7); err != nil {
+		return schedule, err
+	}
+	// both 0 and 7 are Sunday
+	if schedule.dow.values[7] {
+		schedule.dow.values[0] = true
+	}
+
+	// a day-of-month restricted schedule should have a valid day in one of the months (ex: not "0 0 31 2 *")
+	if !schedule.dom.any && schedule.dow.any {
+		valid := false
+		for month := range schedule.month.values {
+			for day := range schedule.dom.values {
+				if day <= cronMaxDays[month] {
+					valid = true
+				}
+			}
+		}
+		if !valid {
+			return schedule, fmt.Errorf("The cron expression (%s) is never triggered", expr)
+		}
+	}
+	return schedule, nil
+}
+
+// matches is func to check whether the time (in minutes) is a trigger of the cron schedule
+func (s cronSchedule) matches(t time.Time) bool {
+	if !s.minute.values[t.Minute()] || !s.hour.values[t.Hour()] {
+		return false
+	}
+	return s.matchesDay(t)
+}
+
+// matchesDay is func to check whether the day of the time has triggers of the cron schedule
+func (s cronSchedule) matchesDay(t time.Time) bool {
+	if !s.month.values[int(t.Month())] {
+		return false
+	}
+	domMatch := s.dom.values[t.Day()]
+	dowMatch := s.dow.values[int(t.Weekday())]
+	// same as cron: if both day fields are restricted, either of them matches
+	if !s.dom.any && !s.dow.any {
+		return domMatch || dowMatch
+	}
+	return domMatch && dowMatch
+}
+
+// lastTrigger is func to get the latest trigger time of the cron schedule at or before the given time (zero time if not found in lookback).
+// It goes back day by day (month by month for months not in the schedule) and finds the latest hour and minute in the day.
+func (s cronSchedule) lastTrigger(now time.Time) time.Time {
+	loc := now.Location()
+	day := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
+	oldest := day.AddDate(-autoScheduleLookbackYears, 0, 0)
+
+	for today := true; !day.Before(oldest); today = false {
+		if !s.month.values[int(day.Month())] {
+			// the last day of the previous month
+			day = time.Date(day.Year(), day.Month(), 0, 0, 0, 0, 0, loc)
+			continue
+		}
+		if s.matchesDay(day) {
+			maxHour := 23
+			if today {
+				maxHour = now.Hour()
+			}
+			for hour := maxHour; hour >= 0; hour-- {
+				if !s.hour.values[hour] {
+					continue
+				}
+				maxMinute := 59
+				if today && hour == now.Hour() {
+					maxMinute = now.Minute()
+				}
+				for minute := maxMinute; minute >= 0; minute-- {
+					if s.minute.values[minute] {
+						return time.Date(day.Year(), day.Month(), day.Day(), hour, minute, 0, 0, loc)
+					}
+				}
+			}
+		}
+		day = time.Date(day.Year(), day.Month(), day.Day()-1, 0, 0, 0, 0, loc)
+	}
+	return time.Time{}
+}
+
+// getAutoScheduleLocation is func to get the time zone of AutoSchedule
+func getAutoScheduleLocation(schedule *AutoSchedule) (*time.Location, error) {
+	if schedule.TimeZone == "" {
+		return time.UTC, nil
+	}
+	return time.LoadLocation(schedule.TimeZone)
+}
+
+// ValidateAutoSchedule is func to validate AutoSchedule
+func ValidateAutoSchedule(schedule *AutoSchedule) error {
+	if len(schedule.Rules) == 0 {
+		return fmt.Errorf("The autoSchedule has no rules")
+	}
+	if _, err := getAutoScheduleLocation(schedule); err != nil {
+		return fmt.Errorf("The timeZone (" + schedule.TimeZone + ") is not available: " + err.Error())
+	}
+	for i, rule := range schedule.Rules {
+		if _, err := parseCron(rule.Cron); err != nil {
+			return fmt.Errorf("rules[" + strconv.Itoa(i) + "]: " + err.Error())
+		}
+		if rule.DesiredVmCount < 0 {
+			return fmt.Errorf("rules[" + strconv.Itoa(i) + "]: The desiredVmCount should not be negative")
+		}
+	}
+	return nil
+}
+
+// GetAutoScheduleTarget is func to get the rule of AutoSchedule triggered most recently at the given time.
+// It returns -1 if no rule has been triggered in the lookback period.
+func GetAutoScheduleTarget(schedule *AutoSchedule, now time.Time) (int, time.Time) {
+	loc, err := getAutoScheduleLocation(schedule)
+	if err != nil {
+		return -1, time.Time{}
+	}
+	now = now.In(loc)
+
+	activeRule := -1
+	var activeTime time.Time
+	for i, rule := range schedule.Rules {
+		cron, err := parseCron(rule.Cron)
+		if err != nil {
+			continue
+		}
+		trigger := cron.lastTrigger(now)
+		if trigger.IsZero() {
+			continue
+		}
+		// the later rule wins if triggered at the same time
+		if activeRule == -1 || !trigger.Before(activeTime) {
+			activeRule = i
+			activeTime = trigger
+		}
+	}
+	return activeRule, activeTime
+}
+
+// sameAutoScope is func to check whether two AutoActions manage the same VMs
+func sameAutoScope(a *AutoAction, b *AutoAction) bool {
+	return a.ScopeLabel == b.ScopeLabel && a.ScopeVmGroupId == b.ScopeVmGroupId
+}
+
+// getScheduledVmCount is func to get the desired VM count set by the time-based policies for the scope of AutoAction.
+// Metric-based policies do not ScaleIn below this count.
+func getScheduledVmCount(mcisPolicy *McisPolicyInfo, autoAction *AutoAction) int {
+	scheduled := 0
+	for _, policy := range mcisPolicy.Policy {
+		if policy.AutoSchedule == nil || !sameAutoScope(&policy.AutoAction, autoAction) {
+			continue
+		}
+		activeRule := policy.AutoSchedule.ActiveRule
+		if activeRule >= 0 && activeRule < len(policy.AutoSchedule.Rules) && policy.AutoSchedule.Rules[activeRule].DesiredVmCount > scheduled {
+			scheduled = policy.AutoSchedule.Rules[activeRule].DesiredVmCount
+		}
+	}
+	return scheduled
+}
+
+// ReconcileScheduledPolicy is func to reconcile the VM count with the desired count of a time-based policy.
+// When a new rule is triggered, the VM count is set to the desired count (ScaleOut or ScaleIn).
+// Otherwise, VMs are added only if the VM count is less than the desired count (VMs added by metric-based policies are kept).
+func ReconcileScheduledPolicy(nsId string, mcisPolicy *McisPolicyInfo, policyIndex int) {
+	policy := &mcisPolicy.Policy[policyIndex]
+	schedule := policy.AutoSchedule
+
+	activeRule, activeTime := GetAutoScheduleTarget(schedule, time.Now())
+	if activeRule == -1 {
+		fmt.Println("[Schedule] No rule has been triggered")
+		return
+	}
+	desired := schedule.Rules[activeRule].DesiredVmCount
+	activeTimeStr := activeTime.Format(time.RFC3339)
+	triggered := schedule.ActiveRule != activeRule || schedule.ActiveRuleTime != activeTimeStr
+
+	record := newMcisPolicyHistory(mcisPolicy.Id, policyIndex, PolicyHistoryAction, policy.Status)
+	record.ActionType = AutoActionScaleTo
+	record.Message = "rules[" + strconv.Itoa(activeRule) + "] (" + schedule.Rules[activeRule].Cron + ") desiredVmCount: " + strconv.Itoa(desired)
+
+	current, err := getAutoScopeVmCount(nsId, mcisPolicy.Id, &policy.AutoAction)
+	if err != nil {
+		common.CBLog.Error(err)
+		policy.Status = AutoStatusError
+		record.Decision = PolicyDecisionError
+		record.Error = err.Error()
+		record.StatusTo = policy.Status
+		RecordMcisPolicyHistory(nsId, mcisPolicy, record)
+		return
+	}
+	fmt.Printf("[Schedule] rule: %d, desired: %d, current: %d, triggered: %v\n", activeRule, desired, current, triggered)
+
+	// set the desired count within min and max of AutoAction
+	autoAction := policy.AutoAction
+	if autoAction.MaxVmCount != 0 && desired > autoAction.MaxVmCount {
+		desired = autoAction.MaxVmCount
+	}
+	if desired < autoAction.MinVmCount {
+		desired = autoAction.MinVmCount
+	}
+
+	var vmList []string
+	switch {
+	case current < desired:
+		autoAction.StepSize = strconv.Itoa(desired - current)
+		autoAction.MaxVmCount = desired
+		vmList, err = ScaleOutMcis(nsId, mcisPolicy.Id, &autoAction)
+		record.AddedVms = vmList
+		record.PlacementAlgo = autoAction.PlacementAlgo
+		record.Placement = getVmPlacement(nsId, mcisPolicy.Id, vmList)
+		if len(vmList) != 0 {
+			mcisPolicy.LastScaleOutTime = time.Now().Format(time.RFC3339)
+		}
+	case current > desired && triggered:
+		autoAction.StepSize = strconv.Itoa(current - desired)
+		autoAction.MinVmCount = desired
+		vmList, err = ScaleInMcis(nsId, mcisPolicy.Id, &autoAction)
+		record.RemovedVms = vmList
+		if len(vmList) != 0 {
+			mcisPolicy.LastScaleInTime = time.Now().Format(time.RFC3339)
+		}
+	}
+
+	if err != nil {
+		common.CBLog.Error(err)
+		policy.Status = AutoStatusError
+		record.Decision = PolicyDecisionError
+		record.Error = err.Error()
+		record.StatusTo = policy.Status
+		RecordMcisPolicyHistory(nsId, mcisPolicy, record)
+		return
+	}
+
+	schedule.ActiveRule = activeRule
+	schedule.ActiveRuleTime = activeTimeStr
+
+	// record only changes (triggers or VMs added or removed)
+	if triggered || len(vmList) != 0 {
+		if len(vmList) != 0 {
+			record.Decision = PolicyDecisionScaled
+		} else {
+			record.Decision = PolicyDecisionNoChange
+		}
+		record.StatusTo = policy.Status
+		RecordMcisPolicyHistory(nsId, mcisPolicy, record)
+	}
+}
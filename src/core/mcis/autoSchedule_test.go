@@ -0,0 +1,127 @@
+/*
+Copyright 2019 The Cloud-Barista Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package mcis is to manage multi-cloud infra service
+package mcis
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCron(t *testing.T) {
+	testCases := []struct {
+		expr  string
+		isErr bool
+	}{
+		{expr: "0 9 * * 1-5"},
+		{expr: "*/15 * * * *"},
+		{expr: "0 0 1,15 * *"},
+		{expr: "30 18 * 1-6/2 7"},
+		{expr: "0 0 29 2 *"},
+		{expr: "0 9 * *", isErr: true},
+		{expr: "60 9 * * *", isErr: true},
+		{expr: "0 24 * * *", isErr: true},
+		{expr: "0 0 0 * *", isErr: true},
+		{expr: "0 0 * 13 *", isErr: true},
+		{expr: "0 0 * * 8", isErr: true},
+		{expr: "0 0 5-1 * *", isErr: true},
+		{expr: "*/0 * * * *", isErr: true},
+		{expr: "a * * * *", isErr: true},
+		// never triggered
+		{expr: "0 0 31 2 *", isErr: true},
+		{expr: "0 0 31 4,6 *", isErr: true},
+		// triggered by day-of-week (either of day fields matches)
+		{expr: "0 0 31 2 1"},
+	}
+
+	for _, tc := range testCases {
+		_, err := parseCron(tc.expr)
+		if tc.isErr {
+			assert.NotNil(t, err, tc.expr)
+		} else {
+			assert.Nil(t, err, tc.expr)
+		}
+	}
+}
+
+func TestCronLastTrigger(t *testing.T) {
+	// Wednesday
+	now := time.Date(2023, 3, 15, 10, 30, 45, 0, time.UTC)
+
+	testCases := []struct {
+		expr     string
+		expected time.Time
+	}{
+		{expr: "* * * * *", expected: time.Date(2023, 3, 15, 10, 30, 0, 0, time.UTC)},
+		{expr: "30 10 * * *", expected: time.Date(2023, 3, 15, 10, 30, 0, 0, time.UTC)},
+		{expr: "31 10 * * *", expected: time.Date(2023, 3, 14, 10, 31, 0, 0, time.UTC)},
+		{expr: "*/20 * * * *", expected: time.Date(2023, 3, 15, 10, 20, 0, 0, time.UTC)},
+		{expr: "0 9 * * 1-5", expected: time.Date(2023, 3, 15, 9, 0, 0, 0, time.UTC)},
+		{expr: "0 18 * * 1-5", expected: time.Date(2023, 3, 14, 18, 0, 0, 0, time.UTC)},
+		{expr: "0 12 * * 0", expected: time.Date(2023, 3, 12, 12, 0, 0, 0, time.UTC)},
+		{expr: "0 12 * * 7", expected: time.Date(2023, 3, 12, 12, 0, 0, 0, time.UTC)},
+		// monthly and yearly rules beyond a week
+		{expr: "0 9 1 * *", expected: time.Date(2023, 3, 1, 9, 0, 0, 0, time.UTC)},
+		{expr: "0 9 20 * *", expected: time.Date(2023, 2, 20, 9, 0, 0, 0, time.UTC)},
+		{expr: "0 9 31 * *", expected: time.Date(2023, 1, 31, 9, 0, 0, 0, time.UTC)},
+		{expr: "0 0 1 1 *", expected: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{expr: "0 0 25 12 *", expected: time.Date(2022, 12, 25, 0, 0, 0, 0, time.UTC)},
+		{expr: "0 0 29 2 *", expected: time.Date(2020, 2, 29, 0, 0, 0, 0, time.UTC)},
+		// either of day-of-month and day-of-week matches if both are restricted
+		{expr: "0 0 1 * 1", expected: time.Date(2023, 3, 13, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tc := range testCases {
+		cron, err := parseCron(tc.expr)
+		assert.Nil(t, err, tc.expr)
+		assert.Equal(t, tc.expected, cron.lastTrigger(now), tc.expr)
+	}
+}
+
+func TestGetAutoScheduleTarget(t *testing.T) {
+	schedule := &AutoSchedule{
+		TimeZone: "Asia/Seoul",
+		Rules: []AutoScheduleRule{
+			{Cron: "0 9 * * 1-5", DesiredVmCount: 10},
+			{Cron: "0 18 * * 1-5", DesiredVmCount: 2},
+		},
+	}
+	assert.Nil(t, ValidateAutoSchedule(schedule))
+	loc, _ := time.LoadLocation("Asia/Seoul")
+
+	testCases := []struct {
+		now          time.Time
+		expectedRule int
+		expectedTime time.Time
+	}{
+		// Wednesday 10:00 KST (01:00 UTC)
+		{now: time.Date(2023, 3, 15, 1, 0, 0, 0, time.UTC), expectedRule: 0, expectedTime: time.Date(2023, 3, 15, 9, 0, 0, 0, loc)},
+		// Wednesday 20:00 KST
+		{now: time.Date(2023, 3, 15, 11, 0, 0, 0, time.UTC), expectedRule: 1, expectedTime: time.Date(2023, 3, 15, 18, 0, 0, 0, loc)},
+		// Sunday: Friday evening rule is kept
+		{now: time.Date(2023, 3, 19, 3, 0, 0, 0, time.UTC), expectedRule: 1, expectedTime: time.Date(2023, 3, 17, 18, 0, 0, 0, loc)},
+	}
+
+	for _, tc := range testCases {
+		rule, triggered := GetAutoScheduleTarget(schedule, tc.now)
+		assert.Equal(t, tc.expectedRule, rule, tc.now.String())
+		assert.True(t, tc.expectedTime.Equal(triggered), tc.now.String())
+	}
+
+	assert.NotNil(t, ValidateAutoSchedule(&AutoSchedule{TimeZone: "Mars/Base", Rules: schedule.Rules}))
+	assert.NotNil(t, ValidateAutoSchedule(&AutoSchedule{}))
+	assert.NotNil(t, ValidateAutoSchedule(&AutoSchedule{Rules: []AutoScheduleRule{{Cron: "0 9 * * *", DesiredVmCount: -1}}}))
+}
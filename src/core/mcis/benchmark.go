@@ -316,6 +316,7 @@ func RunAllBenchmarks(nsId string, mcisId string, host string) (*BenchmarkInfoAr
 	action = "mrtt"
 	fmt.Println("[Benchmark] " + action)
 	content, err = BenchmarkAction(nsId, mcisId, action, option)
+	recordBenchmarkLatency(nsId, mcisId, content)
 	for _, k := range content.ResultArray {
 		SpecId := k.SpecId
 		iX, exist := rttIndexMapX[SpecId]
@@ -391,6 +392,10 @@ func CoreGetBenchmark(nsId string, mcisId string, action string, host string) (*
 	fmt.Println("[Benchmark] " + action)
 	if strings.Contains(vaildActions, action) {
 		content, err = BenchmarkAction(nsId, mcisId, action, option)
+		if action == "mrtt" {
+			// keep the measured inter-region RTT in the latency matrix
+			recordBenchmarkLatency(nsId, mcisId, content)
+		}
 	} else {
 		//mapA := map[string]string{"message": "Not available action"}
 		//return c.JSON(http.StatusFailedDependency, &mapA)
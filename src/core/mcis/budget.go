@@ -0,0 +1,450 @@
+/*
+Copyright 2019 The Cloud-Barista Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package mcis is to manage multi-cloud infra service
+package mcis
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cloud-barista/cb-tumblebug/src/core/common"
+)
+
+// Types of budget alert
+const (
+	BudgetAlertThreshold   string = "threshold"
+	BudgetAlertEnforcement string = "enforcement"
+)
+
+// budgetCheckIntervalSec is the interval to evaluate budgets by BudgetController
+const budgetCheckIntervalSec = 60
+
+// budgetControllerMutex is to prevent concurrent runs of BudgetController
+var budgetControllerMutex sync.Mutex
+var budgetControllerRunning bool
+
+// budgetLock is to serialize read-evaluate-write of the budget of each namespace
+// (SetBudget, DelBudget, CheckBudgetForProvisioning and BudgetController)
+var budgetLock = map[string]*sync.Mutex{}
+var budgetLockMutex sync.Mutex
+
+// lockBudget is func to lock the budget of a namespace (returns the func to unlock)
+func lockBudget(nsId string) func() {
+	budgetLockMutex.Lock()
+	lock, ok := budgetLock[nsId]
+	if !ok {
+		lock = &sync.Mutex{}
+		budgetLock[nsId] = lock
+	}
+	budgetLockMutex.Unlock()
+
+	lock.Lock()
+	return lock.Unlock
+}
+
+// TbBudgetReq is struct for the monthly budget of a namespace
+type TbBudgetReq struct {
+	// MonthlyLimit is the limit of cost in a month (currency of the pricing policy)
+	MonthlyLimit float64 `json:"monthlyLimit" example:"1000"`
+	// Threshold is the list of percentages of MonthlyLimit to record alerts (50,80,100 if empty)
+	Threshold   []float64           `json:"threshold" example:"50,80,100"`
+	Enforcement TbBudgetEnforcement `json:"enforcement"`
+}
+
+// TbBudgetEnforcement is struct for actions when the budget is exhausted (cost of this month >= MonthlyLimit)
+type TbBudgetEnforcement struct {
+	// BlockProvisioning is to reject new VMs (CreateMcis, adding VMs and ScaleOut of auto control)
+	BlockProvisioning bool `json:"blockProvisioning" example:"true"`
+	// SuspendLabel is to suspend MCISs with the label (no suspension if empty)
+	SuspendLabel string `json:"suspendLabel" example:"non-critical"`
+}
+
+// TbBudgetInfo is struct for the budget of a namespace with the usage of this month
+type TbBudgetInfo struct {
+	TbBudgetReq
+
+	NsId string `json:"nsId"`
+	// Month is the month of Spent and AlertedThreshold (YYYY-MM, UTC)
+	Month string `json:"month" example:"2022-01"`
+	// Spent is the cost of this month so far
+	Spent float64 `json:"spent"`
+	// UsedPercent is Spent / MonthlyLimit * 100
+	UsedPercent float64 `json:"usedPercent"`
+	// ProjectedMonthlyCost is Spent + the cost of running VMs until the end of this month
+	ProjectedMonthlyCost float64 `json:"projectedMonthlyCost"`
+	Exhausted            bool    `json:"exhausted"`
+	// AlertedThreshold is the thresholds already alerted in this month
+	AlertedThreshold []float64 `json:"alertedThreshold"`
+	LastCheckTime    string    `json:"lastCheckTime"`
+}
+
+// TbBudgetAlert is struct for an alert event of the namespace budget
+type TbBudgetAlert struct {
+	Id           string  `json:"id"`
+	NsId         string  `json:"nsId"`
+	Type         string  `json:"type" enums:"threshold,enforcement"`
+	Month        string  `json:"month" example:"2022-01"`
+	Threshold    float64 `json:"threshold,omitempty" example:"80"`
+	MonthlyLimit float64 `json:"monthlyLimit"`
+	Spent        float64 `json:"spent"`
+	UsedPercent  float64 `json:"usedPercent"`
+	Message      string  `json:"message"`
+	CreatedTime  string  `json:"createdTime"`
+}
+
+// genBudgetKey is func to generate the key of the namespace budget
+func genBudgetKey(nsId string) string {
+	return "/ns/" + nsId + "/budget"
+}
+
+// GetBudget is func to get the budget of a namespace
+func GetBudget(nsId string) (TbBudgetInfo, error) {
+	budget := TbBudgetInfo{}
+
+	err := common.CheckString(nsId)
+	if err != nil {
+		common.CBLog.Error(err)
+		return budget, err
+	}
+
+	keyValue, err := common.CBStore.Get(genBudgetKey(nsId))
+	if err != nil {
+		common.CBLog.Error(err)
+		return budget, err
+	}
+	if keyValue == nil {
+		return budget, fmt.Errorf("The budget of namespace " + nsId + " does not exist.")
+	}
+	err = json.Unmarshal([]byte(keyValue.Value), &budget)
+	if err != nil {
+		common.CBLog.Error(err)
+		return budget, err
+	}
+	return budget, nil
+}
+
+// putBudget is func to store the budget of a namespace
+func putBudget(budget TbBudgetInfo) error {
+	val, _ := json.Marshal(budget)
+	err := common.CBStore.Put(genBudgetKey(budget.NsId), string(val))
+	if err != nil {
+		common.CBLog.Error(err)
+	}
+	return err
+}
+
+// SetBudget is func to set the monthly budget of a namespace and evaluate it
+func SetBudget(nsId string, req *TbBudgetReq) (TbBudgetInfo, error) {
+	budget := TbBudgetInfo{}
+
+	err := common.CheckString(nsId)
+	if err != nil {
+		common.CBLog.Error(err)
+		return budget, err
+	}
+	check, err := common.CheckNs(nsId)
+	if !check {
+		return budget, fmt.Errorf("The namespace " + nsId + " does not exist.")
+	}
+	if err != nil {
+		common.CBLog.Error(err)
+		return budget, err
+	}
+
+	if req.MonthlyLimit <= 0 {
+		return budget, fmt.Errorf("The monthlyLimit of budget should be positive")
+	}
+	threshold := []float64{}
+	for _, v := range req.Threshold {
+		if v <= 0 {
+			return budget, fmt.Errorf("The threshold of budget should be positive percentages")
+		}
+		threshold = append(threshold, v)
+	}
+	if len(threshold) == 0 {
+		threshold = []float64{50, 80, 100}
+	}
+	sort.Float64s(threshold)
+	req.Threshold = threshold
+	req.Enforcement.SuspendLabel = strings.TrimSpace(req.Enforcement.SuspendLabel)
+
+	unlock := lockBudget(nsId)
+	defer unlock()
+
+	// keep alerted thresholds of this month if the limit is not changed
+	existing, err := GetBudget(nsId)
+	if err == nil && existing.MonthlyLimit == req.MonthlyLimit {
+		budget = existing
+	}
+	budget.TbBudgetReq = *req
+	budget.NsId = nsId
+	if budget.AlertedThreshold == nil {
+		budget.AlertedThreshold = []float64{}
+	}
+
+	err = evaluateBudget(&budget)
+	if err != nil {
+		return budget, err
+	}
+	err = putBudget(budget)
+	return budget, err
+}
+
+// DelBudget is func to delete the budget of a namespace with its alerts
+func DelBudget(nsId string) error {
+	unlock := lockBudget(nsId)
+	defer unlock()
+
+	_, err := GetBudget(nsId)
+	if err != nil {
+		return err
+	}
+	err = common.CBStore.Delete(genBudgetKey(nsId))
+	if err != nil {
+		common.CBLog.Error(err)
+		return err
+	}
+
+	keyValue, err := common.CBStore.GetList(common.GenNsBudgetAlertKey(nsId, ""), true)
+	if err != nil {
+		common.CBLog.Error(err)
+		return err
+	}
+	for _, v := range keyValue {
+		err := common.CBStore.Delete(v.Key)
+		if err != nil {
+			common.CBLog.Error(err)
+			return err
+		}
+	}
+	return nil
+}
+
+// ListBudgetAlert is func to list alert events of the namespace budget (latest first)
+func ListBudgetAlert(nsId string) ([]TbBudgetAlert, error) {
+	content := []TbBudgetAlert{}
+
+	err := common.CheckString(nsId)
+	if err != nil {
+		common.CBLog.Error(err)
+		return content, err
+	}
+
+	keyValue, err := common.CBStore.GetList(common.GenNsBudgetAlertKey(nsId, ""), false)
+	if err != nil {
+		common.CBLog.Error(err)
+		return content, err
+	}
+	for _, v := range keyValue {
+		alert := TbBudgetAlert{}
+		err = json.Unmarshal([]byte(v.Value), &alert)
+		if err != nil {
+			common.CBLog.Error(err)
+			continue
+		}
+		content = append(content, alert)
+	}
+	return content, nil
+}
+
+// recordBudgetAlert is func to store an alert event of the namespace budget
+func recordBudgetAlert(budget TbBudgetInfo, alertType string, threshold float64, message string) {
+	now := time.Now()
+	alert := TbBudgetAlert{
+		// zero-padded UnixNano to list alerts in time order
+		Id:           fmt.Sprintf("%020d-%s", now.UnixNano(), alertType),
+		NsId:         budget.NsId,
+		Type:         alertType,
+		Month:        budget.Month,
+		Threshold:    threshold,
+		MonthlyLimit: budget.MonthlyLimit,
+		Spent:        budget.Spent,
+		UsedPercent:  budget.UsedPercent,
+		Message:      message,
+		CreatedTime:  now.UTC().Format(time.RFC3339),
+	}
+
+	fmt.Printf("[Budget Alert] NS[%s] %s\n", budget.NsId, message)
+	common.CBLog.Info("[Budget Alert] " + budget.NsId + ": " + message)
+
+	val, _ := json.Marshal(alert)
+	err := common.CBStore.Put(common.GenNsBudgetAlertKey(budget.NsId, alert.Id), string(val))
+	if err != nil {
+		common.CBLog.Error(err)
+	}
+}
+
+// evaluateBudget is func to update the usage of this month and record alerts for newly crossed thresholds
+func evaluateBudget(budget *TbBudgetInfo) error {
+	now := time.Now().UTC()
+	month := now.Format("2006-01")
+	if budget.Month != month {
+		// a new month, alert again
+		budget.Month = month
+		budget.AlertedThreshold = []float64{}
+	}
+
+	monthStart := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	report, err := GetCostReport(budget.NsId, "", monthStart.Format(time.RFC3339), now.Format(time.RFC3339), CostGroupByNs)
+	if err != nil {
+		return err
+	}
+	budget.Spent = report.TotalCost
+	budget.UsedPercent = budget.Spent / budget.MonthlyLimit * 100
+	budget.ProjectedMonthlyCost = budget.Spent + report.TotalCurrentCostPerHour*monthStart.AddDate(0, 1, 0).Sub(now).Hours()
+	budget.Exhausted = budget.Spent >= budget.MonthlyLimit
+	budget.LastCheckTime = now.Format(time.RFC3339)
+
+	alerted := map[float64]bool{}
+	for _, v := range budget.AlertedThreshold {
+		alerted[v] = true
+	}
+	for _, v := range budget.Threshold {
+		if budget.UsedPercent < v || alerted[v] {
+			continue
+		}
+		budget.AlertedThreshold = append(budget.AlertedThreshold, v)
+		recordBudgetAlert(*budget, BudgetAlertThreshold, v,
+			fmt.Sprintf("The cost of %s (%.2f) reached %.0f%% of the monthly budget (%.2f)", budget.Month, budget.Spent, v, budget.MonthlyLimit))
+	}
+	return nil
+}
+
+// suspendMcisByLabel is func to suspend MCISs with the label which have running VMs (returns suspended MCIS IDs)
+func suspendMcisByLabel(nsId string, label string) []string {
+	suspended := []string{}
+
+	mcisList, err := ListMcisId(nsId)
+	if err != nil {
+		common.CBLog.Error(err)
+		return suspended
+	}
+	for _, mcisId := range mcisList {
+		mcisObj, err := GetMcisObject(nsId, mcisId)
+		if err != nil || mcisObj.Label != label {
+			continue
+		}
+		running := false
+		for _, vm := range mcisObj.Vm {
+			if vm.Status == StatusRunning {
+				running = true
+				break
+			}
+		}
+		if !running {
+			continue
+		}
+		_, err = HandleMcisAction(nsId, mcisId, ActionSuspend)
+		if err != nil {
+			common.CBLog.Error(err)
+			continue
+		}
+		suspended = append(suspended, mcisId)
+	}
+	return suspended
+}
+
+// CheckBudgetForProvisioning is func to check whether new VMs are allowed by the budget of a namespace
+// (error if the budget is exhausted and BlockProvisioning is enabled)
+func CheckBudgetForProvisioning(nsId string) error {
+	unlock := lockBudget(nsId)
+	defer unlock()
+
+	budget, err := GetBudget(nsId)
+	if err != nil || !budget.Enforcement.BlockProvisioning {
+		// no budget or no enforcement
+		return nil
+	}
+	err = evaluateBudget(&budget)
+	if err != nil {
+		common.CBLog.Error("The budget of namespace " + nsId + " is not evaluated, so new VMs are not blocked: " + err.Error())
+		return nil
+	}
+	err = putBudget(budget)
+	if err != nil {
+		common.CBLog.Error(err)
+	}
+	if budget.Exhausted {
+		return fmt.Errorf("The monthly budget of namespace %s is exhausted (spent: %.2f, limit: %.2f). New VMs are blocked.", nsId, budget.Spent, budget.MonthlyLimit)
+	}
+	return nil
+}
+
+// BudgetController is func to evaluate budgets of namespaces, record alerts and enforce exhausted budgets.
+// BudgetController will be periodically invoked by a time.NewTicker in main.go.
+func BudgetController() {
+	budgetControllerMutex.Lock()
+	if budgetControllerRunning {
+		budgetControllerMutex.Unlock()
+		return
+	}
+	budgetControllerRunning = true
+	budgetControllerMutex.Unlock()
+
+	defer func() {
+		budgetControllerMutex.Lock()
+		budgetControllerRunning = false
+		budgetControllerMutex.Unlock()
+	}()
+
+	nsList, err := common.ListNsId()
+	if err != nil {
+		common.CBLog.Error(err)
+		return
+	}
+
+	for _, nsId := range nsList {
+		budget, evaluated := evaluateBudgetByController(nsId)
+		if !evaluated {
+			continue
+		}
+		// MCISs are suspended without the lock not to block provisioning checks
+		if budget.Exhausted && budget.Enforcement.SuspendLabel != "" {
+			suspended := suspendMcisByLabel(nsId, budget.Enforcement.SuspendLabel)
+			if len(suspended) > 0 {
+				recordBudgetAlert(budget, BudgetAlertEnforcement, 0,
+					"The monthly budget is exhausted. Suspended MCIS (label: "+budget.Enforcement.SuspendLabel+"): "+strings.Join(suspended, ", "))
+			}
+		}
+	}
+}
+
+// evaluateBudgetByController is func to evaluate and store the budget of a namespace if the check interval has passed
+func evaluateBudgetByController(nsId string) (TbBudgetInfo, bool) {
+	unlock := lockBudget(nsId)
+	defer unlock()
+
+	budget, err := GetBudget(nsId)
+	if err != nil {
+		return budget, false
+	}
+	lastCheckTime, _ := time.Parse(time.RFC3339, budget.LastCheckTime)
+	if time.Since(lastCheckTime) < budgetCheckIntervalSec*time.Second {
+		return budget, false
+	}
+	err = evaluateBudget(&budget)
+	if err != nil {
+		common.CBLog.Error(err)
+		return budget, false
+	}
+	err = putBudget(budget)
+	if err != nil {
+		common.CBLog.Error(err)
+	}
+	return budget, true
+}
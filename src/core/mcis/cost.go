@@ -0,0 +1,385 @@
+/*
+Copyright 2019 The Cloud-Barista Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package mcis is to manage multi-cloud infra service
+package mcis
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cloud-barista/cb-tumblebug/src/core/common"
+	"github.com/cloud-barista/cb-tumblebug/src/core/mcir"
+)
+
+// Groups of cost report
+const (
+	CostGroupByVm         string = "vm"
+	CostGroupByMcis       string = "mcis"
+	CostGroupByNs         string = "ns"
+	CostGroupByConnection string = "connection"
+	CostGroupByLabel      string = "label"
+)
+
+// hoursPerMonth is the average hours in a month for the projection of monthly cost (365 * 24 / 12)
+const hoursPerMonth float64 = 730
+
+// vmCostBillableStatus is the VM statuses charged by CSPs (the VM occupies compute resources)
+var vmCostBillableStatus = map[string]bool{
+	StatusRunning:     true,
+	StatusRebooting:   true,
+	StatusSuspending:  true,
+	StatusResuming:    true,
+	StatusTerminating: true,
+}
+
+// vmCostLock is to serialize updates of VM cost records from concurrent status updates
+var vmCostLock sync.Mutex
+
+// TbVmCostInterval is struct for a running (billable) interval of a VM
+type TbVmCostInterval struct {
+	Start string `json:"start" example:"2022-01-01T00:00:00Z"`
+	// End is empty if the VM is still running
+	End string `json:"end,omitempty" example:"2022-01-02T00:00:00Z"`
+	// CostPerHour of the spec at the start of the interval
+	CostPerHour float32 `json:"costPerHour" example:"0.0144"`
+}
+
+// TbVmCostRecord is struct for running intervals of a VM tracked from its status transitions
+type TbVmCostRecord struct {
+	NsId           string             `json:"nsId"`
+	McisId         string             `json:"mcisId"`
+	VmId           string             `json:"vmId"`
+	Label          string             `json:"label"`
+	ConnectionName string             `json:"connectionName"`
+	SpecId         string             `json:"specId"`
+	Status         string             `json:"status"`
+	Deleted        bool               `json:"deleted"`
+	Interval       []TbVmCostInterval `json:"interval"`
+}
+
+// TbCostReportItem is struct for the cost of a group (VM, MCIS, namespace, connection or label)
+type TbCostReportItem struct {
+	// Group is mcisId/vmId (vm), mcisId (mcis), nsId (ns), connectionName (connection) or label (label)
+	Group        string  `json:"group" example:"mcis01"`
+	VmNum        int     `json:"vmNum"`
+	RunningVmNum int     `json:"runningVmNum"`
+	RunningHours float64 `json:"runningHours"`
+	// Cost is the cost of running intervals in the time range of report
+	Cost float64 `json:"cost"`
+	// CurrentCostPerHour is the sum of CostPerHour of running VMs
+	CurrentCostPerHour float64 `json:"currentCostPerHour"`
+	// ProjectedMonthlyCost is the monthly cost if the current state continues (CurrentCostPerHour * 730)
+	ProjectedMonthlyCost float64 `json:"projectedMonthlyCost"`
+}
+
+// TbCostReport is struct for the cost report of a namespace or MCIS in a time range
+type TbCostReport struct {
+	NsId    string             `json:"nsId"`
+	McisId  string             `json:"mcisId,omitempty"`
+	From    string             `json:"from"`
+	To      string             `json:"to"`
+	GroupBy string             `json:"groupBy" enums:"vm,mcis,ns,connection,label"`
+	Item    []TbCostReportItem `json:"item"`
+
+	TotalCost                 float64 `json:"totalCost"`
+	TotalCurrentCostPerHour   float64 `json:"totalCurrentCostPerHour"`
+	TotalProjectedMonthlyCost float64 `json:"totalProjectedMonthlyCost"`
+}
+
+// getVmCostRecord is func to get the cost record of a VM (empty record if not exist)
+func getVmCostRecord(nsId string, mcisId string, vmId string) (TbVmCostRecord, error) {
+	record := TbVmCostRecord{NsId: nsId, McisId: mcisId, VmId: vmId, Interval: []TbVmCostInterval{}}
+	keyValue, err := common.CBStore.Get(common.GenMcisVmCostKey(nsId, mcisId, vmId))
+	if err != nil {
+		return record, err
+	}
+	if keyValue != nil {
+		err = json.Unmarshal([]byte(keyValue.Value), &record)
+	}
+	return record, err
+}
+
+// putVmCostRecord is func to store the cost record of a VM
+func putVmCostRecord(record TbVmCostRecord) error {
+	val, _ := json.Marshal(record)
+	err := common.CBStore.Put(common.GenMcisVmCostKey(record.NsId, record.McisId, record.VmId), string(val))
+	if err != nil {
+		common.CBLog.Error(err)
+	}
+	return err
+}
+
+// getVmCostPerHour is func to get CostPerHour of the spec of a VM by the pricing policy of the namespace
+func getVmCostPerHour(nsId string, vm TbVmInfo) float32 {
+	spec, err := mcir.GetSpecWithPrice(nsId, vm.SpecId)
+	if err != nil {
+		return 0
+	}
+	return spec.CostPerHour
+}
+
+// recordVmCost is func to update running intervals of a VM by its status transition
+func recordVmCost(nsId string, mcisId string, vm TbVmInfo) {
+	vmCostLock.Lock()
+	defer vmCostLock.Unlock()
+
+	record, err := getVmCostRecord(nsId, mcisId, vm.Id)
+	if err != nil {
+		common.CBLog.Error(err)
+		return
+	}
+	// the VM is re-created with the ID of a deleted VM
+	recreated := record.Deleted
+	if record.Status == vm.Status && len(record.Interval) > 0 && !recreated {
+		return
+	}
+	record.Deleted = false
+	record.Label = vm.Label
+	record.ConnectionName = vm.ConnectionName
+	record.SpecId = vm.SpecId
+	record.Status = vm.Status
+
+	now := time.Now().UTC()
+	running := len(record.Interval) > 0 && record.Interval[len(record.Interval)-1].End == ""
+	if vmCostBillableStatus[vm.Status] && !running {
+		start := now
+		if len(record.Interval) == 0 || recreated {
+			// the first interval starts from the creation of VM (not before the end of the deleted VM)
+			created, err := time.ParseInLocation("2006-01-02 15:04:05", vm.CreatedTime, time.Local)
+			if err == nil && created.Before(now) {
+				start = created.UTC()
+			}
+			if len(record.Interval) > 0 {
+				lastEnd, err := time.Parse(time.RFC3339, record.Interval[len(record.Interval)-1].End)
+				if err == nil && start.Before(lastEnd) {
+					start = lastEnd
+				}
+			}
+		}
+		record.Interval = append(record.Interval, TbVmCostInterval{
+			Start:       start.Format(time.RFC3339),
+			CostPerHour: getVmCostPerHour(nsId, vm),
+		})
+	} else if !vmCostBillableStatus[vm.Status] && running {
+		record.Interval[len(record.Interval)-1].End = now.Format(time.RFC3339)
+	}
+
+	putVmCostRecord(record)
+}
+
+// closeVmCost is func to close the running interval of a VM which is deleted
+func closeVmCost(nsId string, mcisId string, vmId string) {
+	vmCostLock.Lock()
+	defer vmCostLock.Unlock()
+
+	record, err := getVmCostRecord(nsId, mcisId, vmId)
+	if err != nil || len(record.Interval) == 0 {
+		return
+	}
+	last := len(record.Interval) - 1
+	if record.Interval[last].End == "" {
+		record.Interval[last].End = time.Now().UTC().Format(time.RFC3339)
+	}
+	record.Deleted = true
+	putVmCostRecord(record)
+}
+
+// ListVmCostRecord is func to list cost records of VMs in the namespace (in the MCIS if mcisId is given)
+func ListVmCostRecord(nsId string, mcisId string) ([]TbVmCostRecord, error) {
+	result := []TbVmCostRecord{}
+
+	err := common.CheckString(nsId)
+	if err != nil {
+		common.CBLog.Error(err)
+		return result, err
+	}
+
+	keyValue, err := common.CBStore.GetList(common.GenMcisVmCostKey(nsId, mcisId, ""), true)
+	if err != nil {
+		common.CBLog.Error(err)
+		return result, err
+	}
+	for _, v := range keyValue {
+		record := TbVmCostRecord{}
+		err = json.Unmarshal([]byte(v.Value), &record)
+		if err != nil {
+			common.CBLog.Error(err)
+			continue
+		}
+		if mcisId != "" && record.McisId != mcisId {
+			continue
+		}
+		result = append(result, record)
+	}
+	return result, nil
+}
+
+// parseCostTime is func to parse the time range of cost report (RFC3339 or YYYY-MM-DD)
+func parseCostTime(value string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t.UTC(), nil
+	}
+	if t, err := time.Parse("2006-01-02", value); err == nil {
+		return t, nil
+	}
+	return time.Time{}, fmt.Errorf("The time " + value + " is not in the form of RFC3339 or YYYY-MM-DD")
+}
+
+// getCostGroup is func to get the group of a VM cost record in cost report
+func getCostGroup(record TbVmCostRecord, groupBy string) string {
+	switch groupBy {
+	case CostGroupByVm:
+		return record.McisId + "/" + record.VmId
+	case CostGroupByMcis:
+		return record.McisId
+	case CostGroupByConnection:
+		return record.ConnectionName
+	case CostGroupByLabel:
+		return record.Label
+	default:
+		return record.NsId
+	}
+}
+
+// GetCostReport is func to get the cost of VMs in the namespace (or MCIS) in the time range grouped by vm, mcis, ns, connection or label
+// (from: the first day of this month, to: now, if not given)
+func GetCostReport(nsId string, mcisId string, from string, to string, groupBy string) (TbCostReport, error) {
+	report := TbCostReport{NsId: nsId, McisId: mcisId, Item: []TbCostReportItem{}}
+
+	err := common.CheckString(nsId)
+	if err != nil {
+		common.CBLog.Error(err)
+		return report, err
+	}
+	if mcisId != "" {
+		err = common.CheckString(mcisId)
+		if err != nil {
+			common.CBLog.Error(err)
+			return report, err
+		}
+	}
+
+	groupBy = strings.ToLower(groupBy)
+	switch groupBy {
+	case "":
+		groupBy = CostGroupByMcis
+	case CostGroupByVm, CostGroupByMcis, CostGroupByNs, CostGroupByConnection, CostGroupByLabel:
+	default:
+		return report, fmt.Errorf("Not available groupBy " + groupBy + " (vm, mcis, ns, connection, label)")
+	}
+	report.GroupBy = groupBy
+
+	now := time.Now().UTC()
+	fromTime := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	toTime := now
+	if from != "" {
+		fromTime, err = parseCostTime(from)
+		if err != nil {
+			return report, err
+		}
+	}
+	if to != "" {
+		toTime, err = parseCostTime(to)
+		if err != nil {
+			return report, err
+		}
+	}
+	if !toTime.After(fromTime) {
+		return report, fmt.Errorf("The time range is not valid (from: " + fromTime.Format(time.RFC3339) + ", to: " + toTime.Format(time.RFC3339) + ")")
+	}
+	report.From = fromTime.Format(time.RFC3339)
+	report.To = toTime.Format(time.RFC3339)
+
+	records, err := ListVmCostRecord(nsId, mcisId)
+	if err != nil {
+		return report, err
+	}
+
+	items := map[string]*TbCostReportItem{}
+	for _, record := range records {
+		group := getCostGroup(record, groupBy)
+		item, ok := items[group]
+		if !ok {
+			item = &TbCostReportItem{Group: group}
+			items[group] = item
+		}
+		item.VmNum++
+
+		for _, interval := range record.Interval {
+			start, err := time.Parse(time.RFC3339, interval.Start)
+			if err != nil {
+				continue
+			}
+			end := now
+			if interval.End != "" {
+				end, err = time.Parse(time.RFC3339, interval.End)
+				if err != nil {
+					continue
+				}
+			} else if !record.Deleted {
+				item.RunningVmNum++
+				item.CurrentCostPerHour += float64(interval.CostPerHour)
+			}
+			// overlap of the interval and the time range of report
+			if start.Before(fromTime) {
+				start = fromTime
+			}
+			if end.After(toTime) {
+				end = toTime
+			}
+			if end.After(start) {
+				hours := end.Sub(start).Hours()
+				item.RunningHours += hours
+				item.Cost += hours * float64(interval.CostPerHour)
+			}
+		}
+	}
+
+	for _, item := range items {
+		item.ProjectedMonthlyCost = item.CurrentCostPerHour * hoursPerMonth
+		report.TotalCost += item.Cost
+		report.TotalCurrentCostPerHour += item.CurrentCostPerHour
+		report.TotalProjectedMonthlyCost += item.ProjectedMonthlyCost
+		report.Item = append(report.Item, *item)
+	}
+	sort.Slice(report.Item, func(i, j int) bool { return report.Item[i].Group < report.Item[j].Group })
+
+	return report, nil
+}
+
+// ConvCostReportToCsv is func to convert the cost report into CSV (a line for each group and the total)
+func ConvCostReportToCsv(report TbCostReport) (string, error) {
+	buf := &bytes.Buffer{}
+	w := csv.NewWriter(buf)
+
+	formatFloat := func(v float64) string { return strconv.FormatFloat(v, 'f', 6, 64) }
+
+	w.Write([]string{report.GroupBy, "vmNum", "runningVmNum", "runningHours", "cost", "currentCostPerHour", "projectedMonthlyCost", "from", "to"})
+	for _, v := range report.Item {
+		w.Write([]string{v.Group, strconv.Itoa(v.VmNum), strconv.Itoa(v.RunningVmNum), formatFloat(v.RunningHours), formatFloat(v.Cost),
+			formatFloat(v.CurrentCostPerHour), formatFloat(v.ProjectedMonthlyCost), report.From, report.To})
+	}
+	w.Write([]string{"total", "", "", "", formatFloat(report.TotalCost),
+		formatFloat(report.TotalCurrentCostPerHour), formatFloat(report.TotalProjectedMonthlyCost), report.From, report.To})
+	w.Flush()
+
+	return buf.String(), w.Error()
+}
@@ -0,0 +1,44 @@
+/*
+Copyright 2019 The Cloud-Barista Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package mcis is to manage multi-cloud infra service
+package mcis
+
+import (
+	"testing"
+
+	"github.com/cloud-barista/cb-tumblebug/src/core/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecordVmCostRecreated(t *testing.T) {
+	nsId, mcisId := "ns-cost-test", "mcis01"
+	vm := TbVmInfo{Id: "vm01", SpecId: "spec01", Status: StatusRunning}
+	defer common.CBStore.Delete(common.GenMcisVmCostKey(nsId, mcisId, vm.Id))
+
+	recordVmCost(nsId, mcisId, vm)
+	closeVmCost(nsId, mcisId, vm.Id)
+	record, err := getVmCostRecord(nsId, mcisId, vm.Id)
+	assert.Nil(t, err)
+	assert.True(t, record.Deleted)
+	assert.Equal(t, 1, len(record.Interval))
+	assert.NotEmpty(t, record.Interval[0].End)
+
+	// the VM is re-created with the same ID (and the same status)
+	recordVmCost(nsId, mcisId, vm)
+	record, err = getVmCostRecord(nsId, mcisId, vm.Id)
+	assert.Nil(t, err)
+	assert.False(t, record.Deleted)
+	assert.Equal(t, 2, len(record.Interval))
+	assert.Empty(t, record.Interval[1].End)
+}
@@ -0,0 +1,648 @@
+/*
+Copyright 2019 The Cloud-Barista Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package mcis is to manage multi-cloud infra service
+package mcis
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cloud-barista/cb-tumblebug/src/core/common"
+)
+
+// Check types of MCIS health policy
+const (
+	// HealthCheckCspStatus is const for the check by VM status from CSP (Failed or Undefined is unhealthy).
+	HealthCheckCspStatus string = "cspStatus"
+
+	// HealthCheckTcp is const for the check by TCP connection to a port of VM.
+	HealthCheckTcp string = "tcp"
+
+	// HealthCheckCommand is const for the check by exit code of a remote command (non-zero is unhealthy).
+	HealthCheckCommand string = "command"
+)
+
+// Remediations of MCIS health policy
+const (
+	// HealthRemediationReboot is const for rebooting the unhealthy VM.
+	HealthRemediationReboot string = "reboot"
+
+	// HealthRemediationReplace is const for replacing the unhealthy VM with a new VM (same spec and image).
+	HealthRemediationReplace string = "replace"
+)
+
+// Health status of VMs
+const (
+	// VmHealthHealthy is const for the VM passed the latest check.
+	VmHealthHealthy string = "Healthy"
+
+	// VmHealthUnhealthy is const for the VM failed the latest check.
+	VmHealthUnhealthy string = "Unhealthy"
+
+	// VmHealthUnrecoverable is const for the VM still unhealthy after all remediations.
+	VmHealthUnrecoverable string = "Unrecoverable"
+)
+
+// McisHealthPolicy is struct for MCIS health policy (check of VMs and remediation of unhealthy VMs)
+type McisHealthPolicy struct {
+	Id string `json:"id"` // MCIS ID
+
+	CheckType string `json:"checkType" example:"tcp" enums:"cspStatus,tcp,command"`
+	// TcpPort is the port to check for tcp check (default: SSH port of VM)
+	TcpPort string `json:"tcpPort,omitempty" example:"80"`
+	// Command is the remote command for command check (exit code 0 is healthy)
+	Command McisCmdReq `json:"command,omitempty"`
+
+	IntervalSec      int `json:"intervalSec" example:"60"`     // interval between checks (default: 60)
+	TimeoutSec       int `json:"timeoutSec" example:"5"`       // timeout of tcp check (default: 5)
+	FailureThreshold int `json:"failureThreshold" example:"3"` // consecutive failures to remediate (default: 3)
+	GracePeriodSec   int `json:"gracePeriodSec" example:"180"` // period to skip checks after a remediation (default: 180)
+
+	// Remediation is the steps of remediation in order. The next step is taken if the VM is still unhealthy after a step. (default: reboot, replace)
+	Remediation []string `json:"remediation" example:"reboot,replace"`
+	// PostCommand is the command to run on the replacement VM if the unhealthy VM has no post command
+	PostCommand McisCmdReq `json:"postCommand,omitempty"`
+
+	LastCheckTime string                  `json:"lastCheckTime,omitempty"` // RFC3339
+	VmHealth      map[string]VmHealthInfo `json:"vmHealth,omitempty"`
+	Description   string                  `json:"description"`
+}
+
+// VmHealthInfo is struct for the health of a VM by MCIS health policy
+type VmHealthInfo struct {
+	Status              string `json:"status" example:"Healthy" enums:"Healthy,Unhealthy,Unrecoverable"`
+	ConsecutiveFailures int    `json:"consecutiveFailures"`
+	RemediationStep     int    `json:"remediationStep"` // index of the next remediation step
+	LastCheckTime       string `json:"lastCheckTime,omitempty"`
+	LastError           string `json:"lastError,omitempty"`
+	GraceUntil          string `json:"graceUntil,omitempty"` // checks are skipped until this time (RFC3339)
+}
+
+// McisHealthLog is struct for a remediation log of MCIS health policy
+type McisHealthLog struct {
+	Id          string `json:"id"`
+	McisId      string `json:"mcisId"`
+	VmId        string `json:"vmId"`
+	Remediation string `json:"remediation" example:"reboot" enums:"reboot,replace"`
+	Reason      string `json:"reason"`
+	StartTime   string `json:"startTime"` // RFC3339
+	EndTime     string `json:"endTime"`   // RFC3339
+	Result      string `json:"result" example:"Succeeded" enums:"Succeeded,Failed"`
+	NewVmId     string `json:"newVmId,omitempty"` // ID of the replacement VM
+	Error       string `json:"error,omitempty"`
+}
+
+// healthControllerMutex is to prevent concurrent runs of HealthController (remediation may take longer than the interval)
+var healthControllerMutex sync.Mutex
+var healthControllerRunning bool
+
+// setMcisHealthPolicyDefault is func to set default values of MCIS health policy
+func setMcisHealthPolicyDefault(policy *McisHealthPolicy) {
+	if policy.IntervalSec <= 0 {
+		policy.IntervalSec = 60
+	}
+	if policy.TimeoutSec <= 0 {
+		policy.TimeoutSec = 5
+	}
+	if policy.FailureThreshold <= 0 {
+		policy.FailureThreshold = 3
+	}
+	if policy.GracePeriodSec <= 0 {
+		policy.GracePeriodSec = 180
+	}
+	if len(policy.Remediation) == 0 {
+		policy.Remediation = []string{HealthRemediationReboot, HealthRemediationReplace}
+	}
+}
+
+// ValidateMcisHealthPolicy is func to validate MCIS health policy
+func ValidateMcisHealthPolicy(policy *McisHealthPolicy) error {
+	switch policy.CheckType {
+	case HealthCheckCspStatus, HealthCheckTcp:
+	case HealthCheckCommand:
+		if policy.Command.Command == "" {
+			return fmt.Errorf("The command is required for " + HealthCheckCommand + " check")
+		}
+	default:
+		return fmt.Errorf("The checkType (" + policy.CheckType + ") is not available. Use " + HealthCheckCspStatus + ", " + HealthCheckTcp + ", " + HealthCheckCommand)
+	}
+	if policy.TcpPort != "" {
+		port, err := strconv.Atoi(policy.TcpPort)
+		if err != nil || port < 1 || port > 65535 {
+			return fmt.Errorf("The tcpPort (" + policy.TcpPort + ") should be in 1-65535")
+		}
+	}
+	for _, remediation := range policy.Remediation {
+		if remediation != HealthRemediationReboot && remediation != HealthRemediationReplace {
+			return fmt.Errorf("The remediation (" + remediation + ") is not available. Use " + HealthRemediationReboot + ", " + HealthRemediationReplace)
+		}
+	}
+	return nil
+}
+
+// CheckMcisHealthPolicy is func to check whether the health policy of MCIS exists
+func CheckMcisHealthPolicy(nsId string, mcisId string) (bool, error) {
+
+	err := common.CheckString(nsId)
+	if err != nil {
+		common.CBLog.Error(err)
+		return false, err
+	}
+
+	err = common.CheckString(mcisId)
+	if err != nil {
+		common.CBLog.Error(err)
+		return false, err
+	}
+
+	keyValue, err := common.CBStore.Get(common.GenMcisHealthPolicyKey(nsId, mcisId))
+	if err != nil {
+		common.CBLog.Error(err)
+		return false, err
+	}
+	return keyValue != nil, nil
+}
+
+// CreateMcisHealthPolicy is func to create the health policy of MCIS
+func CreateMcisHealthPolicy(nsId string, mcisId string, req *McisHealthPolicy) (McisHealthPolicy, error) {
+
+	err := common.CheckString(nsId)
+	if err != nil {
+		common.CBLog.Error(err)
+		return McisHealthPolicy{}, err
+	}
+
+	err = common.CheckString(mcisId)
+	if err != nil {
+		common.CBLog.Error(err)
+		return McisHealthPolicy{}, err
+	}
+
+	check, _ := CheckMcis(nsId, mcisId)
+	if !check {
+		err := fmt.Errorf("The mcis " + mcisId + " does not exist.")
+		return McisHealthPolicy{}, err
+	}
+
+	check, _ = CheckMcisHealthPolicy(nsId, mcisId)
+	if check {
+		err := fmt.Errorf("The health policy of MCIS " + mcisId + " already exists.")
+		return McisHealthPolicy{}, err
+	}
+
+	err = ValidateMcisHealthPolicy(req)
+	if err != nil {
+		common.CBLog.Error(err)
+		return McisHealthPolicy{}, err
+	}
+
+	content := *req
+	content.Id = mcisId
+	content.LastCheckTime = ""
+	content.VmHealth = map[string]VmHealthInfo{}
+	setMcisHealthPolicyDefault(&content)
+
+	err = putMcisHealthPolicy(nsId, content)
+	if err != nil {
+		common.CBLog.Error(err)
+		return McisHealthPolicy{}, err
+	}
+	return content, nil
+}
+
+// putMcisHealthPolicy is func to store the health policy of MCIS
+func putMcisHealthPolicy(nsId string, policy McisHealthPolicy) error {
+	val, _ := json.Marshal(policy)
+	return common.CBStore.Put(common.GenMcisHealthPolicyKey(nsId, policy.Id), string(val))
+}
+
+// GetMcisHealthPolicy is func to get the health policy of MCIS (with the health of VMs)
+func GetMcisHealthPolicy(nsId string, mcisId string) (McisHealthPolicy, error) {
+
+	check, err := CheckMcisHealthPolicy(nsId, mcisId)
+	if err != nil {
+		return McisHealthPolicy{}, err
+	}
+	if !check {
+		err := fmt.Errorf("The health policy of MCIS " + mcisId + " does not exist.")
+		return McisHealthPolicy{}, err
+	}
+
+	keyValue, err := common.CBStore.Get(common.GenMcisHealthPolicyKey(nsId, mcisId))
+	if err != nil || keyValue == nil {
+		err = fmt.Errorf("In GetMcisHealthPolicy(); CBStore.Get() returned an error.")
+		common.CBLog.Error(err)
+		return McisHealthPolicy{}, err
+	}
+	content := McisHealthPolicy{}
+	err = json.Unmarshal([]byte(keyValue.Value), &content)
+	if err != nil {
+		common.CBLog.Error(err)
+		return content, err
+	}
+	return content, nil
+}
+
+// ListMcisHealthPolicy is func to list health policies in the namespace
+func ListMcisHealthPolicy(nsId string) ([]McisHealthPolicy, error) {
+
+	err := common.CheckString(nsId)
+	if err != nil {
+		common.CBLog.Error(err)
+		return nil, err
+	}
+
+	content := []McisHealthPolicy{}
+	keyValue, err := common.CBStore.GetList(common.GenMcisHealthPolicyKey(nsId, ""), true)
+	if err != nil {
+		common.CBLog.Error(err)
+		return content, err
+	}
+	for _, v := range keyValue {
+		policy := McisHealthPolicy{}
+		err = json.Unmarshal([]byte(v.Value), &policy)
+		if err != nil {
+			common.CBLog.Error(err)
+			continue
+		}
+		content = append(content, policy)
+	}
+	return content, nil
+}
+
+// DelMcisHealthPolicy is func to delete the health policy of MCIS (with remediation logs)
+func DelMcisHealthPolicy(nsId string, mcisId string) error {
+
+	check, err := CheckMcisHealthPolicy(nsId, mcisId)
+	if err != nil {
+		return err
+	}
+	if !check {
+		err := fmt.Errorf("The health policy of MCIS " + mcisId + " does not exist.")
+		return err
+	}
+
+	err = common.CBStore.Delete(common.GenMcisHealthPolicyKey(nsId, mcisId))
+	if err != nil {
+		common.CBLog.Error(err)
+		return err
+	}
+
+	keyValue, err := common.CBStore.GetList(common.GenMcisHealthLogKey(nsId, mcisId, ""), true)
+	if err != nil {
+		common.CBLog.Error(err)
+		return err
+	}
+	for _, v := range keyValue {
+		err := common.CBStore.Delete(v.Key)
+		if err != nil {
+			common.CBLog.Error(err)
+			return err
+		}
+	}
+	return nil
+}
+
+// ListMcisHealthLog is func to list remediation logs of MCIS health policy (latest first)
+func ListMcisHealthLog(nsId string, mcisId string) ([]McisHealthLog, error) {
+
+	err := common.CheckString(nsId)
+	if err != nil {
+		common.CBLog.Error(err)
+		return nil, err
+	}
+
+	err = common.CheckString(mcisId)
+	if err != nil {
+		common.CBLog.Error(err)
+		return nil, err
+	}
+
+	content := []McisHealthLog{}
+	keyValue, err := common.CBStore.GetList(common.GenMcisHealthLogKey(nsId, mcisId, ""), false)
+	if err != nil {
+		common.CBLog.Error(err)
+		return content, err
+	}
+	for _, v := range keyValue {
+		log := McisHealthLog{}
+		err = json.Unmarshal([]byte(v.Value), &log)
+		if err != nil {
+			common.CBLog.Error(err)
+			continue
+		}
+		content = append(content, log)
+	}
+	return content, nil
+}
+
+// recordMcisHealthLog is func to store a remediation log of MCIS health policy
+func recordMcisHealthLog(nsId string, log McisHealthLog) {
+	startTime, _ := time.Parse(time.RFC3339Nano, log.StartTime)
+	log.EndTime = time.Now().Format(time.RFC3339Nano)
+	// zero-padded UnixNano to list logs in time order
+	log.Id = fmt.Sprintf("%020d-%s", startTime.UnixNano(), log.VmId)
+
+	fmt.Printf("[Health Remediation] %s %s: %s (reason: %s) %s\n", log.VmId, log.Remediation, log.Result, log.Reason, log.Error)
+	common.CBLog.Info("[Health Remediation] " + log.McisId + "/" + log.VmId + " " + log.Remediation + ": " + log.Result)
+
+	val, _ := json.Marshal(log)
+	err := common.CBStore.Put(common.GenMcisHealthLogKey(nsId, log.McisId, log.Id), string(val))
+	if err != nil {
+		common.CBLog.Error(err)
+	}
+}
+
+// HealthController is func to check VMs of MCIS with health policies and remediate unhealthy VMs.
+// HealthController will be periodically invoked by a time.NewTicker in main.go.
+func HealthController() {
+	healthControllerMutex.Lock()
+	if healthControllerRunning {
+		healthControllerMutex.Unlock()
+		return
+	}
+	healthControllerRunning = true
+	healthControllerMutex.Unlock()
+
+	defer func() {
+		healthControllerMutex.Lock()
+		healthControllerRunning = false
+		healthControllerMutex.Unlock()
+	}()
+
+	nsList, err := common.ListNsId()
+	if err != nil {
+		common.CBLog.Error(err)
+		return
+	}
+
+	for _, nsId := range nsList {
+		policyList, err := ListMcisHealthPolicy(nsId)
+		if err != nil {
+			continue
+		}
+		for _, policy := range policyList {
+			lastCheckTime, _ := time.Parse(time.RFC3339, policy.LastCheckTime)
+			if time.Since(lastCheckTime) < time.Duration(policy.IntervalSec)*time.Second {
+				continue
+			}
+			check, _ := CheckMcis(nsId, policy.Id)
+			if !check {
+				continue
+			}
+			fmt.Println("[Health Check] NS[" + nsId + "] MCIS[" + policy.Id + "] by " + policy.CheckType)
+			checkMcisHealth(nsId, &policy)
+
+			// the policy may have been updated (or deleted) during remediation, so only the results of the check are stored
+			current, err := GetMcisHealthPolicy(nsId, policy.Id)
+			if err != nil {
+				continue
+			}
+			current.LastCheckTime = policy.LastCheckTime
+			current.VmHealth = policy.VmHealth
+			err = putMcisHealthPolicy(nsId, current)
+			if err != nil {
+				common.CBLog.Error(err)
+			}
+		}
+	}
+}
+
+// checkMcisHealth is func to check VMs of MCIS and remediate unhealthy VMs by the health policy
+func checkMcisHealth(nsId string, policy *McisHealthPolicy) {
+	now := time.Now()
+	policy.LastCheckTime = now.Format(time.RFC3339)
+	if policy.VmHealth == nil {
+		policy.VmHealth = map[string]VmHealthInfo{}
+	}
+
+	vmList, err := ListVmId(nsId, policy.Id)
+	if err != nil {
+		common.CBLog.Error(err)
+		return
+	}
+
+	// forget VMs removed from MCIS
+	for vmId := range policy.VmHealth {
+		if !contains(vmList, vmId) {
+			delete(policy.VmHealth, vmId)
+		}
+	}
+
+	for _, vmId := range vmList {
+		health := policy.VmHealth[vmId]
+		graceUntil, _ := time.Parse(time.RFC3339, health.GraceUntil)
+		if now.Before(graceUntil) {
+			continue
+		}
+		health.GraceUntil = ""
+
+		checked, err := checkVmHealth(nsId, policy, vmId)
+		if !checked {
+			// VM in operation (creating, suspended, ...) is not checked
+			continue
+		}
+		health.LastCheckTime = now.Format(time.RFC3339)
+		if err == nil {
+			policy.VmHealth[vmId] = VmHealthInfo{Status: VmHealthHealthy, LastCheckTime: health.LastCheckTime}
+			continue
+		}
+
+		health.ConsecutiveFailures++
+		health.LastError = err.Error()
+		if health.Status != VmHealthUnrecoverable {
+			health.Status = VmHealthUnhealthy
+		}
+		fmt.Printf("[Health Check] %s is unhealthy (%d/%d): %s\n", vmId, health.ConsecutiveFailures, policy.FailureThreshold, health.LastError)
+
+		if health.Status == VmHealthUnrecoverable || health.ConsecutiveFailures < policy.FailureThreshold {
+			policy.VmHealth[vmId] = health
+			continue
+		}
+		if health.RemediationStep >= len(policy.Remediation) {
+			health.Status = VmHealthUnrecoverable
+			policy.VmHealth[vmId] = health
+			common.CBLog.Error("[Health Check] " + policy.Id + "/" + vmId + " is unrecoverable by remediation: " + strings.Join(policy.Remediation, ", "))
+			continue
+		}
+
+		remediation := policy.Remediation[health.RemediationStep]
+		health.RemediationStep++
+		health.ConsecutiveFailures = 0
+		health.GraceUntil = time.Now().Add(time.Duration(policy.GracePeriodSec) * time.Second).Format(time.RFC3339)
+		policy.VmHealth[vmId] = health
+
+		newVmId := remediateVm(nsId, policy, vmId, remediation, health.LastError)
+		if newVmId != "" {
+			// the replacement starts with a clean health record (the VM is kept if it is not deleted)
+			policy.VmHealth[newVmId] = VmHealthInfo{Status: VmHealthHealthy, GraceUntil: health.GraceUntil}
+			if check, _ := CheckVm(nsId, policy.Id, vmId); !check {
+				delete(policy.VmHealth, vmId)
+			}
+		}
+	}
+}
+
+// checkVmHealth is func to check a VM by the health policy.
+// It returns false if the VM is not checked (in operation or stopped by user), and the reason if the VM is unhealthy.
+func checkVmHealth(nsId string, policy *McisHealthPolicy, vmId string) (bool, error) {
+	vmStatus, err := GetVmStatus(nsId, policy.Id, vmId)
+	if err != nil {
+		return true, err
+	}
+	switch vmStatus.Status {
+	case StatusCreating, StatusRebooting, StatusSuspending, StatusSuspended, StatusResuming, StatusTerminating, StatusTerminated:
+		return false, nil
+	case StatusFailed, StatusUndefined:
+		return true, fmt.Errorf("The VM status is " + vmStatus.Status + " (" + vmStatus.NativeStatus + ")")
+	}
+
+	switch policy.CheckType {
+	case HealthCheckTcp:
+		port := policy.TcpPort
+		if port == "" {
+			port = vmStatus.SSHPort
+		}
+		return true, checkConnectivityWithRetry(vmStatus.PublicIp, port, 1, time.Duration(policy.TimeoutSec)*time.Second)
+
+	case HealthCheckCommand:
+		userName, sshKey, err := VerifySshUserName(nsId, policy.Id, vmId, vmStatus.PublicIp, vmStatus.SSHPort, policy.Command.UserName)
+		if err != nil {
+			return true, err
+		}
+		result, err := RunRemoteCommandWithPool(common.GenMcisKey(nsId, policy.Id, vmId), vmStatus.PublicIp, vmStatus.SSHPort, userName, sshKey, policy.Command.Command)
+		if err != nil {
+			// non-zero exit code or SSH failure
+			if result != nil && *result != "" {
+				return true, fmt.Errorf(err.Error() + ": " + strings.TrimSpace(*result))
+			}
+			return true, err
+		}
+	}
+	return true, nil
+}
+
+// remediateVm is func to take a remediation step for an unhealthy VM. It returns the ID of the replacement VM (if created, even though the VM is not deleted).
+func remediateVm(nsId string, policy *McisHealthPolicy, vmId string, remediation string, reason string) string {
+	log := McisHealthLog{
+		McisId:      policy.Id,
+		VmId:        vmId,
+		Remediation: remediation,
+		Reason:      reason,
+		StartTime:   time.Now().Format(time.RFC3339Nano),
+		Result:      "Succeeded",
+	}
+
+	var err error
+	switch remediation {
+	case HealthRemediationReboot:
+		err = ControlVm(nsId, policy.Id, vmId, ActionReboot)
+	case HealthRemediationReplace:
+		var newVm TbVmInfo
+		newVm, err = ReplaceMcisVm(nsId, policy.Id, vmId, &policy.PostCommand)
+		log.NewVmId = newVm.Id
+	}
+	if err != nil {
+		log.Result = "Failed"
+		log.Error = err.Error()
+	}
+	recordMcisHealthLog(nsId, log)
+	return log.NewVmId
+}
+
+// ReplaceMcisVm is func to replace a VM in MCIS with a new VM of the same spec and image.
+// The post command of the VM (or the given one if the VM has none) is run on the new VM, and then the VM is deleted.
+// If the new VM is failed (or its post command), the new VM is deleted and the VM is kept.
+func ReplaceMcisVm(nsId string, mcisId string, vmId string, postCommand *McisCmdReq) (TbVmInfo, error) {
+	vmObj, err := GetVmObject(nsId, mcisId, vmId)
+	if err != nil {
+		common.CBLog.Error(err)
+		return TbVmInfo{}, err
+	}
+
+	// replace the UID appended by the previous replacement (to keep the name short)
+	name := vmObj.Name
+	if i := strings.LastIndex(name, "-"); i > 0 && len(name)-i-1 == len(common.GenUid()) {
+		name = name[:i]
+	}
+
+	vmReq := TbVmInfo{
+		Name:             name + "-" + common.GenUid(),
+		VmGroupId:        vmObj.VmGroupId,
+		Label:            vmObj.Label,
+		Description:      vmObj.Description,
+		ConnectionName:   vmObj.ConnectionName,
+		SpecId:           vmObj.SpecId,
+		ImageId:          vmObj.ImageId,
+		VNetId:           vmObj.VNetId,
+		SubnetId:         vmObj.SubnetId,
+		SecurityGroupIds: vmObj.SecurityGroupIds,
+		SshKeyId:         vmObj.SshKeyId,
+		VmUserAccount:    vmObj.VmUserAccount,
+		VmUserPassword:   vmObj.VmUserPassword,
+		UserData:         vmObj.UserData,
+		UserDataEncoding: vmObj.UserDataEncoding,
+		PostCommand:      vmObj.PostCommand,
+	}
+	if vmReq.UserData != "" && vmReq.UserDataEncoding == "" {
+		// user data of a created VM is kept in base64
+		vmReq.UserDataEncoding = UserDataEncodingBase64
+	}
+	if vmReq.PostCommand.Command == "" && postCommand != nil {
+		vmReq.PostCommand = *postCommand
+	}
+
+	fmt.Println("[Replace VM] " + vmId + " with " + vmReq.Name)
+	result, err := CorePostMcisVm(nsId, mcisId, &vmReq)
+	if err != nil {
+		common.CBLog.Error(err)
+		return TbVmInfo{}, err
+	}
+	if result.Status == StatusFailed {
+		// keep the VM if the replacement is failed
+		DelMcisVm(nsId, mcisId, result.Id, "force")
+		return TbVmInfo{}, fmt.Errorf("The replacement VM " + result.Id + " is failed: " + result.SystemMessage)
+	}
+	if vmReq.VmGroupId != "" {
+		updateVmGroupMember(nsId, mcisId, vmReq.VmGroupId, result.Id, true)
+	}
+
+	if vmReq.PostCommand.Command != "" {
+		fmt.Println("[Post Command to VM] " + vmReq.PostCommand.Command)
+		_, err := RemoteCommandToMcisVm(nsId, mcisId, result.Id, &vmReq.PostCommand)
+		if err != nil {
+			common.CBLog.Error(err)
+			// keep the VM if the post command is failed on the replacement
+			DelMcisVm(nsId, mcisId, result.Id, "force")
+			if vmReq.VmGroupId != "" {
+				updateVmGroupMember(nsId, mcisId, vmReq.VmGroupId, result.Id, false)
+			}
+			return TbVmInfo{}, fmt.Errorf("The post command on the replacement VM " + result.Id + " is failed: " + err.Error())
+		}
+	}
+
+	err = DelMcisVm(nsId, mcisId, vmId, "force")
+	if err != nil {
+		common.CBLog.Error(err)
+		return *result, err
+	}
+	if vmObj.VmGroupId != "" {
+		updateVmGroupMember(nsId, mcisId, vmObj.VmGroupId, vmId, false)
+	}
+	return *result, nil
+}
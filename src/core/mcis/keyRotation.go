@@ -0,0 +1,318 @@
+/*
+Copyright 2019 The Cloud-Barista Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package mcis is to manage multi-cloud infra service
+package mcis
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/cloud-barista/cb-tumblebug/src/core/common"
+	"github.com/cloud-barista/cb-tumblebug/src/core/mcir"
+	"golang.org/x/crypto/ssh"
+)
+
+const (
+	// sshKeyRotationKeyBits is the size of RSA key generated for SSH key rotation
+	sshKeyRotationKeyBits int = 4096
+
+	// authorizedKeysFile is the path of authorized_keys in the home directory of the VM user
+	authorizedKeysFile string = "~/.ssh/authorized_keys"
+)
+
+// VM status of SSH key rotation
+const (
+	KeyRotationRotated          string = "Rotated"
+	KeyRotationFailed           string = "Failed"
+	KeyRotationRolledBack       string = "RolledBack"
+	KeyRotationOldKeyNotRemoved string = "OldKeyNotRemoved"
+)
+
+// TbSshKeyRotateReq is struct for requirements to rotate an SSH key
+type TbSshKeyRotateReq struct {
+	// PrivateKey is a new private key in PEM format (a new RSA key pair is generated if empty)
+	PrivateKey string `json:"privateKey"`
+
+	// UserName is the VM user name to log in (the verified user name of the key is used if empty)
+	UserName string `json:"userName" example:"cb-user"`
+}
+
+// SshKeyRotateVmResult is struct for the result of SSH key rotation on a VM
+type SshKeyRotateVmResult struct {
+	McisId  string `json:"mcisId"`
+	VmId    string `json:"vmId"`
+	VmIp    string `json:"vmIp"`
+	Status  string `json:"status" example:"Rotated"`
+	Message string `json:"message"`
+}
+
+// TbSshKeyRotateResult is struct for the result of SSH key rotation
+type TbSshKeyRotateResult struct {
+	SshKeyId string `json:"sshKeyId"`
+
+	// Status is Succeeded if the stored key has been replaced with the new key, otherwise Failed
+	Status      string                 `json:"status" example:"Succeeded"`
+	Fingerprint string                 `json:"fingerprint"`
+	Vm          []SshKeyRotateVmResult `json:"vm"`
+}
+
+// sshKeyRotateTarget is struct for a VM associated with the SSH key
+type sshKeyRotateTarget struct {
+	nsId     string
+	mcisId   string
+	vmId     string
+	vmIp     string
+	sshPort  string
+	userName string
+	result   SshKeyRotateVmResult
+}
+
+// generateSshKeyPair is func to generate an RSA key pair (PEM private key)
+func generateSshKeyPair() (string, error) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, sshKeyRotationKeyBits)
+	if err != nil {
+		return "", err
+	}
+	privatePem := pem.EncodeToMemory(&pem.Block{
+		Type:  "RSA PRIVATE KEY",
+		Bytes: x509.MarshalPKCS1PrivateKey(privateKey),
+	})
+	return string(privatePem), nil
+}
+
+// getAuthorizedKey is func to get the authorized_keys line (without comment) and fingerprint of a private key
+func getAuthorizedKey(privateKey string) (string, string, error) {
+	signer, err := ssh.ParsePrivateKey([]byte(privateKey))
+	if err != nil {
+		return "", "", err
+	}
+	authorizedKey := strings.TrimSpace(string(ssh.MarshalAuthorizedKey(signer.PublicKey())))
+	return authorizedKey, ssh.FingerprintSHA256(signer.PublicKey()), nil
+}
+
+// getAuthorizedKeyBlob is func to get the base64 key blob from an authorized_keys line
+func getAuthorizedKeyBlob(authorizedKey string) string {
+	fields := strings.Fields(authorizedKey)
+	if len(fields) < 2 {
+		return ""
+	}
+	return fields[1]
+}
+
+// cmdAddAuthorizedKey is func to generate a command adding a public key to authorized_keys
+func cmdAddAuthorizedKey(authorizedKey string) string {
+	return "mkdir -p ~/.ssh && chmod 700 ~/.ssh && " +
+		"(grep -q -F '" + getAuthorizedKeyBlob(authorizedKey) + "' " + authorizedKeysFile + " 2>/dev/null || " +
+		"echo '" + authorizedKey + "' >> " + authorizedKeysFile + ") && chmod 600 " + authorizedKeysFile
+}
+
+// cmdRemoveAuthorizedKey is func to generate a command removing a public key from authorized_keys
+func cmdRemoveAuthorizedKey(authorizedKey string) string {
+	tmpFile := authorizedKeysFile + ".tmp"
+	return "(grep -v -F '" + getAuthorizedKeyBlob(authorizedKey) + "' " + authorizedKeysFile + " > " + tmpFile + " || true) && " +
+		"mv " + tmpFile + " " + authorizedKeysFile + " && chmod 600 " + authorizedKeysFile
+}
+
+// runKeyRotationStep is func to run a step of SSH key rotation for the targets in parallel
+func runKeyRotationStep(targets []*sshKeyRotateTarget, step func(t *sshKeyRotateTarget)) {
+	var wg sync.WaitGroup
+	limiter := newSshLimiter()
+	for _, t := range targets {
+		limiter.acquire()
+		wg.Add(1)
+		go func(t *sshKeyRotateTarget) {
+			defer wg.Done()
+			defer limiter.release()
+			step(t)
+		}(t)
+	}
+	wg.Wait()
+}
+
+// RotateSshKey is func to rotate an SSH key on all the VMs associated with the key.
+// The new public key is installed and verified on every VM and stored before the old key is removed.
+// If any VM fails or the new key cannot be stored, the new key is removed from the VMs (rollback) and the stored key is not changed.
+// Note that the key pair registered in CSP is not changed, so VMs created with the key afterward get the old public key.
+func RotateSshKey(nsId string, sshKeyId string, req *TbSshKeyRotateReq) (TbSshKeyRotateResult, error) {
+
+	err := common.CheckString(nsId)
+	if err != nil {
+		common.CBLog.Error(err)
+		return TbSshKeyRotateResult{}, err
+	}
+
+	err = common.CheckString(sshKeyId)
+	if err != nil {
+		common.CBLog.Error(err)
+		return TbSshKeyRotateResult{}, err
+	}
+
+	tempInterface, err := mcir.GetResource(nsId, common.StrSSHKey, sshKeyId)
+	if err != nil {
+		common.CBLog.Error(err)
+		return TbSshKeyRotateResult{}, err
+	}
+	sshKeyInfo := mcir.TbSshKeyInfo{}
+	err = common.CopySrcToDest(&tempInterface, &sshKeyInfo)
+	if err != nil {
+		common.CBLog.Error(err)
+		return TbSshKeyRotateResult{}, err
+	}
+
+	oldAuthorizedKey, _, err := getAuthorizedKey(sshKeyInfo.PrivateKey)
+	if err != nil {
+		err := fmt.Errorf("Failed to parse the current private key of the sshKey " + sshKeyId + ": " + err.Error())
+		common.CBLog.Error(err)
+		return TbSshKeyRotateResult{}, err
+	}
+
+	newPrivateKey := req.PrivateKey
+	if newPrivateKey == "" {
+		newPrivateKey, err = generateSshKeyPair()
+		if err != nil {
+			common.CBLog.Error(err)
+			return TbSshKeyRotateResult{}, err
+		}
+	}
+	newAuthorizedKey, newFingerprint, err := getAuthorizedKey(newPrivateKey)
+	if err != nil {
+		err := fmt.Errorf("Failed to parse the new private key: " + err.Error())
+		common.CBLog.Error(err)
+		return TbSshKeyRotateResult{}, err
+	}
+	if getAuthorizedKeyBlob(newAuthorizedKey) == getAuthorizedKeyBlob(oldAuthorizedKey) {
+		err := fmt.Errorf("The new key is the same as the current key of the sshKey " + sshKeyId)
+		return TbSshKeyRotateResult{}, err
+	}
+
+	result := TbSshKeyRotateResult{SshKeyId: sshKeyId}
+
+	// associated objects of sshKey are VM keys (/ns/{nsId}/mcis/{mcisId}/vm/{vmId})
+	var targets []*sshKeyRotateTarget
+	for _, v := range sshKeyInfo.AssociatedObjectList {
+		keyParts := strings.Split(v, "/")
+		if len(keyParts) != 7 || keyParts[3] != "mcis" || keyParts[5] != "vm" {
+			continue
+		}
+		t := &sshKeyRotateTarget{nsId: keyParts[2], mcisId: keyParts[4], vmId: keyParts[6]}
+		t.vmIp, t.sshPort = GetVmIp(t.nsId, t.mcisId, t.vmId)
+		t.result = SshKeyRotateVmResult{McisId: t.mcisId, VmId: t.vmId, VmIp: t.vmIp}
+		targets = append(targets, t)
+	}
+
+	fmt.Println("[Rotate SSH key] " + sshKeyId + " for " + fmt.Sprint(len(targets)) + " VMs")
+
+	// Step 1: install the new public key with the current key and verify login with the new key
+	runKeyRotationStep(targets, func(t *sshKeyRotateTarget) {
+		userName, _, err := VerifySshUserName(t.nsId, t.mcisId, t.vmId, t.vmIp, t.sshPort, req.UserName)
+		if err != nil {
+			t.result.Status = KeyRotationFailed
+			t.result.Message = "Failed to log in with the current key: " + err.Error()
+			return
+		}
+		t.userName = userName
+
+		_, err = RunRemoteCommand(t.vmIp, t.sshPort, t.userName, sshKeyInfo.PrivateKey, cmdAddAuthorizedKey(newAuthorizedKey))
+		if err != nil {
+			t.result.Status = KeyRotationFailed
+			t.result.Message = "Failed to install the new key: " + err.Error()
+			return
+		}
+
+		_, err = RunRemoteCommand(t.vmIp, t.sshPort, t.userName, newPrivateKey, "echo verified")
+		if err != nil {
+			t.result.Status = KeyRotationFailed
+			t.result.Message = "Failed to log in with the new key: " + err.Error()
+			return
+		}
+		t.result.Status = KeyRotationRotated
+	})
+
+	// rollback removes the new public key from the VMs where it has been installed
+	rollback := func(message string) {
+		runKeyRotationStep(targets, func(t *sshKeyRotateTarget) {
+			if t.userName == "" {
+				return
+			}
+			_, err := RunRemoteCommand(t.vmIp, t.sshPort, t.userName, sshKeyInfo.PrivateKey, cmdRemoveAuthorizedKey(newAuthorizedKey))
+			if t.result.Status == KeyRotationRotated {
+				t.result.Status = KeyRotationRolledBack
+				t.result.Message = message
+			}
+			if err != nil {
+				t.result.Message += " (failed to remove the new key: " + err.Error() + ")"
+			}
+		})
+
+		result.Status = "Failed"
+		for _, t := range targets {
+			result.Vm = append(result.Vm, t.result)
+		}
+	}
+
+	failed := false
+	for _, t := range targets {
+		if t.result.Status != KeyRotationRotated {
+			failed = true
+		}
+	}
+
+	if failed {
+		rollback("Rolled back since the rotation failed on other VMs")
+		err := fmt.Errorf("Failed to rotate the sshKey " + sshKeyId + " on some VMs. The changes have been rolled back.")
+		common.CBLog.Error(err)
+		return result, err
+	}
+
+	// Step 2: update the stored key before the old key is removed from VMs
+	// (VMs must not trust only a key which is not stored)
+	newSshKeyInfo := sshKeyInfo
+	newSshKeyInfo.PrivateKey = newPrivateKey
+	newSshKeyInfo.PublicKey = newAuthorizedKey
+	newSshKeyInfo.Fingerprint = newFingerprint
+
+	key := common.GenResourceKey(nsId, common.StrSSHKey, sshKeyId)
+	val, _ := json.Marshal(newSshKeyInfo)
+	err = common.CBStore.Put(key, string(val))
+	if err != nil {
+		common.CBLog.Error(err)
+		rollback("Rolled back since the new key could not be stored")
+		err := fmt.Errorf("Failed to store the new key of the sshKey " + sshKeyId + ". The changes have been rolled back: " + err.Error())
+		return result, err
+	}
+
+	// Step 3: remove the old public key with the new key
+	runKeyRotationStep(targets, func(t *sshKeyRotateTarget) {
+		_, err := RunRemoteCommand(t.vmIp, t.sshPort, t.userName, newPrivateKey, cmdRemoveAuthorizedKey(oldAuthorizedKey))
+		if err != nil {
+			t.result.Status = KeyRotationOldKeyNotRemoved
+			t.result.Message = "The new key is installed but the old key is not removed: " + err.Error()
+		}
+		// drop pooled connections authenticated with the old key
+		CloseVmSshConnection(t.nsId, t.mcisId, t.vmId)
+	})
+
+	result.Status = "Succeeded"
+	result.Fingerprint = newFingerprint
+	for _, t := range targets {
+		result.Vm = append(result.Vm, t.result)
+	}
+	return result, nil
+}
@@ -0,0 +1,357 @@
+/*
+Copyright 2019 The Cloud-Barista Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package mcis is to manage multi-cloud infra service
+package mcis
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloud-barista/cb-tumblebug/src/core/common"
+)
+
+// Origins of latency information
+const (
+	LatencyOriginBenchmark string = "benchmark"
+	LatencyOriginCsv       string = "csv"
+	LatencyOriginUser      string = "user"
+)
+
+// latencyMatrixFile is the asset file of the region-to-region latency matrix
+// HEADER: SourceCloudType, SourceRegion, TargetCloudType, TargetRegion, RttMs
+// (the file has no measurement by default, so latencies are estimated by distance until
+// they are measured by mrtt benchmark or registered by user)
+const latencyMatrixFile string = "../assets/cloudlatency.csv"
+
+// latencyPerKm is the estimated round trip time (ms) per km of distance when no measurement exists
+// (light in fiber travels about 200 km/ms, RTT doubles the path and routes are not straight lines)
+const latencyPerKm float64 = 0.015
+
+// latencyEstimateBase is the base round trip time (ms) added to the distance-based estimation
+const latencyEstimateBase float64 = 2
+
+// LatencyInfo is struct for the measured round trip time between two regions (or endpoints).
+// A region is identified by "cloudType/nativeRegion" (ex: aws/ap-northeast-2).
+type LatencyInfo struct {
+	Source      string  `json:"source" example:"aws/ap-northeast-2"`
+	Target      string  `json:"target" example:"gcp/asia-northeast3"`
+	RttMs       float64 `json:"rttMs" example:"32.5"`
+	Origin      string  `json:"origin,omitempty" example:"benchmark" enums:"benchmark,csv,user"`
+	UpdatedTime string  `json:"updatedTime,omitempty" example:"2021-05-11T09:14:33Z"`
+}
+
+// LatencyMatrix is struct for the list of latency information
+type LatencyMatrix struct {
+	Latency []LatencyInfo `json:"latency"`
+}
+
+// normalizeLatencyNode is func to normalize the name of a region (or endpoint) in latency matrix
+func normalizeLatencyNode(node string) string {
+	return strings.Trim(strings.ToLower(strings.ReplaceAll(node, " ", "")), "/")
+}
+
+// GetLatencyNodeOfConnection is func to get the latency matrix node (cloudType/nativeRegion) of a connection config
+func GetLatencyNodeOfConnection(connectionName string) (string, error) {
+	connConfig, err := common.GetConnConfig(connectionName)
+	if err != nil {
+		return "", err
+	}
+	region, err := common.GetRegion(connConfig.RegionName)
+	if err != nil {
+		return "", err
+	}
+	nativeRegion := ""
+	for _, v := range region.KeyValueInfoList {
+		if strings.ToLower(v.Key) == "region" || strings.ToLower(v.Key) == "location" {
+			nativeRegion = v.Value
+			break
+		}
+	}
+	if connConfig.ProviderName == "" || nativeRegion == "" {
+		return "", fmt.Errorf("Cannot identify the region of connection " + connectionName)
+	}
+	return normalizeLatencyNode(connConfig.ProviderName + "/" + nativeRegion), nil
+}
+
+// PutLatency is func to register latency information in the matrix
+func PutLatency(latency LatencyInfo) (LatencyInfo, error) {
+	latency.Source = normalizeLatencyNode(latency.Source)
+	latency.Target = normalizeLatencyNode(latency.Target)
+	if latency.Source == "" || latency.Target == "" {
+		return latency, fmt.Errorf("The source and target of latency are required")
+	}
+	if latency.Source == latency.Target {
+		return latency, fmt.Errorf("The source and target of latency are the same (" + latency.Source + ")")
+	}
+	if latency.RttMs <= 0 {
+		return latency, fmt.Errorf("The rttMs of latency " + latency.Source + " -> " + latency.Target + " should be positive")
+	}
+	if latency.Origin == "" {
+		latency.Origin = LatencyOriginUser
+	}
+	if latency.UpdatedTime == "" {
+		latency.UpdatedTime = time.Now().UTC().Format(time.RFC3339)
+	}
+
+	val, _ := json.Marshal(latency)
+	err := common.CBStore.Put(common.GenLatencyKey(latency.Source, latency.Target), string(val))
+	if err != nil {
+		common.CBLog.Error(err)
+		return latency, err
+	}
+	return latency, nil
+}
+
+// RegisterLatencyMatrix is func to register a list of latency information
+func RegisterLatencyMatrix(req *LatencyMatrix) (LatencyMatrix, error) {
+	result := LatencyMatrix{Latency: []LatencyInfo{}}
+	for _, v := range req.Latency {
+		latency, err := PutLatency(v)
+		if err != nil {
+			return result, err
+		}
+		result.Latency = append(result.Latency, latency)
+	}
+	return result, nil
+}
+
+// GetLatencyMatrix is func to get the latency matrix (only from the source if source is given)
+func GetLatencyMatrix(source string) (LatencyMatrix, error) {
+	result := LatencyMatrix{Latency: []LatencyInfo{}}
+	keyValue, err := common.CBStore.GetList(common.GenLatencyKey(normalizeLatencyNode(source), ""), true)
+	if err != nil {
+		common.CBLog.Error(err)
+		return result, err
+	}
+	for _, v := range keyValue {
+		latency := LatencyInfo{}
+		err = json.Unmarshal([]byte(v.Value), &latency)
+		if err != nil {
+			common.CBLog.Error(err)
+			continue
+		}
+		if source != "" && latency.Source != normalizeLatencyNode(source) {
+			continue
+		}
+		result.Latency = append(result.Latency, latency)
+	}
+	return result, nil
+}
+
+// DelLatencyMatrix is func to delete all latency information
+func DelLatencyMatrix() error {
+	keyValue, err := common.CBStore.GetList(common.GenLatencyKey("", ""), true)
+	if err != nil {
+		common.CBLog.Error(err)
+		return err
+	}
+	for _, v := range keyValue {
+		err = common.CBStore.Delete(v.Key)
+		if err != nil {
+			common.CBLog.Error(err)
+			return err
+		}
+	}
+	return nil
+}
+
+// LoadLatencyMatrix is func to import the latency matrix from the asset file (assets/cloudlatency.csv)
+func LoadLatencyMatrix() (LatencyMatrix, error) {
+	result := LatencyMatrix{Latency: []LatencyInfo{}}
+
+	file, fileErr := os.Open(latencyMatrixFile)
+	defer file.Close()
+	if fileErr != nil {
+		common.CBLog.Error(fileErr)
+		return result, fileErr
+	}
+
+	rdr := csv.NewReader(bufio.NewReader(file))
+	rows, err := rdr.ReadAll()
+	if err != nil {
+		common.CBLog.Error(err)
+		return result, err
+	}
+
+	for i, row := range rows {
+		if i == 0 || len(row) < 5 {
+			// skip header
+			continue
+		}
+		rtt, err := strconv.ParseFloat(strings.TrimSpace(row[4]), 64)
+		if err != nil {
+			fmt.Printf("[LoadLatencyMatrix] skip line %d: %v\n", i+1, row)
+			continue
+		}
+		latency := LatencyInfo{
+			Source: row[0] + "/" + row[1],
+			Target: row[2] + "/" + row[3],
+			RttMs:  rtt,
+			Origin: LatencyOriginCsv,
+		}
+		latency, err = PutLatency(latency)
+		if err != nil {
+			fmt.Printf("[LoadLatencyMatrix] skip line %d: %v\n", i+1, err)
+			continue
+		}
+		result.Latency = append(result.Latency, latency)
+	}
+	return result, nil
+}
+
+// getMeasuredLatency is func to get the measured latency between two nodes (either direction)
+func getMeasuredLatency(source string, target string) (float64, bool) {
+	for _, key := range []string{common.GenLatencyKey(source, target), common.GenLatencyKey(target, source)} {
+		keyValue, err := common.CBStore.Get(key)
+		if err != nil || keyValue == nil {
+			continue
+		}
+		latency := LatencyInfo{}
+		err = json.Unmarshal([]byte(keyValue.Value), &latency)
+		if err == nil && latency.RttMs > 0 {
+			return latency.RttMs, true
+		}
+	}
+	return 0, false
+}
+
+// getLatencyNodeLocation is func to get the coordinate of a node ("cloudType/nativeRegion" or "latitude/longitude")
+func getLatencyNodeLocation(node string) (float64, float64, error) {
+	slice := strings.Split(node, "/")
+	if len(slice) == 2 {
+		latitude, errLat := strconv.ParseFloat(slice[0], 64)
+		longitude, errLon := strconv.ParseFloat(slice[1], 64)
+		if errLat == nil && errLon == nil {
+			return latitude, longitude, nil
+		}
+		location := common.GetCloudLocation(slice[0], slice[1])
+		if location.NativeRegion == slice[1] {
+			latitude, errLat = strconv.ParseFloat(strings.TrimSpace(location.Latitude), 64)
+			longitude, errLon = strconv.ParseFloat(strings.TrimSpace(location.Longitude), 64)
+			if errLat == nil && errLon == nil {
+				return latitude, longitude, nil
+			}
+		}
+	}
+	return 0, 0, fmt.Errorf("Cannot find the location of " + node)
+}
+
+// EstimateLatency is func to estimate the round trip time (ms) between a region and a target (region, endpoint or "latitude/longitude").
+// The measured latency is used if exists. Otherwise, it is estimated by the geographical distance.
+func EstimateLatency(source string, target string) (float64, error) {
+	source = normalizeLatencyNode(source)
+	target = normalizeLatencyNode(target)
+	if source == target {
+		return 0, nil
+	}
+	if rtt, ok := getMeasuredLatency(source, target); ok {
+		return rtt, nil
+	}
+
+	sourceLat, sourceLon, err := getLatencyNodeLocation(source)
+	if err != nil {
+		return 0, fmt.Errorf("No latency between " + source + " and " + target + ": " + err.Error())
+	}
+	targetLat, targetLon, err := getLatencyNodeLocation(target)
+	if err != nil {
+		return 0, fmt.Errorf("No latency between " + source + " and " + target + ": " + err.Error())
+	}
+	distance := getHaversineDistance(sourceLat, sourceLon, targetLat, targetLon)
+	return latencyEstimateBase + distance*latencyPerKm, nil
+}
+
+// loadLatencyMatrixIfEmpty is func to import the asset file if the latency matrix is empty
+func loadLatencyMatrixIfEmpty() {
+	keyValue, err := common.CBStore.GetList(common.GenLatencyKey("", ""), true)
+	if err != nil || len(keyValue) != 0 {
+		return
+	}
+	result, err := LoadLatencyMatrix()
+	if err != nil {
+		common.CBLog.Error(err)
+	}
+	if len(result.Latency) == 0 {
+		fmt.Printf("[Latency] no measured latency in %s, latencies are estimated by geographical distance\n", latencyMatrixFile)
+	}
+}
+
+// recordBenchmarkLatency is func to record the result of mrtt benchmark in the latency matrix
+func recordBenchmarkLatency(nsId string, mcisId string, results BenchmarkInfoArray) {
+	vmList, err := ListVmId(nsId, mcisId)
+	if err != nil {
+		common.CBLog.Error(err)
+		return
+	}
+
+	// find the region of each spec in MCIS
+	specNode := map[string]string{}
+	for _, vmId := range vmList {
+		vmObj, err := GetVmObject(nsId, mcisId, vmId)
+		if err != nil {
+			continue
+		}
+		if _, ok := specNode[vmObj.SpecId]; ok {
+			continue
+		}
+		node, err := GetLatencyNodeOfConnection(vmObj.ConnectionName)
+		if err != nil {
+			common.CBLog.Error(err)
+			continue
+		}
+		specNode[vmObj.SpecId] = node
+	}
+
+	type latencySum struct {
+		sum   float64
+		count int
+	}
+	sums := map[[2]string]*latencySum{}
+	for _, source := range results.ResultArray {
+		for _, target := range source.ResultArray {
+			sourceNode, targetNode := specNode[source.SpecId], specNode[target.SpecId]
+			if sourceNode == "" || targetNode == "" || sourceNode == targetNode {
+				continue
+			}
+			rtt, err := strconv.ParseFloat(strings.TrimSpace(target.Result), 64)
+			if err != nil || rtt <= 0 {
+				continue
+			}
+			if strings.ToLower(target.Unit) == "s" {
+				rtt = rtt * 1000
+			}
+			key := [2]string{sourceNode, targetNode}
+			if sums[key] == nil {
+				sums[key] = &latencySum{}
+			}
+			sums[key].sum += rtt
+			sums[key].count++
+		}
+	}
+
+	for key, v := range sums {
+		latency := LatencyInfo{Source: key[0], Target: key[1], RttMs: v.sum / float64(v.count), Origin: LatencyOriginBenchmark}
+		_, err := PutLatency(latency)
+		if err != nil {
+			common.CBLog.Error(err)
+			continue
+		}
+		fmt.Printf("[Latency] %s -> %s: %.3f ms (benchmark)\n", key[0], key[1], latency.RttMs)
+	}
+}
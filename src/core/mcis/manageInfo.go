@@ -1333,6 +1333,11 @@ func UpdateVmInfo(nsId string, mcisId string, vmInfoData TbVmInfo) {
 	vmTmp := TbVmInfo{}
 	json.Unmarshal([]byte(keyValue.Value), &vmTmp)
 
+	if vmTmp.Status != vmInfoData.Status {
+		// track running intervals for cost accounting
+		recordVmCost(nsId, mcisId, vmInfoData)
+	}
+
 	if !reflect.DeepEqual(vmTmp, vmInfoData) {
 		val, _ := json.Marshal(vmInfoData)
 		err = common.CBStore.Put(key, string(val))
@@ -1446,6 +1451,7 @@ func DelMcis(nsId string, mcisId string, option string) error {
 			common.CBLog.Error(err)
 			return err
 		}
+		closeVmCost(nsId, mcisId, v)
 
 		mcir.UpdateAssociatedObjectList(nsId, common.StrImage, vmInfo.ImageId, common.StrDelete, vmKey)
 		mcir.UpdateAssociatedObjectList(nsId, common.StrSpec, vmInfo.SpecId, common.StrDelete, vmKey)
@@ -1480,6 +1486,29 @@ func DelMcis(nsId string, mcisId string, option string) error {
 		return err
 	}
 
+	// close pooled SSH connections of the VMs in the MCIS
+	CloseVmSshConnection(nsId, mcisId, "")
+
+	// delete retained monitoring samples
+	err = DelMonitoringHistory(nsId, mcisId, "")
+	if err != nil {
+		common.CBLog.Error(err)
+	}
+
+	// delete the health policy with remediation logs and the policy history
+	// so that an MCIS created later with the same ID does not inherit them
+	check, _ = CheckMcisHealthPolicy(nsId, mcisId)
+	if check {
+		err = DelMcisHealthPolicy(nsId, mcisId)
+		if err != nil {
+			common.CBLog.Error(err)
+		}
+	}
+	err = DelMcisPolicyHistory(nsId, mcisId)
+	if err != nil {
+		common.CBLog.Error(err)
+	}
+
 	return nil
 }
 
@@ -1536,6 +1565,13 @@ func DelMcisVm(nsId string, mcisId string, vmId string, option string) error {
 		common.CBLog.Error(err)
 		return err
 	}
+	closeVmCost(nsId, mcisId, vmId)
+
+	// close pooled SSH connection of the VM
+	CloseVmSshConnection(nsId, mcisId, vmId)
+
+	// delete retained monitoring samples of the VM (if exist)
+	DelMonitoringHistory(nsId, mcisId, vmId)
 
 	mcir.UpdateAssociatedObjectList(nsId, common.StrImage, vmInfo.ImageId, common.StrDelete, key)
 	mcir.UpdateAssociatedObjectList(nsId, common.StrSpec, vmInfo.SpecId, common.StrDelete, key)
@@ -1610,6 +1646,12 @@ func GetVmTemplate(nsId string, mcisId string, algo string) (TbVmInfo, error) {
 		common.CBLog.Error(err)
 		return TbVmInfo{}, err
 	}
+	return getVmTemplateFromList(nsId, mcisId, vmList)
+
+}
+
+// getVmTemplateFromList is func to get a VM template (required to create VM) from a VM picked randomly in the list
+func getVmTemplateFromList(nsId string, mcisId string, vmList []string) (TbVmInfo, error) {
 	if len(vmList) == 0 {
 		return TbVmInfo{}, nil
 	}
@@ -1632,10 +1674,9 @@ func GetVmTemplate(nsId string, mcisId string, algo string) (TbVmInfo, error) {
 	vmTemplate.VmUserPassword = vmObj.VmUserPassword
 
 	if vmErr != nil {
-		common.CBLog.Error(err)
+		common.CBLog.Error(vmErr)
 		return TbVmInfo{}, vmErr
 	}
 
 	return vmTemplate, nil
-
 }
@@ -0,0 +1,499 @@
+/*
+Copyright 2019 The Cloud-Barista Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package mcis is to manage multi-cloud infra service
+package mcis
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cloud-barista/cb-tumblebug/src/core/common"
+	"github.com/tidwall/gjson"
+)
+
+// Metrics providers (sources of VM metrics for monitoring and MCIS policies)
+const (
+	// MetricsProviderDragonfly is const for CB-Dragonfly on-demand monitoring (requires the monitoring agent).
+	MetricsProviderDragonfly string = "dragonfly"
+
+	// MetricsProviderPrometheus is const for Prometheus HTTP query API (ex: node_exporter on VMs).
+	MetricsProviderPrometheus string = "prometheus"
+
+	// MetricsProviderSsh is const for reading /proc of VMs by SSH (agentless).
+	MetricsProviderSsh string = "ssh"
+)
+
+// MetricsSource is struct for the source of VM metrics of MCIS policy (CB-Dragonfly if empty)
+type MetricsSource struct {
+	Provider string `json:"provider" example:"dragonfly" enums:"dragonfly,prometheus,ssh"`
+
+	// Fields for prometheus provider
+	PrometheusUrl string `json:"prometheusUrl,omitempty" example:"http://localhost:9090"`
+	// PrometheusQuery is PromQL for each metric (cpu, mem, disk, net, load) to override the default query for node_exporter.
+	// {{nsId}} and {{mcisId}} in the query are replaced. The net should be a rate (bytes/s) since values are retained and averaged.
+	PrometheusQuery map[string]string `json:"prometheusQuery,omitempty"`
+	// PrometheusVmLabel is the label of the result to find the VM by VM ID or IP (default: instance)
+	PrometheusVmLabel string `json:"prometheusVmLabel,omitempty" example:"instance"`
+
+	// Fields for ssh provider
+	SshUserName string `json:"sshUserName,omitempty"`
+}
+
+// MetricsProvider is interface for sources of VM metrics
+type MetricsProvider interface {
+	// GetMcisMetric returns the metric value of each VM in MCIS
+	GetMcisMetric(nsId string, mcisId string, metric string) ([]MonResultSimple, error)
+}
+
+// ValidateMetricsSource is func to validate MetricsSource of MCIS policy
+func ValidateMetricsSource(source *MetricsSource) error {
+	if source == nil {
+		return nil
+	}
+	switch source.Provider {
+	case "", MetricsProviderDragonfly, MetricsProviderSsh:
+	case MetricsProviderPrometheus:
+		if source.PrometheusUrl == "" {
+			return fmt.Errorf("The prometheusUrl is required for the prometheus provider")
+		}
+		if _, err := url.ParseRequestURI(source.PrometheusUrl); err != nil {
+			return fmt.Errorf("The prometheusUrl (" + source.PrometheusUrl + ") is not valid")
+		}
+	default:
+		return fmt.Errorf("The provider (" + source.Provider + ") is not available. Use " + MetricsProviderDragonfly + ", " + MetricsProviderPrometheus + ", " + MetricsProviderSsh)
+	}
+	return nil
+}
+
+// GetMetricsProvider is func to get MetricsProvider by MetricsSource (CB-Dragonfly if nil)
+func GetMetricsProvider(source *MetricsSource) MetricsProvider {
+	if source == nil {
+		return &dragonflyMetricsProvider{}
+	}
+	switch source.Provider {
+	case MetricsProviderPrometheus:
+		return &prometheusMetricsProvider{source: *source}
+	case MetricsProviderSsh:
+		return &sshMetricsProvider{userName: source.SshUserName}
+	}
+	return &dragonflyMetricsProvider{}
+}
+
+// GetMcisMetricBySource is func to get the metric of VMs in MCIS from MetricsSource
+func GetMcisMetricBySource(nsId string, mcisId string, metric string, source *MetricsSource) (MonResultSimpleResponse, error) {
+	content := MonResultSimpleResponse{}
+
+	err := common.CheckString(nsId)
+	if err != nil {
+		common.CBLog.Error(err)
+		return content, err
+	}
+	err = common.CheckString(mcisId)
+	if err != nil {
+		common.CBLog.Error(err)
+		return content, err
+	}
+	check, _ := CheckMcis(nsId, mcisId)
+	if !check {
+		err := fmt.Errorf("The mcis " + mcisId + " does not exist.")
+		return content, err
+	}
+
+	resultArray, err := GetMetricsProvider(source).GetMcisMetric(nsId, mcisId, metric)
+	if err != nil {
+		return content, err
+	}
+	content.NsId = nsId
+	content.McisId = mcisId
+	content.McisMonitoring = resultArray
+
+	// retain the values of VMs (for range queries and forecasts)
+	recordMonitoringResults(nsId, mcisId, metric, content.McisMonitoring)
+
+	return content, nil
+}
+
+// dragonflyMetricsProvider is MetricsProvider by CB-Dragonfly on-demand monitoring.
+// For VMs without the monitoring agent (monAgentStatus: notInstalled), the latest sample of the agentless collector is used.
+type dragonflyMetricsProvider struct{}
+
+// GetMcisMetric is func to get the metric of VMs in MCIS from CB-Dragonfly (or the agentless collector)
+func (p *dragonflyMetricsProvider) GetMcisMetric(nsId string, mcisId string, metric string) ([]MonResultSimple, error) {
+	vmList, err := ListVmId(nsId, mcisId)
+	if err != nil {
+		return nil, err
+	}
+
+	//goroutin sync wg
+	var wg sync.WaitGroup
+	var resultArray []MonResultSimple
+	var vmResults = make([][]MonResultSimple, len(vmList))
+
+	method := "GET"
+
+	for i, v := range vmList {
+		vmId := v
+		vmObj, _ := GetVmObject(nsId, mcisId, vmId)
+		if vmObj.MonAgentStatus == monAgentNotInstalled {
+			vmResults[i] = []MonResultSimple{getLatestVmMetric(nsId, mcisId, vmId, metric)}
+			continue
+		}
+
+		wg.Add(1)
+		vmIp, _ := GetVmIp(nsId, mcisId, vmId)
+
+		// DF: Get vm on-demand monitoring metric info
+		// Path Para: /ns/:nsId/mcis/:mcisId/vm/:vmId/agent_ip/:agent_ip/metric/:metric_name/ondemand-monitoring-info
+		cmd := "/ns/" + nsId + "/mcis/" + mcisId + "/vm/" + vmId + "/agent_ip/" + vmIp + "/metric/" + metric + "/ondemand-monitoring-info"
+
+		go CallGetMonitoringAsync(&wg, nsId, mcisId, vmId, vmIp, method, metric, cmd, &vmResults[i])
+	}
+	wg.Wait() //goroutin sync wg
+
+	for _, v := range vmResults {
+		resultArray = append(resultArray, v...)
+	}
+	return resultArray, nil
+}
+
+// Default PromQL for node_exporter (by instance)
+var prometheusDefaultQuery = map[string]string{
+	monMetricCpu:  `100 - (avg by (instance) (rate(node_cpu_seconds_total{mode="idle"}[1m])) * 100)`,
+	monMetricMem:  `100 * (1 - node_memory_MemAvailable_bytes / node_memory_MemTotal_bytes)`,
+	monMetricDisk: `100 * (1 - node_filesystem_avail_bytes{mountpoint="/"} / node_filesystem_size_bytes{mountpoint="/"})`,
+	monMetricNet:  `sum by (instance) (rate(node_network_transmit_bytes_total{device!="lo"}[1m]))`,
+	monMetricLoad: `node_load1`,
+}
+
+// prometheusMetricsProvider is MetricsProvider by Prometheus HTTP query API
+type prometheusMetricsProvider struct {
+	source MetricsSource
+}
+
+// GetMcisMetric is func to get the metric of VMs in MCIS from Prometheus (instant query)
+func (p *prometheusMetricsProvider) GetMcisMetric(nsId string, mcisId string, metric string) ([]MonResultSimple, error) {
+	query, ok := p.source.PrometheusQuery[metric]
+	if !ok {
+		query, ok = prometheusDefaultQuery[metric]
+	}
+	if !ok {
+		return nil, fmt.Errorf("No prometheus query for the metric (" + metric + ")")
+	}
+	query = strings.ReplaceAll(query, "{{nsId}}", nsId)
+	query = strings.ReplaceAll(query, "{{mcisId}}", mcisId)
+	vmLabel := p.source.PrometheusVmLabel
+	if vmLabel == "" {
+		vmLabel = "instance"
+	}
+
+	vmList, err := ListVmId(nsId, mcisId)
+	if err != nil {
+		return nil, err
+	}
+
+	queryUrl := strings.TrimSuffix(p.source.PrometheusUrl, "/") + "/api/v1/query?query=" + url.QueryEscape(query)
+	fmt.Println("[Call Prometheus] " + queryUrl)
+
+	client := &http.Client{Timeout: 30 * time.Second}
+	res, err := client.Get(queryUrl)
+	if err != nil {
+		common.CBLog.Error(err)
+		return nil, err
+	}
+	defer res.Body.Close()
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		common.CBLog.Error(err)
+		return nil, err
+	}
+	response := string(body)
+	if res.StatusCode >= 400 || res.StatusCode < 200 || gjson.Get(response, "status").String() != "success" {
+		err := fmt.Errorf("Prometheus query failed (HTTP Status: %d): %s", res.StatusCode, gjson.Get(response, "error").String())
+		common.CBLog.Error(err)
+		return nil, err
+	}
+
+	// value of each series by the VM label (ex: "10.0.0.1:9100")
+	seriesValue := map[string]string{}
+	for _, series := range gjson.Get(response, "data.result").Array() {
+		seriesValue[series.Get("metric."+vmLabel).String()] = series.Get("value.1").String()
+	}
+
+	resultArray := []MonResultSimple{}
+	for _, vmId := range vmList {
+		result := MonResultSimple{Metric: metric, VmId: vmId}
+		vmObj, _ := GetVmObject(nsId, mcisId, vmId)
+		value, found := "", false
+		for labelValue, v := range seriesValue {
+			host := labelValue
+			if h, _, err := net.SplitHostPort(labelValue); err == nil {
+				host = h
+			}
+			if labelValue == vmId || (host != "" && (host == vmObj.PublicIP || host == vmObj.PrivateIP)) {
+				value, found = v, true
+				break
+			}
+		}
+		if found {
+			result.Value = value
+		} else {
+			result.Err = "No prometheus series (" + vmLabel + ") for the VM"
+		}
+		resultArray = append(resultArray, result)
+	}
+	return resultArray, nil
+}
+
+// sshMetricInterval is the interval between the two reads of counters in sshMetricCommand
+const sshMetricInterval float64 = 1
+
+// sshMetricCommand is the command to read counters of a VM (/proc) by SSH.
+// The cpu and network counters are read twice (sshMetricInterval) to get the utilization and the rates.
+const sshMetricCommand = "echo '[stat]'; head -1 /proc/stat; echo '[netdev]'; tail -n +3 /proc/net/dev; sleep 1; " +
+	"echo '[stat]'; head -1 /proc/stat; echo '[netdev]'; tail -n +3 /proc/net/dev; " +
+	"echo '[meminfo]'; grep -E '^(MemTotal|MemFree|MemAvailable|Buffers|Cached):' /proc/meminfo; " +
+	"echo '[df]'; df -P / | tail -1; " +
+	"echo '[loadavg]'; cat /proc/loadavg"
+
+// VmMetricSample is struct for metrics of a VM read from /proc by SSH
+type VmMetricSample struct {
+	VmId              string  `json:"vmId"`
+	Time              string  `json:"time"`              // RFC3339
+	CpuUtil           float64 `json:"cpuUtil"`           // %
+	MemUtil           float64 `json:"memUtil"`           // %
+	MemTotalKb        float64 `json:"memTotalKb"`        // KB
+	DiskUtil          float64 `json:"diskUtil"`          // % (root file system)
+	NetBytesInPerSec  float64 `json:"netBytesInPerSec"`  // bytes/s (except lo)
+	NetBytesOutPerSec float64 `json:"netBytesOutPerSec"` // bytes/s (except lo)
+	LoadAverage1      float64 `json:"loadAverage1"`      // 1 minute
+	LoadAverage5      float64 `json:"loadAverage5"`      // 5 minutes
+	LoadAverage15     float64 `json:"loadAverage15"`     // 15 minutes
+}
+
+// parseSshMetricOutput is func to parse the output of sshMetricCommand
+func parseSshMetricOutput(vmId string, output string) (VmMetricSample, error) {
+	sample := VmMetricSample{VmId: vmId, Time: time.Now().Format(time.RFC3339)}
+	section := ""
+	cpuStats := [][]int64{}
+	netStats := [][2]int64{} // rx and tx bytes of each read
+	memInfo := map[string]int64{}
+	parsed := map[string]bool{}
+
+	for _, line := range strings.Split(output, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			section = strings.Trim(line, "[]")
+			if section == "netdev" {
+				netStats = append(netStats, [2]int64{})
+			}
+			continue
+		}
+		fields := strings.Fields(line)
+		switch section {
+		case "stat":
+			if len(fields) < 5 || fields[0] != "cpu" {
+				continue
+			}
+			stat := []int64{}
+			for _, f := range fields[1:] {
+				n, _ := strconv.ParseInt(f, 10, 64)
+				stat = append(stat, n)
+			}
+			cpuStats = append(cpuStats, stat)
+		case "meminfo":
+			if len(fields) >= 2 {
+				n, _ := strconv.ParseInt(fields[1], 10, 64)
+				memInfo[strings.TrimSuffix(fields[0], ":")] = n
+			}
+		case "df":
+			if len(fields) >= 5 {
+				sample.DiskUtil, _ = strconv.ParseFloat(strings.TrimSuffix(fields[4], "%"), 64)
+				parsed["df"] = true
+			}
+		case "netdev":
+			// iface: rx_bytes rx_packets ... (8 fields) tx_bytes ...
+			line = strings.Replace(line, ":", " ", 1)
+			fields = strings.Fields(line)
+			if len(fields) < 10 || fields[0] == "lo" {
+				continue
+			}
+			rx, _ := strconv.ParseInt(fields[1], 10, 64)
+			tx, _ := strconv.ParseInt(fields[9], 10, 64)
+			netStats[len(netStats)-1][0] += rx
+			netStats[len(netStats)-1][1] += tx
+		case "loadavg":
+			if len(fields) >= 3 {
+				sample.LoadAverage1, _ = strconv.ParseFloat(fields[0], 64)
+				sample.LoadAverage5, _ = strconv.ParseFloat(fields[1], 64)
+				sample.LoadAverage15, _ = strconv.ParseFloat(fields[2], 64)
+				parsed["loadavg"] = true
+			}
+		}
+	}
+
+	if len(cpuStats) < 2 {
+		return sample, fmt.Errorf("Failed to read /proc/stat of VM " + vmId)
+	}
+	// user nice system idle iowait irq softirq steal
+	var total, idle int64
+	for i := range cpuStats[1] {
+		if i >= len(cpuStats[0]) || i >= 8 {
+			break
+		}
+		delta := cpuStats[1][i] - cpuStats[0][i]
+		total += delta
+		if i == 3 || i == 4 {
+			idle += delta
+		}
+	}
+	if total > 0 {
+		sample.CpuUtil = 100 * float64(total-idle) / float64(total)
+	}
+
+	// the counters are cumulative, so the rates are kept instead (0 if a counter is reset)
+	if len(netStats) >= 2 {
+		first, last := netStats[0], netStats[len(netStats)-1]
+		sample.NetBytesInPerSec = math.Max(0, float64(last[0]-first[0])/sshMetricInterval)
+		sample.NetBytesOutPerSec = math.Max(0, float64(last[1]-first[1])/sshMetricInterval)
+	}
+
+	memTotal := memInfo["MemTotal"]
+	if memTotal == 0 {
+		return sample, fmt.Errorf("Failed to read /proc/meminfo of VM " + vmId)
+	}
+	memAvailable, ok := memInfo["MemAvailable"]
+	if !ok {
+		// old kernels (before 3.14) do not have MemAvailable
+		memAvailable = memInfo["MemFree"] + memInfo["Buffers"] + memInfo["Cached"]
+	}
+	sample.MemTotalKb = float64(memTotal)
+	sample.MemUtil = 100 * float64(memTotal-memAvailable) / float64(memTotal)
+
+	if !parsed["df"] || !parsed["loadavg"] {
+		return sample, fmt.Errorf("Failed to read the disk usage or load average of VM " + vmId)
+	}
+	return sample, nil
+}
+
+// vmMetricSampleField is struct for a field of VmMetricSample retained as monitoring samples of the metric
+type vmMetricSampleField struct {
+	metric string
+	field  func(s *VmMetricSample) *float64
+}
+
+// vmMetricSampleFields is list of all fields of VmMetricSample retained as monitoring samples
+// (the metrics such as cpu and net, and the other fields by their JSON names)
+var vmMetricSampleFields = []vmMetricSampleField{
+	{metric: monMetricCpu, field: func(s *VmMetricSample) *float64 { return &s.CpuUtil }},
+	{metric: monMetricMem, field: func(s *VmMetricSample) *float64 { return &s.MemUtil }},
+	{metric: "memTotalKb", field: func(s *VmMetricSample) *float64 { return &s.MemTotalKb }},
+	{metric: monMetricDisk, field: func(s *VmMetricSample) *float64 { return &s.DiskUtil }},
+	{metric: "netBytesInPerSec", field: func(s *VmMetricSample) *float64 { return &s.NetBytesInPerSec }},
+	{metric: monMetricNet, field: func(s *VmMetricSample) *float64 { return &s.NetBytesOutPerSec }},
+	{metric: monMetricLoad, field: func(s *VmMetricSample) *float64 { return &s.LoadAverage1 }},
+	{metric: "loadAverage5", field: func(s *VmMetricSample) *float64 { return &s.LoadAverage5 }},
+	{metric: "loadAverage15", field: func(s *VmMetricSample) *float64 { return &s.LoadAverage15 }},
+}
+
+// toMonResultSimple is func to get the value of the metric from VmMetricSample (in the shape of CB-Dragonfly results)
+func (s VmMetricSample) toMonResultSimple(metric string) MonResultSimple {
+	result := MonResultSimple{Metric: metric, VmId: s.VmId}
+	switch metric {
+	case monMetricCpu:
+		result.Value = strconv.FormatFloat(s.CpuUtil, 'f', 2, 64)
+	case monMetricMem:
+		result.Value = strconv.FormatFloat(s.MemUtil, 'f', 2, 64)
+	case monMetricDisk:
+		result.Value = strconv.FormatFloat(s.DiskUtil, 'f', 2, 64)
+	case monMetricNet:
+		result.Value = strconv.FormatFloat(s.NetBytesOutPerSec, 'f', 2, 64)
+	case monMetricLoad:
+		result.Value = strconv.FormatFloat(s.LoadAverage1, 'f', 2, 64)
+	case monMetricAll:
+		value, _ := json.Marshal(s)
+		result.Value = string(value)
+	default:
+		result.Err = "The metric (" + metric + ") is not available by SSH. Use cpu, mem, disk, net, load, all"
+	}
+	return result
+}
+
+// sshMetricsProvider is MetricsProvider by reading /proc of VMs with SSH (agentless)
+type sshMetricsProvider struct {
+	userName string
+}
+
+// GetMcisMetric is func to get the metric of VMs in MCIS by SSH
+func (p *sshMetricsProvider) GetMcisMetric(nsId string, mcisId string, metric string) ([]MonResultSimple, error) {
+	samples, err := collectVmMetricSamples(nsId, mcisId, p.userName)
+	if err != nil {
+		return nil, err
+	}
+	resultArray := []MonResultSimple{}
+	for _, sample := range samples {
+		if sample.err != nil {
+			resultArray = append(resultArray, MonResultSimple{Metric: metric, VmId: sample.VmId, Err: sample.err.Error()})
+			continue
+		}
+		resultArray = append(resultArray, sample.toMonResultSimple(metric))
+	}
+	return resultArray, nil
+}
+
+// vmMetricSampleResult is struct for VmMetricSample with the error of collection
+type vmMetricSampleResult struct {
+	VmMetricSample
+	err error
+}
+
+// collectVmMetricSamples is func to read /proc of running VMs in MCIS by SSH
+func collectVmMetricSamples(nsId string, mcisId string, userName string) ([]vmMetricSampleResult, error) {
+	vmList, err := ListVmId(nsId, mcisId)
+	if err != nil {
+		return nil, err
+	}
+
+	cmdList := map[string]string{}
+	samples := []vmMetricSampleResult{}
+	for _, vmId := range vmList {
+		vmObj, err := GetVmObject(nsId, mcisId, vmId)
+		if err != nil || vmObj.Status != StatusRunning {
+			samples = append(samples, vmMetricSampleResult{VmMetricSample{VmId: vmId}, fmt.Errorf("The VM is not running")})
+			continue
+		}
+		cmdList[vmId] = sshMetricCommand
+	}
+
+	for _, sshResult := range RemoteCommandToVmList(nsId, mcisId, userName, cmdList) {
+		if sshResult.Err != nil {
+			samples = append(samples, vmMetricSampleResult{VmMetricSample{VmId: sshResult.VmId}, sshResult.Err})
+			continue
+		}
+		sample, err := parseSshMetricOutput(sshResult.VmId, sshResult.Result)
+		samples = append(samples, vmMetricSampleResult{sample, err})
+	}
+	return samples, nil
+}
@@ -0,0 +1,175 @@
+/*
+Copyright 2019 The Cloud-Barista Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package mcis is to manage multi-cloud infra service
+package mcis
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSshMetricOutput(t *testing.T) {
+	stat := "[stat]\n" +
+		"cpu  1000 0 500 8000 500 0 0 0 0 0\n" +
+		"cpu  1300 0 600 8500 600 0 0 0 0 0\n"
+	meminfo := "[meminfo]\n" +
+		"MemTotal:        2000000 kB\n" +
+		"MemFree:          500000 kB\n" +
+		"MemAvailable:    1500000 kB\n" +
+		"Buffers:          100000 kB\n" +
+		"Cached:           400000 kB\n"
+	df := "[df]\n" +
+		"/dev/root        30428648 12345678  18066586      41% /\n"
+	netdev := "[netdev]\n" +
+		"    lo: 9999999    1000    0    0    0     0          0         0  9999999    1000    0    0    0     0       0          0\n" +
+		"  eth0: 1000000    2000    0    0    0     0          0         0   300000    1500    0    0    0     0       0          0\n" +
+		"  eth1:500         10    0    0    0     0          0         0      200       5    0    0    0     0       0          0\n"
+	// counters after 1 second (in: 2000 bytes, out: 1000 bytes)
+	netdev2 := "[netdev]\n" +
+		"    lo: 9999999    1000    0    0    0     0          0         0  9999999    1000    0    0    0     0       0          0\n" +
+		"  eth0: 1002000    2000    0    0    0     0          0         0   301000    1500    0    0    0     0       0          0\n" +
+		"  eth1:500         10    0    0    0     0          0         0      200       5    0    0    0     0       0          0\n"
+	loadavg := "[loadavg]\n" +
+		"0.52 0.58 0.59 1/467 12345\n"
+
+	testCases := []struct {
+		name     string
+		output   string
+		expected VmMetricSample
+		isErr    bool
+	}{
+		{
+			// cpu: (300 + 100) busy of 1000 (idle 500, iowait 100)
+			name:   "all sections",
+			output: stat + netdev + netdev2 + meminfo + df + loadavg,
+			expected: VmMetricSample{
+				CpuUtil: 40, MemUtil: 25, MemTotalKb: 2000000, DiskUtil: 41,
+				NetBytesInPerSec: 2000, NetBytesOutPerSec: 1000,
+				LoadAverage1: 0.52, LoadAverage5: 0.58, LoadAverage15: 0.59,
+			},
+		},
+		{
+			// a reset counter (ex: a removed interface) is not a negative rate
+			name:   "reset net counter",
+			output: stat + netdev2 + netdev + meminfo + df + loadavg,
+			expected: VmMetricSample{
+				CpuUtil: 40, MemUtil: 25, MemTotalKb: 2000000, DiskUtil: 41,
+				LoadAverage1: 0.52, LoadAverage5: 0.58, LoadAverage15: 0.59,
+			},
+		},
+		{
+			// the rates are unknown with a single read of the counters
+			name:   "single net read",
+			output: stat + meminfo + df + netdev + loadavg,
+			expected: VmMetricSample{
+				CpuUtil: 40, MemUtil: 25, MemTotalKb: 2000000, DiskUtil: 41,
+				LoadAverage1: 0.52, LoadAverage5: 0.58, LoadAverage15: 0.59,
+			},
+		},
+		{
+			// (MemFree + Buffers + Cached) is available
+			name: "old kernel without MemAvailable",
+			output: stat + "[meminfo]\nMemTotal: 2000000 kB\nMemFree: 500000 kB\nBuffers: 100000 kB\nCached: 400000 kB\n" +
+				df + netdev + netdev2 + loadavg,
+			expected: VmMetricSample{
+				CpuUtil: 40, MemUtil: 50, MemTotalKb: 2000000, DiskUtil: 41,
+				NetBytesInPerSec: 2000, NetBytesOutPerSec: 1000,
+				LoadAverage1: 0.52, LoadAverage5: 0.58, LoadAverage15: 0.59,
+			},
+		},
+		{
+			// counters are not changed
+			name:   "idle cpu",
+			output: "[stat]\ncpu 10 0 10 100 0 0 0 0\ncpu 10 0 10 100 0 0 0 0\n" + meminfo + df + netdev + netdev + loadavg,
+			expected: VmMetricSample{
+				CpuUtil: 0, MemUtil: 25, MemTotalKb: 2000000, DiskUtil: 41,
+				LoadAverage1: 0.52, LoadAverage5: 0.58, LoadAverage15: 0.59,
+			},
+		},
+		{name: "single cpu stat", output: "[stat]\ncpu 10 0 10 100 0 0 0 0\n" + meminfo + df + netdev + loadavg, isErr: true},
+		{name: "no meminfo", output: stat + df + netdev + loadavg, isErr: true},
+		{name: "no df", output: stat + meminfo + netdev + loadavg, isErr: true},
+		{name: "no loadavg", output: stat + meminfo + df + netdev, isErr: true},
+		{name: "empty", output: "", isErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			sample, err := parseSshMetricOutput("vm01", tc.output)
+			if tc.isErr {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, "vm01", sample.VmId)
+			assert.NotEmpty(t, sample.Time)
+			sample.VmId, sample.Time = "", ""
+			assert.InDelta(t, tc.expected.CpuUtil, sample.CpuUtil, 1e-9)
+			assert.InDelta(t, tc.expected.MemUtil, sample.MemUtil, 1e-9)
+			sample.CpuUtil, sample.MemUtil = tc.expected.CpuUtil, tc.expected.MemUtil
+			assert.Equal(t, tc.expected, sample)
+		})
+	}
+}
+
+func TestVmMetricSampleToMonResultSimple(t *testing.T) {
+	sample := VmMetricSample{VmId: "vm01", CpuUtil: 12.345, MemUtil: 50, DiskUtil: 41, NetBytesOutPerSec: 1000, LoadAverage1: 0.52}
+
+	testCases := []struct {
+		metric   string
+		expected string
+		isErr    bool
+	}{
+		{metric: monMetricCpu, expected: "12.35"},
+		{metric: monMetricMem, expected: "50.00"},
+		{metric: monMetricDisk, expected: "41.00"},
+		{metric: monMetricNet, expected: "1000.00"},
+		{metric: monMetricLoad, expected: "0.52"},
+		{metric: "gpu", isErr: true},
+	}
+
+	for _, tc := range testCases {
+		result := sample.toMonResultSimple(tc.metric)
+		assert.Equal(t, "vm01", result.VmId)
+		assert.Equal(t, tc.metric, result.Metric)
+		if tc.isErr {
+			assert.NotEmpty(t, result.Err, tc.metric)
+		} else {
+			assert.Empty(t, result.Err, tc.metric)
+			assert.Equal(t, tc.expected, result.Value, tc.metric)
+		}
+	}
+}
+
+func TestVmMetricSampleFields(t *testing.T) {
+	// all fields of VmMetricSample are retained by a distinct metric
+	sample := VmMetricSample{
+		CpuUtil: 1, MemUtil: 2, MemTotalKb: 3, DiskUtil: 4, NetBytesInPerSec: 5, NetBytesOutPerSec: 6,
+		LoadAverage1: 7, LoadAverage5: 8, LoadAverage15: 9,
+	}
+	restored := VmMetricSample{}
+	metrics := map[string]bool{}
+	for _, f := range vmMetricSampleFields {
+		assert.False(t, metrics[f.metric], f.metric)
+		metrics[f.metric] = true
+		*f.field(&restored) = *f.field(&sample)
+	}
+	assert.Equal(t, sample, restored)
+
+	// the metrics of toMonResultSimple are retained
+	for _, metric := range []string{monMetricCpu, monMetricMem, monMetricDisk, monMetricNet, monMetricLoad} {
+		assert.True(t, metrics[metric], metric)
+	}
+}
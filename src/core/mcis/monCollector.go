@@ -0,0 +1,152 @@
+/*
+Copyright 2019 The Cloud-Barista Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package mcis is to manage multi-cloud infra service
+package mcis
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/cloud-barista/cb-tumblebug/src/core/common"
+)
+
+// monAgentNotInstalled is the MonAgentStatus of VMs monitored by the agentless collector
+const monAgentNotInstalled string = "notInstalled"
+
+var monCollectorRunning bool
+var monCollectorMutex sync.Mutex
+
+// getMonCollectorInterval is func to get the interval of agentless collection (0 if disabled)
+func getMonCollectorInterval() time.Duration {
+	interval, err := strconv.Atoi(common.MonCollectorIntervalSec)
+	if err != nil || interval < 0 {
+		interval = 60
+	}
+	return time.Duration(interval) * time.Second
+}
+
+// MonCollector is func to collect metrics of VMs without the monitoring agent (monAgentStatus: notInstalled) by SSH.
+// MonCollector will be periodically involked by a time.NewTicker in main.go (MON_COLLECTOR_INTERVAL_SEC).
+func MonCollector() {
+	monCollectorMutex.Lock()
+	if monCollectorRunning {
+		monCollectorMutex.Unlock()
+		return
+	}
+	monCollectorRunning = true
+	monCollectorMutex.Unlock()
+
+	defer func() {
+		monCollectorMutex.Lock()
+		monCollectorRunning = false
+		monCollectorMutex.Unlock()
+	}()
+
+	nsList, err := common.ListNsId()
+	if err != nil {
+		common.CBLog.Error(err)
+		return
+	}
+
+	for _, nsId := range nsList {
+		mcisList, err := ListMcisId(nsId)
+		if err != nil {
+			continue
+		}
+		for _, mcisId := range mcisList {
+			err := collectMcisVmMetricSample(nsId, mcisId)
+			if err != nil {
+				common.CBLog.Error(err)
+			}
+		}
+	}
+}
+
+// collectMcisVmMetricSample is func to collect and retain metrics of VMs in MCIS without the monitoring agent
+func collectMcisVmMetricSample(nsId string, mcisId string) error {
+	vmList, err := ListVmId(nsId, mcisId)
+	if err != nil {
+		return err
+	}
+
+	cmdList := map[string]string{}
+	for _, vmId := range vmList {
+		vmObj, err := GetVmObject(nsId, mcisId, vmId)
+		if err != nil || vmObj.Status != StatusRunning || vmObj.MonAgentStatus != monAgentNotInstalled {
+			continue
+		}
+		cmdList[vmId] = sshMetricCommand
+	}
+	if len(cmdList) == 0 {
+		return nil
+	}
+	fmt.Printf("[Agentless Monitoring] NS[%s] MCIS[%s] %d VMs\n", nsId, mcisId, len(cmdList))
+
+	for _, sshResult := range RemoteCommandToVmList(nsId, mcisId, "", cmdList) {
+		if sshResult.Err != nil {
+			fmt.Println("[Agentless Monitoring] " + sshResult.VmId + ": " + sshResult.Err.Error())
+			continue
+		}
+		sample, err := parseSshMetricOutput(sshResult.VmId, sshResult.Result)
+		if err != nil {
+			fmt.Println("[Agentless Monitoring] " + sshResult.VmId + ": " + err.Error())
+			continue
+		}
+		// retain the values (for range queries and the latest metric of the VM)
+		recordVmMetricSampleValues(nsId, mcisId, sample)
+	}
+	return nil
+}
+
+// getLatestVmMetric is func to get the metric of VM from the latest retained sample of the agentless collector
+func getLatestVmMetric(nsId string, mcisId string, vmId string, metric string) MonResultSimple {
+	sample := VmMetricSample{VmId: vmId}
+	if result := sample.toMonResultSimple(metric); result.Err != "" {
+		return result
+	}
+	fields := []vmMetricSampleField{}
+	for _, f := range vmMetricSampleFields {
+		if metric == monMetricAll || f.metric == metric {
+			fields = append(fields, f)
+		}
+	}
+
+	var latest int64
+	for _, f := range fields {
+		bucket, err := getLatestVmMonitoringSample(nsId, mcisId, vmId, f.metric)
+		if err != nil {
+			return MonResultSimple{Metric: metric, VmId: vmId, Err: err.Error()}
+		}
+		if bucket.Count == 0 {
+			continue
+		}
+		*f.field(&sample) = bucket.value(MonAggregationAvg)
+		if bucket.Time > latest {
+			latest = bucket.Time
+		}
+	}
+	if latest == 0 {
+		return MonResultSimple{Metric: metric, VmId: vmId, Err: "No agentless metric sample of the VM yet (monAgentStatus: " + monAgentNotInstalled + ")"}
+	}
+	sample.Time = time.Unix(latest, 0).Format(time.RFC3339)
+	interval := getMonCollectorInterval()
+	if interval == 0 || time.Since(time.Unix(latest, 0)) > 3*interval {
+		return MonResultSimple{Metric: metric, VmId: vmId, Err: "The latest agentless metric sample of the VM is outdated (" + sample.Time + ")"}
+	}
+	result := sample.toMonResultSimple(metric)
+	result.retained = true
+	return result
+}
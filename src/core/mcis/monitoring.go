@@ -50,6 +50,7 @@ const (
 	monMetricSwap    string = "swap"
 	monMetricDisk    string = "disk"
 	monMetricDiskio  string = "diskio"
+	monMetricLoad    string = "load"
 )
 
 // MonAgentInstallReq struct
@@ -103,6 +104,8 @@ type MonResultSimple struct {
 	VmId   string `json:"vmId"`
 	Value  string `json:"value"`
 	Err    string `json:"err"`
+
+	retained bool // the value is already retained (a sample of the agentless collector)
 }
 
 // MonResultSimpleResponse struct is for containing Mcis monitoring results
@@ -173,7 +176,7 @@ type monAgentInstallReq struct {
 }
 
 // CallMonitoringAsync is func to call CB-Dragonfly monitoring framework
-func CallMonitoringAsync(wg *sync.WaitGroup, nsID string, mcisID string, mcisServiceType string, vmID string, givenUserName string, method string, cmd string, returnResult *[]SshCmdResult) {
+func CallMonitoringAsync(wg *sync.WaitGroup, resultMutex *sync.Mutex, nsID string, mcisID string, mcisServiceType string, vmID string, givenUserName string, method string, cmd string, returnResult *[]SshCmdResult) {
 
 	defer wg.Done() //goroutin sync done
 
@@ -279,16 +282,18 @@ func CallMonitoringAsync(wg *sync.WaitGroup, nsID string, mcisID string, mcisSer
 		common.CBLog.Error("[Monitoring Agent deployment errors] " + errStr)
 		sshResultTmp.Result = errStr
 		sshResultTmp.Err = err
-		*returnResult = append(*returnResult, sshResultTmp)
 		vmInfoTmp.MonAgentStatus = "failed"
 	} else {
 		fmt.Println("Result: " + result)
 		sshResultTmp.Result = result
 		sshResultTmp.Err = nil
-		*returnResult = append(*returnResult, sshResultTmp)
 		vmInfoTmp.MonAgentStatus = "installed"
 	}
 
+	resultMutex.Lock()
+	*returnResult = append(*returnResult, sshResultTmp)
+	resultMutex.Unlock()
+
 	UpdateVmInfo(nsID, mcisID, vmInfoTmp)
 
 }
@@ -331,6 +336,10 @@ func InstallMonitorAgentToMcis(nsId string, mcisId string, mcisServiceType strin
 
 	//goroutin sync wg
 	var wg sync.WaitGroup
+	// resultMutex protects resultArray from concurrent appends
+	var resultMutex sync.Mutex
+	// limiter bounds the number of concurrent agent installations (SSH_CONCURRENCY_LIMIT)
+	limiter := newSshLimiter()
 
 	var resultArray []SshCmdResult
 
@@ -345,8 +354,12 @@ func InstallMonitorAgentToMcis(nsId string, mcisId string, mcisServiceType strin
 
 			// Avoid RunRemoteCommand to not ready VM
 			if err == nil {
+				limiter.acquire()
 				wg.Add(1)
-				go CallMonitoringAsync(&wg, nsId, mcisId, mcisServiceType, v, req.UserName, method, cmd, &resultArray)
+				go func(vmId string) {
+					defer limiter.release()
+					CallMonitoringAsync(&wg, &resultMutex, nsId, mcisId, mcisServiceType, vmId, req.UserName, method, cmd, &resultArray)
+				}(v)
 			} else {
 				common.CBLog.Error(err)
 			}
@@ -376,65 +389,11 @@ func InstallMonitorAgentToMcis(nsId string, mcisId string, mcisServiceType strin
 // GetMonitoringData func retrieves monitoring data from cb-dragonfly
 func GetMonitoringData(nsId string, mcisId string, metric string) (MonResultSimpleResponse, error) {
 
-	err := common.CheckString(nsId)
-	if err != nil {
-		temp := MonResultSimpleResponse{}
-		common.CBLog.Error(err)
-		return temp, err
-	}
-
-	err = common.CheckString(mcisId)
+	content, err := GetMcisMetricBySource(nsId, mcisId, metric, nil)
 	if err != nil {
-		temp := MonResultSimpleResponse{}
-		common.CBLog.Error(err)
-		return temp, err
-	}
-	check, _ := CheckMcis(nsId, mcisId)
-
-	if !check {
-		temp := MonResultSimpleResponse{}
-		err := fmt.Errorf("The mcis " + mcisId + " does not exist.")
-		return temp, err
-	}
-
-	content := MonResultSimpleResponse{}
-
-	vmList, err := ListVmId(nsId, mcisId)
-	if err != nil {
-		//common.CBLog.Error(err)
 		return content, err
 	}
 
-	//goroutin sync wg
-	var wg sync.WaitGroup
-
-	var resultArray []MonResultSimple
-
-	method := "GET"
-
-	for _, v := range vmList {
-		wg.Add(1)
-
-		vmId := v
-		vmIp, _ := GetVmIp(nsId, mcisId, vmId)
-
-		// DF: Get vm on-demand monitoring metric info
-		// Path Para: /ns/:nsId/mcis/:mcisId/vm/:vmId/agent_ip/:agent_ip/metric/:metric_name/ondemand-monitoring-info
-		cmd := "/ns/" + nsId + "/mcis/" + mcisId + "/vm/" + vmId + "/agent_ip/" + vmIp + "/metric/" + metric + "/ondemand-monitoring-info"
-		//fmt.Println("[CMD] " + cmd)
-
-		go CallGetMonitoringAsync(&wg, nsId, mcisId, vmId, vmIp, method, metric, cmd, &resultArray)
-
-	}
-	wg.Wait() //goroutin sync wg
-
-	content.NsId = nsId
-	content.McisId = mcisId
-	for _, v := range resultArray {
-		content.McisMonitoring = append(content.McisMonitoring, v)
-		//fmt.Println("result from goroutin " + v)
-	}
-
 	fmt.Printf("%+v\n", content)
 	//common.PrintJsonPretty(content)
 
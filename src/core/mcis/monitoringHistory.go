@@ -0,0 +1,569 @@
+/*
+Copyright 2019 The Cloud-Barista Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package mcis is to manage multi-cloud infra service
+package mcis
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cloud-barista/cb-tumblebug/src/core/common"
+)
+
+// Aggregations for monitoring range queries (to aggregate samples of a VM in a step)
+const (
+	// MonAggregationAvg is const for "avg" aggregation (default).
+	MonAggregationAvg string = "avg"
+
+	// MonAggregationMin is const for "min" aggregation.
+	MonAggregationMin string = "min"
+
+	// MonAggregationMax is const for "max" aggregation.
+	MonAggregationMax string = "max"
+
+	// MonAggregationSum is const for "sum" aggregation.
+	MonAggregationSum string = "sum"
+
+	// MonAggregationCount is const for "count" aggregation (number of samples).
+	MonAggregationCount string = "count"
+)
+
+// monRetentionTier is struct for a resolution of retained monitoring samples
+type monRetentionTier struct {
+	name       string
+	resolution int64 // sec (0 for raw samples)
+	retention  int64 // sec
+}
+
+// Retained monitoring samples are downsampled to buckets on write (raw for 1 hour, 1 minute for 1 day, 1 hour for 30 days)
+var monRetentionTiers = []monRetentionTier{
+	{name: "raw", resolution: 0, retention: 3600},
+	{name: "1m", resolution: 60, retention: 24 * 3600},
+	{name: "1h", resolution: 3600, retention: 30 * 24 * 3600},
+}
+
+// monPruneInterval is the interval to delete expired buckets of a VM metric (sec)
+var monPruneInterval int64 = 600
+
+// MonBucket is struct for retained monitoring samples of a VM in a period (a raw sample if Count is 1)
+type MonBucket struct {
+	Time  int64   `json:"t"` // start of the bucket (Unix time)
+	Sum   float64 `json:"s"`
+	Min   float64 `json:"n"`
+	Max   float64 `json:"x"`
+	Count int     `json:"c"`
+}
+
+// merge is func to add samples of another bucket to the bucket
+func (b *MonBucket) merge(o MonBucket) {
+	if b.Count == 0 {
+		b.Min, b.Max = o.Min, o.Max
+	} else {
+		b.Min = math.Min(b.Min, o.Min)
+		b.Max = math.Max(b.Max, o.Max)
+	}
+	b.Sum += o.Sum
+	b.Count += o.Count
+}
+
+// value is func to get the aggregated value of the bucket
+func (b MonBucket) value(agg string) float64 {
+	switch agg {
+	case MonAggregationMin:
+		return b.Min
+	case MonAggregationMax:
+		return b.Max
+	case MonAggregationSum:
+		return b.Sum
+	case MonAggregationCount:
+		return float64(b.Count)
+	}
+	if b.Count == 0 {
+		return 0
+	}
+	return b.Sum / float64(b.Count)
+}
+
+// MonPoint is struct for a point of monitoring time series
+type MonPoint struct {
+	Time  string  `json:"time"` // RFC3339 (start of the step)
+	Value float64 `json:"value"`
+}
+
+// MonVmSeries is struct for monitoring time series of a VM
+type MonVmSeries struct {
+	VmId   string     `json:"vmId"`
+	Points []MonPoint `json:"points"`
+}
+
+// MonMcisPoint is struct for a point of MCIS-level aggregates (across values of VMs in a step)
+type MonMcisPoint struct {
+	Time    string  `json:"time"` // RFC3339 (start of the step)
+	Avg     float64 `json:"avg"`
+	Min     float64 `json:"min"`
+	Max     float64 `json:"max"`
+	Sum     float64 `json:"sum"`
+	VmCount int     `json:"vmCount"`
+}
+
+// MonRangeResponse is struct for the result of monitoring range query
+type MonRangeResponse struct {
+	NsId        string         `json:"nsId"`
+	McisId      string         `json:"mcisId"`
+	Metric      string         `json:"metric"`
+	From        string         `json:"from"` // RFC3339
+	To          string         `json:"to"`   // RFC3339
+	StepSec     int64          `json:"stepSec"`
+	Aggregation string         `json:"aggregation"`
+	Resolution  string         `json:"resolution" example:"1m" enums:"raw,1m,1h"` // resolution of retained samples used for the query
+	VmSeries    []MonVmSeries  `json:"vmSeries"`
+	McisSeries  []MonMcisPoint `json:"mcisSeries"`
+}
+
+// monSeriesLock is struct to serialize writes to the buckets of a VM metric
+type monSeriesLock struct {
+	sync.Mutex
+	prunedAt int64 // Unix time of the last deletion of expired buckets
+}
+
+var monSeriesLocks = map[string]*monSeriesLock{}
+var monSeriesLocksMutex sync.Mutex
+
+// lockMonitoringSeries is func to lock the buckets of a VM metric (returns the lock to unlock)
+func lockMonitoringSeries(seriesKey string) *monSeriesLock {
+	monSeriesLocksMutex.Lock()
+	lock, ok := monSeriesLocks[seriesKey]
+	if !ok {
+		lock = &monSeriesLock{}
+		monSeriesLocks[seriesKey] = lock
+	}
+	monSeriesLocksMutex.Unlock()
+
+	lock.Lock()
+	return lock
+}
+
+// genMonTierKey is func to generate the key prefix for buckets of a VM metric in a retention tier
+func genMonTierKey(nsId string, mcisId string, vmId string, metric string, tierIndex int) string {
+	return common.GenMcisMonSampleKey(nsId, mcisId, vmId, metric) + "/" + monRetentionTiers[tierIndex].name + "/"
+}
+
+// genMonBucketKey is func to generate the key for a bucket of a VM metric (zero-padded time to keep keys in order of time)
+func genMonBucketKey(nsId string, mcisId string, vmId string, metric string, tierIndex int, t int64) string {
+	return genMonTierKey(nsId, mcisId, vmId, metric, tierIndex) + fmt.Sprintf("%012d", t)
+}
+
+// recordMonitoringResults is func to retain monitoring results of VMs (numeric values only)
+// Note: results from the monitoring agent (Dragonfly, Prometheus) are retained only when they are queried
+// (GetMonitoringData, policy evaluations), while the MonCollector retains samples of VMs without the agent periodically.
+func recordMonitoringResults(nsId string, mcisId string, metric string, monResults []MonResultSimple) {
+	now := time.Now()
+	for _, monData := range monResults {
+		if monData.Err != "" || monData.retained {
+			continue
+		}
+		value, err := strconv.ParseFloat(monData.Value, 64)
+		if err != nil {
+			continue
+		}
+		err = RecordVmMonitoringValue(nsId, mcisId, monData.VmId, metric, value, now)
+		if err != nil {
+			common.CBLog.Error(err)
+		}
+	}
+}
+
+// recordVmMetricSampleValues is func to retain all metrics of VmMetricSample (by the agentless collector)
+func recordVmMetricSampleValues(nsId string, mcisId string, sample VmMetricSample) {
+	t, err := time.Parse(time.RFC3339, sample.Time)
+	if err != nil {
+		t = time.Now()
+	}
+	for _, f := range vmMetricSampleFields {
+		err = RecordVmMonitoringValue(nsId, mcisId, sample.VmId, f.metric, *f.field(&sample), t)
+		if err != nil {
+			common.CBLog.Error(err)
+		}
+	}
+}
+
+// RecordVmMonitoringValue is func to retain a monitoring value of VM (downsampled to each retention tier)
+// Each bucket is stored in its own key, so a sample updates one bucket of each tier.
+func RecordVmMonitoringValue(nsId string, mcisId string, vmId string, metric string, value float64, t time.Time) error {
+	lock := lockMonitoringSeries(common.GenMcisMonSampleKey(nsId, mcisId, vmId, metric))
+	defer lock.Unlock()
+
+	now := time.Now().Unix()
+	sample := MonBucket{Time: t.Unix(), Sum: value, Min: value, Max: value, Count: 1}
+	for i, tier := range monRetentionTiers {
+		bucket := sample
+		if tier.resolution > 0 {
+			bucket.Time = sample.Time - sample.Time%tier.resolution
+		}
+		if bucket.Time < now-tier.retention {
+			continue
+		}
+		key := genMonBucketKey(nsId, mcisId, vmId, metric, i, bucket.Time)
+		keyValue, err := common.CBStore.Get(key)
+		if err != nil {
+			return err
+		}
+		if keyValue != nil {
+			stored := MonBucket{}
+			err = json.Unmarshal([]byte(keyValue.Value), &stored)
+			if err == nil {
+				stored.merge(sample)
+				bucket = stored
+			}
+		}
+		val, _ := json.Marshal(bucket)
+		err = common.CBStore.Put(key, string(val))
+		if err != nil {
+			return err
+		}
+	}
+
+	// delete expired buckets (not on every sample to avoid listing the tiers)
+	if now-lock.prunedAt >= monPruneInterval {
+		lock.prunedAt = now
+		for i, tier := range monRetentionTiers {
+			keyValue, err := common.CBStore.GetList(genMonTierKey(nsId, mcisId, vmId, metric, i), true)
+			if err != nil {
+				return err
+			}
+			for _, v := range keyValue {
+				bucketTime, err := strconv.ParseInt(v.Key[strings.LastIndex(v.Key, "/")+1:], 10, 64)
+				if err == nil && bucketTime >= now-tier.retention {
+					continue
+				}
+				err = common.CBStore.Delete(v.Key)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// getVmMonitoringBuckets is func to get retained buckets of a VM metric in a retention tier (in order of time)
+func getVmMonitoringBuckets(nsId string, mcisId string, vmId string, metric string, tierIndex int) ([]MonBucket, error) {
+	keyValue, err := common.CBStore.GetList(genMonTierKey(nsId, mcisId, vmId, metric, tierIndex), true)
+	if err != nil {
+		return nil, err
+	}
+	// expired buckets may remain until the next deletion
+	from := time.Now().Unix() - monRetentionTiers[tierIndex].retention
+	buckets := []MonBucket{}
+	for _, v := range keyValue {
+		bucket := MonBucket{}
+		err = json.Unmarshal([]byte(v.Value), &bucket)
+		if err != nil {
+			return nil, fmt.Errorf("The monitoring samples of VM " + vmId + " (" + metric + ") are broken: " + err.Error())
+		}
+		if bucket.Time >= from {
+			buckets = append(buckets, bucket)
+		}
+	}
+	sort.Slice(buckets, func(i, j int) bool { return buckets[i].Time < buckets[j].Time })
+	return buckets, nil
+}
+
+// getLatestVmMonitoringSample is func to get the latest raw sample of a VM metric (Count is 0 if no sample is retained)
+func getLatestVmMonitoringSample(nsId string, mcisId string, vmId string, metric string) (MonBucket, error) {
+	buckets, err := getVmMonitoringBuckets(nsId, mcisId, vmId, metric, 0)
+	if err != nil {
+		return MonBucket{}, err
+	}
+	if len(buckets) == 0 {
+		return MonBucket{}, nil
+	}
+	return buckets[len(buckets)-1], nil
+}
+
+// DelMonitoringHistory is func to delete retained monitoring samples of a VM (all VMs in MCIS if vmId is empty)
+func DelMonitoringHistory(nsId string, mcisId string, vmId string) error {
+	keyValue, err := common.CBStore.GetList(common.GenMcisMonSampleKey(nsId, mcisId, vmId, ""), true)
+	if err != nil {
+		return err
+	}
+	for _, v := range keyValue {
+		err := common.CBStore.Delete(v.Key)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// parseMonDuration is func to parse a duration of monitoring queries (time.Duration format with days like 2d)
+func parseMonDuration(value string) (time.Duration, error) {
+	if strings.HasSuffix(value, "d") {
+		days, err := strconv.Atoi(strings.TrimSuffix(value, "d"))
+		if err != nil {
+			return 0, err
+		}
+		return time.Duration(days) * 24 * time.Hour, nil
+	}
+	return time.ParseDuration(value)
+}
+
+// parseMonTime is func to parse the time of range query (RFC3339, Unix time, or relative to now like -1h)
+func parseMonTime(value string, now time.Time) (time.Time, error) {
+	if strings.HasPrefix(value, "-") {
+		d, err := parseMonDuration(value[1:])
+		if err == nil {
+			return now.Add(-d), nil
+		}
+	}
+	if unix, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return time.Unix(unix, 0), nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return t, fmt.Errorf("The time (" + value + ") should be RFC3339 (2021-01-02T15:04:05Z), Unix time or relative to now (-1h)")
+	}
+	return t, nil
+}
+
+// parseMonStep is func to parse the step of range query (duration like 5m or seconds)
+func parseMonStep(value string) (int64, error) {
+	if sec, err := strconv.ParseInt(value, 10, 64); err == nil && sec > 0 {
+		return sec, nil
+	}
+	d, err := parseMonDuration(value)
+	if err != nil || d < time.Second {
+		return 0, fmt.Errorf("The step (" + value + ") should be a duration (5m) or seconds (300) of 1s or more")
+	}
+	return int64(d / time.Second), nil
+}
+
+// GetMonitoringRange is func to get retained monitoring samples of VMs in MCIS in a time range,
+// aggregated by step (agg of samples of each VM) with MCIS-level aggregates across VMs.
+// from and to are RFC3339, Unix time or relative to now (default: -1h and now), step is a duration (default: auto).
+func GetMonitoringRange(nsId string, mcisId string, metric string, from string, to string, step string, agg string) (MonRangeResponse, error) {
+	content := MonRangeResponse{}
+
+	err := common.CheckString(nsId)
+	if err != nil {
+		common.CBLog.Error(err)
+		return content, err
+	}
+	err = common.CheckString(mcisId)
+	if err != nil {
+		common.CBLog.Error(err)
+		return content, err
+	}
+	check, _ := CheckMcis(nsId, mcisId)
+	if !check {
+		err := fmt.Errorf("The mcis " + mcisId + " does not exist.")
+		return content, err
+	}
+
+	now := time.Now()
+	toTime, fromTime := now, now.Add(-time.Hour)
+	if to != "" {
+		toTime, err = parseMonTime(to, now)
+		if err != nil {
+			return content, err
+		}
+	}
+	if from != "" {
+		fromTime, err = parseMonTime(from, now)
+		if err != nil {
+			return content, err
+		}
+	} else {
+		fromTime = toTime.Add(-time.Hour)
+	}
+	if !fromTime.Before(toTime) {
+		return content, fmt.Errorf("The from (" + fromTime.Format(time.RFC3339) + ") should be before the to (" + toTime.Format(time.RFC3339) + ")")
+	}
+
+	agg = strings.ToLower(agg)
+	switch agg {
+	case "":
+		agg = MonAggregationAvg
+	case MonAggregationAvg, MonAggregationMin, MonAggregationMax, MonAggregationSum, MonAggregationCount:
+	default:
+		return content, fmt.Errorf("The agg (" + agg + ") is not available. Use avg, min, max, sum, count")
+	}
+
+	var stepSec int64
+	if step != "" {
+		stepSec, err = parseMonStep(step)
+		if err != nil {
+			return content, err
+		}
+	}
+
+	// the finest tier which retains samples from fromTime (within the step)
+	tierIndex := len(monRetentionTiers) - 1
+	for i, tier := range monRetentionTiers {
+		if now.Unix()-fromTime.Unix() <= tier.retention && (stepSec == 0 || tier.resolution <= stepSec) {
+			tierIndex = i
+			break
+		}
+	}
+	tier := monRetentionTiers[tierIndex]
+	if stepSec == 0 {
+		// about 100 points for the range by default
+		stepSec = int64(math.Ceil(toTime.Sub(fromTime).Seconds() / 100))
+	}
+	if stepSec < tier.resolution {
+		stepSec = tier.resolution
+	}
+	if stepSec < 1 {
+		stepSec = 1
+	}
+
+	content.NsId = nsId
+	content.McisId = mcisId
+	content.Metric = metric
+	content.From = fromTime.Format(time.RFC3339)
+	content.To = toTime.Format(time.RFC3339)
+	content.StepSec = stepSec
+	content.Aggregation = agg
+	content.Resolution = tier.name
+	content.VmSeries = []MonVmSeries{}
+	content.McisSeries = []MonMcisPoint{}
+
+	vmList, err := ListVmId(nsId, mcisId)
+	if err != nil {
+		return content, err
+	}
+
+	// values of VMs for each step
+	stepValues := map[int64][]float64{}
+	for _, vmId := range vmList {
+		buckets, err := getVmMonitoringBuckets(nsId, mcisId, vmId, metric, tierIndex)
+		if err != nil {
+			common.CBLog.Error(err)
+			continue
+		}
+		vmSeries := MonVmSeries{VmId: vmId, Points: []MonPoint{}}
+		steps := map[int64]*MonBucket{}
+		stepTimes := []int64{}
+		for _, bucket := range buckets {
+			if bucket.Time < fromTime.Unix() || bucket.Time >= toTime.Unix() {
+				continue
+			}
+			stepTime := fromTime.Unix() + (bucket.Time-fromTime.Unix())/stepSec*stepSec
+			if _, ok := steps[stepTime]; !ok {
+				steps[stepTime] = &MonBucket{Time: stepTime}
+				stepTimes = append(stepTimes, stepTime)
+			}
+			steps[stepTime].merge(bucket)
+		}
+		sort.Slice(stepTimes, func(i, j int) bool { return stepTimes[i] < stepTimes[j] })
+		for _, stepTime := range stepTimes {
+			value := steps[stepTime].value(agg)
+			vmSeries.Points = append(vmSeries.Points, MonPoint{Time: time.Unix(stepTime, 0).Format(time.RFC3339), Value: value})
+			stepValues[stepTime] = append(stepValues[stepTime], value)
+		}
+		content.VmSeries = append(content.VmSeries, vmSeries)
+	}
+
+	stepTimes := []int64{}
+	for stepTime := range stepValues {
+		stepTimes = append(stepTimes, stepTime)
+	}
+	sort.Slice(stepTimes, func(i, j int) bool { return stepTimes[i] < stepTimes[j] })
+	for _, stepTime := range stepTimes {
+		values := stepValues[stepTime]
+		point := MonMcisPoint{Time: time.Unix(stepTime, 0).Format(time.RFC3339), Min: values[0], Max: values[0], VmCount: len(values)}
+		for _, v := range values {
+			point.Sum += v
+			point.Min = math.Min(point.Min, v)
+			point.Max = math.Max(point.Max, v)
+		}
+		point.Avg = point.Sum / float64(len(values))
+		content.McisSeries = append(content.McisSeries, point)
+	}
+
+	return content, nil
+}
+
+// getVmMonitoringWindowValues is func to get the average of retained samples of each VM in the recent window (for policy evaluation)
+func getVmMonitoringWindowValues(nsId string, mcisId string, metric string, window time.Duration) ([]float64, error) {
+	vmList, err := ListVmId(nsId, mcisId)
+	if err != nil {
+		return nil, err
+	}
+	from := time.Now().Add(-window).Unix()
+
+	// the finest tier which retains the window
+	tierIndex := len(monRetentionTiers) - 1
+	for i, tier := range monRetentionTiers {
+		if int64(window/time.Second) <= tier.retention {
+			tierIndex = i
+			break
+		}
+	}
+
+	values := []float64{}
+	for _, vmId := range vmList {
+		buckets, err := getVmMonitoringBuckets(nsId, mcisId, vmId, metric, tierIndex)
+		if err != nil {
+			continue
+		}
+		sum := MonBucket{}
+		for _, bucket := range buckets {
+			if bucket.Time >= from {
+				sum.merge(bucket)
+			}
+		}
+		if sum.Count > 0 {
+			values = append(values, sum.value(MonAggregationAvg))
+		}
+	}
+	return values, nil
+}
+
+// getMcisMonitoringBuckets is func to get MCIS-level buckets of retained samples in a tier (Sum and Count are of the averages of VMs, for forecasts)
+func getMcisMonitoringBuckets(nsId string, mcisId string, metric string, tierIndex int) ([]MonBucket, error) {
+	vmList, err := ListVmId(nsId, mcisId)
+	if err != nil {
+		return nil, err
+	}
+
+	buckets := map[int64]*MonBucket{}
+	for _, vmId := range vmList {
+		vmBuckets, err := getVmMonitoringBuckets(nsId, mcisId, vmId, metric, tierIndex)
+		if err != nil {
+			common.CBLog.Error(err)
+			continue
+		}
+		for _, bucket := range vmBuckets {
+			value := bucket.value(MonAggregationAvg)
+			if _, ok := buckets[bucket.Time]; !ok {
+				buckets[bucket.Time] = &MonBucket{Time: bucket.Time}
+			}
+			buckets[bucket.Time].merge(MonBucket{Time: bucket.Time, Sum: value, Min: value, Max: value, Count: 1})
+		}
+	}
+
+	result := []MonBucket{}
+	for _, bucket := range buckets {
+		result = append(result, *bucket)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Time < result[j].Time })
+	return result, nil
+}
@@ -0,0 +1,60 @@
+/*
+Copyright 2019 The Cloud-Barista Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package mcis is to manage multi-cloud infra service
+package mcis
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecordVmMonitoringValue(t *testing.T) {
+	nsId, mcisId, vmId, metric := "ns-mon-test", "mcis01", "vm01", "cpu"
+	defer DelMonitoringHistory(nsId, mcisId, "")
+
+	now := time.Now()
+	start := now.Add(-2 * time.Hour).Truncate(time.Hour)
+	for _, offset := range []time.Duration{0, 10 * time.Second, 70 * time.Second} {
+		assert.Nil(t, RecordVmMonitoringValue(nsId, mcisId, vmId, metric, float64(offset/time.Second), start.Add(offset)))
+	}
+	// a sample of another metric whose name starts with the metric
+	assert.Nil(t, RecordVmMonitoringValue(nsId, mcisId, vmId, "cpuLoad", 1, start))
+	assert.Nil(t, RecordVmMonitoringValue(nsId, mcisId, vmId, metric, 50, now))
+
+	raw, err := getVmMonitoringBuckets(nsId, mcisId, vmId, metric, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, []MonBucket{{Time: now.Unix(), Sum: 50, Min: 50, Max: 50, Count: 1}}, raw)
+
+	minute, err := getVmMonitoringBuckets(nsId, mcisId, vmId, metric, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(minute))
+	assert.Equal(t, MonBucket{Time: start.Unix(), Sum: 10, Min: 0, Max: 10, Count: 2}, minute[0])
+	assert.Equal(t, start.Unix()+60, minute[1].Time)
+
+	hour, err := getVmMonitoringBuckets(nsId, mcisId, vmId, metric, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(hour))
+	assert.Equal(t, MonBucket{Time: start.Unix(), Sum: 80, Min: 0, Max: 70, Count: 3}, hour[0])
+
+	latest, err := getLatestVmMonitoringSample(nsId, mcisId, vmId, metric)
+	assert.Nil(t, err)
+	assert.Equal(t, 50.0, latest.Sum)
+
+	assert.Nil(t, DelMonitoringHistory(nsId, mcisId, vmId))
+	hour, err = getVmMonitoringBuckets(nsId, mcisId, vmId, metric, 2)
+	assert.Nil(t, err)
+	assert.Empty(t, hour)
+}
@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/cloud-barista/cb-tumblebug/src/core/common"
 )
@@ -57,15 +58,23 @@ const (
 
 	// AutoActionScaleIn is const for "ScaleIn" action.
 	AutoActionScaleIn string = "ScaleIn"
+
+	// AutoActionScaleTo is const for "ScaleTo" action (to reconcile the VM count with the desired count of AutoSchedule).
+	AutoActionScaleTo string = "ScaleTo"
 )
 
 // AutoCondition is struct for MCIS auto-control condition.
+// Use Expression for composite conditions (AND/OR of multiple metrics). Otherwise, a single metric condition is used.
 type AutoCondition struct {
 	Metric           string   `json:"metric"`
-	Operator         string   `json:"operator"`         // <, <=, >, >=, ...
-	Operand          string   `json:"operand"`          // 10, 70, 80, 98, ...
-	EvaluationPeriod string   `json:"evaluationPeriod"` // evaluationPeriod
+	Aggregation      string   `json:"aggregation,omitempty" example:"avg" enums:"avg,max,min,p95"` // aggregation of values across VMs (default: avg)
+	Operator         string   `json:"operator"`                                                    // <, <=, >, >=, ...
+	Operand          string   `json:"operand"`                                                     // 10, 70, 80, 98, ...
+	EvaluationPeriod string   `json:"evaluationPeriod"`                                            // evaluationPeriod
 	EvaluationValue  []string `json:"evaluationValue"`
+	Window           string   `json:"window,omitempty" example:"5m"` // use the average of retained samples of each VM in the window (instead of the latest values)
+
+	Expression *AutoConditionExpr `json:"expression,omitempty"` // condition expression tree (overrides the single metric condition)
 	//InitTime	   string 	  `json:"initTime"`  // to check start of duration
 	//Duration	   string 	  `json:"duration"`  // duration for checking
 }
@@ -75,13 +84,33 @@ type AutoAction struct {
 	ActionType    string     `json:"actionType"`
 	Vm            TbVmInfo   `json:"vm"`
 	PostCommand   McisCmdReq `json:"postCommand"`
-	PlacementAlgo string     `json:"placementAlgo"`
+	PlacementAlgo string     `json:"placementAlgo" example:"random" enums:"random,spread,cheapest,closest,benchmark"`
+	// PlacementLocation is the geo point ("latitude/longitude") for the closest placement
+	PlacementLocation string `json:"placementLocation,omitempty" example:"37.5665/126.9780"`
+
+	// ScopeLabel is the label of VMs to scale. MinVmCount and MaxVmCount are applied to VMs with this label (all VMs in MCIS if empty).
+	// VMs added by ScaleOut get this label (AutoGen if empty) and only VMs with this label (AutoGen if empty) are removed by ScaleIn.
+	ScopeLabel string `json:"scopeLabel,omitempty"`
+	// ScopeVmGroupId is the VM group to scale. VMs added by ScaleOut join the VM group (by the template of VMs in the group),
+	// and any VM in the VM group can be removed by ScaleIn.
+	ScopeVmGroupId string `json:"scopeVmGroupId,omitempty"`
+	MinVmCount     int    `json:"minVmCount,omitempty" example:"1"`
+	MaxVmCount     int    `json:"maxVmCount,omitempty" example:"10"` // 0 means no limit
+
+	StepSize            string `json:"stepSize,omitempty" example:"1"`              // number of VMs ("2") or percentage of VMs in scope ("20%") to add or remove (default: 1)
+	ScaleOutCooldownSec int    `json:"scaleOutCooldownSec,omitempty" example:"300"` // min interval after the last ScaleOut (for ScaleOut action)
+	ScaleInCooldownSec  int    `json:"scaleInCooldownSec,omitempty" example:"600"`  // min interval after the last ScaleOut or ScaleIn (for ScaleIn action)
+	VictimStrategy      string `json:"victimStrategy,omitempty" example:"newest" enums:"newest,oldest,highestCost"`
 }
 
 // Policy is struct for MCIS auto-control Policy request that includes AutoCondition, AutoAction, Status.
+// If AutoSchedule is given, the policy is time-based (AutoCondition is ignored and the VM count is reconciled by AutoAction ScaleTo).
+// If AutoPredict is given, AutoCondition is also evaluated with the forecasted metric values (predictive mode).
 type Policy struct {
 	AutoCondition AutoCondition `json:"autoCondition"`
 	AutoAction    AutoAction    `json:"autoAction"`
+	AutoSchedule  *AutoSchedule `json:"autoSchedule,omitempty"`
+	AutoPredict   *AutoPredict  `json:"autoPredict,omitempty"`
 	Status        string        `json:"status"`
 }
 
@@ -91,8 +120,14 @@ type McisPolicyInfo struct {
 	Id     string   `json:"Id"`   //MCIS Id (generated ID by the Name)
 	Policy []Policy `json:"policy"`
 
-	ActionLog   string `json:"actionLog"`
+	// MetricsSource is the source of VM metrics to evaluate AutoCondition (CB-Dragonfly if empty)
+	MetricsSource *MetricsSource `json:"metricsSource,omitempty"`
+
+	ActionLog   string `json:"actionLog"` // summary of the latest history record (see ListMcisPolicyHistory for all records)
 	Description string `json:"description"`
+
+	LastScaleOutTime string `json:"lastScaleOutTime,omitempty"` // RFC3339
+	LastScaleInTime  string `json:"lastScaleInTime,omitempty"`  // RFC3339
 }
 
 // OrchestrationController is responsible for executing MCIS automation policy.
@@ -153,6 +188,20 @@ func OrchestrationController() {
 				switch {
 				case mcisPolicyTmp.Policy[policyIndex].Status == AutoStatusReady:
 					fmt.Println("- PolicyStatus[" + AutoStatusReady + "],[" + v + "]")
+
+					// time-based policy: reconcile the VM count with the desired count of AutoSchedule
+					if mcisPolicyTmp.Policy[policyIndex].AutoSchedule != nil {
+						check, _ := CheckMcis(nsId, mcisPolicyTmp.Id)
+						if !check {
+							fmt.Println("[MCIS is not exist] " + mcisPolicyTmp.Id)
+							break
+						}
+						ReconcileScheduledPolicy(nsId, &mcisPolicyTmp, policyIndex)
+						UpdateMcisPolicyInfo(nsId, mcisPolicyTmp)
+						break
+					}
+
+					record := newMcisPolicyHistory(mcisPolicyTmp.Id, policyIndex, PolicyHistoryEvaluation, AutoStatusReady)
 					mcisPolicyTmp.Policy[policyIndex].Status = AutoStatusChecking
 					UpdateMcisPolicyInfo(nsId, mcisPolicyTmp)
 
@@ -163,6 +212,10 @@ func OrchestrationController() {
 
 					if !check {
 						mcisPolicyTmp.Policy[policyIndex].Status = AutoStatusError
+						record.Decision = PolicyDecisionError
+						record.Error = "The MCIS " + mcisPolicyTmp.Id + " does not exist."
+						record.StatusTo = AutoStatusError
+						RecordMcisPolicyHistory(nsId, &mcisPolicyTmp, record)
 						UpdateMcisPolicyInfo(nsId, mcisPolicyTmp)
 						fmt.Println("[MCIS is not exist] " + mcisPolicyTmp.Id)
 						break
@@ -174,105 +227,52 @@ func OrchestrationController() {
 						fmt.Println("- PolicyStatus[" + mcisPolicyTmp.Policy[policyIndex].Status + "],[" + v + "]")
 
 						fmt.Println("[MCIS is exist] " + mcisPolicyTmp.Id)
-						content, err := GetMonitoringData(nsId, mcisPolicyTmp.Id, mcisPolicyTmp.Policy[policyIndex].AutoCondition.Metric)
+
+						//Statistic and Detecting (for each leaf of the condition)
+						result, leafResults, err := EvaluateAutoCondition(nsId, mcisPolicyTmp.Id, &mcisPolicyTmp.Policy[policyIndex].AutoCondition, mcisPolicyTmp.MetricsSource)
+						record.ConditionResult = result
+						record.LeafResults = leafResults
 						if err != nil {
 							common.CBLog.Error(err)
 							mcisPolicyTmp.Policy[policyIndex].Status = AutoStatusError
-							break
-						}
-						//common.PrintJsonPretty(content)
-
-						//Statistic
-						sumMcis := 0.0
-						for _, monData := range content.McisMonitoring {
-							//fmt.Println("[monData.Value: ] " + monData.Value)
-							monDataValue, _ := strconv.ParseFloat(monData.Value, 64)
-							sumMcis += monDataValue
-						}
-						averMcis := (sumMcis / float64(len(content.McisMonitoring)))
-						fmt.Printf("[monData.Value] AverMcis: %f,  SumMcis: %f \n", averMcis, sumMcis)
-
-						evaluationPeriod, _ := strconv.Atoi(mcisPolicyTmp.Policy[policyIndex].AutoCondition.EvaluationPeriod)
-						evaluationValue := mcisPolicyTmp.Policy[policyIndex].AutoCondition.EvaluationValue
-						evaluationValue = append([]string{fmt.Sprintf("%f", averMcis)}, evaluationValue...) // prepend current aver date
-						mcisPolicyTmp.Policy[policyIndex].AutoCondition.EvaluationValue = evaluationValue
-
-						sum := 0.0
-						aver := -0.1
-						// accumerate previous evaluation value
-						fmt.Printf("[Evaluation History]\n")
-						for evi, evv := range evaluationValue {
-							evvFloat, _ := strconv.ParseFloat(evv, 64)
-							sum += evvFloat
-							fmt.Printf("[%v] %f ", evi, evvFloat)
-							// break with outside evaluationValue
-							if evi >= evaluationPeriod-1 {
-								break
-							}
-						}
-						// average for evaluationPeriod (if data for the period is not enough, skip)
-						if evaluationPeriod != 0 && len(evaluationValue) >= evaluationPeriod {
-							aver = sum / float64(evaluationPeriod)
-						}
-						fmt.Printf("\n[Evaluation] Aver: %f,  Period: %v \n", aver, evaluationPeriod)
-
-						//Detecting
-						operator := mcisPolicyTmp.Policy[policyIndex].AutoCondition.Operator
-						operand, _ := strconv.ParseFloat(mcisPolicyTmp.Policy[policyIndex].AutoCondition.Operand, 64)
-
-						if evaluationPeriod == 0 {
-							fmt.Println("[Checking] Not available evaluationPeriod ")
-							mcisPolicyTmp.Policy[policyIndex].Status = AutoStatusError
-							UpdateMcisPolicyInfo(nsId, mcisPolicyTmp)
-							break
-						}
-						// not enough evaluationPeriod
-						if aver == -0.1 {
-							fmt.Println("[Checking] Not enough evaluationPeriod ")
-							mcisPolicyTmp.Policy[policyIndex].Status = AutoStatusReady
+							record.Decision = PolicyDecisionError
+							record.Error = err.Error()
+							record.StatusTo = AutoStatusError
+							RecordMcisPolicyHistory(nsId, &mcisPolicyTmp, record)
 							UpdateMcisPolicyInfo(nsId, mcisPolicyTmp)
 							break
 						}
-						switch {
-						case operator == ">=":
-							if aver >= operand {
-								fmt.Printf("[Detected] Aver: %f >=  Operand: %f \n", aver, operand)
-								mcisPolicyTmp.Policy[policyIndex].Status = AutoStatusDetected
-							} else {
-								fmt.Printf("[Not Detected] Aver: %f >=  Operand: %f \n", aver, operand)
-								mcisPolicyTmp.Policy[policyIndex].Status = AutoStatusReady
-							}
-						case operator == ">":
-							if aver > operand {
-								fmt.Printf("[Detected] Aver: %f >  Operand: %f \n", aver, operand)
-								mcisPolicyTmp.Policy[policyIndex].Status = AutoStatusDetected
 
+						//Forecasting (for predictive policy)
+						if autoPredict := mcisPolicyTmp.Policy[policyIndex].AutoPredict; autoPredict != nil {
+							predicted, predictedLeafResults := EvaluateAutoPredict(nsId, mcisPolicyTmp.Id, &mcisPolicyTmp.Policy[policyIndex].AutoCondition, autoPredict)
+							record.LeafResults = append(record.LeafResults, predictedLeafResults...)
+							record.Message = "forecast: " + predicted
+							if autoPredict.ActionEnabled {
+								result = combineAutoPredictResult(mcisPolicyTmp.Policy[policyIndex].AutoAction.ActionType, result, predicted)
+								record.ConditionResult = result
 							} else {
-								fmt.Printf("[Not Detected] Aver: %f >  Operand: %f \n", aver, operand)
-								mcisPolicyTmp.Policy[policyIndex].Status = AutoStatusReady
+								record.Message += " (actionEnabled: false)"
 							}
-						case operator == "<=":
-							if aver <= operand {
-								fmt.Printf("[Detected] Aver: %f <=  Operand: %f \n", aver, operand)
-								mcisPolicyTmp.Policy[policyIndex].Status = AutoStatusDetected
-
-							} else {
-								fmt.Printf("[Not Detected] Aver: %f <=  Operand: %f \n", aver, operand)
-								mcisPolicyTmp.Policy[policyIndex].Status = AutoStatusReady
-							}
-						case operator == "<":
-							if aver < operand {
-								fmt.Printf("[Detected] Aver: %f <  Operand: %f \n", aver, operand)
-								mcisPolicyTmp.Policy[policyIndex].Status = AutoStatusDetected
+						}
 
-							} else {
-								fmt.Printf("[Not Detected] Aver: %f <  Operand: %f \n", aver, operand)
-								mcisPolicyTmp.Policy[policyIndex].Status = AutoStatusReady
-							}
+						switch result {
+						case autoResultTrue:
+							fmt.Println("[Detected] condition is satisfied")
+							mcisPolicyTmp.Policy[policyIndex].Status = AutoStatusDetected
+							record.Decision = PolicyDecisionDetected
+						case autoResultFalse:
+							fmt.Println("[Not Detected] condition is not satisfied")
+							mcisPolicyTmp.Policy[policyIndex].Status = AutoStatusReady
+							record.Decision = PolicyDecisionNotDetected
 						default:
-							fmt.Println("[Checking] Not available operator " + operator)
-							mcisPolicyTmp.Policy[policyIndex].Status = AutoStatusError
+							// not enough evaluationPeriod
+							fmt.Println("[Checking] Not enough evaluationPeriod ")
+							mcisPolicyTmp.Policy[policyIndex].Status = AutoStatusReady
+							record.Decision = PolicyDecisionNotEnoughData
 						}
+						record.StatusTo = mcisPolicyTmp.Policy[policyIndex].Status
+						RecordMcisPolicyHistory(nsId, &mcisPolicyTmp, record)
 					}
 					UpdateMcisPolicyInfo(nsId, mcisPolicyTmp)
 					fmt.Println("- PolicyStatus[" + mcisPolicyTmp.Policy[policyIndex].Status + "],[" + v + "]")
@@ -296,83 +296,86 @@ func OrchestrationController() {
 
 					autoAction := mcisPolicyTmp.Policy[policyIndex].AutoAction
 					fmt.Println("[autoAction] " + autoAction.ActionType)
+					record := newMcisPolicyHistory(mcisPolicyTmp.Id, policyIndex, PolicyHistoryAction, AutoStatusDetected)
+					record.ActionType = autoAction.ActionType
+
+					// skip the action in cooldown (by the previous action of this or other policies)
+					if remaining := getAutoCooldownRemaining(&mcisPolicyTmp, &autoAction); remaining > 0 {
+						fmt.Println("[Cooldown] " + autoAction.ActionType + " is skipped. Remaining: " + remaining.String())
+						resetAutoConditionHistory(&mcisPolicyTmp.Policy[policyIndex].AutoCondition)
+						mcisPolicyTmp.Policy[policyIndex].Status = AutoStatusReady
+						record.Decision = PolicyDecisionCooldown
+						record.Message = "remaining cooldown: " + remaining.Round(time.Second).String()
+						record.StatusTo = AutoStatusReady
+						RecordMcisPolicyHistory(nsId, &mcisPolicyTmp, record)
+						UpdateMcisPolicyInfo(nsId, mcisPolicyTmp)
+						break
+					}
 
+					var vmList []string
+					var actionErr error
 					switch {
 					case autoAction.ActionType == AutoActionScaleOut:
-
-						autoAction.Vm.Label = labelAutoGen
-						// append UUID to given vm name to avoid duplicated vm ID.
-						autoAction.Vm.Name = autoAction.Vm.Name + "-" + common.GenUid()
-						//vmReqTmp := autoAction.Vm
-
-						if autoAction.PlacementAlgo == "random" {
-							fmt.Println("[autoAction.PlacementAlgo] " + autoAction.PlacementAlgo)
-							var vmTmpErr error
-							autoAction.Vm, vmTmpErr = GetVmTemplate(nsId, mcisPolicyTmp.Id, autoAction.PlacementAlgo)
-							if vmTmpErr != nil {
-								mcisPolicyTmp.Policy[policyIndex].Status = AutoStatusError
-								UpdateMcisPolicyInfo(nsId, mcisPolicyTmp)
-							}
-							autoAction.Vm.Name = autoAction.Vm.Name + "-Random"
-							autoAction.Vm.Label = labelAutoGen
-						}
-
-						common.PrintJsonPretty(autoAction.Vm)
 						fmt.Println("[Action] " + autoAction.ActionType)
-
-						// ScaleOut MCIS according to the VM requirement.
-						fmt.Println("[Generating VM]")
-						result, vmCreateErr := CorePostMcisVm(nsId, mcisPolicyTmp.Id, &autoAction.Vm)
-						if vmCreateErr != nil {
-							mcisPolicyTmp.Policy[policyIndex].Status = AutoStatusError
-							UpdateMcisPolicyInfo(nsId, mcisPolicyTmp)
-						}
-						common.PrintJsonPretty(*result)
-
-						nullMcisCmdReq := McisCmdReq{}
-						if autoAction.PostCommand != nullMcisCmdReq {
-							fmt.Println("[Post Command to VM] " + autoAction.PostCommand.Command)
-							_, cmdErr := RemoteCommandToMcisVm(nsId, mcisPolicyTmp.Id, autoAction.Vm.Name, &autoAction.PostCommand)
-							if cmdErr != nil {
-								mcisPolicyTmp.Policy[policyIndex].Status = AutoStatusError
-								UpdateMcisPolicyInfo(nsId, mcisPolicyTmp)
-							}
+						vmList, actionErr = ScaleOutMcis(nsId, mcisPolicyTmp.Id, &autoAction)
+						record.AddedVms = vmList
+						record.PlacementAlgo = autoAction.PlacementAlgo
+						record.Placement = getVmPlacement(nsId, mcisPolicyTmp.Id, vmList)
+						if len(vmList) != 0 {
+							mcisPolicyTmp.LastScaleOutTime = time.Now().Format(time.RFC3339)
 						}
 
 					case autoAction.ActionType == AutoActionScaleIn:
 						fmt.Println("[Action] " + autoAction.ActionType)
-
-						// ScaleIn MCIS.
-						fmt.Println("[Removing VM]")
-						vmList, vmListErr := GetVmListByLabel(nsId, mcisPolicyTmp.Id, labelAutoGen)
-						if vmListErr != nil {
-							mcisPolicyTmp.Policy[policyIndex].Status = AutoStatusError
-							UpdateMcisPolicyInfo(nsId, mcisPolicyTmp)
+						// do not ScaleIn below the desired count of time-based policies for the same VMs
+						if scheduled := getScheduledVmCount(&mcisPolicyTmp, &autoAction); scheduled > autoAction.MinVmCount {
+							fmt.Println("[Schedule] minVmCount is raised to the scheduled count: " + strconv.Itoa(scheduled))
+							autoAction.MinVmCount = scheduled
 						}
+						vmList, actionErr = ScaleInMcis(nsId, mcisPolicyTmp.Id, &autoAction)
+						record.RemovedVms = vmList
 						if len(vmList) != 0 {
-							removeTargetVm := vmList[len(vmList)-1]
-							fmt.Println("[Removing VM ID] " + removeTargetVm)
-							delVmErr := DelMcisVm(nsId, mcisPolicyTmp.Id, removeTargetVm, "")
-							if delVmErr != nil {
-								mcisPolicyTmp.Policy[policyIndex].Status = AutoStatusError
-								UpdateMcisPolicyInfo(nsId, mcisPolicyTmp)
-							}
+							mcisPolicyTmp.LastScaleInTime = time.Now().Format(time.RFC3339)
 						}
 
 					default:
 					}
 
+					if actionErr != nil {
+						mcisPolicyTmp.Policy[policyIndex].Status = AutoStatusError
+						record.Decision = PolicyDecisionError
+						record.Error = actionErr.Error()
+						record.StatusTo = AutoStatusError
+						RecordMcisPolicyHistory(nsId, &mcisPolicyTmp, record)
+						UpdateMcisPolicyInfo(nsId, mcisPolicyTmp)
+						break
+					}
+
 					mcisPolicyTmp.Policy[policyIndex].Status = AutoStatusStabilizing
+					if len(vmList) == 0 {
+						record.Decision = PolicyDecisionNoChange
+						record.Message = "VM count is at the bound (min: " + strconv.Itoa(autoAction.MinVmCount) + ", max: " + strconv.Itoa(autoAction.MaxVmCount) + ")"
+					} else {
+						record.Decision = PolicyDecisionScaled
+					}
+					record.StatusTo = AutoStatusStabilizing
+					RecordMcisPolicyHistory(nsId, &mcisPolicyTmp, record)
 					UpdateMcisPolicyInfo(nsId, mcisPolicyTmp)
 					fmt.Println("- PolicyStatus[" + mcisPolicyTmp.Policy[policyIndex].Status + "],[" + v + "]")
 
 				case mcisPolicyTmp.Policy[policyIndex].Status == AutoStatusStabilizing:
 					fmt.Println("- PolicyStatus[" + mcisPolicyTmp.Policy[policyIndex].Status + "],[" + v + "]")
 
+					// keep stabilizing until the cooldown of the action is over
+					if remaining := getAutoCooldownRemaining(&mcisPolicyTmp, &mcisPolicyTmp.Policy[policyIndex].AutoAction); remaining > 0 {
+						fmt.Println("[Cooldown] Remaining: " + remaining.String())
+						break
+					}
+
 					//initialize Evaluation history so that controller does not act too early.
 					//with this we can stablize MCIS by init previously measures.
 					//Will invoke [Checking] Not enough evaluationPeriod
-					mcisPolicyTmp.Policy[policyIndex].AutoCondition.EvaluationValue = nil
+					resetAutoConditionHistory(&mcisPolicyTmp.Policy[policyIndex].AutoCondition)
 
 					mcisPolicyTmp.Policy[policyIndex].Status = AutoStatusReady
 					UpdateMcisPolicyInfo(nsId, mcisPolicyTmp)
@@ -445,7 +448,51 @@ func CreateMcisPolicy(nsId string, mcisId string, u *McisPolicyInfo) (McisPolicy
 		return temp, err
 	}
 
+	err = ValidateMetricsSource(u.MetricsSource)
+	if err != nil {
+		temp := McisPolicyInfo{}
+		common.CBLog.Error(err)
+		return temp, err
+	}
+
 	for policyIndex := range u.Policy {
+		if u.Policy[policyIndex].AutoSchedule != nil {
+			err := ValidateAutoSchedule(u.Policy[policyIndex].AutoSchedule)
+			if err != nil {
+				temp := McisPolicyInfo{}
+				common.CBLog.Error(err)
+				return temp, fmt.Errorf("Invalid policy[" + strconv.Itoa(policyIndex) + "]: " + err.Error())
+			}
+			u.Policy[policyIndex].AutoSchedule.ActiveRule = -1
+			u.Policy[policyIndex].AutoSchedule.ActiveRuleTime = ""
+			u.Policy[policyIndex].AutoAction.ActionType = AutoActionScaleTo
+		} else {
+			err := ValidateAutoCondition(&u.Policy[policyIndex].AutoCondition)
+			if err != nil {
+				temp := McisPolicyInfo{}
+				common.CBLog.Error(err)
+				return temp, fmt.Errorf("Invalid policy[" + strconv.Itoa(policyIndex) + "]: " + err.Error())
+			}
+			if u.Policy[policyIndex].AutoPredict != nil {
+				err := ValidateAutoPredict(u.Policy[policyIndex].AutoPredict)
+				if err != nil {
+					temp := McisPolicyInfo{}
+					common.CBLog.Error(err)
+					return temp, fmt.Errorf("Invalid policy[" + strconv.Itoa(policyIndex) + "]: " + err.Error())
+				}
+			}
+			if u.Policy[policyIndex].AutoAction.ActionType == AutoActionScaleTo {
+				temp := McisPolicyInfo{}
+				return temp, fmt.Errorf("Invalid policy[" + strconv.Itoa(policyIndex) + "]: The actionType " + AutoActionScaleTo + " requires autoSchedule")
+			}
+		}
+		err := ValidateAutoAction(&u.Policy[policyIndex].AutoAction)
+		if err != nil {
+			temp := McisPolicyInfo{}
+			common.CBLog.Error(err)
+			return temp, fmt.Errorf("Invalid policy[" + strconv.Itoa(policyIndex) + "]: " + err.Error())
+		}
+		resetAutoConditionHistory(&u.Policy[policyIndex].AutoCondition)
 		u.Policy[policyIndex].Status = AutoStatusReady
 	}
 
@@ -605,6 +652,13 @@ func DelMcisPolicy(nsId string, mcisId string) error {
 		return err
 	}
 
+	// delete history of mcis Policy
+	err = DelMcisPolicyHistory(nsId, mcisId)
+	if err != nil {
+		common.CBLog.Error(err)
+		return err
+	}
+
 	return nil
 }
 
@@ -0,0 +1,329 @@
+/*
+Copyright 2019 The Cloud-Barista Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package mcis is to manage multi-cloud infra service
+package mcis
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cloud-barista/cb-tumblebug/src/core/common"
+)
+
+// Types of MCIS policy history records
+const (
+	// PolicyHistoryEvaluation is const for a record of condition evaluation.
+	PolicyHistoryEvaluation string = "evaluation"
+
+	// PolicyHistoryAction is const for a record of action.
+	PolicyHistoryAction string = "action"
+)
+
+// Decisions of MCIS policy history records
+const (
+	// PolicyDecisionDetected is const for the condition satisfied.
+	PolicyDecisionDetected string = "Detected"
+
+	// PolicyDecisionNotDetected is const for the condition not satisfied.
+	PolicyDecisionNotDetected string = "NotDetected"
+
+	// PolicyDecisionNotEnoughData is const for the condition not evaluated by lack of data for evaluation periods.
+	PolicyDecisionNotEnoughData string = "NotEnoughData"
+
+	// PolicyDecisionScaled is const for VMs added or removed by the action.
+	PolicyDecisionScaled string = "Scaled"
+
+	// PolicyDecisionNoChange is const for the action skipped by min or max VM count.
+	PolicyDecisionNoChange string = "NoChange"
+
+	// PolicyDecisionCooldown is const for the action skipped by cooldown.
+	PolicyDecisionCooldown string = "Cooldown"
+
+	// PolicyDecisionError is const for an error during evaluation or action.
+	PolicyDecisionError string = "Error"
+)
+
+// policyHistoryPruneInterval is the interval between prunes of old records (for each MCIS)
+const policyHistoryPruneInterval time.Duration = time.Hour
+
+// policyHistoryBucketDigits is the number of leading digits of record IDs (zero-padded UnixNano) used as a key prefix
+// to list records by time range without loading all records (10^13 ns, about 2.8 hours for each prefix)
+const policyHistoryBucketDigits int = 7
+
+// policyHistoryBucketNs is the time range (ns) of records with the same key prefix
+const policyHistoryBucketNs int64 = 10000000000000
+
+// McisPolicyHistory is struct for a history record of MCIS policy (an action, or an evaluation of condition whose decision is changed)
+type McisPolicyHistory struct {
+	Id          string `json:"id"`
+	McisId      string `json:"mcisId"`
+	PolicyIndex int    `json:"policyIndex"`
+	Type        string `json:"type" example:"evaluation" enums:"evaluation,action"`
+
+	StartTime string `json:"startTime"` // RFC3339
+	EndTime   string `json:"endTime"`   // RFC3339
+	ElapsedMs int64  `json:"elapsedMs"`
+
+	StatusFrom string `json:"statusFrom"`
+	StatusTo   string `json:"statusTo"`
+	Decision   string `json:"decision" example:"Detected" enums:"Detected,NotDetected,NotEnoughData,Scaled,NoChange,Cooldown,Error"`
+
+	// Fields for evaluation records (metric values observed)
+	ConditionResult string                    `json:"conditionResult,omitempty"` // true, false, unknown
+	LeafResults     []AutoConditionLeafResult `json:"leafResults,omitempty"`
+
+	// Fields for action records
+	ActionType string   `json:"actionType,omitempty"`
+	AddedVms   []string `json:"addedVms,omitempty"`
+	RemovedVms []string `json:"removedVms,omitempty"`
+	// placement of added VMs (vmId: connectionName) by PlacementAlgo
+	PlacementAlgo string   `json:"placementAlgo,omitempty"`
+	Placement     []string `json:"placement,omitempty"`
+
+	Message string `json:"message,omitempty"`
+	Error   string `json:"error,omitempty"`
+}
+
+// McisPolicyHistoryList is struct for a page of MCIS policy history (latest first)
+type McisPolicyHistoryList struct {
+	Offset  int                 `json:"offset"`
+	Limit   int                 `json:"limit"`
+	HasMore bool                `json:"hasMore"` // more records exist after this page
+	History []McisPolicyHistory `json:"history"`
+}
+
+// policyHistoryLastPrune is the time of the last prune (for each MCIS)
+var policyHistoryLastPrune = map[string]time.Time{}
+
+// policyHistoryLastEvaluation is the decision (and error) of the last stored evaluation (for each policy of MCIS)
+var policyHistoryLastEvaluation = map[string]string{}
+var policyHistoryMutex sync.Mutex
+
+// newMcisPolicyHistory is func to start a history record of MCIS policy
+func newMcisPolicyHistory(mcisId string, policyIndex int, recordType string, statusFrom string) McisPolicyHistory {
+	return McisPolicyHistory{
+		McisId:      mcisId,
+		PolicyIndex: policyIndex,
+		Type:        recordType,
+		StartTime:   time.Now().Format(time.RFC3339Nano),
+		StatusFrom:  statusFrom,
+	}
+}
+
+// summary is func to get a one-line summary of McisPolicyHistory (for the action log of McisPolicyInfo)
+func (r McisPolicyHistory) summary() string {
+	summary := r.EndTime + " policy[" + strconv.Itoa(r.PolicyIndex) + "] " + r.Type + ": " + r.Decision
+	if r.ActionType != "" {
+		summary += " (" + r.ActionType + ")"
+	}
+	leafLogs := []string{}
+	for _, leafResult := range r.LeafResults {
+		leafLogs = append(leafLogs, leafResult.String())
+	}
+	if len(leafLogs) != 0 {
+		summary += " [" + strings.Join(leafLogs, "; ") + "]"
+	}
+	if len(r.Placement) != 0 {
+		summary += " added by " + r.PlacementAlgo + ": " + strings.Join(r.Placement, ", ")
+	} else if len(r.AddedVms) != 0 {
+		summary += " added: " + strings.Join(r.AddedVms, ", ")
+	}
+	if len(r.RemovedVms) != 0 {
+		summary += " removed: " + strings.Join(r.RemovedVms, ", ")
+	}
+	if r.Message != "" {
+		summary += " " + r.Message
+	}
+	if r.Error != "" {
+		summary += " error: " + r.Error
+	}
+	return summary
+}
+
+// RecordMcisPolicyHistory is func to set a history record of MCIS policy to the action log of McisPolicyInfo and store it.
+// An evaluation record is stored only if its decision (or error) is changed from the last stored evaluation of the policy
+// so that the periodic evaluation does not flood the history.
+func RecordMcisPolicyHistory(nsId string, mcisPolicy *McisPolicyInfo, record McisPolicyHistory) {
+	startTime, _ := time.Parse(time.RFC3339Nano, record.StartTime)
+	endTime := time.Now()
+	record.EndTime = endTime.Format(time.RFC3339Nano)
+	record.ElapsedMs = endTime.Sub(startTime).Milliseconds()
+	// zero-padded UnixNano to list records in time order
+	record.Id = fmt.Sprintf("%020d-%d", startTime.UnixNano(), record.PolicyIndex)
+
+	mcisPolicy.ActionLog = record.summary()
+	fmt.Println("[Policy History] " + mcisPolicy.ActionLog)
+
+	mcisKey := nsId + "/" + record.McisId
+	policyKey := mcisKey + "/" + strconv.Itoa(record.PolicyIndex)
+	evaluation := record.Decision + "/" + record.Error
+
+	policyHistoryMutex.Lock()
+	defer policyHistoryMutex.Unlock()
+
+	if record.Type == PolicyHistoryEvaluation && policyHistoryLastEvaluation[policyKey] == evaluation {
+		return
+	}
+
+	key := common.GenMcisPolicyHistoryKey(nsId, record.McisId, record.Id)
+	val, _ := json.Marshal(record)
+	err := common.CBStore.Put(key, string(val))
+	if err != nil {
+		common.CBLog.Error(err)
+		return
+	}
+
+	if record.Type == PolicyHistoryEvaluation {
+		policyHistoryLastEvaluation[policyKey] = evaluation
+	} else {
+		// the first evaluation after an action is stored even if its decision is the same as before the action
+		delete(policyHistoryLastEvaluation, policyKey)
+	}
+
+	// prune at the first record (after restart) and every policyHistoryPruneInterval
+	if time.Since(policyHistoryLastPrune[mcisKey]) >= policyHistoryPruneInterval {
+		policyHistoryLastPrune[mcisKey] = time.Now()
+		go pruneMcisPolicyHistory(nsId, record.McisId)
+	}
+}
+
+// getPolicyHistoryRetention is func to get the retention period of MCIS policy history
+func getPolicyHistoryRetention() time.Duration {
+	retentionHour, err := strconv.Atoi(common.PolicyHistoryRetentionHour)
+	if err != nil || retentionHour <= 0 {
+		retentionHour = 168
+	}
+	return time.Duration(retentionHour) * time.Hour
+}
+
+// pruneMcisPolicyHistory is func to delete records older than the retention period or beyond the max count
+func pruneMcisPolicyHistory(nsId string, mcisId string) {
+	maxCount, err := strconv.Atoi(common.PolicyHistoryMaxCount)
+	if err != nil || maxCount <= 0 {
+		maxCount = 10000
+	}
+	oldest := time.Now().Add(-getPolicyHistoryRetention()).UnixNano()
+
+	keyValue, err := common.CBStore.GetList(common.GenMcisPolicyHistoryKey(nsId, mcisId, ""), true)
+	if err != nil {
+		common.CBLog.Error(err)
+		return
+	}
+
+	deleted := 0
+	for i, v := range keyValue {
+		recordId := strings.TrimPrefix(v.Key, common.GenMcisPolicyHistoryKey(nsId, mcisId, ""))
+		recordTime, _ := strconv.ParseInt(strings.Split(recordId, "-")[0], 10, 64)
+		// keyValue is in ascending order, so the rest is in retention
+		if len(keyValue)-i <= maxCount && recordTime >= oldest {
+			break
+		}
+		err := common.CBStore.Delete(v.Key)
+		if err != nil {
+			common.CBLog.Error(err)
+			continue
+		}
+		deleted++
+	}
+	if deleted != 0 {
+		fmt.Println("[Policy History] Pruned " + strconv.Itoa(deleted) + " records of " + mcisId)
+	}
+}
+
+// ListMcisPolicyHistory is func to list history records of MCIS policy (latest first) with pagination.
+// Records are listed by key prefixes of time ranges from the latest one, so only the ranges up to the page are loaded.
+func ListMcisPolicyHistory(nsId string, mcisId string, offset int, limit int) (McisPolicyHistoryList, error) {
+
+	err := common.CheckString(nsId)
+	if err != nil {
+		common.CBLog.Error(err)
+		return McisPolicyHistoryList{}, err
+	}
+
+	err = common.CheckString(mcisId)
+	if err != nil {
+		common.CBLog.Error(err)
+		return McisPolicyHistoryList{}, err
+	}
+
+	if offset < 0 {
+		offset = 0
+	}
+	if limit <= 0 || limit > 1000 {
+		limit = 100
+	}
+	content := McisPolicyHistoryList{Offset: offset, Limit: limit, History: []McisPolicyHistory{}}
+
+	// records older than the retention may remain until the next prune
+	prefix := common.GenMcisPolicyHistoryKey(nsId, mcisId, "")
+	newest := time.Now().UnixNano() / policyHistoryBucketNs
+	oldest := time.Now().Add(-getPolicyHistoryRetention()-policyHistoryPruneInterval).UnixNano() / policyHistoryBucketNs
+
+	skipped := 0
+	for bucket := newest; bucket >= oldest; bucket-- {
+		keyValue, err := common.CBStore.GetList(prefix+fmt.Sprintf("%0*d", policyHistoryBucketDigits, bucket), false)
+		if err != nil {
+			common.CBLog.Error(err)
+			return content, err
+		}
+		for _, v := range keyValue {
+			if skipped < offset {
+				skipped++
+				continue
+			}
+			if len(content.History) == limit {
+				content.HasMore = true
+				return content, nil
+			}
+			record := McisPolicyHistory{}
+			err = json.Unmarshal([]byte(v.Value), &record)
+			if err != nil {
+				common.CBLog.Error(err)
+				continue
+			}
+			content.History = append(content.History, record)
+		}
+	}
+	return content, nil
+}
+
+// DelMcisPolicyHistory is func to delete all history records of MCIS policy
+func DelMcisPolicyHistory(nsId string, mcisId string) error {
+	keyValue, err := common.CBStore.GetList(common.GenMcisPolicyHistoryKey(nsId, mcisId, ""), true)
+	if err != nil {
+		common.CBLog.Error(err)
+		return err
+	}
+	for _, v := range keyValue {
+		err := common.CBStore.Delete(v.Key)
+		if err != nil {
+			common.CBLog.Error(err)
+			return err
+		}
+	}
+
+	policyHistoryMutex.Lock()
+	delete(policyHistoryLastPrune, nsId+"/"+mcisId)
+	for k := range policyHistoryLastEvaluation {
+		if strings.HasPrefix(k, nsId+"/"+mcisId+"/") {
+			delete(policyHistoryLastEvaluation, k)
+		}
+	}
+	policyHistoryMutex.Unlock()
+	return nil
+}
@@ -199,6 +199,11 @@ type TbVmReq struct {
 	SshKeyId         string   `json:"sshKeyId" validate:"required"`
 	VmUserAccount    string   `json:"vmUserAccount,omitempty"`
 	VmUserPassword   string   `json:"vmUserPassword,omitempty"`
+
+	// UserData is cloud-init user data executed at VM boot. cloud-config YAML is validated.
+	UserData string `json:"userData,omitempty" example:"#cloud-config\npackages:\n  - nginx"`
+	// UserDataEncoding is the encoding of UserData (raw if empty)
+	UserDataEncoding string `json:"userDataEncoding,omitempty" enums:"raw,base64" example:"raw"`
 }
 
 // TbVmDynamicReq is struct to get requirements to create a new server instance dynamically (with default resource option)
@@ -215,8 +220,14 @@ type TbVmDynamicReq struct {
 
 	// CommonSpec is field for id of a spec in common namespace
 	CommonSpec string `json:"commonSpec" validate:"required" example:"aws-ap-northeast-2-t2-small"`
-	// CommonImage is field for id of a image in common namespace
+	// CommonImage is field for id of a image in common namespace (ex: ubuntu18.04)
+	// or an OS query with distribution, version and architecture (ex: ubuntu 22.04 arm64)
 	CommonImage string `json:"commonImage" validate:"required" example:"ubuntu18.04"`
+
+	// UserData is cloud-init user data executed at VM boot. cloud-config YAML is validated.
+	UserData string `json:"userData,omitempty" example:"#cloud-config\npackages:\n  - nginx"`
+	// UserDataEncoding is the encoding of UserData (raw if empty)
+	UserDataEncoding string `json:"userDataEncoding,omitempty" enums:"raw,base64" example:"raw"`
 }
 
 // SpiderVMReqInfoWrapper is struct from CB-Spider (VMHandler.go) for wrapping SpiderVMInfo
@@ -238,7 +249,8 @@ type SpiderVMInfo struct { // Spider
 	// Fields for both request and response
 	VMSpecName   string //  instance type or flavour, etc... ex) t2.micro or f1.micro
 	VMUserId     string // ex) user1
-	VMUserPasswd string
+	VMUserPasswd string `secret:"true"`
+	UserData     string // base64 encoded cloud-init user data
 
 	// Fields for response
 	IId               common.IID // {NameId, SystemId}
@@ -324,7 +336,15 @@ type TbVmInfo struct {
 	SecurityGroupIds []string `json:"securityGroupIds"`
 	SshKeyId         string   `json:"sshKeyId"`
 	VmUserAccount    string   `json:"vmUserAccount,omitempty"`
-	VmUserPassword   string   `json:"vmUserPassword,omitempty"`
+	VmUserPassword   string   `json:"vmUserPassword,omitempty" secret:"true"`
+
+	// UserData is cloud-init user data given at VM creation (base64 encoded after creation)
+	UserData string `json:"userData,omitempty"`
+	// UserDataEncoding is the encoding of UserData (raw if empty)
+	UserDataEncoding string `json:"userDataEncoding,omitempty" enums:"raw,base64"`
+
+	// PostCommand is the command run on the VM after creation (rerun on the replacement VM by the health policy)
+	PostCommand McisCmdReq `json:"postCommand,omitempty"`
 
 	CspViewVmDetail SpiderVMInfo `json:"cspViewVmDetail,omitempty"`
 }
@@ -434,6 +454,31 @@ func CorePostMcisVm(nsId string, mcisId string, vmInfoData *TbVmInfo) (*TbVmInfo
 		return temp, err
 	}
 
+	err = CheckBudgetForProvisioning(nsId)
+	if err != nil {
+		temp := &TbVmInfo{}
+		common.CBLog.Error(err)
+		return temp, err
+	}
+
+	// pre-flight validation of resources to avoid CSP errors after requesting to CB-Spider
+	vmReq := TbVmReq{
+		Name:             vmInfoData.Name,
+		ConnectionName:   vmInfoData.ConnectionName,
+		SpecId:           vmInfoData.SpecId,
+		ImageId:          vmInfoData.ImageId,
+		VNetId:           vmInfoData.VNetId,
+		SubnetId:         vmInfoData.SubnetId,
+		SecurityGroupIds: vmInfoData.SecurityGroupIds,
+		SshKeyId:         vmInfoData.SshKeyId,
+	}
+	err = validationErr(ValidateVmReq(nsId, &vmReq))
+	if err != nil {
+		temp := &TbVmInfo{}
+		common.CBLog.Error(err)
+		return temp, err
+	}
+
 	targetAction := ActionCreate
 	targetStatus := StatusRunning
 
@@ -543,6 +588,12 @@ func CreateMcisGroupVm(nsId string, mcisId string, vmRequest *TbVmReq) (*TbMcisI
 		return nil, err
 	}
 
+	_, err = ValidateUserData(vmRequest.ConnectionName, vmRequest.UserData, vmRequest.UserDataEncoding)
+	if err != nil {
+		common.CBLog.Error(err)
+		return nil, err
+	}
+
 	mcisTmp, err := GetMcisObject(nsId, mcisId)
 
 	if err != nil {
@@ -550,6 +601,19 @@ func CreateMcisGroupVm(nsId string, mcisId string, vmRequest *TbVmReq) (*TbMcisI
 		return temp, err
 	}
 
+	err = CheckBudgetForProvisioning(nsId)
+	if err != nil {
+		common.CBLog.Error(err)
+		return nil, err
+	}
+
+	// pre-flight validation of resources to avoid CSP errors after requesting to CB-Spider
+	err = validationErr(ValidateVmReq(nsId, vmRequest))
+	if err != nil {
+		common.CBLog.Error(err)
+		return nil, err
+	}
+
 	//vmRequest := req
 
 	targetAction := ActionCreate
@@ -635,6 +699,8 @@ func CreateMcisGroupVm(nsId string, mcisId string, vmRequest *TbVmReq) (*TbMcisI
 
 		vmInfoData.VmUserAccount = vmRequest.VmUserAccount
 		vmInfoData.VmUserPassword = vmRequest.VmUserPassword
+		vmInfoData.UserData = vmRequest.UserData
+		vmInfoData.UserDataEncoding = vmRequest.UserDataEncoding
 
 		wg.Add(1)
 		go AddVmToMcis(&wg, nsId, mcisId, &vmInfoData)
@@ -737,6 +803,23 @@ func CreateMcis(nsId string, req *TbMcisReq) (*TbMcisInfo, error) {
 		return nil, err
 	}
 
+	err = CheckBudgetForProvisioning(nsId)
+	if err != nil {
+		common.CBLog.Error(err)
+		return nil, err
+	}
+
+	// pre-flight validation of resources of all VMs to avoid CSP errors after requesting to CB-Spider
+	problems := []TbValidationProblem{}
+	for i := range req.Vm {
+		problems = append(problems, ValidateVmReq(nsId, &req.Vm[i])...)
+	}
+	err = validationErr(problems)
+	if err != nil {
+		common.CBLog.Error(err)
+		return nil, err
+	}
+
 	targetAction := ActionCreate
 	targetStatus := StatusRunning
 
@@ -781,7 +864,7 @@ func CreateMcis(nsId string, req *TbMcisReq) (*TbMcisInfo, error) {
 	fmt.Println("<" + keyValue.Key + "> \n" + keyValue.Value)
 	fmt.Println("===========================")
 
-	// Check whether VM names meet requirement.
+	// Check whether VM names and user data meet requirement.
 	for _, k := range vmRequest {
 		err = common.CheckString(k.Name)
 		if err != nil {
@@ -789,6 +872,12 @@ func CreateMcis(nsId string, req *TbMcisReq) (*TbMcisInfo, error) {
 			common.CBLog.Error(err)
 			return temp, err
 		}
+		_, err = ValidateUserData(k.ConnectionName, k.UserData, k.UserDataEncoding)
+		if err != nil {
+			temp := &TbMcisInfo{}
+			common.CBLog.Error(err)
+			return temp, err
+		}
 	}
 
 	//goroutin
@@ -867,6 +956,8 @@ func CreateMcis(nsId string, req *TbMcisReq) (*TbMcisInfo, error) {
 			vmInfoData.Description = k.Description
 			vmInfoData.VmUserAccount = k.VmUserAccount
 			vmInfoData.VmUserPassword = k.VmUserPassword
+			vmInfoData.UserData = k.UserData
+			vmInfoData.UserDataEncoding = k.UserDataEncoding
 
 			vmInfoData.Label = k.Label
 
@@ -983,13 +1074,14 @@ func CreateMcisDynamic(nsId string, req *TbMcisDynamicReq) (*TbMcisInfo, error)
 		resourceName := nsId + common.StrDefaultResourceName + vmReq.ConnectionName
 
 		vmReq.SpecId = specInfo.Id
-		vmReq.ImageId = mcir.ToNamingRuleCompatible(vmReq.ConnectionName + "-" + k.CommonImage)
-		tempInterface, err = mcir.GetResource(commonNS, common.StrImage, vmReq.ImageId)
+		// CommonImage can be an image name (ex: ubuntu18.04) or an OS query (ex: ubuntu 22.04 arm64)
+		imageInfo, err := mcir.GetCommonImageForConnection(vmReq.ConnectionName, k.CommonImage)
 		if err != nil {
-			err := fmt.Errorf("Failed to get the Image " + vmReq.ImageId + " from " + vmReq.ConnectionName)
+			err := fmt.Errorf("Failed to get the Image " + k.CommonImage + " from " + vmReq.ConnectionName + ": " + err.Error())
 			common.CBLog.Error(err)
 			return &TbMcisInfo{}, err
 		}
+		vmReq.ImageId = imageInfo.Id
 
 		vmReq.VNetId = resourceName
 		tempInterface, err = mcir.GetResource(nsId, common.StrVNet, vmReq.VNetId)
@@ -1047,6 +1139,8 @@ func CreateMcisDynamic(nsId string, req *TbMcisDynamicReq) (*TbMcisInfo, error)
 		vmReq.Label = k.Label
 		vmReq.VmGroupSize = k.VmGroupSize
 		vmReq.Description = k.Description
+		vmReq.UserData = k.UserData
+		vmReq.UserDataEncoding = k.UserDataEncoding
 
 		mcisReq.Vm = append(mcisReq.Vm, vmReq)
 
@@ -1263,6 +1357,17 @@ func CreateVm(nsId string, mcisId string, vmInfoData *TbVmInfo) error {
 	tempReq.ReqInfo.VMUserId = vmInfoData.VmUserAccount
 	tempReq.ReqInfo.VMUserPasswd = vmInfoData.VmUserPassword
 
+	// Pass cloud-init user data to CB-Spider (base64 encoded)
+	tempReq.ReqInfo.UserData, err = ValidateUserData(vmInfoData.ConnectionName, vmInfoData.UserData, vmInfoData.UserDataEncoding)
+	if err != nil {
+		common.CBLog.Error(err)
+		return err
+	}
+	vmInfoData.UserData = tempReq.ReqInfo.UserData
+	if vmInfoData.UserData != "" {
+		vmInfoData.UserDataEncoding = UserDataEncodingBase64
+	}
+
 	fmt.Printf("\n[Request body to CB-SPIDER for Creating VM]\n")
 	common.PrintJsonPretty(tempReq)
 
@@ -57,23 +57,29 @@ type PriorityInfo struct {
 	Policy []PriorityCondition `json:"policy"`
 }
 
-// FilterCondition is struct for .
+// PriorityCondition is struct for a weighted criterion of spec recommendation.
+// Each metric is normalized into a score (0 ~ 1) and combined by the weights.
 type PriorityCondition struct {
-	Metric    string            `json:"metric" example:"location" enums:"location,cost,latency"` // location,cost,latency
-	Weight    string            `json:"weight" example:"0.3" enums:"0.1,0.2,..."`                // 0.3
+	Metric    string            `json:"metric" example:"location" enums:"location,cost,performance,latency,evaluationScore01,...,evaluationScore10"`
+	Weight    string            `json:"weight" example:"0.3" enums:"0.1,0.2,..."` // 0.3 (relative, 1 if empty)
 	Parameter []ParameterKeyVal `json:"parameter,omitempty"`
 }
 
 // Operation is struct for .
 type ParameterKeyVal struct {
-	Key string   `json:"key" example:"coordinateClose" enums:"coordinateClose,coordinateWithin,coordinateFair"` // coordinate
-	Val []string `json:"val" example:"44.146838/-116.411403"`                                                   // ["Latitude,Longitude","12,543",..,"31,433"]
+	Key string   `json:"key" example:"coordinateClose" enums:"coordinateClose,coordinateWithin,coordinateFair,latencyTo"` // coordinate
+	Val []string `json:"val" example:"44.146838/-116.411403"`                                                             // ["Latitude,Longitude","12,543",..,"31,433"], latencyTo: ["aws/ap-northeast-2","37.5/127.0"] (endpoints without registered latency are skipped)
 }
 
 ///
 
 //// Info manage for MCIS recommendation
 func RecommendVm(nsId string, plan DeploymentPlan) ([]mcir.TbSpecInfo, error) {
+	return recommendVm(nsId, nsId, plan)
+}
+
+// recommendVm is func to recommend specs in specNsId with CostPerHour by the pricing policy of pricingNsId
+func recommendVm(specNsId string, pricingNsId string, plan DeploymentPlan) ([]mcir.TbSpecInfo, error) {
 
 	fmt.Println("RecommendVm")
 
@@ -145,34 +151,41 @@ func RecommendVm(nsId string, plan DeploymentPlan) ([]mcir.TbSpecInfo, error) {
 		}
 	}
 
-	filteredSpecs, err := mcir.FilterSpecsByRange(nsId, *u)
+	// cost is filtered after applying the price catalog by the pricing policy of namespace
+	costRange := u.CostPerHour
+	u.CostPerHour = mcir.Range{}
 
+	rangedSpecs, err := mcir.FilterSpecsByRange(specNsId, *u)
+	if err != nil {
+		common.CBLog.Error(err)
+		return []mcir.TbSpecInfo{}, err
+	}
+	rangedSpecs, err = mcir.ApplyPriceToSpecs(pricingNsId, rangedSpecs)
 	if err != nil {
 		common.CBLog.Error(err)
 		return []mcir.TbSpecInfo{}, err
 	}
+	filteredSpecs := []mcir.TbSpecInfo{}
+	for _, v := range rangedSpecs {
+		// specs retired by CSP (deprecated by catalog sync) are not recommended
+		if v.Deprecated {
+			continue
+		}
+		if (costRange.Min > 0 && v.CostPerHour < costRange.Min) || (costRange.Max > 0 && v.CostPerHour > costRange.Max) {
+			continue
+		}
+		filteredSpecs = append(filteredSpecs, v)
+	}
 	if len(filteredSpecs) == 0 {
 		return []mcir.TbSpecInfo{}, nil
 	}
 
 	// Prioritizing
 	fmt.Println("[Prioritizing specs]")
-	prioritySpecs := []mcir.TbSpecInfo{}
-
-	for _, v := range plan.Priority.Policy {
-		metric := v.Metric
-
-		switch metric {
-		case "location":
-			prioritySpecs, err = RecommendVmLocation(nsId, &filteredSpecs, &v.Parameter)
-		case "performance":
-			prioritySpecs, err = RecommendVmPerformance(nsId, &filteredSpecs)
-		case "cost":
-			prioritySpecs, err = RecommendVmCost(nsId, &filteredSpecs)
-		default:
-			// fmt.Println("[Checking] Not available metric " + metric)
-		}
-
+	prioritySpecs, err := ScoreSpecsByPriority(filteredSpecs, plan.Priority)
+	if err != nil {
+		common.CBLog.Error(err)
+		return []mcir.TbSpecInfo{}, err
 	}
 
 	// limit the number of items in result list
@@ -0,0 +1,359 @@
+/*
+Copyright 2019 The Cloud-Barista Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package mcis is to manage multi-cloud infra service
+package mcis
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/cloud-barista/cb-tumblebug/src/core/common"
+	"github.com/cloud-barista/cb-tumblebug/src/core/mcir"
+)
+
+// mcisPlanDefaultCandidateNum is the default number of spec candidates considered for each VM requirement
+const mcisPlanDefaultCandidateNum = 10
+
+// mcisPlanMaxSearch is the max number of combinations visited to solve a MCIS plan
+const mcisPlanMaxSearch = 200000
+
+// McisRecommendPlanReq is struct for the requirements of MCIS-level recommendation
+type McisRecommendPlanReq struct {
+	Name            string `json:"name" validate:"required" example:"mcis01"`
+	InstallMonAgent string `json:"installMonAgent" example:"no" default:"yes" enums:"yes,no"`
+	Label           string `json:"label" example:"RecommendedMcis"`
+	Description     string `json:"description" example:"Made in CB-TB"`
+
+	Vm         []VmRecommendPlanReq    `json:"vm" validate:"required"`
+	Constraint McisRecommendConstraint `json:"constraint"`
+}
+
+// VmRecommendPlanReq is struct for a VM (or VM group) requirement of MCIS-level recommendation
+type VmRecommendPlanReq struct {
+	Name        string `json:"name" example:"vm01"`
+	VmGroupSize string `json:"vmGroupSize" example:"3" default:""`
+	Label       string `json:"label" example:"RecommendedVm"`
+	Description string `json:"description" example:"Description"`
+	UserData    string `json:"userData,omitempty"`
+	// UserDataEncoding is the encoding of UserData (raw if empty)
+	UserDataEncoding string `json:"userDataEncoding,omitempty" enums:"raw,base64"`
+
+	// CommonImage is field for id of a image in common namespace (ex: ubuntu18.04) or an OS query (ex: ubuntu 22.04 arm64)
+	CommonImage string       `json:"commonImage" validate:"required" example:"ubuntu18.04"`
+	Filter      FilterInfo   `json:"filter"`
+	Priority    PriorityInfo `json:"priority"`
+}
+
+// McisRecommendConstraint is struct for the constraints applied to the whole MCIS
+type McisRecommendConstraint struct {
+	// MaxCostPerHour is the budget of all VMs per hour (0: no limit). Specs without price are not selected if given.
+	MaxCostPerHour float32 `json:"maxCostPerHour" example:"1.5"`
+	// MinProviderNum is the min number of cloud providers the VMs are spread across (0: no limit)
+	MinProviderNum int `json:"minProviderNum" example:"2"`
+	// SameRegion is to place all VMs in one region
+	SameRegion bool `json:"sameRegion" example:"false"`
+	// CandidateNum is the number of spec candidates considered for each VM requirement (default: 10)
+	CandidateNum int `json:"candidateNum,omitempty" example:"10"`
+}
+
+// VmRecommendPlanInfo is struct for the spec selected for a VM requirement
+type VmRecommendPlanInfo struct {
+	Name           string          `json:"name"`
+	VmCount        int             `json:"vmCount"`
+	ProviderName   string          `json:"providerName"`
+	RegionName     string          `json:"regionName"`
+	CostPerHour    float32         `json:"costPerHour"` // cost of all VMs in the group
+	CandidateCount int             `json:"candidateCount"`
+	Spec           mcir.TbSpecInfo `json:"spec"`
+}
+
+// McisRecommendPlanInfo is struct for the result of MCIS-level recommendation
+type McisRecommendPlanInfo struct {
+	// McisReq is ready to be submitted to POST /ns/{nsId}/mcisDynamic
+	McisReq          TbMcisDynamicReq      `json:"mcisReq"`
+	Vm               []VmRecommendPlanInfo `json:"vm"`
+	TotalCostPerHour float32               `json:"totalCostPerHour"`
+	Score            float32               `json:"score"`
+	Providers        []string              `json:"providers"`
+	Regions          []string              `json:"regions"`
+
+	// Mcis is the created MCIS (deploy=true)
+	Mcis *TbMcisInfo `json:"mcis,omitempty"`
+}
+
+// mcisPlanCandidate is struct for a spec candidate of a VM requirement
+type mcisPlanCandidate struct {
+	spec     mcir.TbSpecInfo
+	score    float64
+	cost     float64
+	provider string
+	region   string
+}
+
+// getMcisPlanCandidates is func to get spec candidates (in common namespace) of a VM requirement
+func getMcisPlanCandidates(nsId string, vmReq VmRecommendPlanReq, vmCount int, candidateNum int, connCache map[string]common.ConnConfig) ([]mcisPlanCandidate, error) {
+	commonNS := "common"
+
+	plan := DeploymentPlan{Filter: vmReq.Filter, Priority: vmReq.Priority, Limit: strconv.Itoa(candidateNum * 3)}
+	// specs in common namespace with the prices by the pricing policy of nsId
+	specList, err := recommendVm(commonNS, nsId, plan)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := []mcisPlanCandidate{}
+	for i, spec := range specList {
+		if len(candidates) >= candidateNum {
+			break
+		}
+		// the image should be available in the connection of spec
+		_, err := mcir.GetCommonImageForConnection(spec.ConnectionName, vmReq.CommonImage)
+		if err != nil {
+			continue
+		}
+
+		connConfig, ok := connCache[spec.ConnectionName]
+		if !ok {
+			connConfig, err = common.GetConnConfig(spec.ConnectionName)
+			if err != nil {
+				common.CBLog.Error(err)
+				continue
+			}
+			connCache[spec.ConnectionName] = connConfig
+		}
+
+		candidate := mcisPlanCandidate{
+			spec:     spec,
+			cost:     float64(spec.CostPerHour) * float64(vmCount),
+			provider: connConfig.ProviderName,
+			region:   connConfig.ProviderName + "/" + connConfig.RegionName,
+		}
+		if len(vmReq.Priority.Policy) > 0 {
+			candidate.score = float64(spec.EvaluationTotalScore)
+		} else {
+			// no priority, follow the order of filtered specs
+			candidate.score = 1 - float64(i)/float64(len(specList))
+		}
+		candidates = append(candidates, candidate)
+	}
+	return candidates, nil
+}
+
+// solveMcisPlan is func to find the combination of candidates (index for each VM requirement)
+// with the highest score satisfying the MCIS constraints by branch and bound (empty if not found)
+func solveMcisPlan(candidates [][]mcisPlanCandidate, vmCounts []int, constraint McisRecommendConstraint) ([]int, float64) {
+	// candidates without price cannot be checked against the budget
+	available := func(c mcisPlanCandidate) bool {
+		return constraint.MaxCostPerHour <= 0 || c.cost > 0
+	}
+
+	// bounds of the remaining requirements for pruning
+	n := len(candidates)
+	bestScoreRemain := make([]float64, n+1)
+	minCostRemain := make([]float64, n+1)
+	for i := n - 1; i >= 0; i-- {
+		found := false
+		maxScore, minCost := float64(0), float64(0)
+		for _, c := range candidates[i] {
+			if !available(c) {
+				continue
+			}
+			if !found || c.score > maxScore {
+				maxScore = c.score
+			}
+			if !found || c.cost < minCost {
+				minCost = c.cost
+			}
+			found = true
+		}
+		if !found {
+			return []int{}, -1
+		}
+		bestScoreRemain[i] = bestScoreRemain[i+1] + maxScore*float64(vmCounts[i])
+		minCostRemain[i] = minCostRemain[i+1] + minCost
+	}
+
+	selected := make([]int, n)
+	best := []int{}
+	bestScore := float64(-1)
+	visited := 0
+	providerCount := map[string]int{}
+
+	var search func(i int, score float64, cost float64, region string)
+	search = func(i int, score float64, cost float64, region string) {
+		visited++
+		if visited > mcisPlanMaxSearch {
+			return
+		}
+		if constraint.MaxCostPerHour > 0 && cost+minCostRemain[i] > float64(constraint.MaxCostPerHour) {
+			return
+		}
+		if i == n {
+			if len(providerCount) >= constraint.MinProviderNum && score > bestScore {
+				bestScore = score
+				best = append([]int{}, selected...)
+			}
+			return
+		}
+		if score+bestScoreRemain[i] <= bestScore {
+			return
+		}
+		if len(providerCount)+(n-i) < constraint.MinProviderNum {
+			return
+		}
+		for j, c := range candidates[i] {
+			if !available(c) {
+				continue
+			}
+			if constraint.SameRegion && region != "" && c.region != region {
+				continue
+			}
+			selected[i] = j
+			providerCount[c.provider]++
+			search(i+1, score+c.score*float64(vmCounts[i]), cost+c.cost, c.region)
+			providerCount[c.provider]--
+			if providerCount[c.provider] == 0 {
+				delete(providerCount, c.provider)
+			}
+		}
+	}
+	search(0, 0, 0, "")
+	if visited > mcisPlanMaxSearch {
+		fmt.Printf("[RecommendMcis] search stopped after %d combinations (best found is used)\n", mcisPlanMaxSearch)
+	}
+	return best, bestScore
+}
+
+// RecommendMcis is func to recommend specs for all VM requirements jointly under MCIS constraints
+// and to return a ready-to-submit TbMcisDynamicReq (MCIS will be created if deploy is true)
+func RecommendMcis(nsId string, req *McisRecommendPlanReq, deploy bool) (McisRecommendPlanInfo, error) {
+	result := McisRecommendPlanInfo{}
+
+	err := common.CheckString(nsId)
+	if err != nil {
+		common.CBLog.Error(err)
+		return result, err
+	}
+	err = common.CheckString(req.Name)
+	if err != nil {
+		common.CBLog.Error(err)
+		return result, err
+	}
+	if len(req.Vm) == 0 {
+		return result, fmt.Errorf("No VM requirement is given")
+	}
+	constraint := req.Constraint
+	if constraint.MaxCostPerHour < 0 || constraint.MinProviderNum < 0 || constraint.CandidateNum < 0 {
+		return result, fmt.Errorf("The constraint values should not be negative")
+	}
+	candidateNum := constraint.CandidateNum
+	if candidateNum == 0 {
+		candidateNum = mcisPlanDefaultCandidateNum
+	}
+
+	// get candidates for each VM requirement
+	connCache := map[string]common.ConnConfig{}
+	vmCounts := make([]int, len(req.Vm))
+	candidates := make([][]mcisPlanCandidate, len(req.Vm))
+	totalVmCount := 0
+	for i, v := range req.Vm {
+		if v.CommonImage == "" {
+			return result, fmt.Errorf("The commonImage of VM requirement " + v.Name + " is required")
+		}
+		vmCounts[i] = 1
+		if v.VmGroupSize != "" {
+			vmCounts[i], err = strconv.Atoi(v.VmGroupSize)
+			if err != nil || vmCounts[i] < 1 {
+				return result, fmt.Errorf("The vmGroupSize (" + v.VmGroupSize + ") of VM requirement " + v.Name + " is not a positive number")
+			}
+		}
+		totalVmCount += vmCounts[i]
+
+		candidates[i], err = getMcisPlanCandidates(nsId, v, vmCounts[i], candidateNum, connCache)
+		if err != nil {
+			common.CBLog.Error(err)
+			return result, err
+		}
+		if len(candidates[i]) == 0 {
+			return result, fmt.Errorf("No spec (with image " + v.CommonImage + ") satisfies the VM requirement " + v.Name)
+		}
+		fmt.Printf("[RecommendMcis] VM requirement %s: %d candidates\n", v.Name, len(candidates[i]))
+	}
+	if constraint.MinProviderNum > len(req.Vm) {
+		return result, fmt.Errorf("Cannot spread " + strconv.Itoa(len(req.Vm)) + " VM requirements across " + strconv.Itoa(constraint.MinProviderNum) + " providers")
+	}
+
+	best, bestScore := solveMcisPlan(candidates, vmCounts, constraint)
+	if len(best) == 0 {
+		return result, fmt.Errorf("No combination of specs satisfies the MCIS constraints (maxCostPerHour, minProviderNum, sameRegion). Specs without price are not selected with maxCostPerHour")
+	}
+
+	// make the plan
+	result.McisReq = TbMcisDynamicReq{
+		Name:            req.Name,
+		InstallMonAgent: req.InstallMonAgent,
+		Label:           req.Label,
+		Description:     req.Description,
+	}
+	providers := map[string]bool{}
+	regions := map[string]bool{}
+	totalCost := float64(0)
+	for i, v := range req.Vm {
+		c := candidates[i][best[i]]
+		result.McisReq.Vm = append(result.McisReq.Vm, TbVmDynamicReq{
+			Name:             v.Name,
+			VmGroupSize:      v.VmGroupSize,
+			Label:            v.Label,
+			Description:      v.Description,
+			CommonSpec:       c.spec.Id,
+			CommonImage:      v.CommonImage,
+			UserData:         v.UserData,
+			UserDataEncoding: v.UserDataEncoding,
+		})
+		result.Vm = append(result.Vm, VmRecommendPlanInfo{
+			Name:           v.Name,
+			VmCount:        vmCounts[i],
+			ProviderName:   c.provider,
+			RegionName:     c.region,
+			CostPerHour:    float32(c.cost),
+			CandidateCount: len(candidates[i]),
+			Spec:           c.spec,
+		})
+		providers[c.provider] = true
+		regions[c.region] = true
+		totalCost += c.cost
+	}
+	for k := range providers {
+		result.Providers = append(result.Providers, k)
+	}
+	for k := range regions {
+		result.Regions = append(result.Regions, k)
+	}
+	sort.Strings(result.Providers)
+	sort.Strings(result.Regions)
+	result.TotalCostPerHour = float32(totalCost)
+	result.Score = float32(bestScore / float64(totalVmCount))
+
+	if deploy {
+		mcisInfo, err := CreateMcisDynamic(nsId, &result.McisReq)
+		if err != nil {
+			common.CBLog.Error(err)
+			return result, err
+		}
+		result.Mcis = mcisInfo
+	}
+
+	return result, nil
+}
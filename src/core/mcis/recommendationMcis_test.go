@@ -0,0 +1,123 @@
+/*
+Copyright 2019 The Cloud-Barista Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package mcis is to manage multi-cloud infra service
+package mcis
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSolveMcisPlan(t *testing.T) {
+	candidate := func(score float64, cost float64, provider string, region string) mcisPlanCandidate {
+		return mcisPlanCandidate{score: score, cost: cost, provider: provider, region: provider + "/" + region}
+	}
+	// candidates of 2 VM requirements (in order of score)
+	web := []mcisPlanCandidate{
+		candidate(1.0, 0.8, "aws", "us-east-1"),
+		candidate(0.8, 0.4, "azure", "koreacentral"),
+		candidate(0.5, 0.2, "aws", "ap-northeast-2"),
+	}
+	db := []mcisPlanCandidate{
+		candidate(1.0, 1.0, "aws", "us-east-1"),
+		candidate(0.9, 0.3, "gcp", "asia-northeast3"),
+		candidate(0.6, 0.1, "azure", "koreacentral"),
+	}
+	unpriced := []mcisPlanCandidate{
+		candidate(1.0, 0, "aws", "us-east-1"),
+		candidate(0.7, 0.5, "gcp", "asia-northeast3"),
+	}
+
+	testCases := []struct {
+		name       string
+		candidates [][]mcisPlanCandidate
+		vmCounts   []int
+		constraint McisRecommendConstraint
+		expected   []int
+		score      float64
+	}{
+		{
+			name:       "no constraint",
+			candidates: [][]mcisPlanCandidate{web, db},
+			vmCounts:   []int{1, 1},
+			expected:   []int{0, 0},
+			score:      2.0,
+		},
+		{
+			// score is weighted by the number of VMs
+			name:       "budget",
+			candidates: [][]mcisPlanCandidate{web, db},
+			vmCounts:   []int{2, 1},
+			constraint: McisRecommendConstraint{MaxCostPerHour: 1.2},
+			expected:   []int{0, 1},
+			score:      2.9,
+		},
+		{
+			name:       "min provider",
+			candidates: [][]mcisPlanCandidate{web, db},
+			vmCounts:   []int{1, 1},
+			constraint: McisRecommendConstraint{MinProviderNum: 2},
+			expected:   []int{0, 1},
+			score:      1.9,
+		},
+		{
+			name:       "same region",
+			candidates: [][]mcisPlanCandidate{web, db},
+			vmCounts:   []int{1, 1},
+			constraint: McisRecommendConstraint{SameRegion: true, MinProviderNum: 1, MaxCostPerHour: 1.5},
+			expected:   []int{1, 2},
+			score:      1.4,
+		},
+		{
+			name:       "too small budget",
+			candidates: [][]mcisPlanCandidate{web, db},
+			vmCounts:   []int{1, 1},
+			constraint: McisRecommendConstraint{MaxCostPerHour: 0.2},
+			expected:   []int{},
+			score:      -1,
+		},
+		{
+			name:       "unpriced is selected without budget",
+			candidates: [][]mcisPlanCandidate{unpriced},
+			vmCounts:   []int{1},
+			expected:   []int{0},
+			score:      1.0,
+		},
+		{
+			name:       "unpriced does not pass the budget",
+			candidates: [][]mcisPlanCandidate{unpriced},
+			vmCounts:   []int{1},
+			constraint: McisRecommendConstraint{MaxCostPerHour: 1},
+			expected:   []int{1},
+			score:      0.7,
+		},
+		{
+			name:       "only unpriced with budget",
+			candidates: [][]mcisPlanCandidate{web, unpriced[:1]},
+			vmCounts:   []int{1, 1},
+			constraint: McisRecommendConstraint{MaxCostPerHour: 10},
+			expected:   []int{},
+			score:      -1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			best, score := solveMcisPlan(tc.candidates, tc.vmCounts, tc.constraint)
+			assert.Equal(t, tc.expected, best)
+			assert.InDelta(t, tc.score, score, 1e-9)
+		})
+	}
+}
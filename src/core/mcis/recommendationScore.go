@@ -0,0 +1,321 @@
+/*
+Copyright 2019 The Cloud-Barista Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package mcis is to manage multi-cloud infra service
+package mcis
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/cloud-barista/cb-tumblebug/src/core/mcir"
+)
+
+// priorityCriterion is struct for a criterion of weighted spec scoring
+type priorityCriterion struct {
+	metric      string
+	weight      float64
+	lowerBetter bool
+	values      []float64
+	missing     []bool // the value of spec is not available (scored 0)
+	min         float64
+	max         float64
+}
+
+// setRange is func to set min and max of the available values of criterion (to normalize values)
+func (c *priorityCriterion) setRange() {
+	first := true
+	for i, v := range c.values {
+		if c.missing[i] {
+			continue
+		}
+		if first || v < c.min {
+			c.min = v
+		}
+		if first || v > c.max {
+			c.max = v
+		}
+		first = false
+	}
+}
+
+// parsePriorityWeight is func to parse the weight of PriorityCondition (1 if not given)
+func parsePriorityWeight(condition PriorityCondition) (float64, error) {
+	weightStr := strings.ReplaceAll(condition.Weight, " ", "")
+	if weightStr == "" {
+		return 1, nil
+	}
+	weight, err := strconv.ParseFloat(weightStr, 64)
+	if err != nil || weight < 0 {
+		return 0, fmt.Errorf("The weight (" + condition.Weight + ") of priority metric " + condition.Metric + " is not a non-negative number")
+	}
+	return weight, nil
+}
+
+// getEvaluationScore is func to get EvaluationScore01 ~ EvaluationScore10 of spec by index
+func getEvaluationScore(spec mcir.TbSpecInfo, index int) float32 {
+	scores := []float32{
+		spec.EvaluationScore01, spec.EvaluationScore02, spec.EvaluationScore03, spec.EvaluationScore04, spec.EvaluationScore05,
+		spec.EvaluationScore06, spec.EvaluationScore07, spec.EvaluationScore08, spec.EvaluationScore09, spec.EvaluationScore10,
+	}
+	return scores[index-1]
+}
+
+// getCoordinateParameter is func to get coordinates ("latitude/longitude") given by coordinateClose parameter
+func getCoordinateParameter(param []ParameterKeyVal) ([][2]float64, error) {
+	coordinates := [][2]float64{}
+	for _, v := range param {
+		if v.Key != "coordinateClose" {
+			continue
+		}
+		for _, coordinateStr := range v.Val {
+			slice := strings.Split(coordinateStr, "/")
+			if len(slice) != 2 {
+				return nil, fmt.Errorf("The coordinate (" + coordinateStr + ") is not in the form of latitude/longitude")
+			}
+			latitude, err := strconv.ParseFloat(strings.ReplaceAll(slice[0], " ", ""), 64)
+			if err != nil {
+				return nil, fmt.Errorf("The latitude of coordinate (" + coordinateStr + ") is not a number")
+			}
+			longitude, err := strconv.ParseFloat(strings.ReplaceAll(slice[1], " ", ""), 64)
+			if err != nil {
+				return nil, fmt.Errorf("The longitude of coordinate (" + coordinateStr + ") is not a number")
+			}
+			coordinates = append(coordinates, [2]float64{latitude, longitude})
+		}
+	}
+	if len(coordinates) == 0 {
+		return nil, fmt.Errorf("The priority metric location requires coordinateClose parameter (latitude/longitude)")
+	}
+	return coordinates, nil
+}
+
+// getPriorityCriterion is func to get raw values of a priority metric for each spec
+func getPriorityCriterion(condition PriorityCondition, specList []mcir.TbSpecInfo) (priorityCriterion, error) {
+	criterion := priorityCriterion{metric: condition.Metric, values: make([]float64, len(specList)), missing: make([]bool, len(specList))}
+
+	metric := strings.ToLower(condition.Metric)
+	switch {
+	case metric == "cost":
+		// specs without price are not the cheapest
+		criterion.lowerBetter = true
+		for i, spec := range specList {
+			criterion.values[i] = float64(spec.CostPerHour)
+			criterion.missing[i] = spec.CostPerHour <= 0
+		}
+
+	case metric == "performance":
+		for i, spec := range specList {
+			criterion.values[i] = float64(spec.EvaluationScore01)
+		}
+
+	case metric == "location":
+		// average distance (km) from the given coordinates to the region of spec
+		criterion.lowerBetter = true
+		coordinates, err := getCoordinateParameter(condition.Parameter)
+		if err != nil {
+			return criterion, err
+		}
+		distanceCache := map[string]float64{}
+		for i, spec := range specList {
+			distance, ok := distanceCache[spec.ConnectionName]
+			if !ok {
+				for _, coordinate := range coordinates {
+					d, err := getDistance(coordinate[0], coordinate[1], spec.ConnectionName)
+					if err != nil {
+						return criterion, err
+					}
+					distance += d
+				}
+				distance = distance / float64(len(coordinates))
+				distanceCache[spec.ConnectionName] = distance
+			}
+			criterion.values[i] = distance
+		}
+
+	case metric == "latency":
+		// average estimated RTT (ms) from the region of spec to the given targets
+		criterion.lowerBetter = true
+		targets := []string{}
+		for _, v := range condition.Parameter {
+			if v.Key == "latencyTo" {
+				targets = append(targets, v.Val...)
+			}
+		}
+		if len(targets) == 0 {
+			return criterion, fmt.Errorf("The priority metric latency requires latencyTo parameter (regions, endpoints or latitude/longitude)")
+		}
+		loadLatencyMatrixIfEmpty()
+
+		// RTT from the region of each connection to each target
+		// (a target without location or measured latency is skipped for all specs to compare the same targets)
+		known := make([]bool, len(targets))
+		for j := range known {
+			known[j] = true
+		}
+		rttCache := map[string][]float64{}
+		for _, spec := range specList {
+			if _, ok := rttCache[spec.ConnectionName]; ok {
+				continue
+			}
+			source, err := GetLatencyNodeOfConnection(spec.ConnectionName)
+			if err != nil {
+				fmt.Printf("[Latency] skip specs of connection %s: %v\n", spec.ConnectionName, err)
+				rttCache[spec.ConnectionName] = nil
+				continue
+			}
+			rtts := make([]float64, len(targets))
+			for j, target := range targets {
+				rtt, err := EstimateLatency(source, target)
+				if err != nil {
+					if known[j] {
+						fmt.Printf("[Latency] skip latencyTo target %s (register the latency of the target by POST /latency): %v\n", target, err)
+					}
+					known[j] = false
+					continue
+				}
+				rtts[j] = rtt
+			}
+			rttCache[spec.ConnectionName] = rtts
+		}
+		knownCount := 0
+		for _, ok := range known {
+			if ok {
+				knownCount++
+			}
+		}
+		if knownCount == 0 {
+			return criterion, fmt.Errorf("None of latencyTo targets " + strings.Join(targets, ", ") + " has a location or measured latency")
+		}
+		for i, spec := range specList {
+			rtts := rttCache[spec.ConnectionName]
+			if rtts == nil {
+				criterion.missing[i] = true
+				continue
+			}
+			latency := float64(0)
+			for j, rtt := range rtts {
+				if known[j] {
+					latency += rtt
+				}
+			}
+			criterion.values[i] = latency / float64(knownCount)
+		}
+
+	case strings.HasPrefix(metric, "evaluationscore"):
+		index, err := strconv.Atoi(strings.TrimPrefix(metric, "evaluationscore"))
+		if err != nil || index < 1 || index > 10 {
+			return criterion, fmt.Errorf("Not available priority metric " + condition.Metric + " (evaluationScore01 ~ evaluationScore10)")
+		}
+		for i, spec := range specList {
+			criterion.values[i] = float64(getEvaluationScore(spec, index))
+		}
+
+	default:
+		return criterion, fmt.Errorf("Not available priority metric " + condition.Metric)
+	}
+	criterion.setRange()
+	return criterion, nil
+}
+
+// normalizePriorityValue is func to normalize the raw value of spec (index) in criterion into a score (0 ~ 1, higher is better)
+func normalizePriorityValue(criterion priorityCriterion, index int) float64 {
+	if criterion.missing[index] {
+		return 0
+	}
+	value := criterion.values[index]
+	if criterion.max == criterion.min {
+		// all specs are equally good on this criterion
+		return 1
+	}
+	if criterion.lowerBetter {
+		return (criterion.max - value) / (criterion.max - criterion.min)
+	}
+	return (value - criterion.min) / (criterion.max - criterion.min)
+}
+
+// ScoreSpecsByPriority is func to rank specs by the weighted sum of normalized scores of the priority metrics.
+// Each spec gets the total score in EvaluationTotalScore, its rank in OrderInFilteredResult
+// and the per-criterion scores in EvaluationBreakdown.
+func ScoreSpecsByPriority(specList []mcir.TbSpecInfo, priority PriorityInfo) ([]mcir.TbSpecInfo, error) {
+	result := make([]mcir.TbSpecInfo, len(specList))
+	copy(result, specList)
+	if len(result) == 0 {
+		return result, nil
+	}
+
+	criteria := []priorityCriterion{}
+	weightSum := float64(0)
+	for _, v := range priority.Policy {
+		weight, err := parsePriorityWeight(v)
+		if err != nil {
+			return []mcir.TbSpecInfo{}, err
+		}
+		criterion, err := getPriorityCriterion(v, result)
+		if err != nil {
+			return []mcir.TbSpecInfo{}, err
+		}
+		criterion.weight = weight
+		weightSum += weight
+		criteria = append(criteria, criterion)
+	}
+	if len(criteria) == 0 {
+		return result, nil
+	}
+
+	for i := range criteria {
+		if weightSum == 0 {
+			// all weights are 0, consider the criteria equally
+			criteria[i].weight = 1 / float64(len(criteria))
+		} else {
+			criteria[i].weight = criteria[i].weight / weightSum
+		}
+	}
+
+	for i := range result {
+		total := float64(0)
+		breakdown := []mcir.SpecEvaluationScore{}
+		for _, criterion := range criteria {
+			score := normalizePriorityValue(criterion, i)
+			total += score * criterion.weight
+			breakdown = append(breakdown, mcir.SpecEvaluationScore{
+				Metric:        criterion.metric,
+				Value:         float32(criterion.values[i]),
+				Score:         float32(score),
+				Weight:        float32(criterion.weight),
+				WeightedScore: float32(score * criterion.weight),
+			})
+		}
+		result[i].EvaluationTotalScore = float32(total)
+		result[i].EvaluationBreakdown = breakdown
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].EvaluationTotalScore > result[j].EvaluationTotalScore
+	})
+
+	// give the same order to specs with the same score
+	order := 1
+	for i := range result {
+		if i != 0 && result[i].EvaluationTotalScore < result[i-1].EvaluationTotalScore {
+			order = i + 1
+		}
+		result[i].OrderInFilteredResult = uint16(order)
+	}
+
+	fmt.Printf("[Prioritizing specs] %s ranked first (score: %v)\n", result[0].Id, result[0].EvaluationTotalScore)
+
+	return result, nil
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2019 The Cloud-Barista Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package mcis is to manage multi-cloud infra service
+package mcis
+
+import (
+	"testing"
+
+	"github.com/cloud-barista/cb-tumblebug/src/core/mcir"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScoreSpecsByPriority(t *testing.T) {
+	specList := []mcir.TbSpecInfo{
+		{Id: "cheap", CostPerHour: 0.1, EvaluationScore01: 10},
+		{Id: "fast", CostPerHour: 0.5, EvaluationScore01: 90},
+		{Id: "middle", CostPerHour: 0.3, EvaluationScore01: 50},
+		{Id: "unpriced", CostPerHour: 0, EvaluationScore01: 50},
+	}
+
+	testCases := []struct {
+		name     string
+		policy   []PriorityCondition
+		expected []string
+		scores   []float32
+		orders   []uint16
+		isErr    bool
+	}{
+		{
+			name:     "cost ranks unpriced last",
+			policy:   []PriorityCondition{{Metric: "cost"}},
+			expected: []string{"cheap", "middle", "fast", "unpriced"},
+			scores:   []float32{1, 0.5, 0, 0},
+			orders:   []uint16{1, 2, 3, 3},
+		},
+		{
+			name:     "performance",
+			policy:   []PriorityCondition{{Metric: "performance"}},
+			expected: []string{"fast", "middle", "unpriced", "cheap"},
+			scores:   []float32{1, 0.5, 0.5, 0},
+			orders:   []uint16{1, 2, 2, 4},
+		},
+		{
+			// cost 0.75 and performance 0.25
+			name:     "weighted",
+			policy:   []PriorityCondition{{Metric: "cost", Weight: "3"}, {Metric: "evaluationScore01", Weight: "1"}},
+			expected: []string{"cheap", "middle", "fast", "unpriced"},
+			scores:   []float32{0.75, 0.5, 0.25, 0.125},
+			orders:   []uint16{1, 2, 3, 4},
+		},
+		{
+			// all weights are 0, so the criteria are considered equally (specs with the same score keep the order)
+			name:     "zero weights",
+			policy:   []PriorityCondition{{Metric: "cost", Weight: "0"}, {Metric: "performance", Weight: "0"}},
+			expected: []string{"cheap", "fast", "middle", "unpriced"},
+			scores:   []float32{0.5, 0.5, 0.5, 0.25},
+			orders:   []uint16{1, 1, 1, 4},
+		},
+		{name: "unknown metric", policy: []PriorityCondition{{Metric: "color"}}, isErr: true},
+		{name: "invalid evaluation score", policy: []PriorityCondition{{Metric: "evaluationScore11"}}, isErr: true},
+		{name: "negative weight", policy: []PriorityCondition{{Metric: "cost", Weight: "-1"}}, isErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := ScoreSpecsByPriority(specList, PriorityInfo{Policy: tc.policy})
+			if tc.isErr {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			ids := []string{}
+			for i, spec := range result {
+				ids = append(ids, spec.Id)
+				assert.InDelta(t, tc.scores[i], spec.EvaluationTotalScore, 1e-6, spec.Id)
+				assert.Equal(t, tc.orders[i], spec.OrderInFilteredResult, spec.Id)
+				assert.Equal(t, len(tc.policy), len(spec.EvaluationBreakdown), spec.Id)
+				// EvaluationScore09 of the spec is kept
+				assert.Equal(t, float32(0), spec.EvaluationScore09, spec.Id)
+			}
+			assert.Equal(t, tc.expected, ids)
+		})
+	}
+
+	// the given list is not changed
+	assert.Equal(t, "cheap", specList[0].Id)
+	assert.Equal(t, float32(0), specList[0].EvaluationTotalScore)
+
+	// all specs are equally good on a criterion
+	result, err := ScoreSpecsByPriority([]mcir.TbSpecInfo{{Id: "a", CostPerHour: 0.2}, {Id: "b", CostPerHour: 0.2}}, PriorityInfo{Policy: []PriorityCondition{{Metric: "cost"}}})
+	assert.Nil(t, err)
+	assert.Equal(t, float32(1), result[0].EvaluationTotalScore)
+	assert.Equal(t, float32(1), result[1].EvaluationTotalScore)
+}
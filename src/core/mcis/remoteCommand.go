@@ -23,6 +23,7 @@ import (
 	"io"
 	"net"
 	"os"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -143,7 +144,7 @@ func RemoteCommandToMcisVm(nsId string, mcisId string, vmId string, req *McisCmd
 	fmt.Println("[CMD] " + cmd)
 	fmt.Println("")
 
-	result, err := RunRemoteCommand(vmIp, sshPort, userName, sshKey, cmd)
+	result, err := RunRemoteCommandWithPool(common.GenMcisKey(nsId, mcisId, vmId), vmIp, sshPort, userName, sshKey, cmd)
 	if err != nil {
 		//return c.JSON(http.StatusInternalServerError, err)
 		//return "", errors.New(err.Error() + *result)
@@ -227,45 +228,57 @@ func RemoteCommandToMcis(nsId string, mcisId string, req *McisCmdReq) ([]SshCmdR
 		return nil, err
 	}
 
+	cmdList := make(map[string]string)
+	for _, v := range vmList {
+		cmdList[v] = req.Command
+	}
+
+	resultArray := RemoteCommandToVmList(nsId, mcisId, req.UserName, cmdList)
+
+	return resultArray, nil
+}
+
+// RemoteCommandToVmList is func to command to VMs in MCIS by SSH (cmdList is a map of vmId to command)
+func RemoteCommandToVmList(nsId string, mcisId string, givenUserName string, cmdList map[string]string) []SshCmdResult {
+
 	//goroutine sync wg
 	var wg sync.WaitGroup
+	// resultMutex protects resultArray from concurrent appends
+	var resultMutex sync.Mutex
+	// limiter bounds the number of concurrent SSH sessions (SSH_CONCURRENCY_LIMIT)
+	limiter := newSshLimiter()
 
 	var resultArray []SshCmdResult
 
-	for _, v := range vmList {
+	for v, c := range cmdList {
 
-		vmId := v
-		vmIp, sshPort := GetVmIp(nsId, mcisId, vmId)
+		limiter.acquire()
+		wg.Add(1)
+		go func(vmId string, cmd string) {
+			defer limiter.release()
 
-		cmd := req.Command
+			vmIp, sshPort := GetVmIp(nsId, mcisId, vmId)
 
-		// userName, sshKey := GetVmSshKey(nsId, mcisId, vmId)
-		// if (userName == "") {
-		// 	userName = req.UserName
-		// }
-		// if (userName == "") {
-		// 	userName = sshDefaultUserName
-		// }
-		// find vaild username
-		userName, sshKey, err := VerifySshUserName(nsId, mcisId, vmId, vmIp, sshPort, req.UserName)
-		// Eventhough VerifySshUserName is not complete, Try RunRemoteCommand
-		// With RunRemoteCommand, error will be checked again
-		if err == nil {
-			// Just logging the error (but it is net a faultal )
-			common.CBLog.Info(err)
-		}
-		fmt.Println("")
-		fmt.Println("[SSH] " + mcisId + "." + vmId + "(" + vmIp + ")" + " with userName: " + userName)
-		fmt.Println("[CMD] " + cmd)
-		fmt.Println("")
+			// find vaild username
+			userName, sshKey, err := VerifySshUserName(nsId, mcisId, vmId, vmIp, sshPort, givenUserName)
+			// Eventhough VerifySshUserName is not complete, Try RunRemoteCommand
+			// With RunRemoteCommand, error will be checked again
+			if err != nil {
+				// Just logging the error (but it is net a faultal )
+				common.CBLog.Info(err)
+			}
+			fmt.Println("")
+			fmt.Println("[SSH] " + mcisId + "." + vmId + "(" + vmIp + ")" + " with userName: " + userName)
+			fmt.Println("[CMD] " + cmd)
+			fmt.Println("")
 
-		wg.Add(1)
-		go RunRemoteCommandAsync(&wg, vmId, vmIp, sshPort, userName, sshKey, cmd, &resultArray)
+			RunRemoteCommandAsync(&wg, &resultMutex, nsId, mcisId, vmId, vmIp, sshPort, userName, sshKey, cmd, &resultArray)
+		}(v, c)
 
 	}
 	wg.Wait() //goroutine sync wg
 
-	return resultArray, nil
+	return resultArray
 }
 
 // RunRemoteCommand is func to execute a SSH command to a VM (sync call)
@@ -288,23 +301,22 @@ func RunRemoteCommand(vmIP string, sshPort string, userName string, privateKey s
 
 }
 
-// RunRemoteCommandAsync is func to execute a SSH command to a VM (async call)
-func RunRemoteCommandAsync(wg *sync.WaitGroup, vmID string, vmIP string, sshPort string, userName string, privateKey string, cmd string, returnResult *[]SshCmdResult) {
+// RunRemoteCommandAsync is func to execute a SSH command to a VM with the pooled connection (async call)
+func RunRemoteCommandAsync(wg *sync.WaitGroup, resultMutex *sync.Mutex, nsID string, mcisID string, vmID string, vmIP string, sshPort string, userName string, privateKey string, cmd string, returnResult *[]SshCmdResult) {
 
 	defer wg.Done() //goroutin sync done
 
-	// RunRemoteCommand
-	result, err := RunRemoteCommand(vmIP, sshPort, userName, privateKey, cmd)
+	// RunRemoteCommand with the pooled connection of the VM
+	result, err := RunRemoteCommandWithPool(common.GenMcisKey(nsID, mcisID, vmID), vmIP, sshPort, userName, privateKey, cmd)
 
 	sshResultTmp := SshCmdResult{}
-	sshResultTmp.McisId = ""
+	sshResultTmp.McisId = mcisID
 	sshResultTmp.VmId = vmID
 	sshResultTmp.VmIp = vmIP
 
 	if err != nil {
 		sshResultTmp.Result = ("[ERROR: " + err.Error() + "]\n " + *result)
 		sshResultTmp.Err = err
-		*returnResult = append(*returnResult, sshResultTmp)
 	} else {
 		fmt.Println("[Begin] SSH Output")
 		fmt.Println(*result)
@@ -312,9 +324,12 @@ func RunRemoteCommandAsync(wg *sync.WaitGroup, vmID string, vmIP string, sshPort
 
 		sshResultTmp.Result = *result
 		sshResultTmp.Err = nil
-		*returnResult = append(*returnResult, sshResultTmp)
 	}
 
+	resultMutex.Lock()
+	*returnResult = append(*returnResult, sshResultTmp)
+	resultMutex.Unlock()
+
 }
 
 // VerifySshUserName is func to verify SSH username
@@ -378,7 +393,7 @@ func VerifySshUserName(nsId string, mcisId string, vmId string, vmIp string, ssh
 	for _, v := range userNames {
 		if v != "" {
 			fmt.Printf("[Check SSH] (%s) with userName: %s\n", vmIp, v)
-			_, err := RunRemoteCommand(vmIp, sshPort, v, privateKey, cmd)
+			_, err := RunRemoteCommandWithPool(common.GenMcisKey(nsId, mcisId, vmId), vmIp, sshPort, v, privateKey, cmd)
 			if err != nil {
 				fmt.Printf("Cannot do ssh, with %s, %s", verifiedUserName, err.Error())
 			} else {
@@ -406,24 +421,30 @@ func VerifySshUserName(nsId string, mcisId string, vmId string, vmIp string, ssh
 func CheckConnectivity(host string, port string) error {
 
 	// retry: 5 times, sleep: 5 seconds. timeout for each Dial: 20 seconds
-	retrycheck := 5
-	timeout := time.Second * time.Duration(20)
+	return checkConnectivityWithRetry(host, port, 5, time.Second*time.Duration(20))
+}
+
+// checkConnectivityWithRetry func checks if given port is open with the number of trials and timeout for each Dial
+func checkConnectivityWithRetry(host string, port string, retrycheck int, timeout time.Duration) error {
+
 	for i := 0; i < retrycheck; i++ {
 		conn, err := net.DialTimeout("tcp", net.JoinHostPort(host, port), timeout)
-		conn.Close()
 
 		fmt.Println("[Check SSH Port]", host, ":", port)
 
 		if err != nil {
 			fmt.Println("SSH Port is NOT accessible yet. retry after 5 seconds sleep ", err)
 		} else {
+			conn.Close()
 			// port is opened. return nil for error.
 			fmt.Println("SSH Port is accessible")
 			return nil
 		}
-		time.Sleep(5 * time.Second)
+		if i < retrycheck-1 {
+			time.Sleep(5 * time.Second)
+		}
 	}
-	return fmt.Errorf("SSH Port is NOT not accessible (5 trials)")
+	return fmt.Errorf("Port " + port + " of " + host + " is NOT accessible (" + strconv.Itoa(retrycheck) + " trials)")
 }
 
 // GetVmSshKey is func to get VM SShKey
@@ -0,0 +1,681 @@
+/*
+Copyright 2019 The Cloud-Barista Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package mcis is to manage multi-cloud infra service
+package mcis
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/cloud-barista/cb-tumblebug/src/core/common"
+	"github.com/cloud-barista/cb-tumblebug/src/core/mcir"
+	validator "github.com/go-playground/validator/v10"
+)
+
+const (
+	// scriptChildVersion is the key type for version history of a script
+	scriptChildVersion string = "version"
+
+	// scriptChildRun is the key type for run history of a script
+	scriptChildRun string = "run"
+
+	// scriptRunMaxCount is the max number of run records kept for a script (older records are deleted)
+	scriptRunMaxCount int = 100
+)
+
+// TbScriptReq is struct for requirements to create or update a command script
+type TbScriptReq struct {
+	Name string `json:"name" validate:"required" example:"install-nginx"`
+
+	// Body is a shell script with Go-template placeholders such as {{.VmId}}, {{.PublicIP}}, {{.PrivateIP}}, {{.McisId}} and {{.Param.port}}
+	// (user params and the label are rendered as single-quoted shell words)
+	Body string `json:"body" validate:"required" example:"sudo apt-get install -y nginx; echo {{.VmId}} {{.Param.port}}"`
+
+	// TargetOs is a hint of the guest OS the script is written for (ex: ubuntu, centos). VMs with another known OS are skipped.
+	TargetOs string `json:"targetOs" example:"ubuntu"`
+
+	// Params is a map of default values for user params ({{.Param.key}})
+	Params map[string]string `json:"params"`
+
+	Description string `json:"description"`
+}
+
+// TbScriptInfo is struct for a command script object
+type TbScriptInfo struct {
+	Id          string            `json:"id"`
+	Name        string            `json:"name"`
+	Body        string            `json:"body"`
+	TargetOs    string            `json:"targetOs"`
+	Params      map[string]string `json:"params"`
+	Description string            `json:"description"`
+
+	// Version is increased whenever the script is updated
+	Version     int    `json:"version"`
+	CreatedTime string `json:"createdTime" example:"2022-11-10 23:00:00"`
+	UpdatedTime string `json:"updatedTime" example:"2022-11-10 23:00:00"`
+}
+
+// TbScriptRunReq is struct for requirements to run a command script
+type TbScriptRunReq struct {
+	McisId string `json:"mcisId" validate:"required" example:"mcis01"`
+
+	// VmGroupId selects VMs in the VM group (optional)
+	VmGroupId string `json:"vmGroupId" example:"group-1"`
+
+	// Label selects VMs whose label has all the comma separated terms (optional)
+	Label string `json:"label" example:"role=web"`
+
+	// Version selects a version of the script (the latest if 0)
+	Version int `json:"version" example:"0"`
+
+	UserName string `json:"userName" example:"cb-user" default:""`
+
+	// Params overrides default values of user params in the script
+	Params map[string]string `json:"params"`
+}
+
+// TbScriptRunInfo is struct for a run record of a command script
+type TbScriptRunInfo struct {
+	Id            string            `json:"id"`
+	ScriptId      string            `json:"scriptId"`
+	ScriptVersion int               `json:"scriptVersion"`
+	McisId        string            `json:"mcisId"`
+	VmGroupId     string            `json:"vmGroupId"`
+	Label         string            `json:"label"`
+	Params        map[string]string `json:"params"`
+
+	// Status is one of [Succeeded, PartiallyFailed, Failed]
+	Status       string              `json:"status" example:"Succeeded"`
+	StartedTime  string              `json:"startedTime" example:"2022-11-10 23:00:00"`
+	FinishedTime string              `json:"finishedTime" example:"2022-11-10 23:00:10"`
+	Results      []TbScriptRunResult `json:"results"`
+}
+
+// TbScriptRunResult is struct for the result of a script run on a VM
+type TbScriptRunResult struct {
+	VmId   string `json:"vmId"`
+	VmIp   string `json:"vmIp"`
+	Result string `json:"result"`
+	Err    string `json:"err"`
+}
+
+// ScriptTemplateData is struct for values rendered into a script for each VM
+type ScriptTemplateData struct {
+	NsId       string
+	McisId     string
+	VmId       string
+	VmName     string
+	VmGroupId  string
+	PublicIP   string
+	PrivateIP  string
+	PublicDNS  string
+	PrivateDNS string
+	SSHPort    string
+	Label      string
+	Region     string
+	Param      map[string]string
+}
+
+// RenderScript is func to render a script body with the given template data
+func RenderScript(body string, data ScriptTemplateData) (string, error) {
+	tmpl, err := template.New("script").Option("missingkey=error").Parse(body)
+	if err != nil {
+		return "", err
+	}
+	var rendered bytes.Buffer
+	err = tmpl.Execute(&rendered, data)
+	if err != nil {
+		return "", err
+	}
+	return rendered.String(), nil
+}
+
+// shellQuote is func to quote a string as a single shell word
+func shellQuote(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", `'\''`) + "'"
+}
+
+// newScriptTemplateData is func to generate template data of a VM
+// (user-given values are shell-quoted so that they cannot inject commands into the script)
+func newScriptTemplateData(nsId string, mcisId string, vm TbVmInfo, params map[string]string) ScriptTemplateData {
+	quotedParams := make(map[string]string, len(params))
+	for k, v := range params {
+		quotedParams[k] = shellQuote(v)
+	}
+	return ScriptTemplateData{
+		NsId:       nsId,
+		McisId:     mcisId,
+		VmId:       vm.Id,
+		VmName:     vm.Name,
+		VmGroupId:  vm.VmGroupId,
+		PublicIP:   vm.PublicIP,
+		PrivateIP:  vm.PrivateIP,
+		PublicDNS:  vm.PublicDNS,
+		PrivateDNS: vm.PrivateDNS,
+		SSHPort:    vm.SSHPort,
+		Label:      shellQuote(vm.Label),
+		Region:     vm.Region.Region,
+		Param:      quotedParams,
+	}
+}
+
+// matchVmLabel is func to check whether the VM label has all the comma separated terms of the selector
+func matchVmLabel(vmLabel string, selector string) bool {
+	if strings.TrimSpace(selector) == "" {
+		return true
+	}
+	terms := make(map[string]bool)
+	for _, v := range strings.Split(vmLabel, ",") {
+		terms[strings.TrimSpace(v)] = true
+	}
+	for _, v := range strings.Split(selector, ",") {
+		if !terms[strings.TrimSpace(v)] {
+			return false
+		}
+	}
+	return true
+}
+
+// checkScriptTargetOs is func to check whether the guest OS of the VM image fits the target OS hint
+func checkScriptTargetOs(nsId string, vm TbVmInfo, targetOs string) bool {
+	if targetOs == "" || vm.ImageId == "" {
+		return true
+	}
+	for _, ns := range []string{nsId, "common"} {
+		tempInterface, err := mcir.GetResource(ns, common.StrImage, vm.ImageId)
+		if err != nil {
+			continue
+		}
+		imageInfo := mcir.TbImageInfo{}
+		err = common.CopySrcToDest(&tempInterface, &imageInfo)
+		if err != nil || imageInfo.GuestOS == "" {
+			// unknown guest OS. try the script anyway.
+			return true
+		}
+		return strings.Contains(strings.ToLower(imageInfo.GuestOS), strings.ToLower(targetOs))
+	}
+	return true
+}
+
+// putScriptObject is func to store the script object and its version history
+func putScriptObject(nsId string, content TbScriptInfo) error {
+	val, _ := json.Marshal(content)
+
+	key := common.GenScriptKey(nsId, content.Id, "", "")
+	err := common.CBStore.Put(key, string(val))
+	if err != nil {
+		common.CBLog.Error(err)
+		return err
+	}
+
+	key = common.GenScriptKey(nsId, content.Id, scriptChildVersion, strconv.Itoa(content.Version))
+	err = common.CBStore.Put(key, string(val))
+	if err != nil {
+		common.CBLog.Error(err)
+		return err
+	}
+	return nil
+}
+
+// CheckScript is func to check whether the script exists
+func CheckScript(nsId string, scriptId string) (bool, error) {
+
+	err := common.CheckString(nsId)
+	if err != nil {
+		common.CBLog.Error(err)
+		return false, err
+	}
+
+	err = common.CheckString(scriptId)
+	if err != nil {
+		common.CBLog.Error(err)
+		return false, err
+	}
+
+	key := common.GenScriptKey(nsId, scriptId, "", "")
+	keyValue, _ := common.CBStore.Get(key)
+	if keyValue != nil {
+		return true, nil
+	}
+	return false, nil
+}
+
+// CreateScript is func to create a command script object
+func CreateScript(nsId string, req *TbScriptReq) (TbScriptInfo, error) {
+
+	err := common.CheckString(nsId)
+	if err != nil {
+		temp := TbScriptInfo{}
+		common.CBLog.Error(err)
+		return temp, err
+	}
+
+	err = validate.Struct(req)
+	if err != nil {
+		if _, ok := err.(*validator.InvalidValidationError); ok {
+			fmt.Println(err)
+		}
+		temp := TbScriptInfo{}
+		return temp, err
+	}
+
+	check, err := CheckScript(nsId, req.Name)
+	if err != nil {
+		temp := TbScriptInfo{}
+		return temp, err
+	}
+	if check {
+		temp := TbScriptInfo{}
+		err := fmt.Errorf("The script " + req.Name + " already exists.")
+		return temp, err
+	}
+
+	// check the template syntax in advance
+	_, err = template.New("script").Parse(req.Body)
+	if err != nil {
+		temp := TbScriptInfo{}
+		err := fmt.Errorf("The script body is not a valid template: " + err.Error())
+		return temp, err
+	}
+
+	now := time.Now().Format("2006-01-02 15:04:05")
+	content := TbScriptInfo{
+		Id:          req.Name,
+		Name:        req.Name,
+		Body:        req.Body,
+		TargetOs:    req.TargetOs,
+		Params:      req.Params,
+		Description: req.Description,
+		Version:     1,
+		CreatedTime: now,
+		UpdatedTime: now,
+	}
+
+	fmt.Println("=========================== PUT CreateScript")
+	err = putScriptObject(nsId, content)
+	if err != nil {
+		return content, err
+	}
+
+	return content, nil
+}
+
+// UpdateScript is func to update a command script object (a new version is kept in the version history)
+func UpdateScript(nsId string, scriptId string, req *TbScriptReq) (TbScriptInfo, error) {
+
+	content, err := GetScript(nsId, scriptId)
+	if err != nil {
+		return TbScriptInfo{}, err
+	}
+
+	if req.Body != "" {
+		_, err = template.New("script").Parse(req.Body)
+		if err != nil {
+			temp := TbScriptInfo{}
+			err := fmt.Errorf("The script body is not a valid template: " + err.Error())
+			return temp, err
+		}
+		content.Body = req.Body
+	}
+	if req.TargetOs != "" {
+		content.TargetOs = req.TargetOs
+	}
+	if req.Params != nil {
+		content.Params = req.Params
+	}
+	if req.Description != "" {
+		content.Description = req.Description
+	}
+	content.Version++
+	content.UpdatedTime = time.Now().Format("2006-01-02 15:04:05")
+
+	fmt.Println("=========================== PUT UpdateScript")
+	err = putScriptObject(nsId, content)
+	if err != nil {
+		return content, err
+	}
+
+	return content, nil
+}
+
+// GetScript is func to get a command script object (the latest version)
+func GetScript(nsId string, scriptId string) (TbScriptInfo, error) {
+
+	check, err := CheckScript(nsId, scriptId)
+	if err != nil {
+		return TbScriptInfo{}, err
+	}
+	if !check {
+		err := fmt.Errorf("The script " + scriptId + " does not exist.")
+		return TbScriptInfo{}, err
+	}
+
+	key := common.GenScriptKey(nsId, scriptId, "", "")
+	keyValue, err := common.CBStore.Get(key)
+	if err != nil {
+		common.CBLog.Error(err)
+		return TbScriptInfo{}, err
+	}
+	if keyValue == nil {
+		return TbScriptInfo{}, fmt.Errorf("Cannot find " + key)
+	}
+
+	content := TbScriptInfo{}
+	json.Unmarshal([]byte(keyValue.Value), &content)
+	return content, nil
+}
+
+// GetScriptVersion is func to get a specific version of a command script object
+func GetScriptVersion(nsId string, scriptId string, version int) (TbScriptInfo, error) {
+
+	check, err := CheckScript(nsId, scriptId)
+	if err != nil {
+		return TbScriptInfo{}, err
+	}
+	if !check {
+		err := fmt.Errorf("The script " + scriptId + " does not exist.")
+		return TbScriptInfo{}, err
+	}
+
+	key := common.GenScriptKey(nsId, scriptId, scriptChildVersion, strconv.Itoa(version))
+	keyValue, err := common.CBStore.Get(key)
+	if err != nil {
+		common.CBLog.Error(err)
+		return TbScriptInfo{}, err
+	}
+	if keyValue == nil {
+		err := fmt.Errorf("The version " + strconv.Itoa(version) + " of the script " + scriptId + " does not exist.")
+		return TbScriptInfo{}, err
+	}
+
+	content := TbScriptInfo{}
+	json.Unmarshal([]byte(keyValue.Value), &content)
+	return content, nil
+}
+
+// ListScript is func to list all command script objects in the namespace
+func ListScript(nsId string) ([]TbScriptInfo, error) {
+
+	err := common.CheckString(nsId)
+	if err != nil {
+		common.CBLog.Error(err)
+		return nil, err
+	}
+
+	keyPrefix := common.GenScriptKey(nsId, "", "", "") + "/"
+	keyValue, err := common.CBStore.GetList(keyPrefix, true)
+	if err != nil {
+		common.CBLog.Error(err)
+		return nil, err
+	}
+
+	result := []TbScriptInfo{}
+	for _, v := range keyValue {
+		// skip version and run history
+		if strings.Contains(strings.TrimPrefix(v.Key, keyPrefix), "/") {
+			continue
+		}
+		content := TbScriptInfo{}
+		json.Unmarshal([]byte(v.Value), &content)
+		result = append(result, content)
+	}
+	return result, nil
+}
+
+// ListScriptVersion is func to list the version history of a command script object
+func ListScriptVersion(nsId string, scriptId string) ([]TbScriptInfo, error) {
+
+	check, err := CheckScript(nsId, scriptId)
+	if err != nil {
+		return nil, err
+	}
+	if !check {
+		err := fmt.Errorf("The script " + scriptId + " does not exist.")
+		return nil, err
+	}
+
+	keyPrefix := common.GenScriptKey(nsId, scriptId, scriptChildVersion, "") + "/"
+	keyValue, err := common.CBStore.GetList(keyPrefix, true)
+	if err != nil {
+		common.CBLog.Error(err)
+		return nil, err
+	}
+
+	result := []TbScriptInfo{}
+	for _, v := range keyValue {
+		content := TbScriptInfo{}
+		json.Unmarshal([]byte(v.Value), &content)
+		result = append(result, content)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Version < result[j].Version
+	})
+	return result, nil
+}
+
+// DelScript is func to delete a command script object with its version and run history
+func DelScript(nsId string, scriptId string) error {
+
+	check, err := CheckScript(nsId, scriptId)
+	if err != nil {
+		return err
+	}
+	if !check {
+		err := fmt.Errorf("The script " + scriptId + " does not exist.")
+		return err
+	}
+
+	fmt.Println("[Delete script] " + scriptId)
+
+	for _, childType := range []string{scriptChildVersion, scriptChildRun} {
+		keyPrefix := common.GenScriptKey(nsId, scriptId, childType, "") + "/"
+		keyValue, err := common.CBStore.GetList(keyPrefix, true)
+		if err != nil {
+			common.CBLog.Error(err)
+			return err
+		}
+		for _, v := range keyValue {
+			err = common.CBStore.Delete(v.Key)
+			if err != nil {
+				common.CBLog.Error(err)
+				return err
+			}
+		}
+	}
+
+	key := common.GenScriptKey(nsId, scriptId, "", "")
+	err = common.CBStore.Delete(key)
+	if err != nil {
+		common.CBLog.Error(err)
+		return err
+	}
+	return nil
+}
+
+// RunScript is func to render a command script for each target VM and execute it by SSH
+func RunScript(nsId string, scriptId string, req *TbScriptRunReq) (TbScriptRunInfo, error) {
+
+	err := validate.Struct(req)
+	if err != nil {
+		if _, ok := err.(*validator.InvalidValidationError); ok {
+			fmt.Println(err)
+		}
+		return TbScriptRunInfo{}, err
+	}
+
+	script := TbScriptInfo{}
+	if req.Version == 0 {
+		script, err = GetScript(nsId, scriptId)
+	} else {
+		script, err = GetScriptVersion(nsId, scriptId, req.Version)
+	}
+	if err != nil {
+		return TbScriptRunInfo{}, err
+	}
+
+	check, _ := CheckMcis(nsId, req.McisId)
+	if !check {
+		err := fmt.Errorf("The mcis " + req.McisId + " does not exist.")
+		return TbScriptRunInfo{}, err
+	}
+
+	// user params in the request override the default params of the script
+	params := make(map[string]string)
+	for k, v := range script.Params {
+		params[k] = v
+	}
+	for k, v := range req.Params {
+		params[k] = v
+	}
+
+	runInfo := TbScriptRunInfo{
+		Id:            common.GenUid(),
+		ScriptId:      script.Id,
+		ScriptVersion: script.Version,
+		McisId:        req.McisId,
+		VmGroupId:     req.VmGroupId,
+		Label:         req.Label,
+		Params:        params,
+		StartedTime:   time.Now().Format("2006-01-02 15:04:05"),
+	}
+
+	vmList, err := ListVmId(nsId, req.McisId)
+	if err != nil {
+		common.CBLog.Error(err)
+		return TbScriptRunInfo{}, err
+	}
+
+	var resultArray []SshCmdResult
+	cmdList := make(map[string]string)
+	for _, v := range vmList {
+		vmInfo, err := GetVmObject(nsId, req.McisId, v)
+		if err != nil {
+			common.CBLog.Error(err)
+			continue
+		}
+		if req.VmGroupId != "" && vmInfo.VmGroupId != req.VmGroupId {
+			continue
+		}
+		if !matchVmLabel(vmInfo.Label, req.Label) {
+			continue
+		}
+
+		resultTmp := SshCmdResult{McisId: req.McisId, VmId: vmInfo.Id, VmIp: vmInfo.PublicIP}
+		if !checkScriptTargetOs(nsId, vmInfo, script.TargetOs) {
+			resultTmp.Result = "[SKIPPED: the guest OS of the VM does not fit the target OS " + script.TargetOs + "]"
+			resultArray = append(resultArray, resultTmp)
+			continue
+		}
+		cmd, err := RenderScript(script.Body, newScriptTemplateData(nsId, req.McisId, vmInfo, params))
+		if err != nil {
+			common.CBLog.Error(err)
+			resultTmp.Result = "[ERROR: " + err.Error() + "]"
+			resultTmp.Err = err
+			resultArray = append(resultArray, resultTmp)
+			continue
+		}
+		cmdList[vmInfo.Id] = cmd
+	}
+
+	if len(cmdList) == 0 && len(resultArray) == 0 {
+		err := fmt.Errorf("No VM in the mcis " + req.McisId + " matches the given vmGroupId and label.")
+		return TbScriptRunInfo{}, err
+	}
+
+	resultArray = append(resultArray, RemoteCommandToVmList(nsId, req.McisId, req.UserName, cmdList)...)
+
+	failed := 0
+	for _, v := range resultArray {
+		resultTmp := TbScriptRunResult{VmId: v.VmId, VmIp: v.VmIp, Result: v.Result}
+		if v.Err != nil {
+			resultTmp.Err = v.Err.Error()
+			failed++
+		}
+		runInfo.Results = append(runInfo.Results, resultTmp)
+	}
+	switch {
+	case failed == 0:
+		runInfo.Status = "Succeeded"
+	case failed == len(resultArray):
+		runInfo.Status = "Failed"
+	default:
+		runInfo.Status = "PartiallyFailed"
+	}
+	runInfo.FinishedTime = time.Now().Format("2006-01-02 15:04:05")
+
+	// keep the run history
+	key := common.GenScriptKey(nsId, scriptId, scriptChildRun, runInfo.Id)
+	val, _ := json.Marshal(runInfo)
+	err = common.CBStore.Put(key, string(val))
+	if err != nil {
+		common.CBLog.Error(err)
+	}
+	pruneScriptRun(nsId, scriptId)
+
+	return runInfo, nil
+}
+
+// pruneScriptRun is func to delete the oldest run records of a script beyond scriptRunMaxCount
+func pruneScriptRun(nsId string, scriptId string) {
+	runList, err := ListScriptRun(nsId, scriptId)
+	if err != nil {
+		common.CBLog.Error(err)
+		return
+	}
+	// runList is in ascending order of the started time
+	for i := 0; i < len(runList)-scriptRunMaxCount; i++ {
+		key := common.GenScriptKey(nsId, scriptId, scriptChildRun, runList[i].Id)
+		err = common.CBStore.Delete(key)
+		if err != nil {
+			common.CBLog.Error(err)
+		}
+	}
+}
+
+// ListScriptRun is func to list the run history of a command script object
+func ListScriptRun(nsId string, scriptId string) ([]TbScriptRunInfo, error) {
+
+	check, err := CheckScript(nsId, scriptId)
+	if err != nil {
+		return nil, err
+	}
+	if !check {
+		err := fmt.Errorf("The script " + scriptId + " does not exist.")
+		return nil, err
+	}
+
+	keyPrefix := common.GenScriptKey(nsId, scriptId, scriptChildRun, "") + "/"
+	keyValue, err := common.CBStore.GetList(keyPrefix, true)
+	if err != nil {
+		common.CBLog.Error(err)
+		return nil, err
+	}
+
+	result := []TbScriptRunInfo{}
+	for _, v := range keyValue {
+		content := TbScriptRunInfo{}
+		json.Unmarshal([]byte(v.Value), &content)
+		result = append(result, content)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].StartedTime < result[j].StartedTime
+	})
+	return result, nil
+}
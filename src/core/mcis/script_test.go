@@ -0,0 +1,54 @@
+/*
+Copyright 2019 The Cloud-Barista Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package mcis is to manage multi-cloud infra service
+package mcis
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRenderScript(t *testing.T) {
+	vm := TbVmInfo{Id: "vm01", PublicIP: "1.2.3.4", Label: "role=web"}
+
+	testCases := []struct {
+		name     string
+		body     string
+		params   map[string]string
+		expected string
+		isErr    bool
+	}{
+		{name: "vm values", body: "echo {{.VmId}} {{.PublicIP}} {{.McisId}}", expected: "echo vm01 1.2.3.4 mcis01"},
+		{name: "param", body: "nginx -p {{.Param.port}}", params: map[string]string{"port": "8080"}, expected: "nginx -p '8080'"},
+		{name: "label", body: "echo {{.Label}}", expected: "echo 'role=web'"},
+		// command substitution and separators in user params are not executed
+		{name: "injected param", body: "echo {{.Param.msg}}", params: map[string]string{"msg": "hi; rm -rf / $(id)"}, expected: "echo 'hi; rm -rf / $(id)'"},
+		{name: "single quote in param", body: "echo {{.Param.msg}}", params: map[string]string{"msg": "it's"}, expected: `echo 'it'\''s'`},
+		{name: "missing param", body: "echo {{.Param.port}}", params: map[string]string{}, isErr: true},
+		{name: "invalid template", body: "echo {{.VmId", isErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rendered, err := RenderScript(tc.body, newScriptTemplateData("ns01", "mcis01", vm, tc.params))
+			if tc.isErr {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expected, rendered)
+		})
+	}
+}
@@ -0,0 +1,311 @@
+/*
+Copyright 2019 The Cloud-Barista Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package mcis is to manage multi-cloud infra service
+package mcis
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cloud-barista/cb-tumblebug/src/core/common"
+	"golang.org/x/crypto/ssh"
+)
+
+const (
+	// sshDefaultConcurrencyLimit is the number of concurrent SSH sessions used when SSH_CONCURRENCY_LIMIT is not valid
+	sshDefaultConcurrencyLimit int = 20
+
+	// sshDefaultIdleTimeoutSec is the idle timeout used when SSH_IDLE_TIMEOUT_SEC is not valid
+	sshDefaultIdleTimeoutSec int = 300
+
+	// sshDefaultSessionTimeoutSec is the timeout of a SSH command used when SSH_SESSION_TIMEOUT_SEC is not valid
+	sshDefaultSessionTimeoutSec int = 1800
+
+	// sshDialTimeout is the timeout for establishing a new SSH connection
+	sshDialTimeout time.Duration = 30 * time.Second
+)
+
+// sshPoolEntry is struct for an SSH connection kept in the pool for a VM
+type sshPoolEntry struct {
+	client   *ssh.Client
+	endpoint string
+	userName string
+	keyHash  string
+	lastUsed time.Time
+	inUse    int
+	broken   bool
+}
+
+// sshConnPool is struct for SSH connections shared by remote command, agent installation and other SSH users
+type sshConnPool struct {
+	mutex      sync.Mutex
+	entries    map[string]*sshPoolEntry
+	reaperOnce sync.Once
+}
+
+// sshPool is the process-wide SSH connection pool keyed by VM key (/ns/{nsId}/mcis/{mcisId}/vm/{vmId})
+var sshPool = &sshConnPool{entries: make(map[string]*sshPoolEntry)}
+
+// getSshConcurrencyLimit returns the max number of concurrent SSH sessions for MCIS-wide operations
+func getSshConcurrencyLimit() int {
+	limit, err := strconv.Atoi(common.SshConcurrencyLimit)
+	if err != nil || limit <= 0 {
+		return sshDefaultConcurrencyLimit
+	}
+	return limit
+}
+
+// getSshIdleTimeout returns the duration after which an unused pooled SSH connection is closed
+func getSshIdleTimeout() time.Duration {
+	timeoutSec, err := strconv.Atoi(common.SshIdleTimeoutSec)
+	if err != nil || timeoutSec <= 0 {
+		timeoutSec = sshDefaultIdleTimeoutSec
+	}
+	return time.Duration(timeoutSec) * time.Second
+}
+
+// sshLimiter bounds the number of concurrent SSH sessions in an MCIS-wide operation
+type sshLimiter chan struct{}
+
+// newSshLimiter returns sshLimiter sized by SSH_CONCURRENCY_LIMIT
+func newSshLimiter() sshLimiter {
+	return make(sshLimiter, getSshConcurrencyLimit())
+}
+
+func (l sshLimiter) acquire() {
+	l <- struct{}{}
+}
+
+func (l sshLimiter) release() {
+	<-l
+}
+
+func hashPrivateKey(privateKey string) string {
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(privateKey)))
+}
+
+// get returns a live pooled connection for vmKey or dials a new one
+func (p *sshConnPool) get(vmKey string, info sshInfo) (*sshPoolEntry, error) {
+	p.reaperOnce.Do(func() { go p.reapIdle() })
+
+	keyHash := hashPrivateKey(string(info.PrivateKey))
+
+	p.mutex.Lock()
+	entry, exist := p.entries[vmKey]
+	if exist && !entry.broken && entry.endpoint == info.ServerPort && entry.userName == info.UserName && entry.keyHash == keyHash {
+		entry.inUse++
+		entry.lastUsed = time.Now()
+		p.mutex.Unlock()
+		return entry, nil
+	}
+	p.mutex.Unlock()
+
+	// dial outside of the lock so that handshakes to different VMs can proceed in parallel
+	clientConfig, err := getClientConfig(info.UserName, info.PrivateKey, ssh.InsecureIgnoreHostKey())
+	if err != nil {
+		return nil, err
+	}
+	clientConfig.Timeout = sshDialTimeout
+	client, err := ssh.Dial("tcp", info.ServerPort, &clientConfig)
+	if err != nil {
+		return nil, err
+	}
+	newEntry := &sshPoolEntry{
+		client:   client,
+		endpoint: info.ServerPort,
+		userName: info.UserName,
+		keyHash:  keyHash,
+		lastUsed: time.Now(),
+		inUse:    1,
+	}
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	old, exist := p.entries[vmKey]
+	if exist {
+		if old.endpoint == newEntry.endpoint && old.userName == newEntry.userName && old.keyHash == newEntry.keyHash {
+			// another goroutine has already connected to the same VM
+			client.Close()
+			old.inUse++
+			old.lastUsed = time.Now()
+			return old, nil
+		}
+		// endpoint or credential has been changed (ex: new public IP, rotated key)
+		if old.inUse == 0 {
+			old.client.Close()
+		}
+	}
+	p.entries[vmKey] = newEntry
+	return newEntry, nil
+}
+
+// release returns the connection to the pool
+func (p *sshConnPool) release(vmKey string, entry *sshPoolEntry) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	entry.inUse--
+	entry.lastUsed = time.Now()
+	if current, exist := p.entries[vmKey]; !exist || current != entry {
+		// the entry has been replaced, removed or marked broken while in use
+		if entry.inUse <= 0 {
+			entry.client.Close()
+		}
+	}
+}
+
+// discard marks a connection broken and removes it from the pool
+// (the connection is closed when the last session using it is released)
+func (p *sshConnPool) discard(vmKey string, entry *sshPoolEntry) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	entry.inUse--
+	entry.broken = true
+	if current, exist := p.entries[vmKey]; exist && current == entry {
+		delete(p.entries, vmKey)
+	}
+	if entry.inUse <= 0 {
+		entry.client.Close()
+	}
+}
+
+// close closes and removes pooled connections matched by the given function
+func (p *sshConnPool) close(match func(vmKey string) bool) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	for k, entry := range p.entries {
+		if match(k) {
+			delete(p.entries, k)
+			if entry.inUse <= 0 {
+				entry.client.Close()
+			}
+		}
+	}
+}
+
+// reapIdle periodically closes connections that have not been used for SSH_IDLE_TIMEOUT_SEC
+func (p *sshConnPool) reapIdle() {
+	for {
+		idleTimeout := getSshIdleTimeout()
+		interval := idleTimeout / 2
+		if interval < 10*time.Second {
+			interval = 10 * time.Second
+		}
+		time.Sleep(interval)
+
+		p.mutex.Lock()
+		for k, entry := range p.entries {
+			if entry.inUse <= 0 && time.Since(entry.lastUsed) > idleTimeout {
+				common.CBLog.Info("[SSH pool] close idle connection: " + k)
+				entry.client.Close()
+				delete(p.entries, k)
+			}
+		}
+		p.mutex.Unlock()
+	}
+}
+
+// getSshSessionTimeout returns the max duration of a SSH command so that a hung command does not hold a session forever
+func getSshSessionTimeout() time.Duration {
+	timeoutSec, err := strconv.Atoi(common.SshSessionTimeoutSec)
+	if err != nil || timeoutSec <= 0 {
+		timeoutSec = sshDefaultSessionTimeoutSec
+	}
+	return time.Duration(timeoutSec) * time.Second
+}
+
+// runCommandOnSession executes cmd on the opened session and closes the session
+// (the session is killed if cmd does not finish within SSH_SESSION_TIMEOUT_SEC)
+func runCommandOnSession(session *ssh.Session, cmd string) (string, bool, error) {
+	defer session.Close()
+
+	var stdout bytes.Buffer
+	session.Stdout = &stdout
+	session.Stderr = os.Stderr
+
+	timeout := getSshSessionTimeout()
+	timer := time.AfterFunc(timeout, func() {
+		// terminate only this session. other sessions may share the pooled connection.
+		session.Signal(ssh.SIGKILL)
+		session.Close()
+	})
+	err := session.Run(cmd)
+	timedOut := !timer.Stop()
+	if timedOut {
+		err = fmt.Errorf("The SSH command has not finished in " + timeout.String())
+	}
+	return strings.Trim(stdout.String(), "\n"), timedOut, err
+}
+
+// RunRemoteCommandWithPool is func to execute a SSH command to a VM by reusing the pooled connection of the VM (sync call)
+func RunRemoteCommandWithPool(vmKey string, vmIP string, sshPort string, userName string, privateKey string, cmd string) (*string, error) {
+
+	sshInfo := sshInfo{
+		ServerPort: fmt.Sprintf("%s:%s", vmIP, sshPort),
+		UserName:   userName,
+		PrivateKey: []byte(privateKey),
+	}
+
+	// retry once with a new connection if the pooled one has been broken.
+	// only opening a session is retried since cmd may not be idempotent once it has been started.
+	result := ""
+	var entry *sshPoolEntry
+	var session *ssh.Session
+	var err error
+	for i := 0; i < 2; i++ {
+		entry, err = sshPool.get(vmKey, sshInfo)
+		if err != nil {
+			return &result, err
+		}
+		session, err = entry.client.NewSession()
+		if err == nil {
+			break
+		}
+		common.CBLog.Info("[SSH pool] discard broken connection: " + vmKey + " (" + err.Error() + ")")
+		sshPool.discard(vmKey, entry)
+	}
+	if err != nil {
+		return &result, err
+	}
+
+	result, timedOut, err := runCommandOnSession(session, cmd)
+	if err == nil {
+		sshPool.release(vmKey, entry)
+		return &result, nil
+	}
+	if _, ok := err.(*ssh.ExitError); ok && !timedOut {
+		// the command itself failed. the connection is still valid.
+		sshPool.release(vmKey, entry)
+		return &result, err
+	}
+	common.CBLog.Info("[SSH pool] discard broken connection: " + vmKey + " (" + err.Error() + ")")
+	sshPool.discard(vmKey, entry)
+	return &result, err
+}
+
+// CloseVmSshConnection is func to close the pooled SSH connection of a VM (all VMs in the MCIS if vmId is empty)
+func CloseVmSshConnection(nsId string, mcisId string, vmId string) {
+	if vmId != "" {
+		vmKey := common.GenMcisKey(nsId, mcisId, vmId)
+		sshPool.close(func(k string) bool { return k == vmKey })
+		return
+	}
+	vmKeyPrefix := common.GenMcisKey(nsId, mcisId, "") + "/vm/"
+	sshPool.close(func(k string) bool { return strings.HasPrefix(k, vmKeyPrefix) })
+}
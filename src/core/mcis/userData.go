@@ -0,0 +1,112 @@
+/*
+Copyright 2019 The Cloud-Barista Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package mcis is to manage multi-cloud infra service
+package mcis
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cloud-barista/cb-tumblebug/src/core/common"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// cloudConfigHeader is the first line of cloud-config user data
+	cloudConfigHeader string = "#cloud-config"
+
+	// defaultUserDataSizeLimit is the max size (bytes, before base64 encoding) of user data for unlisted providers
+	defaultUserDataSizeLimit int = 16384
+
+	// UserDataEncodingRaw is the encoding of user data given as it is (default)
+	UserDataEncodingRaw string = "raw"
+
+	// UserDataEncodingBase64 is the encoding of user data given in base64
+	UserDataEncodingBase64 string = "base64"
+)
+
+// userDataSizeLimit is the max size (bytes, before base64 encoding) of user data for each provider
+var userDataSizeLimit = map[string]int{
+	"aws":       16384,
+	"azure":     49152, // 64KB after base64 encoding
+	"gcp":       262144,
+	"alibaba":   16384,
+	"tencent":   12288, // 16KB after base64 encoding
+	"ibm":       65535,
+	"openstack": 65535,
+	"cloudit":   16384,
+}
+
+// decodeUserData is func to get the raw content of user data by the given encoding (raw if empty)
+func decodeUserData(userData string, encoding string) ([]byte, error) {
+	switch encoding {
+	case "", UserDataEncodingRaw:
+		return []byte(userData), nil
+	case UserDataEncodingBase64:
+		decoded, err := base64.StdEncoding.DecodeString(strings.TrimSpace(userData))
+		if err != nil {
+			return nil, fmt.Errorf("The userData is not valid base64: " + err.Error())
+		}
+		return decoded, nil
+	default:
+		return nil, fmt.Errorf("The userDataEncoding " + encoding + " is not supported (use " + UserDataEncodingRaw + " or " + UserDataEncodingBase64 + ")")
+	}
+}
+
+// getUserDataSizeLimit is func to get the user data size limit of the provider of the connection
+func getUserDataSizeLimit(connectionName string) (string, int) {
+	connConfig, err := common.GetConnConfig(connectionName)
+	if err != nil {
+		common.CBLog.Error(err)
+		return "", defaultUserDataSizeLimit
+	}
+	providerName := strings.ToLower(connConfig.ProviderName)
+	if limit, ok := userDataSizeLimit[providerName]; ok {
+		return providerName, limit
+	}
+	return providerName, defaultUserDataSizeLimit
+}
+
+// ValidateUserData is func to validate user data (raw or base64 by encoding) and returns it as base64 for CB-Spider
+func ValidateUserData(connectionName string, userData string, encoding string) (string, error) {
+	if userData == "" {
+		return "", nil
+	}
+
+	content, err := decodeUserData(userData, encoding)
+	if err != nil {
+		return "", err
+	}
+
+	providerName, limit := getUserDataSizeLimit(connectionName)
+	if len(content) > limit {
+		err := fmt.Errorf("The size of userData (" + strconv.Itoa(len(content)) + " bytes) exceeds the limit of " + providerName + " (" + strconv.Itoa(limit) + " bytes)")
+		return "", err
+	}
+
+	// validate YAML syntax if the user data is cloud-config
+	if bytes.HasPrefix(bytes.TrimSpace(content), []byte(cloudConfigHeader)) {
+		cloudConfig := make(map[interface{}]interface{})
+		err := yaml.Unmarshal(content, &cloudConfig)
+		if err != nil {
+			err := fmt.Errorf("The userData is not a valid cloud-config YAML: " + err.Error())
+			return "", err
+		}
+	}
+
+	return base64.StdEncoding.EncodeToString(content), nil
+}
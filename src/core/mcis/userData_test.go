@@ -0,0 +1,50 @@
+/*
+Copyright 2019 The Cloud-Barista Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package mcis is to manage multi-cloud infra service
+package mcis
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeUserData(t *testing.T) {
+	testCases := []struct {
+		name     string
+		userData string
+		encoding string
+		expected string
+		isErr    bool
+	}{
+		{name: "raw by default", userData: "#cloud-config\npackages:\n  - nginx", encoding: "", expected: "#cloud-config\npackages:\n  - nginx"},
+		{name: "raw text which is valid base64 is kept", userData: "reboot", encoding: UserDataEncodingRaw, expected: "reboot"},
+		{name: "base64", userData: "I2Nsb3VkLWNvbmZpZw==", encoding: UserDataEncodingBase64, expected: "#cloud-config"},
+		{name: "base64 with surrounding spaces", userData: " I2Nsb3VkLWNvbmZpZw==\n", encoding: UserDataEncodingBase64, expected: "#cloud-config"},
+		{name: "invalid base64", userData: "#!/bin/bash", encoding: UserDataEncodingBase64, isErr: true},
+		{name: "unknown encoding", userData: "reboot", encoding: "gzip", isErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := decodeUserData(tc.userData, tc.encoding)
+			if tc.isErr {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expected, string(result))
+		})
+	}
+}
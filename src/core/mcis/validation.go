@@ -0,0 +1,233 @@
+/*
+Copyright 2019 The Cloud-Barista Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package mcis is to manage multi-cloud infra service
+package mcis
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cloud-barista/cb-tumblebug/src/core/common"
+	"github.com/cloud-barista/cb-tumblebug/src/core/mcir"
+)
+
+// Codes of validation problems
+const (
+	ValidationRequired             string = "required"
+	ValidationNotFound             string = "notFound"
+	ValidationAlreadyExists        string = "alreadyExists"
+	ValidationDuplicated           string = "duplicated"
+	ValidationConnectionMismatch   string = "connectionMismatch"
+	ValidationVNetMismatch         string = "vNetMismatch"
+	ValidationArchitectureMismatch string = "architectureMismatch"
+	ValidationOsMismatch           string = "osMismatch"
+	ValidationDiskTooSmall         string = "diskTooSmall"
+)
+
+// imageMinDiskKeys is keys of image key-value lists (CSP specific) for the minimum disk size in GB
+var imageMinDiskKeys = []string{"MinDiskSize", "MinDiskSizeGB", "MinDiskGB", "DiskSizeGb", "DiskSizeGB", "VolumeSize"}
+
+// TbValidationProblem is struct for a problem found by the pre-flight validation of VM requests
+type TbValidationProblem struct {
+	VmName  string `json:"vmName" example:"vm01"`
+	Field   string `json:"field" example:"imageId"`
+	Code    string `json:"code" example:"architectureMismatch" enums:"required,notFound,alreadyExists,duplicated,connectionMismatch,vNetMismatch,architectureMismatch,osMismatch,diskTooSmall"`
+	Message string `json:"message" example:"The architecture of image (arm64) is not compatible with spec (x86_64)"`
+}
+
+// TbValidationResult is struct for the result of the pre-flight validation of VM requests
+type TbValidationResult struct {
+	Valid   bool                  `json:"valid"`
+	Problem []TbValidationProblem `json:"problem"`
+}
+
+// validationErr is func to convert problems of the validation to an error (nil if no problem)
+func validationErr(problems []TbValidationProblem) error {
+	if len(problems) == 0 {
+		return nil
+	}
+	messages := []string{}
+	for _, v := range problems {
+		messages = append(messages, "["+v.VmName+"] "+v.Field+": "+v.Message)
+	}
+	return fmt.Errorf("Pre-flight validation failed with " + strconv.Itoa(len(problems)) + " problem(s): " + strings.Join(messages, "; "))
+}
+
+// getResourceWithCommon is func to get a resource in the namespace or in common namespace (for spec and image)
+func getResourceWithCommon(nsId string, resourceType string, resourceId string, withCommon bool, target interface{}) error {
+	getResource := mcir.GetResource
+	if withCommon {
+		getResource = mcir.GetResourceWithCommon
+	}
+	tempInterface, err := getResource(nsId, resourceType, resourceId)
+	if err != nil {
+		return err
+	}
+	return common.CopySrcToDest(&tempInterface, target)
+}
+
+// ValidateVmReq is func to check resources of a VM request before creation
+// (existence, the same connection, architecture and OS of spec and image) and returns all problems
+func ValidateVmReq(nsId string, vmReq *TbVmReq) []TbValidationProblem {
+	problems := []TbValidationProblem{}
+	report := func(field string, code string, message string) {
+		problems = append(problems, TbValidationProblem{VmName: vmReq.Name, Field: field, Code: code, Message: message})
+	}
+	required := func(field string, value string) bool {
+		if value == "" {
+			report(field, ValidationRequired, "The "+field+" is required")
+			return false
+		}
+		return true
+	}
+	checkConnection := func(field string, resourceType string, resourceId string, connectionName string) {
+		if vmReq.ConnectionName != "" && connectionName != vmReq.ConnectionName {
+			report(field, ValidationConnectionMismatch, "The "+resourceType+" "+resourceId+" belongs to "+connectionName+", not "+vmReq.ConnectionName)
+		}
+	}
+
+	required("connectionName", vmReq.ConnectionName)
+
+	// spec and image (common namespace is used if not found in the namespace)
+	specInfo := mcir.TbSpecInfo{}
+	specFound := false
+	if required("specId", vmReq.SpecId) {
+		err := getResourceWithCommon(nsId, common.StrSpec, vmReq.SpecId, true, &specInfo)
+		if err != nil {
+			report("specId", ValidationNotFound, "The spec "+vmReq.SpecId+" does not exist")
+		} else {
+			specFound = true
+			checkConnection("specId", common.StrSpec, vmReq.SpecId, specInfo.ConnectionName)
+		}
+	}
+	imageInfo := mcir.TbImageInfo{}
+	imageFound := false
+	if required("imageId", vmReq.ImageId) {
+		err := getResourceWithCommon(nsId, common.StrImage, vmReq.ImageId, true, &imageInfo)
+		if err != nil {
+			report("imageId", ValidationNotFound, "The image "+vmReq.ImageId+" does not exist")
+		} else {
+			imageFound = true
+			checkConnection("imageId", common.StrImage, vmReq.ImageId, imageInfo.ConnectionName)
+		}
+	}
+	if specFound && imageFound {
+		// OS information of images registered before parsing is filled here
+		mcir.EnrichImageOsInfo(&imageInfo)
+
+		specArch := mcir.GetSpecArchitecture(specInfo)
+		if specArch != "" && imageInfo.OsArchitecture != "" && specArch != imageInfo.OsArchitecture {
+			report("imageId", ValidationArchitectureMismatch, "The architecture of image ("+imageInfo.OsArchitecture+") is not compatible with spec "+specInfo.CspSpecName+" ("+specArch+")")
+		}
+		specOsFamily := mcir.ParseImageOsQuery(specInfo.OsType).OsFamily
+		if specOsFamily != "" && imageInfo.OsFamily != "" && specOsFamily != imageInfo.OsFamily {
+			report("imageId", ValidationOsMismatch, "The OS of image ("+imageInfo.OsFamily+") is not compatible with spec "+specInfo.CspSpecName+" ("+specInfo.OsType+")")
+		}
+		// disk size can be checked only if both of the spec and the image provide it
+		for _, key := range imageMinDiskKeys {
+			minDisk, err := strconv.ParseFloat(common.LookupKeyValueList(imageInfo.KeyValueList, key), 64)
+			if err != nil || minDisk <= 0 {
+				continue
+			}
+			if specInfo.StorageGiB > 0 && float64(specInfo.StorageGiB) < minDisk {
+				report("specId", ValidationDiskTooSmall, "The disk of spec "+specInfo.CspSpecName+" ("+fmt.Sprint(specInfo.StorageGiB)+" GB) is smaller than the image ("+fmt.Sprint(minDisk)+" GB)")
+			}
+			break
+		}
+	}
+
+	// vNet and subnet
+	if required("vNetId", vmReq.VNetId) {
+		vNetInfo := mcir.TbVNetInfo{}
+		err := getResourceWithCommon(nsId, common.StrVNet, vmReq.VNetId, false, &vNetInfo)
+		if err != nil {
+			report("vNetId", ValidationNotFound, "The vNet "+vmReq.VNetId+" does not exist")
+		} else {
+			checkConnection("vNetId", common.StrVNet, vmReq.VNetId, vNetInfo.ConnectionName)
+			if vmReq.SubnetId != "" {
+				subnetFound := false
+				for _, v := range vNetInfo.SubnetInfoList {
+					if v.Id == vmReq.SubnetId || v.Name == vmReq.SubnetId {
+						subnetFound = true
+						break
+					}
+				}
+				if !subnetFound {
+					report("subnetId", ValidationNotFound, "The subnet "+vmReq.SubnetId+" does not exist in the vNet "+vmReq.VNetId)
+				}
+			}
+		}
+	}
+
+	// security groups
+	if len(vmReq.SecurityGroupIds) == 0 {
+		report("securityGroupIds", ValidationRequired, "The securityGroupIds is required")
+	}
+	for _, sgId := range vmReq.SecurityGroupIds {
+		sgInfo := mcir.TbSecurityGroupInfo{}
+		err := getResourceWithCommon(nsId, common.StrSecurityGroup, sgId, false, &sgInfo)
+		if err != nil {
+			report("securityGroupIds", ValidationNotFound, "The securityGroup "+sgId+" does not exist")
+			continue
+		}
+		checkConnection("securityGroupIds", common.StrSecurityGroup, sgId, sgInfo.ConnectionName)
+		if vmReq.VNetId != "" && sgInfo.VNetId != "" && sgInfo.VNetId != vmReq.VNetId {
+			report("securityGroupIds", ValidationVNetMismatch, "The securityGroup "+sgId+" belongs to the vNet "+sgInfo.VNetId+", not "+vmReq.VNetId)
+		}
+	}
+
+	// ssh key
+	if required("sshKeyId", vmReq.SshKeyId) {
+		sshKeyInfo := mcir.TbSshKeyInfo{}
+		err := getResourceWithCommon(nsId, common.StrSSHKey, vmReq.SshKeyId, false, &sshKeyInfo)
+		if err != nil {
+			report("sshKeyId", ValidationNotFound, "The sshKey "+vmReq.SshKeyId+" does not exist")
+		} else {
+			checkConnection("sshKeyId", common.StrSSHKey, vmReq.SshKeyId, sshKeyInfo.ConnectionName)
+		}
+	}
+
+	return problems
+}
+
+// ValidateMcisReq is func to check an MCIS request and resources of all VM requests before creation
+func ValidateMcisReq(nsId string, req *TbMcisReq) (TbValidationResult, error) {
+	result := TbValidationResult{Problem: []TbValidationProblem{}}
+
+	err := common.CheckString(nsId)
+	if err != nil {
+		common.CBLog.Error(err)
+		return result, err
+	}
+
+	if req.Name == "" {
+		result.Problem = append(result.Problem, TbValidationProblem{Field: "name", Code: ValidationRequired, Message: "The name of MCIS is required"})
+	} else if check, _ := CheckMcis(nsId, req.Name); check {
+		result.Problem = append(result.Problem, TbValidationProblem{Field: "name", Code: ValidationAlreadyExists, Message: "The mcis " + req.Name + " already exists"})
+	}
+
+	vmNames := map[string]bool{}
+	for i := range req.Vm {
+		vmReq := &req.Vm[i]
+		if vmNames[vmReq.Name] {
+			result.Problem = append(result.Problem, TbValidationProblem{VmName: vmReq.Name, Field: "name", Code: ValidationDuplicated, Message: "The VM name " + vmReq.Name + " is duplicated in the request"})
+		}
+		vmNames[vmReq.Name] = true
+		result.Problem = append(result.Problem, ValidateVmReq(nsId, vmReq)...)
+	}
+
+	result.Valid = len(result.Problem) == 0
+	return result, nil
+}
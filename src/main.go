@@ -84,6 +84,19 @@ func main() {
 	common.DBUser = common.NVL(os.Getenv("DB_USER"), "cb_tumblebug")
 	common.DBPassword = common.NVL(os.Getenv("DB_PASSWORD"), "cb_tumblebug")
 	common.AutocontrolDurationMs = common.NVL(os.Getenv("AUTOCONTROL_DURATION_MS"), "10000")
+	common.SshConcurrencyLimit = common.NVL(os.Getenv("SSH_CONCURRENCY_LIMIT"), "20")
+	common.SshIdleTimeoutSec = common.NVL(os.Getenv("SSH_IDLE_TIMEOUT_SEC"), "300")
+	common.SshSessionTimeoutSec = common.NVL(os.Getenv("SSH_SESSION_TIMEOUT_SEC"), "1800")
+	common.PolicyHistoryMaxCount = common.NVL(os.Getenv("POLICY_HISTORY_MAX_COUNT"), "10000")
+	common.PolicyHistoryRetentionHour = common.NVL(os.Getenv("POLICY_HISTORY_RETENTION_HOUR"), "168")
+	common.MonCollectorIntervalSec = common.NVL(os.Getenv("MON_COLLECTOR_INTERVAL_SEC"), "60")
+
+	// load master keys to encrypt secrets at rest (not stored in DB)
+	err := common.InitSecretKey(os.Getenv(common.StrSecretKey), os.Getenv(common.StrSecretKeyFile), os.Getenv(common.StrSecretAccessToken))
+	if err != nil {
+		fmt.Printf("Failed to load master keys for secrets: %s\n", err.Error())
+		os.Exit(1)
+	}
 
 	// load the latest configuration from DB (if exist)
 	fmt.Println("")
@@ -91,6 +104,12 @@ func main() {
 	common.UpdateGlobalVariable(common.StrDragonflyRestUrl)
 	common.UpdateGlobalVariable(common.StrSpiderRestUrl)
 	common.UpdateGlobalVariable(common.StrAutocontrolDurationMs)
+	common.UpdateGlobalVariable(common.StrSshConcurrencyLimit)
+	common.UpdateGlobalVariable(common.StrSshIdleTimeoutSec)
+	common.UpdateGlobalVariable(common.StrSshSessionTimeoutSec)
+	common.UpdateGlobalVariable(common.StrPolicyHistoryMaxCount)
+	common.UpdateGlobalVariable(common.StrPolicyHistoryRetentionHour)
+	common.UpdateGlobalVariable(common.StrMonCollectorIntervalSec)
 
 	// load config
 	//masterConfigInfos = confighandler.GetMasterConfigInfos()
@@ -99,7 +118,7 @@ func main() {
 	fmt.Println("")
 	fmt.Println("[Setup SQL Database]")
 
-	err := os.MkdirAll("../meta_db/dat/", os.ModePerm)
+	err = os.MkdirAll("../meta_db/dat/", os.ModePerm)
 	if err != nil {
 		fmt.Println(err.Error())
 	}
@@ -156,10 +175,29 @@ func main() {
 			_ = t
 			//fmt.Println("- Orchestration Controller ", t.Format("2006-01-02 15:04:05"))
 			mcis.OrchestrationController()
+			// health checks and remediations run in background (skipped if the previous run is not finished)
+			go mcis.HealthController()
+			// budgets are evaluated every minute (skipped if the previous run is not finished)
+			go mcis.BudgetController()
+			// spec and image catalogs are synced with CSPs by the interval of each namespace policy
+			go mcir.CatalogSyncController()
 		}
 	}()
 	defer ticker.Stop()
 
+	//Ticker for agentless monitoring (SSH-based collector for VMs without the monitoring agent)
+	monCollectorInterval, _ := strconv.Atoi(common.MonCollectorIntervalSec) //sec
+	if monCollectorInterval > 0 {
+		monTicker := time.NewTicker(time.Second * time.Duration(monCollectorInterval))
+		go func() {
+			for range monTicker.C {
+				// skipped if the previous collection is not finished
+				go mcis.MonCollector()
+			}
+		}()
+		defer monTicker.Stop()
+	}
+
 	// Launch API servers (REST and gRPC)
 	wg := new(sync.WaitGroup)
 	wg.Add(2)
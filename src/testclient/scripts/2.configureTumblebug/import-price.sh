@@ -0,0 +1,23 @@
+#!/bin/bash
+
+echo "####################################################################"
+echo "## Import price catalog file"
+echo "## ./import-price.sh <file> <format: csv|json|aws|azure>"
+echo "####################################################################"
+
+PRICE_FILE=$1
+PRICE_FORMAT=${2:-csv}
+
+SCRIPT_DIR=`dirname ${BASH_SOURCE[0]-$0}`
+
+if [ -z "$PRICE_FILE" ] || [ ! -f "$PRICE_FILE" ]; then
+	echo "[Error] Price catalog file is required"
+	exit 1
+fi
+PRICE_FILE=$(realpath $PRICE_FILE)
+cd $SCRIPT_DIR
+
+source ../init.sh
+
+curl -H "${AUTH}" -sX POST "http://$TumblebugServer/tumblebug/importPrice?format=$PRICE_FORMAT" -H 'Content-Type: text/plain' --data-binary @$PRICE_FILE | jq '.skippedNum, (.price | length)'
+echo ""
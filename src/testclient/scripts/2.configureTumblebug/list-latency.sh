@@ -0,0 +1,13 @@
+#!/bin/bash
+
+echo "####################################################################"
+echo "## List inter-region latency matrix"
+echo "####################################################################"
+
+SCRIPT_DIR=`dirname ${BASH_SOURCE[0]-$0}`
+cd $SCRIPT_DIR
+
+source ../init.sh
+
+curl -H "${AUTH}" -sX GET http://$TumblebugServer/tumblebug/latency | jq ''
+echo ""
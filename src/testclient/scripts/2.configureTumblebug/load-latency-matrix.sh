@@ -0,0 +1,14 @@
+#!/bin/bash
+
+echo "####################################################################"
+echo "## Load inter-region latency matrix from asset file"
+echo "## (assets/cloudlatency.csv)"
+echo "####################################################################"
+
+SCRIPT_DIR=`dirname ${BASH_SOURCE[0]-$0}`
+cd $SCRIPT_DIR
+
+source ../init.sh
+
+curl -H "${AUTH}" -sX GET http://$TumblebugServer/tumblebug/loadLatencyMatrix | jq ''
+echo ""
@@ -0,0 +1,14 @@
+#!/bin/bash
+
+echo "####################################################################"
+echo "## Load price catalog from asset file"
+echo "## (assets/cloudprice.csv)"
+echo "####################################################################"
+
+SCRIPT_DIR=`dirname ${BASH_SOURCE[0]-$0}`
+cd $SCRIPT_DIR
+
+source ../init.sh
+
+curl -H "${AUTH}" -sX GET http://$TumblebugServer/tumblebug/loadPriceCatalog | jq ''
+echo ""
@@ -0,0 +1,18 @@
+#!/bin/bash
+
+echo "####################################################################"
+echo "## Sync spec and image catalog of common namespace with CSPs"
+echo "####################################################################"
+
+SCRIPT_DIR=`dirname ${BASH_SOURCE[0]-$0}`
+cd $SCRIPT_DIR
+
+source ../init.sh
+
+curl -H "${AUTH}" -sX POST "http://$TumblebugServer/tumblebug/ns/common/catalogSync?connectionName=${CONN_CONFIG[$INDEX,$REGION]}" |
+	jq '{addedNum, changedNum, removedNum, restoredNum, deletedNum, failed}'
+echo ""
+
+echo "[Catalog changes]"
+curl -H "${AUTH}" -sX GET "http://$TumblebugServer/tumblebug/ns/common/catalogChange?connectionName=${CONN_CONFIG[$INDEX,$REGION]}" | jq ''
+echo ""
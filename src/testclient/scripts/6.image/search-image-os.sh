@@ -0,0 +1,15 @@
+#!/bin/bash
+
+echo "####################################################################"
+echo "## 6. image: Search by OS (distribution, version, architecture)"
+echo "####################################################################"
+
+source ../init.sh
+
+OSQUERY=${OPTION01:-ubuntu 22.04}
+
+curl -H "${AUTH}" -sX POST http://$TumblebugServer/tumblebug/ns/$NSID/resources/searchImage -H 'Content-Type: application/json' -d \
+	'{
+		"connectionName": "'${CONN_CONFIG[$INDEX,$REGION]}'",
+		"os": "'"${OSQUERY}"'"
+	}' | jq '.image[] | {id, osFamily, osDistribution, osVersion, osArchitecture, cspImageId}'
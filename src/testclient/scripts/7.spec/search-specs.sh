@@ -0,0 +1,25 @@
+#!/bin/bash
+
+echo "####################################################################"
+echo "## 7. spec: search"
+echo "####################################################################"
+
+source ../init.sh
+
+resp=$(
+	curl -H "${AUTH}" -sX POST http://$TumblebugServer/tumblebug/ns/$NSID/resources/searchSpecs -H 'Content-Type: application/json' -d @- <<EOF
+	    {
+		"condition": [
+			{ "field": "memGiB", "operator": ">=", "value": ["4"] },
+			{ "field": "numvCPU", "operator": "between", "value": ["2", "8"] },
+			{ "field": "connectionName", "operator": "like", "value": ["aws"] }
+		],
+		"orderBy": [
+			{ "field": "costPerHour", "direction": "ascending" }
+		],
+		"limit": 20
+	    }
+EOF
+)
+echo ${resp} | jq ''
+echo ""
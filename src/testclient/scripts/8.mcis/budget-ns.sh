@@ -0,0 +1,22 @@
+#!/bin/bash
+
+echo "####################################################################"
+echo "## 8. MCIS: Set monthly budget of namespace"
+echo "####################################################################"
+
+source ../init.sh
+
+LIMIT=${OPTION01:-100}
+
+curl -H "${AUTH}" -sX PUT http://$TumblebugServer/tumblebug/ns/$NSID/budget -H 'Content-Type: application/json' -d \
+	'{
+		"monthlyLimit": '${LIMIT}',
+		"threshold": [50, 80, 100],
+		"enforcement": {
+			"blockProvisioning": true,
+			"suspendLabel": ""
+		}
+	}' | jq ''
+
+echo "[Budget alerts]"
+curl -H "${AUTH}" -sX GET http://$TumblebugServer/tumblebug/ns/$NSID/budget/alert | jq ''
@@ -0,0 +1,19 @@
+#!/bin/bash
+
+echo "####################################################################"
+echo "## 8. MCIS: Cost report (this month, grouped by VM)"
+echo "####################################################################"
+
+source ../init.sh
+
+echo "${MCISID}"
+
+curl -H "${AUTH}" -sX GET "http://$TumblebugServer/tumblebug/ns/$NSID/costReport?mcisId=${MCISID}&groupBy=vm" | jq ''
+
+echo -e "${BOLD}"
+echo -e "Table: Cost of VMs in the MCIS : ${MCISID}"
+
+echo -e "${NC} ${BLUE} ${BOLD}"
+curl -H "${AUTH}" -sX GET "http://$TumblebugServer/tumblebug/ns/$NSID/costReport?mcisId=${MCISID}&groupBy=vm&format=csv" |
+    column -t -s ','
+echo -e "${NC}"
@@ -0,0 +1,23 @@
+#!/bin/bash
+
+echo "####################################################################"
+echo "## 8. VM: Create MCIS Health Policy (parameter: -x [cspStatus/tcp/command], -y [tcp port])"
+echo "####################################################################"
+
+source ../init.sh
+
+CHECKTYPE=${OPTION01:-tcp}
+TCPPORT=${OPTION02:-}
+
+curl -H "${AUTH}" -sX POST http://$TumblebugServer/tumblebug/ns/$NSID/healthPolicy/mcis/$MCISID -H 'Content-Type: application/json' -d \
+	'{
+		"description": "Tumblebug Self-healing Demo",
+		"checkType": "'${CHECKTYPE}'",
+		"tcpPort": "'${TCPPORT}'",
+		"command": {
+			"command": "systemctl is-active --quiet nginx"
+		},
+		"intervalSec": 60,
+		"failureThreshold": 3,
+		"remediation": ["reboot", "replace"]
+	}' | jq ''
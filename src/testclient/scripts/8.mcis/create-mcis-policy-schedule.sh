@@ -0,0 +1,70 @@
+#!/bin/bash
+
+#function create_mcis_policy_schedule() {
+
+
+	TestSetFile=${5:-../testSet.env}
+    
+    if [ ! -f "$TestSetFile" ]; then
+        echo "$TestSetFile does not exist."
+        exit
+    fi
+	source $TestSetFile
+    source ../conf.env
+	
+	echo "####################################################################"
+	echo "## 8. Create MCIS Policy (Scheduled Scaling)"
+	echo "####################################################################"
+
+	CSP=${1}
+	REGION=${2:-1}
+	POSTFIX=${3:-developer}
+	MCISNAME=${4:-noname}
+
+	source ../common-functions.sh
+	getCloudIndex $CSP
+
+
+	MCISID=${CONN_CONFIG[$INDEX,$REGION]}-${POSTFIX}
+
+	if [ "${INDEX}" == "0" ]; then
+		# MCISPREFIX=avengers
+		MCISID=${POSTFIX}
+	fi
+
+	if [ "${MCISNAME}" != "noname" ]; then
+		echo "[MCIS name is given]"
+		MCISID=${MCISNAME}
+	fi
+
+	curl -H "${AUTH}" -sX POST http://$TumblebugServer/tumblebug/ns/$NSID/policy/mcis/$MCISID -H 'Content-Type: application/json' -d \
+		'{
+			"description": "Tumblebug Scheduled Scaling Demo (10 VMs on weekdays 09-18, 2 VMs otherwise)",
+			"policy": [
+				{
+					"autoSchedule": {
+						"timeZone": "Asia/Seoul",
+						"rules": [
+							{
+								"cron": "0 9 * * 1-5",
+								"desiredVmCount": 10
+							},
+							{
+								"cron": "0 18 * * 1-5",
+								"desiredVmCount": 2
+							}
+						]
+					},
+					"autoAction": {
+						"actionType": "ScaleTo",
+						"placementAlgo": "random",
+						"vm": {
+							"name": "AutoGen"
+						}
+					}
+				}
+			]
+		}' | jq '' 
+#}
+
+#create_mcis
\ No newline at end of file
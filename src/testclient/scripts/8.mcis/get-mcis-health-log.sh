@@ -0,0 +1,10 @@
+#!/bin/bash
+
+echo "####################################################################"
+echo "## 8. VM: Get MCIS Health Policy and Remediation Log"
+echo "####################################################################"
+
+source ../init.sh
+
+curl -H "${AUTH}" -sX GET http://$TumblebugServer/tumblebug/ns/$NSID/healthPolicy/mcis/${MCISID} | jq ''
+curl -H "${AUTH}" -sX GET http://$TumblebugServer/tumblebug/ns/$NSID/healthPolicy/mcis/${MCISID}/log | jq ''
@@ -0,0 +1,12 @@
+#!/bin/bash
+
+echo "####################################################################"
+echo "## 8. VM: Get MCIS Policy History"
+echo "####################################################################"
+
+source ../init.sh
+
+OFFSET=${OPTION01:-0}
+LIMIT=${OPTION02:-20}
+
+curl -H "${AUTH}" -sX GET "http://$TumblebugServer/tumblebug/ns/$NSID/policy/mcis/${MCISID}/history?offset=${OFFSET}&limit=${LIMIT}" | jq ''
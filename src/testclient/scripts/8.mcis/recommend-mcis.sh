@@ -0,0 +1,56 @@
+#!/bin/bash
+
+echo "####################################################################"
+echo "## 8. MCIS: Recommend MCIS plan (parameter: -x [maxCostPerHour], -y [minProviderNum], -z [deploy: true/false])"
+echo "####################################################################"
+
+source ../init.sh
+
+MAXCOST=${OPTION01:-0}
+MINPROVIDER=${OPTION02:-0}
+DEPLOY=${OPTION03:-false}
+
+curl -H "${AUTH}" -sX POST http://$TumblebugServer/tumblebug/ns/$NSID/mcisRecommend?deploy=$DEPLOY -H 'Content-Type: application/json' -d \
+	'{
+		"name": "'${MCISID}'",
+		"installMonAgent": "no",
+		"description": "Tumblebug Recommended MCIS",
+		"vm": [
+			{
+				"name": "web",
+				"vmGroupSize": "2",
+				"commonImage": "ubuntu18.04",
+				"filter": {
+					"policy": [
+						{ "metric": "cpu", "condition": [ { "operator": ">=", "operand": "2" } ] },
+						{ "metric": "memory", "condition": [ { "operator": ">=", "operand": "4" } ] }
+					]
+				},
+				"priority": {
+					"policy": [
+						{ "metric": "cost", "weight": "0.7" },
+						{ "metric": "performance", "weight": "0.3" }
+					]
+				}
+			},
+			{
+				"name": "db",
+				"commonImage": "ubuntu18.04",
+				"filter": {
+					"policy": [
+						{ "metric": "memory", "condition": [ { "operator": ">=", "operand": "8" } ] }
+					]
+				},
+				"priority": {
+					"policy": [
+						{ "metric": "cost", "weight": "1" }
+					]
+				}
+			}
+		],
+		"constraint": {
+			"maxCostPerHour": '${MAXCOST}',
+			"minProviderNum": '${MINPROVIDER}',
+			"sameRegion": false
+		}
+	}' | jq ''
@@ -0,0 +1,28 @@
+#!/bin/bash
+
+echo "####################################################################"
+echo "## 8. vm: Validate MCIS request (pre-flight)"
+echo "####################################################################"
+
+source ../init.sh
+
+NUMVM=${OPTION01:-1}
+
+curl -H "${AUTH}" -sX POST http://$TumblebugServer/tumblebug/ns/$NSID/mcis/validate -H 'Content-Type: application/json' -d \
+	'{
+		"name": "'${MCISID}'",
+		"description": "Tumblebug Demo",
+		"vm": [ {
+			"vmGroupSize": "'${NUMVM}'",
+			"name": "'${CONN_CONFIG[$INDEX,$REGION]}'",
+			"imageId": "'${CONN_CONFIG[$INDEX,$REGION]}'-'${POSTFIX}'",
+			"connectionName": "'${CONN_CONFIG[$INDEX,$REGION]}'",
+			"sshKeyId": "'${CONN_CONFIG[$INDEX,$REGION]}'-'${POSTFIX}'",
+			"specId": "'${CONN_CONFIG[$INDEX,$REGION]}'-'${POSTFIX}'",
+			"securityGroupIds": [
+				"'${CONN_CONFIG[$INDEX,$REGION]}'-'${POSTFIX}'"
+			],
+			"vNetId": "'${CONN_CONFIG[$INDEX,$REGION]}'-'${POSTFIX}'",
+			"subnetId": "'${CONN_CONFIG[$INDEX,$REGION]}'-'${POSTFIX}'"
+		} ]
+	}' | jq ''
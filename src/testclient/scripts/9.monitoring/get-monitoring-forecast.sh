@@ -0,0 +1,18 @@
+#!/bin/bash
+
+source ../init.sh
+
+echo "####################################################################"
+echo "## Get MCIS monitoring forecast (parameter: -x [cpu/memory/disk/network], -y [holtWinters/lastWeek])"
+echo "####################################################################"
+
+USERCMD=${OPTION01}
+MODEL=${OPTION02:-holtWinters}
+
+if [ -z "$USERCMD" ]; then
+	echo "[Warning] Provide monitoring metric to (-x parameter)"
+	echo "Available metric: cpu | cpufreq | memory | disk | network"
+	exit
+fi
+
+curl -H "${AUTH}" -sX GET "http://$TumblebugServer/tumblebug/ns/$NSID/monitoring/mcis/$MCISID/metric/$USERCMD/forecast?model=$MODEL" | jq ''
@@ -0,0 +1,19 @@
+#!/bin/bash
+
+source ../init.sh
+
+echo "####################################################################"
+echo "## Get retained MCIS monitoring data in a time range (parameter: -x [cpu/memory/disk/network], -y [range: 1h], -z [step: 1m])"
+echo "####################################################################"
+
+USERCMD=${OPTION01}
+RANGE=${OPTION02:-1h}
+STEP=${OPTION03:-1m}
+
+if [ -z "$USERCMD" ]; then
+	echo "[Warning] Provide monitoring metric to (-x parameter)"
+	echo "Available metric: cpu | memory | disk | network | load"
+	exit
+fi
+
+curl -H "${AUTH}" -sX GET "http://$TumblebugServer/tumblebug/ns/$NSID/monitoring/mcis/$MCISID/metric/$USERCMD?from=-$RANGE&step=$STEP&agg=avg" | jq ''